@@ -43,6 +43,11 @@ func (c *Client) FindActionTagsByPrefix(arg params.FindTags) (params.FindTagsRes
 // queued Action, or an error if there was a problem queueing up the
 // Action.
 func (c *Client) Enqueue(arg params.Actions) (params.ActionResults, error) {
+	for _, action := range arg.Actions {
+		if action.Timeout != 0 && c.BestAPIVersion() < 3 {
+			return params.ActionResults{}, errors.NotSupportedf("action timeouts on this controller")
+		}
+	}
 	results := params.ActionResults{}
 	err := c.facade.FacadeCall("Enqueue", arg, &results)
 	return results, err
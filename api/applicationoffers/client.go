@@ -32,6 +32,30 @@ func NewClient(st base.APICallCloser) *Client {
 
 // Offer prepares application's endpoints for consumption.
 func (c *Client) Offer(modelUUID, application string, endpoints []string, offerName string, desc string) ([]params.ErrorResult, error) {
+	args := makeAddApplicationOffers(modelUUID, application, endpoints, offerName, desc)
+	out := params.ErrorResults{}
+	if err := c.facade.FacadeCall("Offer", args, &out); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return out.Results, nil
+}
+
+// UpdateOffer replaces the endpoints of an existing offer, and changes its
+// description if desc is not empty. Endpoints which are used by relations
+// to consuming models cannot be removed.
+func (c *Client) UpdateOffer(modelUUID, application string, endpoints []string, offerName string, desc string) ([]params.ErrorResult, error) {
+	if bestVer := c.BestAPIVersion(); bestVer < 3 {
+		return nil, errors.NotImplementedf("UpdateOffer() (need v3+, have v%d)", bestVer)
+	}
+	args := makeAddApplicationOffers(modelUUID, application, endpoints, offerName, desc)
+	out := params.ErrorResults{}
+	if err := c.facade.FacadeCall("UpdateOffer", args, &out); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return out.Results, nil
+}
+
+func makeAddApplicationOffers(modelUUID, application string, endpoints []string, offerName string, desc string) params.AddApplicationOffers {
 	// TODO(wallyworld) - support endpoint aliases
 	ep := make(map[string]string)
 	for _, name := range endpoints {
@@ -46,11 +70,7 @@ func (c *Client) Offer(modelUUID, application string, endpoints []string, offerN
 			OfferName:              offerName,
 		},
 	}
-	out := params.ErrorResults{}
-	if err := c.facade.FacadeCall("Offer", params.AddApplicationOffers{Offers: offers}, &out); err != nil {
-		return nil, errors.Trace(err)
-	}
-	return out.Results, nil
+	return params.AddApplicationOffers{Offers: offers}
 }
 
 // ListOffers gets all remote applications that have been offered from this Juju model.
@@ -647,6 +647,65 @@ func (s *crossmodelMockSuite) TestGetConsumeDetailsBadURL(c *gc.C) {
 	c.Assert(err, gc.ErrorMatches, "application offer URL is missing application")
 }
 
+func (s *crossmodelMockSuite) TestUpdateOffer(c *gc.C) {
+	var called bool
+	apiCaller := basetesting.BestVersionCaller{
+		APICallerFunc: basetesting.APICallerFunc(
+			func(objType string,
+				version int,
+				id, request string,
+				a, result interface{},
+			) error {
+				called = true
+				c.Check(objType, gc.Equals, "ApplicationOffers")
+				c.Check(request, gc.Equals, "UpdateOffer")
+				c.Assert(a, jc.DeepEquals, params.AddApplicationOffers{
+					Offers: []params.AddApplicationOffer{{
+						ModelTag:               "model-uuid",
+						OfferName:              "hosted-mysql",
+						ApplicationName:        "mysql",
+						ApplicationDescription: "a database",
+						Endpoints:              map[string]string{"db": "db"},
+					}},
+				})
+				if results, ok := result.(*params.ErrorResults); ok {
+					results.Results = []params.ErrorResult{{
+						Error: &params.Error{Message: "fail"},
+					}}
+				}
+				return nil
+			},
+		),
+		BestVersion: 3,
+	}
+	client := applicationoffers.NewClient(apiCaller)
+	results, err := client.UpdateOffer("uuid", "mysql", []string{"db"}, "hosted-mysql", "a database")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, jc.DeepEquals, []params.ErrorResult{{
+		Error: &params.Error{Message: "fail"},
+	}})
+	c.Assert(called, jc.IsTrue)
+}
+
+func (s *crossmodelMockSuite) TestUpdateOfferNotSupported(c *gc.C) {
+	apiCaller := basetesting.BestVersionCaller{
+		APICallerFunc: basetesting.APICallerFunc(
+			func(objType string,
+				version int,
+				id, request string,
+				a, result interface{},
+			) error {
+				c.Fail()
+				return nil
+			},
+		),
+		BestVersion: 2,
+	}
+	client := applicationoffers.NewClient(apiCaller)
+	_, err := client.UpdateOffer("uuid", "mysql", []string{"db"}, "hosted-mysql", "")
+	c.Assert(err, gc.ErrorMatches, `UpdateOffer\(\) \(need v3\+, have v2\) not implemented`)
+}
+
 func (s *crossmodelMockSuite) TestDestroyOffers(c *gc.C) {
 	var called bool
 	apiCaller := basetesting.BestVersionCaller{
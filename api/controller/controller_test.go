@@ -138,6 +138,53 @@ func (s *Suite) TestInitiateMigrationError(c *gc.C) {
 	c.Check(err, gc.ErrorMatches, "boom")
 }
 
+func (s *Suite) TestMigrationPrechecks(c *gc.C) {
+	var stub jujutesting.Stub
+	apiCaller := apitesting.BestVersionCaller{
+		BestVersion: 6,
+		APICallerFunc: func(objType string, version int, id, request string, arg, result interface{}) error {
+			stub.AddCall(objType+"."+request, arg)
+			*result.(*params.MigrationPrecheckResults) = params.MigrationPrecheckResults{
+				Results: []params.MigrationPrecheckResult{{
+					Problems: []string{"source: cleanup needed", "target: upgrade in progress"},
+				}},
+			}
+			return nil
+		},
+	}
+	client := controller.NewClient(apiCaller)
+	spec := makeSpec()
+	problems, err := client.MigrationPrechecks(spec)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(problems, jc.DeepEquals, []string{"source: cleanup needed", "target: upgrade in progress"})
+	stub.CheckCalls(c, []jujutesting.StubCall{
+		{"Controller.MigrationPrechecks", []interface{}{specToArgs(spec)}},
+	})
+}
+
+func (s *Suite) TestMigrationPrechecksError(c *gc.C) {
+	apiCaller := apitesting.BestVersionCaller{
+		BestVersion: 6,
+		APICallerFunc: func(objType string, version int, id, request string, arg, result interface{}) error {
+			*result.(*params.MigrationPrecheckResults) = params.MigrationPrecheckResults{
+				Results: []params.MigrationPrecheckResult{{
+					Error: common.ServerError(errors.New("boom")),
+				}},
+			}
+			return nil
+		},
+	}
+	client := controller.NewClient(apiCaller)
+	_, err := client.MigrationPrechecks(makeSpec())
+	c.Check(err, gc.ErrorMatches, "boom")
+}
+
+func (s *Suite) TestMigrationPrechecksNotSupported(c *gc.C) {
+	client := controller.NewClient(apitesting.BestVersionCaller{BestVersion: 5})
+	_, err := client.MigrationPrechecks(makeSpec())
+	c.Check(err, jc.Satisfies, errors.IsNotSupported)
+}
+
 func (s *Suite) TestInitiateMigrationResultMismatch(c *gc.C) {
 	client, _ := makeInitiateMigrationClient(params.InitiateMigrationResults{
 		Results: []params.InitiateMigrationResult{
@@ -346,6 +346,82 @@ func (c *Client) RelationUnitSettings(relationUnits []params.RemoteRelationUnit)
 	return result, nil
 }
 
+// RelationApplicationSettings returns the settings published by the given
+// applications in relations in the remote model.
+func (c *Client) RelationApplicationSettings(relationApplications []params.RemoteRelationApplication) ([]params.SettingsResult, error) {
+	if c.BestAPIVersion() < 2 {
+		return nil, errors.NotImplementedf("RelationApplicationSettings() (need V2+)")
+	}
+	var (
+		args         params.RemoteRelationApplications
+		retryIndices []int
+	)
+
+	args = params.RemoteRelationApplications{RelationApplications: relationApplications}
+	// Use any previously cached discharge macaroons.
+	for i, arg := range args.RelationApplications {
+		if ms, ok := c.getCachedMacaroon("relation application settings", arg.RelationToken); ok {
+			newArg := arg
+			newArg.Macaroons = ms
+			args.RelationApplications[i] = newArg
+		}
+	}
+
+	var results params.SettingsResults
+	apiCall := func() error {
+		// Reset the results struct before each api call.
+		results = params.SettingsResults{}
+		err := c.facade.FacadeCall("RelationApplicationSettings", args, &results)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		if len(results.Results) != len(args.RelationApplications) {
+			return errors.Errorf("expected %d result(s), got %d", len(args.RelationApplications), len(results.Results))
+		}
+		return nil
+	}
+
+	// Make the api call the first time.
+	if err := apiCall(); err != nil {
+		return nil, errors.Trace(err)
+	}
+	// On error, possibly discharge the macaroon and retry.
+	result := results.Results
+	args = params.RemoteRelationApplications{}
+	// Separate the successful calls from those needing a retry.
+	for i, res := range results.Results {
+		if res.Error == nil {
+			continue
+		}
+		mac, err := c.handleError(res.Error)
+		if err != nil {
+			resCopy := res
+			resCopy.Error.Message = err.Error()
+			result[i] = resCopy
+			continue
+		}
+		retryArg := relationApplications[i]
+		retryArg.Macaroons = mac
+		args.RelationApplications = append(args.RelationApplications, retryArg)
+		retryIndices = append(retryIndices, i)
+		c.cache.Upsert(retryArg.RelationToken, mac)
+	}
+	// Nothing to retry so return the original result.
+	if len(args.RelationApplications) == 0 {
+		return result, nil
+	}
+
+	if err := apiCall(); err != nil {
+		return nil, errors.Trace(err)
+	}
+	// After a retry, insert the results into the original result slice.
+	for j, res := range results.Results {
+		resCopy := res
+		result[retryIndices[j]] = resCopy
+	}
+	return result, nil
+}
+
 // WatchEgressAddressesForRelation returns a watcher that notifies when addresses,
 // from which connections will originate to the offering side of the relation, change.
 // Each event contains the entire set of addresses which the offering side is required
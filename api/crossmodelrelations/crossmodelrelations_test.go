@@ -389,6 +389,45 @@ func (s *CrossModelRelationsSuite) TestRelationUnitSettings(c *gc.C) {
 	c.Check(callCount, gc.Equals, 2)
 }
 
+func (s *CrossModelRelationsSuite) TestRelationApplicationSettings(c *gc.C) {
+	mac, err := apitesting.NewMacaroon("id")
+	c.Assert(err, jc.ErrorIsNil)
+	var callCount int
+	apiCaller := testing.APICallerFunc(func(objType string, version int, id, request string, arg, result interface{}) error {
+		c.Check(objType, gc.Equals, "CrossModelRelations")
+		c.Check(version, gc.Equals, 2)
+		c.Check(id, gc.Equals, "")
+		c.Check(request, gc.Equals, "RelationApplicationSettings")
+		c.Check(arg, gc.DeepEquals, params.RemoteRelationApplications{
+			RelationApplications: []params.RemoteRelationApplication{{
+				RelationToken: "token", Application: "a", Macaroons: macaroon.Slice{mac}}}})
+		c.Assert(result, gc.FitsTypeOf, &params.SettingsResults{})
+		*(result.(*params.SettingsResults)) = params.SettingsResults{
+			Results: []params.SettingsResult{{
+				Settings: params.Settings{"foo": "bar"},
+			}},
+		}
+		callCount++
+		return nil
+	})
+	client := crossmodelrelations.NewClientWithCache(testing.BestVersionCaller{apiCaller, 2}, s.cache)
+	result, err := client.RelationApplicationSettings([]params.RemoteRelationApplication{
+		{RelationToken: "token", Application: "a", Macaroons: macaroon.Slice{mac}}})
+	c.Check(err, jc.ErrorIsNil)
+	c.Check(result, jc.DeepEquals, []params.SettingsResult{{Settings: params.Settings{"foo": "bar"}}})
+	c.Check(callCount, gc.Equals, 1)
+}
+
+func (s *CrossModelRelationsSuite) TestRelationApplicationSettingsNotImplemented(c *gc.C) {
+	apiCaller := testing.APICallerFunc(func(objType string, version int, id, request string, arg, result interface{}) error {
+		c.Fatalf("unexpected api call")
+		return nil
+	})
+	client := crossmodelrelations.NewClientWithCache(testing.BestVersionCaller{apiCaller, 1}, s.cache)
+	_, err := client.RelationApplicationSettings([]params.RemoteRelationApplication{{RelationToken: "token", Application: "a"}})
+	c.Check(err, jc.Satisfies, errors.IsNotImplemented)
+}
+
 func (s *CrossModelRelationsSuite) TestRelationUnitSettingsDischargeRequired(c *gc.C) {
 	var (
 		callCount    int
@@ -11,7 +11,7 @@ package api
 // New facades should start at 1.
 // Facades that existed before versioning start at 0.
 var facadeVersions = map[string]int{
-	"Action":                       2,
+	"Action":                       3,
 	"ActionPruner":                 1,
 	"Agent":                        2,
 	"AgentTools":                   1,
@@ -19,7 +19,7 @@ var facadeVersions = map[string]int{
 	"AllWatcher":                   1,
 	"Annotations":                  2,
 	"Application":                  6,
-	"ApplicationOffers":            2,
+	"ApplicationOffers":            3,
 	"ApplicationScaler":            1,
 	"Backups":                      2,
 	"Block":                        2,
@@ -34,19 +34,20 @@ var facadeVersions = map[string]int{
 	"Cleaner":                      2,
 	"Client":                       2,
 	"Cloud":                        2,
-	"Controller":                   5,
+	"Controller":                   6,
 	"CredentialValidator":          1,
 	"CrossController":              1,
-	"CrossModelRelations":          1,
+	"CrossModelRelations":          2,
 	"Deployer":                     1,
 	"DiskManager":                  2,
 	"EntityWatcher":                2,
 	"ExternalControllerUpdater":    1,
 	"FanConfigurer":                1,
 	"FilesystemAttachmentsWatcher": 2,
-	"Firewaller":                   5,
-	"FirewallRules":                1,
+	"Firewaller":                   6,
+	"FirewallRules":                2,
 	"HighAvailability":             2,
+	"HookHistory":                  1,
 	"HostKeyReporter":              1,
 	"ImageManager":                 2,
 	"ImageMetadata":                3,
@@ -70,9 +71,9 @@ var facadeVersions = map[string]int{
 	"MigrationMaster":              1,
 	"MigrationMinion":              1,
 	"MigrationStatusWatcher":       1,
-	"MigrationTarget":              1,
+	"MigrationTarget":              2,
 	"ModelConfig":                  2,
-	"ModelManager":                 4,
+	"ModelManager":                 5,
 	"ModelUpgrader":                1,
 	"NotifyWatcher":                1,
 	"OfferStatusWatcher":           1,
@@ -82,9 +83,11 @@ var facadeVersions = map[string]int{
 	"Provisioner":                  6,
 	"ProxyUpdater":                 2,
 	"Reboot":                       2,
+	"RelationData":                 1,
 	"RelationStatusWatcher":        1,
 	"RelationUnitsWatcher":         1,
-	"RemoteRelations":              1,
+	"RemoteRelationDiagnostics":    1,
+	"RemoteRelations":              3,
 	"Resources":                    1,
 	"ResourcesHookContext":         1,
 	"Resumer":                      2,
@@ -93,13 +96,14 @@ var facadeVersions = map[string]int{
 	"Spaces":                       3,
 	"SSHClient":                    2,
 	"StatusHistory":                2,
-	"Storage":                      4,
-	"StorageProvisioner":           4,
+	"Storage":                      6,
+	"StorageMonitor":               1,
+	"StorageProvisioner":           6,
 	"StringsWatcher":               1,
 	"Subnets":                      2,
 	"Undertaker":                   1,
 	"UnitAssigner":                 1,
-	"Uniter":                       8,
+	"Uniter":                       13,
 	"Upgrader":                     1,
 	"UserManager":                  2,
 	"VolumeAttachmentsWatcher":     2,
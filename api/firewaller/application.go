@@ -6,9 +6,11 @@ package firewaller
 import (
 	"fmt"
 
+	"github.com/juju/errors"
 	"gopkg.in/juju/names.v2"
 
 	"github.com/juju/juju/api/common"
+	apiwatcher "github.com/juju/juju/api/watcher"
 	"github.com/juju/juju/apiserver/params"
 	"github.com/juju/juju/watcher"
 )
@@ -75,3 +77,30 @@ func (s *Application) IsExposed() (bool, error) {
 	}
 	return result.Result, nil
 }
+
+// WatchRelatedIngressAddresses returns a watcher that notifies when the
+// addresses of units related to the application within the model change.
+// Each event contains the entire set of CIDRs from which the related
+// units will connect to the application.
+func (s *Application) WatchRelatedIngressAddresses() (watcher.StringsWatcher, error) {
+	if s.st.BestAPIVersion() < 6 {
+		return nil, errors.NotSupportedf("WatchRelatedIngressAddresses on Firewaller API version %d", s.st.BestAPIVersion())
+	}
+	var results params.StringsWatchResults
+	args := params.Entities{
+		Entities: []params.Entity{{Tag: s.tag.String()}},
+	}
+	err := s.st.facade.FacadeCall("WatchRelatedIngressAddresses", args, &results)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if len(results.Results) != 1 {
+		return nil, errors.Errorf("expected 1 result, got %d", len(results.Results))
+	}
+	result := results.Results[0]
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	w := apiwatcher.NewStringsWatcher(s.st.facade.RawAPICaller(), result)
+	return w, nil
+}
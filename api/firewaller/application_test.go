@@ -91,3 +91,14 @@ func (s *applicationSuite) TestIsExposed(c *gc.C) {
 	c.Assert(err, jc.ErrorIsNil)
 	c.Assert(isExposed, jc.IsFalse)
 }
+
+func (s *applicationSuite) TestWatchRelatedIngressAddresses(c *gc.C) {
+	w, err := s.apiApplication.WatchRelatedIngressAddresses()
+	c.Assert(err, jc.ErrorIsNil)
+	wc := watchertest.NewStringsWatcherC(c, w, s.BackingState.StartSync)
+	defer wc.AssertStops()
+
+	// Initial event; the application has no relations.
+	wc.AssertChange()
+	wc.AssertNoChange()
+}
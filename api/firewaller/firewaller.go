@@ -255,3 +255,35 @@ func (c *Client) FirewallRules(knownServices ...string) ([]params.FirewallRule,
 	}
 	return results.Rules, nil
 }
+
+// ModelFirewallRules returns the named firewall rules which restrict
+// ingress to port ranges in the model, including those set on the
+// controller which aren't overridden by the model.
+func (c *Client) ModelFirewallRules() ([]params.FirewallRule, error) {
+	if c.BestAPIVersion() < 6 {
+		return nil, errors.NotSupportedf("model firewall rules")
+	}
+	var results params.ListFirewallRulesResults
+	err := c.facade.FacadeCall("ModelFirewallRules", nil, &results)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return results.Rules, nil
+}
+
+// WatchModelFirewallRules returns a NotifyWatcher that notifies when
+// the named firewall rules applying to the model may have changed.
+func (c *Client) WatchModelFirewallRules() (watcher.NotifyWatcher, error) {
+	if c.BestAPIVersion() < 6 {
+		return nil, errors.NotSupportedf("model firewall rules")
+	}
+	var result params.NotifyWatchResult
+	if err := c.facade.FacadeCall("WatchModelFirewallRules", nil, &result); err != nil {
+		return nil, errors.Trace(err)
+	}
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	w := apiwatcher.NewNotifyWatcher(c.facade.RawAPICaller(), result)
+	return w, nil
+}
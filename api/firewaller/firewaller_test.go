@@ -4,6 +4,7 @@
 package firewaller_test
 
 import (
+	"github.com/juju/errors"
 	jc "github.com/juju/testing/checkers"
 	"github.com/juju/utils"
 	gc "gopkg.in/check.v1"
@@ -246,3 +247,50 @@ func (s *firewallerSuite) TestFirewallRules(c *gc.C) {
 	c.Assert(result, gc.HasLen, 1)
 	c.Check(callCount, gc.Equals, 1)
 }
+
+func (s *firewallerSuite) TestModelFirewallRules(c *gc.C) {
+	var callCount int
+	apiCaller := testing.BestVersionCaller{
+		APICallerFunc: testing.APICallerFunc(func(objType string, version int, id, request string, arg, result interface{}) error {
+			c.Check(objType, gc.Equals, "Firewaller")
+			c.Check(version, gc.Equals, 6)
+			c.Check(id, gc.Equals, "")
+			c.Check(request, gc.Equals, "ModelFirewallRules")
+			c.Assert(arg, gc.IsNil)
+			c.Assert(result, gc.FitsTypeOf, &params.ListFirewallRulesResults{})
+			*(result.(*params.ListFirewallRulesResults)) = params.ListFirewallRulesResults{
+				Rules: []params.FirewallRule{{
+					Name:           "prometheus",
+					PortRange:      &params.PortRange{FromPort: 9100, ToPort: 9100, Protocol: "tcp"},
+					WhitelistCIDRS: []string{"10.0.0.0/16"},
+				}},
+			}
+			callCount++
+			return nil
+		}),
+		BestVersion: 6,
+	}
+	client, err := firewaller.NewClient(apiCaller)
+	c.Assert(err, jc.ErrorIsNil)
+	result, err := client.ModelFirewallRules()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result, gc.HasLen, 1)
+	c.Assert(result[0].Name, gc.Equals, "prometheus")
+	c.Check(callCount, gc.Equals, 1)
+}
+
+func (s *firewallerSuite) TestModelFirewallRulesNotSupported(c *gc.C) {
+	apiCaller := testing.BestVersionCaller{
+		APICallerFunc: testing.APICallerFunc(func(objType string, version int, id, request string, arg, result interface{}) error {
+			c.Fail()
+			return nil
+		}),
+		BestVersion: 5,
+	}
+	client, err := firewaller.NewClient(apiCaller)
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = client.ModelFirewallRules()
+	c.Assert(err, jc.Satisfies, errors.IsNotSupported)
+	_, err = client.WatchModelFirewallRules()
+	c.Assert(err, jc.Satisfies, errors.IsNotSupported)
+}
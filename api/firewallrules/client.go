@@ -8,6 +8,7 @@ import (
 
 	"github.com/juju/juju/api/base"
 	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/network"
 )
 
 // Client allows access to the firewall rules API end point.
@@ -44,6 +45,49 @@ func (c *Client) SetFirewallRule(service string, whiteListCidrs []string) error
 	return results.OneError()
 }
 
+// SetPortFirewallRule creates or updates a named firewall rule restricting
+// ingress to any ports opened within the specified port range to the
+// whitelisted CIDRs. If controller is true, the rule applies to all
+// models hosted by the controller.
+func (c *Client) SetPortFirewallRule(name string, portRange network.PortRange, whiteListCidrs []string, controller bool) error {
+	if c.BestAPIVersion() < 2 {
+		return errors.NotSupportedf("firewall rules for port ranges")
+	}
+	paramsPortRange := params.FromNetworkPortRange(portRange)
+	args := params.FirewallRuleArgs{
+		Args: []params.FirewallRule{{
+			Name:           name,
+			PortRange:      &paramsPortRange,
+			Controller:     controller,
+			WhitelistCIDRS: whiteListCidrs,
+		}},
+	}
+	var results params.ErrorResults
+	if err := c.facade.FacadeCall("SetFirewallRules", args, &results); err != nil {
+		return errors.Trace(err)
+	}
+	return results.OneError()
+}
+
+// RemoveFirewallRule removes the named firewall rule. If controller
+// is true, the rule is removed from the controller.
+func (c *Client) RemoveFirewallRule(name string, controller bool) error {
+	if c.BestAPIVersion() < 2 {
+		return errors.NotSupportedf("removing firewall rules")
+	}
+	args := params.FirewallRuleArgs{
+		Args: []params.FirewallRule{{
+			Name:       name,
+			Controller: controller,
+		}},
+	}
+	var results params.ErrorResults
+	if err := c.facade.FacadeCall("RemoveFirewallRules", args, &results); err != nil {
+		return errors.Trace(err)
+	}
+	return results.OneError()
+}
+
 // ListFirewallRules returns all the firewall rules.
 func (c *Client) ListFirewallRules() ([]params.FirewallRule, error) {
 	var results params.ListFirewallRulesResults
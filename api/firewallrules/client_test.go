@@ -12,6 +12,7 @@ import (
 	"github.com/juju/juju/api/firewallrules"
 	"github.com/juju/juju/apiserver/common"
 	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/network"
 	"github.com/juju/juju/testing"
 )
 
@@ -141,3 +142,87 @@ func (s *FirewallRulesSuite) TestListError(c *gc.C) {
 	c.Assert(errors.Cause(err), gc.ErrorMatches, "fail")
 	c.Assert(called, jc.IsTrue)
 }
+
+func (s *FirewallRulesSuite) TestSetPortFirewallRule(c *gc.C) {
+	called := false
+	apiCaller := basetesting.BestVersionCaller{
+		APICallerFunc: func(objType string,
+			version int,
+			id, request string,
+			a, result interface{},
+		) error {
+			c.Check(objType, gc.Equals, "FirewallRules")
+			c.Check(request, gc.Equals, "SetFirewallRules")
+			c.Check(a, jc.DeepEquals, params.FirewallRuleArgs{
+				Args: []params.FirewallRule{{
+					Name:           "prometheus",
+					PortRange:      &params.PortRange{FromPort: 9100, ToPort: 9110, Protocol: "tcp"},
+					Controller:     true,
+					WhitelistCIDRS: []string{"10.0.0.0/8"},
+				}},
+			})
+			called = true
+			if results, ok := result.(*params.ErrorResults); ok {
+				results.Results = []params.ErrorResult{{}}
+			}
+			return nil
+		},
+		BestVersion: 2,
+	}
+
+	client := firewallrules.NewClient(apiCaller)
+	err := client.SetPortFirewallRule(
+		"prometheus",
+		network.PortRange{FromPort: 9100, ToPort: 9110, Protocol: "tcp"},
+		[]string{"10.0.0.0/8"},
+		true,
+	)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(called, jc.IsTrue)
+}
+
+func (s *FirewallRulesSuite) TestSetPortFirewallRuleNotSupported(c *gc.C) {
+	apiCaller := basetesting.BestVersionCaller{
+		APICallerFunc: func(objType string,
+			version int,
+			id, request string,
+			a, result interface{},
+		) error {
+			c.Fail()
+			return nil
+		},
+		BestVersion: 1,
+	}
+	client := firewallrules.NewClient(apiCaller)
+	err := client.SetPortFirewallRule("prometheus", network.PortRange{}, nil, false)
+	c.Assert(err, jc.Satisfies, errors.IsNotSupported)
+}
+
+func (s *FirewallRulesSuite) TestRemoveFirewallRule(c *gc.C) {
+	called := false
+	apiCaller := basetesting.BestVersionCaller{
+		APICallerFunc: func(objType string,
+			version int,
+			id, request string,
+			a, result interface{},
+		) error {
+			c.Check(objType, gc.Equals, "FirewallRules")
+			c.Check(request, gc.Equals, "RemoveFirewallRules")
+			c.Check(a, jc.DeepEquals, params.FirewallRuleArgs{
+				Args: []params.FirewallRule{{Name: "prometheus"}},
+			})
+			called = true
+			if results, ok := result.(*params.ErrorResults); ok {
+				results.Results = []params.ErrorResult{{
+					Error: common.ServerError(errors.New("fail"))}}
+			}
+			return nil
+		},
+		BestVersion: 2,
+	}
+
+	client := firewallrules.NewClient(apiCaller)
+	err := client.RemoveFirewallRule("prometheus", false)
+	c.Assert(err, gc.ErrorMatches, "fail")
+	c.Assert(called, jc.IsTrue)
+}
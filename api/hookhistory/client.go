@@ -0,0 +1,44 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package hookhistory
+
+import (
+	"github.com/juju/errors"
+	"gopkg.in/juju/names.v2"
+
+	"github.com/juju/juju/api/base"
+	"github.com/juju/juju/apiserver/params"
+)
+
+// Client allows access to the hook history API end point.
+type Client struct {
+	base.ClientFacade
+	facade base.FacadeCaller
+}
+
+// NewClient creates a new client for accessing the hook history API.
+func NewClient(st base.APICallCloser) *Client {
+	frontend, backend := base.NewClientFacade(st, "HookHistory")
+	return &Client{ClientFacade: frontend, facade: backend}
+}
+
+// HookHistory returns the operations most recently completed by the
+// uniter of the specified unit, newest first. If size is positive, at
+// most that many entries are returned.
+func (c *Client) HookHistory(unit names.UnitTag, size int) ([]params.HookHistoryEntry, error) {
+	args := params.HookHistoryRequests{
+		Requests: []params.HookHistoryRequest{{Tag: unit.String(), Size: size}},
+	}
+	var results params.HookHistoryResults
+	if err := c.facade.FacadeCall("HookHistory", args, &results); err != nil {
+		return nil, errors.Trace(err)
+	}
+	if len(results.Results) != 1 {
+		return nil, errors.Errorf("expected 1 result, got %d", len(results.Results))
+	}
+	if err := results.Results[0].Error; err != nil {
+		return nil, errors.Trace(err)
+	}
+	return results.Results[0].History, nil
+}
@@ -0,0 +1,87 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package hookhistory_test
+
+import (
+	"github.com/juju/errors"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+	"gopkg.in/juju/names.v2"
+
+	basetesting "github.com/juju/juju/api/base/testing"
+	"github.com/juju/juju/api/hookhistory"
+	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/testing"
+)
+
+type ClientSuite struct {
+	testing.BaseSuite
+}
+
+var _ = gc.Suite(&ClientSuite{})
+
+func (s *ClientSuite) TestHookHistory(c *gc.C) {
+	apiCaller := basetesting.APICallerFunc(
+		func(objType string,
+			version int,
+			id, request string,
+			a, result interface{},
+		) error {
+			c.Check(objType, gc.Equals, "HookHistory")
+			c.Check(id, gc.Equals, "")
+			c.Check(request, gc.Equals, "HookHistory")
+			c.Check(a, jc.DeepEquals, params.HookHistoryRequests{
+				Requests: []params.HookHistoryRequest{{Tag: "unit-mysql-0", Size: 10}},
+			})
+			c.Assert(result, gc.FitsTypeOf, &params.HookHistoryResults{})
+			*(result.(*params.HookHistoryResults)) = params.HookHistoryResults{
+				Results: []params.HookHistoryResult{{
+					History: []params.HookHistoryEntry{{Operation: "run install hook", Hook: "install"}},
+				}},
+			}
+			return nil
+		})
+
+	client := hookhistory.NewClient(apiCaller)
+	history, err := client.HookHistory(names.NewUnitTag("mysql/0"), 10)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(history, jc.DeepEquals, []params.HookHistoryEntry{
+		{Operation: "run install hook", Hook: "install"},
+	})
+}
+
+func (s *ClientSuite) TestHookHistoryError(c *gc.C) {
+	apiCaller := basetesting.APICallerFunc(
+		func(objType string,
+			version int,
+			id, request string,
+			a, result interface{},
+		) error {
+			*(result.(*params.HookHistoryResults)) = params.HookHistoryResults{
+				Results: []params.HookHistoryResult{{
+					Error: &params.Error{Message: `unit "mysql/0" not found`, Code: params.CodeNotFound},
+				}},
+			}
+			return nil
+		})
+
+	client := hookhistory.NewClient(apiCaller)
+	_, err := client.HookHistory(names.NewUnitTag("mysql/0"), 0)
+	c.Assert(err, gc.ErrorMatches, `unit "mysql/0" not found`)
+}
+
+func (s *ClientSuite) TestHookHistoryFacadeCallError(c *gc.C) {
+	apiCaller := basetesting.APICallerFunc(
+		func(objType string,
+			version int,
+			id, request string,
+			a, result interface{},
+		) error {
+			return errors.New("facade failure")
+		})
+
+	client := hookhistory.NewClient(apiCaller)
+	_, err := client.HookHistory(names.NewUnitTag("mysql/0"), 0)
+	c.Assert(err, gc.ErrorMatches, "facade failure")
+}
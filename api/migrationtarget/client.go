@@ -53,6 +53,30 @@ func (c *Client) Prechecks(model coremigration.ModelInfo) error {
 	return c.caller.FacadeCall("Prechecks", args, nil)
 }
 
+// CheckImport runs the target prechecks for the model and checks that
+// the serialized model could be imported, without importing it. All of
+// the problems found are returned.
+func (c *Client) CheckImport(model coremigration.ModelInfo, bytes []byte) ([]string, error) {
+	if c.caller.BestAPIVersion() < 2 {
+		return nil, errors.NotSupportedf("checking model import on this controller")
+	}
+	args := params.MigrationImportCheckArgs{
+		Info: params.MigrationModelInfo{
+			UUID:                   model.UUID,
+			Name:                   model.Name,
+			OwnerTag:               model.Owner.String(),
+			AgentVersion:           model.AgentVersion,
+			ControllerAgentVersion: model.ControllerAgentVersion,
+		},
+		Bytes: bytes,
+	}
+	var result params.MigrationProblems
+	if err := c.caller.FacadeCall("CheckImport", args, &result); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return result.Problems, nil
+}
+
 // Import takes a serialized model and imports it into the target
 // controller.
 func (c *Client) Import(bytes []byte) error {
@@ -77,6 +77,52 @@ func (s *ClientSuite) TestPrechecks(c *gc.C) {
 	})
 }
 
+func (s *ClientSuite) TestCheckImport(c *gc.C) {
+	var stub jujutesting.Stub
+	apiCaller := apitesting.BestVersionCaller{
+		BestVersion: 2,
+		APICallerFunc: func(objType string, version int, id, request string, arg, result interface{}) error {
+			stub.AddCall(objType+"."+request, id, arg)
+			*result.(*params.MigrationProblems) = params.MigrationProblems{
+				Problems: []string{"upgrade in progress", "model named \"name\" already exists"},
+			}
+			return nil
+		},
+	}
+	client := migrationtarget.NewClient(apiCaller)
+
+	ownerTag := names.NewUserTag("owner")
+	vers := version.MustParse("1.2.3")
+	problems, err := client.CheckImport(coremigration.ModelInfo{
+		UUID:                   "uuid",
+		Owner:                  ownerTag,
+		Name:                   "name",
+		AgentVersion:           vers,
+		ControllerAgentVersion: vers,
+	}, []byte("model"))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(problems, jc.DeepEquals, []string{"upgrade in progress", "model named \"name\" already exists"})
+	stub.CheckCalls(c, []jujutesting.StubCall{
+		{"MigrationTarget.CheckImport", []interface{}{"", params.MigrationImportCheckArgs{
+			Info: params.MigrationModelInfo{
+				UUID:                   "uuid",
+				Name:                   "name",
+				OwnerTag:               ownerTag.String(),
+				AgentVersion:           vers,
+				ControllerAgentVersion: vers,
+			},
+			Bytes: []byte("model"),
+		}}},
+	})
+}
+
+func (s *ClientSuite) TestCheckImportNotSupported(c *gc.C) {
+	client, stub := s.getClientAndStub(c)
+	_, err := client.CheckImport(coremigration.ModelInfo{}, nil)
+	c.Assert(err, jc.Satisfies, errors.IsNotSupported)
+	stub.CheckNoCalls(c)
+}
+
 func (s *ClientSuite) TestImport(c *gc.C) {
 	client, stub := s.getClientAndStub(c)
 
@@ -12,6 +12,7 @@ import (
 	"github.com/juju/juju/api/base"
 	"github.com/juju/juju/api/common"
 	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/core/migration"
 	"github.com/juju/juju/core/model"
 	"github.com/juju/juju/environs/config"
 	"github.com/juju/juju/instance"
@@ -340,6 +341,31 @@ func (c *Client) DumpModel(model names.ModelTag, simplified bool) (map[string]in
 	return asMap, nil
 }
 
+// ExportModel returns the serialized description of the model, along
+// with details of the charms, agent binaries and resources it uses.
+// The model is marked as being migrated, so that it can no longer be
+// changed.
+func (c *Client) ExportModel(model names.ModelTag) (migration.SerializedModel, error) {
+	if c.BestAPIVersion() < 5 {
+		return migration.SerializedModel{}, errors.NotSupportedf("exporting models on this controller")
+	}
+	var results params.SerializedModelResults
+	args := params.Entities{
+		Entities: []params.Entity{{Tag: model.String()}},
+	}
+	if err := c.facade.FacadeCall("ExportModels", args, &results); err != nil {
+		return migration.SerializedModel{}, errors.Trace(err)
+	}
+	if count := len(results.Results); count != 1 {
+		return migration.SerializedModel{}, errors.Errorf("unexpected result count: %d", count)
+	}
+	result := results.Results[0]
+	if result.Error != nil {
+		return migration.SerializedModel{}, result.Error
+	}
+	return common.ConvertSerializedModel(result.Result)
+}
+
 func (c *Client) dumpModelV2(model names.ModelTag) (map[string]interface{}, error) {
 	var results params.MapResults
 	entities := params.Entities{
@@ -10,6 +10,7 @@ import (
 	"github.com/juju/errors"
 	"github.com/juju/testing"
 	jc "github.com/juju/testing/checkers"
+	"github.com/juju/version"
 	gc "gopkg.in/check.v1"
 	"gopkg.in/juju/names.v2"
 
@@ -639,6 +640,72 @@ func (s *dumpModelSuite) TestDumpModelErrorV2(c *gc.C) {
 	c.Assert(out, gc.IsNil)
 }
 
+func (s *dumpModelSuite) TestExportModel(c *gc.C) {
+	results := params.SerializedModelResults{Results: []params.SerializedModelResult{{
+		Result: params.SerializedModel{
+			Bytes:  []byte("model-uuid: some-uuid\n"),
+			Charms: []string{"cs:xenial/mysql-1"},
+			Tools: []params.SerializedModelTools{{
+				Version: "2.4.0-xenial-amd64",
+				URI:     "/tools/2.4.0-xenial-amd64",
+			}},
+		},
+	}}}
+	apiCaller := basetesting.BestVersionCaller{
+		BestVersion: 5,
+		APICallerFunc: basetesting.APICallerFunc(
+			func(objType string, version int, id, request string, args, result interface{}) error {
+				c.Check(objType, gc.Equals, "ModelManager")
+				c.Check(request, gc.Equals, "ExportModels")
+				c.Check(version, gc.Equals, 5)
+				c.Assert(args, gc.DeepEquals, params.Entities{[]params.Entity{{coretesting.ModelTag.String()}}})
+				res, ok := result.(*params.SerializedModelResults)
+				c.Assert(ok, jc.IsTrue)
+				*res = results
+				return nil
+			}),
+	}
+	client := modelmanager.NewClient(apiCaller)
+	out, err := client.ExportModel(coretesting.ModelTag)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(string(out.Bytes), gc.Equals, "model-uuid: some-uuid\n")
+	c.Assert(out.Charms, jc.DeepEquals, []string{"cs:xenial/mysql-1"})
+	c.Assert(out.Tools, jc.DeepEquals, map[version.Binary]string{
+		version.MustParseBinary("2.4.0-xenial-amd64"): "/tools/2.4.0-xenial-amd64",
+	})
+}
+
+func (s *dumpModelSuite) TestExportModelError(c *gc.C) {
+	results := params.SerializedModelResults{Results: []params.SerializedModelResult{{
+		Error: &params.Error{Message: "fake error"},
+	}}}
+	apiCaller := basetesting.BestVersionCaller{
+		BestVersion: 5,
+		APICallerFunc: basetesting.APICallerFunc(
+			func(objType string, version int, id, request string, args, result interface{}) error {
+				*(result.(*params.SerializedModelResults)) = results
+				return nil
+			}),
+	}
+	client := modelmanager.NewClient(apiCaller)
+	_, err := client.ExportModel(coretesting.ModelTag)
+	c.Assert(err, gc.ErrorMatches, "fake error")
+}
+
+func (s *dumpModelSuite) TestExportModelNotSupported(c *gc.C) {
+	apiCaller := basetesting.BestVersionCaller{
+		BestVersion: 4,
+		APICallerFunc: basetesting.APICallerFunc(
+			func(objType string, version int, id, request string, args, result interface{}) error {
+				c.Fatalf("unexpected API call")
+				return nil
+			}),
+	}
+	client := modelmanager.NewClient(apiCaller)
+	_, err := client.ExportModel(coretesting.ModelTag)
+	c.Assert(err, jc.Satisfies, errors.IsNotSupported)
+}
+
 func (s *dumpModelSuite) TestDumpModelDB(c *gc.C) {
 	expected := map[string]interface{}{
 		"models": []map[string]interface{}{{
@@ -0,0 +1,43 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package relationdata
+
+import (
+	"github.com/juju/errors"
+	"gopkg.in/juju/names.v2"
+
+	"github.com/juju/juju/api/base"
+	"github.com/juju/juju/apiserver/params"
+)
+
+// Client allows access to the relation data API end point.
+type Client struct {
+	base.ClientFacade
+	facade base.FacadeCaller
+}
+
+// NewClient creates a new client for accessing the relation data API.
+func NewClient(st base.APICallCloser) *Client {
+	frontend, backend := base.NewClientFacade(st, "RelationData")
+	return &Client{ClientFacade: frontend, facade: backend}
+}
+
+// RelationData returns the settings of the units in each relation of
+// the named endpoint of the specified application or unit.
+func (c *Client) RelationData(entity names.Tag, endpoint string) ([]params.RelationData, error) {
+	args := params.RelationDataArgs{
+		Args: []params.RelationDataArg{{Entity: entity.String(), Endpoint: endpoint}},
+	}
+	var results params.RelationDataResults
+	if err := c.facade.FacadeCall("RelationData", args, &results); err != nil {
+		return nil, errors.Trace(err)
+	}
+	if len(results.Results) != 1 {
+		return nil, errors.Errorf("expected 1 result, got %d", len(results.Results))
+	}
+	if err := results.Results[0].Error; err != nil {
+		return nil, errors.Trace(err)
+	}
+	return results.Results[0].Relations, nil
+}
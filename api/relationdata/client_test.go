@@ -0,0 +1,93 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package relationdata_test
+
+import (
+	"github.com/juju/errors"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+	"gopkg.in/juju/names.v2"
+
+	basetesting "github.com/juju/juju/api/base/testing"
+	"github.com/juju/juju/api/relationdata"
+	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/testing"
+)
+
+type ClientSuite struct {
+	testing.BaseSuite
+}
+
+var _ = gc.Suite(&ClientSuite{})
+
+func (s *ClientSuite) TestRelationData(c *gc.C) {
+	data := []params.RelationData{{
+		RelationId:      1,
+		Key:             "wordpress:db mysql:server",
+		Endpoint:        "wordpress:db",
+		RelatedEndpoint: "mysql:server",
+		Units: []params.RelationUnitData{{
+			Unit:     "mysql/0",
+			Settings: map[string]interface{}{"user": "wordpress"},
+		}},
+	}}
+	apiCaller := basetesting.APICallerFunc(
+		func(objType string,
+			version int,
+			id, request string,
+			a, result interface{},
+		) error {
+			c.Check(objType, gc.Equals, "RelationData")
+			c.Check(id, gc.Equals, "")
+			c.Check(request, gc.Equals, "RelationData")
+			c.Check(a, jc.DeepEquals, params.RelationDataArgs{
+				Args: []params.RelationDataArg{{Entity: "application-wordpress", Endpoint: "db"}},
+			})
+			c.Assert(result, gc.FitsTypeOf, &params.RelationDataResults{})
+			*(result.(*params.RelationDataResults)) = params.RelationDataResults{
+				Results: []params.RelationDataResult{{Relations: data}},
+			}
+			return nil
+		})
+
+	client := relationdata.NewClient(apiCaller)
+	relations, err := client.RelationData(names.NewApplicationTag("wordpress"), "db")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(relations, jc.DeepEquals, data)
+}
+
+func (s *ClientSuite) TestRelationDataError(c *gc.C) {
+	apiCaller := basetesting.APICallerFunc(
+		func(objType string,
+			version int,
+			id, request string,
+			a, result interface{},
+		) error {
+			*(result.(*params.RelationDataResults)) = params.RelationDataResults{
+				Results: []params.RelationDataResult{{
+					Error: &params.Error{Message: `unit "wordpress/9" not found`, Code: params.CodeNotFound},
+				}},
+			}
+			return nil
+		})
+
+	client := relationdata.NewClient(apiCaller)
+	_, err := client.RelationData(names.NewUnitTag("wordpress/9"), "db")
+	c.Assert(err, gc.ErrorMatches, `unit "wordpress/9" not found`)
+}
+
+func (s *ClientSuite) TestRelationDataFacadeCallError(c *gc.C) {
+	apiCaller := basetesting.APICallerFunc(
+		func(objType string,
+			version int,
+			id, request string,
+			a, result interface{},
+		) error {
+			return errors.New("boom")
+		})
+
+	client := relationdata.NewClient(apiCaller)
+	_, err := client.RelationData(names.NewApplicationTag("wordpress"), "db")
+	c.Assert(err, gc.ErrorMatches, "boom")
+}
@@ -0,0 +1,35 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package remoterelationdiagnostics
+
+import (
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/api/base"
+	"github.com/juju/juju/apiserver/params"
+)
+
+// Client allows access to the remote relation diagnostics API end point.
+type Client struct {
+	base.ClientFacade
+	facade base.FacadeCaller
+}
+
+// NewClient creates a new client for accessing the
+// remote relation diagnostics API.
+func NewClient(st base.APICallCloser) *Client {
+	frontend, backend := base.NewClientFacade(st, "RemoteRelationDiagnostics")
+	return &Client{ClientFacade: frontend, facade: backend}
+}
+
+// RemoteRelations returns diagnostics for the relations of the specified
+// remote applications, or for all remote applications if none are given.
+func (c *Client) RemoteRelations(applicationNames ...string) ([]params.RemoteRelationDiagnosticsResult, error) {
+	args := params.RemoteRelationDiagnosticsArgs{ApplicationNames: applicationNames}
+	var results params.RemoteRelationDiagnosticsResults
+	if err := c.facade.FacadeCall("RemoteRelations", args, &results); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return results.Results, nil
+}
@@ -0,0 +1,66 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package remoterelationdiagnostics_test
+
+import (
+	"github.com/juju/errors"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	basetesting "github.com/juju/juju/api/base/testing"
+	"github.com/juju/juju/api/remoterelationdiagnostics"
+	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/testing"
+)
+
+type ClientSuite struct {
+	testing.BaseSuite
+}
+
+var _ = gc.Suite(&ClientSuite{})
+
+func (s *ClientSuite) TestRemoteRelations(c *gc.C) {
+	apiCaller := basetesting.APICallerFunc(
+		func(objType string,
+			version int,
+			id, request string,
+			a, result interface{},
+		) error {
+			c.Check(objType, gc.Equals, "RemoteRelationDiagnostics")
+			c.Check(id, gc.Equals, "")
+			c.Check(request, gc.Equals, "RemoteRelations")
+			c.Check(a, jc.DeepEquals, params.RemoteRelationDiagnosticsArgs{
+				ApplicationNames: []string{"mysql"},
+			})
+			c.Assert(result, gc.FitsTypeOf, &params.RemoteRelationDiagnosticsResults{})
+			*(result.(*params.RemoteRelationDiagnosticsResults)) = params.RemoteRelationDiagnosticsResults{
+				Results: []params.RemoteRelationDiagnosticsResult{{
+					Result: &params.RemoteRelationDiagnostics{RelationKey: "wordpress:db mysql:server"},
+				}},
+			}
+			return nil
+		})
+
+	client := remoterelationdiagnostics.NewClient(apiCaller)
+	results, err := client.RemoteRelations("mysql")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, jc.DeepEquals, []params.RemoteRelationDiagnosticsResult{{
+		Result: &params.RemoteRelationDiagnostics{RelationKey: "wordpress:db mysql:server"},
+	}})
+}
+
+func (s *ClientSuite) TestRemoteRelationsFacadeCallError(c *gc.C) {
+	apiCaller := basetesting.APICallerFunc(
+		func(objType string,
+			version int,
+			id, request string,
+			a, result interface{},
+		) error {
+			return errors.New("facade failure")
+		})
+
+	client := remoterelationdiagnostics.NewClient(apiCaller)
+	_, err := client.RemoteRelations()
+	c.Assert(err, gc.ErrorMatches, "facade failure")
+}
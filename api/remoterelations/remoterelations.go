@@ -124,6 +124,21 @@ func (c *Client) RelationUnitSettings(relationUnits []params.RelationUnit) ([]pa
 	return results.Results, nil
 }
 
+// RelationApplicationSettings returns the settings published by the given
+// applications in relations in the local model.
+func (c *Client) RelationApplicationSettings(relationApplications []params.RelationApplication) ([]params.SettingsResult, error) {
+	args := params.RelationApplications{relationApplications}
+	var results params.SettingsResults
+	err := c.facade.FacadeCall("RelationApplicationSettings", args, &results)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if len(results.Results) != len(relationApplications) {
+		return nil, errors.Errorf("expected %d result(s), got %d", len(relationApplications), len(results.Results))
+	}
+	return results.Results, nil
+}
+
 // Relations returns information about the cross-model relations with the specified keys
 // in the local model.
 func (c *Client) Relations(keys []string) ([]params.RemoteRelationResult, error) {
@@ -295,3 +310,17 @@ func (c *Client) SetRemoteApplicationStatus(applicationName string, status statu
 	}
 	return results.OneError()
 }
+
+// RecordRemoteRelationEvent records an event exchanged with the remote
+// side of a relation, for use when diagnosing cross model relations.
+func (c *Client) RecordRemoteRelationEvent(event params.RemoteRelationEventArg) error {
+	args := params.RemoteRelationEventArgs{
+		Args: []params.RemoteRelationEventArg{event},
+	}
+	var results params.ErrorResults
+	err := c.facade.FacadeCall("RecordRemoteRelationEvents", args, &results)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	return results.OneError()
+}
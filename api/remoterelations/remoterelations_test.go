@@ -183,6 +183,31 @@ func (s *remoteRelationsSuite) TestRelationUnitSettingsResultsCount(c *gc.C) {
 	c.Check(err, gc.ErrorMatches, `expected 1 result\(s\), got 2`)
 }
 
+func (s *remoteRelationsSuite) TestRelationApplicationSettings(c *gc.C) {
+	var callCount int
+	apiCaller := testing.APICallerFunc(func(objType string, version int, id, request string, arg, result interface{}) error {
+		c.Check(objType, gc.Equals, "RemoteRelations")
+		c.Check(version, gc.Equals, 0)
+		c.Check(id, gc.Equals, "")
+		c.Check(request, gc.Equals, "RelationApplicationSettings")
+		c.Check(arg, gc.DeepEquals, params.RelationApplications{
+			RelationApplications: []params.RelationApplication{{Relation: "r", Application: "a"}}})
+		c.Assert(result, gc.FitsTypeOf, &params.SettingsResults{})
+		*(result.(*params.SettingsResults)) = params.SettingsResults{
+			Results: []params.SettingsResult{{
+				Settings: params.Settings{"foo": "bar"},
+			}},
+		}
+		callCount++
+		return nil
+	})
+	client := remoterelations.NewClient(apiCaller)
+	result, err := client.RelationApplicationSettings([]params.RelationApplication{{Relation: "r", Application: "a"}})
+	c.Check(err, jc.ErrorIsNil)
+	c.Check(result, jc.DeepEquals, []params.SettingsResult{{Settings: params.Settings{"foo": "bar"}}})
+	c.Check(callCount, gc.Equals, 1)
+}
+
 func (s *remoteRelationsSuite) TestRelations(c *gc.C) {
 	var callCount int
 	apiCaller := testing.APICallerFunc(func(objType string, version int, id, request string, arg, result interface{}) error {
@@ -463,3 +488,34 @@ func (s *remoteRelationsSuite) TestSetRemoteApplicationStatus(c *gc.C) {
 	c.Check(err, gc.ErrorMatches, "FAIL")
 	c.Check(callCount, gc.Equals, 1)
 }
+
+func (s *remoteRelationsSuite) TestRecordRemoteRelationEvent(c *gc.C) {
+	event := params.RemoteRelationEventArg{
+		RelationToken: "token",
+		Direction:     params.RemoteRelationEventSent,
+		ChangedUnits:  1,
+		Error:         "boom",
+	}
+	var callCount int
+	apiCaller := testing.APICallerFunc(func(objType string, version int, id, request string, arg, result interface{}) error {
+		c.Check(objType, gc.Equals, "RemoteRelations")
+		c.Check(version, gc.Equals, 0)
+		c.Check(id, gc.Equals, "")
+		c.Check(request, gc.Equals, "RecordRemoteRelationEvents")
+		c.Assert(arg, gc.DeepEquals, params.RemoteRelationEventArgs{
+			Args: []params.RemoteRelationEventArg{event},
+		})
+		c.Assert(result, gc.FitsTypeOf, &params.ErrorResults{})
+		*(result.(*params.ErrorResults)) = params.ErrorResults{
+			Results: []params.ErrorResult{{
+				Error: &params.Error{Message: "FAIL"},
+			}},
+		}
+		callCount++
+		return nil
+	})
+	client := remoterelations.NewClient(apiCaller)
+	err := client.RecordRemoteRelationEvent(event)
+	c.Check(err, gc.ErrorMatches, "FAIL")
+	c.Check(callCount, gc.Equals, 1)
+}
@@ -257,6 +257,105 @@ func (c *Client) Detach(storageIds []string) ([]params.ErrorResult, error) {
 	return results.Results, nil
 }
 
+// Resize requests that the specified storage instance be grown to
+// the specified size in MiB.
+func (c *Client) Resize(storageId string, size uint64) error {
+	if c.BestAPIVersion() < 5 {
+		return errors.NotSupportedf("resizing storage on this juju controller")
+	}
+	if !names.IsValidStorage(storageId) {
+		return errors.NotValidf("storage ID %q", storageId)
+	}
+	var results params.ErrorResults
+	args := params.ResizeStorage{
+		[]params.ResizeStorageParams{{
+			StorageTag: names.NewStorageTag(storageId).String(),
+			Size:       size,
+		}},
+	}
+	if err := c.facade.FacadeCall("ResizeStorage", args, &results); err != nil {
+		return errors.Trace(err)
+	}
+	return results.OneError()
+}
+
+// CreateSnapshots requests snapshots of the volumes backing the
+// specified storage instances. The result for each storage instance
+// holds the ID of the new volume snapshot.
+func (c *Client) CreateSnapshots(storageIds []string) ([]params.StringResult, error) {
+	if c.BestAPIVersion() < 6 {
+		return nil, errors.NotSupportedf("snapshotting storage on this juju controller")
+	}
+	entities := make([]params.Entity, len(storageIds))
+	for i, id := range storageIds {
+		if !names.IsValidStorage(id) {
+			return nil, errors.NotValidf("storage ID %q", id)
+		}
+		entities[i].Tag = names.NewStorageTag(id).String()
+	}
+	var results params.StringResults
+	if err := c.facade.FacadeCall("CreateSnapshots", params.Entities{entities}, &results); err != nil {
+		return nil, errors.Trace(err)
+	}
+	if len(results.Results) != len(storageIds) {
+		return nil, errors.Errorf(
+			"expected %d result(s), got %d",
+			len(storageIds), len(results.Results),
+		)
+	}
+	return results.Results, nil
+}
+
+// ListSnapshots lists the volume snapshots of the specified storage
+// instances. If no storage IDs are specified, all volume snapshots
+// in the model are listed.
+func (c *Client) ListSnapshots(storageIds []string) ([]params.VolumeSnapshotDetails, error) {
+	if c.BestAPIVersion() < 6 {
+		return nil, errors.NotSupportedf("listing storage snapshots on this juju controller")
+	}
+	var filter params.VolumeSnapshotFilter
+	for _, id := range storageIds {
+		if !names.IsValidStorage(id) {
+			return nil, errors.NotValidf("storage ID %q", id)
+		}
+		filter.StorageTags = append(filter.StorageTags, names.NewStorageTag(id).String())
+	}
+	var results params.VolumeSnapshotDetailsListResults
+	args := params.VolumeSnapshotFilters{[]params.VolumeSnapshotFilter{filter}}
+	if err := c.facade.FacadeCall("ListSnapshots", args, &results); err != nil {
+		return nil, errors.Trace(err)
+	}
+	if len(results.Results) != 1 {
+		return nil, errors.Errorf("expected 1 result, got %d", len(results.Results))
+	}
+	if err := results.Results[0].Error; err != nil {
+		return nil, errors.Trace(err)
+	}
+	return results.Results[0].Result, nil
+}
+
+// Restore requests that the volume backing the specified storage
+// instance be restored from the specified volume snapshot.
+func (c *Client) Restore(storageId, snapshotId string) error {
+	if c.BestAPIVersion() < 6 {
+		return errors.NotSupportedf("restoring storage on this juju controller")
+	}
+	if !names.IsValidStorage(storageId) {
+		return errors.NotValidf("storage ID %q", storageId)
+	}
+	var results params.ErrorResults
+	args := params.RestoreStorage{
+		[]params.RestoreStorageParams{{
+			StorageTag: names.NewStorageTag(storageId).String(),
+			SnapshotId: snapshotId,
+		}},
+	}
+	if err := c.facade.FacadeCall("RestoreStorage", args, &results); err != nil {
+		return errors.Trace(err)
+	}
+	return results.OneError()
+}
+
 // Import imports storage into the model.
 func (c *Client) Import(
 	kind storage.StorageKind,
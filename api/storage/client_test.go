@@ -5,6 +5,7 @@ package storage_test
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/juju/collections/set"
 	"github.com/juju/errors"
@@ -622,6 +623,191 @@ func (s *storageMockSuite) TestRemove(c *gc.C) {
 	c.Assert(results[1].Error, jc.DeepEquals, &params.Error{Message: "baz"})
 }
 
+func (s *storageMockSuite) TestResize(c *gc.C) {
+	apiCaller := basetesting.BestVersionCaller{
+		APICallerFunc: basetesting.APICallerFunc(
+			func(objType string,
+				version int,
+				id, request string,
+				a, result interface{},
+			) error {
+				c.Check(objType, gc.Equals, "Storage")
+				c.Check(id, gc.Equals, "")
+				c.Check(request, gc.Equals, "ResizeStorage")
+				c.Check(a, jc.DeepEquals, params.ResizeStorage{[]params.ResizeStorageParams{
+					{StorageTag: "storage-foo-0", Size: 2048},
+				}})
+				c.Assert(result, gc.FitsTypeOf, &params.ErrorResults{})
+				results := result.(*params.ErrorResults)
+				results.Results = []params.ErrorResult{
+					{Error: &params.Error{Message: "baz"}},
+				}
+				return nil
+			},
+		),
+		BestVersion: 5,
+	}
+	client := storage.NewClient(apiCaller)
+	err := client.Resize("foo/0", 2048)
+	c.Check(err, gc.ErrorMatches, "baz")
+}
+
+func (s *storageMockSuite) TestResizeNotSupported(c *gc.C) {
+	apiCaller := basetesting.BestVersionCaller{
+		APICallerFunc: basetesting.APICallerFunc(
+			func(objType string, version int, id, request string, a, result interface{}) error {
+				c.Fatalf("unexpected call to %s", request)
+				return nil
+			},
+		),
+		BestVersion: 4,
+	}
+	client := storage.NewClient(apiCaller)
+	err := client.Resize("foo/0", 2048)
+	c.Check(err, gc.ErrorMatches, "resizing storage on this juju controller not supported")
+}
+
+func (s *storageMockSuite) TestCreateSnapshots(c *gc.C) {
+	apiCaller := basetesting.BestVersionCaller{
+		APICallerFunc: basetesting.APICallerFunc(
+			func(objType string,
+				version int,
+				id, request string,
+				a, result interface{},
+			) error {
+				c.Check(objType, gc.Equals, "Storage")
+				c.Check(id, gc.Equals, "")
+				c.Check(request, gc.Equals, "CreateSnapshots")
+				c.Check(a, jc.DeepEquals, params.Entities{[]params.Entity{
+					{Tag: "storage-foo-0"},
+					{Tag: "storage-foo-1"},
+				}})
+				c.Assert(result, gc.FitsTypeOf, &params.StringResults{})
+				results := result.(*params.StringResults)
+				results.Results = []params.StringResult{
+					{Result: "0"},
+					{Error: &params.Error{Message: "baz"}},
+				}
+				return nil
+			},
+		),
+		BestVersion: 6,
+	}
+	client := storage.NewClient(apiCaller)
+	results, err := client.CreateSnapshots([]string{"foo/0", "foo/1"})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, jc.DeepEquals, []params.StringResult{
+		{Result: "0"},
+		{Error: &params.Error{Message: "baz"}},
+	})
+}
+
+func (s *storageMockSuite) TestCreateSnapshotsNotSupported(c *gc.C) {
+	apiCaller := basetesting.BestVersionCaller{
+		APICallerFunc: basetesting.APICallerFunc(
+			func(objType string, version int, id, request string, a, result interface{}) error {
+				c.Fatalf("unexpected call to %s", request)
+				return nil
+			},
+		),
+		BestVersion: 5,
+	}
+	client := storage.NewClient(apiCaller)
+	_, err := client.CreateSnapshots([]string{"foo/0"})
+	c.Check(err, gc.ErrorMatches, "snapshotting storage on this juju controller not supported")
+}
+
+func (s *storageMockSuite) TestListSnapshots(c *gc.C) {
+	created := time.Date(2018, 1, 2, 3, 4, 5, 0, time.UTC)
+	apiCaller := basetesting.BestVersionCaller{
+		APICallerFunc: basetesting.APICallerFunc(
+			func(objType string,
+				version int,
+				id, request string,
+				a, result interface{},
+			) error {
+				c.Check(objType, gc.Equals, "Storage")
+				c.Check(id, gc.Equals, "")
+				c.Check(request, gc.Equals, "ListSnapshots")
+				c.Check(a, jc.DeepEquals, params.VolumeSnapshotFilters{[]params.VolumeSnapshotFilter{{
+					StorageTags: []string{"storage-foo-0"},
+				}}})
+				c.Assert(result, gc.FitsTypeOf, &params.VolumeSnapshotDetailsListResults{})
+				results := result.(*params.VolumeSnapshotDetailsListResults)
+				results.Results = []params.VolumeSnapshotDetailsListResult{{
+					Result: []params.VolumeSnapshotDetails{{
+						Id:         "0",
+						VolumeTag:  "volume-0",
+						StorageTag: "storage-foo-0",
+						Pool:       "loop",
+						Created:    created,
+						SnapshotId: "snap-0",
+						Size:       1024,
+					}},
+				}}
+				return nil
+			},
+		),
+		BestVersion: 6,
+	}
+	client := storage.NewClient(apiCaller)
+	snapshots, err := client.ListSnapshots([]string{"foo/0"})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(snapshots, jc.DeepEquals, []params.VolumeSnapshotDetails{{
+		Id:         "0",
+		VolumeTag:  "volume-0",
+		StorageTag: "storage-foo-0",
+		Pool:       "loop",
+		Created:    created,
+		SnapshotId: "snap-0",
+		Size:       1024,
+	}})
+}
+
+func (s *storageMockSuite) TestRestore(c *gc.C) {
+	apiCaller := basetesting.BestVersionCaller{
+		APICallerFunc: basetesting.APICallerFunc(
+			func(objType string,
+				version int,
+				id, request string,
+				a, result interface{},
+			) error {
+				c.Check(objType, gc.Equals, "Storage")
+				c.Check(id, gc.Equals, "")
+				c.Check(request, gc.Equals, "RestoreStorage")
+				c.Check(a, jc.DeepEquals, params.RestoreStorage{[]params.RestoreStorageParams{
+					{StorageTag: "storage-foo-0", SnapshotId: "3"},
+				}})
+				c.Assert(result, gc.FitsTypeOf, &params.ErrorResults{})
+				results := result.(*params.ErrorResults)
+				results.Results = []params.ErrorResult{
+					{Error: &params.Error{Message: "baz"}},
+				}
+				return nil
+			},
+		),
+		BestVersion: 6,
+	}
+	client := storage.NewClient(apiCaller)
+	err := client.Restore("foo/0", "3")
+	c.Check(err, gc.ErrorMatches, "baz")
+}
+
+func (s *storageMockSuite) TestRestoreNotSupported(c *gc.C) {
+	apiCaller := basetesting.BestVersionCaller{
+		APICallerFunc: basetesting.APICallerFunc(
+			func(objType string, version int, id, request string, a, result interface{}) error {
+				c.Fatalf("unexpected call to %s", request)
+				return nil
+			},
+		),
+		BestVersion: 5,
+	}
+	client := storage.NewClient(apiCaller)
+	err := client.Restore("foo/0", "3")
+	c.Check(err, gc.ErrorMatches, "restoring storage on this juju controller not supported")
+}
+
 func (s *storageMockSuite) TestRemoveDestroyAttachments(c *gc.C) {
 	apiCaller := basetesting.BestVersionCaller{
 		APICallerFunc: basetesting.APICallerFunc(
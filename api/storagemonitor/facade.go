@@ -0,0 +1,94 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package storagemonitor implements the client-side API facade used
+// by the storagemonitor worker.
+package storagemonitor
+
+import (
+	"github.com/juju/errors"
+	"gopkg.in/juju/names.v2"
+
+	"github.com/juju/juju/api/base"
+	"github.com/juju/juju/apiserver/params"
+)
+
+// Facade provides access to the StorageMonitor API facade.
+type Facade struct {
+	caller base.FacadeCaller
+	tag    names.MachineTag
+}
+
+// NewFacade creates a new client-side StorageMonitor facade for
+// the machine with the specified tag.
+func NewFacade(caller base.APICaller, tag names.MachineTag) *Facade {
+	return &Facade{
+		caller: base.NewFacadeCaller(caller, "StorageMonitor"),
+		tag:    tag,
+	}
+}
+
+// FilesystemAttachments returns the provisioned filesystem
+// attachments of the machine.
+func (f *Facade) FilesystemAttachments() ([]params.FilesystemAttachment, error) {
+	args := params.Entities{Entities: []params.Entity{{Tag: f.tag.String()}}}
+	var results params.FilesystemAttachmentsResults
+	if err := f.caller.FacadeCall("FilesystemAttachments", args, &results); err != nil {
+		return nil, errors.Trace(err)
+	}
+	if len(results.Results) != 1 {
+		return nil, errors.Errorf("expected 1 result, got %d", len(results.Results))
+	}
+	result := results.Results[0]
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return result.Attachments, nil
+}
+
+// SetFilesystemAttachmentUsage records the usage of filesystems
+// attached to the machine, returning an error result for each.
+func (f *Facade) SetFilesystemAttachmentUsage(usages []params.FilesystemAttachmentUsage) ([]params.ErrorResult, error) {
+	args := params.FilesystemAttachmentUsages{Usages: usages}
+	var results params.ErrorResults
+	if err := f.caller.FacadeCall("SetFilesystemAttachmentUsage", args, &results); err != nil {
+		return nil, errors.Trace(err)
+	}
+	if len(results.Results) != len(usages) {
+		return nil, errors.Errorf("expected %d results, got %d", len(usages), len(results.Results))
+	}
+	return results.Results, nil
+}
+
+// MountedVolumeAttachments returns the provisioned volume attachments
+// of the machine whose block devices have a filesystem mounted on them.
+func (f *Facade) MountedVolumeAttachments() ([]params.MountedVolumeAttachment, error) {
+	args := params.Entities{Entities: []params.Entity{{Tag: f.tag.String()}}}
+	var results params.MountedVolumeAttachmentsResults
+	if err := f.caller.FacadeCall("MountedVolumeAttachments", args, &results); err != nil {
+		return nil, errors.Trace(err)
+	}
+	if len(results.Results) != 1 {
+		return nil, errors.Errorf("expected 1 result, got %d", len(results.Results))
+	}
+	result := results.Results[0]
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return result.Attachments, nil
+}
+
+// SetVolumeAttachmentUsage records the usage of the filesystems mounted
+// on the block devices of volumes attached to the machine, returning an
+// error result for each.
+func (f *Facade) SetVolumeAttachmentUsage(usages []params.VolumeAttachmentUsage) ([]params.ErrorResult, error) {
+	args := params.VolumeAttachmentUsages{Usages: usages}
+	var results params.ErrorResults
+	if err := f.caller.FacadeCall("SetVolumeAttachmentUsage", args, &results); err != nil {
+		return nil, errors.Trace(err)
+	}
+	if len(results.Results) != len(usages) {
+		return nil, errors.Errorf("expected %d results, got %d", len(usages), len(results.Results))
+	}
+	return results.Results, nil
+}
@@ -0,0 +1,211 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package storagemonitor_test
+
+import (
+	"errors"
+	"time"
+
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+	"gopkg.in/juju/names.v2"
+
+	basetesting "github.com/juju/juju/api/base/testing"
+	"github.com/juju/juju/api/storagemonitor"
+	"github.com/juju/juju/apiserver/params"
+)
+
+type facadeSuite struct {
+	testing.IsolationSuite
+}
+
+var _ = gc.Suite(&facadeSuite{})
+
+func (s *facadeSuite) TestFilesystemAttachments(c *gc.C) {
+	stub := new(testing.Stub)
+	apiCaller := basetesting.APICallerFunc(func(
+		objType string, version int,
+		id, request string,
+		args, response interface{},
+	) error {
+		c.Check(objType, gc.Equals, "StorageMonitor")
+		c.Check(version, gc.Equals, 0)
+		c.Check(id, gc.Equals, "")
+		stub.AddCall(request, args)
+		*response.(*params.FilesystemAttachmentsResults) = params.FilesystemAttachmentsResults{
+			Results: []params.FilesystemAttachmentsResult{{
+				Attachments: []params.FilesystemAttachment{{
+					FilesystemTag: "filesystem-0",
+					MachineTag:    "machine-42",
+					Info:          params.FilesystemAttachmentInfo{MountPoint: "/srv"},
+				}},
+			}},
+		}
+		return nil
+	})
+	facade := storagemonitor.NewFacade(apiCaller, names.NewMachineTag("42"))
+
+	attachments, err := facade.FilesystemAttachments()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(attachments, jc.DeepEquals, []params.FilesystemAttachment{{
+		FilesystemTag: "filesystem-0",
+		MachineTag:    "machine-42",
+		Info:          params.FilesystemAttachmentInfo{MountPoint: "/srv"},
+	}})
+	stub.CheckCalls(c, []testing.StubCall{{
+		"FilesystemAttachments", []interface{}{params.Entities{
+			Entities: []params.Entity{{Tag: "machine-42"}},
+		}},
+	}})
+}
+
+func (s *facadeSuite) TestFilesystemAttachmentsInnerError(c *gc.C) {
+	apiCaller := basetesting.APICallerFunc(func(
+		objType string, version int,
+		id, request string,
+		args, response interface{},
+	) error {
+		*response.(*params.FilesystemAttachmentsResults) = params.FilesystemAttachmentsResults{
+			Results: []params.FilesystemAttachmentsResult{{
+				Error: &params.Error{Message: "blam"},
+			}},
+		}
+		return nil
+	})
+	facade := storagemonitor.NewFacade(apiCaller, names.NewMachineTag("42"))
+
+	_, err := facade.FilesystemAttachments()
+	c.Assert(err, gc.ErrorMatches, "blam")
+}
+
+func (s *facadeSuite) TestSetFilesystemAttachmentUsage(c *gc.C) {
+	stub := new(testing.Stub)
+	apiCaller := basetesting.APICallerFunc(func(
+		objType string, version int,
+		id, request string,
+		args, response interface{},
+	) error {
+		c.Check(objType, gc.Equals, "StorageMonitor")
+		stub.AddCall(request, args)
+		*response.(*params.ErrorResults) = params.ErrorResults{
+			Results: []params.ErrorResult{{
+				Error: &params.Error{Message: "blam"},
+			}},
+		}
+		return nil
+	})
+	facade := storagemonitor.NewFacade(apiCaller, names.NewMachineTag("42"))
+
+	usages := []params.FilesystemAttachmentUsage{{
+		FilesystemTag: "filesystem-0",
+		MachineTag:    "machine-42",
+		Usage: params.FilesystemUsage{
+			Size:      100,
+			Used:      10,
+			Available: 90,
+			Updated:   time.Unix(1000, 0).UTC(),
+		},
+	}}
+	results, err := facade.SetFilesystemAttachmentUsage(usages)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, gc.HasLen, 1)
+	c.Assert(results[0].Error, gc.ErrorMatches, "blam")
+	stub.CheckCalls(c, []testing.StubCall{{
+		"SetFilesystemAttachmentUsage", []interface{}{params.FilesystemAttachmentUsages{
+			Usages: usages,
+		}},
+	}})
+}
+
+func (s *facadeSuite) TestMountedVolumeAttachments(c *gc.C) {
+	stub := new(testing.Stub)
+	apiCaller := basetesting.APICallerFunc(func(
+		objType string, version int,
+		id, request string,
+		args, response interface{},
+	) error {
+		c.Check(objType, gc.Equals, "StorageMonitor")
+		stub.AddCall(request, args)
+		*response.(*params.MountedVolumeAttachmentsResults) = params.MountedVolumeAttachmentsResults{
+			Results: []params.MountedVolumeAttachmentsResult{{
+				Attachments: []params.MountedVolumeAttachment{{
+					VolumeTag:  "volume-0",
+					MachineTag: "machine-42",
+					MountPoint: "/srv",
+				}},
+			}},
+		}
+		return nil
+	})
+	facade := storagemonitor.NewFacade(apiCaller, names.NewMachineTag("42"))
+
+	attachments, err := facade.MountedVolumeAttachments()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(attachments, jc.DeepEquals, []params.MountedVolumeAttachment{{
+		VolumeTag:  "volume-0",
+		MachineTag: "machine-42",
+		MountPoint: "/srv",
+	}})
+	stub.CheckCalls(c, []testing.StubCall{{
+		"MountedVolumeAttachments", []interface{}{params.Entities{
+			Entities: []params.Entity{{Tag: "machine-42"}},
+		}},
+	}})
+}
+
+func (s *facadeSuite) TestSetVolumeAttachmentUsage(c *gc.C) {
+	stub := new(testing.Stub)
+	apiCaller := basetesting.APICallerFunc(func(
+		objType string, version int,
+		id, request string,
+		args, response interface{},
+	) error {
+		c.Check(objType, gc.Equals, "StorageMonitor")
+		stub.AddCall(request, args)
+		*response.(*params.ErrorResults) = params.ErrorResults{
+			Results: []params.ErrorResult{{}},
+		}
+		return nil
+	})
+	facade := storagemonitor.NewFacade(apiCaller, names.NewMachineTag("42"))
+
+	usages := []params.VolumeAttachmentUsage{{
+		VolumeTag:  "volume-0",
+		MachineTag: "machine-42",
+		Usage: params.FilesystemUsage{
+			Size:      100,
+			Used:      10,
+			Available: 90,
+		},
+	}}
+	results, err := facade.SetVolumeAttachmentUsage(usages)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, jc.DeepEquals, []params.ErrorResult{{}})
+	stub.CheckCalls(c, []testing.StubCall{{
+		"SetVolumeAttachmentUsage", []interface{}{params.VolumeAttachmentUsages{
+			Usages: usages,
+		}},
+	}})
+}
+
+func (s *facadeSuite) TestCallError(c *gc.C) {
+	apiCaller := basetesting.APICallerFunc(func(
+		objType string, version int,
+		id, request string,
+		args, response interface{},
+	) error {
+		return errors.New("blam")
+	})
+	facade := storagemonitor.NewFacade(apiCaller, names.NewMachineTag("42"))
+
+	_, err := facade.FilesystemAttachments()
+	c.Assert(err, gc.ErrorMatches, "blam")
+	_, err = facade.SetFilesystemAttachmentUsage(nil)
+	c.Assert(err, gc.ErrorMatches, "blam")
+	_, err = facade.MountedVolumeAttachments()
+	c.Assert(err, gc.ErrorMatches, "blam")
+	_, err = facade.SetVolumeAttachmentUsage(nil)
+	c.Assert(err, gc.ErrorMatches, "blam")
+}
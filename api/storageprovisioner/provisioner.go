@@ -81,6 +81,20 @@ func (st *State) WatchVolumes() (watcher.StringsWatcher, error) {
 	return st.watchStorageEntities("WatchVolumes")
 }
 
+// WatchVolumeResizes watches for changes to volumes scoped to the
+// entity with the tag passed to NewState, including requests to
+// resize them.
+func (st *State) WatchVolumeResizes() (watcher.StringsWatcher, error) {
+	return st.watchStorageEntities("WatchVolumeResizes")
+}
+
+// WatchVolumeSnapshots watches for changes to snapshots of volumes
+// scoped to the entity with the tag passed to NewState. The watcher
+// reports the IDs of the snapshots.
+func (st *State) WatchVolumeSnapshots() (watcher.StringsWatcher, error) {
+	return st.watchStorageEntities("WatchVolumeSnapshots")
+}
+
 // WatchVolumes watches for lifecycle changes to volumes scoped to the
 // entity with the tag passed to NewState.
 func (st *State) WatchFilesystems() (watcher.StringsWatcher, error) {
@@ -263,6 +277,41 @@ func (st *State) RemoveVolumeParams(tags []names.VolumeTag) ([]params.RemoveVolu
 	return results.Results, nil
 }
 
+// VolumeResizeParams returns the parameters for growing the volumes
+// with the specified tags.
+func (st *State) VolumeResizeParams(tags []names.VolumeTag) ([]params.VolumeResizeParamsResult, error) {
+	args := params.Entities{
+		Entities: make([]params.Entity, len(tags)),
+	}
+	for i, tag := range tags {
+		args.Entities[i].Tag = tag.String()
+	}
+	var results params.VolumeResizeParamsResults
+	err := st.facade.FacadeCall("VolumeResizeParams", args, &results)
+	if err != nil {
+		return nil, err
+	}
+	if len(results.Results) != len(tags) {
+		return nil, errors.Errorf("expected %d result(s), got %d", len(tags), len(results.Results))
+	}
+	return results.Results, nil
+}
+
+// VolumeSnapshotParams returns the parameters for taking or restoring
+// the volume snapshots with the specified IDs.
+func (st *State) VolumeSnapshotParams(ids []string) ([]params.VolumeSnapshotParamsResult, error) {
+	args := params.VolumeSnapshotIds{Ids: ids}
+	var results params.VolumeSnapshotParamsResults
+	err := st.facade.FacadeCall("VolumeSnapshotParams", args, &results)
+	if err != nil {
+		return nil, err
+	}
+	if len(results.Results) != len(ids) {
+		return nil, errors.Errorf("expected %d result(s), got %d", len(ids), len(results.Results))
+	}
+	return results.Results, nil
+}
+
 // FilesystemParams returns the parameters for creating the filesystems
 // with the specified tags.
 func (st *State) FilesystemParams(tags []names.FilesystemTag) ([]params.FilesystemParamsResult, error) {
@@ -347,6 +396,50 @@ func (st *State) SetVolumeInfo(volumes []params.Volume) ([]params.ErrorResult, e
 	return results.Results, nil
 }
 
+// SetVolumeSnapshotInfo records the details of newly taken volume snapshots.
+func (st *State) SetVolumeSnapshotInfo(snapshots []params.VolumeSnapshot) ([]params.ErrorResult, error) {
+	args := params.VolumeSnapshots{Snapshots: snapshots}
+	var results params.ErrorResults
+	err := st.facade.FacadeCall("SetVolumeSnapshotInfo", args, &results)
+	if err != nil {
+		return nil, err
+	}
+	if len(results.Results) != len(snapshots) {
+		return nil, errors.Errorf("expected %d result(s), got %d", len(snapshots), len(results.Results))
+	}
+	return results.Results, nil
+}
+
+// SetVolumeSnapshotMessages records messages against volume snapshots,
+// describing why they could not be taken or restored.
+func (st *State) SetVolumeSnapshotMessages(messages []params.VolumeSnapshotMessage) ([]params.ErrorResult, error) {
+	args := params.VolumeSnapshotMessages{Messages: messages}
+	var results params.ErrorResults
+	err := st.facade.FacadeCall("SetVolumeSnapshotMessages", args, &results)
+	if err != nil {
+		return nil, err
+	}
+	if len(results.Results) != len(messages) {
+		return nil, errors.Errorf("expected %d result(s), got %d", len(messages), len(results.Results))
+	}
+	return results.Results, nil
+}
+
+// SetVolumeSnapshotsRestored records that volumes have been restored
+// from the volume snapshots with the specified IDs.
+func (st *State) SetVolumeSnapshotsRestored(ids []string) ([]params.ErrorResult, error) {
+	args := params.VolumeSnapshotIds{Ids: ids}
+	var results params.ErrorResults
+	err := st.facade.FacadeCall("SetVolumeSnapshotsRestored", args, &results)
+	if err != nil {
+		return nil, err
+	}
+	if len(results.Results) != len(ids) {
+		return nil, errors.Errorf("expected %d result(s), got %d", len(ids), len(results.Results))
+	}
+	return results.Results, nil
+}
+
 // SetFilesystemInfo records the details of newly provisioned filesystems.
 func (st *State) SetFilesystemInfo(filesystems []params.Filesystem) ([]params.ErrorResult, error) {
 	args := params.Filesystems{Filesystems: filesystems}
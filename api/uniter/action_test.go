@@ -4,6 +4,8 @@
 package uniter_test
 
 import (
+	"time"
+
 	jc "github.com/juju/testing/checkers"
 	gc "gopkg.in/check.v1"
 	"gopkg.in/juju/names.v2"
@@ -30,6 +32,13 @@ func (s *actionSuite) TestAction(c *gc.C) {
 			Name:       "fakeaction",
 			Parameters: basicParams,
 		},
+	}, {
+		description: "An Action with a timeout.",
+		action: params.Action{
+			Name:       "fakeaction",
+			Parameters: basicParams,
+			Timeout:    5 * time.Minute,
+		},
 	}, {
 		description: "An Action with nested parameters.",
 		action: params.Action{
@@ -46,9 +55,10 @@ func (s *actionSuite) TestAction(c *gc.C) {
 
 	for i, actionTest := range actionTests {
 		c.Logf("test %d: %s", i, actionTest.description)
-		a, err := s.uniterSuite.wordpressUnit.AddAction(
+		a, err := s.uniterSuite.wordpressUnit.AddActionWithTimeout(
 			actionTest.action.Name,
-			actionTest.action.Parameters)
+			actionTest.action.Parameters,
+			actionTest.action.Timeout)
 		c.Assert(err, jc.ErrorIsNil)
 
 		ok := names.IsValidAction(a.Id())
@@ -61,6 +71,7 @@ func (s *actionSuite) TestAction(c *gc.C) {
 
 		c.Assert(retrievedAction.Name(), gc.DeepEquals, actionTest.action.Name)
 		c.Assert(retrievedAction.Params(), gc.DeepEquals, actionTest.action.Parameters)
+		c.Assert(retrievedAction.Timeout(), gc.Equals, actionTest.action.Timeout)
 	}
 }
 
@@ -152,6 +152,50 @@ func (ru *RelationUnit) ReadSettings(uname string) (params.Settings, error) {
 	return result.Settings, nil
 }
 
+// ReadApplicationSettings returns the settings published in the
+// relation by the named application. Only the leader of the unit's
+// own application may read that application's settings, except in a
+// peer relation.
+func (ru *RelationUnit) ReadApplicationSettings(appName string) (params.Settings, error) {
+	if ru.st.facade.BestAPIVersion() < 11 {
+		return nil, errors.NotImplementedf("ReadApplicationSettings() (need V11+)")
+	}
+	var results params.SettingsResults
+	args := params.RelationApplications{
+		RelationApplications: []params.RelationApplication{{
+			Relation:    ru.relation.tag.String(),
+			Unit:        ru.unit.tag.String(),
+			Application: appName,
+		}},
+	}
+	err := ru.st.facade.FacadeCall("ReadApplicationSettings", args, &results)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if len(results.Results) != 1 {
+		return nil, errors.Errorf("expected 1 result, got %d", len(results.Results))
+	}
+	result := results.Results[0]
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return result.Settings, nil
+}
+
+// ApplicationSettings returns a Settings which allows access to the
+// settings published in the relation by the unit's application, which
+// the unit must lead. Settings can be modified, then written back with
+// Write.
+func (ru *RelationUnit) ApplicationSettings() (*Settings, error) {
+	settings, err := ru.ReadApplicationSettings(ru.unit.ApplicationName())
+	if err != nil {
+		return nil, err
+	}
+	s := newSettings(ru.st, ru.relation.tag.String(), ru.unit.tag.String(), settings)
+	s.writeMethod = "UpdateApplicationSettings"
+	return s, nil
+}
+
 // Watch returns a watcher that notifies of changes to counterpart
 // units in the relation.
 func (ru *RelationUnit) Watch() (watcher.RelationUnitsWatcher, error) {
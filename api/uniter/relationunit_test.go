@@ -4,6 +4,8 @@
 package uniter_test
 
 import (
+	"time"
+
 	jc "github.com/juju/testing/checkers"
 	gc "gopkg.in/check.v1"
 	"gopkg.in/juju/charm.v6"
@@ -240,6 +242,42 @@ func (s *relationUnitSuite) TestReadSettingsInvalidUnitTag(c *gc.C) {
 	c.Assert(err, gc.ErrorMatches, "\"mysql\" is not a valid unit")
 }
 
+func (s *relationUnitSuite) TestReadApplicationSettings(c *gc.C) {
+	err := s.State.LeadershipClaimer().ClaimLeadership("mysql", "mysql/0", time.Minute)
+	c.Assert(err, jc.ErrorIsNil)
+	token := s.State.LeadershipChecker().LeadershipCheck("mysql", "mysql/0")
+	err = s.stateRelation.UpdateApplicationSettings("mysql", token, map[string]string{"host": "10.0.0.1"})
+	c.Assert(err, jc.ErrorIsNil)
+
+	_, apiRelUnit := s.getRelationUnits(c)
+	gotSettings, err := apiRelUnit.ReadApplicationSettings("mysql")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(gotSettings, gc.DeepEquals, params.Settings{"host": "10.0.0.1"})
+
+	// Only the leader may read its own application's settings.
+	_, err = apiRelUnit.ReadApplicationSettings("wordpress")
+	c.Assert(err, gc.ErrorMatches, `"wordpress/0" is not leader of "wordpress"`)
+}
+
+func (s *relationUnitSuite) TestApplicationSettings(c *gc.C) {
+	_, apiRelUnit := s.getRelationUnits(c)
+	_, err := apiRelUnit.ApplicationSettings()
+	c.Assert(err, gc.ErrorMatches, `"wordpress/0" is not leader of "wordpress"`)
+
+	err = s.State.LeadershipClaimer().ClaimLeadership("wordpress", "wordpress/0", time.Minute)
+	c.Assert(err, jc.ErrorIsNil)
+	settings, err := apiRelUnit.ApplicationSettings()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(settings.Map(), gc.HasLen, 0)
+	settings.Set("user", "admin")
+	err = settings.Write()
+	c.Assert(err, jc.ErrorIsNil)
+
+	stateSettings, err := s.stateRelation.ApplicationSettings("wordpress")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(stateSettings, gc.DeepEquals, map[string]interface{}{"user": "admin"})
+}
+
 func (s *relationUnitSuite) TestWatchRelationUnits(c *gc.C) {
 	// Enter scope with mysqlUnit.
 	myRelUnit, err := s.stateRelation.Unit(s.mysqlUnit)
@@ -10,12 +10,16 @@ import (
 // This module implements a subset of the interface provided by
 // state.Settings, as needed by the uniter API.
 
-// Settings manages changes to unit settings in a relation.
+// Settings manages changes to unit or application settings in a
+// relation.
 type Settings struct {
 	st          *State
 	relationTag string
 	unitTag     string
 	settings    params.Settings
+
+	// writeMethod is the facade method used to write the settings.
+	writeMethod string
 }
 
 func newSettings(st *State, relationTag, unitTag string, settings params.Settings) *Settings {
@@ -27,6 +31,7 @@ func newSettings(st *State, relationTag, unitTag string, settings params.Setting
 		relationTag: relationTag,
 		unitTag:     unitTag,
 		settings:    settings,
+		writeMethod: "UpdateSettings",
 	}
 }
 
@@ -88,7 +93,7 @@ func (s *Settings) Write() error {
 			Settings: settingsCopy,
 		}},
 	}
-	err := s.st.facade.FacadeCall("UpdateSettings", args, &result)
+	err := s.st.facade.FacadeCall(s.writeMethod, args, &result)
 	if err != nil {
 		return err
 	}
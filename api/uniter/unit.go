@@ -4,6 +4,8 @@
 package uniter
 
 import (
+	"time"
+
 	"github.com/juju/errors"
 	"gopkg.in/juju/charm.v6"
 	"gopkg.in/juju/names.v2"
@@ -139,6 +141,138 @@ func (u *Unit) SetAgentStatus(agentStatus status.Status, info string, data map[s
 	return result.OneError()
 }
 
+// RecordHookHistory records an operation completed by the unit's
+// uniter. relationId is the id of the relation for a relation hook,
+// and -1 otherwise.
+func (u *Unit) RecordHookHistory(entry params.HookHistoryEntry, relationId int) error {
+	if u.st.facade.BestAPIVersion() < 9 {
+		return errors.NotImplementedf("RecordHookHistory() (need V9+)")
+	}
+	var result params.ErrorResults
+	args := params.RecordHookHistoryArgs{
+		Args: []params.RecordHookHistoryArg{{
+			Tag:        u.tag.String(),
+			RelationId: relationId,
+			Entry:      entry,
+		}},
+	}
+	err := u.st.facade.FacadeCall("RecordHookHistory", args, &result)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	return result.OneError()
+}
+
+// SetHealth records the results of the health checks declared by
+// the unit's charm.
+func (u *Unit) SetHealth(checks []params.HealthCheckResult) error {
+	if u.st.facade.BestAPIVersion() < 10 {
+		return errors.NotImplementedf("SetHealth() (need V10+)")
+	}
+	var result params.ErrorResults
+	args := params.SetUnitHealthArgs{
+		Args: []params.SetUnitHealthArg{{
+			Tag:    u.tag.String(),
+			Checks: checks,
+		}},
+	}
+	err := u.st.facade.FacadeCall("SetUnitHealth", args, &result)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	return result.OneError()
+}
+
+// Health returns the results of the health checks declared by the
+// unit's charm, as most recently recorded.
+func (u *Unit) Health() (params.UnitHealth, error) {
+	if u.st.facade.BestAPIVersion() < 10 {
+		return params.UnitHealth{}, errors.NotImplementedf("Health() (need V10+)")
+	}
+	var results params.UnitHealthResults
+	args := params.Entities{
+		Entities: []params.Entity{{Tag: u.tag.String()}},
+	}
+	err := u.st.facade.FacadeCall("UnitHealth", args, &results)
+	if err != nil {
+		return params.UnitHealth{}, errors.Trace(err)
+	}
+	if len(results.Results) != 1 {
+		return params.UnitHealth{}, errors.Errorf("expected 1 result, got %d", len(results.Results))
+	}
+	result := results.Results[0]
+	if result.Error != nil {
+		return params.UnitHealth{}, result.Error
+	}
+	return *result.Result, nil
+}
+
+// SetSubWorkload records the version and status of the named
+// sub-workload of the unit.
+func (u *Unit) SetSubWorkload(name, version string, subStatus status.Status, info string) error {
+	if u.st.facade.BestAPIVersion() < 12 {
+		return errors.NotImplementedf("SetSubWorkload() (need V12+)")
+	}
+	var result params.ErrorResults
+	args := params.SetSubWorkloadArgs{
+		Args: []params.SetSubWorkloadArg{{
+			Tag:     u.tag.String(),
+			Name:    name,
+			Version: version,
+			Status:  subStatus.String(),
+			Info:    info,
+		}},
+	}
+	err := u.st.facade.FacadeCall("SetSubWorkloads", args, &result)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	return result.OneError()
+}
+
+// HookTimeout returns how long the unit's hooks may run before they
+// are killed, or zero if there is no limit.
+func (u *Unit) HookTimeout() (time.Duration, error) {
+	if u.st.facade.BestAPIVersion() < 13 {
+		return 0, errors.NotImplementedf("HookTimeout() (need V13+)")
+	}
+	var results params.HookTimeoutResults
+	args := params.Entities{
+		Entities: []params.Entity{{Tag: u.tag.String()}},
+	}
+	err := u.st.facade.FacadeCall("HookTimeouts", args, &results)
+	if err != nil {
+		return 0, errors.Trace(err)
+	}
+	if len(results.Results) != 1 {
+		return 0, errors.Errorf("expected 1 result, got %d", len(results.Results))
+	}
+	result := results.Results[0]
+	if result.Error != nil {
+		return 0, result.Error
+	}
+	return result.Timeout, nil
+}
+
+// RemoveSubWorkload removes the named sub-workload of the unit.
+func (u *Unit) RemoveSubWorkload(name string) error {
+	if u.st.facade.BestAPIVersion() < 12 {
+		return errors.NotImplementedf("RemoveSubWorkload() (need V12+)")
+	}
+	var result params.ErrorResults
+	args := params.SubWorkloadArgs{
+		Args: []params.SubWorkloadArg{{
+			Tag:  u.tag.String(),
+			Name: name,
+		}},
+	}
+	err := u.st.facade.FacadeCall("RemoveSubWorkloads", args, &result)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	return result.OneError()
+}
+
 // AddMetrics adds the metrics for the unit.
 func (u *Unit) AddMetrics(metrics []params.Metric) error {
 	var result params.ErrorResults
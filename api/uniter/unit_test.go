@@ -508,6 +508,231 @@ func (s *unitSuite) TestNetworkInfo(c *gc.C) {
 	c.Assert(called, gc.Equals, 2)
 }
 
+func (s *unitSuite) TestRecordHookHistory(c *gc.C) {
+	started := time.Date(2018, 5, 1, 12, 30, 15, 0, time.UTC)
+	entry := params.HookHistoryEntry{
+		Operation: "run config-changed hook",
+		Hook:      "config-changed",
+		Started:   started,
+		Completed: started.Add(time.Second),
+		Result:    "completed",
+	}
+	var called int
+	apiCaller := testing.APICallerFunc(func(objType string, version int, id, request string, arg, result interface{}) error {
+		called++
+		if called == 1 {
+			*(result.(*params.UnitRefreshResults)) = params.UnitRefreshResults{
+				Results: []params.UnitRefreshResult{{Life: params.Alive, Resolved: params.ResolvedNone, Series: "quantal"}}}
+			return nil
+		}
+		c.Check(objType, gc.Equals, "Uniter")
+		c.Check(version, gc.Equals, expectedVersion)
+		c.Check(id, gc.Equals, "")
+		c.Check(request, gc.Equals, "RecordHookHistory")
+		c.Check(arg, jc.DeepEquals, params.RecordHookHistoryArgs{
+			Args: []params.RecordHookHistoryArg{{
+				Tag:        "unit-mysql-0",
+				RelationId: -1,
+				Entry:      entry,
+			}},
+		})
+		c.Assert(result, gc.FitsTypeOf, &params.ErrorResults{})
+		*(result.(*params.ErrorResults)) = params.ErrorResults{
+			Results: []params.ErrorResult{{Error: &params.Error{Message: "FAIL"}}},
+		}
+		return nil
+	})
+	ut := names.NewUnitTag("mysql/0")
+	st := uniter.NewState(apiCaller, ut)
+	unit, err := st.Unit(ut)
+	c.Assert(err, jc.ErrorIsNil)
+	err = unit.RecordHookHistory(entry, -1)
+	c.Assert(err, gc.ErrorMatches, "FAIL")
+	c.Assert(called, gc.Equals, 2)
+}
+
+func (s *unitSuite) TestSetHealth(c *gc.C) {
+	checks := []params.HealthCheckResult{{
+		Name:    "http",
+		Healthy: true,
+		Since:   time.Date(2018, 5, 1, 12, 30, 15, 0, time.UTC),
+	}}
+	var called int
+	apiCaller := testing.APICallerFunc(func(objType string, version int, id, request string, arg, result interface{}) error {
+		called++
+		if called == 1 {
+			*(result.(*params.UnitRefreshResults)) = params.UnitRefreshResults{
+				Results: []params.UnitRefreshResult{{Life: params.Alive, Resolved: params.ResolvedNone, Series: "quantal"}}}
+			return nil
+		}
+		c.Check(objType, gc.Equals, "Uniter")
+		c.Check(version, gc.Equals, expectedVersion)
+		c.Check(id, gc.Equals, "")
+		c.Check(request, gc.Equals, "SetUnitHealth")
+		c.Check(arg, jc.DeepEquals, params.SetUnitHealthArgs{
+			Args: []params.SetUnitHealthArg{{
+				Tag:    "unit-mysql-0",
+				Checks: checks,
+			}},
+		})
+		c.Assert(result, gc.FitsTypeOf, &params.ErrorResults{})
+		*(result.(*params.ErrorResults)) = params.ErrorResults{
+			Results: []params.ErrorResult{{Error: &params.Error{Message: "FAIL"}}},
+		}
+		return nil
+	})
+	ut := names.NewUnitTag("mysql/0")
+	st := uniter.NewState(apiCaller, ut)
+	unit, err := st.Unit(ut)
+	c.Assert(err, jc.ErrorIsNil)
+	err = unit.SetHealth(checks)
+	c.Assert(err, gc.ErrorMatches, "FAIL")
+	c.Assert(called, gc.Equals, 2)
+}
+
+func (s *unitSuite) TestHealth(c *gc.C) {
+	health := params.UnitHealth{
+		Status: "unhealthy",
+		Checks: []params.HealthCheckResult{{
+			Name:     "http",
+			Failures: 3,
+			Message:  "connection refused",
+			Since:    time.Date(2018, 5, 1, 12, 30, 15, 0, time.UTC),
+		}},
+	}
+	var called int
+	apiCaller := testing.APICallerFunc(func(objType string, version int, id, request string, arg, result interface{}) error {
+		called++
+		if called == 1 {
+			*(result.(*params.UnitRefreshResults)) = params.UnitRefreshResults{
+				Results: []params.UnitRefreshResult{{Life: params.Alive, Resolved: params.ResolvedNone, Series: "quantal"}}}
+			return nil
+		}
+		c.Check(objType, gc.Equals, "Uniter")
+		c.Check(version, gc.Equals, expectedVersion)
+		c.Check(request, gc.Equals, "UnitHealth")
+		c.Check(arg, jc.DeepEquals, params.Entities{
+			Entities: []params.Entity{{Tag: "unit-mysql-0"}},
+		})
+		c.Assert(result, gc.FitsTypeOf, &params.UnitHealthResults{})
+		*(result.(*params.UnitHealthResults)) = params.UnitHealthResults{
+			Results: []params.UnitHealthResult{{Result: &health}},
+		}
+		return nil
+	})
+	ut := names.NewUnitTag("mysql/0")
+	st := uniter.NewState(apiCaller, ut)
+	unit, err := st.Unit(ut)
+	c.Assert(err, jc.ErrorIsNil)
+	result, err := unit.Health()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result, jc.DeepEquals, health)
+	c.Assert(called, gc.Equals, 2)
+}
+
+func (s *unitSuite) TestSetSubWorkload(c *gc.C) {
+	var called int
+	apiCaller := testing.APICallerFunc(func(objType string, version int, id, request string, arg, result interface{}) error {
+		called++
+		if called == 1 {
+			*(result.(*params.UnitRefreshResults)) = params.UnitRefreshResults{
+				Results: []params.UnitRefreshResult{{Life: params.Alive, Resolved: params.ResolvedNone, Series: "quantal"}}}
+			return nil
+		}
+		c.Check(objType, gc.Equals, "Uniter")
+		c.Check(version, gc.Equals, expectedVersion)
+		c.Check(id, gc.Equals, "")
+		c.Check(request, gc.Equals, "SetSubWorkloads")
+		c.Check(arg, jc.DeepEquals, params.SetSubWorkloadArgs{
+			Args: []params.SetSubWorkloadArg{{
+				Tag:     "unit-mysql-0",
+				Name:    "telegraf",
+				Version: "1.7.2",
+				Status:  "active",
+				Info:    "shipping metrics",
+			}},
+		})
+		c.Assert(result, gc.FitsTypeOf, &params.ErrorResults{})
+		*(result.(*params.ErrorResults)) = params.ErrorResults{
+			Results: []params.ErrorResult{{Error: &params.Error{Message: "FAIL"}}},
+		}
+		return nil
+	})
+	ut := names.NewUnitTag("mysql/0")
+	st := uniter.NewState(apiCaller, ut)
+	unit, err := st.Unit(ut)
+	c.Assert(err, jc.ErrorIsNil)
+	err = unit.SetSubWorkload("telegraf", "1.7.2", status.Active, "shipping metrics")
+	c.Assert(err, gc.ErrorMatches, "FAIL")
+	c.Assert(called, gc.Equals, 2)
+}
+
+func (s *unitSuite) TestHookTimeout(c *gc.C) {
+	var called int
+	apiCaller := testing.APICallerFunc(func(objType string, version int, id, request string, arg, result interface{}) error {
+		called++
+		if called == 1 {
+			*(result.(*params.UnitRefreshResults)) = params.UnitRefreshResults{
+				Results: []params.UnitRefreshResult{{Life: params.Alive, Resolved: params.ResolvedNone, Series: "quantal"}}}
+			return nil
+		}
+		c.Check(objType, gc.Equals, "Uniter")
+		c.Check(version, gc.Equals, expectedVersion)
+		c.Check(id, gc.Equals, "")
+		c.Check(request, gc.Equals, "HookTimeouts")
+		c.Check(arg, jc.DeepEquals, params.Entities{
+			Entities: []params.Entity{{Tag: "unit-mysql-0"}},
+		})
+		c.Assert(result, gc.FitsTypeOf, &params.HookTimeoutResults{})
+		*(result.(*params.HookTimeoutResults)) = params.HookTimeoutResults{
+			Results: []params.HookTimeoutResult{{Timeout: 5 * time.Minute}},
+		}
+		return nil
+	})
+	ut := names.NewUnitTag("mysql/0")
+	st := uniter.NewState(apiCaller, ut)
+	unit, err := st.Unit(ut)
+	c.Assert(err, jc.ErrorIsNil)
+	timeout, err := unit.HookTimeout()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(timeout, gc.Equals, 5*time.Minute)
+	c.Assert(called, gc.Equals, 2)
+}
+
+func (s *unitSuite) TestRemoveSubWorkload(c *gc.C) {
+	var called int
+	apiCaller := testing.APICallerFunc(func(objType string, version int, id, request string, arg, result interface{}) error {
+		called++
+		if called == 1 {
+			*(result.(*params.UnitRefreshResults)) = params.UnitRefreshResults{
+				Results: []params.UnitRefreshResult{{Life: params.Alive, Resolved: params.ResolvedNone, Series: "quantal"}}}
+			return nil
+		}
+		c.Check(objType, gc.Equals, "Uniter")
+		c.Check(version, gc.Equals, expectedVersion)
+		c.Check(id, gc.Equals, "")
+		c.Check(request, gc.Equals, "RemoveSubWorkloads")
+		c.Check(arg, jc.DeepEquals, params.SubWorkloadArgs{
+			Args: []params.SubWorkloadArg{{
+				Tag:  "unit-mysql-0",
+				Name: "telegraf",
+			}},
+		})
+		c.Assert(result, gc.FitsTypeOf, &params.ErrorResults{})
+		*(result.(*params.ErrorResults)) = params.ErrorResults{
+			Results: []params.ErrorResult{{Error: &params.Error{Message: "FAIL"}}},
+		}
+		return nil
+	})
+	ut := names.NewUnitTag("mysql/0")
+	st := uniter.NewState(apiCaller, ut)
+	unit, err := st.Unit(ut)
+	c.Assert(err, jc.ErrorIsNil)
+	err = unit.RemoveSubWorkload("telegraf")
+	c.Assert(err, gc.ErrorMatches, "FAIL")
+	c.Assert(called, gc.Equals, 2)
+}
+
 func (s *unitSuite) TestConfigSettings(c *gc.C) {
 	// Make sure ConfigSettings returns an error when
 	// no charm URL is set, as its state counterpart does.
@@ -72,12 +72,12 @@ func newStateForVersionFn(version int) func(base.APICaller, names.UnitTag) *Stat
 	}
 }
 
-// newStateV8 creates a new client-side Uniter facade, version 8
-var newStateV8 = newStateForVersionFn(8)
+// newStateV13 creates a new client-side Uniter facade, version 13
+var newStateV13 = newStateForVersionFn(13)
 
 // NewState creates a new client-side Uniter facade.
 // Defined like this to allow patching during tests.
-var NewState = newStateV8
+var NewState = newStateV13
 
 // BestAPIVersion returns the API version that we were able to
 // determine is supported by both the client and the API Server.
@@ -237,8 +237,9 @@ func (st *State) Action(tag names.ActionTag) (*Action, error) {
 		return nil, err
 	}
 	return &Action{
-		name:   result.Action.Name,
-		params: result.Action.Parameters,
+		name:    result.Action.Name,
+		params:  result.Action.Parameters,
+		timeout: result.Action.Timeout,
 	}, nil
 }
 
@@ -279,6 +279,12 @@ func copyRelationUnitsChanged(src params.RelationUnitsChange) watcher.RelationUn
 			}
 		}
 	}
+	if src.AppChanged != nil {
+		dst.AppChanged = make(map[string]int64)
+		for appName, version := range src.AppChanged {
+			dst.AppChanged[appName] = version
+		}
+	}
 	return dst
 }
 
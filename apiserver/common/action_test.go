@@ -5,6 +5,8 @@
 package common_test
 
 import (
+	"time"
+
 	"github.com/juju/errors"
 	jc "github.com/juju/testing/checkers"
 	"github.com/juju/utils"
@@ -144,7 +146,7 @@ func (s *actionsSuite) TestBeginActions(c *gc.C) {
 func (s *actionsSuite) TestGetActions(c *gc.C) {
 	args := entities("success", "fail", "notPending")
 	actionFn := makeGetActionByTagString(map[string]state.Action{
-		"success":    fakeAction{name: "floosh", status: state.ActionPending},
+		"success":    fakeAction{name: "floosh", status: state.ActionPending, timeout: time.Minute},
 		"notPending": fakeAction{status: state.ActionCancelled},
 	})
 
@@ -152,7 +154,7 @@ func (s *actionsSuite) TestGetActions(c *gc.C) {
 
 	c.Assert(results, jc.DeepEquals, params.ActionResults{
 		[]params.ActionResult{
-			{Action: &params.Action{Name: "floosh"}},
+			{Action: &params.Action{Name: "floosh", Timeout: time.Minute}},
 			{Error: common.ServerError(actionNotFoundErr)},
 			{Error: common.ServerError(common.ErrActionNotAvailable)},
 		},
@@ -335,6 +337,7 @@ type fakeAction struct {
 	beginErr  error
 	finishErr error
 	status    state.ActionStatus
+	timeout   time.Duration
 }
 
 func (mock fakeAction) Status() state.ActionStatus {
@@ -357,6 +360,10 @@ func (mock fakeAction) Parameters() map[string]interface{} {
 	return nil
 }
 
+func (mock fakeAction) Timeout() time.Duration {
+	return mock.timeout
+}
+
 func (mock fakeAction) Finish(state.ActionResults) (state.Action, error) {
 	return nil, mock.finishErr
 }
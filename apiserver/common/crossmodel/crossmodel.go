@@ -141,9 +141,33 @@ func PublishRelationChange(backend Backend, relationTag names.Tag, change params
 			return errors.Trace(err)
 		}
 	}
+
+	if change.ApplicationSettings != nil {
+		logger.Debugf("%s updated application settings (%v)", applicationTag.Id(), change.ApplicationSettings)
+		if err := replaceApplicationSettings(rel, applicationTag.Id(), change.ApplicationSettings); err != nil {
+			return errors.Trace(err)
+		}
+	}
 	return nil
 }
 
+// replaceApplicationSettings replaces the settings published in the
+// relation by the remote application with the supplied values.
+func replaceApplicationSettings(rel Relation, appName string, settings map[string]interface{}) error {
+	current, err := rel.ApplicationSettings(appName)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	updates := make(map[string]string)
+	for k := range current {
+		updates[k] = ""
+	}
+	for k, v := range settings {
+		updates[k] = fmt.Sprint(v)
+	}
+	return rel.UpdateRemoteApplicationSettings(appName, updates)
+}
+
 // WatchRelationUnits returns a watcher for changes to the units on the specified relation.
 func WatchRelationUnits(backend Backend, tag names.RelationTag) (state.RelationUnitsWatcher, error) {
 	relation, err := backend.KeyRelation(tag.Id())
@@ -202,6 +226,30 @@ func RelationUnitSettings(backend Backend, ru params.RelationUnit) (params.Setti
 	return paramsSettings, nil
 }
 
+// RelationApplicationSettings returns the settings published in the
+// specified relation by the named application.
+func RelationApplicationSettings(backend Backend, relationTag names.Tag, appName string) (params.Settings, error) {
+	rel, err := backend.KeyRelation(relationTag.Id())
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	settings, err := rel.ApplicationSettings(appName)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	paramsSettings := make(params.Settings)
+	for k, v := range settings {
+		vString, ok := v.(string)
+		if !ok {
+			return nil, errors.Errorf(
+				"invalid relation setting %q: expected string, got %T", k, v,
+			)
+		}
+		paramsSettings[k] = vString
+	}
+	return paramsSettings, nil
+}
+
 // PublishIngressNetworkChange saves the specified ingress networks for a relation.
 func PublishIngressNetworkChange(backend Backend, relationTag names.Tag, change params.IngressNetworksChangeEvent) error {
 	logger.Debugf("publish into model %v network change for %v: %+v", backend.ModelUUID(), relationTag, change)
@@ -129,6 +129,14 @@ type Relation interface {
 
 	// SetSuspended sets the suspended status of the relation.
 	SetSuspended(bool, string) error
+
+	// ApplicationSettings returns the settings published in the
+	// relation by the named application.
+	ApplicationSettings(appName string) (map[string]interface{}, error)
+
+	// UpdateRemoteApplicationSettings changes the settings published
+	// in the relation by the named remote application.
+	UpdateRemoteApplicationSettings(appName string, updates map[string]string) error
 }
 
 // RelationUnit provides access to the settings of a single unit in a relation,
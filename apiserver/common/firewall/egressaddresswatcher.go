@@ -24,6 +24,10 @@ type EgressAddressWatcher struct {
 	appName string
 	rel     Relation
 
+	// private is true if the units' cloud-local addresses are
+	// required, rather than their public ones.
+	private bool
+
 	out chan []string
 
 	// Channel for machineAddressWatchers to report individual machine
@@ -56,10 +60,23 @@ type machineData struct {
 
 // NewEgressAddressWatcher creates an EgressAddressWatcher.
 func NewEgressAddressWatcher(backend State, rel Relation, appName string) (*EgressAddressWatcher, error) {
+	return newEgressAddressWatcher(backend, rel, appName, false)
+}
+
+// newPrivateAddressWatcher creates an EgressAddressWatcher that reports
+// the cloud-local addresses of the units, for use when both ends of the
+// relation are in the same model. Egress CIDRs describe how traffic
+// leaves the model, so they are not used.
+func newPrivateAddressWatcher(backend State, rel Relation, appName string) (*EgressAddressWatcher, error) {
+	return newEgressAddressWatcher(backend, rel, appName, true)
+}
+
+func newEgressAddressWatcher(backend State, rel Relation, appName string, private bool) (*EgressAddressWatcher, error) {
 	w := &EgressAddressWatcher{
 		backend:          backend,
 		appName:          appName,
 		rel:              rel,
+		private:          private,
 		known:            make(map[string]string),
 		out:              make(chan []string),
 		addressChanges:   make(chan string),
@@ -129,9 +146,12 @@ func (w *EgressAddressWatcher) loop() error {
 				// machine addresses. Relation CIDRs take
 				// precedence over those specified in model
 				// config.
-				addresses = set.NewStrings(w.knownRelationEgress.Values()...)
-				if addresses.Size() == 0 {
-					addresses = set.NewStrings(w.knownModelEgress.Values()...)
+				addresses = set.NewStrings()
+				if !w.private {
+					addresses = set.NewStrings(w.knownRelationEgress.Values()...)
+					if addresses.Size() == 0 {
+						addresses = set.NewStrings(w.knownModelEgress.Values()...)
+					}
 				}
 				if addresses.Size() == 0 {
 					// No user configured egress so just use the unit addresses.
@@ -226,19 +246,25 @@ func (w *EgressAddressWatcher) loop() error {
 }
 
 func (w *EgressAddressWatcher) unitAddress(unit Unit) (string, bool, error) {
-	addr, err := unit.PublicAddress()
+	scope := "public"
+	getAddress := unit.PublicAddress
+	if w.private {
+		scope = "private"
+		getAddress = unit.PrivateAddress
+	}
+	addr, err := getAddress()
 	if errors.IsNotAssigned(err) {
 		logger.Debugf("unit %s is not assigned to a machine, can't get address", unit.Name())
 		return "", false, nil
 	}
 	if network.IsNoAddressError(err) {
-		logger.Debugf("unit %s has no public address", unit.Name())
+		logger.Debugf("unit %s has no %s address", unit.Name(), scope)
 		return "", false, nil
 	}
 	if err != nil {
 		return "", false, err
 	}
-	logger.Debugf("unit %q has public address %q", unit.Name(), addr.Value)
+	logger.Debugf("unit %q has %s address %q", unit.Name(), scope, addr.Value)
 	return addr.Value, true, nil
 }
 
@@ -73,6 +73,45 @@ func WatchEgressAddressesForRelations(resources facade.Resources, st State, rela
 	return results, nil
 }
 
+// WatchRelatedIngressAddresses creates a watcher that notifies when the addresses
+// of units related to each of the specified applications change.
+// Each event contains the entire set of addresses which are required for ingress
+// to the application from the units it is related to within the model.
+func WatchRelatedIngressAddresses(resources facade.Resources, st State, applications params.Entities) (params.StringsWatchResults, error) {
+	results := params.StringsWatchResults{
+		make([]params.StringsWatchResult, len(applications.Entities)),
+	}
+
+	one := func(tag string) (id string, changes []string, _ error) {
+		logger.Debugf("Watching related ingress addresses for %+v", tag)
+
+		appTag, err := names.ParseApplicationTag(tag)
+		if err != nil {
+			return "", nil, errors.Trace(err)
+		}
+		w, err := NewRelatedIngressWatcher(st, appTag.Id())
+		if err != nil {
+			return "", nil, errors.Trace(err)
+		}
+		changes, ok := <-w.Changes()
+		if !ok {
+			return "", nil, common.ServerError(watcher.EnsureErr(w))
+		}
+		return resources.Register(w), changes, nil
+	}
+
+	for i, e := range applications.Entities {
+		watcherId, changes, err := one(e.Tag)
+		if err != nil {
+			results.Results[i].Error = common.ServerError(err)
+			continue
+		}
+		results.Results[i].StringsWatcherId = watcherId
+		results.Results[i].Changes = changes
+	}
+	return results, nil
+}
+
 type localEndpointInfo struct {
 	relation    Relation
 	application string
@@ -224,11 +224,13 @@ type mockApplication struct {
 	testing.Stub
 	name  string
 	units []*mockUnit
+	rw    *mockStringsWatcher
 }
 
 func newMockApplication(name string) *mockApplication {
 	return &mockApplication{
 		name: name,
+		rw:   newMockStringsWatcher(),
 	}
 }
 
@@ -237,6 +239,11 @@ func (a *mockApplication) Name() string {
 	return a.name
 }
 
+func (a *mockApplication) WatchRelations() state.StringsWatcher {
+	a.MethodCall(a, "WatchRelations")
+	return a.rw
+}
+
 func (a *mockApplication) AllUnits() (results []firewall.Unit, err error) {
 	a.MethodCall(a, "AllUnits")
 	for _, unit := range a.units {
@@ -263,6 +270,7 @@ type mockRelation struct {
 	firewall.Relation
 	id        int
 	key       string
+	life      state.Life
 	endpoints []state.Endpoint
 	ruw       *mockRelationUnitsWatcher
 	ew        *mockStringsWatcher
@@ -282,6 +290,11 @@ func (r *mockRelation) Id() int {
 	return r.id
 }
 
+func (r *mockRelation) Life() state.Life {
+	r.MethodCall(r, "Life")
+	return r.life
+}
+
 func (r *mockRelation) Endpoints() []state.Endpoint {
 	r.MethodCall(r, "Endpoints")
 	return r.endpoints
@@ -340,11 +353,12 @@ func (st *mockState) KeyRelation(key string) (firewall.Relation, error) {
 
 type mockUnit struct {
 	testing.Stub
-	mu            sync.Mutex
-	name          string
-	assigned      bool
-	publicAddress network.Address
-	machineId     string
+	mu             sync.Mutex
+	name           string
+	assigned       bool
+	publicAddress  network.Address
+	privateAddress network.Address
+	machineId      string
 }
 
 func newMockUnit(name string) *mockUnit {
@@ -376,6 +390,23 @@ func (u *mockUnit) PublicAddress() (network.Address, error) {
 	return u.publicAddress, nil
 }
 
+func (u *mockUnit) PrivateAddress() (network.Address, error) {
+	u.MethodCall(u, "PrivateAddress")
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	if err := u.NextErr(); err != nil {
+		return network.Address{}, err
+	}
+	if !u.assigned {
+		return network.Address{}, errors.NotAssignedf(u.name)
+	}
+	if u.privateAddress.Value == "" {
+		return network.Address{}, network.NoAddressError("private")
+	}
+	return u.privateAddress, nil
+}
+
 func (u *mockUnit) AssignedMachineId() (string, error) {
 	u.MethodCall(u, "AssignedMachineId")
 	if err := u.NextErr(); err != nil {
@@ -0,0 +1,315 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package firewall
+
+import (
+	"github.com/juju/collections/set"
+	"github.com/juju/errors"
+	"gopkg.in/juju/worker.v1"
+
+	"github.com/juju/juju/state"
+	"github.com/juju/juju/worker/catacomb"
+)
+
+// RelatedIngressWatcher reports changes to the addresses of units
+// related to a given application within the same model.
+// Each event contains the entire set of CIDRs from which the related
+// units will originate connections, and hence the set of networks
+// which need to be allowed ingress to the application's units.
+// Relations to remote (cross model) applications are ignored as
+// ingress for those is recorded against the relation networks.
+type RelatedIngressWatcher struct {
+	catacomb catacomb.Catacomb
+
+	backend State
+	appName string
+
+	out chan []string
+
+	// Channel for relationAddressWorkers to report changes to
+	// the addresses for individual relations.
+	addressChanges chan relationAddresses
+
+	// A map of relation key to the worker tracking the
+	// addresses of the related units on that relation.
+	relations map[string]*relationAddressWorker
+
+	// A map of relation key to the last known CIDRs for
+	// that relation.
+	known map[string]set.Strings
+}
+
+// relationAddresses holds the CIDRs reported for a single relation.
+type relationAddresses struct {
+	key   string
+	cidrs []string
+}
+
+// NewRelatedIngressWatcher creates a RelatedIngressWatcher.
+func NewRelatedIngressWatcher(backend State, appName string) (*RelatedIngressWatcher, error) {
+	w := &RelatedIngressWatcher{
+		backend:        backend,
+		appName:        appName,
+		out:            make(chan []string),
+		addressChanges: make(chan relationAddresses),
+		relations:      make(map[string]*relationAddressWorker),
+		known:          make(map[string]set.Strings),
+	}
+	err := catacomb.Invoke(catacomb.Plan{
+		Site: &w.catacomb,
+		Work: w.loop,
+	})
+	return w, err
+}
+
+func (w *RelatedIngressWatcher) loop() error {
+	defer close(w.out)
+
+	app, err := w.backend.Application(w.appName)
+	if errors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return errors.Trace(err)
+	}
+	rw := app.WatchRelations()
+	if err := w.catacomb.Add(rw); err != nil {
+		return errors.Trace(err)
+	}
+
+	var (
+		changed         bool
+		sentInitial     bool
+		haveInitial     bool
+		out             chan<- []string
+		lastAddresses   set.Strings
+		addressesCIDR   []string
+		pendingInitials = set.NewStrings()
+	)
+
+	for {
+		var ready bool
+		if !sentInitial {
+			ready = haveInitial && pendingInitials.Size() == 0
+		}
+		if ready || changed {
+			addresses := set.NewStrings()
+			for _, cidrs := range w.known {
+				addresses = addresses.Union(cidrs)
+			}
+			changed = false
+			if !setEquals(addresses, lastAddresses) {
+				lastAddresses = addresses
+				addressesCIDR = addresses.SortedValues()
+				ready = ready || sentInitial
+			}
+		}
+		if ready {
+			out = w.out
+		}
+
+		select {
+		case <-w.catacomb.Dying():
+			return w.catacomb.ErrDying()
+
+		case out <- addressesCIDR:
+			sentInitial = true
+			out = nil
+
+		case keys, ok := <-rw.Changes():
+			if !ok {
+				return w.catacomb.ErrDying()
+			}
+			haveInitial = true
+			for _, key := range keys {
+				started, stopped, err := w.relationChanged(key)
+				if err != nil {
+					return errors.Trace(err)
+				}
+				if started && !sentInitial {
+					pendingInitials.Add(key)
+				}
+				if stopped {
+					pendingInitials.Remove(key)
+					changed = true
+				}
+			}
+
+		case change := <-w.addressChanges:
+			if _, ok := w.relations[change.key]; !ok {
+				// The relation has since been removed.
+				continue
+			}
+			pendingInitials.Remove(change.key)
+			cidrs := set.NewStrings(change.cidrs...)
+			if !setEquals(cidrs, w.known[change.key]) {
+				logger.Debugf(
+					"related ingress addresses for %v on %q changed to %s",
+					w.appName, change.key, cidrs.SortedValues(),
+				)
+				w.known[change.key] = cidrs
+				changed = true
+			}
+		}
+	}
+}
+
+// relationChanged starts tracking the addresses of the units related
+// to the application over the relation with the specified key, or
+// stops tracking them if the relation is no longer alive.
+func (w *RelatedIngressWatcher) relationChanged(key string) (started, stopped bool, _ error) {
+	rel, err := w.backend.KeyRelation(key)
+	if err != nil && !errors.IsNotFound(err) {
+		return false, false, errors.Trace(err)
+	}
+	_, known := w.relations[key]
+	if errors.IsNotFound(err) || rel.Life() != state.Alive {
+		if !known {
+			return false, false, nil
+		}
+		return false, true, w.forgetRelation(key)
+	}
+	if known {
+		return false, false, nil
+	}
+
+	relatedApp, ok, err := w.relatedApplication(rel)
+	if err != nil || !ok {
+		return false, false, errors.Trace(err)
+	}
+	addressWorker, err := newRelationAddressWorker(w.backend, rel, key, relatedApp, w.addressChanges)
+	if err != nil {
+		return false, false, errors.Trace(err)
+	}
+	if err := w.catacomb.Add(addressWorker); err != nil {
+		return false, false, errors.Trace(err)
+	}
+	w.relations[key] = addressWorker
+	return true, false, nil
+}
+
+// relatedApplication returns the name of the application at the other
+// end of the relation, or false if that application is not in this model.
+// For peer relations, the application itself is returned.
+func (w *RelatedIngressWatcher) relatedApplication(rel Relation) (string, bool, error) {
+	relatedApp := w.appName
+	for _, ep := range rel.Endpoints() {
+		if ep.ApplicationName != w.appName {
+			relatedApp = ep.ApplicationName
+			break
+		}
+	}
+	_, err := w.backend.Application(relatedApp)
+	if errors.IsNotFound(err) {
+		// Ingress for remote applications is handled using
+		// the relation ingress networks.
+		logger.Debugf("ignoring relation to remote application %q", relatedApp)
+		return "", false, nil
+	}
+	if err != nil {
+		return "", false, errors.Trace(err)
+	}
+	return relatedApp, true, nil
+}
+
+func (w *RelatedIngressWatcher) forgetRelation(key string) error {
+	addressWorker := w.relations[key]
+	delete(w.relations, key)
+	delete(w.known, key)
+	return errors.Trace(worker.Stop(addressWorker))
+}
+
+// Changes returns the event channel for this watcher.
+func (w *RelatedIngressWatcher) Changes() <-chan []string {
+	return w.out
+}
+
+// Kill asks the watcher to stop without waiting for it do so.
+func (w *RelatedIngressWatcher) Kill() {
+	w.catacomb.Kill(nil)
+}
+
+// Wait waits for the watcher to die and returns any
+// error encountered when it was running.
+func (w *RelatedIngressWatcher) Wait() error {
+	return w.catacomb.Wait()
+}
+
+// Stop kills the watcher, then waits for it to die.
+func (w *RelatedIngressWatcher) Stop() error {
+	w.Kill()
+	return w.Wait()
+}
+
+// Err returns any error encountered while the watcher
+// has been running.
+func (w *RelatedIngressWatcher) Err() error {
+	return w.catacomb.Err()
+}
+
+func newRelationAddressWorker(
+	backend State, rel Relation, key, appName string, out chan<- relationAddresses,
+) (*relationAddressWorker, error) {
+	aw, err := newPrivateAddressWatcher(backend, rel, appName)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	w := &relationAddressWorker{
+		key:     key,
+		watcher: aw,
+		out:     out,
+	}
+	err = catacomb.Invoke(catacomb.Plan{
+		Site: &w.catacomb,
+		Work: w.loop,
+		Init: []worker.Worker{aw},
+	})
+	return w, errors.Trace(err)
+}
+
+// relationAddressWorker forwards the cloud-local addresses of the related
+// units on a single relation to the out channel.
+type relationAddressWorker struct {
+	catacomb catacomb.Catacomb
+	key      string
+	watcher  *EgressAddressWatcher
+	out      chan<- relationAddresses
+}
+
+func (w *relationAddressWorker) loop() error {
+	var (
+		out    chan<- relationAddresses
+		change relationAddresses
+		done   bool
+	)
+	in := w.watcher.Changes()
+	for {
+		select {
+		case <-w.catacomb.Dying():
+			return w.catacomb.ErrDying()
+		case cidrs, ok := <-in:
+			if !ok {
+				// The relation has gone away, so report that there
+				// are no addresses for it before stopping.
+				in = nil
+				done = true
+			}
+			change = relationAddresses{key: w.key, cidrs: cidrs}
+			out = w.out
+		case out <- change:
+			out = nil
+			if done {
+				return errors.Trace(w.watcher.Wait())
+			}
+		}
+	}
+}
+
+func (w *relationAddressWorker) Kill() {
+	w.catacomb.Kill(nil)
+}
+
+func (w *relationAddressWorker) Wait() error {
+	return w.catacomb.Wait()
+}
@@ -0,0 +1,130 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package firewall_test
+
+import (
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/apiserver/common/firewall"
+	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/network"
+	"github.com/juju/juju/state"
+	statetesting "github.com/juju/juju/state/testing"
+	coretesting "github.com/juju/juju/testing"
+)
+
+var _ = gc.Suite(&relatedIngressWatcherSuite{})
+
+type relatedIngressWatcherSuite struct {
+	coretesting.BaseSuite
+
+	st *mockState
+}
+
+func (s *relatedIngressWatcherSuite) SetUpTest(c *gc.C) {
+	s.BaseSuite.SetUpTest(c)
+	s.st = newMockState(coretesting.ModelTag.Id())
+	s.st.applications["mysql"] = newMockApplication("mysql")
+}
+
+func (s *relatedIngressWatcherSuite) setupRelation(c *gc.C, key, relatedApp string) *mockRelation {
+	rel := newMockRelation(123)
+	rel.key = key
+	rel.ruwApp = relatedApp
+	rel.endpoints = []state.Endpoint{{
+		ApplicationName: "mysql",
+	}, {
+		ApplicationName: relatedApp,
+	}}
+	// Initial event.
+	rel.ew.changes <- []string{}
+	s.st.relations[key] = rel
+	return rel
+}
+
+func (s *relatedIngressWatcherSuite) addRelatedUnit(c *gc.C, appName, unitName, addr string) {
+	unit := newMockUnit(unitName)
+	unit.privateAddress = network.Address{Value: addr}
+	unit.publicAddress = network.Address{Value: "54.1.2.3"}
+	unit.machineId = "0"
+	s.st.units[unitName] = unit
+	app := newMockApplication(appName)
+	app.units = []*mockUnit{unit}
+	s.st.applications[appName] = app
+	s.st.machines["0"] = newMockMachine("0")
+}
+
+func (s *relatedIngressWatcherSuite) TestInitialNoRelations(c *gc.C) {
+	w, err := firewall.NewRelatedIngressWatcher(s.st, "mysql")
+	c.Assert(err, jc.ErrorIsNil)
+	defer statetesting.AssertStop(c, w)
+	wc := statetesting.NewStringsWatcherC(c, nopSyncStarter{}, w)
+
+	s.st.applications["mysql"].rw.changes <- []string{}
+	wc.AssertChange()
+	wc.AssertNoChange()
+}
+
+func (s *relatedIngressWatcherSuite) TestRelatedUnitAddresses(c *gc.C) {
+	s.addRelatedUnit(c, "wordpress", "wordpress/0", "10.0.0.3")
+	rel := s.setupRelation(c, "wordpress:db mysql:server", "wordpress")
+	w, err := firewall.NewRelatedIngressWatcher(s.st, "mysql")
+	c.Assert(err, jc.ErrorIsNil)
+	defer statetesting.AssertStop(c, w)
+	wc := statetesting.NewStringsWatcherC(c, nopSyncStarter{}, w)
+
+	s.st.applications["mysql"].rw.changes <- []string{"wordpress:db mysql:server"}
+	rel.ruw.changes <- params.RelationUnitsChange{
+		Changed: map[string]params.UnitSettings{
+			"wordpress/0": {},
+		},
+	}
+	// The unit is in the same model, so its cloud-local
+	// address is used rather than its public one.
+	wc.AssertChange("10.0.0.3/32")
+	wc.AssertNoChange()
+
+	// When the unit departs, its address is removed.
+	rel.ruw.changes <- params.RelationUnitsChange{
+		Departed: []string{"wordpress/0"},
+	}
+	wc.AssertChange()
+	wc.AssertNoChange()
+}
+
+func (s *relatedIngressWatcherSuite) TestRelationDying(c *gc.C) {
+	s.addRelatedUnit(c, "wordpress", "wordpress/0", "10.0.0.3")
+	rel := s.setupRelation(c, "wordpress:db mysql:server", "wordpress")
+	w, err := firewall.NewRelatedIngressWatcher(s.st, "mysql")
+	c.Assert(err, jc.ErrorIsNil)
+	defer statetesting.AssertStop(c, w)
+	wc := statetesting.NewStringsWatcherC(c, nopSyncStarter{}, w)
+
+	s.st.applications["mysql"].rw.changes <- []string{"wordpress:db mysql:server"}
+	rel.ruw.changes <- params.RelationUnitsChange{
+		Changed: map[string]params.UnitSettings{
+			"wordpress/0": {},
+		},
+	}
+	wc.AssertChange("10.0.0.3/32")
+	wc.AssertNoChange()
+
+	rel.life = state.Dying
+	s.st.applications["mysql"].rw.changes <- []string{"wordpress:db mysql:server"}
+	wc.AssertChange()
+	wc.AssertNoChange()
+}
+
+func (s *relatedIngressWatcherSuite) TestIgnoresRemoteApplications(c *gc.C) {
+	s.setupRelation(c, "remote-db2:db mysql:server", "remote-db2")
+	w, err := firewall.NewRelatedIngressWatcher(s.st, "mysql")
+	c.Assert(err, jc.ErrorIsNil)
+	defer statetesting.AssertStop(c, w)
+	wc := statetesting.NewStringsWatcherC(c, nopSyncStarter{}, w)
+
+	s.st.applications["mysql"].rw.changes <- []string{"remote-db2:db mysql:server"}
+	wc.AssertChange()
+	wc.AssertNoChange()
+}
@@ -50,6 +50,7 @@ func (st stateShim) KeyRelation(key string) (Relation, error) {
 
 type Relation interface {
 	status.StatusSetter
+	Life() state.Life
 	Endpoints() []state.Endpoint
 	WatchUnits(applicationName string) (state.RelationUnitsWatcher, error)
 	WatchRelationIngressNetworks() state.StringsWatcher
@@ -70,6 +71,7 @@ func (st stateShim) Application(name string) (Application, error) {
 
 type Application interface {
 	Name() string
+	WatchRelations() state.StringsWatcher
 }
 
 type applicationShim struct {
@@ -79,6 +81,7 @@ type applicationShim struct {
 type Unit interface {
 	Name() string
 	PublicAddress() (network.Address, error)
+	PrivateAddress() (network.Address, error)
 	AssignedMachineId() (string, error)
 }
 
@@ -94,6 +94,7 @@ type Model interface {
 	SLALevel() string
 	SLAOwner() string
 	MigrationMode() state.MigrationMode
+	SetMigrationMode(state.MigrationMode) error
 	Name() string
 	UUID() string
 	ControllerUUID() string
@@ -0,0 +1,123 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package common
+
+import (
+	"github.com/juju/collections/set"
+	"github.com/juju/description"
+	"github.com/juju/errors"
+	"github.com/juju/version"
+
+	"github.com/juju/juju/apiserver/params"
+)
+
+// SerializeModel returns the serialized form of the model description,
+// along with the charms, agent binaries and resources used by the model,
+// so that they may be transferred with it.
+func SerializeModel(model description.Model) (params.SerializedModel, error) {
+	bytes, err := description.Serialize(model)
+	if err != nil {
+		return params.SerializedModel{}, errors.Trace(err)
+	}
+	return params.SerializedModel{
+		Bytes:     bytes,
+		Charms:    getUsedCharms(model),
+		Tools:     getUsedTools(model),
+		Resources: getUsedResources(model),
+	}, nil
+}
+
+func getUsedCharms(model description.Model) []string {
+	result := set.NewStrings()
+	for _, application := range model.Applications() {
+		result.Add(application.CharmURL())
+	}
+	return result.Values()
+}
+
+func getUsedTools(model description.Model) []params.SerializedModelTools {
+	// Iterate through the model for all tools, and make a map of them.
+	usedVersions := make(map[version.Binary]bool)
+	// It is most likely that the preconditions will limit the number of
+	// tools versions in use, but that is not relied on here.
+	for _, machine := range model.Machines() {
+		addToolsVersionForMachine(machine, usedVersions)
+	}
+
+	for _, application := range model.Applications() {
+		for _, unit := range application.Units() {
+			tools := unit.Tools()
+			usedVersions[tools.Version()] = true
+		}
+	}
+
+	out := make([]params.SerializedModelTools, 0, len(usedVersions))
+	for v := range usedVersions {
+		out = append(out, params.SerializedModelTools{
+			Version: v.String(),
+			URI:     ToolsURL("", v),
+		})
+	}
+	return out
+}
+
+func addToolsVersionForMachine(machine description.Machine, usedVersions map[version.Binary]bool) {
+	tools := machine.Tools()
+	usedVersions[tools.Version()] = true
+	for _, container := range machine.Containers() {
+		addToolsVersionForMachine(container, usedVersions)
+	}
+}
+
+func getUsedResources(model description.Model) []params.SerializedModelResource {
+	var out []params.SerializedModelResource
+	for _, app := range model.Applications() {
+		for _, resource := range app.Resources() {
+			outRes := resourceToSerialized(app.Name(), resource)
+
+			// Hunt through the application's units and look for
+			// revisions of this resource. This is particularly
+			// efficient or clever but will be fine even with 1000's
+			// of units and 10's of resources.
+			outRes.UnitRevisions = make(map[string]params.SerializedModelResourceRevision)
+			for _, unit := range app.Units() {
+				for _, unitResource := range unit.Resources() {
+					if unitResource.Name() == resource.Name() {
+						outRes.UnitRevisions[unit.Name()] = revisionToSerialized(unitResource.Revision())
+					}
+				}
+			}
+
+			out = append(out, outRes)
+		}
+
+	}
+	return out
+}
+
+func resourceToSerialized(app string, desc description.Resource) params.SerializedModelResource {
+	return params.SerializedModelResource{
+		Application:         app,
+		Name:                desc.Name(),
+		ApplicationRevision: revisionToSerialized(desc.ApplicationRevision()),
+		CharmStoreRevision:  revisionToSerialized(desc.CharmStoreRevision()),
+	}
+}
+
+func revisionToSerialized(rr description.ResourceRevision) params.SerializedModelResourceRevision {
+	if rr == nil {
+		return params.SerializedModelResourceRevision{}
+	}
+	return params.SerializedModelResourceRevision{
+		Revision:       rr.Revision(),
+		Type:           rr.Type(),
+		Path:           rr.Path(),
+		Description:    rr.Description(),
+		Origin:         rr.Origin(),
+		FingerprintHex: rr.FingerprintHex(),
+		Size:           rr.Size(),
+		Timestamp:      rr.Timestamp(),
+		Username:       rr.Username(),
+	}
+}
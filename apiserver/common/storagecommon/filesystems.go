@@ -68,6 +68,30 @@ func FilesystemParams(
 	return result, nil
 }
 
+// IsSharedFilesystem reports whether or not the given filesystem may be
+// attached to multiple machines. Shared filesystems have no backing volume,
+// and are assigned to storage owned by an application rather than a unit.
+func IsSharedFilesystem(
+	f state.Filesystem,
+	getStorageInstance func(names.StorageTag) (state.StorageInstance, error),
+) (bool, error) {
+	if _, err := f.Volume(); err == nil {
+		return false, nil
+	} else if err != state.ErrNoBackingVolume {
+		return false, errors.Annotate(err, "getting filesystem volume")
+	}
+	storageInstance, err := MaybeAssignedStorageInstance(f.Storage, getStorageInstance)
+	if errors.IsNotFound(err) {
+		return false, nil
+	} else if err != nil {
+		return false, errors.Annotate(err, "getting storage instance")
+	} else if storageInstance == nil {
+		return false, nil
+	}
+	owner, ok := storageInstance.Owner()
+	return ok && owner.Kind() == names.ApplicationTagKind, nil
+}
+
 // FilesystemToState converts a params.Filesystem to state.FilesystemInfo
 // and names.FilesystemTag.
 func FilesystemToState(v params.Filesystem) (names.FilesystemTag, state.FilesystemInfo, error) {
@@ -151,6 +175,34 @@ func FilesystemAttachmentInfoFromState(info state.FilesystemAttachmentInfo) para
 	}
 }
 
+// FilesystemUsageFromState converts a state.FilesystemUsage
+// to params.FilesystemUsage.
+func FilesystemUsageFromState(usage state.FilesystemUsage) params.FilesystemUsage {
+	return params.FilesystemUsage{
+		Size:       usage.Size,
+		Used:       usage.Used,
+		Available:  usage.Available,
+		Inodes:     usage.Inodes,
+		InodesUsed: usage.InodesUsed,
+		InodesFree: usage.InodesFree,
+		Updated:    usage.Updated,
+	}
+}
+
+// FilesystemUsageToState converts a params.FilesystemUsage
+// to state.FilesystemUsage.
+func FilesystemUsageToState(usage params.FilesystemUsage) state.FilesystemUsage {
+	return state.FilesystemUsage{
+		Size:       usage.Size,
+		Used:       usage.Used,
+		Available:  usage.Available,
+		Inodes:     usage.Inodes,
+		InodesUsed: usage.InodesUsed,
+		InodesFree: usage.InodesFree,
+		Updated:    usage.Updated,
+	}
+}
+
 // ParseFilesystemAttachmentIds parses the strings, returning machine storage IDs.
 func ParseFilesystemAttachmentIds(stringIds []string) ([]params.MachineStorageId, error) {
 	ids := make([]params.MachineStorageId, len(stringIds))
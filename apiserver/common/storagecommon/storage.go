@@ -142,8 +142,9 @@ func volumeStorageAttachmentInfo(
 		return nil, errors.Trace(err)
 	}
 	return &storage.StorageAttachmentInfo{
-		storage.StorageKindBlock,
-		devicePath,
+		Kind:     storage.StorageKindBlock,
+		Location: devicePath,
+		Size:     volumeInfo.Size,
 	}, nil
 }
 
@@ -171,12 +172,49 @@ func filesystemStorageAttachmentInfo(
 	if err != nil {
 		return nil, errors.Annotate(err, "getting filesystem attachment info")
 	}
+	size, err := filesystemStorageSize(st, storageTag, filesystem)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
 	return &storage.StorageAttachmentInfo{
-		storage.StorageKindFilesystem,
-		filesystemAttachmentInfo.MountPoint,
+		Kind:     storage.StorageKindFilesystem,
+		Location: filesystemAttachmentInfo.MountPoint,
+		Size:     size,
 	}, nil
 }
 
+// filesystemStorageSize returns the size of the filesystem storage. The
+// size of a volume-backed filesystem is that of its volume, which may
+// be grown after the filesystem has been created.
+func filesystemStorageSize(
+	st StorageInterface,
+	storageTag names.StorageTag,
+	filesystem state.Filesystem,
+) (uint64, error) {
+	if _, err := filesystem.Volume(); err == state.ErrNoBackingVolume {
+		filesystemInfo, err := filesystem.Info()
+		if errors.IsNotProvisioned(err) {
+			return 0, nil
+		} else if err != nil {
+			return 0, errors.Annotate(err, "getting filesystem info")
+		}
+		return filesystemInfo.Size, nil
+	} else if err != nil {
+		return 0, errors.Annotate(err, "getting backing volume")
+	}
+	volume, err := st.StorageInstanceVolume(storageTag)
+	if err != nil {
+		return 0, errors.Annotate(err, "getting backing volume")
+	}
+	volumeInfo, err := volume.Info()
+	if errors.IsNotProvisioned(err) {
+		return 0, nil
+	} else if err != nil {
+		return 0, errors.Annotate(err, "getting backing volume info")
+	}
+	return volumeInfo.Size, nil
+}
+
 // WatchStorageAttachment returns a state.NotifyWatcher that reacts to changes
 // to the VolumeAttachmentInfo or FilesystemAttachmentInfo corresponding to the
 // tags specified.
@@ -219,6 +257,13 @@ func WatchStorageAttachment(
 		watchers = []state.NotifyWatcher{
 			st.WatchFilesystemAttachment(machineTag, filesystem.FilesystemTag()),
 		}
+		if _, err := filesystem.Volume(); err == nil {
+			// The backing volume may be resized, which will
+			// be reflected in the machine's block devices.
+			watchers = append(watchers, st.WatchBlockDevices(machineTag))
+		} else if err != state.ErrNoBackingVolume {
+			return nil, errors.Annotate(err, "getting backing volume")
+		}
 	default:
 		return nil, errors.Errorf("invalid storage kind %v", storageInstance.Kind())
 	}
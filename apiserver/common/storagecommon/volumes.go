@@ -49,12 +49,12 @@ func VolumeParams(
 		return params.VolumeParams{}, errors.Trace(err)
 	}
 	return params.VolumeParams{
-		v.Tag().String(),
-		size,
-		string(providerType),
-		cfg.Attrs(),
-		volumeTags,
-		nil, // attachment params set by the caller
+		VolumeTag:  v.Tag().String(),
+		Size:       size,
+		Provider:   string(providerType),
+		Attributes: cfg.Attrs(),
+		Tags:       volumeTags,
+		// Attachment and SnapshotId are set by the caller.
 	}, nil
 }
 
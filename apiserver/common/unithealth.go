@@ -0,0 +1,28 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package common
+
+import (
+	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/state"
+)
+
+// UnitHealth converts the results of a unit's health checks into
+// their API representation.
+func UnitHealth(health state.UnitHealth) *params.UnitHealth {
+	result := &params.UnitHealth{
+		Status: health.Status(),
+		Checks: make([]params.HealthCheckResult, len(health.Checks)),
+	}
+	for i, check := range health.Checks {
+		result.Checks[i] = params.HealthCheckResult{
+			Name:     check.Name,
+			Healthy:  check.Healthy,
+			Failures: check.Failures,
+			Message:  check.Message,
+			Since:    check.Since,
+		}
+	}
+	return result
+}
@@ -0,0 +1,59 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package common_test
+
+import (
+	"time"
+
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/apiserver/common"
+	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/state"
+)
+
+type UnitHealthSuite struct {
+	testing.IsolationSuite
+}
+
+var _ = gc.Suite(&UnitHealthSuite{})
+
+func (s *UnitHealthSuite) TestUnitHealth(c *gc.C) {
+	since := time.Date(2018, 5, 1, 12, 30, 15, 0, time.UTC)
+	result := common.UnitHealth(state.UnitHealth{
+		Checks: []state.HealthCheckResult{{
+			Name:    "http",
+			Healthy: true,
+			Since:   since,
+		}, {
+			Name:     "db",
+			Failures: 3,
+			Message:  "connection refused",
+			Since:    since,
+		}},
+	})
+	c.Assert(result, jc.DeepEquals, &params.UnitHealth{
+		Status: "unhealthy",
+		Checks: []params.HealthCheckResult{{
+			Name:    "http",
+			Healthy: true,
+			Since:   since,
+		}, {
+			Name:     "db",
+			Failures: 3,
+			Message:  "connection refused",
+			Since:    since,
+		}},
+	})
+}
+
+func (s *UnitHealthSuite) TestUnitHealthNoChecks(c *gc.C) {
+	result := common.UnitHealth(state.UnitHealth{})
+	c.Assert(result, jc.DeepEquals, &params.UnitHealth{
+		Status: "unknown",
+		Checks: []params.HealthCheckResult{},
+	})
+}
@@ -175,6 +175,14 @@ func (p *ProvisionerAPI) machineVolumeParams(
 		} else if !errors.IsNotProvisioned(err) {
 			return nil, nil, errors.Annotate(err, "getting volume info")
 		}
+		if stateVolumeParams, ok := volume.Params(); ok && !volumeProvisioned && stateVolumeParams.Snapshot != "" {
+			// Volumes created from snapshots are left to the
+			// storage provisioner, which creates them once the
+			// machine has been provisioned. State only permits
+			// creating volumes from snapshots with dynamic
+			// storage providers.
+			continue
+		}
 		stateVolumeAttachmentParams, volumeDetached := volumeAttachment.Params()
 		if !volumeDetached {
 			// Volume is already attached to the machine, so
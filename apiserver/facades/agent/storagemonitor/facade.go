@@ -0,0 +1,227 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package storagemonitor implements the API facade used by the
+// storagemonitor worker.
+package storagemonitor
+
+import (
+	"github.com/juju/errors"
+	"gopkg.in/juju/names.v2"
+
+	"github.com/juju/juju/apiserver/common"
+	"github.com/juju/juju/apiserver/common/storagecommon"
+	"github.com/juju/juju/apiserver/facade"
+	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/state"
+)
+
+// Backend defines the State API used by the storagemonitor facade.
+type Backend interface {
+	MachineFilesystemAttachments(names.MachineTag) ([]state.FilesystemAttachment, error)
+	SetFilesystemAttachmentUsage(names.MachineTag, names.FilesystemTag, state.FilesystemUsage) error
+	MachineVolumeAttachments(names.MachineTag) ([]state.VolumeAttachment, error)
+	Volume(names.VolumeTag) (state.Volume, error)
+	BlockDevices(names.MachineTag) ([]state.BlockDeviceInfo, error)
+	SetVolumeAttachmentUsage(names.MachineTag, names.VolumeTag, state.FilesystemUsage) error
+}
+
+// Facade implements the API required by the storagemonitor worker.
+type Facade struct {
+	backend      Backend
+	getCanAccess common.GetAuthFunc
+}
+
+// New returns a new API facade for the storagemonitor worker.
+func New(backend Backend, _ facade.Resources, authorizer facade.Authorizer) (*Facade, error) {
+	if !authorizer.AuthMachineAgent() {
+		return nil, common.ErrPerm
+	}
+	return &Facade{
+		backend: backend,
+		getCanAccess: func() (common.AuthFunc, error) {
+			return authorizer.AuthOwner, nil
+		},
+	}, nil
+}
+
+// FilesystemAttachments returns the provisioned filesystem attachments
+// of each of the specified machines.
+func (facade *Facade) FilesystemAttachments(args params.Entities) (params.FilesystemAttachmentsResults, error) {
+	results := params.FilesystemAttachmentsResults{
+		Results: make([]params.FilesystemAttachmentsResult, len(args.Entities)),
+	}
+	canAccess, err := facade.getCanAccess()
+	if err != nil {
+		return results, err
+	}
+	for i, arg := range args.Entities {
+		tag, err := names.ParseMachineTag(arg.Tag)
+		if err != nil || !canAccess(tag) {
+			results.Results[i].Error = common.ServerError(common.ErrPerm)
+			continue
+		}
+		attachments, err := facade.machineFilesystemAttachments(tag)
+		if err != nil {
+			results.Results[i].Error = common.ServerError(err)
+			continue
+		}
+		results.Results[i].Attachments = attachments
+	}
+	return results, nil
+}
+
+func (facade *Facade) machineFilesystemAttachments(tag names.MachineTag) ([]params.FilesystemAttachment, error) {
+	attachments, err := facade.backend.MachineFilesystemAttachments(tag)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	var result []params.FilesystemAttachment
+	for _, attachment := range attachments {
+		if attachment.Life() != state.Alive {
+			continue
+		}
+		paramsAttachment, err := storagecommon.FilesystemAttachmentFromState(attachment)
+		if errors.IsNotProvisioned(err) {
+			continue
+		} else if err != nil {
+			return nil, errors.Trace(err)
+		}
+		result = append(result, paramsAttachment)
+	}
+	return result, nil
+}
+
+// SetFilesystemAttachmentUsage records the usage of filesystems attached
+// to machines. State updates the status of each filesystem to reflect
+// the usage reported for all of its attachments.
+func (facade *Facade) SetFilesystemAttachmentUsage(args params.FilesystemAttachmentUsages) (params.ErrorResults, error) {
+	results := params.ErrorResults{
+		Results: make([]params.ErrorResult, len(args.Usages)),
+	}
+	canAccess, err := facade.getCanAccess()
+	if err != nil {
+		return results, err
+	}
+	for i, arg := range args.Usages {
+		machineTag, err := names.ParseMachineTag(arg.MachineTag)
+		if err != nil || !canAccess(machineTag) {
+			results.Results[i].Error = common.ServerError(common.ErrPerm)
+			continue
+		}
+		filesystemTag, err := names.ParseFilesystemTag(arg.FilesystemTag)
+		if err != nil {
+			results.Results[i].Error = common.ServerError(err)
+			continue
+		}
+		usage := storagecommon.FilesystemUsageToState(arg.Usage)
+		err = facade.backend.SetFilesystemAttachmentUsage(machineTag, filesystemTag, usage)
+		results.Results[i].Error = common.ServerError(err)
+	}
+	return results, nil
+}
+
+// MountedVolumeAttachments returns the provisioned volume attachments
+// of each of the specified machines whose block devices have a
+// filesystem mounted on them.
+func (facade *Facade) MountedVolumeAttachments(args params.Entities) (params.MountedVolumeAttachmentsResults, error) {
+	results := params.MountedVolumeAttachmentsResults{
+		Results: make([]params.MountedVolumeAttachmentsResult, len(args.Entities)),
+	}
+	canAccess, err := facade.getCanAccess()
+	if err != nil {
+		return results, err
+	}
+	for i, arg := range args.Entities {
+		tag, err := names.ParseMachineTag(arg.Tag)
+		if err != nil || !canAccess(tag) {
+			results.Results[i].Error = common.ServerError(common.ErrPerm)
+			continue
+		}
+		attachments, err := facade.machineMountedVolumeAttachments(tag)
+		if err != nil {
+			results.Results[i].Error = common.ServerError(err)
+			continue
+		}
+		results.Results[i].Attachments = attachments
+	}
+	return results, nil
+}
+
+func (facade *Facade) machineMountedVolumeAttachments(tag names.MachineTag) ([]params.MountedVolumeAttachment, error) {
+	attachments, err := facade.backend.MachineVolumeAttachments(tag)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	var blockDevices []state.BlockDeviceInfo
+	var result []params.MountedVolumeAttachment
+	for _, attachment := range attachments {
+		if attachment.Life() != state.Alive {
+			continue
+		}
+		attachmentInfo, err := attachment.Info()
+		if errors.IsNotProvisioned(err) {
+			continue
+		} else if err != nil {
+			return nil, errors.Trace(err)
+		}
+		volume, err := facade.backend.Volume(attachment.Volume())
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		volumeInfo, err := volume.Info()
+		if errors.IsNotProvisioned(err) {
+			continue
+		} else if err != nil {
+			return nil, errors.Trace(err)
+		}
+		if blockDevices == nil {
+			blockDevices, err = facade.backend.BlockDevices(tag)
+			if err != nil {
+				return nil, errors.Trace(err)
+			}
+		}
+		blockDevice, ok := storagecommon.MatchingBlockDevice(blockDevices, volumeInfo, attachmentInfo)
+		if !ok || blockDevice.MountPoint == "" {
+			// Only the usage of filesystems mounted
+			// on block devices can be measured.
+			continue
+		}
+		result = append(result, params.MountedVolumeAttachment{
+			VolumeTag:  attachment.Volume().String(),
+			MachineTag: tag.String(),
+			MountPoint: blockDevice.MountPoint,
+		})
+	}
+	return result, nil
+}
+
+// SetVolumeAttachmentUsage records the usage of the filesystems mounted
+// on the block devices of volumes attached to machines. State updates the
+// status of each volume to reflect the usage reported for all of its
+// attachments.
+func (facade *Facade) SetVolumeAttachmentUsage(args params.VolumeAttachmentUsages) (params.ErrorResults, error) {
+	results := params.ErrorResults{
+		Results: make([]params.ErrorResult, len(args.Usages)),
+	}
+	canAccess, err := facade.getCanAccess()
+	if err != nil {
+		return results, err
+	}
+	for i, arg := range args.Usages {
+		machineTag, err := names.ParseMachineTag(arg.MachineTag)
+		if err != nil || !canAccess(machineTag) {
+			results.Results[i].Error = common.ServerError(common.ErrPerm)
+			continue
+		}
+		volumeTag, err := names.ParseVolumeTag(arg.VolumeTag)
+		if err != nil {
+			results.Results[i].Error = common.ServerError(err)
+			continue
+		}
+		usage := storagecommon.FilesystemUsageToState(arg.Usage)
+		err = facade.backend.SetVolumeAttachmentUsage(machineTag, volumeTag, usage)
+		results.Results[i].Error = common.ServerError(err)
+	}
+	return results, nil
+}
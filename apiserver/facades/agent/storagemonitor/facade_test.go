@@ -0,0 +1,358 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package storagemonitor_test
+
+import (
+	"time"
+
+	"github.com/juju/errors"
+	jujutesting "github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+	"gopkg.in/juju/names.v2"
+
+	"github.com/juju/juju/apiserver/common"
+	"github.com/juju/juju/apiserver/facades/agent/storagemonitor"
+	"github.com/juju/juju/apiserver/params"
+	apiservertesting "github.com/juju/juju/apiserver/testing"
+	"github.com/juju/juju/state"
+	"github.com/juju/juju/testing"
+)
+
+type facadeSuite struct {
+	testing.BaseSuite
+	backend    *mockBackend
+	authorizer *apiservertesting.FakeAuthorizer
+	facade     *storagemonitor.Facade
+}
+
+var _ = gc.Suite(&facadeSuite{})
+
+func (s *facadeSuite) SetUpTest(c *gc.C) {
+	s.BaseSuite.SetUpTest(c)
+	s.backend = &mockBackend{}
+	s.authorizer = &apiservertesting.FakeAuthorizer{Tag: names.NewMachineTag("1")}
+	facade, err := storagemonitor.New(s.backend, nil, s.authorizer)
+	c.Assert(err, jc.ErrorIsNil)
+	s.facade = facade
+}
+
+func (s *facadeSuite) TestNewNotMachineAgent(c *gc.C) {
+	s.authorizer.Tag = names.NewUnitTag("mysql/0")
+	_, err := storagemonitor.New(s.backend, nil, s.authorizer)
+	c.Assert(err, gc.Equals, common.ErrPerm)
+}
+
+func (s *facadeSuite) TestFilesystemAttachments(c *gc.C) {
+	s.backend.attachments = []state.FilesystemAttachment{
+		&mockFilesystemAttachment{
+			filesystem: names.NewFilesystemTag("0"),
+			machine:    names.NewMachineTag("1"),
+			life:       state.Alive,
+			info:       &state.FilesystemAttachmentInfo{MountPoint: "/srv/data"},
+		},
+		&mockFilesystemAttachment{
+			// Not yet provisioned, so omitted.
+			filesystem: names.NewFilesystemTag("1"),
+			machine:    names.NewMachineTag("1"),
+			life:       state.Alive,
+		},
+		&mockFilesystemAttachment{
+			// Dying, so omitted.
+			filesystem: names.NewFilesystemTag("2"),
+			machine:    names.NewMachineTag("1"),
+			life:       state.Dying,
+			info:       &state.FilesystemAttachmentInfo{MountPoint: "/srv/old"},
+		},
+	}
+	results, err := s.facade.FilesystemAttachments(params.Entities{
+		Entities: []params.Entity{
+			{Tag: "machine-0"},
+			{Tag: "machine-1"},
+			{Tag: "unit-mysql-0"},
+		},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, jc.DeepEquals, params.FilesystemAttachmentsResults{
+		Results: []params.FilesystemAttachmentsResult{
+			{Error: apiservertesting.ErrUnauthorized},
+			{Attachments: []params.FilesystemAttachment{{
+				FilesystemTag: "filesystem-0",
+				MachineTag:    "machine-1",
+				Info:          params.FilesystemAttachmentInfo{MountPoint: "/srv/data"},
+			}}},
+			{Error: apiservertesting.ErrUnauthorized},
+		},
+	})
+	s.backend.stub.CheckCalls(c, []jujutesting.StubCall{
+		{"MachineFilesystemAttachments", []interface{}{names.NewMachineTag("1")}},
+	})
+}
+
+func (s *facadeSuite) usageArgs(used, available uint64) params.FilesystemAttachmentUsages {
+	return params.FilesystemAttachmentUsages{
+		Usages: []params.FilesystemAttachmentUsage{{
+			FilesystemTag: "filesystem-0",
+			MachineTag:    "machine-1",
+			Usage: params.FilesystemUsage{
+				Size:      used + available,
+				Used:      used,
+				Available: available,
+				Updated:   time.Unix(1000, 0).UTC(),
+			},
+		}},
+	}
+}
+
+func (s *facadeSuite) TestSetFilesystemAttachmentUsage(c *gc.C) {
+	results, err := s.facade.SetFilesystemAttachmentUsage(s.usageArgs(50, 50))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Combine(), jc.ErrorIsNil)
+	s.backend.stub.CheckCalls(c, []jujutesting.StubCall{
+		{"SetFilesystemAttachmentUsage", []interface{}{
+			names.NewMachineTag("1"),
+			names.NewFilesystemTag("0"),
+			state.FilesystemUsage{
+				Size:      100,
+				Used:      50,
+				Available: 50,
+				Updated:   time.Unix(1000, 0).UTC(),
+			},
+		}},
+	})
+}
+
+func (s *facadeSuite) TestSetFilesystemAttachmentUsageUnauthorized(c *gc.C) {
+	args := s.usageArgs(50, 50)
+	args.Usages[0].MachineTag = "machine-0"
+	results, err := s.facade.SetFilesystemAttachmentUsage(args)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results, jc.DeepEquals, []params.ErrorResult{
+		{Error: apiservertesting.ErrUnauthorized},
+	})
+	s.backend.stub.CheckNoCalls(c)
+}
+
+func (s *facadeSuite) TestSetFilesystemAttachmentUsageError(c *gc.C) {
+	s.backend.stub.SetErrors(errors.NotFoundf("filesystem attachment"))
+	results, err := s.facade.SetFilesystemAttachmentUsage(s.usageArgs(50, 50))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results[0].Error, gc.ErrorMatches, "filesystem attachment not found")
+	s.backend.stub.CheckCallNames(c, "SetFilesystemAttachmentUsage")
+}
+
+func (s *facadeSuite) TestMountedVolumeAttachments(c *gc.C) {
+	s.backend.volumeAttachments = []state.VolumeAttachment{
+		&mockVolumeAttachment{
+			volume:  names.NewVolumeTag("0"),
+			machine: names.NewMachineTag("1"),
+			life:    state.Alive,
+			info:    &state.VolumeAttachmentInfo{DeviceName: "sdb"},
+		},
+		&mockVolumeAttachment{
+			// Block device has no filesystem mounted, so omitted.
+			volume:  names.NewVolumeTag("1"),
+			machine: names.NewMachineTag("1"),
+			life:    state.Alive,
+			info:    &state.VolumeAttachmentInfo{DeviceName: "sdc"},
+		},
+		&mockVolumeAttachment{
+			// Not yet provisioned, so omitted.
+			volume:  names.NewVolumeTag("2"),
+			machine: names.NewMachineTag("1"),
+			life:    state.Alive,
+		},
+		&mockVolumeAttachment{
+			// Dying, so omitted.
+			volume:  names.NewVolumeTag("3"),
+			machine: names.NewMachineTag("1"),
+			life:    state.Dying,
+			info:    &state.VolumeAttachmentInfo{DeviceName: "sdd"},
+		},
+	}
+	s.backend.volumes = map[names.VolumeTag]*mockVolume{
+		names.NewVolumeTag("0"): {info: &state.VolumeInfo{VolumeId: "vol-0"}},
+		names.NewVolumeTag("1"): {info: &state.VolumeInfo{VolumeId: "vol-1"}},
+	}
+	s.backend.blockDevices = []state.BlockDeviceInfo{
+		{DeviceName: "sdb", MountPoint: "/srv/data"},
+		{DeviceName: "sdc"},
+	}
+	results, err := s.facade.MountedVolumeAttachments(params.Entities{
+		Entities: []params.Entity{
+			{Tag: "machine-0"},
+			{Tag: "machine-1"},
+		},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, jc.DeepEquals, params.MountedVolumeAttachmentsResults{
+		Results: []params.MountedVolumeAttachmentsResult{
+			{Error: apiservertesting.ErrUnauthorized},
+			{Attachments: []params.MountedVolumeAttachment{{
+				VolumeTag:  "volume-0",
+				MachineTag: "machine-1",
+				MountPoint: "/srv/data",
+			}}},
+		},
+	})
+	s.backend.stub.CheckCalls(c, []jujutesting.StubCall{
+		{"MachineVolumeAttachments", []interface{}{names.NewMachineTag("1")}},
+		{"Volume", []interface{}{names.NewVolumeTag("0")}},
+		{"BlockDevices", []interface{}{names.NewMachineTag("1")}},
+		{"Volume", []interface{}{names.NewVolumeTag("1")}},
+	})
+}
+
+func (s *facadeSuite) TestSetVolumeAttachmentUsage(c *gc.C) {
+	results, err := s.facade.SetVolumeAttachmentUsage(params.VolumeAttachmentUsages{
+		Usages: []params.VolumeAttachmentUsage{{
+			VolumeTag:  "volume-0",
+			MachineTag: "machine-1",
+			Usage: params.FilesystemUsage{
+				Size:      100,
+				Used:      95,
+				Available: 5,
+			},
+		}, {
+			VolumeTag:  "volume-1",
+			MachineTag: "machine-0",
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results, jc.DeepEquals, []params.ErrorResult{
+		{},
+		{Error: apiservertesting.ErrUnauthorized},
+	})
+	s.backend.stub.CheckCalls(c, []jujutesting.StubCall{
+		{"SetVolumeAttachmentUsage", []interface{}{
+			names.NewMachineTag("1"),
+			names.NewVolumeTag("0"),
+			state.FilesystemUsage{
+				Size:      100,
+				Used:      95,
+				Available: 5,
+			},
+		}},
+	})
+}
+
+type mockBackend struct {
+	stub              jujutesting.Stub
+	attachments       []state.FilesystemAttachment
+	volumeAttachments []state.VolumeAttachment
+	volumes           map[names.VolumeTag]*mockVolume
+	blockDevices      []state.BlockDeviceInfo
+}
+
+func (b *mockBackend) MachineFilesystemAttachments(tag names.MachineTag) ([]state.FilesystemAttachment, error) {
+	b.stub.AddCall("MachineFilesystemAttachments", tag)
+	if err := b.stub.NextErr(); err != nil {
+		return nil, err
+	}
+	return b.attachments, nil
+}
+
+func (b *mockBackend) SetFilesystemAttachmentUsage(m names.MachineTag, f names.FilesystemTag, usage state.FilesystemUsage) error {
+	b.stub.AddCall("SetFilesystemAttachmentUsage", m, f, usage)
+	return b.stub.NextErr()
+}
+
+func (b *mockBackend) MachineVolumeAttachments(tag names.MachineTag) ([]state.VolumeAttachment, error) {
+	b.stub.AddCall("MachineVolumeAttachments", tag)
+	if err := b.stub.NextErr(); err != nil {
+		return nil, err
+	}
+	return b.volumeAttachments, nil
+}
+
+func (b *mockBackend) Volume(tag names.VolumeTag) (state.Volume, error) {
+	b.stub.AddCall("Volume", tag)
+	if err := b.stub.NextErr(); err != nil {
+		return nil, err
+	}
+	v, ok := b.volumes[tag]
+	if !ok {
+		return nil, errors.NotFoundf("volume %q", tag.Id())
+	}
+	return v, nil
+}
+
+func (b *mockBackend) BlockDevices(tag names.MachineTag) ([]state.BlockDeviceInfo, error) {
+	b.stub.AddCall("BlockDevices", tag)
+	if err := b.stub.NextErr(); err != nil {
+		return nil, err
+	}
+	return b.blockDevices, nil
+}
+
+func (b *mockBackend) SetVolumeAttachmentUsage(m names.MachineTag, v names.VolumeTag, usage state.FilesystemUsage) error {
+	b.stub.AddCall("SetVolumeAttachmentUsage", m, v, usage)
+	return b.stub.NextErr()
+}
+
+type mockVolume struct {
+	state.Volume
+	info *state.VolumeInfo
+}
+
+func (v *mockVolume) Info() (state.VolumeInfo, error) {
+	if v.info == nil {
+		return state.VolumeInfo{}, errors.NotProvisionedf("volume")
+	}
+	return *v.info, nil
+}
+
+type mockVolumeAttachment struct {
+	state.VolumeAttachment
+	volume  names.VolumeTag
+	machine names.MachineTag
+	life    state.Life
+	info    *state.VolumeAttachmentInfo
+}
+
+func (a *mockVolumeAttachment) Volume() names.VolumeTag {
+	return a.volume
+}
+
+func (a *mockVolumeAttachment) Machine() names.MachineTag {
+	return a.machine
+}
+
+func (a *mockVolumeAttachment) Life() state.Life {
+	return a.life
+}
+
+func (a *mockVolumeAttachment) Info() (state.VolumeAttachmentInfo, error) {
+	if a.info == nil {
+		return state.VolumeAttachmentInfo{}, errors.NotProvisionedf("volume attachment")
+	}
+	return *a.info, nil
+}
+
+type mockFilesystemAttachment struct {
+	state.FilesystemAttachment
+	filesystem names.FilesystemTag
+	machine    names.MachineTag
+	life       state.Life
+	info       *state.FilesystemAttachmentInfo
+}
+
+func (a *mockFilesystemAttachment) Filesystem() names.FilesystemTag {
+	return a.filesystem
+}
+
+func (a *mockFilesystemAttachment) Machine() names.MachineTag {
+	return a.machine
+}
+
+func (a *mockFilesystemAttachment) Life() state.Life {
+	return a.life
+}
+
+func (a *mockFilesystemAttachment) Info() (state.FilesystemAttachmentInfo, error) {
+	if a.info == nil {
+		return state.FilesystemAttachmentInfo{}, errors.NotProvisionedf("filesystem attachment")
+	}
+	return *a.info, nil
+}
@@ -9,10 +9,11 @@ import (
 	"github.com/juju/juju/state"
 )
 
-// Backend provides access to filesystems and volumes for the
-// filesystem watchers to use.
+// Backend provides access to filesystems, volumes and storage
+// instances for the filesystem watchers to use.
 type Backend interface {
 	Filesystem(names.FilesystemTag) (state.Filesystem, error)
+	StorageInstance(names.StorageTag) (state.StorageInstance, error)
 	VolumeAttachment(names.MachineTag, names.VolumeTag) (state.VolumeAttachment, error)
 	WatchMachineFilesystems(names.MachineTag) state.StringsWatcher
 	WatchMachineFilesystemAttachments(names.MachineTag) state.StringsWatcher
@@ -19,6 +19,7 @@ type mockBackend struct {
 	modelVolumeAttachmentsW       *watchertest.StringsWatcher
 
 	filesystems               map[string]*mockFilesystem
+	storageInstances          map[string]*mockStorageInstance
 	volumeAttachments         map[string]*mockVolumeAttachment
 	volumeAttachmentRequested chan names.VolumeTag
 }
@@ -30,6 +31,13 @@ func (b *mockBackend) Filesystem(tag names.FilesystemTag) (state.Filesystem, err
 	return nil, errors.NotFoundf("filesystem %s", tag.Id())
 }
 
+func (b *mockBackend) StorageInstance(tag names.StorageTag) (state.StorageInstance, error) {
+	if si, ok := b.storageInstances[tag.Id()]; ok {
+		return si, nil
+	}
+	return nil, errors.NotFoundf("storage instance %s", tag.Id())
+}
+
 func (b *mockBackend) VolumeAttachment(m names.MachineTag, v names.VolumeTag) (state.VolumeAttachment, error) {
 	if m.Id() != "0" {
 		// The tests all operate on machine "0", and the watchers
@@ -78,7 +86,15 @@ func newStringsWatcher() *watchertest.StringsWatcher {
 
 type mockFilesystem struct {
 	state.Filesystem
-	volume names.VolumeTag
+	volume  names.VolumeTag
+	storage names.StorageTag
+}
+
+func (f *mockFilesystem) Storage() (names.StorageTag, error) {
+	if f.storage == (names.StorageTag{}) {
+		return names.StorageTag{}, errors.NotAssignedf("filesystem")
+	}
+	return f.storage, nil
 }
 
 func (f *mockFilesystem) Volume() (names.VolumeTag, error) {
@@ -88,6 +104,15 @@ func (f *mockFilesystem) Volume() (names.VolumeTag, error) {
 	return f.volume, nil
 }
 
+type mockStorageInstance struct {
+	state.StorageInstance
+	owner names.Tag
+}
+
+func (si *mockStorageInstance) Owner() (names.Tag, bool) {
+	return si.owner, si.owner != nil
+}
+
 type mockVolumeAttachment struct {
 	state.VolumeAttachment
 	life state.Life
@@ -9,6 +9,7 @@ import (
 	"gopkg.in/juju/names.v2"
 	"gopkg.in/tomb.v1"
 
+	"github.com/juju/juju/apiserver/common/storagecommon"
 	"github.com/juju/juju/state"
 	"github.com/juju/juju/state/watcher"
 )
@@ -19,6 +20,10 @@ import (
 // model-scoped filesystems that have no backing volume. The machine-level worker
 // watches both machine-scoped filesystems, and model-scoped filesystems whose
 // backing volumes are attached to the machine.
+//
+// Shared filesystems are created by the model-level worker, but must be
+// mounted on each machine they are attached to, so their attachments are
+// managed by the machine-level worker.
 type Watchers struct {
 	Backend Backend
 }
@@ -198,7 +203,8 @@ func (w *machineFilesystemsWatcher) modelVolumeAttachmentChanged(volumeTag names
 // WatchModelManagedFilesystemAttachments returns a strings watcher that
 // reports lifecycle changes to attachments of model-scoped filesystem that
 // have no backing volume. Volume-backed filesystems are always managed by
-// the machine to which they are attached.
+// the machine to which they are attached, as are attachments of shared
+// filesystems.
 func (fw Watchers) WatchModelManagedFilesystemAttachments() state.StringsWatcher {
 	return newFilteredStringsWatcher(fw.Backend.WatchModelFilesystemAttachments(), func(id string) (bool, error) {
 		_, filesystemTag, err := state.ParseFilesystemAttachmentId(id)
@@ -211,15 +217,21 @@ func (fw Watchers) WatchModelManagedFilesystemAttachments() state.StringsWatcher
 		} else if err != nil {
 			return false, errors.Trace(err)
 		}
-		_, err = f.Volume()
-		return err == state.ErrNoBackingVolume, nil
+		if _, err := f.Volume(); err != state.ErrNoBackingVolume {
+			return false, nil
+		}
+		shared, err := storagecommon.IsSharedFilesystem(f, fw.Backend.StorageInstance)
+		if err != nil {
+			return false, errors.Trace(err)
+		}
+		return !shared, nil
 	})
 }
 
 // WatchMachineManagedFilesystemAttachments returns a strings watcher that
 // reports lifecycle change sfor attachments to both machine-scoped filesystems,
-// and model-scoped, volume-backed filesystems that are attached to the
-// specified machine.
+// and model-scoped, volume-backed or shared filesystems that are attached to
+// the specified machine.
 func (fw Watchers) WatchMachineManagedFilesystemAttachments(m names.MachineTag) state.StringsWatcher {
 	w := &machineFilesystemAttachmentsWatcher{
 		stringsWatcherBase: stringsWatcherBase{out: make(chan []string)},
@@ -346,7 +358,15 @@ func (w *machineFilesystemAttachmentsWatcher) modelFilesystemAttachmentChanged(
 	}
 	volumeTag, err := filesystem.Volume()
 	if err == state.ErrNoBackingVolume {
-		// Filesystem has no backing volume: nothing more to do.
+		// Filesystem has no backing volume: only shared
+		// filesystems are managed by the machine.
+		shared, err := storagecommon.IsSharedFilesystem(filesystem, w.backend.StorageInstance)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		if shared {
+			w.changes.Add(filesystemAttachmentId)
+		}
 		return nil
 	} else if err != nil {
 		return errors.Annotate(err, "getting filesystem volume")
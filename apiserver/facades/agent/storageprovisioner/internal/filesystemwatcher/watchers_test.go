@@ -38,6 +38,16 @@ func (s *WatchersSuite) SetUpTest(c *gc.C) {
 			"1": {volume: names.NewVolumeTag("1")},
 			// filesystem 2 is backed by volume 2.
 			"2": {volume: names.NewVolumeTag("2")},
+			// filesystem 4 has no backing volume, and is
+			// assigned to shared storage.
+			"4": {storage: names.NewStorageTag("shared/0")},
+			// filesystem 5 has no backing volume, and is
+			// assigned to unit storage.
+			"5": {storage: names.NewStorageTag("data/1")},
+		},
+		storageInstances: map[string]*mockStorageInstance{
+			"shared/0": {owner: names.NewApplicationTag("mariadb")},
+			"data/1":   {owner: names.NewUnitTag("mariadb/0")},
 		},
 		volumeAttachments: map[string]*mockVolumeAttachment{
 			"1": {life: state.Alive},
@@ -83,6 +93,18 @@ func (s *WatchersSuite) TestWatchModelManagedFilesystemAttachments(c *gc.C) {
 	wc.AssertNoChange()
 }
 
+func (s *WatchersSuite) TestWatchModelManagedFilesystemAttachmentsShared(c *gc.C) {
+	w := s.watchers.WatchModelManagedFilesystemAttachments()
+	defer statetesting.AssertKillAndWait(c, w)
+	s.backend.modelFilesystemAttachmentsW.C <- []string{"0:4", "0:5"}
+
+	// Filesystem 4 is shared, so its attachments are managed
+	// by the machines, and should not be reported.
+	wc := statetesting.NewStringsWatcherC(c, nopSyncStarter{}, w)
+	wc.AssertChangeInSingleEvent("0:5")
+	wc.AssertNoChange()
+}
+
 func (s *WatchersSuite) TestWatchModelManagedFilesystemAttachmentsWatcherErrorsPropagate(c *gc.C) {
 	w := s.watchers.WatchModelManagedFilesystemAttachments()
 	s.backend.modelFilesystemAttachmentsW.T.Kill(errors.New("rah"))
@@ -179,6 +201,20 @@ func (s *WatchersSuite) TestWatchMachineManagedFilesystemAttachments(c *gc.C) {
 	wc.AssertNoChange()
 }
 
+func (s *WatchersSuite) TestWatchMachineManagedFilesystemAttachmentsShared(c *gc.C) {
+	w := s.watchers.WatchMachineManagedFilesystemAttachments(names.NewMachineTag("0"))
+	defer statetesting.AssertKillAndWait(c, w)
+	s.backend.modelFilesystemAttachmentsW.C <- []string{"0:4", "0:5", "1:4"}
+	s.backend.machineFilesystemAttachmentsW.C <- []string{}
+	s.backend.modelVolumeAttachmentsW.C <- []string{}
+
+	// Only the attachment of shared filesystem 4 to
+	// machine 0 should be reported.
+	wc := statetesting.NewStringsWatcherC(c, nopSyncStarter{}, w)
+	wc.AssertChangeInSingleEvent("0:4")
+	wc.AssertNoChange()
+}
+
 func (s *WatchersSuite) TestWatchMachineManagedFilesystemAttachmentsErrorsPropagate(c *gc.C) {
 	w := s.watchers.WatchMachineManagedFilesystemAttachments(names.NewMachineTag("0"))
 	s.backend.modelFilesystemAttachmentsW.T.Kill(errors.New("rah"))
@@ -21,6 +21,16 @@ import (
 
 var logger = loggo.GetLogger("juju.apiserver.storageprovisioner")
 
+// StorageProvisionerAPIv6 provides the StorageProvisioner API v6 facade.
+type StorageProvisionerAPIv6 struct {
+	*StorageProvisionerAPIv5
+}
+
+// StorageProvisionerAPIv5 provides the StorageProvisioner API v5 facade.
+type StorageProvisionerAPIv5 struct {
+	*StorageProvisionerAPIv4
+}
+
 // StorageProvisionerAPIv4 provides the StorageProvisioner API v4 facade.
 type StorageProvisionerAPIv4 struct {
 	*StorageProvisionerAPIv3
@@ -45,6 +55,16 @@ type StorageProvisionerAPIv3 struct {
 	getAttachmentAuthFunc    func() (func(names.MachineTag, names.Tag) bool, error)
 }
 
+// NewStorageProvisionerAPIv6 creates a new server-side StorageProvisioner v6 facade.
+func NewStorageProvisionerAPIv6(v5 *StorageProvisionerAPIv5) *StorageProvisionerAPIv6 {
+	return &StorageProvisionerAPIv6{v5}
+}
+
+// NewStorageProvisionerAPIv5 creates a new server-side StorageProvisioner v5 facade.
+func NewStorageProvisionerAPIv5(v4 *StorageProvisionerAPIv4) *StorageProvisionerAPIv5 {
+	return &StorageProvisionerAPIv5{v4}
+}
+
 // NewStorageProvisionerAPIv4 creates a new server-side StorageProvisioner v4 facade.
 func NewStorageProvisionerAPIv4(v3 *StorageProvisionerAPIv3) *StorageProvisionerAPIv4 {
 	return &StorageProvisionerAPIv4{v3}
@@ -125,6 +145,21 @@ func NewStorageProvisionerAPIv3(
 				}
 			} else if err != state.ErrNoBackingVolume {
 				return false
+			} else if !authorizer.AuthController() {
+				// The filesystem is model-scoped with no backing
+				// volume, and may be shared between machines. If
+				// the authenticated agent has access to any of the
+				// machines that the filesystem is attached to, then
+				// it may access the filesystem.
+				filesystemAttachments, err := st.FilesystemAttachments(tag)
+				if err != nil {
+					return false
+				}
+				for _, a := range filesystemAttachments {
+					if canAccessStorageMachine(a.Machine(), false) {
+						return true
+					}
+				}
 			}
 			return authorizer.AuthController()
 		case names.MachineTag:
@@ -287,6 +322,20 @@ func (s *StorageProvisionerAPIv3) WatchVolumes(args params.Entities) (params.Str
 	return s.watchStorageEntities(args, s.st.WatchModelVolumes, s.st.WatchMachineVolumes)
 }
 
+// WatchVolumeResizes watches for changes to volumes scoped to the
+// entity with the tag passed to NewState, including requests to
+// resize them.
+func (s *StorageProvisionerAPIv5) WatchVolumeResizes(args params.Entities) (params.StringsWatchResults, error) {
+	return s.watchStorageEntities(args, s.st.WatchModelVolumeResizes, s.st.WatchMachineVolumeResizes)
+}
+
+// WatchVolumeSnapshots watches for changes to snapshots of volumes
+// scoped to the entity with the tag passed to NewState. The watchers
+// report the IDs of the snapshots.
+func (s *StorageProvisionerAPIv6) WatchVolumeSnapshots(args params.Entities) (params.StringsWatchResults, error) {
+	return s.watchStorageEntities(args, s.st.WatchModelVolumeSnapshots, s.st.WatchMachineVolumeSnapshots)
+}
+
 // WatchFilesystems watches for changes to filesystems scoped
 // to the entity with the tag passed to NewState.
 func (s *StorageProvisionerAPIv3) WatchFilesystems(args params.Entities) (params.StringsWatchResults, error) {
@@ -612,6 +661,17 @@ func (s *StorageProvisionerAPIv3) VolumeParams(args params.Entities) (params.Vol
 		if err != nil {
 			return params.VolumeParams{}, err
 		}
+		if stateVolumeParams, ok := volume.Params(); ok && stateVolumeParams.Snapshot != "" {
+			snapshot, err := s.st.VolumeSnapshot(stateVolumeParams.Snapshot)
+			if err != nil {
+				return params.VolumeParams{}, err
+			}
+			snapshotInfo, err := snapshot.Info()
+			if err != nil {
+				return params.VolumeParams{}, err
+			}
+			volumeParams.SnapshotId = snapshotInfo.SnapshotId
+		}
 		if len(volumeAttachments) == 1 {
 			// There is exactly one attachment to be made, so make
 			// it immediately. Otherwise we will defer attachments
@@ -713,6 +773,228 @@ func (s *StorageProvisionerAPIv4) RemoveVolumeParams(args params.Entities) (para
 	return results, nil
 }
 
+// VolumeResizeParams returns the parameters for growing the volumes
+// with the specified tags. A NotFound error is returned for volumes
+// that do not exist, are not alive, or have no pending resize.
+func (s *StorageProvisionerAPIv5) VolumeResizeParams(args params.Entities) (params.VolumeResizeParamsResults, error) {
+	canAccess, err := s.getStorageEntityAuthFunc()
+	if err != nil {
+		return params.VolumeResizeParamsResults{}, err
+	}
+	results := params.VolumeResizeParamsResults{
+		Results: make([]params.VolumeResizeParamsResult, len(args.Entities)),
+	}
+	one := func(arg params.Entity) (params.VolumeResizeParams, error) {
+		tag, err := names.ParseVolumeTag(arg.Tag)
+		if err != nil || !canAccess(tag) {
+			return params.VolumeResizeParams{}, common.ErrPerm
+		}
+		// Volumes may be removed after the resize watcher reports
+		// them, so a missing volume is reported as NotFound rather
+		// than as a permission error.
+		volume, err := s.st.Volume(tag)
+		if err != nil {
+			return params.VolumeResizeParams{}, err
+		}
+		size, ok := volume.RequestedSize()
+		if !ok || volume.Life() != state.Alive {
+			return params.VolumeResizeParams{}, errors.NotFoundf(
+				"pending resize for %s", names.ReadableString(tag),
+			)
+		}
+		volumeInfo, err := volume.Info()
+		if err != nil {
+			return params.VolumeResizeParams{}, err
+		}
+		provider, cfg, err := storagecommon.StoragePoolConfig(
+			volumeInfo.Pool, s.poolManager, s.registry,
+		)
+		if err != nil {
+			return params.VolumeResizeParams{}, err
+		}
+		return params.VolumeResizeParams{
+			VolumeTag:  tag.String(),
+			VolumeId:   volumeInfo.VolumeId,
+			Provider:   string(provider),
+			Attributes: cfg.Attrs(),
+			Size:       size,
+		}, nil
+	}
+	for i, arg := range args.Entities {
+		var result params.VolumeResizeParamsResult
+		resizeParams, err := one(arg)
+		if err != nil {
+			result.Error = common.ServerError(err)
+		} else {
+			result.Result = resizeParams
+		}
+		results.Results[i] = result
+	}
+	return results, nil
+}
+
+// VolumeSnapshotParams returns the parameters for taking or restoring
+// the volume snapshots with the specified IDs. A NotFound error is
+// returned for snapshots that do not exist, have nothing pending, or
+// whose volumes are not alive and provisioned.
+func (s *StorageProvisionerAPIv6) VolumeSnapshotParams(args params.VolumeSnapshotIds) (params.VolumeSnapshotParamsResults, error) {
+	canAccess, err := s.getStorageEntityAuthFunc()
+	if err != nil {
+		return params.VolumeSnapshotParamsResults{}, err
+	}
+	results := params.VolumeSnapshotParamsResults{
+		Results: make([]params.VolumeSnapshotParamsResult, len(args.Ids)),
+	}
+	one := func(id string) (params.VolumeSnapshotParams, error) {
+		// Snapshots may be removed after the snapshot watcher
+		// reports them, so a missing snapshot is reported as
+		// NotFound rather than as a permission error.
+		snapshot, err := s.st.VolumeSnapshot(id)
+		if err != nil {
+			return params.VolumeSnapshotParams{}, err
+		}
+		volumeTag := snapshot.Volume()
+		if !canAccess(volumeTag) {
+			return params.VolumeSnapshotParams{}, common.ErrPerm
+		}
+		notPending := errors.NotFoundf("pending operation for volume snapshot %q", id)
+		snapshotInfo, err := snapshot.Info()
+		if err == nil && !snapshot.Restoring() {
+			return params.VolumeSnapshotParams{}, notPending
+		} else if err != nil && !errors.IsNotProvisioned(err) {
+			return params.VolumeSnapshotParams{}, err
+		}
+		volume, err := s.st.Volume(volumeTag)
+		if errors.IsNotFound(err) {
+			return params.VolumeSnapshotParams{}, notPending
+		} else if err != nil {
+			return params.VolumeSnapshotParams{}, err
+		}
+		if volume.Life() != state.Alive {
+			return params.VolumeSnapshotParams{}, notPending
+		}
+		volumeInfo, err := volume.Info()
+		if errors.IsNotProvisioned(err) {
+			return params.VolumeSnapshotParams{}, notPending
+		} else if err != nil {
+			return params.VolumeSnapshotParams{}, err
+		}
+		provider, _, err := storagecommon.StoragePoolConfig(
+			volumeInfo.Pool, s.poolManager, s.registry,
+		)
+		if err != nil {
+			return params.VolumeSnapshotParams{}, err
+		}
+		return params.VolumeSnapshotParams{
+			Id:         id,
+			VolumeTag:  volumeTag.String(),
+			VolumeId:   volumeInfo.VolumeId,
+			Provider:   string(provider),
+			SnapshotId: snapshotInfo.SnapshotId,
+			Restore:    snapshot.Restoring(),
+		}, nil
+	}
+	for i, id := range args.Ids {
+		var result params.VolumeSnapshotParamsResult
+		snapshotParams, err := one(id)
+		if err != nil {
+			result.Error = common.ServerError(err)
+		} else {
+			result.Result = snapshotParams
+		}
+		results.Results[i] = result
+	}
+	return results, nil
+}
+
+// canAccessVolumeSnapshot returns common.ErrPerm if the snapshot with
+// the specified ID does not exist, or if the authenticated agent may
+// not access its volume.
+func (s *StorageProvisionerAPIv6) canAccessVolumeSnapshot(id string, canAccess common.AuthFunc) error {
+	snapshot, err := s.st.VolumeSnapshot(id)
+	if errors.IsNotFound(err) {
+		return common.ErrPerm
+	} else if err != nil {
+		return errors.Trace(err)
+	}
+	if !canAccess(snapshot.Volume()) {
+		return common.ErrPerm
+	}
+	return nil
+}
+
+// SetVolumeSnapshotInfo records the details of newly taken
+// volume snapshots.
+func (s *StorageProvisionerAPIv6) SetVolumeSnapshotInfo(args params.VolumeSnapshots) (params.ErrorResults, error) {
+	canAccess, err := s.getStorageEntityAuthFunc()
+	if err != nil {
+		return params.ErrorResults{}, err
+	}
+	results := params.ErrorResults{
+		Results: make([]params.ErrorResult, len(args.Snapshots)),
+	}
+	one := func(arg params.VolumeSnapshot) error {
+		if err := s.canAccessVolumeSnapshot(arg.Id, canAccess); err != nil {
+			return err
+		}
+		return s.st.SetVolumeSnapshotInfo(arg.Id, state.VolumeSnapshotInfo{
+			SnapshotId: arg.SnapshotId,
+			Size:       arg.Size,
+		})
+	}
+	for i, arg := range args.Snapshots {
+		err := one(arg)
+		results.Results[i].Error = common.ServerError(err)
+	}
+	return results, nil
+}
+
+// SetVolumeSnapshotMessages records messages against volume
+// snapshots, describing why they could not be taken or restored.
+func (s *StorageProvisionerAPIv6) SetVolumeSnapshotMessages(args params.VolumeSnapshotMessages) (params.ErrorResults, error) {
+	canAccess, err := s.getStorageEntityAuthFunc()
+	if err != nil {
+		return params.ErrorResults{}, err
+	}
+	results := params.ErrorResults{
+		Results: make([]params.ErrorResult, len(args.Messages)),
+	}
+	one := func(arg params.VolumeSnapshotMessage) error {
+		if err := s.canAccessVolumeSnapshot(arg.Id, canAccess); err != nil {
+			return err
+		}
+		return s.st.SetVolumeSnapshotMessage(arg.Id, arg.Message)
+	}
+	for i, arg := range args.Messages {
+		err := one(arg)
+		results.Results[i].Error = common.ServerError(err)
+	}
+	return results, nil
+}
+
+// SetVolumeSnapshotsRestored records that the volumes have been
+// restored from the volume snapshots with the specified IDs.
+func (s *StorageProvisionerAPIv6) SetVolumeSnapshotsRestored(args params.VolumeSnapshotIds) (params.ErrorResults, error) {
+	canAccess, err := s.getStorageEntityAuthFunc()
+	if err != nil {
+		return params.ErrorResults{}, err
+	}
+	results := params.ErrorResults{
+		Results: make([]params.ErrorResult, len(args.Ids)),
+	}
+	one := func(id string) error {
+		if err := s.canAccessVolumeSnapshot(id, canAccess); err != nil {
+			return err
+		}
+		return s.st.SetVolumeSnapshotRestored(id)
+	}
+	for i, id := range args.Ids {
+		err := one(id)
+		results.Results[i].Error = common.ServerError(err)
+	}
+	return results, nil
+}
+
 // FilesystemParams returns the parameters for creating the filesystems
 // with the specified tags.
 func (s *StorageProvisionerAPIv3) FilesystemParams(args params.Entities) (params.FilesystemParamsResults, error) {
@@ -964,6 +1246,10 @@ func (s *StorageProvisionerAPIv3) FilesystemAttachmentParams(
 			location = filesystemAttachmentInfo.MountPoint
 			readOnly = filesystemAttachmentInfo.ReadOnly
 		}
+		shared, err := storagecommon.IsSharedFilesystem(filesystem, s.st.StorageInstance)
+		if err != nil {
+			return params.FilesystemAttachmentParams{}, errors.Trace(err)
+		}
 		return params.FilesystemAttachmentParams{
 			filesystemAttachment.Filesystem().String(),
 			filesystemAttachment.Machine().String(),
@@ -975,6 +1261,7 @@ func (s *StorageProvisionerAPIv3) FilesystemAttachmentParams(
 			// parts of the codebase.
 			location,
 			readOnly,
+			shared,
 		}, nil
 	}
 	for i, arg := range args.Ids {
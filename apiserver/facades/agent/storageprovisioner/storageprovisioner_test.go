@@ -37,7 +37,7 @@ type provisionerSuite struct {
 	factory    *factory.Factory
 	resources  *common.Resources
 	authorizer *apiservertesting.FakeAuthorizer
-	api        *storageprovisioner.StorageProvisionerAPIv4
+	api        *storageprovisioner.StorageProvisionerAPIv6
 }
 
 func (s *provisionerSuite) SetUpTest(c *gc.C) {
@@ -63,7 +63,9 @@ func (s *provisionerSuite) SetUpTest(c *gc.C) {
 	c.Assert(err, jc.ErrorIsNil)
 	v3, err := storageprovisioner.NewStorageProvisionerAPIv3(backend, s.resources, s.authorizer, registry, pm)
 	c.Assert(err, jc.ErrorIsNil)
-	s.api = storageprovisioner.NewStorageProvisionerAPIv4(v3)
+	s.api = storageprovisioner.NewStorageProvisionerAPIv6(
+		storageprovisioner.NewStorageProvisionerAPIv5(storageprovisioner.NewStorageProvisionerAPIv4(v3)),
+	)
 }
 
 func (s *provisionerSuite) TestNewStorageProvisionerAPINonMachine(c *gc.C) {
@@ -903,6 +905,291 @@ func (s *provisionerSuite) TestWatchVolumes(c *gc.C) {
 	wc.AssertNoChange()
 }
 
+func (s *provisionerSuite) TestWatchVolumeResizes(c *gc.C) {
+	s.setupVolumes(c)
+	c.Assert(s.resources.Count(), gc.Equals, 0)
+
+	args := params.Entities{Entities: []params.Entity{
+		{"machine-0"},
+		{s.IAASModel.ModelTag().String()},
+		{"machine-42"}},
+	}
+	result, err := s.api.WatchVolumeResizes(args)
+	c.Assert(err, jc.ErrorIsNil)
+	sort.Strings(result.Results[1].Changes)
+	c.Assert(result, jc.DeepEquals, params.StringsWatchResults{
+		Results: []params.StringsWatchResult{
+			{StringsWatcherId: "1", Changes: []string{"0/0"}},
+			{StringsWatcherId: "2", Changes: []string{"1", "2", "3", "4"}},
+			{Error: apiservertesting.ErrUnauthorized},
+		},
+	})
+
+	c.Assert(s.resources.Count(), gc.Equals, 2)
+	v0Watcher := s.resources.Get("1")
+	defer statetesting.AssertStop(c, v0Watcher)
+	v1Watcher := s.resources.Get("2")
+	defer statetesting.AssertStop(c, v1Watcher)
+
+	wc := statetesting.NewStringsWatcherC(c, s.State, v1Watcher.(state.StringsWatcher))
+	wc.AssertNoChange()
+	err = s.IAASModel.ResizeVolume(names.NewVolumeTag("2"), 8192)
+	c.Assert(err, jc.ErrorIsNil)
+	wc.AssertChangeInSingleEvent("2")
+}
+
+func (s *provisionerSuite) TestVolumeResizeParams(c *gc.C) {
+	s.setupVolumes(c)
+	err := s.IAASModel.ResizeVolume(names.NewVolumeTag("2"), 8192)
+	c.Assert(err, jc.ErrorIsNil)
+
+	results, err := s.api.VolumeResizeParams(params.Entities{
+		Entities: []params.Entity{
+			{"volume-2"},
+			{"volume-0-0"},
+			{"volume-1"},
+			{"volume-42"},
+		},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, jc.DeepEquals, params.VolumeResizeParamsResults{
+		Results: []params.VolumeResizeParamsResult{
+			{Result: params.VolumeResizeParams{
+				VolumeTag: "volume-2",
+				VolumeId:  "def",
+				Provider:  "modelscoped",
+				Size:      8192,
+			}},
+			{Error: &params.Error{
+				Code:    params.CodeNotFound,
+				Message: "pending resize for volume 0/0 not found",
+			}},
+			{Error: &params.Error{
+				Code:    params.CodeNotFound,
+				Message: "pending resize for volume 1 not found",
+			}},
+			{Error: &params.Error{
+				Code:    params.CodeNotFound,
+				Message: `volume "42" not found`,
+			}},
+		},
+	})
+}
+
+func (s *provisionerSuite) TestSetVolumeInfoResized(c *gc.C) {
+	s.setupVolumes(c)
+	err := s.IAASModel.ResizeVolume(names.NewVolumeTag("2"), 8192)
+	c.Assert(err, jc.ErrorIsNil)
+
+	results, err := s.api.SetVolumeInfo(params.Volumes{
+		Volumes: []params.Volume{{
+			VolumeTag: "volume-2",
+			Info: params.VolumeInfo{
+				VolumeId:   "def",
+				HardwareId: "456",
+				Size:       8192,
+			},
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, jc.DeepEquals, params.ErrorResults{
+		Results: []params.ErrorResult{{}},
+	})
+
+	volume, err := s.IAASModel.Volume(names.NewVolumeTag("2"))
+	c.Assert(err, jc.ErrorIsNil)
+	info, err := volume.Info()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(info.Size, gc.Equals, uint64(8192))
+	c.Assert(info.Pool, gc.Equals, "modelscoped")
+	_, ok := volume.RequestedSize()
+	c.Assert(ok, jc.IsFalse)
+}
+
+func (s *provisionerSuite) TestWatchVolumeSnapshots(c *gc.C) {
+	s.setupVolumes(c)
+	_, err := s.IAASModel.SnapshotVolume(names.NewVolumeTag("2"))
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = s.IAASModel.SnapshotVolume(names.NewVolumeTag("0/0"))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(s.resources.Count(), gc.Equals, 0)
+
+	args := params.Entities{Entities: []params.Entity{
+		{"machine-0"},
+		{s.IAASModel.ModelTag().String()},
+		{"machine-42"}},
+	}
+	result, err := s.api.WatchVolumeSnapshots(args)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result, jc.DeepEquals, params.StringsWatchResults{
+		Results: []params.StringsWatchResult{
+			{StringsWatcherId: "1", Changes: []string{"1"}},
+			{StringsWatcherId: "2", Changes: []string{"0"}},
+			{Error: apiservertesting.ErrUnauthorized},
+		},
+	})
+
+	c.Assert(s.resources.Count(), gc.Equals, 2)
+	v0Watcher := s.resources.Get("1")
+	defer statetesting.AssertStop(c, v0Watcher)
+	v1Watcher := s.resources.Get("2")
+	defer statetesting.AssertStop(c, v1Watcher)
+
+	wc := statetesting.NewStringsWatcherC(c, s.State, v1Watcher.(state.StringsWatcher))
+	wc.AssertNoChange()
+	_, err = s.IAASModel.SnapshotVolume(names.NewVolumeTag("2"))
+	c.Assert(err, jc.ErrorIsNil)
+	wc.AssertChangeInSingleEvent("2")
+}
+
+func (s *provisionerSuite) TestVolumeSnapshotParams(c *gc.C) {
+	s.setupVolumes(c)
+	for _, tag := range []string{"2", "0/0", "2"} {
+		_, err := s.IAASModel.SnapshotVolume(names.NewVolumeTag(tag))
+		c.Assert(err, jc.ErrorIsNil)
+	}
+	for _, id := range []string{"1", "2"} {
+		err := s.IAASModel.SetVolumeSnapshotInfo(id, state.VolumeSnapshotInfo{
+			SnapshotId: "snap-" + id,
+			Size:       1024,
+		})
+		c.Assert(err, jc.ErrorIsNil)
+	}
+	err := s.IAASModel.RestoreVolume(names.NewVolumeTag("0/0"), "1")
+	c.Assert(err, jc.ErrorIsNil)
+
+	results, err := s.api.VolumeSnapshotParams(params.VolumeSnapshotIds{
+		Ids: []string{"0", "1", "2", "42"},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, jc.DeepEquals, params.VolumeSnapshotParamsResults{
+		Results: []params.VolumeSnapshotParamsResult{
+			{Result: params.VolumeSnapshotParams{
+				Id:        "0",
+				VolumeTag: "volume-2",
+				VolumeId:  "def",
+				Provider:  "modelscoped",
+			}},
+			{Result: params.VolumeSnapshotParams{
+				Id:         "1",
+				VolumeTag:  "volume-0-0",
+				VolumeId:   "abc",
+				Provider:   "machinescoped",
+				SnapshotId: "snap-1",
+				Restore:    true,
+			}},
+			{Error: &params.Error{
+				Code:    params.CodeNotFound,
+				Message: `pending operation for volume snapshot "2" not found`,
+			}},
+			{Error: &params.Error{
+				Code:    params.CodeNotFound,
+				Message: `volume snapshot "42" not found`,
+			}},
+		},
+	})
+}
+
+func (s *provisionerSuite) TestSetVolumeSnapshotInfo(c *gc.C) {
+	s.setupVolumes(c)
+	_, err := s.IAASModel.SnapshotVolume(names.NewVolumeTag("2"))
+	c.Assert(err, jc.ErrorIsNil)
+
+	results, err := s.api.SetVolumeSnapshotInfo(params.VolumeSnapshots{
+		Snapshots: []params.VolumeSnapshot{{
+			Id:         "0",
+			SnapshotId: "snap-0",
+			Size:       4096,
+		}, {
+			Id:         "42",
+			SnapshotId: "snap-42",
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, jc.DeepEquals, params.ErrorResults{
+		Results: []params.ErrorResult{
+			{},
+			{Error: &params.Error{Code: params.CodeUnauthorized, Message: "permission denied"}},
+		},
+	})
+
+	snapshot, err := s.IAASModel.VolumeSnapshot("0")
+	c.Assert(err, jc.ErrorIsNil)
+	info, err := snapshot.Info()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(info, jc.DeepEquals, state.VolumeSnapshotInfo{
+		SnapshotId: "snap-0",
+		Size:       4096,
+	})
+}
+
+func (s *provisionerSuite) TestSetVolumeSnapshotMessages(c *gc.C) {
+	s.setupVolumes(c)
+	_, err := s.IAASModel.SnapshotVolume(names.NewVolumeTag("2"))
+	c.Assert(err, jc.ErrorIsNil)
+
+	results, err := s.api.SetVolumeSnapshotMessages(params.VolumeSnapshotMessages{
+		Messages: []params.VolumeSnapshotMessage{{
+			Id:      "0",
+			Message: "quota exceeded",
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, jc.DeepEquals, params.ErrorResults{
+		Results: []params.ErrorResult{{}},
+	})
+
+	snapshot, err := s.IAASModel.VolumeSnapshot("0")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(snapshot.Message(), gc.Equals, "quota exceeded")
+}
+
+func (s *provisionerSuite) TestSetVolumeSnapshotsRestored(c *gc.C) {
+	s.setupVolumes(c)
+	_, err := s.IAASModel.SnapshotVolume(names.NewVolumeTag("2"))
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.IAASModel.SetVolumeSnapshotInfo("0", state.VolumeSnapshotInfo{SnapshotId: "snap-0"})
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.IAASModel.RestoreVolume(names.NewVolumeTag("2"), "0")
+	c.Assert(err, jc.ErrorIsNil)
+
+	results, err := s.api.SetVolumeSnapshotsRestored(params.VolumeSnapshotIds{
+		Ids: []string{"0"},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, jc.DeepEquals, params.ErrorResults{
+		Results: []params.ErrorResult{{}},
+	})
+
+	snapshot, err := s.IAASModel.VolumeSnapshot("0")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(snapshot.Restoring(), jc.IsFalse)
+}
+
+func (s *provisionerSuite) TestVolumeParamsFromSnapshot(c *gc.C) {
+	s.setupVolumes(c)
+	_, err := s.IAASModel.SnapshotVolume(names.NewVolumeTag("2"))
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.IAASModel.SetVolumeSnapshotInfo("0", state.VolumeSnapshotInfo{
+		SnapshotId: "snap-0",
+		Size:       4096,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	s.factory.MakeMachine(c, &factory.MachineParams{
+		Volumes: []state.MachineVolumeParams{{
+			Volume: state.VolumeParams{Pool: "modelscoped", Size: 4096, Snapshot: "0"},
+		}},
+	})
+
+	results, err := s.api.VolumeParams(params.Entities{
+		Entities: []params.Entity{{"volume-5"}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results, gc.HasLen, 1)
+	c.Assert(results.Results[0].Error, gc.IsNil)
+	c.Assert(results.Results[0].Result.SnapshotId, gc.Equals, "snap-0")
+}
+
 func (s *provisionerSuite) TestWatchVolumeAttachments(c *gc.C) {
 	s.setupVolumes(c)
 	s.factory.MakeMachine(c, nil)
@@ -595,6 +595,10 @@ func (m *mockFilesystem) FilesystemTag() names.FilesystemTag {
 	return m.tag
 }
 
+func (m *mockFilesystem) Volume() (names.VolumeTag, error) {
+	return names.VolumeTag{}, state.ErrNoBackingVolume
+}
+
 type mockStorageInstance struct {
 	state.StorageInstance
 	kind state.StorageKind
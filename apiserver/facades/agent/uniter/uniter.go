@@ -8,6 +8,7 @@ package uniter
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/juju/collections/set"
 	"github.com/juju/errors"
@@ -62,9 +63,36 @@ type UniterAPI struct {
 	cloudSpec       cloudspec.CloudSpecAPI
 }
 
+// UniterAPIV12 doesn't have the HookTimeouts method.
+type UniterAPIV12 struct {
+	UniterAPI
+}
+
+// UniterAPIV11 doesn't have the SetSubWorkloads or RemoveSubWorkloads
+// methods.
+type UniterAPIV11 struct {
+	UniterAPIV12
+}
+
+// UniterAPIV10 doesn't have the ReadApplicationSettings or
+// UpdateApplicationSettings methods.
+type UniterAPIV10 struct {
+	UniterAPIV11
+}
+
+// UniterAPIV9 doesn't have the SetUnitHealth or UnitHealth methods.
+type UniterAPIV9 struct {
+	UniterAPIV10
+}
+
+// UniterAPIV8 doesn't have the RecordHookHistory method.
+type UniterAPIV8 struct {
+	UniterAPIV9
+}
+
 // UniterAPIV7 adds CMR support to NetworkInfo.
 type UniterAPIV7 struct {
-	UniterAPI
+	UniterAPIV8
 }
 
 // UniterAPIV6 adds NetworkInfo as a preferred method to calling NetworkConfig.
@@ -248,14 +276,69 @@ func NewUniterAPI(st *state.State, resources facade.Resources, authorizer facade
 	}, nil
 }
 
+// NewUniterAPIV12 creates an instance of the V12 uniter API.
+func NewUniterAPIV12(st *state.State, resources facade.Resources, authorizer facade.Authorizer) (*UniterAPIV12, error) {
+	uniterAPI, err := NewUniterAPI(st, resources, authorizer)
+	if err != nil {
+		return nil, err
+	}
+	return &UniterAPIV12{
+		UniterAPI: *uniterAPI,
+	}, nil
+}
+
+// NewUniterAPIV11 creates an instance of the V11 uniter API.
+func NewUniterAPIV11(st *state.State, resources facade.Resources, authorizer facade.Authorizer) (*UniterAPIV11, error) {
+	uniterAPI, err := NewUniterAPIV12(st, resources, authorizer)
+	if err != nil {
+		return nil, err
+	}
+	return &UniterAPIV11{
+		UniterAPIV12: *uniterAPI,
+	}, nil
+}
+
+// NewUniterAPIV10 creates an instance of the V10 uniter API.
+func NewUniterAPIV10(st *state.State, resources facade.Resources, authorizer facade.Authorizer) (*UniterAPIV10, error) {
+	uniterAPI, err := NewUniterAPIV11(st, resources, authorizer)
+	if err != nil {
+		return nil, err
+	}
+	return &UniterAPIV10{
+		UniterAPIV11: *uniterAPI,
+	}, nil
+}
+
+// NewUniterAPIV9 creates an instance of the V9 uniter API.
+func NewUniterAPIV9(st *state.State, resources facade.Resources, authorizer facade.Authorizer) (*UniterAPIV9, error) {
+	uniterAPI, err := NewUniterAPIV10(st, resources, authorizer)
+	if err != nil {
+		return nil, err
+	}
+	return &UniterAPIV9{
+		UniterAPIV10: *uniterAPI,
+	}, nil
+}
+
+// NewUniterAPIV8 creates an instance of the V8 uniter API.
+func NewUniterAPIV8(st *state.State, resources facade.Resources, authorizer facade.Authorizer) (*UniterAPIV8, error) {
+	uniterAPI, err := NewUniterAPIV9(st, resources, authorizer)
+	if err != nil {
+		return nil, err
+	}
+	return &UniterAPIV8{
+		UniterAPIV9: *uniterAPI,
+	}, nil
+}
+
 // NewUniterAPIV7 creates an instance of the V7 uniter API.
 func NewUniterAPIV7(st *state.State, resources facade.Resources, authorizer facade.Authorizer) (*UniterAPIV7, error) {
-	uniterAPI, err := NewUniterAPI(st, resources, authorizer)
+	uniterAPI, err := NewUniterAPIV8(st, resources, authorizer)
 	if err != nil {
 		return nil, err
 	}
 	return &UniterAPIV7{
-		UniterAPI: *uniterAPI,
+		UniterAPIV8: *uniterAPI,
 	}, nil
 }
 
@@ -2627,3 +2710,381 @@ func (u *UniterAPI) goalStateUnits(application *state.Application) (params.Units
 
 	return unitsGoalState, nil
 }
+
+// Mask the RecordHookHistory method from the v8 API. The API reflection
+// code in rpc/rpcreflect/type.go:newMethod skips 2-argument methods, so
+// this removes the method as far as the RPC machinery is concerned.
+
+// RecordHookHistory isn't on the v8 API.
+func (u *UniterAPIV8) RecordHookHistory(_, _ struct{}) {}
+
+// RecordHookHistory records operations completed by the uniters of
+// the given units, such as the running of hooks and actions.
+func (u *UniterAPI) RecordHookHistory(args params.RecordHookHistoryArgs) (params.ErrorResults, error) {
+	result := params.ErrorResults{
+		Results: make([]params.ErrorResult, len(args.Args)),
+	}
+	canAccess, err := u.accessUnit()
+	if err != nil {
+		return params.ErrorResults{}, err
+	}
+	for i, arg := range args.Args {
+		err := u.recordHookHistory(canAccess, arg)
+		result.Results[i].Error = common.ServerError(err)
+	}
+	return result, nil
+}
+
+func (u *UniterAPI) recordHookHistory(canAccess common.AuthFunc, arg params.RecordHookHistoryArg) error {
+	tag, err := names.ParseUnitTag(arg.Tag)
+	if err != nil {
+		return err
+	}
+	if !canAccess(tag) {
+		return common.ErrPerm
+	}
+	unit, err := u.getUnit(tag)
+	if err != nil {
+		return err
+	}
+	entry := state.HookHistoryEntry{
+		Operation:  arg.Entry.Operation,
+		Hook:       arg.Entry.Hook,
+		RemoteUnit: arg.Entry.RemoteUnit,
+		Started:    arg.Entry.Started,
+		Completed:  arg.Entry.Completed,
+		Result:     arg.Entry.Result,
+		Error:      arg.Entry.Error,
+	}
+	if arg.RelationId >= 0 {
+		rel, err := u.st.Relation(arg.RelationId)
+		if errors.IsNotFound(err) {
+			// The relation may have been removed since the hook
+			// ran; the history is still worth keeping.
+			entry.Relation = fmt.Sprintf("relation-%d", arg.RelationId)
+		} else if err != nil {
+			return err
+		} else {
+			entry.Relation = rel.String()
+		}
+	}
+	return unit.AddHookHistory(entry)
+}
+
+// SetUnitHealth isn't on the v9 API.
+func (u *UniterAPIV9) SetUnitHealth(_, _ struct{}) {}
+
+// UnitHealth isn't on the v9 API.
+func (u *UniterAPIV9) UnitHealth(_, _ struct{}) {}
+
+// SetUnitHealth records the results of the health checks declared by
+// the charms of the given units.
+func (u *UniterAPI) SetUnitHealth(args params.SetUnitHealthArgs) (params.ErrorResults, error) {
+	result := params.ErrorResults{
+		Results: make([]params.ErrorResult, len(args.Args)),
+	}
+	canAccess, err := u.accessUnit()
+	if err != nil {
+		return params.ErrorResults{}, err
+	}
+	for i, arg := range args.Args {
+		err := u.setUnitHealth(canAccess, arg)
+		result.Results[i].Error = common.ServerError(err)
+	}
+	return result, nil
+}
+
+func (u *UniterAPI) setUnitHealth(canAccess common.AuthFunc, arg params.SetUnitHealthArg) error {
+	tag, err := names.ParseUnitTag(arg.Tag)
+	if err != nil {
+		return err
+	}
+	if !canAccess(tag) {
+		return common.ErrPerm
+	}
+	unit, err := u.getUnit(tag)
+	if err != nil {
+		return err
+	}
+	health := state.UnitHealth{
+		Checks: make([]state.HealthCheckResult, len(arg.Checks)),
+	}
+	for i, check := range arg.Checks {
+		health.Checks[i] = state.HealthCheckResult{
+			Name:     check.Name,
+			Healthy:  check.Healthy,
+			Failures: check.Failures,
+			Message:  check.Message,
+			Since:    check.Since,
+		}
+	}
+	return unit.SetHealth(health)
+}
+
+// UnitHealth returns the results of the health checks declared by the
+// charms of the given units.
+func (u *UniterAPI) UnitHealth(args params.Entities) (params.UnitHealthResults, error) {
+	result := params.UnitHealthResults{
+		Results: make([]params.UnitHealthResult, len(args.Entities)),
+	}
+	canAccess, err := u.accessUnit()
+	if err != nil {
+		return params.UnitHealthResults{}, err
+	}
+	for i, entity := range args.Entities {
+		health, err := u.unitHealth(canAccess, entity.Tag)
+		if err != nil {
+			result.Results[i].Error = common.ServerError(err)
+			continue
+		}
+		result.Results[i].Result = health
+	}
+	return result, nil
+}
+
+func (u *UniterAPI) unitHealth(canAccess common.AuthFunc, tagString string) (*params.UnitHealth, error) {
+	tag, err := names.ParseUnitTag(tagString)
+	if err != nil {
+		return nil, err
+	}
+	if !canAccess(tag) {
+		return nil, common.ErrPerm
+	}
+	unit, err := u.getUnit(tag)
+	if err != nil {
+		return nil, err
+	}
+	health, err := unit.Health()
+	if err != nil {
+		return nil, err
+	}
+	return common.UnitHealth(health), nil
+}
+
+// ReadApplicationSettings isn't on the v10 API.
+func (u *UniterAPIV10) ReadApplicationSettings(_, _ struct{}) {}
+
+// UpdateApplicationSettings isn't on the v10 API.
+func (u *UniterAPIV10) UpdateApplicationSettings(_, _ struct{}) {}
+
+// ReadApplicationSettings returns the settings published by the given
+// applications in the given relations. A unit may read the settings
+// of any application in a relation it belongs to. Only the leader may
+// read its own application's settings, except in a peer relation,
+// where they are published to every unit of the application.
+func (u *UniterAPI) ReadApplicationSettings(args params.RelationApplications) (params.SettingsResults, error) {
+	result := params.SettingsResults{
+		Results: make([]params.SettingsResult, len(args.RelationApplications)),
+	}
+	canAccess, err := u.accessUnit()
+	if err != nil {
+		return params.SettingsResults{}, err
+	}
+	checker := u.st.LeadershipChecker()
+	for i, arg := range args.RelationApplications {
+		settings, err := u.readApplicationSettings(canAccess, checker, arg)
+		if err == nil {
+			result.Results[i].Settings, err = convertRelationSettings(settings)
+		}
+		result.Results[i].Error = common.ServerError(err)
+	}
+	return result, nil
+}
+
+func (u *UniterAPI) readApplicationSettings(
+	canAccess common.AuthFunc, checker leadership.Checker, arg params.RelationApplication,
+) (map[string]interface{}, error) {
+	unitTag, err := names.ParseUnitTag(arg.Unit)
+	if err != nil {
+		return nil, common.ErrPerm
+	}
+	rel, unit, err := u.getRelationAndUnit(canAccess, arg.Relation, unitTag)
+	if err != nil {
+		return nil, err
+	}
+	ep, err := rel.Endpoint(unit.ApplicationName())
+	if err != nil {
+		return nil, common.ErrPerm
+	}
+	if arg.Application == unit.ApplicationName() && ep.Role != charm.RolePeer {
+		token := checker.LeadershipCheck(unit.ApplicationName(), unit.Name())
+		if err := token.Check(nil); err != nil {
+			return nil, errors.Trace(err)
+		}
+	}
+	return rel.ApplicationSettings(arg.Application)
+}
+
+// UpdateApplicationSettings changes the settings published in the
+// given relations by the applications of the given units, which must
+// be their applications' leaders. Keys with empty values are considered
+// a signal to delete these values.
+func (u *UniterAPI) UpdateApplicationSettings(args params.RelationUnitsSettings) (params.ErrorResults, error) {
+	result := params.ErrorResults{
+		Results: make([]params.ErrorResult, len(args.RelationUnits)),
+	}
+	canAccess, err := u.accessUnit()
+	if err != nil {
+		return params.ErrorResults{}, err
+	}
+	checker := u.st.LeadershipChecker()
+	for i, arg := range args.RelationUnits {
+		err := u.updateApplicationSettings(canAccess, checker, arg)
+		result.Results[i].Error = common.ServerError(err)
+	}
+	return result, nil
+}
+
+func (u *UniterAPI) updateApplicationSettings(
+	canAccess common.AuthFunc, checker leadership.Checker, arg params.RelationUnitSettings,
+) error {
+	unitTag, err := names.ParseUnitTag(arg.Unit)
+	if err != nil {
+		return common.ErrPerm
+	}
+	rel, unit, err := u.getRelationAndUnit(canAccess, arg.Relation, unitTag)
+	if err != nil {
+		return err
+	}
+	if _, err := rel.Endpoint(unit.ApplicationName()); err != nil {
+		return common.ErrPerm
+	}
+	token := checker.LeadershipCheck(unit.ApplicationName(), unit.Name())
+	if err := token.Check(nil); err != nil {
+		return errors.Trace(err)
+	}
+	return rel.UpdateApplicationSettings(unit.ApplicationName(), token, arg.Settings)
+}
+
+// SetSubWorkloads isn't on the v11 API.
+func (u *UniterAPIV11) SetSubWorkloads(_, _ struct{}) {}
+
+// RemoveSubWorkloads isn't on the v11 API.
+func (u *UniterAPIV11) RemoveSubWorkloads(_, _ struct{}) {}
+
+// SetSubWorkloads records the versions and statuses reported by the
+// charms of the given units for their sub-workloads.
+func (u *UniterAPI) SetSubWorkloads(args params.SetSubWorkloadArgs) (params.ErrorResults, error) {
+	result := params.ErrorResults{
+		Results: make([]params.ErrorResult, len(args.Args)),
+	}
+	canAccess, err := u.accessUnit()
+	if err != nil {
+		return params.ErrorResults{}, err
+	}
+	for i, arg := range args.Args {
+		err := u.setSubWorkload(canAccess, arg)
+		result.Results[i].Error = common.ServerError(err)
+	}
+	return result, nil
+}
+
+func (u *UniterAPI) setSubWorkload(canAccess common.AuthFunc, arg params.SetSubWorkloadArg) error {
+	tag, err := names.ParseUnitTag(arg.Tag)
+	if err != nil {
+		return err
+	}
+	if !canAccess(tag) {
+		return common.ErrPerm
+	}
+	unit, err := u.getUnit(tag)
+	if err != nil {
+		return err
+	}
+	return unit.SetSubWorkload(arg.Name, arg.Version, status.StatusInfo{
+		Status:  status.Status(arg.Status),
+		Message: arg.Info,
+	})
+}
+
+// HookTimeouts isn't on the v12 API.
+func (u *UniterAPIV12) HookTimeouts(_, _ struct{}) {}
+
+// HookTimeouts returns how long the hooks of the given units may run
+// before they are killed. The hook-timeout set in a unit's application
+// config takes precedence over the model's; zero means no limit.
+func (u *UniterAPI) HookTimeouts(args params.Entities) (params.HookTimeoutResults, error) {
+	result := params.HookTimeoutResults{
+		Results: make([]params.HookTimeoutResult, len(args.Entities)),
+	}
+	canAccess, err := u.accessUnit()
+	if err != nil {
+		return params.HookTimeoutResults{}, err
+	}
+	cfg, err := u.m.ModelConfig()
+	if err != nil {
+		return params.HookTimeoutResults{}, errors.Trace(err)
+	}
+	for i, entity := range args.Entities {
+		timeout, err := u.hookTimeout(canAccess, entity.Tag, cfg.HookTimeout())
+		if err != nil {
+			result.Results[i].Error = common.ServerError(err)
+			continue
+		}
+		result.Results[i].Timeout = timeout
+	}
+	return result, nil
+}
+
+func (u *UniterAPI) hookTimeout(canAccess common.AuthFunc, tagString string, modelTimeout time.Duration) (time.Duration, error) {
+	tag, err := names.ParseUnitTag(tagString)
+	if err != nil {
+		return 0, err
+	}
+	if !canAccess(tag) {
+		return 0, common.ErrPerm
+	}
+	unit, err := u.getUnit(tag)
+	if err != nil {
+		return 0, err
+	}
+	app, err := unit.Application()
+	if err != nil {
+		return 0, err
+	}
+	config, err := app.ApplicationConfig()
+	if err != nil {
+		return 0, err
+	}
+	value := config.GetString(application.HookTimeoutConfigOptionName, "")
+	if value == "" {
+		return modelTimeout, nil
+	}
+	timeout, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, errors.NotValidf("hook timeout %q of application %q", value, app.Name())
+	}
+	return timeout, nil
+}
+
+// RemoveSubWorkloads removes the named sub-workloads of the given
+// units.
+func (u *UniterAPI) RemoveSubWorkloads(args params.SubWorkloadArgs) (params.ErrorResults, error) {
+	result := params.ErrorResults{
+		Results: make([]params.ErrorResult, len(args.Args)),
+	}
+	canAccess, err := u.accessUnit()
+	if err != nil {
+		return params.ErrorResults{}, err
+	}
+	for i, arg := range args.Args {
+		err := u.removeSubWorkload(canAccess, arg)
+		result.Results[i].Error = common.ServerError(err)
+	}
+	return result, nil
+}
+
+func (u *UniterAPI) removeSubWorkload(canAccess common.AuthFunc, arg params.SubWorkloadArg) error {
+	tag, err := names.ParseUnitTag(arg.Tag)
+	if err != nil {
+		return err
+	}
+	if !canAccess(tag) {
+		return common.ErrPerm
+	}
+	unit, err := u.getUnit(tag)
+	if err != nil {
+		return err
+	}
+	return unit.RemoveSubWorkload(arg.Name)
+}
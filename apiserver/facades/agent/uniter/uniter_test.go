@@ -904,6 +904,196 @@ func (s *uniterSuite) TestSetWorkloadVersion(c *gc.C) {
 	c.Assert(newVersion, gc.Equals, "shiro")
 }
 
+func (s *uniterSuite) TestRecordHookHistory(c *gc.C) {
+	rel := s.addRelation(c, "wordpress", "mysql")
+	started := time.Date(2018, 5, 1, 12, 30, 15, 0, time.UTC)
+	entry := params.HookHistoryEntry{
+		Operation:  "run relation-changed (1; mysql/0) hook",
+		Hook:       "db-relation-changed",
+		RemoteUnit: "mysql/0",
+		Started:    started,
+		Completed:  started.Add(time.Second),
+		Result:     "completed",
+	}
+	args := params.RecordHookHistoryArgs{Args: []params.RecordHookHistoryArg{
+		{Tag: "unit-mysql-0", RelationId: -1, Entry: entry},
+		{Tag: "unit-wordpress-0", RelationId: rel.Id(), Entry: entry},
+		{Tag: "unit-wordpress-0", RelationId: -1, Entry: params.HookHistoryEntry{
+			Operation: "run action 1234",
+			Started:   started.Add(time.Minute),
+			Completed: started.Add(2 * time.Minute),
+			Result:    "failed",
+			Error:     "boom",
+		}},
+		{Tag: "unit-foo-42", RelationId: -1, Entry: entry},
+	}}
+	result, err := s.uniter.RecordHookHistory(args)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result, gc.DeepEquals, params.ErrorResults{
+		Results: []params.ErrorResult{
+			{apiservertesting.ErrUnauthorized},
+			{nil},
+			{nil},
+			{apiservertesting.ErrUnauthorized},
+		},
+	})
+
+	history, err := s.wordpressUnit.HookHistory(0)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(history, jc.DeepEquals, []state.HookHistoryEntry{{
+		Operation: "run action 1234",
+		Started:   started.Add(time.Minute),
+		Completed: started.Add(2 * time.Minute),
+		Result:    "failed",
+		Error:     "boom",
+	}, {
+		Operation:  "run relation-changed (1; mysql/0) hook",
+		Hook:       "db-relation-changed",
+		Relation:   rel.String(),
+		RemoteUnit: "mysql/0",
+		Started:    started,
+		Completed:  started.Add(time.Second),
+		Result:     "completed",
+	}})
+}
+
+func (s *uniterSuite) TestSetUnitHealth(c *gc.C) {
+	since := time.Date(2018, 5, 1, 12, 30, 15, 0, time.UTC)
+	checks := []params.HealthCheckResult{{
+		Name:    "http",
+		Healthy: true,
+		Since:   since,
+	}, {
+		Name:     "db",
+		Failures: 3,
+		Message:  "connection refused",
+		Since:    since,
+	}}
+	args := params.SetUnitHealthArgs{Args: []params.SetUnitHealthArg{
+		{Tag: "unit-mysql-0", Checks: checks},
+		{Tag: "unit-wordpress-0", Checks: checks},
+		{Tag: "unit-foo-42", Checks: checks},
+	}}
+	result, err := s.uniter.SetUnitHealth(args)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result, gc.DeepEquals, params.ErrorResults{
+		Results: []params.ErrorResult{
+			{apiservertesting.ErrUnauthorized},
+			{nil},
+			{apiservertesting.ErrUnauthorized},
+		},
+	})
+
+	health, err := s.wordpressUnit.Health()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(health, jc.DeepEquals, state.UnitHealth{
+		Checks: []state.HealthCheckResult{{
+			Name:    "http",
+			Healthy: true,
+			Since:   since,
+		}, {
+			Name:     "db",
+			Failures: 3,
+			Message:  "connection refused",
+			Since:    since,
+		}},
+	})
+
+	healthResult, err := s.uniter.UnitHealth(params.Entities{Entities: []params.Entity{
+		{Tag: "unit-mysql-0"},
+		{Tag: "unit-wordpress-0"},
+	}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(healthResult, jc.DeepEquals, params.UnitHealthResults{
+		Results: []params.UnitHealthResult{
+			{Error: apiservertesting.ErrUnauthorized},
+			{Result: &params.UnitHealth{Status: "unhealthy", Checks: checks}},
+		},
+	})
+}
+
+func (s *uniterSuite) TestSetSubWorkloads(c *gc.C) {
+	args := params.SetSubWorkloadArgs{Args: []params.SetSubWorkloadArg{
+		{Tag: "unit-mysql-0", Name: "telegraf", Status: "active"},
+		{Tag: "unit-wordpress-0", Name: "telegraf", Version: "1.7.2", Status: "active", Info: "shipping metrics"},
+		{Tag: "unit-wordpress-0", Name: "filebeat", Status: "bogus"},
+		{Tag: "unit-foo-42", Name: "telegraf", Status: "active"},
+	}}
+	result, err := s.uniter.SetSubWorkloads(args)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.Results, gc.HasLen, 4)
+	c.Assert(result.Results[0].Error, jc.DeepEquals, apiservertesting.ErrUnauthorized)
+	c.Assert(result.Results[1].Error, gc.IsNil)
+	c.Assert(result.Results[2].Error, gc.ErrorMatches, `cannot set status of sub-workload "filebeat" of unit "wordpress/0": invalid status "bogus"`)
+	c.Assert(result.Results[3].Error, jc.DeepEquals, apiservertesting.ErrUnauthorized)
+
+	subWorkloads, err := s.wordpressUnit.SubWorkloads()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(subWorkloads, gc.HasLen, 1)
+	c.Assert(subWorkloads[0].Name, gc.Equals, "telegraf")
+	c.Assert(subWorkloads[0].Version, gc.Equals, "1.7.2")
+	c.Assert(subWorkloads[0].Status.Status, gc.Equals, status.Active)
+	c.Assert(subWorkloads[0].Status.Message, gc.Equals, "shipping metrics")
+}
+
+func (s *uniterSuite) TestHookTimeouts(c *gc.C) {
+	err := s.IAASModel.UpdateModelConfig(map[string]interface{}{config.HookTimeoutKey: "20m"}, nil)
+	c.Assert(err, jc.ErrorIsNil)
+
+	args := params.Entities{Entities: []params.Entity{
+		{Tag: "unit-mysql-0"},
+		{Tag: "unit-wordpress-0"},
+		{Tag: "unit-foo-42"},
+	}}
+	result, err := s.uniter.HookTimeouts(args)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result, gc.DeepEquals, params.HookTimeoutResults{
+		Results: []params.HookTimeoutResult{
+			{Error: apiservertesting.ErrUnauthorized},
+			{Timeout: 20 * time.Minute},
+			{Error: apiservertesting.ErrUnauthorized},
+		},
+	})
+
+	// The application's hook-timeout takes precedence over the model's.
+	conf := map[string]interface{}{application.HookTimeoutConfigOptionName: "5m"}
+	fields := map[string]environschema.Attr{application.HookTimeoutConfigOptionName: {Type: environschema.Tstring}}
+	err = s.wordpress.UpdateApplicationConfig(conf, nil, fields, nil)
+	c.Assert(err, jc.ErrorIsNil)
+
+	result, err = s.uniter.HookTimeouts(params.Entities{Entities: []params.Entity{{Tag: "unit-wordpress-0"}}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result, gc.DeepEquals, params.HookTimeoutResults{
+		Results: []params.HookTimeoutResult{{Timeout: 5 * time.Minute}},
+	})
+}
+
+func (s *uniterSuite) TestRemoveSubWorkloads(c *gc.C) {
+	err := s.wordpressUnit.SetSubWorkload("telegraf", "", status.StatusInfo{Status: status.Active})
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.wordpressUnit.SetSubWorkload("filebeat", "", status.StatusInfo{Status: status.Active})
+	c.Assert(err, jc.ErrorIsNil)
+
+	args := params.SubWorkloadArgs{Args: []params.SubWorkloadArg{
+		{Tag: "unit-mysql-0", Name: "telegraf"},
+		{Tag: "unit-wordpress-0", Name: "telegraf"},
+		{Tag: "unit-wordpress-0", Name: "collectd"},
+		{Tag: "unit-foo-42", Name: "telegraf"},
+	}}
+	result, err := s.uniter.RemoveSubWorkloads(args)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.Results, gc.HasLen, 4)
+	c.Assert(result.Results[0].Error, jc.DeepEquals, apiservertesting.ErrUnauthorized)
+	c.Assert(result.Results[1].Error, gc.IsNil)
+	c.Assert(result.Results[2].Error, jc.Satisfies, params.IsCodeNotFound)
+	c.Assert(result.Results[3].Error, jc.DeepEquals, apiservertesting.ErrUnauthorized)
+
+	subWorkloads, err := s.wordpressUnit.SubWorkloads()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(subWorkloads, gc.HasLen, 1)
+	c.Assert(subWorkloads[0].Name, gc.Equals, "filebeat")
+}
+
 func (s *uniterSuite) TestCharmModifiedVersion(c *gc.C) {
 	args := params.Entities{Entities: []params.Entity{
 		{Tag: "application-mysql"},
@@ -2325,6 +2515,121 @@ func (s *uniterSuite) TestUpdateSettings(c *gc.C) {
 	})
 }
 
+func (s *uniterSuite) TestReadApplicationSettings(c *gc.C) {
+	rel := s.addRelation(c, "wordpress", "mysql")
+	err := rel.UpdateApplicationSettings("mysql", &fakeToken{}, map[string]string{"host": "10.0.0.1"})
+	c.Assert(err, jc.ErrorIsNil)
+	err = rel.UpdateApplicationSettings("wordpress", &fakeToken{}, map[string]string{"user": "admin"})
+	c.Assert(err, jc.ErrorIsNil)
+
+	args := params.RelationApplications{RelationApplications: []params.RelationApplication{
+		{Relation: rel.Tag().String(), Unit: "unit-wordpress-0", Application: "mysql"},
+		{Relation: rel.Tag().String(), Unit: "unit-wordpress-0", Application: "wordpress"},
+		{Relation: rel.Tag().String(), Unit: "unit-mysql-0", Application: "mysql"},
+		{Relation: "relation-42", Unit: "unit-wordpress-0", Application: "mysql"},
+		{Relation: rel.Tag().String(), Unit: "unit-wordpress-0", Application: "logging"},
+		{Relation: "foo", Unit: "bar", Application: "baz"},
+	}}
+	result, err := s.uniter.ReadApplicationSettings(args)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.Results, gc.HasLen, 6)
+	c.Assert(result.Results[0], gc.DeepEquals, params.SettingsResult{
+		Settings: params.Settings{"host": "10.0.0.1"},
+	})
+	c.Assert(result.Results[1].Error, gc.ErrorMatches, `"wordpress/0" is not leader of "wordpress"`)
+	c.Assert(result.Results[2].Error, gc.DeepEquals, apiservertesting.ErrUnauthorized)
+	c.Assert(result.Results[3].Error, gc.DeepEquals, apiservertesting.ErrUnauthorized)
+	c.Assert(result.Results[4].Error, gc.ErrorMatches, `application "logging" is not a member of "wordpress:db mysql:server"`)
+	c.Assert(result.Results[5].Error, gc.DeepEquals, apiservertesting.ErrUnauthorized)
+
+	// The leader can read its own application's settings.
+	err = s.State.LeadershipClaimer().ClaimLeadership("wordpress", "wordpress/0", time.Minute)
+	c.Assert(err, jc.ErrorIsNil)
+	result, err = s.uniter.ReadApplicationSettings(params.RelationApplications{
+		RelationApplications: args.RelationApplications[1:2],
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result, gc.DeepEquals, params.SettingsResults{
+		Results: []params.SettingsResult{{Settings: params.Settings{"user": "admin"}}},
+	})
+}
+
+func (s *uniterSuite) TestReadApplicationSettingsPeer(c *gc.C) {
+	riak := s.Factory.MakeApplication(c, &jujufactory.ApplicationParams{
+		Charm: s.Factory.MakeCharm(c, &jujufactory.CharmParams{Name: "riak"}),
+	})
+	riakUnit := s.Factory.MakeUnit(c, &jujufactory.UnitParams{Application: riak})
+	ep, err := riak.Endpoint("ring")
+	c.Assert(err, jc.ErrorIsNil)
+	rel, err := s.State.EndpointsRelation(ep)
+	c.Assert(err, jc.ErrorIsNil)
+	err = rel.UpdateApplicationSettings("riak", &fakeToken{}, map[string]string{"ring-size": "64"})
+	c.Assert(err, jc.ErrorIsNil)
+
+	riakAuthorizer := s.authorizer
+	riakAuthorizer.Tag = riakUnit.Tag()
+	riakUniter, err := uniter.NewUniterAPI(s.State, s.resources, riakAuthorizer)
+	c.Assert(err, jc.ErrorIsNil)
+
+	// In a peer relation, a unit which is not the leader may read
+	// its own application's settings.
+	result, err := riakUniter.ReadApplicationSettings(params.RelationApplications{
+		RelationApplications: []params.RelationApplication{{
+			Relation:    rel.Tag().String(),
+			Unit:        riakUnit.Tag().String(),
+			Application: "riak",
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result, gc.DeepEquals, params.SettingsResults{
+		Results: []params.SettingsResult{{Settings: params.Settings{"ring-size": "64"}}},
+	})
+
+	// It still may not change them.
+	updateResult, err := riakUniter.UpdateApplicationSettings(params.RelationUnitsSettings{
+		RelationUnits: []params.RelationUnitSettings{{
+			Relation: rel.Tag().String(),
+			Unit:     riakUnit.Tag().String(),
+			Settings: params.Settings{"ring-size": "128"},
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(updateResult.Results, gc.HasLen, 1)
+	c.Assert(updateResult.Results[0].Error, gc.ErrorMatches, `"riak/0" is not leader of "riak"`)
+}
+
+func (s *uniterSuite) TestUpdateApplicationSettings(c *gc.C) {
+	rel := s.addRelation(c, "wordpress", "mysql")
+	args := params.RelationUnitsSettings{RelationUnits: []params.RelationUnitSettings{
+		{Relation: rel.Tag().String(), Unit: "unit-wordpress-0", Settings: params.Settings{"user": "admin"}},
+		{Relation: rel.Tag().String(), Unit: "unit-mysql-0", Settings: params.Settings{"host": "10.0.0.1"}},
+		{Relation: "relation-42", Unit: "unit-wordpress-0", Settings: nil},
+		{Relation: "foo", Unit: "bar", Settings: nil},
+	}}
+	result, err := s.uniter.UpdateApplicationSettings(args)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.Results, gc.HasLen, 4)
+	c.Assert(result.Results[0].Error, gc.ErrorMatches, `"wordpress/0" is not leader of "wordpress"`)
+	c.Assert(result.Results[1:], gc.DeepEquals, []params.ErrorResult{
+		{apiservertesting.ErrUnauthorized},
+		{apiservertesting.ErrUnauthorized},
+		{apiservertesting.ErrUnauthorized},
+	})
+
+	err = s.State.LeadershipClaimer().ClaimLeadership("wordpress", "wordpress/0", time.Minute)
+	c.Assert(err, jc.ErrorIsNil)
+	result, err = s.uniter.UpdateApplicationSettings(params.RelationUnitsSettings{
+		RelationUnits: args.RelationUnits[:1],
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result, gc.DeepEquals, params.ErrorResults{
+		Results: []params.ErrorResult{{nil}},
+	})
+	settings, err := rel.ApplicationSettings("wordpress")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(settings, gc.DeepEquals, map[string]interface{}{"user": "admin"})
+}
+
 func (s *uniterSuite) TestWatchRelationUnits(c *gc.C) {
 	// Add a relation between wordpress and mysql and enter scope with
 	// mysqlUnit.
@@ -4207,3 +4512,11 @@ func (s *cloudSpecUniterSuite) TestGetCloudSpecReturnsSpecWhenTrusted(c *gc.C) {
 	}
 	c.Assert(result.Result.Credential.Attributes, gc.DeepEquals, exp)
 }
+
+// fakeToken implements leadership.Token.
+type fakeToken struct{}
+
+// Check is part of the leadership.Token interface. It always claims success.
+func (*fakeToken) Check(interface{}) error {
+	return nil
+}
@@ -23,6 +23,20 @@ type ActionAPI struct {
 	check      *common.BlockChecker
 }
 
+// ActionAPIV2 ignores the timeouts of enqueued actions.
+type ActionAPIV2 struct {
+	*ActionAPI
+}
+
+// NewActionAPIV2 returns an initialized ActionAPIV2.
+func NewActionAPIV2(st *state.State, resources facade.Resources, authorizer facade.Authorizer) (*ActionAPIV2, error) {
+	api, err := NewActionAPI(st, resources, authorizer)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &ActionAPIV2{api}, nil
+}
+
 // NewActionAPI returns an initialized ActionAPI
 func NewActionAPI(st *state.State, resources facade.Resources, authorizer facade.Authorizer) (*ActionAPI, error) {
 	if !authorizer.AuthClient() {
@@ -174,7 +188,7 @@ func (a *ActionAPI) FindActionsByNames(arg params.FindActionsByNames) (params.Ac
 // Enqueue takes a list of Actions and queues them up to be executed by
 // the designated ActionReceiver, returning the params.Action for each
 // enqueued Action, or an error if there was a problem enqueueing the
-// Action.
+// Action. An Action with a timeout is killed if it runs for longer.
 func (a *ActionAPI) Enqueue(arg params.Actions) (params.ActionResults, error) {
 	if err := a.checkCanWrite(); err != nil {
 		return params.ActionResults{}, errors.Trace(err)
@@ -193,7 +207,7 @@ func (a *ActionAPI) Enqueue(arg params.Actions) (params.ActionResults, error) {
 			currentResult.Error = common.ServerError(err)
 			continue
 		}
-		enqueued, err := receiver.AddAction(action.Name, action.Parameters)
+		enqueued, err := receiver.AddActionWithTimeout(action.Name, action.Parameters, action.Timeout)
 		if err != nil {
 			currentResult.Error = common.ServerError(err)
 			continue
@@ -204,6 +218,15 @@ func (a *ActionAPI) Enqueue(arg params.Actions) (params.ActionResults, error) {
 	return response, nil
 }
 
+// Enqueue queues up the given Actions without their timeouts, which
+// aren't supported by the v2 API.
+func (a *ActionAPIV2) Enqueue(arg params.Actions) (params.ActionResults, error) {
+	for i := range arg.Actions {
+		arg.Actions[i].Timeout = 0
+	}
+	return a.ActionAPI.Enqueue(arg)
+}
+
 // ListAll takes a list of Entities representing ActionReceivers and
 // returns all of the Actions that have been enqueued or run by each of
 // those Entities.
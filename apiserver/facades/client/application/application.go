@@ -250,7 +250,11 @@ func (api *APIBase) Deploy(args params.ApplicationsDeploy) (params.ErrorResults,
 
 func applicationConfigSchema(modelType state.ModelType) (environschema.Fields, schema.Defaults, error) {
 	if modelType != state.ModelTypeCAAS {
-		return trustFields, trustDefaults, nil
+		schema, err := AddHookTimeoutSchema(trustFields)
+		if err != nil {
+			return nil, nil, err
+		}
+		return schema, trustDefaults, nil
 	}
 	// TODO(caas) - get the schema from the provider
 	defaults := caas.ConfigDefaults(k8s.ConfigDefaults())
@@ -258,6 +262,10 @@ func applicationConfigSchema(modelType state.ModelType) (environschema.Fields, s
 	if err != nil {
 		return nil, nil, err
 	}
+	schema, err = AddHookTimeoutSchema(schema)
+	if err != nil {
+		return nil, nil, err
+	}
 	return AddTrustSchemaAndDefaults(schema, defaults)
 }
 
@@ -282,6 +290,9 @@ func splitApplicationAndCharmConfig(modelType state.ModelType, inConfig map[stri
 			charmConfig[k] = v
 		}
 	}
+	if err := validateHookTimeout(appConfigAttrs); err != nil {
+		return nil, nil, errors.Trace(err)
+	}
 	return appConfigAttrs, charmConfig, nil
 }
 
@@ -1056,6 +1056,8 @@ func (s *ApplicationSuite) TestSetApplicationConfig(c *gc.C) {
 	schema, err := caas.ConfigSchema(k8s.ConfigSchema())
 	c.Assert(err, jc.ErrorIsNil)
 	defaults := caas.ConfigDefaults(k8s.ConfigDefaults())
+	schema, err = application.AddHookTimeoutSchema(schema)
+	c.Assert(err, jc.ErrorIsNil)
 	schema, defaults, err = application.AddTrustSchemaAndDefaults(schema, defaults)
 	c.Assert(err, jc.ErrorIsNil)
 
@@ -1065,6 +1067,20 @@ func (s *ApplicationSuite) TestSetApplicationConfig(c *gc.C) {
 	app.CheckCall(c, 1, "UpdateCharmConfig", charm.Settings{"stringOption": "stringVal"})
 }
 
+func (s *ApplicationSuite) TestSetApplicationConfigInvalidHookTimeout(c *gc.C) {
+	result, err := s.api.SetApplicationsConfig(params.ApplicationConfigSetArgs{
+		Args: []params.ApplicationConfigSet{{
+			ApplicationName: "postgresql",
+			Config: map[string]string{
+				"hook-timeout": "soon",
+			},
+		}}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.OneError(), gc.ErrorMatches, `hook timeout "soon" not valid`)
+	app := s.backend.applications["postgresql"]
+	app.CheckNoCalls(c)
+}
+
 func (s *ApplicationSuite) TestBlockSetApplicationConfig(c *gc.C) {
 	s.blockChecker.SetErrors(errors.New("blocked"))
 	_, err := s.api.SetApplicationsConfig(params.ApplicationConfigSetArgs{})
@@ -1100,6 +1116,8 @@ func (s *ApplicationSuite) TestUnsetApplicationConfig(c *gc.C) {
 	schema, err := caas.ConfigSchema(k8s.ConfigSchema())
 	c.Assert(err, jc.ErrorIsNil)
 	defaults := caas.ConfigDefaults(k8s.ConfigDefaults())
+	schema, err = application.AddHookTimeoutSchema(schema)
+	c.Assert(err, jc.ErrorIsNil)
 	schema, defaults, err = application.AddTrustSchemaAndDefaults(schema, defaults)
 	c.Assert(err, jc.ErrorIsNil)
 
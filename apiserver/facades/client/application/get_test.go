@@ -113,6 +113,11 @@ func (s *getSuite) TestClientApplicationGetIAASModelSmoketest(c *gc.C) {
 			},
 		},
 		ApplicationConfig: map[string]interface{}{
+			"hook-timeout": map[string]interface{}{
+				"description": "How long hooks may run before being killed (e.g. 30m)",
+				"source":      "unset",
+				"type":        environschema.Tstring,
+			},
 			"trust": map[string]interface{}{
 				"default":     false,
 				"description": "Does this application have access to trusted credentials",
@@ -138,6 +143,8 @@ func (s *getSuite) TestClientApplicationGetCAASModelSmoketest(c *gc.C) {
 	c.Assert(err, jc.ErrorIsNil)
 	defaults := caas.ConfigDefaults(k8s.ConfigDefaults())
 
+	schemaFields, err = application.AddHookTimeoutSchema(schemaFields)
+	c.Assert(err, jc.ErrorIsNil)
 	schemaFields, defaults, err = application.AddTrustSchemaAndDefaults(schemaFields, defaults)
 	c.Assert(err, jc.ErrorIsNil)
 
@@ -261,6 +268,11 @@ var getTests = []struct {
 			},
 		},
 		ApplicationConfig: map[string]interface{}{
+			"hook-timeout": map[string]interface{}{
+				"description": "How long hooks may run before being killed (e.g. 30m)",
+				"source":      "unset",
+				"type":        "string",
+			},
 			"trust": map[string]interface{}{
 				"value":       false,
 				"default":     false,
@@ -319,6 +331,11 @@ var getTests = []struct {
 			},
 		},
 		ApplicationConfig: map[string]interface{}{
+			"hook-timeout": map[string]interface{}{
+				"description": "How long hooks may run before being killed (e.g. 30m)",
+				"source":      "unset",
+				"type":        "string",
+			},
 			"trust": map[string]interface{}{
 				"value":       false,
 				"default":     false,
@@ -336,6 +353,11 @@ var getTests = []struct {
 		CharmConfig: map[string]interface{}{},
 		Series:      "quantal",
 		ApplicationConfig: map[string]interface{}{
+			"hook-timeout": map[string]interface{}{
+				"description": "How long hooks may run before being killed (e.g. 30m)",
+				"source":      "unset",
+				"type":        "string",
+			},
 			"trust": map[string]interface{}{
 				"value":       false,
 				"default":     false,
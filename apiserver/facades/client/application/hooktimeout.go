@@ -0,0 +1,57 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package application
+
+import (
+	"time"
+
+	"github.com/juju/errors"
+	"gopkg.in/juju/environschema.v1"
+)
+
+// HookTimeoutConfigOptionName is the option name used to set how long
+// the hooks of an application's units may run before they are killed.
+// When unset, the model's hook-timeout applies.
+const HookTimeoutConfigOptionName = "hook-timeout"
+
+var hookTimeoutFields = environschema.Fields{
+	HookTimeoutConfigOptionName: {
+		Description: "How long hooks may run before being killed (e.g. 30m)",
+		Type:        environschema.Tstring,
+		Group:       environschema.JujuGroup,
+	},
+}
+
+// AddHookTimeoutSchema adds the hook timeout schema fields to an existing
+// set of schema fields.
+func AddHookTimeoutSchema(extra environschema.Fields) (environschema.Fields, error) {
+	fields := make(environschema.Fields)
+	for name, field := range hookTimeoutFields {
+		fields[name] = field
+	}
+	for name, field := range extra {
+		if _, ok := hookTimeoutFields[name]; ok {
+			return nil, errors.Errorf("config field %q clashes with common config", name)
+		}
+		fields[name] = field
+	}
+	return fields, nil
+}
+
+// validateHookTimeout returns an error if the hook timeout in the given
+// application config attributes is not a non-negative duration.
+func validateHookTimeout(attrs map[string]interface{}) error {
+	value, ok := attrs[HookTimeoutConfigOptionName].(string)
+	if !ok || value == "" {
+		return nil
+	}
+	timeout, err := time.ParseDuration(value)
+	if err != nil {
+		return errors.NotValidf("hook timeout %q", value)
+	}
+	if timeout < 0 {
+		return errors.NotValidf("negative hook timeout %q", value)
+	}
+	return nil
+}
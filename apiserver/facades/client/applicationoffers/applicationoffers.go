@@ -40,6 +40,11 @@ type OffersAPIV2 struct {
 	*OffersAPI
 }
 
+// OffersAPIV3 implements the cross model interface V3.
+type OffersAPIV3 struct {
+	*OffersAPIV2
+}
+
 // createAPI returns a new application offers OffersAPI facade.
 func createOffersAPI(
 	getApplicationOffers func(interface{}) jujucrossmodel.ApplicationOffers,
@@ -122,6 +127,15 @@ func NewOffersAPIV2(ctx facade.Context) (*OffersAPIV2, error) {
 	return &OffersAPIV2{OffersAPI: apiV1}, nil
 }
 
+// NewOffersAPIV3 returns a new application offers OffersAPIV3 facade.
+func NewOffersAPIV3(ctx facade.Context) (*OffersAPIV3, error) {
+	apiV2, err := NewOffersAPIV2(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &OffersAPIV3{OffersAPIV2: apiV2}, nil
+}
+
 // Offer makes application endpoints available for consumption at a specified URL.
 func (api *OffersAPI) Offer(all params.AddApplicationOffers) (params.ErrorResults, error) {
 	result := make([]params.ErrorResult, len(all.Offers))
@@ -183,6 +197,61 @@ func (api *OffersAPI) makeAddOfferArgsFromParams(backend Backend, addOfferParams
 	return result, nil
 }
 
+// UpdateOffer changes the endpoints and description of existing
+// application offers. An endpoint may only be removed from an offer
+// if it is not used by any relation to a consuming model.
+func (api *OffersAPIV3) UpdateOffer(all params.AddApplicationOffers) (params.ErrorResults, error) {
+	result := make([]params.ErrorResult, len(all.Offers))
+	for i, one := range all.Offers {
+		result[i].Error = common.ServerError(api.updateOneOffer(one))
+	}
+	return params.ErrorResults{Results: result}, nil
+}
+
+func (api *OffersAPIV3) updateOneOffer(one params.AddApplicationOffer) error {
+	modelTag, err := names.ParseModelTag(one.ModelTag)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	backend, releaser, err := api.StatePool.Get(modelTag.Id())
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer releaser()
+
+	if err := api.checkAdmin(backend); err != nil {
+		return errors.Trace(err)
+	}
+
+	offerName := one.OfferName
+	if offerName == "" {
+		offerName = one.ApplicationName
+	}
+	offers := api.GetApplicationOffers(backend)
+	existing, err := offers.ApplicationOffer(offerName)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if one.ApplicationName != existing.ApplicationName {
+		return errors.Errorf(
+			"offer %q is for application %q, not %q",
+			offerName, existing.ApplicationName, one.ApplicationName,
+		)
+	}
+	description := one.ApplicationDescription
+	if description == "" {
+		description = existing.ApplicationDescription
+	}
+	_, err = offers.UpdateOffer(jujucrossmodel.AddApplicationOfferArgs{
+		OfferName:              offerName,
+		ApplicationName:        existing.ApplicationName,
+		ApplicationDescription: description,
+		Endpoints:              one.Endpoints,
+		Owner:                  api.Authorizer.GetAuthTag().Id(),
+	})
+	return errors.Trace(err)
+}
+
 // ListApplicationOffers gets deployed details about application offers that match given filter.
 // The results contain details about the deployed applications such as connection count.
 func (api *OffersAPI) ListApplicationOffers(filters params.OfferFilters) (params.QueryApplicationOffersResults, error) {
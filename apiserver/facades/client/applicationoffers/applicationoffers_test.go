@@ -29,7 +29,7 @@ import (
 
 type applicationOffersSuite struct {
 	baseSuite
-	api *applicationoffers.OffersAPIV2
+	api *applicationoffers.OffersAPIV3
 }
 
 var _ = gc.Suite(&applicationOffersSuite{})
@@ -57,7 +57,9 @@ func (s *applicationOffersSuite) SetUpTest(c *gc.C) {
 		context.NewCloudCallContext(),
 	)
 	c.Assert(err, jc.ErrorIsNil)
-	s.api = &applicationoffers.OffersAPIV2{OffersAPI: apiV1}
+	s.api = &applicationoffers.OffersAPIV3{
+		OffersAPIV2: &applicationoffers.OffersAPIV2{OffersAPI: apiV1},
+	}
 }
 
 func (s *applicationOffersSuite) assertOffer(c *gc.C, expectedErr error) {
@@ -122,6 +124,87 @@ func (s *applicationOffersSuite) TestOfferPermission(c *gc.C) {
 	s.assertOffer(c, common.ErrPerm)
 }
 
+func (s *applicationOffersSuite) setupUpdateOffer(c *gc.C) params.AddApplicationOffers {
+	s.applicationOffers.applicationOffer = func(name string) (*jujucrossmodel.ApplicationOffer, error) {
+		if name != "offer-test" {
+			return nil, errors.NotFoundf("application offer %q", name)
+		}
+		return &jujucrossmodel.ApplicationOffer{
+			OfferName:              "offer-test",
+			ApplicationName:        "test",
+			ApplicationDescription: "A pretty popular blog engine",
+		}, nil
+	}
+	return params.AddApplicationOffers{Offers: []params.AddApplicationOffer{{
+		ModelTag:        testing.ModelTag.String(),
+		OfferName:       "offer-test",
+		ApplicationName: "test",
+		Endpoints:       map[string]string{"db": "db", "admin": "admin"},
+	}}}
+}
+
+func (s *applicationOffersSuite) TestUpdateOffer(c *gc.C) {
+	s.authorizer.Tag = names.NewUserTag("admin")
+	all := s.setupUpdateOffer(c)
+	all.Offers[0].ApplicationDescription = "A better blog engine"
+	s.applicationOffers.updateOffer = func(offer jujucrossmodel.AddApplicationOfferArgs) (*jujucrossmodel.ApplicationOffer, error) {
+		c.Assert(offer, jc.DeepEquals, jujucrossmodel.AddApplicationOfferArgs{
+			OfferName:              "offer-test",
+			ApplicationName:        "test",
+			ApplicationDescription: "A better blog engine",
+			Endpoints:              map[string]string{"db": "db", "admin": "admin"},
+			Owner:                  "admin",
+		})
+		return &jujucrossmodel.ApplicationOffer{}, nil
+	}
+	errs, err := s.api.UpdateOffer(all)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(errs.Results, gc.HasLen, 1)
+	c.Assert(errs.Results[0].Error, gc.IsNil)
+	s.applicationOffers.CheckCallNames(c, offerCall, updateOfferCall)
+}
+
+func (s *applicationOffersSuite) TestUpdateOfferKeepsDescription(c *gc.C) {
+	s.authorizer.Tag = names.NewUserTag("admin")
+	all := s.setupUpdateOffer(c)
+	s.applicationOffers.updateOffer = func(offer jujucrossmodel.AddApplicationOfferArgs) (*jujucrossmodel.ApplicationOffer, error) {
+		c.Assert(offer.ApplicationDescription, gc.Equals, "A pretty popular blog engine")
+		return &jujucrossmodel.ApplicationOffer{}, nil
+	}
+	errs, err := s.api.UpdateOffer(all)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(errs.Results[0].Error, gc.IsNil)
+}
+
+func (s *applicationOffersSuite) TestUpdateOfferPermission(c *gc.C) {
+	s.authorizer.Tag = names.NewUserTag("mary")
+	all := s.setupUpdateOffer(c)
+	errs, err := s.api.UpdateOffer(all)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(errs.Results[0].Error, gc.ErrorMatches, common.ErrPerm.Error())
+	s.applicationOffers.CheckNoCalls(c)
+}
+
+func (s *applicationOffersSuite) TestUpdateOfferDifferentApplication(c *gc.C) {
+	s.authorizer.Tag = names.NewUserTag("admin")
+	all := s.setupUpdateOffer(c)
+	all.Offers[0].ApplicationName = "other"
+	errs, err := s.api.UpdateOffer(all)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(errs.Results[0].Error, gc.ErrorMatches, `offer "offer-test" is for application "test", not "other"`)
+	s.applicationOffers.CheckCallNames(c, offerCall)
+}
+
+func (s *applicationOffersSuite) TestUpdateOfferNotFound(c *gc.C) {
+	s.authorizer.Tag = names.NewUserTag("admin")
+	all := s.setupUpdateOffer(c)
+	all.Offers[0].OfferName = "missing"
+	errs, err := s.api.UpdateOffer(all)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(errs.Results[0].Error, gc.ErrorMatches, `application offer "missing" not found`)
+	c.Assert(errs.Results[0].Error, jc.Satisfies, params.IsCodeNotFound)
+}
+
 func (s *applicationOffersSuite) TestOfferSomeFail(c *gc.C) {
 	s.authorizer.Tag = names.NewUserTag("admin")
 	s.addApplication(c, "one")
@@ -92,6 +92,8 @@ type Unit interface {
 	PrivateAddress() (network.Address, error)
 	Resolve(retryHooks bool) error
 	AgentHistory() status.StatusHistoryGetter
+	SubWorkloads() ([]state.SubWorkload, error)
+	SubWorkloadStatusHistory(name string, filter status.StatusHistoryFilter) ([]status.StatusInfo, error)
 }
 
 // TODO - CAAS(ericclaudejones): This should contain state alone, model will be
@@ -74,9 +74,28 @@ func (c *Client) unitStatusHistory(unitTag names.UnitTag, filter status.StatusHi
 		}
 		statuses = append(statuses, agentStatusFromStatusInfo(agentStatuses, status.KindUnitAgent)...)
 	}
+	if kind == status.KindUnit || kind == status.KindSubWorkload {
+		subWorkloads, err := unit.SubWorkloads()
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		for _, sw := range subWorkloads {
+			subStatuses, err := unit.SubWorkloadStatusHistory(sw.Name, filter)
+			if err != nil {
+				return nil, errors.Trace(err)
+			}
+			// Prefix each message with the sub-workload's name so
+			// that entries for different sub-workloads can be told
+			// apart.
+			for i, s := range subStatuses {
+				subStatuses[i].Message = sw.Name + ": " + s.Message
+			}
+			statuses = append(statuses, agentStatusFromStatusInfo(subStatuses, status.KindSubWorkload)...)
+		}
+	}
 
 	sort.Sort(byTime(statuses))
-	if kind == status.KindUnit && filter.Size > 0 {
+	if (kind == status.KindUnit || kind == status.KindSubWorkload) && filter.Size > 0 {
 		if len(statuses) > filter.Size {
 			statuses = statuses[len(statuses)-filter.Size:]
 		}
@@ -140,7 +159,7 @@ func (c *Client) StatusHistory(request params.StatusHistoryRequests) params.Stat
 		kind := status.HistoryKind(request.Kind)
 		err = errors.NotValidf("%q requires a unit, got %T", kind, request.Tag)
 		switch kind {
-		case status.KindUnit, status.KindWorkload, status.KindUnitAgent:
+		case status.KindUnit, status.KindWorkload, status.KindUnitAgent, status.KindSubWorkload:
 			var u names.UnitTag
 			if u, err = names.ParseUnitTag(request.Tag); err == nil {
 				hist, err = c.unitStatusHistory(u, filter, kind)
@@ -1196,6 +1215,22 @@ func (context *statusContext) processUnit(unit *state.Unit, applicationCharm str
 	if leader := context.leaders[unit.ApplicationName()]; leader == unit.Name() {
 		result.Leader = true
 	}
+	if health, err := unit.Health(); err != nil {
+		logger.Debugf("error fetching health: %v", err)
+	} else if len(health.Checks) > 0 {
+		result.Health = common.UnitHealth(health)
+	}
+	if subWorkloads := context.status.UnitSubWorkloads(unit.Name()); len(subWorkloads) > 0 {
+		result.SubWorkloads = make(map[string]params.SubWorkloadStatus)
+		for _, sw := range subWorkloads {
+			result.SubWorkloads[sw.Name] = params.SubWorkloadStatus{
+				Version: sw.Version,
+				Status:  sw.Status.Status.String(),
+				Info:    sw.Status.Message,
+				Since:   sw.Status.Since,
+			}
+		}
+	}
 	containerInfo, err := unit.ContainerInfo()
 	if err != nil && !errors.IsNotFound(err) {
 		logger.Debugf("error fetching container info: %v", err)
@@ -21,6 +21,7 @@ import (
 	"github.com/juju/juju/instance"
 	jujutesting "github.com/juju/juju/juju/testing"
 	"github.com/juju/juju/state"
+	"github.com/juju/juju/status"
 	"github.com/juju/juju/testing/factory"
 )
 
@@ -325,6 +326,59 @@ func (s *statusUnitTestSuite) TestWorkloadVersionOkWithUnset(c *gc.C) {
 	checkUnitVersion(c, appStatus, unit, "")
 }
 
+func (s *statusUnitTestSuite) TestUnitHealth(c *gc.C) {
+	application := s.Factory.MakeApplication(c, nil)
+	healthy, err := application.AddUnit(state.AddUnitParams{})
+	c.Assert(err, jc.ErrorIsNil)
+	unchecked, err := application.AddUnit(state.AddUnitParams{})
+	c.Assert(err, jc.ErrorIsNil)
+	since := time.Date(2018, 5, 1, 12, 30, 15, 0, time.UTC)
+	err = healthy.SetHealth(state.UnitHealth{
+		Checks: []state.HealthCheckResult{{Name: "http", Healthy: true, Since: since}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	client := s.APIState.Client()
+	status, err := client.Status(nil)
+	c.Assert(err, jc.ErrorIsNil)
+	appStatus, found := status.Applications[application.Name()]
+	c.Assert(found, jc.IsTrue)
+	c.Check(appStatus.Units[healthy.Name()].Health, jc.DeepEquals, &params.UnitHealth{
+		Status: "healthy",
+		Checks: []params.HealthCheckResult{{Name: "http", Healthy: true, Since: since}},
+	})
+	c.Check(appStatus.Units[unchecked.Name()].Health, gc.IsNil)
+}
+
+func (s *statusUnitTestSuite) TestUnitSubWorkloads(c *gc.C) {
+	application := s.Factory.MakeApplication(c, nil)
+	reporting, err := application.AddUnit(state.AddUnitParams{})
+	c.Assert(err, jc.ErrorIsNil)
+	silent, err := application.AddUnit(state.AddUnitParams{})
+	c.Assert(err, jc.ErrorIsNil)
+	since := time.Date(2018, 5, 1, 12, 30, 15, 0, time.UTC)
+	err = reporting.SetSubWorkload("telegraf", "1.7.2", status.StatusInfo{
+		Status:  status.Active,
+		Message: "shipping metrics",
+		Since:   &since,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	client := s.APIState.Client()
+	fullStatus, err := client.Status(nil)
+	c.Assert(err, jc.ErrorIsNil)
+	appStatus, found := fullStatus.Applications[application.Name()]
+	c.Assert(found, jc.IsTrue)
+	subWorkloads := appStatus.Units[reporting.Name()].SubWorkloads
+	c.Assert(subWorkloads, gc.HasLen, 1)
+	telegraf := subWorkloads["telegraf"]
+	c.Check(telegraf.Version, gc.Equals, "1.7.2")
+	c.Check(telegraf.Status, gc.Equals, "active")
+	c.Check(telegraf.Info, gc.Equals, "shipping metrics")
+	c.Check(telegraf.Since.Equal(since), jc.IsTrue)
+	c.Check(appStatus.Units[silent.Name()].SubWorkloads, gc.HasLen, 0)
+}
+
 func (s *statusUnitTestSuite) TestMigrationInProgress(c *gc.C) {
 
 	// Create a host model because controller models can't be migrated.
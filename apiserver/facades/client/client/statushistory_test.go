@@ -15,6 +15,7 @@ import (
 	"github.com/juju/juju/apiserver/params"
 	apiservertesting "github.com/juju/juju/apiserver/testing"
 	"github.com/juju/juju/environs/context"
+	"github.com/juju/juju/state"
 	"github.com/juju/juju/status"
 	"github.com/juju/juju/testing"
 )
@@ -222,10 +223,51 @@ func (s *statusHistoryTestSuite) TestStatusHistoryCombined(c *gc.C) {
 	checkStatusInfo(c, h.Results[0].History.Statuses, expected)
 }
 
+func (s *statusHistoryTestSuite) TestStatusHistorySubWorkloadsOnly(c *gc.C) {
+	s.st.unitHistory = statusInfoWithDates([]status.StatusInfo{
+		{
+			Status:  status.Active,
+			Message: "running",
+		},
+	})
+	s.st.subWorkloadHistory = map[string][]status.StatusInfo{
+		"telegraf": statusInfoWithDates([]status.StatusInfo{
+			{
+				Status:  status.Blocked,
+				Message: "no output configured",
+			},
+			{
+				Status:  status.Maintenance,
+				Message: "installing",
+			},
+		}),
+	}
+	h := s.api.StatusHistory(params.StatusHistoryRequests{
+		Requests: []params.StatusHistoryRequest{{
+			Tag:    "unit-unit-0",
+			Kind:   status.KindSubWorkload.String(),
+			Filter: params.StatusHistoryFilter{Size: 10},
+		}}})
+	c.Assert(h.Results, gc.HasLen, 1)
+	c.Assert(h.Results[0].Error, gc.IsNil)
+	expected := []status.StatusInfo{{
+		Status:  status.Maintenance,
+		Message: "telegraf: installing",
+	}, {
+		Status:  status.Blocked,
+		Message: "telegraf: no output configured",
+	}}
+	checkStatusInfo(c, h.Results[0].History.Statuses, expected)
+	for _, st := range h.Results[0].History.Statuses {
+		c.Check(st.Kind, gc.Equals, status.KindSubWorkload.String())
+	}
+}
+
 type mockState struct {
 	client.Backend
-	unitHistory  []status.StatusInfo
-	agentHistory []status.StatusInfo
+	unitHistory        []status.StatusInfo
+	agentHistory       []status.StatusInfo
+	subWorkloadHistory map[string][]status.StatusInfo
 }
 
 func (m *mockState) ModelUUID() string {
@@ -245,17 +287,31 @@ func (m *mockState) Unit(name string) (client.Unit, error) {
 		return nil, errors.NotFoundf("%v", name)
 	}
 	return &mockUnit{
-		status: m.unitHistory,
-		agent:  &mockUnitAgent{m.agentHistory},
+		status:       m.unitHistory,
+		agent:        &mockUnitAgent{m.agentHistory},
+		subWorkloads: m.subWorkloadHistory,
 	}, nil
 }
 
 type mockUnit struct {
-	status statuses
-	agent  *mockUnitAgent
+	status       statuses
+	agent        *mockUnitAgent
+	subWorkloads map[string][]status.StatusInfo
 	client.Unit
 }
 
+func (m *mockUnit) SubWorkloads() ([]state.SubWorkload, error) {
+	var result []state.SubWorkload
+	for name := range m.subWorkloads {
+		result = append(result, state.SubWorkload{Name: name})
+	}
+	return result, nil
+}
+
+func (m *mockUnit) SubWorkloadStatusHistory(name string, filter status.StatusHistoryFilter) ([]status.StatusInfo, error) {
+	return statuses(m.subWorkloads[name]).StatusHistory(filter)
+}
+
 func (m *mockUnit) StatusHistory(filter status.StatusHistoryFilter) ([]status.StatusInfo, error) {
 	return m.status.StatusHistory(filter)
 }
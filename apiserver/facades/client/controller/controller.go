@@ -45,11 +45,18 @@ type ControllerAPI struct {
 	hub        facade.Hub
 }
 
+// ControllerAPIv5 provides the v5 Controller API. The only difference
+// between this and v6 is that v5 doesn't have the MigrationPrechecks
+// method.
+type ControllerAPIv5 struct {
+	*ControllerAPI
+}
+
 // ControllerAPIv4 provides the v4 Controller API. The only difference
 // between this and v5 is that v4 doesn't have the
 // UpdateControllerConfig method.
 type ControllerAPIv4 struct {
-	*ControllerAPI
+	*ControllerAPIv5
 }
 
 // ControllerAPIv3 provides the v3 Controller API.
@@ -57,8 +64,8 @@ type ControllerAPIv3 struct {
 	*ControllerAPIv4
 }
 
-// NewControllerAPIv5 creates a new ControllerAPIv5.
-func NewControllerAPIv5(ctx facade.Context) (*ControllerAPI, error) {
+// NewControllerAPIv6 creates a new ControllerAPIv6.
+func NewControllerAPIv6(ctx facade.Context) (*ControllerAPI, error) {
 	st := ctx.State()
 	authorizer := ctx.Auth()
 	pool := ctx.StatePool()
@@ -76,6 +83,15 @@ func NewControllerAPIv5(ctx facade.Context) (*ControllerAPI, error) {
 	)
 }
 
+// NewControllerAPIv5 creates a new ControllerAPIv5.
+func NewControllerAPIv5(ctx facade.Context) (*ControllerAPIv5, error) {
+	v6, err := NewControllerAPIv6(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &ControllerAPIv5{v6}, nil
+}
+
 // NewControllerAPIv4 creates a new ControllerAPIv4.
 func NewControllerAPIv4(ctx facade.Context) (*ControllerAPIv4, error) {
 	v5, err := NewControllerAPIv5(ctx)
@@ -428,41 +444,62 @@ func (c *ControllerAPI) InitiateMigration(reqArgs params.InitiateMigrationArgs)
 }
 
 func (c *ControllerAPI) initiateOneMigration(spec params.MigrationSpec) (string, error) {
-	modelTag, err := names.ParseModelTag(spec.ModelTag)
+	hostedState, targetInfo, err := c.migrationSpecState(spec)
 	if err != nil {
-		return "", errors.Annotate(err, "model tag")
+		return "", errors.Trace(err)
 	}
+	defer hostedState.Release()
 
-	// Ensure the model exists.
-	if modelExists, err := c.state.ModelExists(modelTag.Id()); err != nil {
-		return "", errors.Annotate(err, "reading model")
-	} else if !modelExists {
-		return "", errors.NotFoundf("model")
+	// Check if the migration is likely to succeed.
+	if err := runMigrationPrechecks(hostedState.State, c.statePool.SystemState(), &targetInfo, c.presence); err != nil {
+		return "", errors.Trace(err)
 	}
 
-	hostedState, err := c.statePool.Get(modelTag.Id())
+	// Trigger the migration.
+	mig, err := hostedState.CreateMigration(state.MigrationSpec{
+		InitiatedBy: c.apiUser,
+		TargetInfo:  targetInfo,
+	})
 	if err != nil {
 		return "", errors.Trace(err)
 	}
-	defer hostedState.Release()
+	return mig.Id(), nil
+}
+
+// migrationSpecState returns the state of the model to be migrated
+// according to the spec, along with the details of the target
+// controller. The state must be released after use.
+func (c *ControllerAPI) migrationSpecState(spec params.MigrationSpec) (*state.PooledState, coremigration.TargetInfo, error) {
+	var targetInfo coremigration.TargetInfo
+	modelTag, err := names.ParseModelTag(spec.ModelTag)
+	if err != nil {
+		return nil, targetInfo, errors.Annotate(err, "model tag")
+	}
+
+	// Ensure the model exists.
+	if modelExists, err := c.state.ModelExists(modelTag.Id()); err != nil {
+		return nil, targetInfo, errors.Annotate(err, "reading model")
+	} else if !modelExists {
+		return nil, targetInfo, errors.NotFoundf("model")
+	}
 
 	// Construct target info.
 	specTarget := spec.TargetInfo
 	controllerTag, err := names.ParseControllerTag(specTarget.ControllerTag)
 	if err != nil {
-		return "", errors.Annotate(err, "controller tag")
+		return nil, targetInfo, errors.Annotate(err, "controller tag")
 	}
 	authTag, err := names.ParseUserTag(specTarget.AuthTag)
 	if err != nil {
-		return "", errors.Annotate(err, "auth tag")
+		return nil, targetInfo, errors.Annotate(err, "auth tag")
 	}
 	var macs []macaroon.Slice
 	if specTarget.Macaroons != "" {
 		if err := json.Unmarshal([]byte(specTarget.Macaroons), &macs); err != nil {
-			return "", errors.Annotate(err, "invalid macaroons")
+			return nil, targetInfo, errors.Annotate(err, "invalid macaroons")
 		}
 	}
-	targetInfo := coremigration.TargetInfo{
+	targetInfo = coremigration.TargetInfo{
 		ControllerTag: controllerTag,
 		Addrs:         specTarget.Addrs,
 		CACert:        specTarget.CACert,
@@ -471,20 +508,49 @@ func (c *ControllerAPI) initiateOneMigration(spec params.MigrationSpec) (string,
 		Macaroons:     macs,
 	}
 
-	// Check if the migration is likely to succeed.
-	if err := runMigrationPrechecks(hostedState.State, c.statePool.SystemState(), &targetInfo, c.presence); err != nil {
-		return "", errors.Trace(err)
+	hostedState, err := c.statePool.Get(modelTag.Id())
+	if err != nil {
+		return nil, targetInfo, errors.Trace(err)
 	}
+	return hostedState, targetInfo, nil
+}
 
-	// Trigger the migration.
-	mig, err := hostedState.CreateMigration(state.MigrationSpec{
-		InitiatedBy: c.apiUser,
-		TargetInfo:  targetInfo,
-	})
+// MigrationPrechecks runs the prechecks for the migration of one or
+// more models to other controllers without starting the migrations.
+// The source and target prechecks are run, and the target controller
+// checks that the model could be imported. Rather than failing on the
+// first problem found, all of them are reported.
+func (c *ControllerAPI) MigrationPrechecks(reqArgs params.InitiateMigrationArgs) (
+	params.MigrationPrecheckResults, error,
+) {
+	out := params.MigrationPrecheckResults{
+		Results: make([]params.MigrationPrecheckResult, len(reqArgs.Specs)),
+	}
+	if err := c.checkHasAdmin(); err != nil {
+		return out, errors.Trace(err)
+	}
+
+	for i, spec := range reqArgs.Specs {
+		result := &out.Results[i]
+		result.ModelTag = spec.ModelTag
+		problems, err := c.precheckOneMigration(spec)
+		if err != nil {
+			result.Error = common.ServerError(err)
+		} else {
+			result.Problems = problems
+		}
+	}
+	return out, nil
+}
+
+func (c *ControllerAPI) precheckOneMigration(spec params.MigrationSpec) ([]string, error) {
+	hostedState, targetInfo, err := c.migrationSpecState(spec)
 	if err != nil {
-		return "", errors.Trace(err)
+		return nil, errors.Trace(err)
 	}
-	return mig.Id(), nil
+	defer hostedState.Release()
+	problems, err := runMigrationDryRun(hostedState.State, c.statePool.SystemState(), &targetInfo, c.presence)
+	return problems, errors.Trace(err)
 }
 
 // ModifyControllerAccess changes the model access granted to users.
@@ -598,6 +664,58 @@ var runMigrationPrechecks = func(st, ctlrSt *state.State, targetInfo *coremigrat
 	return errors.Annotate(err, "target prechecks failed")
 }
 
+// runMigrationDryRun runs the migration prechecks on the source and
+// target controllers, and has the target controller check that the
+// model could be imported, returning all of the problems found.
+var runMigrationDryRun = func(st, ctlrSt *state.State, targetInfo *coremigration.TargetInfo, presence facade.Presence) ([]string, error) {
+	// Check model and source controller.
+	backend, err := migration.PrecheckShim(st, ctlrSt)
+	if err != nil {
+		return nil, errors.Annotate(err, "creating backend")
+	}
+	modelPresence := presence.ModelPresence(st.ModelUUID())
+	controllerPresence := presence.ModelPresence(ctlrSt.ModelUUID())
+	sourceProblems, err := migration.SourcePrecheckProblems(backend, modelPresence, controllerPresence)
+	if err != nil {
+		return nil, errors.Annotate(err, "running source prechecks")
+	}
+	var problems []string
+	for _, problem := range sourceProblems {
+		problems = append(problems, "source: "+problem.Error())
+	}
+
+	// Check target controller.
+	conn, err := api.Open(targetToAPIInfo(targetInfo), migration.ControllerDialOpts())
+	if err != nil {
+		return nil, errors.Annotate(err, "connect to target controller")
+	}
+	defer conn.Close()
+	modelInfo, err := makeModelInfo(st, ctlrSt)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	bytes, err := migration.ExportModel(st)
+	if err != nil {
+		return nil, errors.Annotate(err, "exporting model")
+	}
+	client := migrationtarget.NewClient(conn)
+	targetProblems, err := client.CheckImport(modelInfo, bytes)
+	if errors.IsNotSupported(err) {
+		// Older target controllers can only report the first
+		// problem found by their prechecks.
+		targetProblems = nil
+		if err := client.Prechecks(modelInfo); err != nil {
+			targetProblems = []string{err.Error()}
+		}
+	} else if err != nil {
+		return nil, errors.Annotate(err, "checking model import")
+	}
+	for _, problem := range targetProblems {
+		problems = append(problems, "target: "+problem)
+	}
+	return problems, nil
+}
+
 func makeModelInfo(st, ctlrSt *state.State) (coremigration.ModelInfo, error) {
 	var empty coremigration.ModelInfo
 
@@ -749,3 +867,6 @@ func (o orderedBlockInfo) Less(i, j int) bool {
 func (o orderedBlockInfo) Swap(i, j int) {
 	o[i], o[j] = o[j], o[i]
 }
+
+// MigrationPrechecks isn't on the v5 API.
+func (c *ControllerAPIv5) MigrationPrechecks(_, _ struct{}) {}
@@ -14,8 +14,16 @@ import (
 // with the same names.
 type Backend interface {
 	ModelTag() names.ModelTag
+	ControllerTag() names.ControllerTag
 	SaveFirewallRule(state.FirewallRule) error
 	ListFirewallRules() ([]*state.FirewallRule, error)
+	RemoveFirewallRule(name string) error
+
+	// The controller variants operate on the rules set for the
+	// controller, which apply to all hosted models.
+	SaveControllerFirewallRule(state.FirewallRule) error
+	ListControllerFirewallRules() ([]*state.FirewallRule, error)
+	RemoveControllerFirewallRule(name string) error
 }
 
 // BlockChecker defines the block-checking functionality required by
@@ -23,6 +31,7 @@ type Backend interface {
 // apiserver/common.BlockChecker.
 type BlockChecker interface {
 	ChangeAllowed() error
+	RemoveAllowed() error
 }
 
 // TODO - CAAS(ericclaudejones): This should contain state alone, model will be
@@ -30,17 +39,20 @@ type BlockChecker interface {
 type stateShim struct {
 	*state.State
 	*state.IAASModel
+	controllerState *state.State
 }
 
-// NewStateBackend converts a state.State into a Backend.
-func NewStateBackend(st *state.State) (Backend, error) {
+// NewStateBackend converts a state.State into a Backend. The
+// controller state is used for rules applying to all models.
+func NewStateBackend(st, controllerState *state.State) (Backend, error) {
 	im, err := st.IAASModel()
 	if err != nil {
 		return nil, err
 	}
 	return &stateShim{
-		State:     st,
-		IAASModel: im,
+		State:           st,
+		IAASModel:       im,
+		controllerState: controllerState,
 	}, nil
 }
 
@@ -53,3 +65,23 @@ func (s stateShim) ListFirewallRules() ([]*state.FirewallRule, error) {
 	api := state.NewFirewallRules(s.State)
 	return api.AllRules()
 }
+
+func (s stateShim) RemoveFirewallRule(name string) error {
+	api := state.NewFirewallRules(s.State)
+	return api.Remove(name)
+}
+
+func (s stateShim) SaveControllerFirewallRule(rule state.FirewallRule) error {
+	api := state.NewControllerFirewallRules(s.controllerState)
+	return api.Save(rule)
+}
+
+func (s stateShim) ListControllerFirewallRules() ([]*state.FirewallRule, error) {
+	api := state.NewControllerFirewallRules(s.controllerState)
+	return api.NamedRules()
+}
+
+func (s stateShim) RemoveControllerFirewallRule(name string) error {
+	api := state.NewControllerFirewallRules(s.controllerState)
+	return api.Remove(name)
+}
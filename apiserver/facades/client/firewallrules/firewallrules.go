@@ -17,16 +17,31 @@ import (
 
 var logger = loggo.GetLogger("juju.apiserver.firewallrules")
 
-// API provides the firewallrules facade APIs for v1.
+// API provides the firewallrules facade APIs for v2.
 type API struct {
 	backend    Backend
 	authorizer facade.Authorizer
 	check      BlockChecker
 }
 
+// APIv1 provides the firewallrules facade APIs for v1.
+type APIv1 struct {
+	*API
+}
+
+// NewFacadeV1 provides the signature required for facade registration
+// of the v1 facade.
+func NewFacadeV1(ctx facade.Context) (*APIv1, error) {
+	api, err := NewFacade(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &APIv1{api}, nil
+}
+
 // NewFacade provides the signature required for facade registration.
 func NewFacade(ctx facade.Context) (*API, error) {
-	backend, err := NewStateBackend(ctx.State())
+	backend, err := NewStateBackend(ctx.State(), ctx.StatePool().SystemState())
 	if err != nil {
 		return nil, errors.Annotate(err, "getting state")
 	}
@@ -73,6 +88,31 @@ func (api *API) checkCanRead() error {
 	return api.checkPermission(api.backend.ModelTag(), permission.ReadAccess)
 }
 
+func (api *API) checkControllerAdmin() error {
+	return api.checkPermission(api.backend.ControllerTag(), permission.SuperuserAccess)
+}
+
+func (api *API) saveFirewallRule(arg params.FirewallRule) error {
+	rule := state.FirewallRule{
+		WellKnownService: state.WellKnownServiceType(arg.KnownService),
+		Name:             arg.Name,
+		WhitelistCIDRs:   arg.WhitelistCIDRS,
+	}
+	if arg.PortRange != nil {
+		rule.PortRange = arg.PortRange.NetworkPortRange()
+	}
+	if !arg.Controller {
+		return api.backend.SaveFirewallRule(rule)
+	}
+	if rule.Name == "" {
+		return errors.NotValidf("controller firewall rule for well known service %q", arg.KnownService)
+	}
+	if err := api.checkControllerAdmin(); err != nil {
+		return errors.Trace(err)
+	}
+	return api.backend.SaveControllerFirewallRule(rule)
+}
+
 // SetFirewallRules creates or updates the specified firewall rules.
 func (api *API) SetFirewallRules(args params.FirewallRuleArgs) (params.ErrorResults, error) {
 	var errResults params.ErrorResults
@@ -86,10 +126,7 @@ func (api *API) SetFirewallRules(args params.FirewallRuleArgs) (params.ErrorResu
 	results := make([]params.ErrorResult, len(args.Args))
 	for i, arg := range args.Args {
 		logger.Debugf("saving firewall rule %+v", arg)
-		err := api.backend.SaveFirewallRule(state.FirewallRule{
-			WellKnownService: state.WellKnownServiceType(arg.KnownService),
-			WhitelistCIDRs:   arg.WhitelistCIDRS,
-		})
+		err := api.saveFirewallRule(arg)
 		results[i].Error = common.ServerError(err)
 	}
 	errResults.Results = results
@@ -97,6 +134,8 @@ func (api *API) SetFirewallRules(args params.FirewallRuleArgs) (params.ErrorResu
 }
 
 // ListFirewallRules returns all the firewall rules.
+// For the v2 facade, this includes named rules set for the
+// controller which apply to the model.
 func (api *API) ListFirewallRules() (params.ListFirewallRulesResults, error) {
 	var listResults params.ListFirewallRulesResults
 	if err := api.checkCanRead(); err != nil {
@@ -106,12 +145,88 @@ func (api *API) ListFirewallRules() (params.ListFirewallRulesResults, error) {
 	if err != nil {
 		return listResults, errors.Trace(err)
 	}
-	listResults.Rules = make([]params.FirewallRule, len(rules))
-	for i, r := range rules {
-		listResults.Rules[i] = params.FirewallRule{
-			KnownService:   params.KnownServiceValue(r.WellKnownService),
-			WhitelistCIDRS: r.WhitelistCIDRs,
+	controllerRules, err := api.backend.ListControllerFirewallRules()
+	if err != nil {
+		return listResults, errors.Trace(err)
+	}
+	listResults.Rules = make([]params.FirewallRule, 0, len(rules)+len(controllerRules))
+	for _, r := range rules {
+		listResults.Rules = append(listResults.Rules, toParamsRule(r, false))
+	}
+	for _, r := range controllerRules {
+		listResults.Rules = append(listResults.Rules, toParamsRule(r, true))
+	}
+	return listResults, nil
+}
+
+// ListFirewallRules returns all the firewall rules for well known services.
+func (api *APIv1) ListFirewallRules() (params.ListFirewallRulesResults, error) {
+	var listResults params.ListFirewallRulesResults
+	if err := api.checkCanRead(); err != nil {
+		return listResults, errors.Trace(err)
+	}
+	rules, err := api.backend.ListFirewallRules()
+	if err != nil {
+		return listResults, errors.Trace(err)
+	}
+	listResults.Rules = []params.FirewallRule{}
+	for _, r := range rules {
+		if r.Name != "" {
+			continue
 		}
+		listResults.Rules = append(listResults.Rules, toParamsRule(r, false))
 	}
 	return listResults, nil
 }
+
+// RemoveFirewallRules removes the specified named firewall rules.
+func (api *API) RemoveFirewallRules(args params.FirewallRuleArgs) (params.ErrorResults, error) {
+	var errResults params.ErrorResults
+	if err := api.checkAdmin(); err != nil {
+		return errResults, errors.Trace(err)
+	}
+	if err := api.check.RemoveAllowed(); err != nil {
+		return errResults, errors.Trace(err)
+	}
+
+	results := make([]params.ErrorResult, len(args.Args))
+	for i, arg := range args.Args {
+		logger.Debugf("removing firewall rule %+v", arg)
+		results[i].Error = common.ServerError(api.removeFirewallRule(arg))
+	}
+	errResults.Results = results
+	return errResults, nil
+}
+
+func (api *API) removeFirewallRule(arg params.FirewallRule) error {
+	if arg.Name == "" {
+		return errors.NotValidf("removing firewall rule without a name")
+	}
+	if params.KnownServiceValue(arg.Name).Validate() == nil {
+		return errors.NotValidf("removing firewall rule for well known service %q", arg.Name)
+	}
+	if !arg.Controller {
+		return api.backend.RemoveFirewallRule(arg.Name)
+	}
+	if err := api.checkControllerAdmin(); err != nil {
+		return errors.Trace(err)
+	}
+	return api.backend.RemoveControllerFirewallRule(arg.Name)
+}
+
+// RemoveFirewallRules isn't on the v1 API.
+func (api *APIv1) RemoveFirewallRules(_, _ struct{}) {}
+
+func toParamsRule(r *state.FirewallRule, controller bool) params.FirewallRule {
+	rule := params.FirewallRule{
+		KnownService:   params.KnownServiceValue(r.WellKnownService),
+		Name:           r.Name,
+		Controller:     controller,
+		WhitelistCIDRS: r.WhitelistCIDRs,
+	}
+	if r.Name != "" {
+		portRange := params.FromNetworkPortRange(r.PortRange)
+		rule.PortRange = &portRange
+	}
+	return rule
+}
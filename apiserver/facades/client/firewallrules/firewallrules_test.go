@@ -13,6 +13,7 @@ import (
 	"github.com/juju/juju/apiserver/facades/client/firewallrules"
 	"github.com/juju/juju/apiserver/params"
 	apiservertesting "github.com/juju/juju/apiserver/testing"
+	"github.com/juju/juju/network"
 	"github.com/juju/juju/state"
 	coretesting "github.com/juju/juju/testing"
 )
@@ -47,8 +48,9 @@ func (s *FirewallRulesSuite) SetUpTest(c *gc.C) {
 		Tag: names.NewUserTag("admin"),
 	}
 	s.backend = mockBackend{
-		modelUUID: coretesting.ModelTag.Id(),
-		rules:     make(map[string]state.FirewallRule),
+		modelUUID:       coretesting.ModelTag.Id(),
+		rules:           make(map[string]state.FirewallRule),
+		controllerRules: make(map[string]state.FirewallRule),
 	}
 	s.blockChecker = mockBlockChecker{}
 	api, err := firewallrules.NewAPI(
@@ -105,9 +107,126 @@ func (s *FirewallRulesSuite) TestSetFirewallRulesBlocked(c *gc.C) {
 	c.Assert(s.backend.rules, gc.HasLen, 0)
 }
 
+func (s *FirewallRulesSuite) TestSetNamedFirewallRule(c *gc.C) {
+	result, err := s.api.SetFirewallRules(params.FirewallRuleArgs{
+		Args: []params.FirewallRule{{
+			Name:           "prometheus",
+			PortRange:      &params.PortRange{FromPort: 9100, ToPort: 9110, Protocol: "tcp"},
+			WhitelistCIDRS: []string{"10.0.0.0/8"},
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result, jc.DeepEquals, params.ErrorResults{[]params.ErrorResult{{Error: nil}}})
+	c.Assert(s.backend.rules["prometheus"], jc.DeepEquals, state.FirewallRule{
+		Name:           "prometheus",
+		PortRange:      network.PortRange{FromPort: 9100, ToPort: 9110, Protocol: "tcp"},
+		WhitelistCIDRs: []string{"10.0.0.0/8"},
+	})
+	c.Assert(s.backend.controllerRules, gc.HasLen, 0)
+}
+
+func (s *FirewallRulesSuite) TestSetControllerFirewallRule(c *gc.C) {
+	result, err := s.api.SetFirewallRules(params.FirewallRuleArgs{
+		Args: []params.FirewallRule{{
+			Name:           "prometheus",
+			PortRange:      &params.PortRange{FromPort: 9100, ToPort: 9100, Protocol: "tcp"},
+			Controller:     true,
+			WhitelistCIDRS: []string{"10.0.0.0/8"},
+		}, {
+			KnownService:   "ssh",
+			Controller:     true,
+			WhitelistCIDRS: []string{"10.0.0.0/8"},
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.Results, gc.HasLen, 2)
+	c.Assert(result.Results[0].Error, gc.IsNil)
+	c.Assert(result.Results[1].Error, gc.ErrorMatches, `controller firewall rule for well known service "ssh" not valid`)
+	c.Assert(s.backend.controllerRules["prometheus"], jc.DeepEquals, state.FirewallRule{
+		Name:           "prometheus",
+		PortRange:      network.PortRange{FromPort: 9100, ToPort: 9100, Protocol: "tcp"},
+		WhitelistCIDRs: []string{"10.0.0.0/8"},
+	})
+	c.Assert(s.backend.rules, gc.HasLen, 0)
+}
+
+func (s *FirewallRulesSuite) TestSetControllerFirewallRulePermission(c *gc.C) {
+	// A model admin who isn't a controller superuser.
+	s.setAPIUser(c, names.NewUserTag("admin-"+coretesting.ModelTag.String()))
+	result, err := s.api.SetFirewallRules(params.FirewallRuleArgs{
+		Args: []params.FirewallRule{{
+			Name:           "prometheus",
+			PortRange:      &params.PortRange{FromPort: 9100, ToPort: 9100, Protocol: "tcp"},
+			Controller:     true,
+			WhitelistCIDRS: []string{"10.0.0.0/8"},
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.OneError(), gc.ErrorMatches, ".*permission denied.*")
+	c.Assert(s.backend.controllerRules, gc.HasLen, 0)
+}
+
+func (s *FirewallRulesSuite) TestRemoveFirewallRules(c *gc.C) {
+	s.backend.rules["prometheus"] = state.FirewallRule{Name: "prometheus"}
+	s.backend.controllerRules["grafana"] = state.FirewallRule{Name: "grafana"}
+	result, err := s.api.RemoveFirewallRules(params.FirewallRuleArgs{
+		Args: []params.FirewallRule{
+			{Name: "prometheus"},
+			{Name: "grafana", Controller: true},
+			{KnownService: "ssh"},
+			{Name: "juju-controller"},
+		},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.Results, gc.HasLen, 4)
+	c.Assert(result.Results[0].Error, gc.IsNil)
+	c.Assert(result.Results[1].Error, gc.IsNil)
+	c.Assert(result.Results[2].Error, gc.ErrorMatches, "removing firewall rule without a name not valid")
+	c.Assert(result.Results[3].Error, gc.ErrorMatches, `removing firewall rule for well known service "juju-controller" not valid`)
+	c.Assert(s.backend.rules, gc.HasLen, 0)
+	c.Assert(s.backend.controllerRules, gc.HasLen, 0)
+	s.blockChecker.CheckCallNames(c, "RemoveAllowed")
+}
+
+func (s *FirewallRulesSuite) TestRemoveFirewallRulesBlocked(c *gc.C) {
+	s.blockChecker.SetErrors(errors.New("blocked"))
+	s.backend.rules["prometheus"] = state.FirewallRule{Name: "prometheus"}
+	_, err := s.api.RemoveFirewallRules(params.FirewallRuleArgs{
+		Args: []params.FirewallRule{{Name: "prometheus"}},
+	})
+	c.Assert(err, gc.ErrorMatches, "blocked")
+	c.Assert(s.backend.rules, gc.HasLen, 1)
+}
+
+func (s *FirewallRulesSuite) TestRemoveFirewallRulesPermission(c *gc.C) {
+	s.setAPIUser(c, names.NewUserTag("mary"))
+	s.backend.rules["prometheus"] = state.FirewallRule{Name: "prometheus"}
+	_, err := s.api.RemoveFirewallRules(params.FirewallRuleArgs{
+		Args: []params.FirewallRule{{Name: "prometheus"}},
+	})
+	c.Assert(err, gc.ErrorMatches, ".*permission denied.*")
+	c.Assert(s.backend.rules, gc.HasLen, 1)
+}
+
 func (s *FirewallRulesSuite) TestListFirewallRules(c *gc.C) {
 	result, err := s.api.ListFirewallRules()
 	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result, jc.DeepEquals, params.ListFirewallRulesResults{
+		Rules: []params.FirewallRule{{
+			KnownService:   params.JujuApplicationOfferRule,
+			WhitelistCIDRS: []string{"1.2.3.4/8"},
+		}, {
+			Name:           "prometheus",
+			PortRange:      &params.PortRange{FromPort: 9100, ToPort: 9100, Protocol: "tcp"},
+			Controller:     true,
+			WhitelistCIDRS: []string{"10.0.0.0/8"},
+		}}})
+}
+
+func (s *FirewallRulesSuite) TestListFirewallRulesV1(c *gc.C) {
+	api := &firewallrules.APIv1{s.api}
+	result, err := api.ListFirewallRules()
+	c.Assert(err, jc.ErrorIsNil)
 	c.Assert(result, jc.DeepEquals, params.ListFirewallRulesResults{
 		Rules: []params.FirewallRule{{
 			KnownService:   params.JujuApplicationOfferRule,
@@ -8,15 +8,18 @@ import (
 	"gopkg.in/juju/names.v2"
 
 	"github.com/juju/juju/apiserver/facades/client/firewallrules"
+	"github.com/juju/juju/network"
 	"github.com/juju/juju/state"
+	coretesting "github.com/juju/juju/testing"
 )
 
 type mockBackend struct {
 	jtesting.Stub
 	firewallrules.Backend
 
-	modelUUID string
-	rules     map[string]state.FirewallRule
+	modelUUID       string
+	rules           map[string]state.FirewallRule
+	controllerRules map[string]state.FirewallRule
 }
 
 func (m *mockBackend) GetBlockForType(t state.BlockType) (state.Block, bool, error) {
@@ -32,7 +35,50 @@ func (m *mockBackend) ModelTag() names.ModelTag {
 func (m *mockBackend) SaveFirewallRule(rule state.FirewallRule) error {
 	m.MethodCall(m, "SaveFirewallRule")
 	m.PopNoErr()
-	m.rules[string(rule.WellKnownService)] = rule
+	m.rules[rule.Id()] = rule
+	return nil
+}
+
+func (m *mockBackend) ControllerTag() names.ControllerTag {
+	m.MethodCall(m, "ControllerTag")
+	m.PopNoErr()
+	return coretesting.ControllerTag
+}
+
+func (m *mockBackend) RemoveFirewallRule(name string) error {
+	m.MethodCall(m, "RemoveFirewallRule", name)
+	if err := m.NextErr(); err != nil {
+		return err
+	}
+	delete(m.rules, name)
+	return nil
+}
+
+func (m *mockBackend) SaveControllerFirewallRule(rule state.FirewallRule) error {
+	m.MethodCall(m, "SaveControllerFirewallRule")
+	m.PopNoErr()
+	m.controllerRules[rule.Id()] = rule
+	return nil
+}
+
+func (m *mockBackend) ListControllerFirewallRules() ([]*state.FirewallRule, error) {
+	m.MethodCall(m, "ListControllerFirewallRules")
+	m.PopNoErr()
+	return []*state.FirewallRule{
+		{
+			Name:           "prometheus",
+			PortRange:      network.PortRange{FromPort: 9100, ToPort: 9100, Protocol: "tcp"},
+			WhitelistCIDRs: []string{"10.0.0.0/8"},
+		},
+	}, nil
+}
+
+func (m *mockBackend) RemoveControllerFirewallRule(name string) error {
+	m.MethodCall(m, "RemoveControllerFirewallRule", name)
+	if err := m.NextErr(); err != nil {
+		return err
+	}
+	delete(m.controllerRules, name)
 	return nil
 }
 
@@ -55,3 +101,8 @@ func (c *mockBlockChecker) ChangeAllowed() error {
 	c.MethodCall(c, "ChangeAllowed")
 	return c.NextErr()
 }
+
+func (c *mockBlockChecker) RemoveAllowed() error {
+	c.MethodCall(c, "RemoveAllowed")
+	return c.NextErr()
+}
@@ -0,0 +1,44 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package hookhistory
+
+import (
+	"github.com/juju/errors"
+	"gopkg.in/juju/names.v2"
+
+	"github.com/juju/juju/state"
+)
+
+// Backend defines the state functionality required by the
+// hookhistory facade.
+type Backend interface {
+	ModelTag() names.ModelTag
+	Unit(name string) (Unit, error)
+}
+
+// Unit defines the unit functionality required by the facade.
+type Unit interface {
+	HookHistory(size int) ([]state.HookHistoryEntry, error)
+}
+
+// NewStateBackend converts a state.State into a Backend.
+func NewStateBackend(st *state.State) Backend {
+	return stateShim{st}
+}
+
+type stateShim struct {
+	st *state.State
+}
+
+func (s stateShim) ModelTag() names.ModelTag {
+	return s.st.ModelTag()
+}
+
+func (s stateShim) Unit(name string) (Unit, error) {
+	unit, err := s.st.Unit(name)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return unit, nil
+}
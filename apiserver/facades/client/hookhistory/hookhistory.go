@@ -0,0 +1,98 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package hookhistory provides a client facade reporting the
+// operations most recently completed by the uniters of units.
+package hookhistory
+
+import (
+	"github.com/juju/errors"
+	"gopkg.in/juju/names.v2"
+
+	"github.com/juju/juju/apiserver/common"
+	"github.com/juju/juju/apiserver/facade"
+	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/permission"
+)
+
+// API provides the HookHistory facade.
+type API struct {
+	backend    Backend
+	authorizer facade.Authorizer
+}
+
+// NewFacade provides the signature required for facade registration.
+func NewFacade(ctx facade.Context) (*API, error) {
+	return NewAPI(NewStateBackend(ctx.State()), ctx.Auth())
+}
+
+// NewAPI returns a new HookHistory API facade.
+func NewAPI(backend Backend, authorizer facade.Authorizer) (*API, error) {
+	if !authorizer.AuthClient() {
+		return nil, common.ErrPerm
+	}
+	return &API{
+		backend:    backend,
+		authorizer: authorizer,
+	}, nil
+}
+
+func (api *API) checkCanRead() error {
+	canRead, err := api.authorizer.HasPermission(permission.ReadAccess, api.backend.ModelTag())
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if !canRead {
+		return common.ErrPerm
+	}
+	return nil
+}
+
+// HookHistory returns the hook history of each of the specified units,
+// newest first.
+func (api *API) HookHistory(args params.HookHistoryRequests) (params.HookHistoryResults, error) {
+	if err := api.checkCanRead(); err != nil {
+		return params.HookHistoryResults{}, errors.Trace(err)
+	}
+	results := params.HookHistoryResults{
+		Results: make([]params.HookHistoryResult, len(args.Requests)),
+	}
+	for i, arg := range args.Requests {
+		history, err := api.hookHistory(arg)
+		if err != nil {
+			results.Results[i].Error = common.ServerError(err)
+			continue
+		}
+		results.Results[i].History = history
+	}
+	return results, nil
+}
+
+func (api *API) hookHistory(arg params.HookHistoryRequest) ([]params.HookHistoryEntry, error) {
+	tag, err := names.ParseUnitTag(arg.Tag)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	unit, err := api.backend.Unit(tag.Id())
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	entries, err := unit.HookHistory(arg.Size)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	history := make([]params.HookHistoryEntry, len(entries))
+	for i, entry := range entries {
+		history[i] = params.HookHistoryEntry{
+			Operation:  entry.Operation,
+			Hook:       entry.Hook,
+			Relation:   entry.Relation,
+			RemoteUnit: entry.RemoteUnit,
+			Started:    entry.Started,
+			Completed:  entry.Completed,
+			Result:     entry.Result,
+			Error:      entry.Error,
+		}
+	}
+	return history, nil
+}
@@ -0,0 +1,116 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package hookhistory_test
+
+import (
+	"time"
+
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+	"gopkg.in/juju/names.v2"
+
+	"github.com/juju/juju/apiserver/common"
+	"github.com/juju/juju/apiserver/facades/client/hookhistory"
+	"github.com/juju/juju/apiserver/params"
+	apiservertesting "github.com/juju/juju/apiserver/testing"
+	"github.com/juju/juju/state"
+	coretesting "github.com/juju/juju/testing"
+)
+
+type HookHistorySuite struct {
+	testing.IsolationSuite
+
+	backend    *mockBackend
+	unit       *mockUnit
+	authorizer apiservertesting.FakeAuthorizer
+}
+
+var _ = gc.Suite(&HookHistorySuite{})
+
+var started = time.Date(2018, 5, 1, 12, 30, 15, 0, time.UTC)
+
+func (s *HookHistorySuite) SetUpTest(c *gc.C) {
+	s.IsolationSuite.SetUpTest(c)
+	s.authorizer = apiservertesting.FakeAuthorizer{
+		Tag: names.NewUserTag("admin"),
+	}
+	s.unit = &mockUnit{
+		history: []state.HookHistoryEntry{{
+			Operation:  "run relation-changed (1; mysql/0) hook",
+			Hook:       "db-relation-changed",
+			Relation:   "wordpress:db mysql:server",
+			RemoteUnit: "mysql/0",
+			Started:    started,
+			Completed:  started.Add(3 * time.Second),
+			Result:     "failed",
+			Error:      "exit status 1",
+		}, {
+			Operation: "run install hook",
+			Hook:      "install",
+			Started:   started.Add(-time.Minute),
+			Completed: started.Add(-time.Second),
+			Result:    "completed",
+		}},
+	}
+	s.backend = &mockBackend{
+		modelTag: coretesting.ModelTag,
+		units: map[string]*mockUnit{
+			"wordpress/0": s.unit,
+		},
+	}
+}
+
+func (s *HookHistorySuite) newAPI(c *gc.C) *hookhistory.API {
+	api, err := hookhistory.NewAPI(s.backend, s.authorizer)
+	c.Assert(err, jc.ErrorIsNil)
+	return api
+}
+
+func (s *HookHistorySuite) TestNewAPINotClient(c *gc.C) {
+	s.authorizer.Tag = names.NewMachineTag("0")
+	_, err := hookhistory.NewAPI(s.backend, s.authorizer)
+	c.Assert(err, gc.Equals, common.ErrPerm)
+}
+
+func (s *HookHistorySuite) TestHookHistoryPermission(c *gc.C) {
+	s.authorizer.Tag = names.NewUserTag("mary")
+	_, err := s.newAPI(c).HookHistory(params.HookHistoryRequests{})
+	c.Assert(err, gc.ErrorMatches, "permission denied")
+}
+
+func (s *HookHistorySuite) TestHookHistory(c *gc.C) {
+	result, err := s.newAPI(c).HookHistory(params.HookHistoryRequests{
+		Requests: []params.HookHistoryRequest{
+			{Tag: "unit-wordpress-0", Size: 5},
+			{Tag: "unit-mysql-0"},
+			{Tag: "application-wordpress"},
+		},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.Results, gc.HasLen, 3)
+	c.Assert(result.Results[0], jc.DeepEquals, params.HookHistoryResult{
+		History: []params.HookHistoryEntry{{
+			Operation:  "run relation-changed (1; mysql/0) hook",
+			Hook:       "db-relation-changed",
+			Relation:   "wordpress:db mysql:server",
+			RemoteUnit: "mysql/0",
+			Started:    started,
+			Completed:  started.Add(3 * time.Second),
+			Result:     "failed",
+			Error:      "exit status 1",
+		}, {
+			Operation: "run install hook",
+			Hook:      "install",
+			Started:   started.Add(-time.Minute),
+			Completed: started.Add(-time.Second),
+			Result:    "completed",
+		}},
+	})
+	c.Assert(result.Results[1].Error, gc.NotNil)
+	c.Assert(result.Results[1].Error.Code, gc.Equals, params.CodeNotFound)
+	c.Assert(result.Results[2].Error, gc.ErrorMatches, `"application-wordpress" is not a valid unit tag`)
+
+	s.unit.CheckCall(c, 0, "HookHistory", 5)
+}
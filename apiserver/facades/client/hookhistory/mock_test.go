@@ -0,0 +1,50 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package hookhistory_test
+
+import (
+	"github.com/juju/errors"
+	jtesting "github.com/juju/testing"
+	"gopkg.in/juju/names.v2"
+
+	"github.com/juju/juju/apiserver/facades/client/hookhistory"
+	"github.com/juju/juju/state"
+)
+
+type mockBackend struct {
+	jtesting.Stub
+
+	modelTag names.ModelTag
+	units    map[string]*mockUnit
+}
+
+func (m *mockBackend) ModelTag() names.ModelTag {
+	return m.modelTag
+}
+
+func (m *mockBackend) Unit(name string) (hookhistory.Unit, error) {
+	m.MethodCall(m, "Unit", name)
+	if err := m.NextErr(); err != nil {
+		return nil, err
+	}
+	unit, ok := m.units[name]
+	if !ok {
+		return nil, errors.NotFoundf("unit %q", name)
+	}
+	return unit, nil
+}
+
+type mockUnit struct {
+	jtesting.Stub
+
+	history []state.HookHistoryEntry
+}
+
+func (m *mockUnit) HookHistory(size int) ([]state.HookHistoryEntry, error) {
+	m.MethodCall(m, "HookHistory", size)
+	if err := m.NextErr(); err != nil {
+		return nil, err
+	}
+	return m.history, nil
+}
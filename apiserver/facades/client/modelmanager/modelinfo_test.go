@@ -569,6 +569,14 @@ type fakeModelDescription struct {
 	UUID string `yaml:"model-uuid"`
 }
 
+func (*fakeModelDescription) Applications() []description.Application {
+	return nil
+}
+
+func (*fakeModelDescription) Machines() []description.Machine {
+	return nil
+}
+
 func (st *mockState) ModelUUID() string {
 	st.MethodCall(st, "ModelUUID")
 	return st.model.UUID()
@@ -1024,6 +1032,15 @@ func (m *mockModel) MigrationMode() state.MigrationMode {
 	return m.migrationStatus
 }
 
+func (m *mockModel) SetMigrationMode(mode state.MigrationMode) error {
+	m.MethodCall(m, "SetMigrationMode", mode)
+	if err := m.NextErr(); err != nil {
+		return err
+	}
+	m.migrationStatus = mode
+	return nil
+}
+
 func (m *mockModel) AddUser(spec state.UserAccessSpec) (permission.UserAccess, error) {
 	m.MethodCall(m, "AddUser", spec)
 	return permission.UserAccess{}, m.NextErr()
@@ -38,7 +38,21 @@ import (
 
 var logger = loggo.GetLogger("juju.apiserver.modelmanager")
 
-// ModelManagerV4 defines the methods on the version 2 facade for the
+// ModelManagerV5 defines the methods on the version 5 facade for the
+// modelmanager API endpoint.
+type ModelManagerV5 interface {
+	CreateModel(args params.ModelCreateArgs) (params.ModelInfo, error)
+	DumpModels(args params.DumpModelRequest) params.StringResults
+	DumpModelsDB(args params.Entities) params.MapResults
+	ExportModels(args params.Entities) params.SerializedModelResults
+	ListModelSummaries(request params.ModelSummariesRequest) (params.ModelSummaryResults, error)
+	ListModels(user params.Entity) (params.UserModelList, error)
+	DestroyModels(args params.DestroyModelsParams) (params.ErrorResults, error)
+	ModelInfo(args params.Entities) (params.ModelInfoResults, error)
+	ModelStatus(req params.Entities) (params.ModelStatusResults, error)
+}
+
+// ModelManagerV4 defines the methods on the version 4 facade for the
 // modelmanager API endpoint.
 type ModelManagerV4 interface {
 	CreateModel(args params.ModelCreateArgs) (params.ModelInfo, error)
@@ -89,10 +103,16 @@ type ModelManagerAPI struct {
 	callContext context.ProviderCallContext
 }
 
-// ModelManagerAPIV2 provides a way to wrap the different calls between
+// ModelManagerAPIV4 provides a way to wrap the different calls between
+// version 4 and version 5 of the model manager API
+type ModelManagerAPIV4 struct {
+	*ModelManagerAPI
+}
+
+// ModelManagerAPIV3 provides a way to wrap the different calls between
 // version 3 and version 4 of the model manager API
 type ModelManagerAPIV3 struct {
-	*ModelManagerAPI
+	*ModelManagerAPIV4
 }
 
 // ModelManagerAPIV2 provides a way to wrap the different calls between
@@ -102,13 +122,14 @@ type ModelManagerAPIV2 struct {
 }
 
 var (
-	_ ModelManagerV4 = (*ModelManagerAPI)(nil)
+	_ ModelManagerV5 = (*ModelManagerAPI)(nil)
+	_ ModelManagerV4 = (*ModelManagerAPIV4)(nil)
 	_ ModelManagerV3 = (*ModelManagerAPIV3)(nil)
 	_ ModelManagerV2 = (*ModelManagerAPIV2)(nil)
 )
 
-// NewFacadeV4 is used for API registration.
-func NewFacadeV4(ctx facade.Context) (*ModelManagerAPI, error) {
+// NewFacadeV5 is used for API registration.
+func NewFacadeV5(ctx facade.Context) (*ModelManagerAPI, error) {
 	st := ctx.State()
 	pool := ctx.StatePool()
 	ctlrSt := pool.SystemState()
@@ -137,6 +158,15 @@ func NewFacadeV4(ctx facade.Context) (*ModelManagerAPI, error) {
 	)
 }
 
+// NewFacadeV4 is used for API registration.
+func NewFacadeV4(ctx facade.Context) (*ModelManagerAPIV4, error) {
+	v5, err := NewFacadeV5(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &ModelManagerAPIV4{v5}, nil
+}
+
 // NewFacadeV3 is used for API registration.
 func NewFacadeV3(ctx facade.Context) (*ModelManagerAPIV3, error) {
 	v4, err := NewFacadeV4(ctx)
@@ -691,6 +721,84 @@ func (m *ModelManagerAPI) DumpModelsDB(args params.Entities) params.MapResults {
 	return results
 }
 
+// ExportModels exports the models, returning their serialized
+// descriptions along with the charms, agent binaries and resources
+// they use, so that they may be imported into another controller
+// without the controllers communicating. Each exported model is marked
+// as being migrated, so that it can no longer be changed here. The user
+// needs to either be a controller admin, or have admin privileges on the
+// model itself.
+func (m *ModelManagerAPI) ExportModels(args params.Entities) params.SerializedModelResults {
+	results := params.SerializedModelResults{
+		Results: make([]params.SerializedModelResult, len(args.Entities)),
+	}
+	for i, entity := range args.Entities {
+		serialized, err := m.exportModel(entity)
+		if err != nil {
+			results.Results[i].Error = common.ServerError(err)
+			continue
+		}
+		results.Results[i].Result = serialized
+	}
+	return results
+}
+
+func (m *ModelManagerAPI) exportModel(args params.Entity) (_ params.SerializedModel, err error) {
+	modelTag, err := names.ParseModelTag(args.Tag)
+	if err != nil {
+		return params.SerializedModel{}, errors.Trace(err)
+	}
+
+	isModelAdmin, err := m.authorizer.HasPermission(permission.AdminAccess, modelTag)
+	if err != nil {
+		return params.SerializedModel{}, errors.Trace(err)
+	}
+	if !isModelAdmin && !m.isAdmin {
+		return params.SerializedModel{}, common.ErrPerm
+	}
+
+	st, release, err := m.state.GetBackend(modelTag.Id())
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return params.SerializedModel{}, errors.Trace(common.ErrBadId)
+		}
+		return params.SerializedModel{}, errors.Trace(err)
+	}
+	defer release()
+
+	model, err := st.Model()
+	if err != nil {
+		return params.SerializedModel{}, errors.Trace(err)
+	}
+	if model.MigrationMode() != state.MigrationModeNone {
+		return params.SerializedModel{}, errors.Errorf("model %q is already being migrated", model.Name())
+	}
+	// The model is marked as exporting before it is serialized, so
+	// that it cannot change once the copy has been taken; from then
+	// on the model belongs to the controller it is imported into.
+	if err := model.SetMigrationMode(state.MigrationModeExporting); err != nil {
+		return params.SerializedModel{}, errors.Trace(err)
+	}
+	defer func() {
+		if err == nil {
+			return
+		}
+		if resetErr := model.SetMigrationMode(state.MigrationModeNone); resetErr != nil {
+			logger.Errorf("cannot reset migration mode of model %q: %v", model.Name(), resetErr)
+		}
+	}()
+
+	exported, err := st.Export()
+	if err != nil {
+		return params.SerializedModel{}, errors.Trace(err)
+	}
+	serialized, err := common.SerializeModel(exported)
+	return serialized, errors.Trace(err)
+}
+
+// ExportModels isn't on the V4 API.
+func (*ModelManagerAPIV4) ExportModels(_, _ struct{}) {}
+
 // ListModelSummaries returns models that the specified user
 // has access to in the current server.  Controller admins (superuser)
 // can list models for any user.  Other users
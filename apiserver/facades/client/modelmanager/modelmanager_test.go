@@ -637,7 +637,7 @@ func (s *modelManagerSuite) TestUnsetModelDefaultsAsNormalUser(c *gc.C) {
 
 func (s *modelManagerSuite) TestDumpModelV2(c *gc.C) {
 	api := &modelmanager.ModelManagerAPIV2{
-		&modelmanager.ModelManagerAPIV3{s.api},
+		&modelmanager.ModelManagerAPIV3{&modelmanager.ModelManagerAPIV4{s.api}},
 	}
 
 	results := api.DumpModels(params.Entities{[]params.Entity{{
@@ -718,6 +718,54 @@ func (s *modelManagerSuite) TestDumpModelUsers(c *gc.C) {
 	}
 }
 
+func (s *modelManagerSuite) TestExportModels(c *gc.C) {
+	results := s.api.ExportModels(params.Entities{[]params.Entity{{
+		Tag: "bad-tag",
+	}, {
+		Tag: "application-foo",
+	}, {
+		Tag: s.st.ModelTag().String(),
+	}}})
+
+	c.Assert(results.Results, gc.HasLen, 3)
+	bad, notApp, good := results.Results[0], results.Results[1], results.Results[2]
+	c.Check(bad.Error.Message, gc.Equals, `"bad-tag" is not a valid tag`)
+	c.Check(notApp.Error.Message, gc.Equals, `"application-foo" is not a valid model tag`)
+
+	c.Check(good.Error, gc.IsNil)
+	c.Check(string(good.Result.Bytes), gc.Equals, "model-uuid: deadbeef-0bad-400d-8000-4b1d0d06f00d\n")
+	c.Check(good.Result.Charms, gc.HasLen, 0)
+	c.Check(good.Result.Tools, gc.HasLen, 0)
+	c.Check(good.Result.Resources, gc.HasLen, 0)
+
+	// The exported model is marked as being migrated.
+	c.Check(s.st.model.migrationStatus, gc.Equals, state.MigrationModeExporting)
+}
+
+func (s *modelManagerSuite) TestExportModelsAlreadyMigrating(c *gc.C) {
+	s.st.model.migrationStatus = state.MigrationModeExporting
+	results := s.api.ExportModels(params.Entities{[]params.Entity{{
+		Tag: s.st.ModelTag().String(),
+	}}})
+	c.Assert(results.Results, gc.HasLen, 1)
+	c.Assert(results.Results[0].Error, gc.NotNil)
+	c.Check(results.Results[0].Error.Message, gc.Equals, `model "only" is already being migrated`)
+}
+
+func (s *modelManagerSuite) TestExportModelsUsers(c *gc.C) {
+	models := params.Entities{[]params.Entity{{Tag: s.st.ModelTag().String()}}}
+	for _, user := range []names.UserTag{
+		names.NewUserTag("otheruser"),
+		names.NewUserTag("unknown"),
+	} {
+		s.setAPIUser(c, user)
+		results := s.api.ExportModels(models)
+		c.Assert(results.Results, gc.HasLen, 1)
+		c.Assert(results.Results[0].Error, gc.NotNil)
+		c.Check(results.Results[0].Error.Message, gc.Equals, `permission denied`)
+	}
+}
+
 func (s *modelManagerSuite) TestDumpModelsDB(c *gc.C) {
 	results := s.api.DumpModelsDB(params.Entities{[]params.Entity{{
 		Tag: "bad-tag",
@@ -817,7 +865,7 @@ func (s *modelManagerSuite) TestAddModelCantCreateModelForSomeoneElse(c *gc.C) {
 }
 
 func (s *modelManagerSuite) TestDestroyModelsV3(c *gc.C) {
-	api := &modelmanager.ModelManagerAPIV3{s.api}
+	api := &modelmanager.ModelManagerAPIV3{&modelmanager.ModelManagerAPIV4{s.api}}
 	results, err := api.DestroyModels(params.Entities{
 		Entities: []params.Entity{{coretesting.ModelTag.String()}},
 	})
@@ -1581,7 +1629,7 @@ func (s *modelManagerStateSuite) TestModifyModelAccessInvalidAction(c *gc.C) {
 
 func (s *modelManagerSuite) TestModelStatusV2(c *gc.C) {
 	api := &modelmanager.ModelManagerAPIV2{
-		&modelmanager.ModelManagerAPIV3{s.api},
+		&modelmanager.ModelManagerAPIV3{&modelmanager.ModelManagerAPIV4{s.api}},
 	}
 	// Check that we err out immediately if a model errs.
 	results, err := api.ModelStatus(params.Entities{[]params.Entity{{
@@ -1610,7 +1658,7 @@ func (s *modelManagerSuite) TestModelStatusV2(c *gc.C) {
 }
 
 func (s *modelManagerSuite) TestModelStatusV3(c *gc.C) {
-	api := &modelmanager.ModelManagerAPIV3{s.api}
+	api := &modelmanager.ModelManagerAPIV3{&modelmanager.ModelManagerAPIV4{s.api}}
 
 	// Check that we err out immediately if a model errs.
 	results, err := api.ModelStatus(params.Entities{[]params.Entity{{
@@ -0,0 +1,86 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package relationdata
+
+import (
+	"github.com/juju/errors"
+	"gopkg.in/juju/names.v2"
+
+	"github.com/juju/juju/state"
+)
+
+// Backend defines the state functionality required by the
+// relationdata facade.
+type Backend interface {
+	ModelTag() names.ModelTag
+	Application(name string) (Application, error)
+	Unit(name string) (Unit, error)
+}
+
+// Application defines the application functionality required by
+// the facade.
+type Application interface {
+	Endpoints() ([]state.Endpoint, error)
+	Relations() ([]Relation, error)
+}
+
+// Unit defines the unit functionality required by the facade.
+type Unit interface {
+	ApplicationName() string
+}
+
+// Relation defines the relation functionality required by the facade.
+type Relation interface {
+	Id() int
+	String() string
+	Endpoint(applicationName string) (state.Endpoint, error)
+	RelatedEndpoints(applicationName string) ([]state.Endpoint, error)
+	AllUnitSettings() (map[string]map[string]interface{}, error)
+	ApplicationSettings(applicationName string) (map[string]interface{}, error)
+}
+
+// NewStateBackend converts a state.State into a Backend.
+func NewStateBackend(st *state.State) Backend {
+	return stateShim{st}
+}
+
+type stateShim struct {
+	st *state.State
+}
+
+func (s stateShim) ModelTag() names.ModelTag {
+	return s.st.ModelTag()
+}
+
+func (s stateShim) Application(name string) (Application, error) {
+	app, err := s.st.Application(name)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return applicationShim{app}, nil
+}
+
+func (s stateShim) Unit(name string) (Unit, error) {
+	unit, err := s.st.Unit(name)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return unit, nil
+}
+
+type applicationShim struct {
+	*state.Application
+}
+
+func (a applicationShim) Relations() ([]Relation, error) {
+	relations, err := a.Application.Relations()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	result := make([]Relation, len(relations))
+	for i, rel := range relations {
+		result[i] = rel
+	}
+	return result, nil
+}
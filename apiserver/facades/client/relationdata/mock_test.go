@@ -0,0 +1,133 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package relationdata_test
+
+import (
+	"fmt"
+
+	"github.com/juju/errors"
+	jtesting "github.com/juju/testing"
+	"gopkg.in/juju/charm.v6"
+	"gopkg.in/juju/names.v2"
+
+	"github.com/juju/juju/apiserver/facades/client/relationdata"
+	"github.com/juju/juju/state"
+)
+
+type mockBackend struct {
+	jtesting.Stub
+
+	modelTag     names.ModelTag
+	applications map[string]*mockApplication
+	units        map[string]*mockUnit
+}
+
+func (m *mockBackend) ModelTag() names.ModelTag {
+	return m.modelTag
+}
+
+func (m *mockBackend) Application(name string) (relationdata.Application, error) {
+	m.MethodCall(m, "Application", name)
+	if err := m.NextErr(); err != nil {
+		return nil, err
+	}
+	app, ok := m.applications[name]
+	if !ok {
+		return nil, errors.NotFoundf("application %q", name)
+	}
+	return app, nil
+}
+
+func (m *mockBackend) Unit(name string) (relationdata.Unit, error) {
+	m.MethodCall(m, "Unit", name)
+	if err := m.NextErr(); err != nil {
+		return nil, err
+	}
+	unit, ok := m.units[name]
+	if !ok {
+		return nil, errors.NotFoundf("unit %q", name)
+	}
+	return unit, nil
+}
+
+type mockApplication struct {
+	endpoints []state.Endpoint
+	relations []*mockRelation
+}
+
+func (m *mockApplication) Endpoints() ([]state.Endpoint, error) {
+	return m.endpoints, nil
+}
+
+func (m *mockApplication) Relations() ([]relationdata.Relation, error) {
+	relations := make([]relationdata.Relation, len(m.relations))
+	for i, rel := range m.relations {
+		relations[i] = rel
+	}
+	return relations, nil
+}
+
+type mockUnit struct {
+	application string
+}
+
+func (m *mockUnit) ApplicationName() string {
+	return m.application
+}
+
+type mockRelation struct {
+	id          int
+	endpoints   []state.Endpoint
+	settings    map[string]map[string]interface{}
+	appSettings map[string]map[string]interface{}
+}
+
+func (m *mockRelation) Id() int {
+	return m.id
+}
+
+func (m *mockRelation) String() string {
+	return fmt.Sprintf("%s:%s %s:%s",
+		m.endpoints[0].ApplicationName, m.endpoints[0].Name,
+		m.endpoints[1].ApplicationName, m.endpoints[1].Name,
+	)
+}
+
+func (m *mockRelation) Endpoint(applicationName string) (state.Endpoint, error) {
+	for _, ep := range m.endpoints {
+		if ep.ApplicationName == applicationName {
+			return ep, nil
+		}
+	}
+	return state.Endpoint{}, errors.NotFoundf("endpoint of application %q", applicationName)
+}
+
+func (m *mockRelation) RelatedEndpoints(applicationName string) ([]state.Endpoint, error) {
+	for i, ep := range m.endpoints {
+		if ep.ApplicationName == applicationName {
+			return []state.Endpoint{m.endpoints[1-i]}, nil
+		}
+	}
+	return nil, errors.NotFoundf("endpoint of application %q", applicationName)
+}
+
+func (m *mockRelation) AllUnitSettings() (map[string]map[string]interface{}, error) {
+	return m.settings, nil
+}
+
+func (m *mockRelation) ApplicationSettings(applicationName string) (map[string]interface{}, error) {
+	return m.appSettings[applicationName], nil
+}
+
+func endpoint(application, name string, role charm.RelationRole) state.Endpoint {
+	return state.Endpoint{
+		ApplicationName: application,
+		Relation: charm.Relation{
+			Name:      name,
+			Role:      role,
+			Interface: "mysql",
+			Scope:     charm.ScopeGlobal,
+		},
+	}
+}
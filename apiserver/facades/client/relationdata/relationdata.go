@@ -0,0 +1,205 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package relationdata provides a client facade reporting the
+// settings that units have published in their relations.
+package relationdata
+
+import (
+	"sort"
+
+	"github.com/juju/errors"
+	"gopkg.in/juju/names.v2"
+
+	"github.com/juju/juju/apiserver/common"
+	"github.com/juju/juju/apiserver/facade"
+	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/permission"
+)
+
+// API provides the RelationData facade.
+type API struct {
+	backend    Backend
+	authorizer facade.Authorizer
+}
+
+// NewFacade provides the signature required for facade registration.
+func NewFacade(ctx facade.Context) (*API, error) {
+	return NewAPI(NewStateBackend(ctx.State()), ctx.Auth())
+}
+
+// NewAPI returns a new RelationData API facade.
+func NewAPI(backend Backend, authorizer facade.Authorizer) (*API, error) {
+	if !authorizer.AuthClient() {
+		return nil, common.ErrPerm
+	}
+	return &API{
+		backend:    backend,
+		authorizer: authorizer,
+	}, nil
+}
+
+// Relation settings are written by charms for each other, and may hold
+// credentials; only model administrators may read them.
+func (api *API) checkIsAdmin() error {
+	isAdmin, err := api.authorizer.HasPermission(permission.AdminAccess, api.backend.ModelTag())
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if !isAdmin {
+		return common.ErrPerm
+	}
+	return nil
+}
+
+// RelationData returns, for each of the specified application or unit
+// endpoints, the settings of the units on both sides of each relation
+// of that endpoint. When a unit is specified, the settings of the other
+// units of its application are omitted.
+func (api *API) RelationData(args params.RelationDataArgs) (params.RelationDataResults, error) {
+	if err := api.checkIsAdmin(); err != nil {
+		return params.RelationDataResults{}, errors.Trace(err)
+	}
+	results := params.RelationDataResults{
+		Results: make([]params.RelationDataResult, len(args.Args)),
+	}
+	for i, arg := range args.Args {
+		relations, err := api.relationData(arg)
+		if err != nil {
+			results.Results[i].Error = common.ServerError(err)
+			continue
+		}
+		results.Results[i].Relations = relations
+	}
+	return results, nil
+}
+
+func (api *API) relationData(arg params.RelationDataArg) ([]params.RelationData, error) {
+	tag, err := names.ParseTag(arg.Entity)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	var appName, unitName string
+	switch tag := tag.(type) {
+	case names.ApplicationTag:
+		appName = tag.Id()
+	case names.UnitTag:
+		unit, err := api.backend.Unit(tag.Id())
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		appName = unit.ApplicationName()
+		unitName = tag.Id()
+	default:
+		return nil, errors.NotValidf("entity %q", arg.Entity)
+	}
+
+	app, err := api.backend.Application(appName)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if err := checkEndpoint(app, appName, arg.Endpoint); err != nil {
+		return nil, errors.Trace(err)
+	}
+	relations, err := app.Relations()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	result := []params.RelationData{}
+	for _, rel := range relations {
+		ep, err := rel.Endpoint(appName)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		if ep.Name != arg.Endpoint {
+			continue
+		}
+		data, err := relationData(rel, appName, unitName)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		data.Endpoint = appName + ":" + ep.Name
+		result = append(result, data)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].RelationId < result[j].RelationId
+	})
+	return result, nil
+}
+
+func checkEndpoint(app Application, appName, endpoint string) error {
+	endpoints, err := app.Endpoints()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	for _, ep := range endpoints {
+		if ep.Name == endpoint {
+			return nil
+		}
+	}
+	return errors.NotFoundf("endpoint %q of application %q", endpoint, appName)
+}
+
+// relationData returns the settings of the units in the relation's
+// scope, and of the applications that have published any. If unitName
+// is not empty, the other units of the named application are omitted.
+func relationData(rel Relation, appName, unitName string) (params.RelationData, error) {
+	related, err := rel.RelatedEndpoints(appName)
+	if err != nil {
+		return params.RelationData{}, errors.Trace(err)
+	}
+	settings, err := rel.AllUnitSettings()
+	if err != nil {
+		return params.RelationData{}, errors.Trace(err)
+	}
+	data := params.RelationData{
+		RelationId: rel.Id(),
+		Key:        rel.String(),
+		Units:      []params.RelationUnitData{},
+	}
+	if len(related) > 0 {
+		data.RelatedEndpoint = related[0].ApplicationName + ":" + related[0].Name
+	}
+	for name, unitSettings := range settings {
+		if unitName != "" && name != unitName {
+			unitAppName, err := names.UnitApplication(name)
+			if err != nil {
+				return params.RelationData{}, errors.Trace(err)
+			}
+			if unitAppName == appName {
+				continue
+			}
+		}
+		data.Units = append(data.Units, params.RelationUnitData{
+			Unit:     name,
+			Settings: unitSettings,
+		})
+	}
+	sort.Slice(data.Units, func(i, j int) bool {
+		return data.Units[i].Unit < data.Units[j].Unit
+	})
+
+	appNames := []string{appName}
+	for _, ep := range related {
+		if ep.ApplicationName != appName {
+			appNames = append(appNames, ep.ApplicationName)
+		}
+	}
+	for _, name := range appNames {
+		appSettings, err := rel.ApplicationSettings(name)
+		if err != nil {
+			return params.RelationData{}, errors.Trace(err)
+		}
+		if len(appSettings) == 0 {
+			continue
+		}
+		data.Applications = append(data.Applications, params.RelationApplicationData{
+			Application: name,
+			Settings:    appSettings,
+		})
+	}
+	sort.Slice(data.Applications, func(i, j int) bool {
+		return data.Applications[i].Application < data.Applications[j].Application
+	})
+	return data, nil
+}
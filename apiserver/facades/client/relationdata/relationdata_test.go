@@ -0,0 +1,163 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package relationdata_test
+
+import (
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+	"gopkg.in/juju/charm.v6"
+	"gopkg.in/juju/names.v2"
+
+	"github.com/juju/juju/apiserver/common"
+	"github.com/juju/juju/apiserver/facades/client/relationdata"
+	"github.com/juju/juju/apiserver/params"
+	apiservertesting "github.com/juju/juju/apiserver/testing"
+	"github.com/juju/juju/state"
+	coretesting "github.com/juju/juju/testing"
+)
+
+type RelationDataSuite struct {
+	testing.IsolationSuite
+
+	backend    *mockBackend
+	authorizer apiservertesting.FakeAuthorizer
+}
+
+var _ = gc.Suite(&RelationDataSuite{})
+
+func (s *RelationDataSuite) SetUpTest(c *gc.C) {
+	s.IsolationSuite.SetUpTest(c)
+	s.authorizer = apiservertesting.FakeAuthorizer{
+		Tag: names.NewUserTag("admin"),
+	}
+	wordpressDB := endpoint("wordpress", "db", charm.RoleRequirer)
+	mysqlServer := endpoint("mysql", "server", charm.RoleProvider)
+	db := &mockRelation{
+		id:        1,
+		endpoints: []state.Endpoint{wordpressDB, mysqlServer},
+		settings: map[string]map[string]interface{}{
+			"wordpress/0": {"ingress-address": "10.0.0.1"},
+			"wordpress/1": {"ingress-address": "10.0.0.2"},
+			"mysql/0":     {"user": "wordpress", "password": "sekrit"},
+		},
+		appSettings: map[string]map[string]interface{}{
+			"mysql": {"database": "wordpress"},
+		},
+	}
+	s.backend = &mockBackend{
+		modelTag: coretesting.ModelTag,
+		applications: map[string]*mockApplication{
+			"wordpress": {
+				endpoints: []state.Endpoint{
+					wordpressDB,
+					endpoint("wordpress", "cache", charm.RoleRequirer),
+				},
+				relations: []*mockRelation{db},
+			},
+		},
+		units: map[string]*mockUnit{
+			"wordpress/0": {application: "wordpress"},
+		},
+	}
+}
+
+func (s *RelationDataSuite) newAPI(c *gc.C) *relationdata.API {
+	api, err := relationdata.NewAPI(s.backend, s.authorizer)
+	c.Assert(err, jc.ErrorIsNil)
+	return api
+}
+
+func (s *RelationDataSuite) TestNewAPINotClient(c *gc.C) {
+	s.authorizer.Tag = names.NewMachineTag("0")
+	_, err := relationdata.NewAPI(s.backend, s.authorizer)
+	c.Assert(err, gc.Equals, common.ErrPerm)
+}
+
+func (s *RelationDataSuite) TestRelationDataRequiresAdmin(c *gc.C) {
+	s.authorizer.Tag = names.NewUserTag("write")
+	_, err := s.newAPI(c).RelationData(params.RelationDataArgs{})
+	c.Assert(err, gc.ErrorMatches, "permission denied")
+}
+
+func (s *RelationDataSuite) TestRelationDataApplication(c *gc.C) {
+	result, err := s.newAPI(c).RelationData(params.RelationDataArgs{
+		Args: []params.RelationDataArg{
+			{Entity: "application-wordpress", Endpoint: "db"},
+			{Entity: "application-wordpress", Endpoint: "cache"},
+		},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result, jc.DeepEquals, params.RelationDataResults{
+		Results: []params.RelationDataResult{{
+			Relations: []params.RelationData{{
+				RelationId:      1,
+				Key:             "wordpress:db mysql:server",
+				Endpoint:        "wordpress:db",
+				RelatedEndpoint: "mysql:server",
+				Units: []params.RelationUnitData{{
+					Unit:     "mysql/0",
+					Settings: map[string]interface{}{"user": "wordpress", "password": "sekrit"},
+				}, {
+					Unit:     "wordpress/0",
+					Settings: map[string]interface{}{"ingress-address": "10.0.0.1"},
+				}, {
+					Unit:     "wordpress/1",
+					Settings: map[string]interface{}{"ingress-address": "10.0.0.2"},
+				}},
+				Applications: []params.RelationApplicationData{{
+					Application: "mysql",
+					Settings:    map[string]interface{}{"database": "wordpress"},
+				}},
+			}},
+		}, {
+			Relations: []params.RelationData{},
+		}},
+	})
+}
+
+func (s *RelationDataSuite) TestRelationDataUnit(c *gc.C) {
+	result, err := s.newAPI(c).RelationData(params.RelationDataArgs{
+		Args: []params.RelationDataArg{{Entity: "unit-wordpress-0", Endpoint: "db"}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.Results, gc.HasLen, 1)
+	c.Assert(result.Results[0].Error, gc.IsNil)
+	c.Assert(result.Results[0].Relations, gc.HasLen, 1)
+	c.Assert(result.Results[0].Relations[0].Units, jc.DeepEquals, []params.RelationUnitData{{
+		Unit:     "mysql/0",
+		Settings: map[string]interface{}{"user": "wordpress", "password": "sekrit"},
+	}, {
+		Unit:     "wordpress/0",
+		Settings: map[string]interface{}{"ingress-address": "10.0.0.1"},
+	}})
+}
+
+func (s *RelationDataSuite) TestRelationDataErrors(c *gc.C) {
+	result, err := s.newAPI(c).RelationData(params.RelationDataArgs{
+		Args: []params.RelationDataArg{
+			{Entity: "application-wordpress", Endpoint: "website"},
+			{Entity: "application-mysql", Endpoint: "server"},
+			{Entity: "unit-wordpress-9", Endpoint: "db"},
+			{Entity: "machine-0", Endpoint: "db"},
+			{Entity: "wordpress", Endpoint: "db"},
+		},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.Results, gc.HasLen, 5)
+	c.Assert(result.Results[0].Error, jc.DeepEquals, &params.Error{
+		Message: `endpoint "website" of application "wordpress" not found`,
+		Code:    params.CodeNotFound,
+	})
+	c.Assert(result.Results[1].Error, jc.DeepEquals, &params.Error{
+		Message: `application "mysql" not found`,
+		Code:    params.CodeNotFound,
+	})
+	c.Assert(result.Results[2].Error, jc.DeepEquals, &params.Error{
+		Message: `unit "wordpress/9" not found`,
+		Code:    params.CodeNotFound,
+	})
+	c.Assert(result.Results[3].Error, gc.ErrorMatches, `entity "machine-0" not valid`)
+	c.Assert(result.Results[4].Error, gc.ErrorMatches, `"wordpress" is not a valid tag`)
+}
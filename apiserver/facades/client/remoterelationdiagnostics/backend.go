@@ -0,0 +1,146 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package remoterelationdiagnostics
+
+import (
+	"github.com/juju/errors"
+	"gopkg.in/juju/names.v2"
+	"gopkg.in/macaroon.v2-unstable"
+
+	"github.com/juju/juju/core/crossmodel"
+	"github.com/juju/juju/state"
+	"github.com/juju/juju/status"
+)
+
+// Backend defines the state functionality required by the
+// remoterelationdiagnostics facade.
+type Backend interface {
+	ModelTag() names.ModelTag
+	AllRemoteApplications() ([]RemoteApplication, error)
+	RemoteApplication(name string) (RemoteApplication, error)
+
+	// GetToken and GetMacaroon return the token and macaroon
+	// recorded for the given remote entity.
+	GetToken(names.Tag) (string, error)
+	GetMacaroon(names.Tag) (*macaroon.Macaroon, error)
+
+	// ControllerForModel returns the connection details of the
+	// external controller hosting the specified model.
+	ControllerForModel(modelUUID string) (crossmodel.ControllerInfo, error)
+
+	// RemoteRelationEvents returns the events most recently
+	// exchanged for the relation with the given key.
+	RemoteRelationEvents(relationKey string) (state.RemoteRelationEvents, error)
+
+	// IngressNetworks and EgressNetworks return the CIDRs
+	// saved for the relation with the given key.
+	IngressNetworks(relationKey string) ([]string, error)
+	EgressNetworks(relationKey string) ([]string, error)
+}
+
+// RemoteApplication defines the remote application
+// functionality required by the facade.
+type RemoteApplication interface {
+	Name() string
+	OfferUUID() string
+	URL() (string, bool)
+	SourceModel() names.ModelTag
+	IsConsumerProxy() bool
+	Relations() ([]Relation, error)
+}
+
+// Relation defines the relation functionality required by the facade.
+type Relation interface {
+	Id() int
+	String() string
+	Tag() names.Tag
+	Status() (status.StatusInfo, error)
+}
+
+// NewStateBackend converts a state.State into a Backend.
+func NewStateBackend(st *state.State) Backend {
+	return stateShim{st}
+}
+
+type stateShim struct {
+	st *state.State
+}
+
+func (s stateShim) ModelTag() names.ModelTag {
+	return s.st.ModelTag()
+}
+
+func (s stateShim) AllRemoteApplications() ([]RemoteApplication, error) {
+	apps, err := s.st.AllRemoteApplications()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	result := make([]RemoteApplication, len(apps))
+	for i, app := range apps {
+		result[i] = remoteApplicationShim{app}
+	}
+	return result, nil
+}
+
+func (s stateShim) RemoteApplication(name string) (RemoteApplication, error) {
+	app, err := s.st.RemoteApplication(name)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return remoteApplicationShim{app}, nil
+}
+
+func (s stateShim) GetToken(entity names.Tag) (string, error) {
+	return s.st.RemoteEntities().GetToken(entity)
+}
+
+func (s stateShim) GetMacaroon(entity names.Tag) (*macaroon.Macaroon, error) {
+	return s.st.RemoteEntities().GetMacaroon(entity)
+}
+
+func (s stateShim) ControllerForModel(modelUUID string) (crossmodel.ControllerInfo, error) {
+	ec, err := state.NewExternalControllers(s.st).ControllerForModel(modelUUID)
+	if err != nil {
+		return crossmodel.ControllerInfo{}, errors.Trace(err)
+	}
+	return ec.ControllerInfo(), nil
+}
+
+func (s stateShim) RemoteRelationEvents(relationKey string) (state.RemoteRelationEvents, error) {
+	return s.st.RemoteRelationEvents(relationKey)
+}
+
+func (s stateShim) IngressNetworks(relationKey string) ([]string, error) {
+	return relationNetworks(state.NewRelationIngressNetworks(s.st), relationKey)
+}
+
+func (s stateShim) EgressNetworks(relationKey string) ([]string, error) {
+	return relationNetworks(state.NewRelationEgressNetworks(s.st), relationKey)
+}
+
+func relationNetworks(rn state.RelationNetworker, relationKey string) ([]string, error) {
+	networks, err := rn.Networks(relationKey)
+	if errors.IsNotFound(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return networks.CIDRS(), nil
+}
+
+type remoteApplicationShim struct {
+	*state.RemoteApplication
+}
+
+func (a remoteApplicationShim) Relations() ([]Relation, error) {
+	rels, err := a.RemoteApplication.Relations()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	result := make([]Relation, len(rels))
+	for i, rel := range rels {
+		result[i] = rel
+	}
+	return result, nil
+}
@@ -0,0 +1,175 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package remoterelationdiagnostics_test
+
+import (
+	"net"
+	"time"
+
+	"github.com/juju/errors"
+	jtesting "github.com/juju/testing"
+	"gopkg.in/juju/names.v2"
+	"gopkg.in/macaroon.v2-unstable"
+
+	"github.com/juju/juju/apiserver/facades/client/remoterelationdiagnostics"
+	"github.com/juju/juju/core/crossmodel"
+	"github.com/juju/juju/state"
+	"github.com/juju/juju/status"
+)
+
+type mockBackend struct {
+	jtesting.Stub
+
+	modelTag    names.ModelTag
+	apps        map[string]*mockRemoteApplication
+	tokens      map[names.Tag]string
+	macaroons   map[names.Tag]*macaroon.Macaroon
+	controllers map[string]crossmodel.ControllerInfo
+	events      map[string]state.RemoteRelationEvents
+	ingress     map[string][]string
+	egress      map[string][]string
+}
+
+func (m *mockBackend) ModelTag() names.ModelTag {
+	return m.modelTag
+}
+
+func (m *mockBackend) AllRemoteApplications() ([]remoterelationdiagnostics.RemoteApplication, error) {
+	m.MethodCall(m, "AllRemoteApplications")
+	if err := m.NextErr(); err != nil {
+		return nil, err
+	}
+	var result []remoterelationdiagnostics.RemoteApplication
+	for _, app := range m.apps {
+		result = append(result, app)
+	}
+	return result, nil
+}
+
+func (m *mockBackend) RemoteApplication(name string) (remoterelationdiagnostics.RemoteApplication, error) {
+	m.MethodCall(m, "RemoteApplication", name)
+	if err := m.NextErr(); err != nil {
+		return nil, err
+	}
+	app, ok := m.apps[name]
+	if !ok {
+		return nil, errors.NotFoundf("remote application %q", name)
+	}
+	return app, nil
+}
+
+func (m *mockBackend) GetToken(entity names.Tag) (string, error) {
+	m.MethodCall(m, "GetToken", entity)
+	token, ok := m.tokens[entity]
+	if !ok {
+		return "", errors.NotFoundf("token for %v", entity)
+	}
+	return token, nil
+}
+
+func (m *mockBackend) GetMacaroon(entity names.Tag) (*macaroon.Macaroon, error) {
+	m.MethodCall(m, "GetMacaroon", entity)
+	mac, ok := m.macaroons[entity]
+	if !ok {
+		return nil, errors.NotFoundf("macaroon for %v", entity)
+	}
+	return mac, nil
+}
+
+func (m *mockBackend) ControllerForModel(modelUUID string) (crossmodel.ControllerInfo, error) {
+	m.MethodCall(m, "ControllerForModel", modelUUID)
+	info, ok := m.controllers[modelUUID]
+	if !ok {
+		return crossmodel.ControllerInfo{}, errors.NotFoundf("external controller with model %v", modelUUID)
+	}
+	return info, nil
+}
+
+func (m *mockBackend) RemoteRelationEvents(relationKey string) (state.RemoteRelationEvents, error) {
+	m.MethodCall(m, "RemoteRelationEvents", relationKey)
+	events, ok := m.events[relationKey]
+	if !ok {
+		return events, errors.NotFoundf("remote relation events for %q", relationKey)
+	}
+	return events, nil
+}
+
+func (m *mockBackend) IngressNetworks(relationKey string) ([]string, error) {
+	m.MethodCall(m, "IngressNetworks", relationKey)
+	return m.ingress[relationKey], nil
+}
+
+func (m *mockBackend) EgressNetworks(relationKey string) ([]string, error) {
+	m.MethodCall(m, "EgressNetworks", relationKey)
+	return m.egress[relationKey], nil
+}
+
+type mockRemoteApplication struct {
+	name          string
+	offerUUID     string
+	url           string
+	sourceModel   names.ModelTag
+	consumerProxy bool
+	relations     []remoterelationdiagnostics.Relation
+}
+
+func (a *mockRemoteApplication) Name() string {
+	return a.name
+}
+
+func (a *mockRemoteApplication) OfferUUID() string {
+	return a.offerUUID
+}
+
+func (a *mockRemoteApplication) URL() (string, bool) {
+	return a.url, a.url != ""
+}
+
+func (a *mockRemoteApplication) SourceModel() names.ModelTag {
+	return a.sourceModel
+}
+
+func (a *mockRemoteApplication) IsConsumerProxy() bool {
+	return a.consumerProxy
+}
+
+func (a *mockRemoteApplication) Relations() ([]remoterelationdiagnostics.Relation, error) {
+	return a.relations, nil
+}
+
+type mockRelation struct {
+	id     int
+	key    string
+	status status.StatusInfo
+}
+
+func (r *mockRelation) Id() int {
+	return r.id
+}
+
+func (r *mockRelation) String() string {
+	return r.key
+}
+
+func (r *mockRelation) Tag() names.Tag {
+	return names.NewRelationTag(r.key)
+}
+
+func (r *mockRelation) Status() (status.StatusInfo, error) {
+	return r.status, nil
+}
+
+type mockDialer struct {
+	jtesting.Stub
+}
+
+func (d *mockDialer) Dial(network, address string, timeout time.Duration) (net.Conn, error) {
+	d.MethodCall(d, "Dial", network, address, timeout)
+	if err := d.NextErr(); err != nil {
+		return nil, err
+	}
+	client, server := net.Pipe()
+	server.Close()
+	return client, nil
+}
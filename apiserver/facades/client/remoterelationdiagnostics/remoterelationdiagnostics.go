@@ -0,0 +1,256 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package remoterelationdiagnostics provides a client facade reporting
+// on the health of the cross model relations in a model.
+package remoterelationdiagnostics
+
+import (
+	"net"
+	"sort"
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/utils/clock"
+	"gopkg.in/juju/names.v2"
+	"gopkg.in/macaroon-bakery.v2-unstable/bakery/checkers"
+	"gopkg.in/macaroon.v2-unstable"
+
+	"github.com/juju/juju/apiserver/common"
+	"github.com/juju/juju/apiserver/facade"
+	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/permission"
+	"github.com/juju/juju/state"
+)
+
+// dialTimeout is how long to wait when checking whether
+// a remote controller address accepts connections.
+const dialTimeout = 5 * time.Second
+
+// DialFunc is used to check whether an address is reachable.
+type DialFunc func(network, address string, timeout time.Duration) (net.Conn, error)
+
+// API provides the RemoteRelationDiagnostics facade.
+type API struct {
+	backend    Backend
+	authorizer facade.Authorizer
+	clock      clock.Clock
+	dial       DialFunc
+}
+
+// NewFacade provides the signature required for facade registration.
+func NewFacade(ctx facade.Context) (*API, error) {
+	return NewAPI(
+		NewStateBackend(ctx.State()),
+		ctx.Auth(),
+		clock.WallClock,
+		net.DialTimeout,
+	)
+}
+
+// NewAPI returns a new RemoteRelationDiagnostics API facade.
+func NewAPI(
+	backend Backend,
+	authorizer facade.Authorizer,
+	clock clock.Clock,
+	dial DialFunc,
+) (*API, error) {
+	if !authorizer.AuthClient() {
+		return nil, common.ErrPerm
+	}
+	return &API{
+		backend:    backend,
+		authorizer: authorizer,
+		clock:      clock,
+		dial:       dial,
+	}, nil
+}
+
+func (api *API) checkCanRead() error {
+	canRead, err := api.authorizer.HasPermission(permission.ReadAccess, api.backend.ModelTag())
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if !canRead {
+		return common.ErrPerm
+	}
+	return nil
+}
+
+// RemoteRelations returns diagnostics for the relations of the specified
+// remote applications, or of all remote applications if none are specified.
+func (api *API) RemoteRelations(args params.RemoteRelationDiagnosticsArgs) (params.RemoteRelationDiagnosticsResults, error) {
+	var results params.RemoteRelationDiagnosticsResults
+	if err := api.checkCanRead(); err != nil {
+		return results, errors.Trace(err)
+	}
+
+	var apps []RemoteApplication
+	if len(args.ApplicationNames) == 0 {
+		all, err := api.backend.AllRemoteApplications()
+		if err != nil {
+			return results, errors.Trace(err)
+		}
+		apps = all
+	} else {
+		for _, name := range args.ApplicationNames {
+			app, err := api.backend.RemoteApplication(name)
+			if err != nil {
+				results.Results = append(results.Results, params.RemoteRelationDiagnosticsResult{
+					Error: common.ServerError(err),
+				})
+				continue
+			}
+			apps = append(apps, app)
+		}
+	}
+	sort.Slice(apps, func(i, j int) bool {
+		return apps[i].Name() < apps[j].Name()
+	})
+
+	// Reachability is checked once per remote model.
+	controllers := make(map[string]*params.RemoteControllerDiagnostics)
+	for _, app := range apps {
+		rels, err := app.Relations()
+		if err != nil {
+			results.Results = append(results.Results, params.RemoteRelationDiagnosticsResult{
+				Error: common.ServerError(errors.Annotatef(err, "getting relations for %q", app.Name())),
+			})
+			continue
+		}
+		sort.Slice(rels, func(i, j int) bool {
+			return rels[i].Id() < rels[j].Id()
+		})
+		var controller *params.RemoteControllerDiagnostics
+		if !app.IsConsumerProxy() {
+			modelUUID := app.SourceModel().Id()
+			if _, ok := controllers[modelUUID]; !ok {
+				controllers[modelUUID] = api.controllerDiagnostics(modelUUID)
+			}
+			controller = controllers[modelUUID]
+		}
+		for _, rel := range rels {
+			diagnostics, err := api.relationDiagnostics(app, rel)
+			if err != nil {
+				results.Results = append(results.Results, params.RemoteRelationDiagnosticsResult{
+					Error: common.ServerError(err),
+				})
+				continue
+			}
+			diagnostics.Controller = controller
+			results.Results = append(results.Results, params.RemoteRelationDiagnosticsResult{
+				Result: diagnostics,
+			})
+		}
+	}
+	return results, nil
+}
+
+func (api *API) relationDiagnostics(app RemoteApplication, rel Relation) (*params.RemoteRelationDiagnostics, error) {
+	key := rel.String()
+	relStatus, err := rel.Status()
+	if err != nil {
+		return nil, errors.Annotatef(err, "getting status for relation %q", key)
+	}
+	url, _ := app.URL()
+	result := &params.RemoteRelationDiagnostics{
+		RelationKey:       key,
+		RelationId:        rel.Id(),
+		Status:            string(relStatus.Status),
+		StatusMessage:     relStatus.Message,
+		RemoteApplication: app.Name(),
+		OfferUUID:         app.OfferUUID(),
+		OfferURL:          url,
+		SourceModelTag:    app.SourceModel().String(),
+		ConsumerProxy:     app.IsConsumerProxy(),
+	}
+
+	if result.ApplicationToken, err = api.token(names.NewApplicationTag(app.Name())); err != nil {
+		return nil, errors.Trace(err)
+	}
+	if result.RelationToken, err = api.token(rel.Tag()); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	mac, err := api.backend.GetMacaroon(rel.Tag())
+	if err != nil && !errors.IsNotFound(err) {
+		return nil, errors.Trace(err)
+	}
+	if mac != nil {
+		result.Macaroon = &params.RemoteMacaroonDiagnostics{}
+		if expiry, ok := checkers.MacaroonsExpiryTime(macaroon.Slice{mac}); ok {
+			result.Macaroon.Expiry = &expiry
+			result.Macaroon.Expired = !api.clock.Now().Before(expiry)
+		}
+	}
+
+	events, err := api.backend.RemoteRelationEvents(key)
+	if err != nil && !errors.IsNotFound(err) {
+		return nil, errors.Trace(err)
+	}
+	result.LastSent = eventInfo(events.LastSent)
+	result.LastReceived = eventInfo(events.LastReceived)
+	result.PendingSent = events.PendingSent
+	result.PendingReceived = events.PendingReceived
+
+	if result.IngressNetworks, err = api.backend.IngressNetworks(key); err != nil {
+		return nil, errors.Trace(err)
+	}
+	if result.EgressNetworks, err = api.backend.EgressNetworks(key); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return result, nil
+}
+
+func (api *API) token(entity names.Tag) (string, error) {
+	token, err := api.backend.GetToken(entity)
+	if errors.IsNotFound(err) {
+		return "", nil
+	}
+	return token, errors.Trace(err)
+}
+
+// controllerDiagnostics reports whether the controller hosting the
+// specified model can be reached. Models hosted by this controller
+// are always considered reachable.
+func (api *API) controllerDiagnostics(modelUUID string) *params.RemoteControllerDiagnostics {
+	info, err := api.backend.ControllerForModel(modelUUID)
+	if errors.IsNotFound(err) {
+		return &params.RemoteControllerDiagnostics{Local: true, Reachable: true}
+	}
+	if err != nil {
+		return &params.RemoteControllerDiagnostics{Error: err.Error()}
+	}
+	result := &params.RemoteControllerDiagnostics{
+		ControllerTag: info.ControllerTag.String(),
+		Alias:         info.Alias,
+		Addrs:         info.Addrs,
+	}
+	for _, addr := range info.Addrs {
+		conn, err := api.dial("tcp", addr, dialTimeout)
+		if err != nil {
+			result.Error = err.Error()
+			continue
+		}
+		conn.Close()
+		result.Reachable = true
+		result.Error = ""
+		break
+	}
+	if len(info.Addrs) == 0 {
+		result.Error = "no addresses known for controller"
+	}
+	return result
+}
+
+func eventInfo(event *state.RemoteRelationEvent) *params.RemoteRelationEventInfo {
+	if event == nil {
+		return nil
+	}
+	return &params.RemoteRelationEventInfo{
+		Time:          event.Time,
+		ChangedUnits:  event.ChangedUnits,
+		DepartedUnits: event.DepartedUnits,
+		Error:         event.Error,
+	}
+}
@@ -0,0 +1,226 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package remoterelationdiagnostics_test
+
+import (
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+	"gopkg.in/juju/names.v2"
+	"gopkg.in/macaroon-bakery.v2-unstable/bakery/checkers"
+	"gopkg.in/macaroon.v2-unstable"
+
+	apitesting "github.com/juju/juju/api/testing"
+	"github.com/juju/juju/apiserver/common"
+	"github.com/juju/juju/apiserver/facades/client/remoterelationdiagnostics"
+	"github.com/juju/juju/apiserver/params"
+	apiservertesting "github.com/juju/juju/apiserver/testing"
+	"github.com/juju/juju/core/crossmodel"
+	"github.com/juju/juju/state"
+	"github.com/juju/juju/status"
+	coretesting "github.com/juju/juju/testing"
+)
+
+type DiagnosticsSuite struct {
+	testing.IsolationSuite
+
+	backend    *mockBackend
+	dialer     *mockDialer
+	clock      *testing.Clock
+	authorizer apiservertesting.FakeAuthorizer
+	api        *remoterelationdiagnostics.API
+}
+
+var _ = gc.Suite(&DiagnosticsSuite{})
+
+const remoteModelUUID = "deadbeef-0bad-400d-8000-4b1d0d06f00d"
+
+func (s *DiagnosticsSuite) SetUpTest(c *gc.C) {
+	s.IsolationSuite.SetUpTest(c)
+	s.clock = testing.NewClock(time.Date(2018, 1, 2, 3, 4, 5, 0, time.UTC))
+	s.dialer = &mockDialer{}
+	s.authorizer = apiservertesting.FakeAuthorizer{
+		Tag: names.NewUserTag("admin"),
+	}
+
+	mac, err := apitesting.NewMacaroon("relation")
+	c.Assert(err, jc.ErrorIsNil)
+	cav := checkers.TimeBeforeCaveat(s.clock.Now().Add(time.Hour))
+	mac.AddFirstPartyCaveat(cav.Condition)
+
+	relTag := names.NewRelationTag("wordpress:db mysql:server")
+	s.backend = &mockBackend{
+		modelTag: coretesting.ModelTag,
+		apps: map[string]*mockRemoteApplication{
+			"mysql": {
+				name:        "mysql",
+				offerUUID:   "offer-uuid",
+				url:         "other:fred/prod.mysql",
+				sourceModel: names.NewModelTag(remoteModelUUID),
+				relations: []remoterelationdiagnostics.Relation{&mockRelation{
+					id:     7,
+					key:    "wordpress:db mysql:server",
+					status: status.StatusInfo{Status: status.Joined},
+				}},
+			},
+		},
+		tokens: map[names.Tag]string{
+			names.NewApplicationTag("mysql"): "app-token",
+			relTag:                           "rel-token",
+		},
+		macaroons: map[names.Tag]*macaroon.Macaroon{
+			relTag: mac,
+		},
+		controllers: map[string]crossmodel.ControllerInfo{
+			remoteModelUUID: {
+				ControllerTag: coretesting.ControllerTag,
+				Alias:         "other",
+				Addrs:         []string{"10.0.0.1:17070", "10.0.0.2:17070"},
+			},
+		},
+		events: map[string]state.RemoteRelationEvents{
+			"wordpress:db mysql:server": {
+				LastSent: &state.RemoteRelationEvent{
+					Time:         s.clock.Now().Add(-time.Minute),
+					ChangedUnits: 2,
+					Error:        "connection refused",
+				},
+				LastReceived: &state.RemoteRelationEvent{
+					Time:          s.clock.Now().Add(-time.Hour),
+					DepartedUnits: 1,
+				},
+				PendingSent: 2,
+			},
+		},
+		ingress: map[string][]string{
+			"wordpress:db mysql:server": {"10.0.0.0/24"},
+		},
+		egress: map[string][]string{
+			"wordpress:db mysql:server": {"192.168.1.0/24"},
+		},
+	}
+	s.api = s.newAPI(c)
+}
+
+func (s *DiagnosticsSuite) newAPI(c *gc.C) *remoterelationdiagnostics.API {
+	api, err := remoterelationdiagnostics.NewAPI(s.backend, s.authorizer, s.clock, s.dialer.Dial)
+	c.Assert(err, jc.ErrorIsNil)
+	return api
+}
+
+func (s *DiagnosticsSuite) TestNewAPINotClient(c *gc.C) {
+	s.authorizer.Tag = names.NewMachineTag("0")
+	_, err := remoterelationdiagnostics.NewAPI(s.backend, s.authorizer, s.clock, s.dialer.Dial)
+	c.Assert(err, gc.Equals, common.ErrPerm)
+}
+
+func (s *DiagnosticsSuite) TestRemoteRelationsPermission(c *gc.C) {
+	s.authorizer.Tag = names.NewUserTag("mary")
+	_, err := s.newAPI(c).RemoteRelations(params.RemoteRelationDiagnosticsArgs{})
+	c.Assert(err, gc.ErrorMatches, "permission denied")
+}
+
+func (s *DiagnosticsSuite) TestRemoteRelations(c *gc.C) {
+	s.dialer.SetErrors(errors.New("no route to host"))
+	result, err := s.api.RemoteRelations(params.RemoteRelationDiagnosticsArgs{})
+	c.Assert(err, jc.ErrorIsNil)
+
+	expiry := s.clock.Now().Add(time.Hour)
+	c.Assert(result, jc.DeepEquals, params.RemoteRelationDiagnosticsResults{
+		Results: []params.RemoteRelationDiagnosticsResult{{
+			Result: &params.RemoteRelationDiagnostics{
+				RelationKey:       "wordpress:db mysql:server",
+				RelationId:        7,
+				Status:            "joined",
+				RemoteApplication: "mysql",
+				OfferUUID:         "offer-uuid",
+				OfferURL:          "other:fred/prod.mysql",
+				SourceModelTag:    names.NewModelTag(remoteModelUUID).String(),
+				ApplicationToken:  "app-token",
+				RelationToken:     "rel-token",
+				Controller: &params.RemoteControllerDiagnostics{
+					ControllerTag: coretesting.ControllerTag.String(),
+					Alias:         "other",
+					Addrs:         []string{"10.0.0.1:17070", "10.0.0.2:17070"},
+					Reachable:     true,
+				},
+				Macaroon: &params.RemoteMacaroonDiagnostics{
+					Expiry: &expiry,
+				},
+				LastSent: &params.RemoteRelationEventInfo{
+					Time:         s.clock.Now().Add(-time.Minute),
+					ChangedUnits: 2,
+					Error:        "connection refused",
+				},
+				LastReceived: &params.RemoteRelationEventInfo{
+					Time:          s.clock.Now().Add(-time.Hour),
+					DepartedUnits: 1,
+				},
+				PendingSent:     2,
+				IngressNetworks: []string{"10.0.0.0/24"},
+				EgressNetworks:  []string{"192.168.1.0/24"},
+			},
+		}},
+	})
+	s.dialer.CheckCalls(c, []testing.StubCall{
+		{"Dial", []interface{}{"tcp", "10.0.0.1:17070", 5 * time.Second}},
+		{"Dial", []interface{}{"tcp", "10.0.0.2:17070", 5 * time.Second}},
+	})
+}
+
+func (s *DiagnosticsSuite) TestRemoteRelationsUnreachableExpired(c *gc.C) {
+	s.dialer.SetErrors(errors.New("no route to host"), errors.New("connection refused"))
+	s.clock.Advance(2 * time.Hour)
+	result, err := s.api.RemoteRelations(params.RemoteRelationDiagnosticsArgs{
+		ApplicationNames: []string{"mysql"},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.Results, gc.HasLen, 1)
+	diagnostics := result.Results[0].Result
+	c.Assert(diagnostics.Controller.Reachable, jc.IsFalse)
+	c.Assert(diagnostics.Controller.Error, gc.Equals, "connection refused")
+	c.Assert(diagnostics.Macaroon.Expired, jc.IsTrue)
+}
+
+func (s *DiagnosticsSuite) TestRemoteRelationsSameController(c *gc.C) {
+	delete(s.backend.controllers, remoteModelUUID)
+	delete(s.backend.macaroons, names.NewRelationTag("wordpress:db mysql:server"))
+	delete(s.backend.events, "wordpress:db mysql:server")
+	result, err := s.api.RemoteRelations(params.RemoteRelationDiagnosticsArgs{})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.Results, gc.HasLen, 1)
+	diagnostics := result.Results[0].Result
+	c.Assert(diagnostics.Controller, jc.DeepEquals, &params.RemoteControllerDiagnostics{
+		Local:     true,
+		Reachable: true,
+	})
+	c.Assert(diagnostics.Macaroon, gc.IsNil)
+	c.Assert(diagnostics.LastSent, gc.IsNil)
+	c.Assert(diagnostics.LastReceived, gc.IsNil)
+	s.dialer.CheckNoCalls(c)
+}
+
+func (s *DiagnosticsSuite) TestRemoteRelationsConsumerProxy(c *gc.C) {
+	s.backend.apps["mysql"].consumerProxy = true
+	result, err := s.api.RemoteRelations(params.RemoteRelationDiagnosticsArgs{})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.Results, gc.HasLen, 1)
+	c.Assert(result.Results[0].Result.ConsumerProxy, jc.IsTrue)
+	c.Assert(result.Results[0].Result.Controller, gc.IsNil)
+	s.backend.CheckCallNames(c, "AllRemoteApplications",
+		"GetToken", "GetToken", "GetMacaroon", "RemoteRelationEvents", "IngressNetworks", "EgressNetworks")
+}
+
+func (s *DiagnosticsSuite) TestRemoteRelationsNotFound(c *gc.C) {
+	result, err := s.api.RemoteRelations(params.RemoteRelationDiagnosticsArgs{
+		ApplicationNames: []string{"foo"},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.Results, gc.HasLen, 1)
+	c.Assert(result.Results[0].Error, gc.ErrorMatches, `remote application "foo" not found`)
+	c.Assert(result.Results[0].Error.Code, gc.Equals, params.CodeNotFound)
+}
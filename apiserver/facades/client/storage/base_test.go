@@ -25,7 +25,7 @@ type baseStorageSuite struct {
 	resources  *common.Resources
 	authorizer apiservertesting.FakeAuthorizer
 
-	api   *storage.APIv4
+	api   *storage.APIv6
 	apiv3 *storage.APIv3
 	state *mockState
 
@@ -40,6 +40,7 @@ type baseStorageSuite struct {
 	filesystemTag        names.FilesystemTag
 	filesystem           *mockFilesystem
 	filesystemAttachment *mockFilesystemAttachment
+	volumeSnapshots      []state.VolumeSnapshot
 	stub                 testing.Stub
 
 	registry    jujustorage.StaticProviderRegistry
@@ -61,7 +62,7 @@ func (s *baseStorageSuite) SetUpTest(c *gc.C) {
 	s.poolManager = s.constructPoolManager()
 
 	var err error
-	s.api, err = storage.NewAPIv4(s.state, s.registry, s.poolManager, s.resources, s.authorizer)
+	s.api, err = storage.NewAPIv6(s.state, s.registry, s.poolManager, s.resources, s.authorizer)
 	c.Assert(err, jc.ErrorIsNil)
 	s.apiv3, err = storage.NewAPIv3(s.state, s.registry, s.poolManager, s.resources, s.authorizer)
 	c.Assert(err, jc.ErrorIsNil)
@@ -95,7 +96,11 @@ const (
 	detachStorageCall                       = "detachStorage"
 	destroyStorageInstanceCall              = "destroyStorageInstance"
 	releaseStorageInstanceCall              = "releaseStorageInstance"
+	resizeStorageInstanceCall               = "resizeStorageInstance"
 	addExistingFilesystemCall               = "addExistingFilesystem"
+	snapshotStorageInstanceCall             = "snapshotStorageInstance"
+	restoreStorageInstanceCall              = "restoreStorageInstance"
+	allVolumeSnapshotsCall                  = "allVolumeSnapshots"
 )
 
 func (s *baseStorageSuite) constructState() *mockState {
@@ -276,10 +281,29 @@ func (s *baseStorageSuite) constructState() *mockState {
 			s.stub.AddCall(releaseStorageInstanceCall, tag, destroyAttached)
 			return errors.New("cannae do it")
 		},
+		resizeStorageInstance: func(tag names.StorageTag, size uint64) error {
+			s.stub.AddCall(resizeStorageInstanceCall, tag, size)
+			return s.stub.NextErr()
+		},
 		addExistingFilesystem: func(f state.FilesystemInfo, v *state.VolumeInfo, storageName string) (names.StorageTag, error) {
 			s.stub.AddCall(addExistingFilesystemCall, f, v, storageName)
 			return s.storageTag, s.stub.NextErr()
 		},
+		snapshotStorageInstance: func(tag names.StorageTag) (state.VolumeSnapshot, error) {
+			s.stub.AddCall(snapshotStorageInstanceCall, tag)
+			if err := s.stub.NextErr(); err != nil {
+				return nil, err
+			}
+			return &mockVolumeSnapshot{id: "0", volume: s.volumeTag, storage: &tag}, nil
+		},
+		restoreStorageInstance: func(tag names.StorageTag, snapshotId string) error {
+			s.stub.AddCall(restoreStorageInstanceCall, tag, snapshotId)
+			return s.stub.NextErr()
+		},
+		allVolumeSnapshots: func() ([]state.VolumeSnapshot, error) {
+			s.stub.AddCall(allVolumeSnapshotsCall)
+			return s.volumeSnapshots, s.stub.NextErr()
+		},
 	}
 }
 
@@ -4,6 +4,8 @@
 package storage_test
 
 import (
+	"time"
+
 	"github.com/juju/errors"
 	jc "github.com/juju/testing/checkers"
 	gc "gopkg.in/check.v1"
@@ -156,3 +158,36 @@ func (s *filesystemSuite) TestListFilesystemsVolumeBacked(c *gc.C) {
 	c.Assert(found.Results[0].Result, gc.HasLen, 1)
 	c.Assert(found.Results[0].Result[0], jc.DeepEquals, expected)
 }
+
+func (s *filesystemSuite) TestListFilesystemsAttachmentUsage(c *gc.C) {
+	updated := time.Unix(1000, 0).UTC()
+	s.filesystemAttachment.usage = &state.FilesystemUsage{
+		Size:       1024,
+		Used:       512,
+		Available:  512,
+		Inodes:     64,
+		InodesUsed: 8,
+		InodesFree: 56,
+		Updated:    updated,
+	}
+	expected := s.expectedFilesystemDetails()
+	expected.MachineAttachments[s.machineTag.String()] = params.FilesystemAttachmentDetails{
+		Life: "dead",
+		Usage: &params.FilesystemUsage{
+			Size:       1024,
+			Used:       512,
+			Available:  512,
+			Inodes:     64,
+			InodesUsed: 8,
+			InodesFree: 56,
+			Updated:    updated,
+		},
+	}
+	found, err := s.api.ListFilesystems(params.FilesystemFilters{
+		[]params.FilesystemFilter{{}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(found.Results, gc.HasLen, 1)
+	c.Assert(found.Results[0].Result, gc.HasLen, 1)
+	c.Assert(found.Results[0].Result[0], jc.DeepEquals, expected)
+}
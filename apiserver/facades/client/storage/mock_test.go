@@ -4,6 +4,8 @@
 package storage_test
 
 import (
+	"time"
+
 	"github.com/juju/errors"
 	"gopkg.in/juju/charm.v6"
 	"gopkg.in/juju/names.v2"
@@ -65,9 +67,13 @@ type mockState struct {
 	blockDevices                        func(names.MachineTag) ([]state.BlockDeviceInfo, error)
 	destroyStorageInstance              func(names.StorageTag, bool) error
 	releaseStorageInstance              func(names.StorageTag, bool) error
+	resizeStorageInstance               func(names.StorageTag, uint64) error
 	attachStorage                       func(names.StorageTag, names.UnitTag) error
 	detachStorage                       func(names.StorageTag, names.UnitTag) error
 	addExistingFilesystem               func(state.FilesystemInfo, *state.VolumeInfo, string) (names.StorageTag, error)
+	snapshotStorageInstance             func(names.StorageTag) (state.VolumeSnapshot, error)
+	restoreStorageInstance              func(names.StorageTag, string) error
+	allVolumeSnapshots                  func() ([]state.VolumeSnapshot, error)
 }
 
 func (st *mockState) StorageInstance(s names.StorageTag) (state.StorageInstance, error) {
@@ -193,6 +199,10 @@ func (st *mockState) ReleaseStorageInstance(tag names.StorageTag, destroyAttache
 	return st.releaseStorageInstance(tag, destroyAttached)
 }
 
+func (st *mockState) ResizeStorageInstance(tag names.StorageTag, size uint64) error {
+	return st.resizeStorageInstance(tag, size)
+}
+
 func (st *mockState) UnitStorageAttachments(tag names.UnitTag) ([]state.StorageAttachment, error) {
 	panic("should not be called")
 }
@@ -201,6 +211,68 @@ func (st *mockState) AddExistingFilesystem(f state.FilesystemInfo, v *state.Volu
 	return st.addExistingFilesystem(f, v, s)
 }
 
+func (st *mockState) SnapshotStorageInstance(tag names.StorageTag) (state.VolumeSnapshot, error) {
+	return st.snapshotStorageInstance(tag)
+}
+
+func (st *mockState) RestoreStorageInstance(tag names.StorageTag, snapshotId string) error {
+	return st.restoreStorageInstance(tag, snapshotId)
+}
+
+func (st *mockState) AllVolumeSnapshots() ([]state.VolumeSnapshot, error) {
+	return st.allVolumeSnapshots()
+}
+
+type mockVolumeSnapshot struct {
+	state.VolumeSnapshot
+	id        string
+	volume    names.VolumeTag
+	storage   *names.StorageTag
+	pool      string
+	created   time.Time
+	info      *state.VolumeSnapshotInfo
+	restoring bool
+	message   string
+}
+
+func (s *mockVolumeSnapshot) Id() string {
+	return s.id
+}
+
+func (s *mockVolumeSnapshot) Volume() names.VolumeTag {
+	return s.volume
+}
+
+func (s *mockVolumeSnapshot) StorageInstance() (names.StorageTag, error) {
+	if s.storage == nil {
+		return names.StorageTag{}, errors.NewNotAssigned(nil, "error from mock")
+	}
+	return *s.storage, nil
+}
+
+func (s *mockVolumeSnapshot) Pool() string {
+	return s.pool
+}
+
+func (s *mockVolumeSnapshot) Created() time.Time {
+	return s.created
+}
+
+func (s *mockVolumeSnapshot) Info() (state.VolumeSnapshotInfo, error) {
+	if s.info == nil {
+		return state.VolumeSnapshotInfo{}, errors.NotProvisionedf("volume snapshot %q", s.id)
+	}
+	return *s.info, nil
+}
+
+func (s *mockVolumeSnapshot) Restoring() bool {
+	return s.restoring
+}
+
+func (s *mockVolumeSnapshot) Message() string {
+	return s.message
+}
+
 type mockVolume struct {
 	state.Volume
 	tag     names.VolumeTag
@@ -289,6 +361,7 @@ type mockFilesystemAttachment struct {
 	filesystem names.FilesystemTag
 	machine    names.MachineTag
 	info       *state.FilesystemAttachmentInfo
+	usage      *state.FilesystemUsage
 	life       state.Life
 }
 
@@ -311,6 +384,13 @@ func (m *mockFilesystemAttachment) Life() state.Life {
 	return m.life
 }
 
+func (m *mockFilesystemAttachment) Usage() (state.FilesystemUsage, error) {
+	if m.usage != nil {
+		return *m.usage, nil
+	}
+	return state.FilesystemUsage{}, errors.NotFoundf("filesystem usage")
+}
+
 type mockStorageInstance struct {
 	state.StorageInstance
 	kind       state.StorageKind
@@ -365,6 +445,7 @@ type mockVolumeAttachment struct {
 	VolumeTag  names.VolumeTag
 	MachineTag names.MachineTag
 	info       *state.VolumeAttachmentInfo
+	usage      *state.FilesystemUsage
 	life       state.Life
 }
 
@@ -391,6 +472,13 @@ func (va *mockVolumeAttachment) Params() (state.VolumeAttachmentParams, bool) {
 	panic("not implemented for test")
 }
 
+func (va *mockVolumeAttachment) Usage() (state.FilesystemUsage, error) {
+	if va.usage != nil {
+		return *va.usage, nil
+	}
+	return state.FilesystemUsage{}, errors.NotFoundf("volume usage")
+}
+
 type mockBlock struct {
 	state.Block
 	t   state.BlockType
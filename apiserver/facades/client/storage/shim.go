@@ -19,6 +19,32 @@ import (
 // to change any part of it so that it were no longer *obviously* and
 // *trivially* correct, you would be Doing It Wrong.
 
+// NewFacadeV6 provides the signature required for facade registration.
+func NewFacadeV6(
+	st *state.State,
+	resources facade.Resources,
+	authorizer facade.Authorizer,
+) (*APIv6, error) {
+	apiv5, err := NewFacadeV5(st, resources, authorizer)
+	if err != nil {
+		return nil, err
+	}
+	return &APIv6{apiv5}, nil
+}
+
+// NewFacadeV5 provides the signature required for facade registration.
+func NewFacadeV5(
+	st *state.State,
+	resources facade.Resources,
+	authorizer facade.Authorizer,
+) (*APIv5, error) {
+	apiv4, err := NewFacadeV4(st, resources, authorizer)
+	if err != nil {
+		return nil, err
+	}
+	return &APIv5{apiv4}, nil
+}
+
 // NewFacadeV4 provides the signature required for facade registration.
 func NewFacadeV4(
 	st *state.State,
@@ -152,6 +178,22 @@ type storageAccess interface {
 	// ReleaseStorageInstance releases the storage instance with the specified tag.
 	ReleaseStorageInstance(names.StorageTag, bool) error
 
+	// ResizeStorageInstance requests that the storage instance with
+	// the specified tag be grown to the specified size in MiB.
+	ResizeStorageInstance(names.StorageTag, uint64) error
+
+	// SnapshotStorageInstance requests a snapshot of the volume
+	// backing the storage instance with the specified tag.
+	SnapshotStorageInstance(names.StorageTag) (state.VolumeSnapshot, error)
+
+	// RestoreStorageInstance requests that the volume backing the
+	// storage instance with the specified tag be restored from the
+	// volume snapshot with the specified ID.
+	RestoreStorageInstance(names.StorageTag, string) error
+
+	// AllVolumeSnapshots returns all volume snapshots in the model.
+	AllVolumeSnapshots() ([]state.VolumeSnapshot, error)
+
 	// UnitStorageAttachments returns the storage attachments for the
 	// identified unit.
 	UnitStorageAttachments(names.UnitTag) ([]state.StorageAttachment, error)
@@ -35,6 +35,46 @@ type APIv4 struct {
 	*APIv3
 }
 
+// APIv6 implements the storage v6 API.
+type APIv6 struct {
+	*APIv5
+}
+
+// APIv5 implements the storage v5 API.
+type APIv5 struct {
+	*APIv4
+}
+
+// NewAPIv6 returns a new storage v6 API facade.
+func NewAPIv6(
+	st storageAccess,
+	registry storage.ProviderRegistry,
+	pm poolmanager.PoolManager,
+	resources facade.Resources,
+	authorizer facade.Authorizer,
+) (*APIv6, error) {
+	apiv5, err := NewAPIv5(st, registry, pm, resources, authorizer)
+	if err != nil {
+		return nil, err
+	}
+	return &APIv6{apiv5}, nil
+}
+
+// NewAPIv5 returns a new storage v5 API facade.
+func NewAPIv5(
+	st storageAccess,
+	registry storage.ProviderRegistry,
+	pm poolmanager.PoolManager,
+	resources facade.Resources,
+	authorizer facade.Authorizer,
+) (*APIv5, error) {
+	apiv4, err := NewAPIv4(st, registry, pm, resources, authorizer)
+	if err != nil {
+		return nil, err
+	}
+	return &APIv5{apiv4}, nil
+}
+
 // NewAPIv4 returns a new storage v4 API facade.
 func NewAPIv4(
 	st storageAccess,
@@ -517,6 +557,10 @@ func createVolumeDetails(
 					stateInfo,
 				)
 			}
+			if usage, err := attachment.Usage(); err == nil {
+				paramsUsage := storagecommon.FilesystemUsageFromState(usage)
+				attDetails.Usage = &paramsUsage
+			}
 			details.MachineAttachments[attachment.Machine().String()] = attDetails
 		}
 	}
@@ -673,6 +717,10 @@ func createFilesystemDetails(
 					stateInfo,
 				)
 			}
+			if usage, err := attachment.Usage(); err == nil {
+				paramsUsage := storagecommon.FilesystemUsageFromState(usage)
+				attDetails.Usage = &paramsUsage
+			}
 			details.MachineAttachments[attachment.Machine().String()] = attDetails
 		}
 	}
@@ -730,7 +778,7 @@ func (a *APIv3) addToUnit(args params.StoragesAddParams) (params.AddStorageResul
 	}
 
 	paramsToState := func(p params.StorageConstraints) state.StorageConstraints {
-		s := state.StorageConstraints{Pool: p.Pool}
+		s := state.StorageConstraints{Pool: p.Pool, Snapshot: p.Snapshot}
 		if p.Size != nil {
 			s.Size = *p.Size
 		}
@@ -921,6 +969,149 @@ func (a *APIv3) attachStorage(storageTag names.StorageTag, unitTag names.UnitTag
 	return a.storage.AttachStorage(storageTag, unitTag)
 }
 
+// ResizeStorage requests that the specified storage instances be grown
+// to the specified sizes. The storage provisioner will resize the
+// underlying volumes, after which the charm is notified with a
+// storage-resized hook. A "CHANGE" block can block this operation.
+func (a *APIv5) ResizeStorage(args params.ResizeStorage) (params.ErrorResults, error) {
+	if err := a.checkCanWrite(); err != nil {
+		return params.ErrorResults{}, errors.Trace(err)
+	}
+
+	blockChecker := common.NewBlockChecker(a.storage)
+	if err := blockChecker.ChangeAllowed(); err != nil {
+		return params.ErrorResults{}, errors.Trace(err)
+	}
+
+	resizeOne := func(arg params.ResizeStorageParams) error {
+		tag, err := names.ParseStorageTag(arg.StorageTag)
+		if err != nil {
+			return err
+		}
+		return a.storage.ResizeStorageInstance(tag, arg.Size)
+	}
+
+	result := make([]params.ErrorResult, len(args.Storage))
+	for i, arg := range args.Storage {
+		result[i].Error = common.ServerError(resizeOne(arg))
+	}
+	return params.ErrorResults{Results: result}, nil
+}
+
+// CreateSnapshots requests snapshots of the volumes backing the
+// specified storage instances, returning the IDs of the snapshots.
+// The storage provisioner takes the snapshots asynchronously.
+// A "CHANGE" block can block this operation.
+func (a *APIv6) CreateSnapshots(args params.Entities) (params.StringResults, error) {
+	if err := a.checkCanWrite(); err != nil {
+		return params.StringResults{}, errors.Trace(err)
+	}
+
+	blockChecker := common.NewBlockChecker(a.storage)
+	if err := blockChecker.ChangeAllowed(); err != nil {
+		return params.StringResults{}, errors.Trace(err)
+	}
+
+	snapshotOne := func(arg params.Entity) (string, error) {
+		tag, err := names.ParseStorageTag(arg.Tag)
+		if err != nil {
+			return "", err
+		}
+		snapshot, err := a.storage.SnapshotStorageInstance(tag)
+		if err != nil {
+			return "", err
+		}
+		return snapshot.Id(), nil
+	}
+
+	results := make([]params.StringResult, len(args.Entities))
+	for i, arg := range args.Entities {
+		id, err := snapshotOne(arg)
+		if err != nil {
+			results[i].Error = common.ServerError(err)
+			continue
+		}
+		results[i].Result = id
+	}
+	return params.StringResults{Results: results}, nil
+}
+
+// ListSnapshots returns the volume snapshots in the model that match
+// the specified filters. An empty filter matches all snapshots.
+func (a *APIv6) ListSnapshots(filters params.VolumeSnapshotFilters) (params.VolumeSnapshotDetailsListResults, error) {
+	if err := a.checkCanRead(); err != nil {
+		return params.VolumeSnapshotDetailsListResults{}, errors.Trace(err)
+	}
+	snapshots, err := a.storage.AllVolumeSnapshots()
+	if err != nil {
+		return params.VolumeSnapshotDetailsListResults{}, errors.Trace(err)
+	}
+	details := make([]params.VolumeSnapshotDetails, len(snapshots))
+	for i, snapshot := range snapshots {
+		details[i] = createVolumeSnapshotDetails(snapshot)
+	}
+	results := params.VolumeSnapshotDetailsListResults{
+		Results: make([]params.VolumeSnapshotDetailsListResult, len(filters.Filters)),
+	}
+	for i, filter := range filters.Filters {
+		storageTags := set.NewStrings(filter.StorageTags...)
+		for _, d := range details {
+			if storageTags.IsEmpty() || storageTags.Contains(d.StorageTag) {
+				results.Results[i].Result = append(results.Results[i].Result, d)
+			}
+		}
+	}
+	return results, nil
+}
+
+func createVolumeSnapshotDetails(snapshot state.VolumeSnapshot) params.VolumeSnapshotDetails {
+	details := params.VolumeSnapshotDetails{
+		Id:        snapshot.Id(),
+		VolumeTag: snapshot.Volume().String(),
+		Pool:      snapshot.Pool(),
+		Created:   snapshot.Created(),
+		Restoring: snapshot.Restoring(),
+		Message:   snapshot.Message(),
+	}
+	if storageTag, err := snapshot.StorageInstance(); err == nil {
+		details.StorageTag = storageTag.String()
+	}
+	if info, err := snapshot.Info(); err == nil {
+		details.SnapshotId = info.SnapshotId
+		details.Size = info.Size
+	}
+	return details
+}
+
+// RestoreStorage requests that the volumes backing the specified
+// storage instances be restored from the specified volume snapshots.
+// The storage provisioner restores the volumes asynchronously.
+// A "CHANGE" block can block this operation.
+func (a *APIv6) RestoreStorage(args params.RestoreStorage) (params.ErrorResults, error) {
+	if err := a.checkCanWrite(); err != nil {
+		return params.ErrorResults{}, errors.Trace(err)
+	}
+
+	blockChecker := common.NewBlockChecker(a.storage)
+	if err := blockChecker.ChangeAllowed(); err != nil {
+		return params.ErrorResults{}, errors.Trace(err)
+	}
+
+	restoreOne := func(arg params.RestoreStorageParams) error {
+		tag, err := names.ParseStorageTag(arg.StorageTag)
+		if err != nil {
+			return err
+		}
+		return a.storage.RestoreStorageInstance(tag, arg.SnapshotId)
+	}
+
+	result := make([]params.ErrorResult, len(args.Storage))
+	for i, arg := range args.Storage {
+		result[i].Error = common.ServerError(restoreOne(arg))
+	}
+	return params.ErrorResults{Results: result}, nil
+}
+
 // Import imports existing storage into the model.
 // A "CHANGE" block can block this operation.
 func (a *APIv4) Import(args params.BulkImportStorageParams) (params.ImportStorageResults, error) {
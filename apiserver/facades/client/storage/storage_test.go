@@ -5,6 +5,7 @@ package storage_test
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/juju/errors"
 	"github.com/juju/testing"
@@ -356,6 +357,147 @@ func (s *storageSuite) TestRemove(c *gc.C) {
 	s.stub.CheckCall(c, 4, releaseStorageInstanceCall, names.NewStorageTag("foo/1"), true)
 }
 
+func (s *storageSuite) TestResizeStorage(c *gc.C) {
+	s.stub.SetErrors(nil, errors.New("cannae do it"))
+	results, err := s.api.ResizeStorage(params.ResizeStorage{[]params.ResizeStorageParams{
+		{StorageTag: "storage-foo-0", Size: 2048},
+		{StorageTag: "storage-foo-1", Size: 4096},
+		{StorageTag: "volume-0", Size: 1024},
+	}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results, jc.DeepEquals, []params.ErrorResult{
+		{},
+		{Error: &params.Error{Message: "cannae do it"}},
+		{Error: &params.Error{Message: `"volume-0" is not a valid storage tag`}},
+	})
+	s.stub.CheckCallNames(c,
+		getBlockForTypeCall, // Change
+		resizeStorageInstanceCall,
+		resizeStorageInstanceCall,
+	)
+	s.stub.CheckCall(c, 1, resizeStorageInstanceCall, names.NewStorageTag("foo/0"), uint64(2048))
+	s.stub.CheckCall(c, 2, resizeStorageInstanceCall, names.NewStorageTag("foo/1"), uint64(4096))
+}
+
+func (s *storageSuite) TestResizeStorageBlocked(c *gc.C) {
+	s.addBlock(c, state.ChangeBlock, "TestResizeStorageBlocked")
+	_, err := s.api.ResizeStorage(params.ResizeStorage{[]params.ResizeStorageParams{
+		{StorageTag: "storage-foo-0", Size: 2048},
+	}})
+	s.assertBlocked(c, err, "TestResizeStorageBlocked")
+}
+
+func (s *storageSuite) TestCreateSnapshots(c *gc.C) {
+	s.stub.SetErrors(nil, errors.New("cannae do it"))
+	results, err := s.api.CreateSnapshots(params.Entities{[]params.Entity{
+		{Tag: "storage-foo-0"},
+		{Tag: "storage-foo-1"},
+		{Tag: "volume-0"},
+	}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results, jc.DeepEquals, []params.StringResult{
+		{Result: "0"},
+		{Error: &params.Error{Message: "cannae do it"}},
+		{Error: &params.Error{Message: `"volume-0" is not a valid storage tag`}},
+	})
+	s.stub.CheckCallNames(c,
+		getBlockForTypeCall, // Change
+		snapshotStorageInstanceCall,
+		snapshotStorageInstanceCall,
+	)
+	s.stub.CheckCall(c, 1, snapshotStorageInstanceCall, names.NewStorageTag("foo/0"))
+	s.stub.CheckCall(c, 2, snapshotStorageInstanceCall, names.NewStorageTag("foo/1"))
+}
+
+func (s *storageSuite) TestCreateSnapshotsBlocked(c *gc.C) {
+	s.addBlock(c, state.ChangeBlock, "TestCreateSnapshotsBlocked")
+	_, err := s.api.CreateSnapshots(params.Entities{[]params.Entity{
+		{Tag: "storage-foo-0"},
+	}})
+	s.assertBlocked(c, err, "TestCreateSnapshotsBlocked")
+}
+
+func (s *storageSuite) TestListSnapshots(c *gc.C) {
+	created := time.Date(2018, 1, 2, 3, 4, 5, 0, time.UTC)
+	foo0 := names.NewStorageTag("foo/0")
+	foo1 := names.NewStorageTag("foo/1")
+	s.volumeSnapshots = []state.VolumeSnapshot{
+		&mockVolumeSnapshot{
+			id:      "0",
+			volume:  names.NewVolumeTag("0"),
+			storage: &foo0,
+			pool:    "loop",
+			created: created,
+			info:    &state.VolumeSnapshotInfo{SnapshotId: "snap-0", Size: 1024},
+		},
+		&mockVolumeSnapshot{
+			id:      "1",
+			volume:  names.NewVolumeTag("1"),
+			storage: &foo1,
+			pool:    "loop",
+			created: created,
+			message: "failed",
+		},
+	}
+	results, err := s.api.ListSnapshots(params.VolumeSnapshotFilters{[]params.VolumeSnapshotFilter{
+		{},
+		{StorageTags: []string{"storage-foo-1"}},
+	}})
+	c.Assert(err, jc.ErrorIsNil)
+	snap0 := params.VolumeSnapshotDetails{
+		Id:         "0",
+		VolumeTag:  "volume-0",
+		StorageTag: "storage-foo-0",
+		Pool:       "loop",
+		Created:    created,
+		SnapshotId: "snap-0",
+		Size:       1024,
+	}
+	snap1 := params.VolumeSnapshotDetails{
+		Id:         "1",
+		VolumeTag:  "volume-1",
+		StorageTag: "storage-foo-1",
+		Pool:       "loop",
+		Created:    created,
+		Message:    "failed",
+	}
+	c.Assert(results.Results, jc.DeepEquals, []params.VolumeSnapshotDetailsListResult{
+		{Result: []params.VolumeSnapshotDetails{snap0, snap1}},
+		{Result: []params.VolumeSnapshotDetails{snap1}},
+	})
+	s.stub.CheckCallNames(c, allVolumeSnapshotsCall)
+}
+
+func (s *storageSuite) TestRestoreStorage(c *gc.C) {
+	s.stub.SetErrors(nil, errors.New("cannae do it"))
+	results, err := s.api.RestoreStorage(params.RestoreStorage{[]params.RestoreStorageParams{
+		{StorageTag: "storage-foo-0", SnapshotId: "0"},
+		{StorageTag: "storage-foo-1", SnapshotId: "1"},
+		{StorageTag: "volume-0", SnapshotId: "2"},
+	}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results, jc.DeepEquals, []params.ErrorResult{
+		{},
+		{Error: &params.Error{Message: "cannae do it"}},
+		{Error: &params.Error{Message: `"volume-0" is not a valid storage tag`}},
+	})
+	s.stub.CheckCallNames(c,
+		getBlockForTypeCall, // Change
+		restoreStorageInstanceCall,
+		restoreStorageInstanceCall,
+	)
+	s.stub.CheckCall(c, 1, restoreStorageInstanceCall, names.NewStorageTag("foo/0"), "0")
+	s.stub.CheckCall(c, 2, restoreStorageInstanceCall, names.NewStorageTag("foo/1"), "1")
+}
+
+func (s *storageSuite) TestRestoreStorageBlocked(c *gc.C) {
+	s.addBlock(c, state.ChangeBlock, "TestRestoreStorageBlocked")
+	_, err := s.api.RestoreStorage(params.RestoreStorage{[]params.RestoreStorageParams{
+		{StorageTag: "storage-foo-0", SnapshotId: "0"},
+	}})
+	s.assertBlocked(c, err, "TestRestoreStorageBlocked")
+}
+
 func (s *storageSuite) TestDestroyV3(c *gc.C) {
 	results, err := s.apiv3.Destroy(params.Entities{[]params.Entity{
 		{Tag: "storage-foo-0"},
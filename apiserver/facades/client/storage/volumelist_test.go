@@ -202,3 +202,25 @@ func (s *volumeSuite) TestListVolumesStorageLocationBlockDevicePath(c *gc.C) {
 	c.Assert(found.Results, gc.HasLen, 1)
 	c.Assert(found.Results[0].Result[0], jc.DeepEquals, expected)
 }
+
+func (s *volumeSuite) TestListVolumesAttachmentUsage(c *gc.C) {
+	s.volumeAttachment.usage = &state.FilesystemUsage{
+		Size:      1024,
+		Used:      973,
+		Available: 51,
+	}
+	expected := s.expectedVolumeDetails()
+	expected.MachineAttachments[s.machineTag.String()] = params.VolumeAttachmentDetails{
+		Life: "alive",
+		Usage: &params.FilesystemUsage{
+			Size:      1024,
+			Used:      973,
+			Available: 51,
+		},
+	}
+	found, err := s.api.ListVolumes(params.VolumeFilters{[]params.VolumeFilter{{}}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(found.Results, gc.HasLen, 1)
+	c.Assert(found.Results[0].Result, gc.HasLen, 1)
+	c.Assert(found.Results[0].Result[0], jc.DeepEquals, expected)
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/juju/errors"
 	"github.com/juju/loggo"
+	"github.com/juju/utils/clock"
 	"gopkg.in/juju/charm.v6"
 	"gopkg.in/juju/names.v2"
 	"gopkg.in/macaroon.v2-unstable"
@@ -34,6 +35,7 @@ type CrossModelRelationsAPI struct {
 	fw         firewall.State
 	resources  facade.Resources
 	authorizer facade.Authorizer
+	clock      clock.Clock
 
 	mu              sync.Mutex
 	authCtxt        *commoncrossmodel.AuthContext
@@ -44,6 +46,11 @@ type CrossModelRelationsAPI struct {
 	offerStatusWatcher    offerStatusWatcherFunc
 }
 
+// CrossModelRelationsAPIV2 provides access to the CrossModelRelations v2 API facade.
+type CrossModelRelationsAPIV2 struct {
+	*CrossModelRelationsAPI
+}
+
 // NewStateCrossModelRelationsAPI creates a new server-side CrossModelRelations API facade
 // backed by global state.
 func NewStateCrossModelRelationsAPI(ctx facade.Context) (*CrossModelRelationsAPI, error) {
@@ -64,9 +71,20 @@ func NewStateCrossModelRelationsAPI(ctx facade.Context) (*CrossModelRelationsAPI
 		firewall.WatchEgressAddressesForRelations,
 		watchRelationLifeSuspendedStatus,
 		watchOfferStatus,
+		clock.WallClock,
 	)
 }
 
+// NewStateCrossModelRelationsAPIV2 creates a new server-side
+// CrossModelRelationsAPIV2 facade backed by global state.
+func NewStateCrossModelRelationsAPIV2(ctx facade.Context) (*CrossModelRelationsAPIV2, error) {
+	api, err := NewStateCrossModelRelationsAPI(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &CrossModelRelationsAPIV2{api}, nil
+}
+
 // NewCrossModelRelationsAPI returns a new server-side CrossModelRelationsAPI facade.
 func NewCrossModelRelationsAPI(
 	st CrossModelRelationsState,
@@ -77,12 +95,14 @@ func NewCrossModelRelationsAPI(
 	egressAddressWatcher egressAddressWatcherFunc,
 	relationStatusWatcher relationStatusWatcherFunc,
 	offerStatusWatcher offerStatusWatcherFunc,
+	clock clock.Clock,
 ) (*CrossModelRelationsAPI, error) {
 	return &CrossModelRelationsAPI{
 		st:                    st,
 		fw:                    fw,
 		resources:             resources,
 		authorizer:            authorizer,
+		clock:                 clock,
 		authCtxt:              authCtxt,
 		egressAddressWatcher:  egressAddressWatcher,
 		relationStatusWatcher: relationStatusWatcher,
@@ -130,7 +150,9 @@ func (api *CrossModelRelationsAPI) PublishRelationChanges(
 			results.Results[i].Error = common.ServerError(err)
 			continue
 		}
-		if err := commoncrossmodel.PublishRelationChange(api.st, relationTag, change); err != nil {
+		err = commoncrossmodel.PublishRelationChange(api.st, relationTag, change)
+		api.recordRelationEvent(relationTag, change, err)
+		if err != nil {
 			results.Results[i].Error = common.ServerError(err)
 			continue
 		}
@@ -141,6 +163,26 @@ func (api *CrossModelRelationsAPI) PublishRelationChanges(
 	return results, nil
 }
 
+// recordRelationEvent records the outcome of consuming a relation change
+// published by the remote model so it can be reported when diagnosing the
+// relation. Failing to record the event does not fail the publish.
+func (api *CrossModelRelationsAPI) recordRelationEvent(
+	relationTag names.Tag, change params.RemoteRelationChangeEvent, eventErr error,
+) {
+	event := state.RemoteRelationEvent{
+		Time:          api.clock.Now(),
+		ChangedUnits:  len(change.ChangedUnits),
+		DepartedUnits: len(change.DepartedUnits),
+	}
+	if eventErr != nil {
+		event.Error = eventErr.Error()
+	}
+	err := api.st.RecordRemoteRelationEvent(relationTag.Id(), state.RemoteRelationEventReceived, event)
+	if err != nil {
+		logger.Warningf("recording received event for %v: %v", relationTag, err)
+	}
+}
+
 // RegisterRemoteRelationArgs sets up the model to participate
 // in the specified relations. This operation is idempotent.
 func (api *CrossModelRelationsAPI) RegisterRemoteRelations(
@@ -355,6 +397,32 @@ func (api *CrossModelRelationsAPI) RelationUnitSettings(relationUnits params.Rem
 	return results, nil
 }
 
+// RelationApplicationSettings returns the settings published by the given
+// applications in their relations.
+func (api *CrossModelRelationsAPIV2) RelationApplicationSettings(args params.RemoteRelationApplications) (params.SettingsResults, error) {
+	results := params.SettingsResults{
+		Results: make([]params.SettingsResult, len(args.RelationApplications)),
+	}
+	for i, arg := range args.RelationApplications {
+		relationTag, err := api.st.GetRemoteEntity(arg.RelationToken)
+		if err != nil {
+			results.Results[i].Error = common.ServerError(err)
+			continue
+		}
+		if err := api.checkMacaroonsForRelation(relationTag, arg.Macaroons); err != nil {
+			results.Results[i].Error = common.ServerError(err)
+			continue
+		}
+		settings, err := commoncrossmodel.RelationApplicationSettings(api.st, relationTag, arg.Application)
+		if err != nil {
+			results.Results[i].Error = common.ServerError(err)
+			continue
+		}
+		results.Results[i].Settings = settings
+	}
+	return results, nil
+}
+
 func watchRelationLifeSuspendedStatus(st CrossModelRelationsState, tag names.RelationTag) (state.StringsWatcher, error) {
 	relation, err := st.KeyRelation(tag.Id())
 	if err != nil {
@@ -6,7 +6,9 @@ package crossmodelrelations_test
 import (
 	"bytes"
 	"regexp"
+	"time"
 
+	"github.com/juju/errors"
 	"github.com/juju/testing"
 	jc "github.com/juju/testing/checkers"
 	gc "gopkg.in/check.v1"
@@ -39,7 +41,8 @@ type crossmodelRelationsSuite struct {
 	mockStatePool *mockStatePool
 	bakery        *mockBakeryService
 	authContext   *commoncrossmodel.AuthContext
-	api           *crossmodelrelations.CrossModelRelationsAPI
+	clock         *testing.Clock
+	api           *crossmodelrelations.CrossModelRelationsAPIV2
 
 	watchedRelations params.Entities
 	watchedOffers    []string
@@ -58,6 +61,7 @@ func (s *crossmodelRelationsSuite) SetUpTest(c *gc.C) {
 	}
 
 	s.st = newMockState()
+	s.clock = testing.NewClock(time.Date(2018, 1, 2, 3, 4, 5, 0, time.UTC))
 	s.mockStatePool = &mockStatePool{map[string]commoncrossmodel.Backend{coretesting.ModelTag.Id(): s.st}}
 	fw := &mockFirewallState{}
 	egressAddressWatcher := func(_ facade.Resources, fws firewall.State, relations params.Entities) (params.StringsWatchResults, error) {
@@ -83,9 +87,9 @@ func (s *crossmodelRelationsSuite) SetUpTest(c *gc.C) {
 	s.authContext, err = commoncrossmodel.NewAuthContext(s.mockStatePool, s.bakery, s.bakery)
 	c.Assert(err, jc.ErrorIsNil)
 	api, err := crossmodelrelations.NewCrossModelRelationsAPI(
-		s.st, fw, s.resources, s.authorizer, s.authContext, egressAddressWatcher, relationStatusWatcher, offerStatusWatcher)
+		s.st, fw, s.resources, s.authorizer, s.authContext, egressAddressWatcher, relationStatusWatcher, offerStatusWatcher, s.clock)
 	c.Assert(err, jc.ErrorIsNil)
-	s.api = api
+	s.api = &crossmodelrelations.CrossModelRelationsAPIV2{CrossModelRelationsAPI: api}
 }
 
 func (s *crossmodelRelationsSuite) assertPublishRelationsChanges(c *gc.C, life params.Life, suspendedReason string) {
@@ -152,6 +156,17 @@ func (s *crossmodelRelationsSuite) assertPublishRelationsChanges(c *gc.C, life p
 			"RemoteApplication", []interface{}{"db2"},
 		})
 	}
+	expected = append(expected, testing.StubCall{
+		"RecordRemoteRelationEvent", []interface{}{
+			"db2:db django:db",
+			state.RemoteRelationEventReceived,
+			state.RemoteRelationEvent{
+				Time:          s.clock.Now(),
+				ChangedUnits:  1,
+				DepartedUnits: 1,
+			},
+		},
+	})
 	s.st.CheckCalls(c, expected)
 	ru1.CheckCalls(c, []testing.StubCall{
 		{"InScope", []interface{}{}},
@@ -174,6 +189,91 @@ func (s *crossmodelRelationsSuite) TestPublishRelationsChangesDyingWhileSuspende
 	s.assertPublishRelationsChanges(c, params.Dying, "")
 }
 
+func (s *crossmodelRelationsSuite) TestPublishRelationsChangesApplicationSettings(c *gc.C) {
+	s.st.remoteApplications["db2"] = &mockRemoteApplication{}
+	s.st.remoteEntities[names.NewApplicationTag("db2")] = "token-db2"
+	rel := newMockRelation(1)
+	rel.appSettings["db2"] = map[string]interface{}{"foo": "baz", "gone": "soon"}
+	s.st.relations["db2:db django:db"] = rel
+	s.st.offerConnectionsByKey["db2:db django:db"] = &mockOfferConnection{
+		offerUUID:       "hosted-db2-uuid",
+		sourcemodelUUID: "source-model-uuid",
+		relationKey:     "db2:db django:db",
+		relationId:      1,
+	}
+	s.st.remoteEntities[names.NewRelationTag("db2:db django:db")] = "token-db2:db django:db"
+	mac, err := s.bakery.NewMacaroon(
+		[]checkers.Caveat{
+			checkers.DeclaredCaveat("source-model-uuid", s.st.ModelUUID()),
+			checkers.DeclaredCaveat("relation-key", "db2:db django:db"),
+			checkers.DeclaredCaveat("username", "mary"),
+		})
+	c.Assert(err, jc.ErrorIsNil)
+
+	results, err := s.api.PublishRelationChanges(params.RemoteRelationsChanges{
+		Changes: []params.RemoteRelationChangeEvent{{
+			Life:                params.Alive,
+			ApplicationToken:    "token-db2",
+			RelationToken:       "token-db2:db django:db",
+			ApplicationSettings: map[string]interface{}{"foo": "bar"},
+			Macaroons:           macaroon.Slice{mac},
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Combine(), jc.ErrorIsNil)
+	rel.CheckCalls(c, []testing.StubCall{
+		{"Suspended", []interface{}{}},
+		{"ApplicationSettings", []interface{}{"db2"}},
+		{"UpdateRemoteApplicationSettings", []interface{}{"db2", map[string]string{
+			"foo":  "bar",
+			"gone": "",
+		}}},
+	})
+}
+
+func (s *crossmodelRelationsSuite) TestPublishRelationsChangesRecordsError(c *gc.C) {
+	s.st.offerConnectionsByKey["db2:db django:db"] = &mockOfferConnection{
+		offerUUID:       "hosted-db2-uuid",
+		sourcemodelUUID: "source-model-uuid",
+		relationKey:     "db2:db django:db",
+		relationId:      1,
+	}
+	s.st.remoteEntities[names.NewRelationTag("db2:db django:db")] = "token-db2:db django:db"
+	mac, err := s.bakery.NewMacaroon(
+		[]checkers.Caveat{
+			checkers.DeclaredCaveat("source-model-uuid", s.st.ModelUUID()),
+			checkers.DeclaredCaveat("relation-key", "db2:db django:db"),
+			checkers.DeclaredCaveat("username", "mary"),
+		})
+	c.Assert(err, jc.ErrorIsNil)
+	s.st.SetErrors(nil, errors.New("boom"))
+
+	results, err := s.api.PublishRelationChanges(params.RemoteRelationsChanges{
+		Changes: []params.RemoteRelationChangeEvent{{
+			Life:             params.Alive,
+			ApplicationToken: "token-db2",
+			RelationToken:    "token-db2:db django:db",
+			DepartedUnits:    []int{2},
+			Macaroons:        macaroon.Slice{mac},
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Combine(), gc.ErrorMatches, "boom")
+	s.st.CheckCalls(c, []testing.StubCall{
+		{"GetRemoteEntity", []interface{}{"token-db2:db django:db"}},
+		{"KeyRelation", []interface{}{"db2:db django:db"}},
+		{"RecordRemoteRelationEvent", []interface{}{
+			"db2:db django:db",
+			state.RemoteRelationEventReceived,
+			state.RemoteRelationEvent{
+				Time:          s.clock.Now(),
+				DepartedUnits: 1,
+				Error:         "boom",
+			},
+		}},
+	})
+}
+
 func (s *crossmodelRelationsSuite) assertRegisterRemoteRelations(c *gc.C) {
 	app := &mockApplication{}
 	app.eps = []state.Endpoint{{
@@ -297,6 +397,43 @@ func (s *crossmodelRelationsSuite) TestRelationUnitSettings(c *gc.C) {
 	})
 }
 
+func (s *crossmodelRelationsSuite) TestRelationApplicationSettings(c *gc.C) {
+	db2Relation := newMockRelation(123)
+	db2Relation.appSettings["django"] = map[string]interface{}{"key": "value"}
+	s.st.relations["db2:db django:db"] = db2Relation
+	s.st.offerConnectionsByKey["db2:db django:db"] = &mockOfferConnection{
+		offerUUID:       "hosted-db2-uuid",
+		sourcemodelUUID: "source-model-uuid",
+		relationKey:     "db2:db django:db",
+		relationId:      1,
+	}
+	s.st.remoteEntities[names.NewRelationTag("db2:db django:db")] = "token-db2"
+	mac, err := s.bakery.NewMacaroon(
+		[]checkers.Caveat{
+			checkers.DeclaredCaveat("source-model-uuid", s.st.ModelUUID()),
+			checkers.DeclaredCaveat("relation-key", "db2:db django:db"),
+			checkers.DeclaredCaveat("username", "mary"),
+		})
+
+	c.Assert(err, jc.ErrorIsNil)
+	result, err := s.api.RelationApplicationSettings(params.RemoteRelationApplications{
+		RelationApplications: []params.RemoteRelationApplication{{
+			RelationToken: "token-db2",
+			Application:   "django",
+			Macaroons:     macaroon.Slice{mac},
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.Results, jc.DeepEquals, []params.SettingsResult{{Settings: params.Settings{"key": "value"}}})
+	s.st.CheckCalls(c, []testing.StubCall{
+		{"GetRemoteEntity", []interface{}{"token-db2"}},
+		{"KeyRelation", []interface{}{"db2:db django:db"}},
+	})
+	db2Relation.CheckCalls(c, []testing.StubCall{
+		{"ApplicationSettings", []interface{}{"django"}},
+	})
+}
+
 func (s *crossmodelRelationsSuite) TestPublishIngressNetworkChanges(c *gc.C) {
 	s.st.remoteApplications["db2"] = &mockRemoteApplication{}
 	rel := newMockRelation(1)
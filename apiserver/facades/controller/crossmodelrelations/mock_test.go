@@ -139,6 +139,13 @@ func (st *mockState) OfferConnectionForRelation(relationKey string) (crossmodelr
 	return oc, nil
 }
 
+func (st *mockState) RecordRemoteRelationEvent(
+	relationKey string, direction state.RemoteRelationEventDirection, event state.RemoteRelationEvent,
+) error {
+	st.MethodCall(st, "RecordRemoteRelationEvent", relationKey, direction, event)
+	return st.NextErr()
+}
+
 func (st *mockState) EndpointsRelation(eps ...state.Endpoint) (commoncrossmodel.Relation, error) {
 	key := fmt.Sprintf("%v:%v %v:%v", eps[0].ApplicationName, eps[0].Name, eps[1].ApplicationName, eps[1].Name)
 	if rel, ok := st.relations[key]; ok {
@@ -312,12 +319,14 @@ type mockRelation struct {
 	status          status.Status
 	message         string
 	units           map[string]commoncrossmodel.RelationUnit
+	appSettings     map[string]map[string]interface{}
 }
 
 func newMockRelation(id int) *mockRelation {
 	return &mockRelation{
-		id:    id,
-		units: make(map[string]commoncrossmodel.RelationUnit),
+		id:          id,
+		units:       make(map[string]commoncrossmodel.RelationUnit),
+		appSettings: make(map[string]map[string]interface{}),
 	}
 }
 
@@ -376,6 +385,19 @@ func (r *mockRelation) RemoteUnit(unitId string) (commoncrossmodel.RelationUnit,
 	return u, nil
 }
 
+func (r *mockRelation) ApplicationSettings(appName string) (map[string]interface{}, error) {
+	r.MethodCall(r, "ApplicationSettings", appName)
+	if err := r.NextErr(); err != nil {
+		return nil, err
+	}
+	return r.appSettings[appName], nil
+}
+
+func (r *mockRelation) UpdateRemoteApplicationSettings(appName string, updates map[string]string) error {
+	r.MethodCall(r, "UpdateRemoteApplicationSettings", appName, updates)
+	return r.NextErr()
+}
+
 func (r *mockRelation) Unit(unitId string) (commoncrossmodel.RelationUnit, error) {
 	r.MethodCall(r, "Unit", unitId)
 	if err := r.NextErr(); err != nil {
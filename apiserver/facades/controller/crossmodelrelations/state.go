@@ -25,6 +25,10 @@ type CrossModelRelationsState interface {
 
 	// OfferConnectionForRelation returns the offer connection details for the given relation key.
 	OfferConnectionForRelation(string) (OfferConnection, error)
+
+	// RecordRemoteRelationEvent records the latest event exchanged in
+	// the specified direction for the relation with the given key.
+	RecordRemoteRelationEvent(relationKey string, direction state.RemoteRelationEventDirection, event state.RemoteRelationEvent) error
 }
 
 // TODO - CAAS(ericclaudejones): This should contain state alone, model will be
@@ -47,6 +51,12 @@ func (st stateShim) OfferConnectionForRelation(relationKey string) (OfferConnect
 	return st.st.OfferConnectionForRelation(relationKey)
 }
 
+func (st stateShim) RecordRemoteRelationEvent(
+	relationKey string, direction state.RemoteRelationEventDirection, event state.RemoteRelationEvent,
+) error {
+	return st.st.RecordRemoteRelationEvent(relationKey, direction, event)
+}
+
 type Model interface {
 	Name() string
 	Owner() names.UserTag
@@ -51,6 +51,11 @@ type FirewallerAPIV5 struct {
 	*FirewallerAPIV4
 }
 
+// FirewallerAPIV6 provides access to the Firewaller v6 API facade.
+type FirewallerAPIV6 struct {
+	*FirewallerAPIV5
+}
+
 // NewStateFirewallerAPIV3 creates a new server-side FirewallerAPIV3 facade.
 func NewStateFirewallerAPIV3(context facade.Context) (*FirewallerAPIV3, error) {
 	st := context.State()
@@ -64,7 +69,12 @@ func NewStateFirewallerAPIV3(context facade.Context) (*FirewallerAPIV3, error) {
 		cloudspec.MakeCloudSpecGetterForModel(st),
 		common.AuthFuncForTag(m.ModelTag()),
 	)
-	return NewFirewallerAPI(stateShim{st: st, State: firewall.StateShim(st, m)}, context.Resources(), context.Auth(), cloudSpecAPI)
+	shim := stateShim{
+		st:           st,
+		controllerSt: context.StatePool().SystemState(),
+		State:        firewall.StateShim(st, m),
+	}
+	return NewFirewallerAPI(shim, context.Resources(), context.Auth(), cloudSpecAPI)
 }
 
 // NewStateFirewallerAPIV4 creates a new server-side FirewallerAPIV4 facade.
@@ -90,6 +100,17 @@ func NewStateFirewallerAPIV5(context facade.Context) (*FirewallerAPIV5, error) {
 	}, nil
 }
 
+// NewStateFirewallerAPIV6 creates a new server-side FirewallerAPIV6 facade.
+func NewStateFirewallerAPIV6(context facade.Context) (*FirewallerAPIV6, error) {
+	facadev5, err := NewStateFirewallerAPIV5(context)
+	if err != nil {
+		return nil, err
+	}
+	return &FirewallerAPIV6{
+		FirewallerAPIV5: facadev5,
+	}, nil
+}
+
 // NewFirewallerAPI creates a new server-side FirewallerAPIV3 facade.
 func NewFirewallerAPI(
 	st State,
@@ -532,3 +553,44 @@ func (f *FirewallerAPIV5) AreManuallyProvisioned(args params.Entities) (params.B
 	}
 	return result, nil
 }
+
+// WatchRelatedIngressAddresses creates a watcher that notifies when the
+// addresses of units related to each given application, from which
+// connections to the application's units will originate, change.
+// Relations to applications in other models are not included.
+func (f *FirewallerAPIV6) WatchRelatedIngressAddresses(args params.Entities) (params.StringsWatchResults, error) {
+	return firewall.WatchRelatedIngressAddresses(f.resources, f.st, args)
+}
+
+// ModelFirewallRules returns the named firewall rules which apply to
+// arbitrary port ranges in the model, including any set on the controller
+// which aren't overridden by a model rule of the same name.
+func (f *FirewallerAPIV6) ModelFirewallRules() (params.ListFirewallRulesResults, error) {
+	var result params.ListFirewallRulesResults
+	rules, err := f.st.ModelFirewallRules()
+	if err != nil {
+		return result, common.ServerError(err)
+	}
+	result.Rules = make([]params.FirewallRule, len(rules))
+	for i, rule := range rules {
+		portRange := params.FromNetworkPortRange(rule.PortRange)
+		result.Rules[i] = params.FirewallRule{
+			Name:           rule.Name,
+			PortRange:      &portRange,
+			WhitelistCIDRS: rule.WhitelistCIDRs,
+		}
+	}
+	return result, nil
+}
+
+// WatchModelFirewallRules returns a NotifyWatcher which notifies when
+// the named firewall rules applying to the model may have changed.
+func (f *FirewallerAPIV6) WatchModelFirewallRules() (params.NotifyWatchResult, error) {
+	var result params.NotifyWatchResult
+	w := f.st.WatchModelFirewallRules()
+	if _, ok := <-w.Changes(); !ok {
+		return result, common.ServerError(watcher.EnsureErr(w))
+	}
+	result.NotifyWatcherId = f.resources.Register(w)
+	return result, nil
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/juju/juju/apiserver/params"
 	apiservertesting "github.com/juju/juju/apiserver/testing"
 	"github.com/juju/juju/core/crossmodel"
+	"github.com/juju/juju/network"
 	"github.com/juju/juju/state"
 	"github.com/juju/juju/status"
 	coretesting "github.com/juju/juju/testing"
@@ -134,3 +135,33 @@ func (s *RemoteFirewallerSuite) TestFirewallRules(c *gc.C) {
 	c.Assert(result.Rules[0].KnownService, gc.Equals, params.KnownServiceValue("juju-application-offer"))
 	c.Assert(result.Rules[0].WhitelistCIDRS, jc.SameContents, []string{"192.168.0.0/16"})
 }
+
+func (s *RemoteFirewallerSuite) TestModelFirewallRules(c *gc.C) {
+	s.st.namedRules = []*state.FirewallRule{{
+		Name:           "prometheus",
+		PortRange:      network.PortRange{FromPort: 9100, ToPort: 9110, Protocol: "tcp"},
+		WhitelistCIDRs: []string{"10.0.0.0/8"},
+	}}
+	api := &firewaller.FirewallerAPIV6{&firewaller.FirewallerAPIV5{s.api}}
+	result, err := api.ModelFirewallRules()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result, jc.DeepEquals, params.ListFirewallRulesResults{
+		Rules: []params.FirewallRule{{
+			Name:           "prometheus",
+			PortRange:      &params.PortRange{FromPort: 9100, ToPort: 9110, Protocol: "tcp"},
+			WhitelistCIDRS: []string{"10.0.0.0/8"},
+		}},
+	})
+}
+
+func (s *RemoteFirewallerSuite) TestWatchModelFirewallRules(c *gc.C) {
+	api := &firewaller.FirewallerAPIV6{&firewaller.FirewallerAPIV5{s.api}}
+	result, err := api.WatchModelFirewallRules()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.Error, gc.IsNil)
+	c.Assert(result.NotifyWatcherId, gc.Equals, "1")
+
+	resource := s.resources.Get("1")
+	c.Assert(resource, gc.Equals, s.st.rulesWatcher)
+	s.st.CheckCallNames(c, "WatchModelFirewallRules")
+}
@@ -40,6 +40,8 @@ type mockState struct {
 	relations      map[string]*mockRelation
 	controllerInfo map[string]*mockControllerInfo
 	firewallRules  map[state.WellKnownServiceType]*state.FirewallRule
+	namedRules     []*state.FirewallRule
+	rulesWatcher   *mockNotifyWatcher
 	subnetsWatcher *mockStringsWatcher
 	modelWatcher   *mockNotifyWatcher
 	configAttrs    map[string]interface{}
@@ -53,6 +55,7 @@ func newMockState(modelUUID string) *mockState {
 		macaroons:      make(map[names.Tag]*macaroon.Macaroon),
 		controllerInfo: make(map[string]*mockControllerInfo),
 		firewallRules:  make(map[state.WellKnownServiceType]*state.FirewallRule),
+		rulesWatcher:   newMockNotifyWatcher(),
 		subnetsWatcher: newMockStringsWatcher(),
 		modelWatcher:   newMockNotifyWatcher(),
 		configAttrs:    coretesting.FakeConfig(),
@@ -120,6 +123,16 @@ func (st *mockState) FirewallRule(service state.WellKnownServiceType) (*state.Fi
 	return r, nil
 }
 
+func (st *mockState) ModelFirewallRules() ([]*state.FirewallRule, error) {
+	st.MethodCall(st, "ModelFirewallRules")
+	return st.namedRules, st.NextErr()
+}
+
+func (st *mockState) WatchModelFirewallRules() state.NotifyWatcher {
+	st.MethodCall(st, "WatchModelFirewallRules")
+	return st.rulesWatcher
+}
+
 type mockWatcher struct {
 	testing.Stub
 	tomb.Tomb
@@ -4,9 +4,12 @@
 package firewaller
 
 import (
+	"github.com/juju/collections/set"
+	"github.com/juju/errors"
 	"gopkg.in/juju/names.v2"
 	"gopkg.in/macaroon.v2-unstable"
 
+	"github.com/juju/juju/apiserver/common"
 	"github.com/juju/juju/apiserver/common/firewall"
 	"github.com/juju/juju/state"
 )
@@ -25,16 +28,26 @@ type State interface {
 	FindEntity(tag names.Tag) (state.Entity, error)
 
 	FirewallRule(service state.WellKnownServiceType) (*state.FirewallRule, error)
+
+	// ModelFirewallRules returns the named firewall rules which apply
+	// to the model, being those set on the model along with those set
+	// on the controller which aren't overridden by the model.
+	ModelFirewallRules() ([]*state.FirewallRule, error)
+
+	// WatchModelFirewallRules returns a watcher which notifies when
+	// the named firewall rules applying to the model may have changed.
+	WatchModelFirewallRules() state.NotifyWatcher
 }
 
 // TODO(wallyworld) - for tests, remove when remaining firewaller tests become unit tests.
 func StateShim(st *state.State, m *state.Model) stateShim {
-	return stateShim{st: st, State: firewall.StateShim(st, m)}
+	return stateShim{st: st, controllerSt: st, State: firewall.StateShim(st, m)}
 }
 
 type stateShim struct {
 	firewall.State
-	st *state.State
+	st           *state.State
+	controllerSt *state.State
 }
 
 func (st stateShim) ModelUUID() string {
@@ -58,3 +71,31 @@ func (s stateShim) FirewallRule(service state.WellKnownServiceType) (*state.Fire
 	api := state.NewFirewallRules(s.st)
 	return api.Rule(service)
 }
+
+func (s stateShim) ModelFirewallRules() ([]*state.FirewallRule, error) {
+	rules, err := state.NewFirewallRules(s.st).NamedRules()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	controllerRules, err := state.NewControllerFirewallRules(s.controllerSt).NamedRules()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	modelRules := set.NewStrings()
+	for _, rule := range rules {
+		modelRules.Add(rule.Name)
+	}
+	for _, rule := range controllerRules {
+		if !modelRules.Contains(rule.Name) {
+			rules = append(rules, rule)
+		}
+	}
+	return rules, nil
+}
+
+func (s stateShim) WatchModelFirewallRules() state.NotifyWatcher {
+	return common.NewMultiNotifyWatcher(
+		state.NewFirewallRules(s.st).Watch(),
+		state.NewControllerFirewallRules(s.controllerSt).Watch(),
+	)
+}
@@ -35,11 +35,25 @@ type API struct {
 	callContext context.ProviderCallContext
 }
 
+// APIV1 implements the V1 API. It lacks CheckImport.
+type APIV1 struct {
+	*API
+}
+
 // NewFacade is used for API registration.
 func NewFacade(ctx facade.Context) (*API, error) {
 	return NewAPI(ctx, stateenvirons.GetNewEnvironFunc(environs.New), state.CallContext(ctx.State()))
 }
 
+// NewFacadeV1 is used for V1 API registration.
+func NewFacadeV1(ctx facade.Context) (*APIV1, error) {
+	api, err := NewFacade(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &APIV1{api}, nil
+}
+
 // NewAPI returns a new API. Accepts a NewEnvironFunc and context.ProviderCallContext
 // for testing purposes.
 func NewAPI(ctx facade.Context, getEnviron stateenvirons.NewEnvironFunc, callCtx context.ProviderCallContext) (*API, error) {
@@ -103,6 +117,45 @@ func (api *API) Prechecks(model params.MigrationModelInfo) error {
 	)
 }
 
+// CheckImport runs the same checks as Prechecks, and checks that the
+// serialized model could be imported, without importing it. Rather
+// than failing on the first problem found, all of them are returned.
+func (api *API) CheckImport(args params.MigrationImportCheckArgs) (params.MigrationProblems, error) {
+	var result params.MigrationProblems
+	ownerTag, err := names.ParseUserTag(args.Info.OwnerTag)
+	if err != nil {
+		return result, errors.Trace(err)
+	}
+	controllerState := api.pool.SystemState()
+	backend, err := migration.PrecheckShim(api.state, controllerState)
+	if err != nil {
+		return result, errors.Annotate(err, "creating backend")
+	}
+	problems, err := migration.TargetPrecheckProblems(
+		backend,
+		migration.PoolShim(api.pool),
+		coremigration.ModelInfo{
+			UUID:                   args.Info.UUID,
+			Name:                   args.Info.Name,
+			Owner:                  ownerTag,
+			AgentVersion:           args.Info.AgentVersion,
+			ControllerAgentVersion: args.Info.ControllerAgentVersion,
+		},
+		api.presence.ModelPresence(controllerState.ModelUUID()),
+	)
+	if err != nil {
+		return result, errors.Trace(err)
+	}
+	importProblems, err := migration.ValidateImport(api.state, args.Bytes)
+	if err != nil {
+		return result, errors.Trace(err)
+	}
+	for _, problem := range append(problems, importProblems...) {
+		result.Problems = append(result.Problems, problem.Error())
+	}
+	return result, nil
+}
+
 // Import takes a serialized Juju model, deserializes it, and
 // recreates it in the receiving controller.
 func (api *API) Import(serialized params.SerializedModel) error {
@@ -314,3 +367,6 @@ func (api *API) CACert() (params.BytesResult, error) {
 	caCert, _ := cfg.CACert()
 	return params.BytesResult{Result: []byte(caCert)}, nil
 }
+
+// CheckImport isn't on the V1 API.
+func (*APIV1) CheckImport(_, _ struct{}) {}
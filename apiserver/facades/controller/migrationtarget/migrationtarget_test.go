@@ -4,6 +4,7 @@
 package migrationtarget_test
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/juju/description"
@@ -62,7 +63,7 @@ func (s *Suite) SetUpTest(c *gc.C) {
 }
 
 func (s *Suite) TestFacadeRegistered(c *gc.C) {
-	factory, err := apiserver.AllFacades().GetFactory("MigrationTarget", 1)
+	factory, err := apiserver.AllFacades().GetFactory("MigrationTarget", 2)
 	c.Assert(err, jc.ErrorIsNil)
 
 	api, err := factory(&facadetest.Context{
@@ -74,6 +75,19 @@ func (s *Suite) TestFacadeRegistered(c *gc.C) {
 	c.Assert(api, gc.FitsTypeOf, new(migrationtarget.API))
 }
 
+func (s *Suite) TestFacadeRegisteredV1(c *gc.C) {
+	factory, err := apiserver.AllFacades().GetFactory("MigrationTarget", 1)
+	c.Assert(err, jc.ErrorIsNil)
+
+	api, err := factory(&facadetest.Context{
+		State_:     s.State,
+		Resources_: s.resources,
+		Auth_:      s.authorizer,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(api, gc.FitsTypeOf, new(migrationtarget.APIV1))
+}
+
 func (s *Suite) TestNotUser(c *gc.C) {
 	s.authorizer.Tag = names.NewMachineTag("0")
 	_, err := s.newAPI(nil)
@@ -128,6 +142,52 @@ func (s *Suite) TestPrechecksFail(c *gc.C) {
 	c.Assert(err, gc.NotNil)
 }
 
+func (s *Suite) TestCheckImport(c *gc.C) {
+	api := s.mustNewAPI(c)
+	uuid, bytes := s.makeExportedModel(c)
+	result, err := api.CheckImport(params.MigrationImportCheckArgs{
+		Info: params.MigrationModelInfo{
+			UUID:                   uuid,
+			Name:                   "some-model",
+			OwnerTag:               names.NewUserTag("someone").String(),
+			AgentVersion:           s.controllerVersion(c),
+			ControllerAgentVersion: s.controllerVersion(c),
+		},
+		Bytes: bytes,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.Problems, gc.HasLen, 0)
+
+	// Nothing is imported.
+	exists, err := s.State.ModelExists(uuid)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(exists, jc.IsFalse)
+}
+
+func (s *Suite) TestCheckImportProblems(c *gc.C) {
+	controllerVersion := s.controllerVersion(c)
+	modelVersion := controllerVersion
+	modelVersion.Minor++
+
+	api := s.mustNewAPI(c)
+	result, err := api.CheckImport(params.MigrationImportCheckArgs{
+		Info: params.MigrationModelInfo{
+			UUID:                   s.State.ModelUUID(),
+			Name:                   s.IAASModel.Name(),
+			OwnerTag:               s.IAASModel.Owner().String(),
+			AgentVersion:           modelVersion,
+			ControllerAgentVersion: controllerVersion,
+		},
+		Bytes: []byte("not a model"),
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.Problems, gc.HasLen, 4)
+	c.Check(result.Problems[0], gc.Matches, `model has higher version than target controller \(.*\)`)
+	c.Check(result.Problems[1], gc.Matches, `model with same UUID already exists \(.*\)`)
+	c.Check(result.Problems[2], gc.Equals, fmt.Sprintf("model named %q already exists", s.IAASModel.Name()))
+	c.Check(result.Problems[3], gc.Matches, `cannot read model: .*`)
+}
+
 func (s *Suite) TestImport(c *gc.C) {
 	api := s.mustNewAPI(c)
 	tag := s.importModel(c, api)
@@ -132,6 +132,13 @@ func (st *mockState) SaveMacaroon(entity names.Tag, mac *macaroon.Macaroon) erro
 	return st.NextErr()
 }
 
+func (st *mockState) RecordRemoteRelationEvent(
+	relationKey string, direction state.RemoteRelationEventDirection, event state.RemoteRelationEvent,
+) error {
+	st.MethodCall(st, "RecordRemoteRelationEvent", relationKey, direction, event)
+	return st.NextErr()
+}
+
 func (st *mockState) KeyRelation(key string) (common.Relation, error) {
 	st.MethodCall(st, "KeyRelation", key)
 	if err := st.NextErr(); err != nil {
@@ -227,6 +234,7 @@ type mockRelation struct {
 	remoteUnits           map[string]common.RelationUnit
 	endpoints             []state.Endpoint
 	endpointUnitsWatchers map[string]*mockRelationUnitsWatcher
+	appSettings           map[string]map[string]interface{}
 }
 
 func newMockRelation(id int) *mockRelation {
@@ -236,6 +244,7 @@ func newMockRelation(id int) *mockRelation {
 		units:                 make(map[string]common.RelationUnit),
 		remoteUnits:           make(map[string]common.RelationUnit),
 		endpointUnitsWatchers: make(map[string]*mockRelationUnitsWatcher),
+		appSettings:           make(map[string]map[string]interface{}),
 	}
 }
 
@@ -283,6 +292,18 @@ func (r *mockRelation) RemoteUnit(unitId string) (common.RelationUnit, error) {
 	return u, nil
 }
 
+func (r *mockRelation) ApplicationSettings(appName string) (map[string]interface{}, error) {
+	r.MethodCall(r, "ApplicationSettings", appName)
+	if err := r.NextErr(); err != nil {
+		return nil, err
+	}
+	settings, ok := r.appSettings[appName]
+	if !ok {
+		return nil, errors.NotFoundf("application %q", appName)
+	}
+	return settings, nil
+}
+
 func (r *mockRelation) Endpoints() []state.Endpoint {
 	r.MethodCall(r, "Endpoints")
 	return r.endpoints
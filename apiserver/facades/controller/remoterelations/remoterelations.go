@@ -11,6 +11,7 @@ import (
 	commoncrossmodel "github.com/juju/juju/apiserver/common/crossmodel"
 	"github.com/juju/juju/apiserver/facade"
 	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/state"
 	"github.com/juju/juju/state/watcher"
 	"github.com/juju/juju/status"
 )
@@ -23,6 +24,16 @@ type RemoteRelationsAPI struct {
 	authorizer facade.Authorizer
 }
 
+// RemoteRelationsAPIV2 provides access to the RemoteRelations v2 API facade.
+type RemoteRelationsAPIV2 struct {
+	*RemoteRelationsAPI
+}
+
+// RemoteRelationsAPIV3 provides access to the RemoteRelations v3 API facade.
+type RemoteRelationsAPIV3 struct {
+	*RemoteRelationsAPIV2
+}
+
 // NewStateRemoteRelationsAPI creates a new server-side RemoteRelationsAPI facade
 // backed by global state.
 func NewStateRemoteRelationsAPI(ctx facade.Context) (*RemoteRelationsAPI, error) {
@@ -34,6 +45,26 @@ func NewStateRemoteRelationsAPI(ctx facade.Context) (*RemoteRelationsAPI, error)
 
 }
 
+// NewStateRemoteRelationsAPIV2 creates a new server-side RemoteRelationsAPIV2
+// facade backed by global state.
+func NewStateRemoteRelationsAPIV2(ctx facade.Context) (*RemoteRelationsAPIV2, error) {
+	api, err := NewStateRemoteRelationsAPI(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &RemoteRelationsAPIV2{api}, nil
+}
+
+// NewStateRemoteRelationsAPIV3 creates a new server-side RemoteRelationsAPIV3
+// facade backed by global state.
+func NewStateRemoteRelationsAPIV3(ctx facade.Context) (*RemoteRelationsAPIV3, error) {
+	api, err := NewStateRemoteRelationsAPIV2(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &RemoteRelationsAPIV3{api}, nil
+}
+
 // NewRemoteRelationsAPI returns a new server-side RemoteRelationsAPI facade.
 func NewRemoteRelationsAPI(
 	st RemoteRelationsState,
@@ -419,3 +450,69 @@ func (f *RemoteRelationsAPI) SetRemoteApplicationsStatus(args params.SetStatus)
 	}
 	return result, nil
 }
+
+// RecordRemoteRelationEvents records the relation settings changes most
+// recently exchanged with remote models, so that stalled cross model
+// relations can be diagnosed.
+func (api *RemoteRelationsAPIV2) RecordRemoteRelationEvents(args params.RemoteRelationEventArgs) (params.ErrorResults, error) {
+	results := params.ErrorResults{
+		Results: make([]params.ErrorResult, len(args.Args)),
+	}
+	for i, arg := range args.Args {
+		err := api.recordRemoteRelationEvent(arg)
+		results.Results[i].Error = common.ServerError(err)
+	}
+	return results, nil
+}
+
+func (api *RemoteRelationsAPIV2) recordRemoteRelationEvent(arg params.RemoteRelationEventArg) error {
+	tag, err := api.st.GetRemoteEntity(arg.RelationToken)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	relationTag, ok := tag.(names.RelationTag)
+	if !ok {
+		return errors.NotValidf("token %q for %v", arg.RelationToken, tag)
+	}
+	var direction state.RemoteRelationEventDirection
+	switch arg.Direction {
+	case params.RemoteRelationEventSent:
+		direction = state.RemoteRelationEventSent
+	case params.RemoteRelationEventReceived:
+		direction = state.RemoteRelationEventReceived
+	default:
+		return errors.NotValidf("remote relation event direction %q", arg.Direction)
+	}
+	return api.st.RecordRemoteRelationEvent(
+		relationTag.Id(),
+		direction,
+		state.RemoteRelationEvent{
+			Time:          arg.Time,
+			ChangedUnits:  arg.ChangedUnits,
+			DepartedUnits: arg.DepartedUnits,
+			Error:         arg.Error,
+		},
+	)
+}
+
+// RelationApplicationSettings returns the settings published by the given
+// applications in relations in the local model.
+func (api *RemoteRelationsAPIV3) RelationApplicationSettings(args params.RelationApplications) (params.SettingsResults, error) {
+	results := params.SettingsResults{
+		Results: make([]params.SettingsResult, len(args.RelationApplications)),
+	}
+	for i, arg := range args.RelationApplications {
+		relationTag, err := names.ParseRelationTag(arg.Relation)
+		if err != nil {
+			results.Results[i].Error = common.ServerError(err)
+			continue
+		}
+		settings, err := commoncrossmodel.RelationApplicationSettings(api.st, relationTag, arg.Application)
+		if err != nil {
+			results.Results[i].Error = common.ServerError(err)
+			continue
+		}
+		results.Results[i].Settings = settings
+	}
+	return results, nil
+}
@@ -4,6 +4,8 @@
 package remoterelations_test
 
 import (
+	"time"
+
 	"github.com/juju/testing"
 	jc "github.com/juju/testing/checkers"
 	gc "gopkg.in/check.v1"
@@ -29,7 +31,7 @@ type remoteRelationsSuite struct {
 	resources  *common.Resources
 	authorizer *apiservertesting.FakeAuthorizer
 	st         *mockState
-	api        *remoterelations.RemoteRelationsAPI
+	api        *remoterelations.RemoteRelationsAPIV3
 }
 
 func (s *remoteRelationsSuite) SetUpTest(c *gc.C) {
@@ -46,7 +48,9 @@ func (s *remoteRelationsSuite) SetUpTest(c *gc.C) {
 	s.st = newMockState()
 	api, err := remoterelations.NewRemoteRelationsAPI(s.st, common.NewControllerConfig(s.st), s.resources, s.authorizer)
 	c.Assert(err, jc.ErrorIsNil)
-	s.api = api
+	s.api = &remoterelations.RemoteRelationsAPIV3{
+		RemoteRelationsAPIV2: &remoterelations.RemoteRelationsAPIV2{RemoteRelationsAPI: api},
+	}
 }
 
 func (s *remoteRelationsSuite) TestWatchRemoteApplications(c *gc.C) {
@@ -267,6 +271,28 @@ func (s *remoteRelationsSuite) TestRelationUnitSettings(c *gc.C) {
 	})
 }
 
+func (s *remoteRelationsSuite) TestRelationApplicationSettings(c *gc.C) {
+	db2Relation := newMockRelation(123)
+	db2Relation.appSettings["django"] = map[string]interface{}{"key": "value"}
+	s.st.relations["db2:db django:db"] = db2Relation
+	result, err := s.api.RelationApplicationSettings(params.RelationApplications{
+		RelationApplications: []params.RelationApplication{
+			{Relation: "relation-db2.db#django.db", Application: "django"},
+			{Relation: "relation-db2.db#django.db", Application: "db2"},
+			{Relation: "application-django", Application: "django"},
+		}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.Results, jc.DeepEquals, []params.SettingsResult{
+		{Settings: params.Settings{"key": "value"}},
+		{Error: &params.Error{Code: params.CodeNotFound, Message: `application "db2" not found`}},
+		{Error: &params.Error{Message: `"application-django" is not a valid relation tag`}},
+	})
+	s.st.CheckCalls(c, []testing.StubCall{
+		{"KeyRelation", []interface{}{"db2:db django:db"}},
+		{"KeyRelation", []interface{}{"db2:db django:db"}},
+	})
+}
+
 func (s *remoteRelationsSuite) TestRemoteApplications(c *gc.C) {
 	s.st.remoteApplications["django"] = newMockRemoteApplication("django", "me/model.riak")
 	result, err := s.api.RemoteApplications(params.Entities{Entities: []params.Entity{{Tag: "application-django"}}})
@@ -426,3 +452,39 @@ func (s *remoteRelationsSuite) TestSetRemoteApplicationsStatus(c *gc.C) {
 	c.Assert(remoteApp.status, gc.Equals, status.Blocked)
 	c.Assert(remoteApp.message, gc.Equals, "a message")
 }
+
+func (s *remoteRelationsSuite) TestRecordRemoteRelationEvents(c *gc.C) {
+	relTag := names.NewRelationTag("db2:db django:db")
+	s.st.remoteEntities[relTag] = "rel-token"
+	s.st.remoteEntities[names.NewApplicationTag("db2")] = "app-token"
+	now := time.Date(2018, 1, 2, 3, 4, 5, 0, time.UTC)
+	result, err := s.api.RecordRemoteRelationEvents(params.RemoteRelationEventArgs{
+		Args: []params.RemoteRelationEventArg{{
+			RelationToken: "rel-token",
+			Direction:     params.RemoteRelationEventSent,
+			Time:          now,
+			ChangedUnits:  2,
+			Error:         "boom",
+		}, {
+			RelationToken: "app-token",
+			Direction:     params.RemoteRelationEventReceived,
+		}, {
+			RelationToken: "unknown",
+			Direction:     params.RemoteRelationEventReceived,
+		}, {
+			RelationToken: "rel-token",
+			Direction:     "sideways",
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.Results, gc.HasLen, 4)
+	c.Assert(result.Results[0].Error, gc.IsNil)
+	c.Assert(result.Results[1].Error, gc.ErrorMatches, `token "app-token" for application-db2 not valid`)
+	c.Assert(result.Results[2].Error, gc.ErrorMatches, `token unknown not found`)
+	c.Assert(result.Results[3].Error, gc.ErrorMatches, `remote relation event direction "sideways" not valid`)
+	s.st.CheckCall(c, 1, "RecordRemoteRelationEvent", "db2:db django:db", state.RemoteRelationEventSent, state.RemoteRelationEvent{
+		Time:         now,
+		ChangedUnits: 2,
+		Error:        "boom",
+	})
+}
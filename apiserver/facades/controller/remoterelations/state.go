@@ -38,6 +38,10 @@ type RemoteRelationsState interface {
 
 	// SaveMacaroon saves the given macaroon for the specified entity.
 	SaveMacaroon(entity names.Tag, mac *macaroon.Macaroon) error
+
+	// RecordRemoteRelationEvent records the latest event exchanged in
+	// the specified direction for the relation with the given key.
+	RecordRemoteRelationEvent(relationKey string, direction state.RemoteRelationEventDirection, event state.RemoteRelationEvent) error
 }
 
 // TODO - CAAS(ericclaudejones): This should contain state alone, model will be
@@ -62,6 +66,12 @@ func (st stateShim) SaveMacaroon(entity names.Tag, mac *macaroon.Macaroon) error
 	return r.SaveMacaroon(entity, mac)
 }
 
+func (st stateShim) RecordRemoteRelationEvent(
+	relationKey string, direction state.RemoteRelationEventDirection, event state.RemoteRelationEvent,
+) error {
+	return st.st.RecordRemoteRelationEvent(relationKey, direction, event)
+}
+
 func (st stateShim) WatchRemoteApplications() state.StringsWatcher {
 	return st.st.WatchRemoteApplications()
 }
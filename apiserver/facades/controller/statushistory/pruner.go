@@ -33,10 +33,14 @@ func NewAPI(st *state.State, r facade.Resources, auth facade.Authorizer) (*API,
 
 // Prune endpoint removes status history entries until
 // only the ones newer than now - p.MaxHistoryTime remain and
-// the history is smaller than p.MaxHistoryMB.
+// the history is smaller than p.MaxHistoryMB. Hook history
+// is pruned in the same way.
 func (api *API) Prune(p params.StatusHistoryPruneArgs) error {
 	if !api.authorizer.AuthController() {
 		return common.ErrPerm
 	}
-	return state.PruneStatusHistory(api.st, p.MaxHistoryTime, p.MaxHistoryMB)
+	if err := state.PruneStatusHistory(api.st, p.MaxHistoryTime, p.MaxHistoryMB); err != nil {
+		return err
+	}
+	return state.PruneHookHistory(api.st, p.MaxHistoryTime, p.MaxHistoryMB)
 }
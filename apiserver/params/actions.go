@@ -38,6 +38,7 @@ type Action struct {
 	Receiver   string                 `json:"receiver"`
 	Name       string                 `json:"name"`
 	Parameters map[string]interface{} `json:"parameters,omitempty"`
+	Timeout    time.Duration          `json:"timeout,omitempty"`
 }
 
 // ActionResults is a slice of ActionResult for bulk requests.
@@ -4,6 +4,8 @@
 package params
 
 import (
+	"time"
+
 	"gopkg.in/juju/charm.v6"
 	"gopkg.in/macaroon.v2-unstable"
 )
@@ -370,10 +372,58 @@ type RemoteRelationChangeEvent struct {
 	// the relation since the last change.
 	DepartedUnits []int `json:"departed-units,omitempty"`
 
+	// ApplicationSettings holds the settings published by the
+	// application, if they have changed. It is not omitted when
+	// empty, so that removing every setting can be told apart
+	// from no change at all.
+	ApplicationSettings map[string]interface{} `json:"application-settings"`
+
 	// Macaroons are used for authentication.
 	Macaroons macaroon.Slice `json:"macaroons,omitempty"`
 }
 
+// RemoteRelationEventArgs holds the events exchanged with remote
+// models which are to be recorded for diagnostic purposes.
+type RemoteRelationEventArgs struct {
+	Args []RemoteRelationEventArg `json:"args"`
+}
+
+// RemoteRelationEventDirection identifies whether an event was sent to,
+// or received from, the remote side of a relation.
+type RemoteRelationEventDirection string
+
+const (
+	// RemoteRelationEventSent is the direction of an event
+	// published to the remote model.
+	RemoteRelationEventSent RemoteRelationEventDirection = "sent"
+
+	// RemoteRelationEventReceived is the direction of an event
+	// consumed from the remote model.
+	RemoteRelationEventReceived RemoteRelationEventDirection = "received"
+)
+
+// RemoteRelationEventArg describes a relation settings change which
+// was sent to, or received from, the remote side of a relation.
+type RemoteRelationEventArg struct {
+	// RelationToken is the token of the relation.
+	RelationToken string `json:"relation-token"`
+
+	// Direction is whether the event was sent or received.
+	Direction RemoteRelationEventDirection `json:"direction"`
+
+	// Time is when the event was exchanged.
+	Time time.Time `json:"time"`
+
+	// ChangedUnits is the number of units whose settings changed.
+	ChangedUnits int `json:"changed-units"`
+
+	// DepartedUnits is the number of units which departed.
+	DepartedUnits int `json:"departed-units"`
+
+	// Error, if set, is the reason the event could not be delivered.
+	Error string `json:"error,omitempty"`
+}
+
 // RelationLifeSuspendedStatusChange describes the life
 // and suspended status of a relation.
 type RelationLifeSuspendedStatusChange struct {
@@ -587,6 +637,19 @@ type RemoteRelationUnits struct {
 	RelationUnits []RemoteRelationUnit `json:"relation-units"`
 }
 
+// RemoteRelationApplication identifies an application whose settings
+// in a remote relation are being requested.
+type RemoteRelationApplication struct {
+	RelationToken string         `json:"relation-token"`
+	Application   string         `json:"application"`
+	Macaroons     macaroon.Slice `json:"macaroons,omitempty"`
+}
+
+// RemoteRelationApplications identifies multiple remote relation applications.
+type RemoteRelationApplications struct {
+	RelationApplications []RemoteRelationApplication `json:"relation-applications"`
+}
+
 // ModifyModelAccessRequest holds the parameters for making grant and revoke offer calls.
 type ModifyOfferAccessRequest struct {
 	Changes []ModifyOfferAccess `json:"changes"`
@@ -627,3 +690,115 @@ type ExternalControllerInfo struct {
 	Addrs         []string `json:"addrs"`
 	CACert        string   `json:"ca-cert"`
 }
+
+// RemoteRelationDiagnosticsArgs holds the names of the remote applications
+// whose relations are to be diagnosed. If no names are specified, all
+// remote applications in the model are included.
+type RemoteRelationDiagnosticsArgs struct {
+	ApplicationNames []string `json:"application-names,omitempty"`
+}
+
+// RemoteRelationDiagnosticsResults holds the diagnostics for a set of
+// cross model relations.
+type RemoteRelationDiagnosticsResults struct {
+	Results []RemoteRelationDiagnosticsResult `json:"results"`
+}
+
+// RemoteRelationDiagnosticsResult holds the diagnostics for a single
+// cross model relation, or an error.
+type RemoteRelationDiagnosticsResult struct {
+	Result *RemoteRelationDiagnostics `json:"result,omitempty"`
+	Error  *Error                     `json:"error,omitempty"`
+}
+
+// RemoteRelationDiagnostics describes the health of a cross model relation.
+type RemoteRelationDiagnostics struct {
+	// RelationKey is the key of the relation in the local model.
+	RelationKey string `json:"relation-key"`
+
+	// RelationId is the id of the relation in the local model.
+	RelationId int `json:"relation-id"`
+
+	// Status and StatusMessage hold the relation status.
+	Status        string `json:"status"`
+	StatusMessage string `json:"status-message,omitempty"`
+
+	// RemoteApplication is the name of the remote application proxy.
+	RemoteApplication string `json:"remote-application"`
+
+	// OfferUUID and OfferURL identify the offer being related to.
+	OfferUUID string `json:"offer-uuid,omitempty"`
+	OfferURL  string `json:"offer-url,omitempty"`
+
+	// SourceModelTag is the tag of the model hosting the other
+	// side of the relation.
+	SourceModelTag string `json:"source-model-tag"`
+
+	// ConsumerProxy is true if the remote application represents
+	// the consuming side of the relation, ie this is the offering model.
+	ConsumerProxy bool `json:"consumer-proxy"`
+
+	// ApplicationToken and RelationToken are the tokens used to
+	// identify the remote application and the relation between models.
+	ApplicationToken string `json:"application-token,omitempty"`
+	RelationToken    string `json:"relation-token,omitempty"`
+
+	// Controller describes the controller hosting the remote model.
+	// It is not set for the offering side of a relation.
+	Controller *RemoteControllerDiagnostics `json:"controller,omitempty"`
+
+	// Macaroon describes the macaroon used to authorise the relation.
+	// It is not set if no macaroon has been saved.
+	Macaroon *RemoteMacaroonDiagnostics `json:"macaroon,omitempty"`
+
+	// LastSent and LastReceived are the most recent settings changes
+	// exchanged with the remote model.
+	LastSent     *RemoteRelationEventInfo `json:"last-sent,omitempty"`
+	LastReceived *RemoteRelationEventInfo `json:"last-received,omitempty"`
+
+	// PendingSent and PendingReceived are the number of unit changes
+	// not yet delivered in each direction.
+	PendingSent     int `json:"pending-sent"`
+	PendingReceived int `json:"pending-received"`
+
+	// IngressNetworks and EgressNetworks are the networks
+	// required for traffic on the relation.
+	IngressNetworks []string `json:"ingress-networks,omitempty"`
+	EgressNetworks  []string `json:"egress-networks,omitempty"`
+}
+
+// RemoteControllerDiagnostics describes the reachability of the
+// controller hosting the remote side of a cross model relation.
+type RemoteControllerDiagnostics struct {
+	ControllerTag string   `json:"controller-tag,omitempty"`
+	Alias         string   `json:"alias,omitempty"`
+	Addrs         []string `json:"addrs,omitempty"`
+
+	// Local is true if the remote model is hosted
+	// by the same controller.
+	Local bool `json:"local"`
+
+	// Reachable is true if any of the controller's
+	// addresses accepted a connection.
+	Reachable bool   `json:"reachable"`
+	Error     string `json:"error,omitempty"`
+}
+
+// RemoteMacaroonDiagnostics describes the macaroon used to
+// authorise a cross model relation.
+type RemoteMacaroonDiagnostics struct {
+	// Expiry is when the macaroon expires, if it has a time caveat.
+	Expiry *time.Time `json:"expiry,omitempty"`
+
+	// Expired is true if the macaroon has expired.
+	Expired bool `json:"expired"`
+}
+
+// RemoteRelationEventInfo describes a relation settings change
+// exchanged with the remote side of a cross model relation.
+type RemoteRelationEventInfo struct {
+	Time          time.Time `json:"time"`
+	ChangedUnits  int       `json:"changed-units"`
+	DepartedUnits int       `json:"departed-units"`
+	Error         string    `json:"error,omitempty"`
+}
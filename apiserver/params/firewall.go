@@ -21,7 +21,18 @@ type ListFirewallRulesResults struct {
 // FirewallRule is a rule for ingress through a firewall.
 type FirewallRule struct {
 	// KnownService is the well known service for a firewall rule.
-	KnownService KnownServiceValue `json:"known-service"`
+	KnownService KnownServiceValue `json:"known-service,omitempty"`
+
+	// Name is the name of a rule applying to an arbitrary port range,
+	// used instead of KnownService.
+	Name string `json:"name,omitempty"`
+
+	// PortRange is the port range to which a named rule applies.
+	PortRange *PortRange `json:"port-range,omitempty"`
+
+	// Controller is true if the rule applies to all models
+	// hosted by the controller, rather than the current model.
+	Controller bool `json:"controller,omitempty"`
 
 	// WhitelistCIDRS is the ist of subnets allowed access.
 	WhitelistCIDRS []string `json:"whitelist-cidrs,omitempty"`
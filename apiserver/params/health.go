@@ -0,0 +1,47 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package params
+
+import "time"
+
+// HealthCheckResult holds the outcome of one of the health checks
+// declared by a unit's charm.
+type HealthCheckResult struct {
+	Name     string    `json:"name"`
+	Healthy  bool      `json:"healthy"`
+	Failures int       `json:"failures,omitempty"`
+	Message  string    `json:"message,omitempty"`
+	Since    time.Time `json:"since"`
+}
+
+// UnitHealth holds the results of the health checks declared by a
+// unit's charm. Status is one of "unknown", "healthy" or "unhealthy".
+type UnitHealth struct {
+	Status string              `json:"status"`
+	Checks []HealthCheckResult `json:"checks"`
+}
+
+// SetUnitHealthArg holds the health check results to record for the
+// unit with the given tag.
+type SetUnitHealthArg struct {
+	Tag    string              `json:"tag"`
+	Checks []HealthCheckResult `json:"checks"`
+}
+
+// SetUnitHealthArgs holds the arguments for the uniter's
+// SetUnitHealth method.
+type SetUnitHealthArgs struct {
+	Args []SetUnitHealthArg `json:"args"`
+}
+
+// UnitHealthResult holds the health of a unit, or an error.
+type UnitHealthResult struct {
+	Result *UnitHealth `json:"result,omitempty"`
+	Error  *Error      `json:"error,omitempty"`
+}
+
+// UnitHealthResults holds a slice of UnitHealthResult.
+type UnitHealthResults struct {
+	Results []UnitHealthResult `json:"results"`
+}
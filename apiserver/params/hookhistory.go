@@ -0,0 +1,60 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package params
+
+import "time"
+
+// HookHistoryEntry describes an operation completed by a unit's
+// uniter, such as the running of a hook or an action.
+type HookHistoryEntry struct {
+	Operation  string    `json:"operation"`
+	Hook       string    `json:"hook,omitempty"`
+	Relation   string    `json:"relation,omitempty"`
+	RemoteUnit string    `json:"remote-unit,omitempty"`
+	Started    time.Time `json:"started"`
+	Completed  time.Time `json:"completed"`
+	Result     string    `json:"result"`
+	Error      string    `json:"error,omitempty"`
+}
+
+// RecordHookHistoryArg holds an operation completed by the uniter
+// of the unit with the given tag. RelationId is the id of the
+// relation for a relation hook, and -1 otherwise; the relation
+// is recorded by key.
+type RecordHookHistoryArg struct {
+	Tag        string           `json:"tag"`
+	RelationId int              `json:"relation-id"`
+	Entry      HookHistoryEntry `json:"entry"`
+}
+
+// RecordHookHistoryArgs holds the arguments for the uniter's
+// RecordHookHistory method.
+type RecordHookHistoryArgs struct {
+	Args []RecordHookHistoryArg `json:"args"`
+}
+
+// HookHistoryRequest requests the hook history of the unit with the
+// given tag. If Size is positive, at most that many of the most
+// recent entries are returned.
+type HookHistoryRequest struct {
+	Tag  string `json:"tag"`
+	Size int    `json:"size"`
+}
+
+// HookHistoryRequests holds a slice of HookHistoryRequest.
+type HookHistoryRequests struct {
+	Requests []HookHistoryRequest `json:"requests"`
+}
+
+// HookHistoryResult holds the hook history of a unit, newest first,
+// or an error.
+type HookHistoryResult struct {
+	History []HookHistoryEntry `json:"history"`
+	Error   *Error             `json:"error,omitempty"`
+}
+
+// HookHistoryResults holds a slice of HookHistoryResult.
+type HookHistoryResults struct {
+	Results []HookHistoryResult `json:"results"`
+}
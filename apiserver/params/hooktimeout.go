@@ -0,0 +1,18 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package params
+
+import "time"
+
+// HookTimeoutResult holds how long the hooks of a unit may run before
+// they are killed, or an error. A zero timeout means no limit.
+type HookTimeoutResult struct {
+	Timeout time.Duration `json:"timeout"`
+	Error   *Error        `json:"error,omitempty"`
+}
+
+// HookTimeoutResults holds a slice of HookTimeoutResult.
+type HookTimeoutResults struct {
+	Results []HookTimeoutResult `json:"results"`
+}
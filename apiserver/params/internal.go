@@ -294,6 +294,21 @@ type RelationUnitPairs struct {
 	RelationUnitPairs []RelationUnitPair `json:"relation-unit-pairs"`
 }
 
+// RelationApplication holds a relation tag, the tag of a unit in the
+// relation, and the name of an application whose relation settings
+// the unit wants to access.
+type RelationApplication struct {
+	Relation    string `json:"relation"`
+	Unit        string `json:"unit"`
+	Application string `json:"application"`
+}
+
+// RelationApplications holds the parameters for API calls expecting
+// multiple sets of a relation tag, a unit tag and an application name.
+type RelationApplications struct {
+	RelationApplications []RelationApplication `json:"relation-applications"`
+}
+
 // RelationUnitSettings holds a relation tag, a unit tag and local
 // unit settings.
 type RelationUnitSettings struct {
@@ -570,6 +585,10 @@ type RelationUnitsChange struct {
 	// Departed holds a set of units that have previously been reported to
 	// be in scope, but which no longer are.
 	Departed []string `json:"departed,omitempty"`
+
+	// AppChanged holds the latest known settings version of each
+	// application whose settings in the relation have changed.
+	AppChanged map[string]int64 `json:"app-changed,omitempty"`
 }
 
 // RelationUnitsWatchResult holds a RelationUnitsWatcher id, baseline state
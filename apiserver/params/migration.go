@@ -52,6 +52,20 @@ type InitiateMigrationResult struct {
 	MigrationId string `json:"migration-id"`
 }
 
+// MigrationPrecheckResult holds the problems found by the prechecks
+// for a single model migration.
+type MigrationPrecheckResult struct {
+	ModelTag string   `json:"model-tag"`
+	Problems []string `json:"problems,omitempty"`
+	Error    *Error   `json:"error,omitempty"`
+}
+
+// MigrationPrecheckResults is used to return the result of
+// prechecking one or more model migrations.
+type MigrationPrecheckResults struct {
+	Results []MigrationPrecheckResult `json:"results"`
+}
+
 // SetMigrationPhaseArgs provides a migration phase to the
 // migrationmaster.SetPhase API method.
 type SetMigrationPhaseArgs struct {
@@ -73,6 +87,18 @@ type SerializedModel struct {
 	Resources []SerializedModelResource `json:"resources"`
 }
 
+// SerializedModelResult holds the result of exporting a model,
+// or an error.
+type SerializedModelResult struct {
+	Result SerializedModel `json:"result"`
+	Error  *Error          `json:"error,omitempty"`
+}
+
+// SerializedModelResults holds the results of exporting models.
+type SerializedModelResults struct {
+	Results []SerializedModelResult `json:"results"`
+}
+
 // SerializedModelTools holds the version and URI for a given tools
 // version.
 type SerializedModelTools struct {
@@ -134,6 +160,19 @@ type MigrationModelInfo struct {
 	ControllerAgentVersion version.Number `json:"controller-agent-version"`
 }
 
+// MigrationImportCheckArgs holds the details of a model to be checked
+// by the target controller of a migration before it is imported.
+type MigrationImportCheckArgs struct {
+	Info  MigrationModelInfo `json:"info"`
+	Bytes []byte             `json:"bytes"`
+}
+
+// MigrationProblems holds the problems found when checking a model
+// migration.
+type MigrationProblems struct {
+	Problems []string `json:"problems,omitempty"`
+}
+
 // MigrationStatus reports the current status of a model migration.
 type MigrationStatus struct {
 	MigrationId string `json:"migration-id"`
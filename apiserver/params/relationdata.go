@@ -0,0 +1,58 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package params
+
+// RelationDataArg identifies an endpoint of an application or unit
+// whose relation data is requested. Entity is an application or unit
+// tag, and Endpoint the name of one of the application's endpoints.
+type RelationDataArg struct {
+	Entity   string `json:"entity"`
+	Endpoint string `json:"endpoint"`
+}
+
+// RelationDataArgs holds a slice of RelationDataArg.
+type RelationDataArgs struct {
+	Args []RelationDataArg `json:"args"`
+}
+
+// RelationData holds the settings of the units in the scope of a
+// relation. Endpoint is the endpoint that was asked about, and
+// RelatedEndpoint is the endpoint at the other end of the relation,
+// in "application:endpoint" form.
+type RelationData struct {
+	RelationId      int                `json:"relation-id"`
+	Key             string             `json:"key"`
+	Endpoint        string             `json:"endpoint"`
+	RelatedEndpoint string             `json:"related-endpoint"`
+	Units           []RelationUnitData `json:"units"`
+
+	// Applications holds the settings published by the applications
+	// in the relation, if any.
+	Applications []RelationApplicationData `json:"applications,omitempty"`
+}
+
+// RelationUnitData holds the settings of a unit in a relation.
+type RelationUnitData struct {
+	Unit     string                 `json:"unit"`
+	Settings map[string]interface{} `json:"settings"`
+}
+
+// RelationApplicationData holds the settings published by an
+// application in a relation.
+type RelationApplicationData struct {
+	Application string                 `json:"application"`
+	Settings    map[string]interface{} `json:"settings"`
+}
+
+// RelationDataResult holds the data of each relation of an endpoint,
+// or an error.
+type RelationDataResult struct {
+	Relations []RelationData `json:"relations"`
+	Error     *Error         `json:"error,omitempty"`
+}
+
+// RelationDataResults holds a slice of RelationDataResult.
+type RelationDataResults struct {
+	Results []RelationDataResult `json:"results"`
+}
@@ -179,6 +179,14 @@ type UnitStatus struct {
 	Subordinates  map[string]UnitStatus `json:"subordinates"`
 	Leader        bool                  `json:"leader,omitempty"`
 
+	// Health holds the results of the health checks declared by the
+	// unit's charm, if any.
+	Health *UnitHealth `json:"health,omitempty"`
+
+	// SubWorkloads holds the versions and statuses reported by the
+	// unit's charm for its sub-workloads, keyed by name.
+	SubWorkloads map[string]SubWorkloadStatus `json:"sub-workloads,omitempty"`
+
 	// The following are for CAAS models.
 	ProviderId string `json:"provider-id,omitempty"`
 	Address    string `json:"address,omitempty"`
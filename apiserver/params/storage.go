@@ -3,7 +3,11 @@
 
 package params
 
-import "github.com/juju/juju/storage"
+import (
+	"time"
+
+	"github.com/juju/juju/storage"
+)
 
 // MachineBlockDevices holds a machine tag and the block devices present
 // on that machine.
@@ -94,6 +98,9 @@ type StorageAttachment struct {
 	Kind     StorageKind `json:"kind"`
 	Location string      `json:"location"`
 	Life     Life        `json:"life"`
+
+	// Size is the size of the storage in MiB.
+	Size uint64 `json:"size,omitempty"`
 }
 
 // StorageAttachmentId identifies a storage attachment by the tags of the
@@ -216,6 +223,10 @@ type VolumeParams struct {
 	Attributes map[string]interface{}  `json:"attributes,omitempty"`
 	Tags       map[string]string       `json:"tags,omitempty"`
 	Attachment *VolumeAttachmentParams `json:"attachment,omitempty"`
+
+	// SnapshotId is the storage provider's unique ID for the
+	// snapshot from which the volume should be created, if any.
+	SnapshotId string `json:"snapshot-id,omitempty"`
 }
 
 // RemoveVolumeParams holds the parameters for destroying or releasing a
@@ -301,6 +312,108 @@ type RemoveVolumeParamsResults struct {
 	Results []RemoveVolumeParamsResult `json:"results,omitempty"`
 }
 
+// VolumeResizeParams holds the parameters for growing a volume.
+type VolumeResizeParams struct {
+	// VolumeTag is the tag of the volume to resize.
+	VolumeTag string `json:"volume-tag"`
+
+	// VolumeId is the storage provider's unique ID for the volume.
+	VolumeId string `json:"volume-id"`
+
+	// Provider is the storage provider that manages the volume.
+	Provider string `json:"provider"`
+
+	// Attributes holds the configuration of the volume's storage pool.
+	Attributes map[string]interface{} `json:"attributes,omitempty"`
+
+	// Size is the requested size of the volume in MiB.
+	Size uint64 `json:"size"`
+}
+
+// VolumeResizeParamsResult holds parameters for resizing a volume.
+type VolumeResizeParamsResult struct {
+	Result VolumeResizeParams `json:"result"`
+	Error  *Error             `json:"error,omitempty"`
+}
+
+// VolumeResizeParamsResults holds parameters for resizing multiple volumes.
+type VolumeResizeParamsResults struct {
+	Results []VolumeResizeParamsResult `json:"results,omitempty"`
+}
+
+// VolumeSnapshotIds holds the IDs of volume snapshots.
+type VolumeSnapshotIds struct {
+	Ids []string `json:"ids"`
+}
+
+// VolumeSnapshotParams holds the parameters for taking a snapshot
+// of a volume, or for restoring a volume from a snapshot.
+type VolumeSnapshotParams struct {
+	// Id is the unique ID assigned by Juju to the snapshot.
+	Id string `json:"id"`
+
+	// VolumeTag is the tag of the volume.
+	VolumeTag string `json:"volume-tag"`
+
+	// VolumeId is the storage provider's unique ID for the volume.
+	VolumeId string `json:"volume-id"`
+
+	// Provider is the storage provider that manages the volume.
+	Provider string `json:"provider"`
+
+	// SnapshotId is the storage provider's unique ID for the
+	// snapshot. This is only set when Restore is true.
+	SnapshotId string `json:"snapshot-id,omitempty"`
+
+	// Restore indicates that the volume should be restored from
+	// the snapshot, rather than the snapshot taken.
+	Restore bool `json:"restore,omitempty"`
+}
+
+// VolumeSnapshotParamsResult holds parameters for taking or
+// restoring a volume snapshot.
+type VolumeSnapshotParamsResult struct {
+	Result VolumeSnapshotParams `json:"result"`
+	Error  *Error               `json:"error,omitempty"`
+}
+
+// VolumeSnapshotParamsResults holds parameters for taking or
+// restoring multiple volume snapshots.
+type VolumeSnapshotParamsResults struct {
+	Results []VolumeSnapshotParamsResult `json:"results,omitempty"`
+}
+
+// VolumeSnapshot describes a volume snapshot taken by the
+// storage provider.
+type VolumeSnapshot struct {
+	// Id is the unique ID assigned by Juju to the snapshot.
+	Id string `json:"id"`
+
+	// SnapshotId is the storage provider's unique ID for the snapshot.
+	SnapshotId string `json:"snapshot-id"`
+
+	// Size is the size of the snapshotted volume in MiB.
+	Size uint64 `json:"size"`
+}
+
+// VolumeSnapshots describes a set of volume snapshots.
+type VolumeSnapshots struct {
+	Snapshots []VolumeSnapshot `json:"snapshots"`
+}
+
+// VolumeSnapshotMessage holds a message to record against
+// a volume snapshot, e.g. describing why it could not be taken.
+type VolumeSnapshotMessage struct {
+	Id      string `json:"id"`
+	Message string `json:"message"`
+}
+
+// VolumeSnapshotMessages holds messages to record against
+// volume snapshots.
+type VolumeSnapshotMessages struct {
+	Messages []VolumeSnapshotMessage `json:"messages"`
+}
+
 // VolumeAttachmentParamsResults holds provisioning parameters for a volume
 // attachment.
 type VolumeAttachmentParamsResult struct {
@@ -391,6 +504,7 @@ type FilesystemAttachmentParams struct {
 	Provider      string `json:"provider"`
 	MountPoint    string `json:"mount-point,omitempty"`
 	ReadOnly      bool   `json:"read-only,omitempty"`
+	Shared        bool   `json:"shared,omitempty"`
 }
 
 // FilesystemAttachmentResult holds the details of a single filesystem attachment,
@@ -651,6 +765,11 @@ type VolumeAttachmentDetails struct {
 	// Juju controllers older than 2.2 do not populate this
 	// field, so it may be omitted.
 	Life Life `json:"life,omitempty"`
+
+	// Usage contains the most recently reported usage of the
+	// filesystem mounted on the volume's block device on the
+	// machine, if any has been reported.
+	Usage *FilesystemUsage `json:"usage,omitempty"`
 }
 
 // VolumeDetailsResult contains details about a volume, its attachments or
@@ -679,6 +798,65 @@ type VolumeDetailsListResults struct {
 	Results []VolumeDetailsListResult `json:"results,omitempty"`
 }
 
+// VolumeSnapshotFilter holds a filter for listing volume snapshots.
+type VolumeSnapshotFilter struct {
+	// StorageTags are the tags of storage instances to filter on.
+	StorageTags []string `json:"storage-tags,omitempty"`
+}
+
+// VolumeSnapshotFilters holds a set of filters for listing
+// volume snapshots.
+type VolumeSnapshotFilters struct {
+	Filters []VolumeSnapshotFilter `json:"filters,omitempty"`
+}
+
+// VolumeSnapshotDetails describes a volume snapshot in the model
+// for the purpose of storage CLI commands.
+type VolumeSnapshotDetails struct {
+	// Id is the unique ID assigned by Juju to the snapshot.
+	Id string `json:"id"`
+
+	// VolumeTag is the tag of the volume the snapshot was taken of.
+	VolumeTag string `json:"volume-tag"`
+
+	// StorageTag is the tag of the storage instance that the
+	// volume was assigned to, if any.
+	StorageTag string `json:"storage-tag,omitempty"`
+
+	// Pool is the name of the storage pool of the volume.
+	Pool string `json:"pool"`
+
+	// Created is the time at which the snapshot was requested.
+	Created time.Time `json:"created"`
+
+	// SnapshotId is the storage provider's unique ID for the
+	// snapshot. It is empty until the snapshot has been taken.
+	SnapshotId string `json:"snapshot-id,omitempty"`
+
+	// Size is the size of the snapshotted volume in MiB.
+	Size uint64 `json:"size,omitempty"`
+
+	// Restoring indicates that the volume is being
+	// restored from the snapshot.
+	Restoring bool `json:"restoring,omitempty"`
+
+	// Message describes any problem taking or restoring the snapshot.
+	Message string `json:"message,omitempty"`
+}
+
+// VolumeSnapshotDetailsListResult holds a collection of
+// volume snapshot details.
+type VolumeSnapshotDetailsListResult struct {
+	Result []VolumeSnapshotDetails `json:"result,omitempty"`
+	Error  *Error                  `json:"error,omitempty"`
+}
+
+// VolumeSnapshotDetailsListResults holds a collection of collections
+// of volume snapshot details.
+type VolumeSnapshotDetailsListResults struct {
+	Results []VolumeSnapshotDetailsListResult `json:"results,omitempty"`
+}
+
 // FilesystemDetails describes a storage filesystem in the model
 // for the purpose of filesystem CLI commands.
 //
@@ -728,6 +906,100 @@ type FilesystemAttachmentDetails struct {
 	// Juju controllers older than 2.2 do not populate this
 	// field, so it may be omitted.
 	Life Life `json:"life,omitempty"`
+
+	// Usage contains the most recently reported usage of the
+	// filesystem on the machine, if any has been reported.
+	Usage *FilesystemUsage `json:"usage,omitempty"`
+}
+
+// FilesystemUsage describes the space and inode usage of a mounted
+// filesystem.
+type FilesystemUsage struct {
+	// Size is the total size of the filesystem, in bytes.
+	Size uint64 `json:"size"`
+
+	// Used is the number of bytes used in the filesystem.
+	Used uint64 `json:"used"`
+
+	// Available is the number of bytes available to
+	// unprivileged users in the filesystem.
+	Available uint64 `json:"available"`
+
+	// Inodes is the total number of inodes in the filesystem.
+	// Some filesystems do not report inode counts, in which
+	// case the inode fields are zero.
+	Inodes uint64 `json:"inodes,omitempty"`
+
+	// InodesUsed is the number of inodes used in the filesystem.
+	InodesUsed uint64 `json:"inodes-used,omitempty"`
+
+	// InodesFree is the number of inodes free in the filesystem.
+	InodesFree uint64 `json:"inodes-free,omitempty"`
+
+	// Updated is the time at which the usage was measured.
+	Updated time.Time `json:"updated"`
+}
+
+// FilesystemAttachmentUsage holds the usage of a filesystem
+// attached to a machine.
+type FilesystemAttachmentUsage struct {
+	FilesystemTag string          `json:"filesystem-tag"`
+	MachineTag    string          `json:"machine-tag"`
+	Usage         FilesystemUsage `json:"usage"`
+}
+
+// FilesystemAttachmentUsages holds the usage of a set of
+// filesystems attached to machines.
+type FilesystemAttachmentUsages struct {
+	Usages []FilesystemAttachmentUsage `json:"usages"`
+}
+
+// VolumeAttachmentUsage holds the usage of the filesystem mounted
+// on the block device of a volume attached to a machine.
+type VolumeAttachmentUsage struct {
+	VolumeTag  string          `json:"volume-tag"`
+	MachineTag string          `json:"machine-tag"`
+	Usage      FilesystemUsage `json:"usage"`
+}
+
+// VolumeAttachmentUsages holds the usage of a set of
+// volumes attached to machines.
+type VolumeAttachmentUsages struct {
+	Usages []VolumeAttachmentUsage `json:"usages"`
+}
+
+// MountedVolumeAttachment holds the details of a volume attachment
+// whose block device has a filesystem mounted on the machine.
+type MountedVolumeAttachment struct {
+	VolumeTag  string `json:"volume-tag"`
+	MachineTag string `json:"machine-tag"`
+	MountPoint string `json:"mount-point"`
+}
+
+// MountedVolumeAttachmentsResult holds the mounted volume
+// attachments of a machine, or an error.
+type MountedVolumeAttachmentsResult struct {
+	Attachments []MountedVolumeAttachment `json:"attachments,omitempty"`
+	Error       *Error                    `json:"error,omitempty"`
+}
+
+// MountedVolumeAttachmentsResults holds the mounted volume
+// attachments of a set of machines.
+type MountedVolumeAttachmentsResults struct {
+	Results []MountedVolumeAttachmentsResult `json:"results"`
+}
+
+// FilesystemAttachmentsResult holds the filesystem attachments
+// of a machine, or an error.
+type FilesystemAttachmentsResult struct {
+	Attachments []FilesystemAttachment `json:"attachments,omitempty"`
+	Error       *Error                 `json:"error,omitempty"`
+}
+
+// FilesystemAttachmentsResults holds the filesystem attachments
+// of a set of machines.
+type FilesystemAttachmentsResults struct {
+	Results []FilesystemAttachmentsResult `json:"results"`
 }
 
 // FilesystemDetailsResult contains details about a filesystem, its attachments or
@@ -765,6 +1037,10 @@ type StorageConstraints struct {
 
 	// Count is the required number of storage instances.
 	Count *uint64 `json:"count,omitempty"`
+
+	// Snapshot is the ID of the volume snapshot from which to
+	// create the storage instances, if any.
+	Snapshot string `json:"snapshot,omitempty"`
 }
 
 // StorageAddParams holds storage details to add to a unit dynamically.
@@ -805,6 +1081,38 @@ type RemoveStorageInstance struct {
 	DestroyStorage bool `json:"destroy-storage,omitempty"`
 }
 
+// ResizeStorage holds the parameters for resizing storage instances.
+type ResizeStorage struct {
+	Storage []ResizeStorageParams `json:"storage"`
+}
+
+// ResizeStorageParams holds the parameters for resizing a storage instance.
+type ResizeStorageParams struct {
+	// StorageTag is the tag of the storage instance to be resized.
+	StorageTag string `json:"storage-tag"`
+
+	// Size is the new size of the storage instance in MiB. It must
+	// be larger than the current size.
+	Size uint64 `json:"size"`
+}
+
+// RestoreStorage holds the parameters for restoring storage
+// instances from volume snapshots.
+type RestoreStorage struct {
+	Storage []RestoreStorageParams `json:"storage"`
+}
+
+// RestoreStorageParams holds the parameters for restoring a storage
+// instance from a volume snapshot.
+type RestoreStorageParams struct {
+	// StorageTag is the tag of the storage instance to be restored.
+	StorageTag string `json:"storage-tag"`
+
+	// SnapshotId is the ID of the volume snapshot to restore. The
+	// snapshot must have been taken of the storage's volume.
+	SnapshotId string `json:"snapshot-id"`
+}
+
 // BulkImportStorageParams contains the parameters for importing a collection
 // of storage entities.
 type BulkImportStorageParams struct {
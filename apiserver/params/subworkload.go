@@ -0,0 +1,44 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package params
+
+import "time"
+
+// SetSubWorkloadArg holds the version and status to record for the
+// named sub-workload of the unit with the given tag.
+type SetSubWorkloadArg struct {
+	Tag     string `json:"tag"`
+	Name    string `json:"name"`
+	Version string `json:"version,omitempty"`
+	Status  string `json:"status"`
+	Info    string `json:"info,omitempty"`
+}
+
+// SetSubWorkloadArgs holds the arguments for the uniter's
+// SetSubWorkloads method.
+type SetSubWorkloadArgs struct {
+	Args []SetSubWorkloadArg `json:"args"`
+}
+
+// SubWorkloadArg identifies the named sub-workload of the unit with
+// the given tag.
+type SubWorkloadArg struct {
+	Tag  string `json:"tag"`
+	Name string `json:"name"`
+}
+
+// SubWorkloadArgs holds the arguments for the uniter's
+// RemoveSubWorkloads method.
+type SubWorkloadArgs struct {
+	Args []SubWorkloadArg `json:"args"`
+}
+
+// SubWorkloadStatus holds the version and status reported by a unit's
+// charm for one of its sub-workloads.
+type SubWorkloadStatus struct {
+	Version string     `json:"version,omitempty"`
+	Status  string     `json:"status"`
+	Info    string     `json:"info,omitempty"`
+	Since   *time.Time `json:"since,omitempty"`
+}
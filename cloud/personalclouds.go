@@ -17,15 +17,19 @@ import (
 
 // JujuPersonalCloudsPath is the location where personal cloud information is
 // expected to be found. Requires JUJU_HOME to be set.
-func JujuPersonalCloudsPath() string {
-	return osenv.JujuXDGDataHomePath("clouds.yaml")
+func JujuPersonalCloudsPath() (string, error) {
+	return osenv.JujuCloudsDataPath("clouds.yaml")
 }
 
 // PersonalCloudMetadata loads any personal cloud metadata defined
 // in the Juju Home directory. If not cloud metadata is found,
 // that is not an error; nil is returned.
 func PersonalCloudMetadata() (map[string]Cloud, error) {
-	clouds, err := ParseCloudMetadataFile(JujuPersonalCloudsPath())
+	path, err := JujuPersonalCloudsPath()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	clouds, err := ParseCloudMetadataFile(path)
 	if err != nil && os.IsNotExist(err) {
 		return nil, nil
 	}
@@ -53,5 +57,9 @@ func WritePersonalCloudMetadata(cloudsMap map[string]Cloud) error {
 	if err != nil {
 		return errors.Trace(err)
 	}
-	return ioutil.WriteFile(JujuPersonalCloudsPath(), data, os.FileMode(0600))
+	path, err := JujuPersonalCloudsPath()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	return ioutil.WriteFile(path, data, os.FileMode(0600))
 }
@@ -36,6 +36,7 @@ type runCommand struct {
 	paramsYAML   cmd.FileVar
 	parseStrings bool
 	wait         waitFlag
+	timeout      time.Duration
 	out          cmd.Output
 	args         [][]string
 }
@@ -57,6 +58,10 @@ is a boolean true in YAML.
 If --params is passed, along with key.key...=value explicit arguments, the
 explicit arguments will override the parameter file.
 
+If --timeout is given, the action is killed and marked failed if it is still
+running after the given duration. Hook timeouts set in model or application
+config do not apply to actions.
+
 Examples:
 
 $ juju run-action mysql/3 backup --wait
@@ -114,6 +119,11 @@ file:
 $ juju run-action sleeper/0 pause time=1000
 ...
 
+$ juju run-action mysql/3 backup --timeout 30m
+...
+The action will be killed if it has not finished after 30 minutes.
+...
+
 $ juju run-action sleeper/0 pause --string-args time=1000
 ...
 The value for the "time" param will be the string literal "1000".
@@ -126,6 +136,7 @@ func (c *runCommand) SetFlags(f *gnuflag.FlagSet) {
 	f.Var(&c.paramsYAML, "params", "Path to yaml-formatted params file")
 	f.BoolVar(&c.parseStrings, "string-args", false, "Use raw string values of CLI args")
 	f.Var(&c.wait, "wait", "Wait for results, with optional timeout")
+	f.DurationVar(&c.timeout, "timeout", 0, "Kill the action if it runs for longer than this")
 }
 
 func (c *runCommand) Info() *cmd.Info {
@@ -156,6 +167,9 @@ func (c *runCommand) Init(args []string) error {
 	if c.actionName == "" {
 		return errors.New("no action specified")
 	}
+	if c.timeout < 0 {
+		return errors.Errorf("timeout %v cannot be negative", c.timeout)
+	}
 	c.unitTags = make([]names.UnitTag, len(unitNames))
 	for idx, unitName := range unitNames {
 		c.unitTags[idx] = names.NewUnitTag(unitName)
@@ -246,6 +260,7 @@ func (c *runCommand) Run(ctx *cmd.Context) error {
 		actions[i].Receiver = unitTag.String()
 		actions[i].Name = c.actionName
 		actions[i].Parameters = actionParams
+		actions[i].Timeout = c.timeout
 	}
 	results, err := api.Enqueue(params.Actions{Actions: actions})
 	if err != nil {
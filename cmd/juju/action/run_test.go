@@ -7,6 +7,7 @@ import (
 	"bytes"
 	"errors"
 	"strings"
+	"time"
 	"unicode/utf8"
 
 	"github.com/juju/cmd/cmdtesting"
@@ -146,6 +147,10 @@ func (s *RunSuite) TestInit(c *gc.C) {
 		expectUnits:  []names.UnitTag{names.NewUnitTag(validUnitId)},
 		expectAction: "valid-action-name",
 		expectKVArgs: [][]string{{"ok", "this=is=weird="}},
+	}, {
+		should:      "fail with negative timeout",
+		args:        []string{validUnitId, "valid-action-name", "--timeout=-5m"},
+		expectError: "timeout -5m0s cannot be negative",
 	}, {
 		should:       "init properly with no params",
 		args:         []string{validUnitId, "valid-action-name"},
@@ -285,6 +290,18 @@ func (s *RunSuite) TestRun(c *gc.C) {
 			Parameters: map[string]interface{}{},
 			Receiver:   names.NewUnitTag(validUnitId).String(),
 		},
+	}, {
+		should:   "enqueue an action with a timeout",
+		withArgs: []string{validUnitId, "some-action", "--timeout", "30m"},
+		withActionResults: []params.ActionResult{{
+			Action: &params.Action{Tag: validActionTagString},
+		}},
+		expectedActionEnqueued: params.Action{
+			Name:       "some-action",
+			Parameters: map[string]interface{}{},
+			Receiver:   names.NewUnitTag(validUnitId).String(),
+			Timeout:    30 * time.Minute,
+		},
 	}, {
 		should: "enqueue an action with some explicit params",
 		withArgs: []string{validUnitId, "some-action",
@@ -110,6 +110,15 @@ func NewResumeRelationCommandForTest(api SetRelationSuspendedAPI, store jujuclie
 	return modelcmd.Wrap(cmd)
 }
 
+// NewShowRelationDataCommandForTest returns a ShowRelationDataCommand with the api provided as specified.
+func NewShowRelationDataCommandForTest(api RelationDataAPI, store jujuclient.ClientStore) modelcmd.ModelCommand {
+	cmd := &showRelationDataCommand{newAPIFunc: func() (RelationDataAPI, error) {
+		return api, nil
+	}}
+	cmd.SetClientStore(store)
+	return modelcmd.Wrap(cmd)
+}
+
 // NewRemoveSaasCommandForTest returns a RemoveSaasCommand with the api provided as specified.
 func NewRemoveSaasCommandForTest(api RemoveSaasAPI, store jujuclient.ClientStore) modelcmd.ModelCommand {
 	cmd := &removeSaasCommand{newAPIFunc: func() (RemoveSaasAPI, error) {
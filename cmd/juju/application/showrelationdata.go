@@ -0,0 +1,166 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package application
+
+import (
+	"strings"
+
+	"github.com/juju/cmd"
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+	"gopkg.in/juju/names.v2"
+
+	"github.com/juju/juju/api/relationdata"
+	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/cmd/modelcmd"
+)
+
+var showRelationDataHelpSummary = `
+Shows the settings of the units in the relations of an endpoint.`[1:]
+
+var showRelationDataHelpDetails = `
+Shows the settings published by the units on both sides of each relation
+of the specified endpoint, as they would be seen by relation-get. When a
+unit is specified, the settings of the other units of its application are
+omitted. Settings published by the applications themselves, as seen by
+"relation-get --app", are shown under "applications".
+
+Relation settings may include credentials, so only model administrators
+may show them.
+
+Examples:
+    juju show-relation-data wordpress:db
+    juju show-relation-data wordpress/0:db --format json
+
+See also:
+    add-relation
+    remove-relation`
+
+// NewShowRelationDataCommand returns a command that shows the settings
+// of the units in the relations of an endpoint.
+func NewShowRelationDataCommand() cmd.Command {
+	cmd := &showRelationDataCommand{}
+	cmd.newAPIFunc = func() (RelationDataAPI, error) {
+		root, err := cmd.NewAPIRoot()
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		return relationdata.NewClient(root), nil
+	}
+	return modelcmd.Wrap(cmd)
+}
+
+// RelationDataAPI defines the API methods that the show-relation-data
+// command uses.
+type RelationDataAPI interface {
+	Close() error
+	RelationData(entity names.Tag, endpoint string) ([]params.RelationData, error)
+}
+
+type showRelationDataCommand struct {
+	modelcmd.ModelCommandBase
+	out        cmd.Output
+	entity     names.Tag
+	endpoint   string
+	newAPIFunc func() (RelationDataAPI, error)
+}
+
+// Info implements Command.Info.
+func (c *showRelationDataCommand) Info() *cmd.Info {
+	return &cmd.Info{
+		Name:    "show-relation-data",
+		Args:    "<application or unit>:<endpoint>",
+		Purpose: showRelationDataHelpSummary,
+		Doc:     showRelationDataHelpDetails,
+	}
+}
+
+// SetFlags implements Command.SetFlags.
+func (c *showRelationDataCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.ModelCommandBase.SetFlags(f)
+	c.out.AddFlags(f, "yaml", map[string]cmd.Formatter{
+		"yaml": cmd.FormatYaml,
+		"json": cmd.FormatJson,
+	})
+}
+
+// Init implements Command.Init.
+func (c *showRelationDataCommand) Init(args []string) error {
+	switch len(args) {
+	case 0:
+		return errors.New("no endpoint specified")
+	case 1:
+	default:
+		return cmd.CheckEmpty(args[1:])
+	}
+	parts := strings.SplitN(args[0], ":", 2)
+	if len(parts) != 2 || parts[1] == "" {
+		return errors.Errorf("expected <application or unit>:<endpoint>, got %q", args[0])
+	}
+	switch name := parts[0]; {
+	case names.IsValidUnit(name):
+		c.entity = names.NewUnitTag(name)
+	case names.IsValidApplication(name):
+		c.entity = names.NewApplicationTag(name)
+	default:
+		return errors.NotValidf("application or unit name %q", name)
+	}
+	c.endpoint = parts[1]
+	return nil
+}
+
+// Run implements Command.Run.
+func (c *showRelationDataCommand) Run(ctx *cmd.Context) error {
+	client, err := c.newAPIFunc()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	relations, err := client.RelationData(c.entity, c.endpoint)
+	if params.IsCodeNotImplemented(err) {
+		return errors.New("showing relation data is not supported by this version of Juju")
+	} else if err != nil {
+		return errors.Trace(err)
+	}
+	if len(relations) == 0 {
+		ctx.Infof("No relations found for %s:%s.", c.entity.Id(), c.endpoint)
+		return nil
+	}
+	result := make([]relationData, len(relations))
+	for i, rel := range relations {
+		result[i] = formatRelationData(rel)
+	}
+	return c.out.Write(ctx, result)
+}
+
+// relationData is the serialisation of the data of a relation.
+type relationData struct {
+	RelationId      int                               `yaml:"relation-id" json:"relation-id"`
+	Key             string                            `yaml:"key" json:"key"`
+	Endpoint        string                            `yaml:"endpoint" json:"endpoint"`
+	RelatedEndpoint string                            `yaml:"related-endpoint" json:"related-endpoint"`
+	Units           map[string]map[string]interface{} `yaml:"units" json:"units"`
+	Applications    map[string]map[string]interface{} `yaml:"applications,omitempty" json:"applications,omitempty"`
+}
+
+func formatRelationData(rel params.RelationData) relationData {
+	data := relationData{
+		RelationId:      rel.RelationId,
+		Key:             rel.Key,
+		Endpoint:        rel.Endpoint,
+		RelatedEndpoint: rel.RelatedEndpoint,
+		Units:           make(map[string]map[string]interface{}),
+	}
+	for _, unit := range rel.Units {
+		data.Units[unit.Unit] = unit.Settings
+	}
+	if len(rel.Applications) > 0 {
+		data.Applications = make(map[string]map[string]interface{})
+		for _, app := range rel.Applications {
+			data.Applications[app.Application] = app.Settings
+		}
+	}
+	return data
+}
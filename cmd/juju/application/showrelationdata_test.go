@@ -0,0 +1,125 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package application
+
+import (
+	"github.com/juju/cmd"
+	"github.com/juju/cmd/cmdtesting"
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+	"gopkg.in/juju/names.v2"
+
+	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/jujuclient/jujuclienttesting"
+)
+
+type ShowRelationDataSuite struct {
+	testing.IsolationSuite
+
+	mockAPI *mockRelationDataAPI
+}
+
+var _ = gc.Suite(&ShowRelationDataSuite{})
+
+func (s *ShowRelationDataSuite) SetUpTest(c *gc.C) {
+	s.IsolationSuite.SetUpTest(c)
+	s.mockAPI = &mockRelationDataAPI{
+		Stub: &testing.Stub{},
+		relations: []params.RelationData{{
+			RelationId:      1,
+			Key:             "wordpress:db mysql:server",
+			Endpoint:        "wordpress:db",
+			RelatedEndpoint: "mysql:server",
+			Units: []params.RelationUnitData{{
+				Unit:     "mysql/0",
+				Settings: map[string]interface{}{"user": "wordpress", "password": "sekrit"},
+			}, {
+				Unit:     "wordpress/0",
+				Settings: map[string]interface{}{"ingress-address": "10.0.0.1"},
+			}},
+			Applications: []params.RelationApplicationData{{
+				Application: "mysql",
+				Settings:    map[string]interface{}{"database": "wordpress"},
+			}},
+		}},
+	}
+}
+
+func (s *ShowRelationDataSuite) runShowRelationData(c *gc.C, args ...string) (*cmd.Context, error) {
+	store := jujuclienttesting.MinimalStore()
+	return cmdtesting.RunCommand(c, NewShowRelationDataCommandForTest(s.mockAPI, store), args...)
+}
+
+func (s *ShowRelationDataSuite) TestInvalidArgs(c *gc.C) {
+	_, err := s.runShowRelationData(c)
+	c.Assert(err, gc.ErrorMatches, "no endpoint specified")
+	_, err = s.runShowRelationData(c, "wordpress")
+	c.Assert(err, gc.ErrorMatches, `expected <application or unit>:<endpoint>, got "wordpress"`)
+	_, err = s.runShowRelationData(c, "wordpress:")
+	c.Assert(err, gc.ErrorMatches, `expected <application or unit>:<endpoint>, got "wordpress:"`)
+	_, err = s.runShowRelationData(c, "Word_Press:db")
+	c.Assert(err, gc.ErrorMatches, `application or unit name "Word_Press" not valid`)
+	_, err = s.runShowRelationData(c, "wordpress:db", "mysql:server")
+	c.Assert(err, gc.ErrorMatches, `unrecognized args: \["mysql:server"\]`)
+}
+
+func (s *ShowRelationDataSuite) TestShowRelationData(c *gc.C) {
+	ctx, err := s.runShowRelationData(c, "wordpress:db")
+	c.Assert(err, jc.ErrorIsNil)
+	s.mockAPI.CheckCall(c, 0, "RelationData", names.NewApplicationTag("wordpress"), "db")
+	s.mockAPI.CheckCall(c, 1, "Close")
+	c.Assert(cmdtesting.Stdout(ctx), gc.Equals, `
+- relation-id: 1
+  key: wordpress:db mysql:server
+  endpoint: wordpress:db
+  related-endpoint: mysql:server
+  units:
+    mysql/0:
+      password: sekrit
+      user: wordpress
+    wordpress/0:
+      ingress-address: 10.0.0.1
+  applications:
+    mysql:
+      database: wordpress
+`[1:])
+}
+
+func (s *ShowRelationDataSuite) TestShowRelationDataUnitJSON(c *gc.C) {
+	ctx, err := s.runShowRelationData(c, "wordpress/0:db", "--format", "json")
+	c.Assert(err, jc.ErrorIsNil)
+	s.mockAPI.CheckCall(c, 0, "RelationData", names.NewUnitTag("wordpress/0"), "db")
+	c.Assert(cmdtesting.Stdout(ctx), gc.Equals, `[{"relation-id":1,"key":"wordpress:db mysql:server","endpoint":"wordpress:db","related-endpoint":"mysql:server","units":{"mysql/0":{"password":"sekrit","user":"wordpress"},"wordpress/0":{"ingress-address":"10.0.0.1"}},"applications":{"mysql":{"database":"wordpress"}}}]`+"\n")
+}
+
+func (s *ShowRelationDataSuite) TestShowRelationDataNone(c *gc.C) {
+	s.mockAPI.relations = nil
+	ctx, err := s.runShowRelationData(c, "wordpress:cache")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(cmdtesting.Stdout(ctx), gc.Equals, "")
+	c.Assert(cmdtesting.Stderr(ctx), gc.Equals, "No relations found for wordpress:cache.\n")
+}
+
+func (s *ShowRelationDataSuite) TestShowRelationDataOldServer(c *gc.C) {
+	s.mockAPI.SetErrors(&params.Error{Code: params.CodeNotImplemented})
+	_, err := s.runShowRelationData(c, "wordpress:db")
+	c.Assert(err, gc.ErrorMatches, "showing relation data is not supported by this version of Juju")
+	s.mockAPI.CheckCall(c, 1, "Close")
+}
+
+type mockRelationDataAPI struct {
+	*testing.Stub
+	relations []params.RelationData
+}
+
+func (m *mockRelationDataAPI) Close() error {
+	m.MethodCall(m, "Close")
+	return m.NextErr()
+}
+
+func (m *mockRelationDataAPI) RelationData(entity names.Tag, endpoint string) ([]params.RelationData, error) {
+	m.MethodCall(m, "RelationData", entity, endpoint)
+	return m.relations, m.NextErr()
+}
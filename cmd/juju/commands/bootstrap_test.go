@@ -1937,8 +1937,9 @@ func createToolsSource(c *gc.C, versions []version.Binary) string {
 
 // resetJujuXDGDataHome restores an new, clean Juju home environment without tools.
 func resetJujuXDGDataHome(c *gc.C) {
-	cloudsPath := cloud.JujuPersonalCloudsPath()
-	err := ioutil.WriteFile(cloudsPath, []byte(`
+	cloudsPath, err := cloud.JujuPersonalCloudsPath()
+	c.Assert(err, jc.ErrorIsNil)
+	err = ioutil.WriteFile(cloudsPath, []byte(`
 clouds:
     dummy-cloud:
         type: dummy
@@ -0,0 +1,71 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package commands
+
+import (
+	"github.com/juju/cmd"
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+
+	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/network/ssh"
+)
+
+func newDebugCodeCommand(hostChecker ssh.ReachableChecker) cmd.Command {
+	c := new(debugCodeCommand)
+	c.getActionAPI = c.newActionsAPI
+	c.setHostChecker(hostChecker)
+	return modelcmd.Wrap(c)
+}
+
+// debugCodeCommand is responsible for launching a ssh shell on a given
+// unit, in which matching hooks are run with JUJU_DEBUG_AT set.
+type debugCodeCommand struct {
+	debugHooksCommand
+}
+
+const debugCodeDoc = `
+Interactively debug hooks or actions remotely on an application unit.
+
+Unlike debug-hooks, matching hooks and actions are run as they would
+be normally, but with $JUJU_DEBUG_AT set to the value of --at. Charms
+can use this to stop at their own breakpoints, for example by starting
+pdb, in the tmux session. Exiting the session allows the unit to
+continue processing events as usual; running debug-code again will
+reattach to a session that is still in progress.
+
+See the "juju help ssh" for information about SSH related options
+accepted by the debug-code command.
+
+Examples:
+
+Run all hooks and actions on mysql/0, with JUJU_DEBUG_AT=all:
+
+    juju debug-code mysql/0
+
+Run the install and start hooks, with JUJU_DEBUG_AT=hook:
+
+    juju debug-code --at=hook mysql/0 install start
+`
+
+func (c *debugCodeCommand) Info() *cmd.Info {
+	return &cmd.Info{
+		Name:    "debug-code",
+		Args:    "<unit name> [hook or action names]",
+		Purpose: "Launch a tmux session to debug hooks and/or actions, with charm breakpoints enabled.",
+		Doc:     debugCodeDoc,
+	}
+}
+
+func (c *debugCodeCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.debugHooksCommand.SetFlags(f)
+	f.StringVar(&c.debugAt, "at", "all", "value to set for JUJU_DEBUG_AT")
+}
+
+func (c *debugCodeCommand) Init(args []string) error {
+	if c.debugAt == "" {
+		return errors.Errorf("--at must not be empty")
+	}
+	return c.debugHooksCommand.Init(args)
+}
@@ -0,0 +1,86 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package commands
+
+import (
+	"encoding/base64"
+	"regexp"
+	"runtime"
+
+	"github.com/juju/cmd/cmdtesting"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	unitdebug "github.com/juju/juju/worker/uniter/runner/debug"
+)
+
+var _ = gc.Suite(&DebugCodeSuite{})
+
+type DebugCodeSuite struct {
+	SSHCommonSuite
+}
+
+var debugCodeTests = []struct {
+	info    string
+	args    []string
+	hooks   []string
+	debugAt string
+	error   string
+}{{
+	info:    "all hooks, debug at all",
+	args:    []string{"mysql/0"},
+	debugAt: "all",
+}, {
+	info:    "named hooks",
+	args:    []string{"mysql/0", "start", "stop"},
+	hooks:   []string{"start", "stop"},
+	debugAt: "all",
+}, {
+	info:    "debug at hook",
+	args:    []string{"--at=hook", "mysql/0", "install"},
+	hooks:   []string{"install"},
+	debugAt: "hook",
+}, {
+	info:  "empty debug at",
+	args:  []string{"--at=", "mysql/0"},
+	error: "--at must not be empty",
+}, {
+	info:  "invalid unit syntax",
+	args:  []string{"mysql"},
+	error: `"mysql" is not a valid unit name`,
+}, {
+	info:  "invalid hook",
+	args:  []string{"mysql/0", "invalid-hook"},
+	error: `unit "mysql/0" contains neither hook nor action "invalid-hook", .*`,
+}}
+
+var debugCodeScriptRE = regexp.MustCompile(`echo (\S+) \| base64 -d > \$F`)
+
+func (s *DebugCodeSuite) TestDebugCodeCommand(c *gc.C) {
+	if runtime.GOOS == "windows" {
+		c.Skip("debug-code is not supported on windows")
+	}
+
+	s.setupModel(c)
+	s.setHostChecker(validAddresses("0.private", "0.public"))
+	s.setForceAPIv1(true)
+
+	for i, t := range debugCodeTests {
+		c.Logf("test %d: %s\n\t%s\n", i, t.info, t.args)
+
+		ctx, err := cmdtesting.RunCommand(c, newDebugCodeCommand(s.hostChecker), t.args...)
+		if t.error != "" {
+			c.Check(err, gc.ErrorMatches, t.error)
+			continue
+		}
+		c.Assert(err, jc.ErrorIsNil)
+
+		matches := debugCodeScriptRE.FindStringSubmatch(cmdtesting.Stdout(ctx))
+		c.Assert(matches, gc.HasLen, 2)
+		script, err := base64.StdEncoding.DecodeString(matches[1])
+		c.Assert(err, jc.ErrorIsNil)
+		expected := unitdebug.ClientScript(unitdebug.NewHooksContext("mysql/0"), t.hooks, t.debugAt)
+		c.Check(string(script), gc.Equals, expected)
+	}
+}
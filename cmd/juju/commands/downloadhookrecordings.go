@@ -0,0 +1,121 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package commands
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/juju/cmd"
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+	"gopkg.in/juju/names.v2"
+
+	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/network/ssh"
+	"github.com/juju/juju/worker/uniter/runner/record"
+)
+
+func newDownloadHookRecordingsCommand(hostChecker ssh.ReachableChecker) cmd.Command {
+	c := new(downloadHookRecordingsCommand)
+	c.setHostChecker(hostChecker)
+	return modelcmd.Wrap(c)
+}
+
+// downloadHookRecordingsCommand fetches the hook recordings kept by
+// a unit agent, as a gzipped tar archive, over SSH.
+type downloadHookRecordingsCommand struct {
+	sshCommand
+	output string
+}
+
+const downloadHookRecordingsDoc = `
+Download the recordings of the most recent hook executions of a unit.
+
+Hooks are only recorded while the model's "hook-recording" config is
+true. The recordings are written to a gzipped tar archive, named after
+the unit unless --output is specified. Each recording holds the hook's
+environment, the charm config, leader and relation data available to
+it, and every hook tool call it made, along with the response.
+
+A recorded hook may be replayed locally against its recorded hook tool
+responses with the juju-replay-hook plugin.
+
+Recordings may contain secrets, such as passwords in charm config or
+relation data, and should be handled accordingly.
+
+See the "juju help ssh" for information about SSH related options
+accepted by the download-hook-recordings command.
+
+Examples:
+
+    juju model-config hook-recording=true
+    juju download-hook-recordings mysql/0
+    juju download-hook-recordings mysql/0 --output /tmp/mysql.tar.gz
+`
+
+func (c *downloadHookRecordingsCommand) Info() *cmd.Info {
+	return &cmd.Info{
+		Name:    "download-hook-recordings",
+		Args:    "<unit name>",
+		Purpose: "Download the hook recordings of a unit.",
+		Doc:     downloadHookRecordingsDoc,
+	}
+}
+
+func (c *downloadHookRecordingsCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.sshCommand.SetFlags(f)
+	f.StringVar(&c.output, "output", "", "The file to write the recordings to")
+}
+
+func (c *downloadHookRecordingsCommand) Init(args []string) error {
+	if len(args) == 0 {
+		return errors.Errorf("no unit name specified")
+	}
+	target, args := args[0], args[1:]
+	if !names.IsValidUnit(target) {
+		return errors.Errorf("%q is not a valid unit name", target)
+	}
+	if err := cmd.CheckEmpty(args); err != nil {
+		return errors.Trace(err)
+	}
+	c.Target = target
+	if c.output == "" {
+		c.output = strings.Replace(target, "/", "-", -1) + "-hook-recordings.tar.gz"
+	}
+	// The archive is binary, so there must be no pseudo-terminal
+	// between it and the output file.
+	pty := false
+	c.pty.b = &pty
+	return nil
+}
+
+// Run resolves c.Target to a machine, and connects to it via SSH to
+// archive the unit's recordings and write them to the output file.
+func (c *downloadHookRecordingsCommand) Run(ctx *cmd.Context) error {
+	err := c.initRun()
+	if err != nil {
+		return err
+	}
+	defer c.cleanupRun()
+
+	dir := path.Join("/var/lib/juju/agents", names.NewUnitTag(c.Target).String(), record.DirName)
+	c.Args = []string{fmt.Sprintf("sudo tar -C %s -czf - .", dir)}
+
+	filename := ctx.AbsPath(c.output)
+	f, err := os.Create(filename)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer f.Close()
+	sshCtx := *ctx
+	sshCtx.Stdout = f
+	if err := c.sshCommand.Run(&sshCtx); err != nil {
+		return errors.Annotatef(err, "downloading recordings of %s", c.Target)
+	}
+	ctx.Infof("Hook recordings of %s written to %s", c.Target, filename)
+	return nil
+}
@@ -0,0 +1,92 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package commands
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"regexp"
+	"runtime"
+
+	"github.com/juju/cmd/cmdtesting"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	jujussh "github.com/juju/juju/network/ssh"
+)
+
+var _ = gc.Suite(&DownloadHookRecordingsSuite{})
+
+type DownloadHookRecordingsSuite struct {
+	SSHCommonSuite
+}
+
+var downloadHookRecordingsTests = []struct {
+	info        string
+	args        []string
+	hostChecker jujussh.ReachableChecker
+	forceAPIv1  bool
+	output      string
+	error       string
+	expected    *argsSpec
+}{{
+	info:        "default output (api v1)",
+	args:        []string{"mysql/0"},
+	hostChecker: validAddresses("0.private", "0.public"),
+	forceAPIv1:  true,
+	output:      "mysql-0-hook-recordings.tar.gz",
+	expected: &argsSpec{
+		hostKeyChecking: "yes",
+		knownHosts:      "0",
+		args:            "ubuntu@0.public sudo tar -C /var/lib/juju/agents/unit-mysql-0/hook-recordings -czf - .",
+	},
+}, {
+	info:        "output file (api v2)",
+	args:        []string{"mysql/0", "--output", "recordings.tar.gz"},
+	hostChecker: validAddresses("0.private", "0.public", "0.1.2.3"),
+	output:      "recordings.tar.gz",
+	expected: &argsSpec{
+		hostKeyChecking: "yes",
+		knownHosts:      "0",
+		argsMatch:       `ubuntu@0\.(private|public|1\.2\.3) sudo tar -C /var/lib/juju/agents/unit-mysql-0/hook-recordings -czf - \.`,
+	},
+}, {
+	info:  "no args",
+	error: "no unit name specified",
+}, {
+	info:  "machine target",
+	args:  []string{"0"},
+	error: `"0" is not a valid unit name`,
+}, {
+	info:  "extra args",
+	args:  []string{"mysql/0", "extra"},
+	error: `unrecognized args: ["extra"]`,
+}}
+
+func (s *DownloadHookRecordingsSuite) TestDownloadHookRecordingsCommand(c *gc.C) {
+	if runtime.GOOS == "windows" {
+		c.Skip("download-hook-recordings is not supported on windows")
+	}
+
+	s.setupModel(c)
+
+	for i, t := range downloadHookRecordingsTests {
+		c.Logf("test %d: %s\n\t%s\n", i, t.info, t.args)
+
+		s.setHostChecker(t.hostChecker)
+		s.setForceAPIv1(t.forceAPIv1)
+
+		ctx, err := cmdtesting.RunCommand(c, newDownloadHookRecordingsCommand(s.hostChecker), t.args...)
+		if t.error != "" {
+			c.Check(err, gc.ErrorMatches, regexp.QuoteMeta(t.error))
+			continue
+		}
+		c.Check(err, jc.ErrorIsNil)
+		c.Check(cmdtesting.Stdout(ctx), gc.Equals, "")
+		// The fake ssh writes its command line to the output file.
+		data, err := ioutil.ReadFile(filepath.Join(ctx.Dir, t.output))
+		c.Assert(err, jc.ErrorIsNil)
+		t.expected.check(c, string(data))
+	}
+}
@@ -0,0 +1,181 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package commands
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+
+	"github.com/juju/cmd"
+	"github.com/juju/errors"
+	"gopkg.in/juju/names.v2"
+
+	"github.com/juju/juju/api"
+	"github.com/juju/juju/api/base"
+	"github.com/juju/juju/cmd/modelcmd"
+	coremigration "github.com/juju/juju/core/migration"
+	"github.com/juju/juju/migration"
+)
+
+func newExportModelCommand() modelcmd.ModelCommand {
+	return modelcmd.Wrap(&exportModelCommand{})
+}
+
+// exportModelCommand writes a model, and the binaries it uses, to a
+// file which may be imported into another controller.
+type exportModelCommand struct {
+	modelcmd.ModelCommandBase
+	api        exportModelAPI
+	downloader modelDownloader
+	filename   string
+}
+
+type exportModelAPI interface {
+	ExportModel(names.ModelTag) (coremigration.SerializedModel, error)
+	Close() error
+}
+
+// modelDownloader downloads the binaries used by a model.
+type modelDownloader interface {
+	migration.CharmDownloader
+	migration.ToolsDownloader
+	migration.ResourceDownloader
+	Close() error
+}
+
+const exportModelDoc = `
+export-model writes the model, along with the charms, agent binaries
+and resources it uses, to a file. The file may be imported into
+another controller with "juju import-model", without the two
+controllers needing to communicate. This is useful when the
+controllers are in networks which cannot reach each other.
+
+Once exported, the model is marked as being migrated, so that it can
+no longer be changed on its current controller. Its agents keep
+running, but are not redirected to the controller into which it is
+imported; they must be reconfigured to connect to that controller,
+after which the model should be removed from this one.
+
+Exporting a model requires admin access to the model.
+
+Examples:
+
+    juju export-model -m mymodel mymodel.zip
+
+See also:
+    import-model
+    migrate
+`
+
+// Info implements cmd.Command.
+func (c *exportModelCommand) Info() *cmd.Info {
+	return &cmd.Info{
+		Name:    "export-model",
+		Args:    "<filename>",
+		Purpose: "Export a model to a file.",
+		Doc:     exportModelDoc,
+	}
+}
+
+// Init implements cmd.Command.
+func (c *exportModelCommand) Init(args []string) error {
+	if len(args) < 1 {
+		return errors.New("filename not specified")
+	}
+	c.filename = args[0]
+	return cmd.CheckEmpty(args[1:])
+}
+
+// Run implements cmd.Command.
+func (c *exportModelCommand) Run(ctx *cmd.Context) (err error) {
+	modelName, details, err := c.ModelDetails()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	client, err := c.getAPI()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer client.Close()
+	downloader, err := c.getDownloader()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer downloader.Close()
+
+	ctx.Infof("Exporting model %q", modelName)
+	serialized, err := client.ExportModel(names.NewModelTag(details.ModelUUID))
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	filename := ctx.AbsPath(c.filename)
+	f, err := os.OpenFile(filename, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer func() {
+		if closeErr := f.Close(); err == nil {
+			err = errors.Trace(closeErr)
+		}
+		if err != nil {
+			os.Remove(filename)
+		}
+	}()
+	err = migration.WriteArchive(f, migration.WriteArchiveConfig{
+		Model:              serialized,
+		CharmDownloader:    downloader,
+		ToolsDownloader:    downloader,
+		ResourceDownloader: downloader,
+	})
+	if err != nil {
+		return errors.Trace(err)
+	}
+	ctx.Infof("Model %q exported to %s", modelName, c.filename)
+	return nil
+}
+
+func (c *exportModelCommand) getAPI() (exportModelAPI, error) {
+	if c.api != nil {
+		return c.api, nil
+	}
+	return c.NewModelManagerAPIClient()
+}
+
+func (c *exportModelCommand) getDownloader() (modelDownloader, error) {
+	if c.downloader != nil {
+		return c.downloader, nil
+	}
+	root, err := c.NewAPIRoot()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return apiDownloader{
+		Client: root.Client(),
+		caller: root,
+	}, nil
+}
+
+// apiDownloader downloads the binaries used by the model of an API
+// connection.
+type apiDownloader struct {
+	*api.Client
+	caller base.APICaller
+}
+
+// OpenResource is part of the migration.ResourceDownloader interface.
+func (d apiDownloader) OpenResource(application, name string) (io.ReadCloser, error) {
+	httpClient, err := d.caller.HTTPClient()
+	if err != nil {
+		return nil, errors.Annotate(err, "unable to create HTTP client")
+	}
+	uri := fmt.Sprintf("/applications/%s/resources/%s", application, name)
+	var resp *http.Response
+	if err := httpClient.Get(uri, &resp); err != nil {
+		return nil, errors.Annotate(err, "unable to retrieve resource")
+	}
+	return resp.Body, nil
+}
@@ -0,0 +1,141 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package commands
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"net/url"
+	"path/filepath"
+
+	"github.com/juju/cmd/cmdtesting"
+	"github.com/juju/errors"
+	jujutesting "github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+	"gopkg.in/juju/charm.v6"
+	"gopkg.in/juju/names.v2"
+
+	"github.com/juju/juju/cmd/modelcmd"
+	coremigration "github.com/juju/juju/core/migration"
+	"github.com/juju/juju/jujuclient/jujuclienttesting"
+	"github.com/juju/juju/migration"
+	"github.com/juju/juju/testing"
+)
+
+type ExportModelSuite struct {
+	testing.FakeJujuXDGDataHomeSuite
+	api        *fakeExportModelAPI
+	downloader *fakeModelDownloader
+}
+
+var _ = gc.Suite(&ExportModelSuite{})
+
+func (s *ExportModelSuite) SetUpTest(c *gc.C) {
+	s.FakeJujuXDGDataHomeSuite.SetUpTest(c)
+	s.api = &fakeExportModelAPI{
+		model: coremigration.SerializedModel{
+			Bytes:  []byte("model"),
+			Charms: []string{"cs:trusty/mysql-1"},
+		},
+	}
+	s.downloader = &fakeModelDownloader{}
+}
+
+func (s *ExportModelSuite) makeCommand() modelcmd.ModelCommand {
+	store := jujuclienttesting.MinimalStore()
+	details := store.Models["arthur"].Models["king/sword"]
+	details.ModelUUID = modelUUID
+	store.Models["arthur"].Models["king/sword"] = details
+	cmd := newExportModelCommand()
+	cmd.SetClientStore(store)
+	inner := modelcmd.InnerCommand(cmd).(*exportModelCommand)
+	inner.api = s.api
+	inner.downloader = s.downloader
+	return cmd
+}
+
+func (s *ExportModelSuite) TestNoFilename(c *gc.C) {
+	_, err := cmdtesting.RunCommand(c, s.makeCommand())
+	c.Assert(err, gc.ErrorMatches, "filename not specified")
+}
+
+func (s *ExportModelSuite) TestExport(c *gc.C) {
+	filename := filepath.Join(c.MkDir(), "model.zip")
+	ctx, err := cmdtesting.RunCommand(c, s.makeCommand(), filename)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(cmdtesting.Stderr(ctx), gc.Equals, ""+
+		"Exporting model \"king/sword\"\n"+
+		"Model \"king/sword\" exported to "+filename+"\n")
+	s.api.CheckCalls(c, []jujutesting.StubCall{
+		{"ExportModel", []interface{}{names.NewModelTag(modelUUID)}},
+		{"Close", nil},
+	})
+	c.Check(s.downloader.charms, jc.DeepEquals, []string{"cs:trusty/mysql-1"})
+	c.Check(s.downloader.closed, jc.IsTrue)
+
+	archive, err := migration.OpenArchive(filename)
+	c.Assert(err, jc.ErrorIsNil)
+	defer archive.Close()
+	c.Check(string(archive.Model.Bytes), gc.Equals, "model")
+	c.Check(archive.Model.Charms, jc.DeepEquals, []string{"cs:trusty/mysql-1"})
+}
+
+func (s *ExportModelSuite) TestExportError(c *gc.C) {
+	s.api.SetErrors(errors.New("boom"))
+	filename := filepath.Join(c.MkDir(), "model.zip")
+	_, err := cmdtesting.RunCommand(c, s.makeCommand(), filename)
+	c.Assert(err, gc.ErrorMatches, "boom")
+	c.Assert(filename, jc.DoesNotExist)
+}
+
+func (s *ExportModelSuite) TestFileExists(c *gc.C) {
+	filename := filepath.Join(c.MkDir(), "model.zip")
+	err := ioutil.WriteFile(filename, []byte("precious"), 0600)
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = cmdtesting.RunCommand(c, s.makeCommand(), filename)
+	c.Assert(err, gc.ErrorMatches, ".*file exists")
+	data, err := ioutil.ReadFile(filename)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(string(data), gc.Equals, "precious")
+}
+
+type fakeExportModelAPI struct {
+	jujutesting.Stub
+	model coremigration.SerializedModel
+}
+
+func (a *fakeExportModelAPI) ExportModel(tag names.ModelTag) (coremigration.SerializedModel, error) {
+	a.MethodCall(a, "ExportModel", tag)
+	return a.model, a.NextErr()
+}
+
+func (a *fakeExportModelAPI) Close() error {
+	a.MethodCall(a, "Close")
+	return a.NextErr()
+}
+
+type fakeModelDownloader struct {
+	charms []string
+	closed bool
+}
+
+func (d *fakeModelDownloader) OpenCharm(curl *charm.URL) (io.ReadCloser, error) {
+	d.charms = append(d.charms, curl.String())
+	return ioutil.NopCloser(bytes.NewReader([]byte(curl.String()))), nil
+}
+
+func (d *fakeModelDownloader) OpenURI(uri string, _ url.Values) (io.ReadCloser, error) {
+	return ioutil.NopCloser(bytes.NewReader([]byte(uri))), nil
+}
+
+func (d *fakeModelDownloader) OpenResource(application, name string) (io.ReadCloser, error) {
+	return ioutil.NopCloser(bytes.NewReader([]byte(name))), nil
+}
+
+func (d *fakeModelDownloader) Close() error {
+	d.closed = true
+	return nil
+}
@@ -58,6 +58,10 @@ Currently available charm hook tools are:
     storage-add              add storage instances
     storage-get              print information for storage instance with specified id
     storage-list             list storage attached to the unit
+    sub-workload-set         set the status of a sub-workload
+    sub-workload-unset       stop reporting a sub-workload
+    timer-set                run a timer hook at regular intervals
+    timer-unset              stop running a timer hook
     unit-get                 print public-address or private-address
 
 Examples:
@@ -102,6 +106,10 @@ var expectedCommands = []string{
 	"storage-add",
 	"storage-get",
 	"storage-list",
+	"sub-workload-set",
+	"sub-workload-unset",
+	"timer-set",
+	"timer-unset",
 	"unit-get",
 }
 
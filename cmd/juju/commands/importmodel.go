@@ -0,0 +1,247 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package commands
+
+import (
+	"io"
+
+	"github.com/juju/cmd"
+	"github.com/juju/description"
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+	"github.com/juju/version"
+	"gopkg.in/juju/charm.v6"
+
+	"github.com/juju/juju/api/migrationtarget"
+	"github.com/juju/juju/cmd/modelcmd"
+	coremigration "github.com/juju/juju/core/migration"
+	"github.com/juju/juju/migration"
+	"github.com/juju/juju/resource"
+	"github.com/juju/juju/tools"
+)
+
+func newImportModelCommand() modelcmd.ControllerCommand {
+	return modelcmd.WrapController(&importModelCommand{})
+}
+
+// importModelCommand imports a model from a file written by
+// export-model.
+type importModelCommand struct {
+	modelcmd.ControllerCommandBase
+	api      importModelAPI
+	filename string
+	activate bool
+}
+
+type importModelAPI interface {
+	Prechecks(coremigration.ModelInfo) error
+	Import([]byte) error
+	UploadCharm(string, *charm.URL, io.ReadSeeker) (*charm.URL, error)
+	UploadTools(string, io.ReadSeeker, version.Binary, ...string) (tools.List, error)
+	UploadResource(string, resource.Resource, io.ReadSeeker) error
+	SetPlaceholderResource(string, resource.Resource) error
+	SetUnitResource(string, string, resource.Resource) error
+	AdoptResources(string) error
+	Activate(string) error
+	Abort(string) error
+	Close() error
+}
+
+const importModelDoc = `
+import-model creates a model on the controller from a file written by
+"juju export-model", uploading the charms, agent binaries and
+resources held in the file.
+
+The model's machine and unit agents are still configured to connect
+to the controller from which the model was exported, so the imported
+model is left inactive: it cannot be used until it is activated. Once
+the agents have been reconfigured to connect to this controller, run
+import-model again with the same file and --activate to take over the
+model's cloud resources and make it available for use. The model
+should then be removed from the original controller.
+
+Importing a model requires superuser access to the controller.
+
+Examples:
+
+    juju import-model mymodel.zip
+    juju import-model -c othercontroller mymodel.zip
+    juju import-model --activate mymodel.zip
+
+See also:
+    export-model
+    migrate
+`
+
+// Info implements cmd.Command.
+func (c *importModelCommand) Info() *cmd.Info {
+	return &cmd.Info{
+		Name:    "import-model",
+		Args:    "<filename>",
+		Purpose: "Import a model from a file.",
+		Doc:     importModelDoc,
+	}
+}
+
+// SetFlags implements cmd.Command.
+func (c *importModelCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.ControllerCommandBase.SetFlags(f)
+	f.BoolVar(&c.activate, "activate", false, "Activate a previously imported model once its agents have been redirected")
+}
+
+// Init implements cmd.Command.
+func (c *importModelCommand) Init(args []string) error {
+	if len(args) < 1 {
+		return errors.New("filename not specified")
+	}
+	c.filename = args[0]
+	return cmd.CheckEmpty(args[1:])
+}
+
+// Run implements cmd.Command.
+func (c *importModelCommand) Run(ctx *cmd.Context) (err error) {
+	archive, err := migration.OpenArchive(ctx.AbsPath(c.filename))
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer archive.Close()
+	info, err := archiveModelInfo(archive.Model.Bytes)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	client, err := c.getAPI()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer client.Close()
+
+	if c.activate {
+		if err := client.AdoptResources(info.UUID); err != nil {
+			return errors.Annotate(err, "failed to adopt cloud resources")
+		}
+		if err := client.Activate(info.UUID); err != nil {
+			return errors.Annotate(err, "failed to activate model")
+		}
+		ctx.Infof("Model %q activated", info.Name)
+		return nil
+	}
+
+	if err := client.Prechecks(info); err != nil {
+		return errors.Annotate(err, "prechecks failed")
+	}
+	ctx.Infof("Importing model %q", info.Name)
+	if err := client.Import(archive.Model.Bytes); err != nil {
+		return errors.Annotate(err, "model import failed")
+	}
+	defer func() {
+		if err != nil {
+			if abortErr := client.Abort(info.UUID); abortErr != nil {
+				logger.Errorf("cannot abort import of model %q: %v", info.Name, abortErr)
+			}
+		}
+	}()
+
+	uploader := &importUploader{client: client, modelUUID: info.UUID}
+	err = migration.UploadBinaries(migration.UploadBinariesConfig{
+		Charms:          archive.Model.Charms,
+		CharmDownloader: archive,
+		CharmUploader:   uploader,
+
+		Tools:           archive.Model.Tools,
+		ToolsDownloader: archive,
+		ToolsUploader:   uploader,
+
+		Resources:          archive.Model.Resources,
+		ResourceDownloader: archive,
+		ResourceUploader:   uploader,
+	})
+	if err != nil {
+		return errors.Annotate(err, "failed to upload binaries")
+	}
+	// The model's agents still report to the controller it was
+	// exported from, so it is left inactive until they have been
+	// redirected to this one.
+	ctx.Infof("Model %q imported but not activated", info.Name)
+	ctx.Infof("Once its agents have been redirected to this controller, activate it with:\n  juju import-model --activate %s", c.filename)
+	return nil
+}
+
+// archiveModelInfo returns the details of the serialized model
+// needed for the target prechecks.
+func archiveModelInfo(bytes []byte) (coremigration.ModelInfo, error) {
+	model, err := description.Deserialize(bytes)
+	if err != nil {
+		return coremigration.ModelInfo{}, errors.Annotate(err, "cannot read model")
+	}
+	name, _ := model.Config()["name"].(string)
+	agentVersion, _ := model.Config()["agent-version"].(string)
+	vers, err := version.Parse(agentVersion)
+	if err != nil {
+		return coremigration.ModelInfo{}, errors.Annotate(err, "cannot read model agent version")
+	}
+	return coremigration.ModelInfo{
+		UUID:         model.Tag().Id(),
+		Owner:        model.Owner(),
+		Name:         name,
+		AgentVersion: vers,
+		// The source controller is not known, but it can have been
+		// no older than the model's agents.
+		ControllerAgentVersion: vers,
+	}, nil
+}
+
+func (c *importModelCommand) getAPI() (importModelAPI, error) {
+	if c.api != nil {
+		return c.api, nil
+	}
+	root, err := c.NewAPIRoot()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return importClientShim{migrationtarget.NewClient(root), root}, nil
+}
+
+// importClientShim adds Close to the migration target client.
+type importClientShim struct {
+	*migrationtarget.Client
+	closer io.Closer
+}
+
+// Close is part of the importModelAPI interface.
+func (c importClientShim) Close() error {
+	return c.closer.Close()
+}
+
+// importUploader implements the migration uploader interfaces,
+// uploading the binaries of the imported model.
+type importUploader struct {
+	client    importModelAPI
+	modelUUID string
+}
+
+// UploadTools is part of the migration.ToolsUploader interface.
+func (u *importUploader) UploadTools(r io.ReadSeeker, vers version.Binary, additionalSeries ...string) (tools.List, error) {
+	return u.client.UploadTools(u.modelUUID, r, vers, additionalSeries...)
+}
+
+// UploadCharm is part of the migration.CharmUploader interface.
+func (u *importUploader) UploadCharm(curl *charm.URL, content io.ReadSeeker) (*charm.URL, error) {
+	return u.client.UploadCharm(u.modelUUID, curl, content)
+}
+
+// UploadResource is part of the migration.ResourceUploader interface.
+func (u *importUploader) UploadResource(res resource.Resource, content io.ReadSeeker) error {
+	return u.client.UploadResource(u.modelUUID, res, content)
+}
+
+// SetPlaceholderResource is part of the migration.ResourceUploader interface.
+func (u *importUploader) SetPlaceholderResource(res resource.Resource) error {
+	return u.client.SetPlaceholderResource(u.modelUUID, res)
+}
+
+// SetUnitResource is part of the migration.ResourceUploader interface.
+func (u *importUploader) SetUnitResource(unitName string, res resource.Resource) error {
+	return u.client.SetUnitResource(u.modelUUID, unitName, res)
+}
@@ -0,0 +1,186 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package commands
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/juju/cmd"
+	"github.com/juju/cmd/cmdtesting"
+	"github.com/juju/description"
+	"github.com/juju/errors"
+	jujutesting "github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	"github.com/juju/version"
+	gc "gopkg.in/check.v1"
+	"gopkg.in/juju/charm.v6"
+	"gopkg.in/juju/names.v2"
+
+	"github.com/juju/juju/cmd/modelcmd"
+	coremigration "github.com/juju/juju/core/migration"
+	"github.com/juju/juju/jujuclient/jujuclienttesting"
+	"github.com/juju/juju/migration"
+	"github.com/juju/juju/resource"
+	"github.com/juju/juju/testing"
+	"github.com/juju/juju/tools"
+)
+
+type ImportModelSuite struct {
+	testing.FakeJujuXDGDataHomeSuite
+	api      *fakeImportModelAPI
+	filename string
+}
+
+var _ = gc.Suite(&ImportModelSuite{})
+
+func (s *ImportModelSuite) SetUpTest(c *gc.C) {
+	s.FakeJujuXDGDataHomeSuite.SetUpTest(c)
+	s.api = &fakeImportModelAPI{}
+
+	model := description.NewModel(description.ModelArgs{
+		Config: map[string]interface{}{
+			"uuid":          modelUUID,
+			"name":          "sword",
+			"agent-version": "2.4.0",
+		},
+		Owner: names.NewUserTag("king"),
+	})
+	bytes, err := description.Serialize(model)
+	c.Assert(err, jc.ErrorIsNil)
+
+	s.filename = filepath.Join(c.MkDir(), "model.zip")
+	f, err := os.Create(s.filename)
+	c.Assert(err, jc.ErrorIsNil)
+	defer f.Close()
+	err = migration.WriteArchive(f, migration.WriteArchiveConfig{
+		Model: coremigration.SerializedModel{
+			Bytes:  bytes,
+			Charms: []string{"cs:trusty/mysql-1"},
+		},
+		CharmDownloader:    &fakeModelDownloader{},
+		ToolsDownloader:    &fakeModelDownloader{},
+		ResourceDownloader: &fakeModelDownloader{},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *ImportModelSuite) runCommand(c *gc.C, args ...string) (*cmd.Context, error) {
+	command := newImportModelCommand()
+	command.SetClientStore(jujuclienttesting.MinimalStore())
+	inner := modelcmd.InnerCommand(command).(*importModelCommand)
+	inner.api = s.api
+	return cmdtesting.RunCommand(c, command, args...)
+}
+
+func (s *ImportModelSuite) TestNoFilename(c *gc.C) {
+	_, err := s.runCommand(c)
+	c.Assert(err, gc.ErrorMatches, "filename not specified")
+}
+
+func (s *ImportModelSuite) TestImport(c *gc.C) {
+	ctx, err := s.runCommand(c, s.filename)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(cmdtesting.Stderr(ctx), gc.Equals, ""+
+		"Importing model \"sword\"\n"+
+		"Model \"sword\" imported but not activated\n"+
+		"Once its agents have been redirected to this controller, activate it with:\n"+
+		"  juju import-model --activate "+s.filename+"\n")
+
+	// The model is left inactive until its agents are redirected.
+	vers := version.MustParse("2.4.0")
+	s.api.CheckCallNames(c, "Prechecks", "Import", "UploadCharm", "Close")
+	s.api.CheckCall(c, 0, "Prechecks", coremigration.ModelInfo{
+		UUID:                   modelUUID,
+		Owner:                  names.NewUserTag("king"),
+		Name:                   "sword",
+		AgentVersion:           vers,
+		ControllerAgentVersion: vers,
+	})
+	s.api.CheckCall(c, 2, "UploadCharm", modelUUID, "cs:trusty/mysql-1")
+}
+
+func (s *ImportModelSuite) TestActivate(c *gc.C) {
+	ctx, err := s.runCommand(c, "--activate", s.filename)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(cmdtesting.Stderr(ctx), gc.Equals, "Model \"sword\" activated\n")
+	s.api.CheckCallNames(c, "AdoptResources", "Activate", "Close")
+	s.api.CheckCall(c, 0, "AdoptResources", modelUUID)
+	s.api.CheckCall(c, 1, "Activate", modelUUID)
+}
+
+func (s *ImportModelSuite) TestPrechecksFail(c *gc.C) {
+	s.api.SetErrors(errors.New("boom"))
+	_, err := s.runCommand(c, s.filename)
+	c.Assert(err, gc.ErrorMatches, "prechecks failed: boom")
+	s.api.CheckCallNames(c, "Prechecks", "Close")
+}
+
+func (s *ImportModelSuite) TestUploadFailAborts(c *gc.C) {
+	s.api.SetErrors(nil, nil, errors.New("boom"))
+	_, err := s.runCommand(c, s.filename)
+	c.Assert(err, gc.ErrorMatches, "failed to upload binaries: .*boom")
+	s.api.CheckCallNames(c, "Prechecks", "Import", "UploadCharm", "Abort", "Close")
+	s.api.CheckCall(c, 3, "Abort", modelUUID)
+}
+
+type fakeImportModelAPI struct {
+	jujutesting.Stub
+}
+
+func (a *fakeImportModelAPI) Prechecks(info coremigration.ModelInfo) error {
+	a.MethodCall(a, "Prechecks", info)
+	return a.NextErr()
+}
+
+func (a *fakeImportModelAPI) Import(bytes []byte) error {
+	a.MethodCall(a, "Import")
+	return a.NextErr()
+}
+
+func (a *fakeImportModelAPI) UploadCharm(modelUUID string, curl *charm.URL, _ io.ReadSeeker) (*charm.URL, error) {
+	a.MethodCall(a, "UploadCharm", modelUUID, curl.String())
+	return curl, a.NextErr()
+}
+
+func (a *fakeImportModelAPI) UploadTools(modelUUID string, _ io.ReadSeeker, vers version.Binary, _ ...string) (tools.List, error) {
+	a.MethodCall(a, "UploadTools", modelUUID, vers)
+	return nil, a.NextErr()
+}
+
+func (a *fakeImportModelAPI) UploadResource(modelUUID string, res resource.Resource, _ io.ReadSeeker) error {
+	a.MethodCall(a, "UploadResource", modelUUID, res.Name)
+	return a.NextErr()
+}
+
+func (a *fakeImportModelAPI) SetPlaceholderResource(modelUUID string, res resource.Resource) error {
+	a.MethodCall(a, "SetPlaceholderResource", modelUUID, res.Name)
+	return a.NextErr()
+}
+
+func (a *fakeImportModelAPI) SetUnitResource(modelUUID, unit string, res resource.Resource) error {
+	a.MethodCall(a, "SetUnitResource", modelUUID, unit, res.Name)
+	return a.NextErr()
+}
+
+func (a *fakeImportModelAPI) AdoptResources(modelUUID string) error {
+	a.MethodCall(a, "AdoptResources", modelUUID)
+	return a.NextErr()
+}
+
+func (a *fakeImportModelAPI) Activate(modelUUID string) error {
+	a.MethodCall(a, "Activate", modelUUID)
+	return a.NextErr()
+}
+
+func (a *fakeImportModelAPI) Abort(modelUUID string) error {
+	a.MethodCall(a, "Abort", modelUUID)
+	return a.NextErr()
+}
+
+func (a *fakeImportModelAPI) Close() error {
+	a.MethodCall(a, "Close")
+	return a.NextErr()
+}
@@ -417,6 +417,7 @@ var commandNames = []string{
 	"charm",
 	"charm-resources",
 	"clouds",
+	"clone-model",
 	"collect-metrics",
 	"config",
 	"consume",
@@ -426,6 +427,7 @@ var commandNames = []string{
 	"create-storage-pool",
 	"create-wallet",
 	"credentials",
+	"debug-code",
 	"debug-hooks",
 	"debug-log",
 	"deploy",
@@ -436,10 +438,12 @@ var commandNames = []string{
 	"disable-user",
 	"disabled-commands",
 	"download-backup",
+	"download-hook-recordings",
 	"enable-command",
 	"enable-destroy-controller",
 	"enable-ha",
 	"enable-user",
+	"export-model",
 	"expose",
 	"find-offers",
 	"firewall-rules",
@@ -452,6 +456,7 @@ var commandNames = []string{
 	"hook-tool",
 	"hook-tools",
 	"import-filesystem",
+	"import-model",
 	"import-ssh-key",
 	"kill-controller",
 	"list-actions",
@@ -471,6 +476,7 @@ var commandNames = []string{
 	"list-plans",
 	"list-regions",
 	"list-resources",
+	"list-snapshots",
 	"list-spaces",
 	"list-ssh-keys",
 	"list-storage",
@@ -491,6 +497,7 @@ var commandNames = []string{
 	"offers",
 	"payloads",
 	"plans",
+	"profile",
 	"regions",
 	"register",
 	"relate", //alias for add-relation
@@ -501,6 +508,7 @@ var commandNames = []string{
 	"remove-cloud",
 	"remove-consumed-application",
 	"remove-credential",
+	"remove-firewall-rule",
 	"remove-k8s",
 	"remove-machine",
 	"remove-offer",
@@ -510,10 +518,12 @@ var commandNames = []string{
 	"remove-storage",
 	"remove-unit",
 	"remove-user",
+	"resize-storage",
 	"resolved",
 	"resolve",
 	"resources",
 	"restore-backup",
+	"restore-storage",
 	"resume-relation",
 	"retry-provisioning",
 	"revoke",
@@ -535,15 +545,21 @@ var commandNames = []string{
 	"show-controller",
 	"show-credential",
 	"show-credentials",
+	"show-hook-history",
 	"show-machine",
+	"show-machine-lock",
 	"show-model",
 	"show-offer",
+	"show-relation-data",
+	"show-remote-relation",
 	"show-status",
 	"show-status-log",
 	"show-storage",
 	"show-user",
 	"show-wallet",
 	"sla",
+	"snapshot-storage",
+	"snapshots",
 	"spaces",
 	"ssh",
 	"ssh-keys",
@@ -568,6 +584,7 @@ var commandNames = []string{
 	"upload-backup",
 	"users",
 	"version",
+	"wait-for",
 	"wallets",
 	"whoami",
 }
@@ -6,6 +6,7 @@ package commands
 import (
 	"github.com/juju/cmd"
 	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
 	"gopkg.in/macaroon-bakery.v2-unstable/httpbakery"
 	"gopkg.in/macaroon.v2-unstable"
 
@@ -28,10 +29,12 @@ type migrateCommand struct {
 	newAPIRoot       func(jujuclient.ClientStore, string, string) (api.Connection, error)
 	api              migrateAPI
 	targetController string
+	dryRun           bool
 }
 
 type migrateAPI interface {
 	InitiateMigration(spec controller.MigrationSpec) (string, error)
+	MigrationPrechecks(spec controller.MigrationSpec) ([]string, error)
 }
 
 const migrateDoc = `
@@ -57,6 +60,11 @@ This command only starts a model migration - it does not wait for its
 completion. The progress of a migration can be tracked using the
 "status" command and by consulting the logs.
 
+With --dry-run, the checks made before a migration starts are run on
+both controllers, along with a check that the target controller could
+import the model, but the migration is not started. Every problem that
+would prevent the migration is reported, rather than just the first.
+
 See also:
     login
     controllers
@@ -73,6 +81,12 @@ func (c *migrateCommand) Info() *cmd.Info {
 	}
 }
 
+// SetFlags implements cmd.Command.
+func (c *migrateCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.ModelCommandBase.SetFlags(f)
+	f.BoolVar(&c.dryRun, "dry-run", false, "Check that the model can be migrated without migrating it")
+}
+
 // Init implements cmd.Command.
 func (c *migrateCommand) Init(args []string) error {
 	if len(args) < 1 {
@@ -141,6 +155,9 @@ func (c *migrateCommand) Run(ctx *cmd.Context) error {
 	if err != nil {
 		return err
 	}
+	if c.dryRun {
+		return c.runPrechecks(ctx, api, *spec)
+	}
 	id, err := api.InitiateMigration(*spec)
 	if err != nil {
 		return err
@@ -149,6 +166,22 @@ func (c *migrateCommand) Run(ctx *cmd.Context) error {
 	return nil
 }
 
+func (c *migrateCommand) runPrechecks(ctx *cmd.Context, api migrateAPI, spec controller.MigrationSpec) error {
+	problems, err := api.MigrationPrechecks(spec)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if len(problems) == 0 {
+		ctx.Infof("Migration prechecks passed")
+		return nil
+	}
+	ctx.Infof("Migration prechecks found %d problem(s):", len(problems))
+	for _, problem := range problems {
+		ctx.Infof("  %s", problem)
+	}
+	return cmd.ErrSilent
+}
+
 func (c *migrateCommand) getAPI() (migrateAPI, error) {
 	if c.api != nil {
 		return c.api, nil
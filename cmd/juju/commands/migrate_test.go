@@ -181,6 +181,37 @@ func (s *MigrateSuite) TestSuccessMacaroons(c *gc.C) {
 	})
 }
 
+func (s *MigrateSuite) TestDryRun(c *gc.C) {
+	ctx, err := s.makeAndRun(c, "model", "target", "--dry-run")
+	c.Assert(err, jc.ErrorIsNil)
+
+	c.Check(cmdtesting.Stderr(ctx), gc.Equals, "Migration prechecks passed\n")
+	c.Check(s.api.specSeen, gc.IsNil)
+	c.Check(s.api.precheckSeen, jc.DeepEquals, &controller.MigrationSpec{
+		ModelUUID:            modelUUID,
+		TargetControllerUUID: targetControllerUUID,
+		TargetAddrs:          []string{"1.2.3.4:5"},
+		TargetCACert:         "cert",
+		TargetUser:           "targetuser",
+		TargetPassword:       "secret",
+	})
+}
+
+func (s *MigrateSuite) TestDryRunProblems(c *gc.C) {
+	s.api.problems = []string{
+		"source: unit foo/0 not idle or executing (failed)",
+		"target: model named \"model\" already exists",
+	}
+	ctx, err := s.makeAndRun(c, "model", "target", "--dry-run")
+	c.Assert(err, gc.Equals, cmd.ErrSilent)
+
+	c.Check(cmdtesting.Stderr(ctx), gc.Equals, ""+
+		"Migration prechecks found 2 problem(s):\n"+
+		"  source: unit foo/0 not idle or executing (failed)\n"+
+		"  target: model named \"model\" already exists\n")
+	c.Check(s.api.specSeen, gc.IsNil)
+}
+
 func (s *MigrateSuite) TestModelDoesntExist(c *gc.C) {
 	cmd := s.makeCommand()
 	_, err := cmdtesting.RunCommand(c, cmd, "wat", "target")
@@ -236,7 +267,9 @@ func (s *MigrateSuite) makeCommand() modelcmd.ModelCommand {
 }
 
 type fakeMigrateAPI struct {
-	specSeen *controller.MigrationSpec
+	specSeen     *controller.MigrationSpec
+	precheckSeen *controller.MigrationSpec
+	problems     []string
 }
 
 func (a *fakeMigrateAPI) InitiateMigration(spec controller.MigrationSpec) (string, error) {
@@ -244,6 +277,11 @@ func (a *fakeMigrateAPI) InitiateMigration(spec controller.MigrationSpec) (strin
 	return "uuid:0", nil
 }
 
+func (a *fakeMigrateAPI) MigrationPrechecks(spec controller.MigrationSpec) ([]string, error) {
+	a.precheckSeen = &spec
+	return a.problems, nil
+}
+
 type fakeModelAPI struct {
 	models []base.UserModel
 }
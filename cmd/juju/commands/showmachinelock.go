@@ -0,0 +1,83 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package commands
+
+import (
+	"fmt"
+
+	"github.com/juju/cmd"
+	"github.com/juju/errors"
+	"gopkg.in/juju/names.v2"
+
+	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/network/ssh"
+)
+
+func newShowMachineLockCommand(hostChecker ssh.ReachableChecker) cmd.Command {
+	c := new(showMachineLockCommand)
+	c.setHostChecker(hostChecker)
+	return modelcmd.Wrap(c)
+}
+
+// showMachineLockCommand reports on the machine lock of a machine,
+// by querying the introspection worker of each agent on it over SSH.
+type showMachineLockCommand struct {
+	sshCommand
+}
+
+const showMachineLockDoc = `
+Show the holder of, the requests waiting for, and the recent history
+of the lock that serialises hook execution on a machine.
+
+Each agent on the machine reports the lock requests it has made. The
+target may be given either as a machine or as a unit on that machine.
+
+See the "juju help ssh" for information about SSH related options
+accepted by the show-machine-lock command.
+
+Examples:
+
+    juju show-machine-lock 0
+    juju show-machine-lock mysql/0
+`
+
+func (c *showMachineLockCommand) Info() *cmd.Info {
+	return &cmd.Info{
+		Name:    "show-machine-lock",
+		Args:    "<machine>|<unit name>",
+		Purpose: "Show the status of the machine lock.",
+		Doc:     showMachineLockDoc,
+	}
+}
+
+func (c *showMachineLockCommand) Init(args []string) error {
+	if len(args) == 0 {
+		return errors.Errorf("no machine or unit specified")
+	}
+	target, args := args[0], args[1:]
+	if !names.IsValidMachine(target) && !names.IsValidUnit(target) {
+		return errors.Errorf("%q is not a valid machine or unit", target)
+	}
+	if err := cmd.CheckEmpty(args); err != nil {
+		return errors.Trace(err)
+	}
+	c.Target = target
+	return nil
+}
+
+// showMachineLockScript asks each agent on the machine for its view of
+// the machine lock. Agents that don't report on the lock are skipped.
+const showMachineLockScript = `for agent in $(ls /var/lib/juju/agents); do juju-introspect --agent=$agent machinelock/ 2>/dev/null; done`
+
+// Run resolves c.Target to a machine, and connects to it via SSH to
+// report on the machine lock.
+func (c *showMachineLockCommand) Run(ctx *cmd.Context) error {
+	err := c.initRun()
+	if err != nil {
+		return err
+	}
+	defer c.cleanupRun()
+	c.Args = []string{fmt.Sprintf("sudo /bin/bash -c '%s'", showMachineLockScript)}
+	return c.sshCommand.Run(ctx)
+}
@@ -0,0 +1,83 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package commands
+
+import (
+	"regexp"
+	"runtime"
+
+	"github.com/juju/cmd/cmdtesting"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	jujussh "github.com/juju/juju/network/ssh"
+)
+
+var _ = gc.Suite(&ShowMachineLockSuite{})
+
+type ShowMachineLockSuite struct {
+	SSHCommonSuite
+}
+
+var showMachineLockTests = []struct {
+	info        string
+	args        []string
+	hostChecker jujussh.ReachableChecker
+	forceAPIv1  bool
+	error       string
+	expected    *argsSpec
+}{{
+	info:        "machine (api v1)",
+	args:        []string{"0"},
+	hostChecker: validAddresses("0.private", "0.public"),
+	forceAPIv1:  true,
+	expected: &argsSpec{
+		hostKeyChecking: "yes",
+		knownHosts:      "0",
+		args:            "ubuntu@0.public sudo /bin/bash -c 'for agent in $(ls /var/lib/juju/agents); do juju-introspect --agent=$agent machinelock/ 2>/dev/null; done'",
+	},
+}, {
+	info:        "unit (api v2)",
+	args:        []string{"mysql/0"},
+	hostChecker: validAddresses("0.private", "0.public", "0.1.2.3"),
+	expected: &argsSpec{
+		hostKeyChecking: "yes",
+		knownHosts:      "0",
+		argsMatch:       `ubuntu@0\.(private|public|1\.2\.3) sudo /bin/bash -c 'for agent in .+'`,
+	},
+}, {
+	info:  "no args",
+	error: "no machine or unit specified",
+}, {
+	info:  "invalid target",
+	args:  []string{"mysql"},
+	error: `"mysql" is not a valid machine or unit`,
+}, {
+	info:  "extra args",
+	args:  []string{"0", "extra"},
+	error: `unrecognized args: ["extra"]`,
+}}
+
+func (s *ShowMachineLockSuite) TestShowMachineLockCommand(c *gc.C) {
+	if runtime.GOOS == "windows" {
+		c.Skip("show-machine-lock is not supported on windows")
+	}
+
+	s.setupModel(c)
+
+	for i, t := range showMachineLockTests {
+		c.Logf("test %d: %s\n\t%s\n", i, t.info, t.args)
+
+		s.setHostChecker(t.hostChecker)
+		s.setForceAPIv1(t.forceAPIv1)
+
+		ctx, err := cmdtesting.RunCommand(c, newShowMachineLockCommand(s.hostChecker), t.args...)
+		if t.error != "" {
+			c.Check(err, gc.ErrorMatches, regexp.QuoteMeta(t.error))
+		} else {
+			c.Check(err, jc.ErrorIsNil)
+			t.expected.check(c, cmdtesting.Stdout(ctx))
+		}
+	}
+}
@@ -70,3 +70,11 @@ func NewRemoveCommandForTest(store jujuclient.ClientStore, api RemoveAPI) cmd.Co
 	aCmd.SetClientStore(store)
 	return modelcmd.WrapController(aCmd)
 }
+
+func NewShowRemoteRelationCommandForTest(store jujuclient.ClientStore, api ShowRemoteRelationAPI) cmd.Command {
+	aCmd := &showRemoteRelationCommand{newAPIFunc: func() (ShowRemoteRelationAPI, error) {
+		return api, nil
+	}}
+	aCmd.SetClientStore(store)
+	return modelcmd.Wrap(aCmd)
+}
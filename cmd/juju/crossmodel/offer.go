@@ -25,12 +25,20 @@ Deployed application endpoints are offered for use by consumers.
 By default, the offer is named after the application, unless
 an offer name is explicitly specified.
 
+An existing offer may be changed in place with --update. The
+endpoints given replace those of the offer, and the description
+is changed if --description is specified. Endpoints which are
+used by relations to consuming models cannot be removed from
+the offer.
+
 Examples:
 
 $ juju offer mysql:db
 $ juju offer mymodel.mysql:db
 $ juju offer db2:db hosted-db2
 $ juju offer db2:db,log hosted-db2
+$ juju offer db2:db,log,admin hosted-db2 --update
+$ juju offer mysql:db --update --description "MySQL 5.7"
 
 See also:
     consume
@@ -65,6 +73,12 @@ type offerCommand struct {
 
 	// QualifiedModelName stores the name of the model hosting the offer.
 	QualifiedModelName string
+
+	// Description stores the description of the offer.
+	Description string
+
+	// Update is true if an existing offer is to be updated.
+	Update bool
 }
 
 // NewApplicationOffersAPI returns an application offers api for the root api endpoint
@@ -104,6 +118,8 @@ func (c *offerCommand) Init(args []string) error {
 // SetFlags implements Command.SetFlags.
 func (c *offerCommand) SetFlags(f *gnuflag.FlagSet) {
 	c.ControllerCommandBase.SetFlags(f)
+	f.StringVar(&c.Description, "description", "", "The description of the offer")
+	f.BoolVar(&c.Update, "update", false, "Update the endpoints and description of an existing offer")
 }
 
 // Run implements Command.Run.
@@ -149,8 +165,11 @@ func (c *offerCommand) Run(ctx *cmd.Context) error {
 	if c.OfferName == "" {
 		c.OfferName = c.Application
 	}
-	// TODO (anastasiamac 2015-11-16) Add a sensible way for user to specify long-ish (at times) description when offering
-	results, err := api.Offer(modelDetails.ModelUUID, c.Application, c.Endpoints, c.OfferName, "")
+	offer := api.Offer
+	if c.Update {
+		offer = api.UpdateOffer
+	}
+	results, err := offer(modelDetails.ModelUUID, c.Application, c.Endpoints, c.OfferName, c.Description)
 	if err != nil {
 		return err
 	}
@@ -164,6 +183,10 @@ func (c *offerCommand) Run(ctx *cmd.Context) error {
 	}
 	url := jujucrossmodel.MakeURL(ownerTag.Name(), unqualifiedModelName, c.OfferName, "")
 	ep := strings.Join(c.Endpoints, ", ")
+	if c.Update {
+		ctx.Infof("Offer %q updated, application %q endpoints [%s] available at %q", c.OfferName, c.Application, ep, url)
+		return nil
+	}
 	ctx.Infof("Application %q endpoints [%s] available at %q", c.Application, ep, url)
 	return nil
 }
@@ -172,6 +195,7 @@ func (c *offerCommand) Run(ctx *cmd.Context) error {
 type OfferAPI interface {
 	Close() error
 	Offer(modelUUID, application string, endpoints []string, offerName string, desc string) ([]params.ErrorResult, error)
+	UpdateOffer(modelUUID, application string, endpoints []string, offerName string, desc string) ([]params.ErrorResult, error)
 }
 
 // applicationParse is used to split an application string
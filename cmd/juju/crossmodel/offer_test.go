@@ -107,6 +107,30 @@ func (s *offerSuite) TestOfferMultipleEndpoints(c *gc.C) {
 	s.assertOfferOutput(c, "test", "tst", "tst", []string{"db", "admin"})
 }
 
+func (s *offerSuite) TestOfferDescription(c *gc.C) {
+	s.args = []string{"tst:db", "--description", "a test database"}
+	s.assertOfferOutput(c, "test", "tst", "tst", []string{"db"})
+	c.Assert(s.mockAPI.descs["tst"], gc.Equals, "a test database")
+}
+
+func (s *offerSuite) TestOfferUpdate(c *gc.C) {
+	s.args = []string{"tst:db,admin", "hosted-tst", "--update", "--description", "a test database"}
+	ctx, err := s.runOffer(c, s.args...)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(s.mockAPI.offers, gc.HasLen, 0)
+	c.Assert(s.mockAPI.updated["hosted-tst"], jc.SameContents, []string{"db", "admin"})
+	c.Assert(s.mockAPI.descs["hosted-tst"], gc.Equals, "a test database")
+	c.Assert(s.mockAPI.modelUUID, gc.Equals, "fred-uuid")
+	c.Assert(cmdtesting.Stderr(ctx), gc.Equals,
+		`Offer "hosted-tst" updated, application "tst" endpoints [db, admin] available at "fred/test.hosted-tst"`+"\n")
+}
+
+func (s *offerSuite) TestOfferUpdateErred(c *gc.C) {
+	s.args = []string{"tst:db", "--update"}
+	s.mockAPI.errData = true
+	s.assertOfferErrorOutput(c, ".*failed.*")
+}
+
 func (s *offerSuite) assertOfferOutput(c *gc.C, expectedModel, expectedOffer, expectedApplication string, endpoints []string) {
 	_, err := s.runOffer(c, s.args...)
 	c.Assert(err, jc.ErrorIsNil)
@@ -117,6 +141,7 @@ type mockOfferAPI struct {
 	errCall, errData bool
 	modelUUID        string
 	offers           map[string][]string
+	updated          map[string][]string
 	applications     map[string]string
 	descs            map[string]string
 }
@@ -124,6 +149,7 @@ type mockOfferAPI struct {
 func newMockOfferAPI() *mockOfferAPI {
 	mock := &mockOfferAPI{}
 	mock.offers = make(map[string][]string)
+	mock.updated = make(map[string][]string)
 	mock.descs = make(map[string]string)
 	mock.applications = make(map[string]string)
 	return mock
@@ -151,3 +177,19 @@ func (s *mockOfferAPI) Offer(modelUUID, application string, endpoints []string,
 	s.descs[offerName] = desc
 	return result, nil
 }
+
+func (s *mockOfferAPI) UpdateOffer(modelUUID, application string, endpoints []string, offerName, desc string) ([]params.ErrorResult, error) {
+	if s.errCall {
+		return nil, errors.New("aborted")
+	}
+	result := make([]params.ErrorResult, 1)
+	if s.errData {
+		result[0].Error = common.ServerError(errors.New("failed"))
+		return result, nil
+	}
+	s.modelUUID = modelUUID
+	s.updated[offerName] = endpoints
+	s.applications[offerName] = application
+	s.descs[offerName] = desc
+	return result, nil
+}
@@ -0,0 +1,273 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package crossmodel
+
+import (
+	"github.com/juju/cmd"
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+	"gopkg.in/juju/names.v2"
+
+	"github.com/juju/juju/api/remoterelationdiagnostics"
+	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/cmd/juju/common"
+	"github.com/juju/juju/cmd/modelcmd"
+)
+
+const showRemoteRelationCommandDoc = `
+Shows diagnostic information about the cross model relations of the
+specified remote (SAAS) applications, or of all remote applications in
+the model if none are specified.
+
+For each relation, the output includes whether the controller hosting
+the other model can be reached, whether the macaroon authorising the
+relation has expired, the last settings change exchanged with the other
+model in each direction and whether it was delivered, the number of
+unit changes still pending delivery, and the ingress and egress networks
+required by the relation.
+
+Reachability of the other controller is checked from this controller.
+It is not reported in the offering model, which never initiates
+connections to the consuming model.
+
+Examples:
+    juju show-remote-relation
+    juju show-remote-relation mysql
+    juju show-remote-relation mysql --format json
+
+See also:
+    consume
+    offers
+    relate
+`
+
+// NewShowRemoteRelationCommand returns a command which shows
+// diagnostics for cross model relations.
+func NewShowRemoteRelationCommand() cmd.Command {
+	showCmd := &showRemoteRelationCommand{}
+	showCmd.newAPIFunc = func() (ShowRemoteRelationAPI, error) {
+		root, err := showCmd.NewAPIRoot()
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		return remoterelationdiagnostics.NewClient(root), nil
+	}
+	return modelcmd.Wrap(showCmd)
+}
+
+// ShowRemoteRelationAPI defines the API methods used
+// by the show-remote-relation command.
+type ShowRemoteRelationAPI interface {
+	Close() error
+	RemoteRelations(applicationNames ...string) ([]params.RemoteRelationDiagnosticsResult, error)
+}
+
+type showRemoteRelationCommand struct {
+	modelcmd.ModelCommandBase
+
+	out        cmd.Output
+	isoTime    bool
+	appNames   []string
+	newAPIFunc func() (ShowRemoteRelationAPI, error)
+}
+
+// Info implements Command.Info.
+func (c *showRemoteRelationCommand) Info() *cmd.Info {
+	return &cmd.Info{
+		Name:    "show-remote-relation",
+		Args:    "[<remote-application-name> ...]",
+		Purpose: "Shows diagnostic information about cross model relations.",
+		Doc:     showRemoteRelationCommandDoc,
+	}
+}
+
+// SetFlags implements Command.SetFlags.
+func (c *showRemoteRelationCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.ModelCommandBase.SetFlags(f)
+	f.BoolVar(&c.isoTime, "utc", false, "Display time as UTC in RFC3339 format")
+	c.out.AddFlags(f, "yaml", map[string]cmd.Formatter{
+		"yaml": cmd.FormatYaml,
+		"json": cmd.FormatJson,
+	})
+}
+
+// Init implements Command.Init.
+func (c *showRemoteRelationCommand) Init(args []string) error {
+	for _, arg := range args {
+		if !names.IsValidApplication(arg) {
+			return errors.NotValidf("application name %q", arg)
+		}
+	}
+	c.appNames = args
+	return nil
+}
+
+// Run implements Command.Run.
+func (c *showRemoteRelationCommand) Run(ctx *cmd.Context) error {
+	api, err := c.newAPIFunc()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer api.Close()
+
+	results, err := api.RemoteRelations(c.appNames...)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	output := make(map[string]RemoteRelationDiagnostics)
+	var failed bool
+	for _, result := range results {
+		if result.Error != nil {
+			ctx.Infof("ERROR %v", result.Error)
+			failed = true
+			continue
+		}
+		output[result.Result.RelationKey] = c.formatDiagnostics(result.Result)
+	}
+	if len(output) == 0 && !failed {
+		ctx.Infof("No cross model relations found.")
+		return nil
+	}
+	if len(output) > 0 {
+		if err := c.out.Write(ctx, output); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	if failed {
+		return cmd.ErrSilent
+	}
+	return nil
+}
+
+// RemoteRelationDiagnostics holds the diagnostics for a
+// cross model relation, formatted for output.
+type RemoteRelationDiagnostics struct {
+	RelationId        int    `yaml:"relation-id" json:"relation-id"`
+	Status            string `yaml:"status" json:"status"`
+	Message           string `yaml:"message,omitempty" json:"message,omitempty"`
+	RemoteApplication string `yaml:"remote-application" json:"remote-application"`
+	OfferURL          string `yaml:"offer-url,omitempty" json:"offer-url,omitempty"`
+	OfferUUID         string `yaml:"offer-uuid,omitempty" json:"offer-uuid,omitempty"`
+	RemoteModelUUID   string `yaml:"remote-model-uuid" json:"remote-model-uuid"`
+
+	// Role is the role of this model in the relation,
+	// either "consumer" or "offerer".
+	Role string `yaml:"role" json:"role"`
+
+	Tokens          RemoteRelationTokens        `yaml:"tokens" json:"tokens"`
+	Controller      *RemoteControllerStatus     `yaml:"controller,omitempty" json:"controller,omitempty"`
+	Macaroon        RemoteMacaroonStatus        `yaml:"macaroon" json:"macaroon"`
+	LastSent        *RemoteRelationEventDetails `yaml:"last-sent,omitempty" json:"last-sent,omitempty"`
+	LastReceived    *RemoteRelationEventDetails `yaml:"last-received,omitempty" json:"last-received,omitempty"`
+	Pending         RemoteRelationPending       `yaml:"pending-changes" json:"pending-changes"`
+	IngressNetworks []string                    `yaml:"ingress-networks,omitempty" json:"ingress-networks,omitempty"`
+	EgressNetworks  []string                    `yaml:"egress-networks,omitempty" json:"egress-networks,omitempty"`
+}
+
+// RemoteRelationTokens holds the tokens identifying
+// a relation and its remote application between models.
+type RemoteRelationTokens struct {
+	Application string `yaml:"application,omitempty" json:"application,omitempty"`
+	Relation    string `yaml:"relation,omitempty" json:"relation,omitempty"`
+}
+
+// RemoteControllerStatus describes whether the controller
+// hosting the remote model can be reached.
+type RemoteControllerStatus struct {
+	UUID      string   `yaml:"uuid,omitempty" json:"uuid,omitempty"`
+	Alias     string   `yaml:"alias,omitempty" json:"alias,omitempty"`
+	Addresses []string `yaml:"addresses,omitempty" json:"addresses,omitempty"`
+	Local     bool     `yaml:"local,omitempty" json:"local,omitempty"`
+	Reachable bool     `yaml:"reachable" json:"reachable"`
+	Error     string   `yaml:"error,omitempty" json:"error,omitempty"`
+}
+
+// RemoteMacaroonStatus describes the macaroon authorising a relation.
+type RemoteMacaroonStatus struct {
+	// Status is one of "valid", "expired" or "missing".
+	Status string `yaml:"status" json:"status"`
+	Expiry string `yaml:"expiry,omitempty" json:"expiry,omitempty"`
+}
+
+// RemoteRelationEventDetails describes a settings change
+// exchanged with the remote model.
+type RemoteRelationEventDetails struct {
+	Time          string `yaml:"time" json:"time"`
+	ChangedUnits  int    `yaml:"changed-units" json:"changed-units"`
+	DepartedUnits int    `yaml:"departed-units" json:"departed-units"`
+	Error         string `yaml:"error,omitempty" json:"error,omitempty"`
+}
+
+// RemoteRelationPending holds the number of unit changes
+// not yet delivered in each direction.
+type RemoteRelationPending struct {
+	Sent     int `yaml:"sent" json:"sent"`
+	Received int `yaml:"received" json:"received"`
+}
+
+func (c *showRemoteRelationCommand) formatDiagnostics(in *params.RemoteRelationDiagnostics) RemoteRelationDiagnostics {
+	out := RemoteRelationDiagnostics{
+		RelationId:        in.RelationId,
+		Status:            in.Status,
+		Message:           in.StatusMessage,
+		RemoteApplication: in.RemoteApplication,
+		OfferURL:          in.OfferURL,
+		OfferUUID:         in.OfferUUID,
+		Role:              "consumer",
+		Tokens: RemoteRelationTokens{
+			Application: in.ApplicationToken,
+			Relation:    in.RelationToken,
+		},
+		LastSent:     c.formatEvent(in.LastSent),
+		LastReceived: c.formatEvent(in.LastReceived),
+		Pending: RemoteRelationPending{
+			Sent:     in.PendingSent,
+			Received: in.PendingReceived,
+		},
+		IngressNetworks: in.IngressNetworks,
+		EgressNetworks:  in.EgressNetworks,
+	}
+	if in.ConsumerProxy {
+		out.Role = "offerer"
+	}
+	if modelTag, err := names.ParseModelTag(in.SourceModelTag); err == nil {
+		out.RemoteModelUUID = modelTag.Id()
+	}
+	if in.Controller != nil {
+		out.Controller = &RemoteControllerStatus{
+			Alias:     in.Controller.Alias,
+			Addresses: in.Controller.Addrs,
+			Local:     in.Controller.Local,
+			Reachable: in.Controller.Reachable,
+			Error:     in.Controller.Error,
+		}
+		if controllerTag, err := names.ParseControllerTag(in.Controller.ControllerTag); err == nil {
+			out.Controller.UUID = controllerTag.Id()
+		}
+	}
+	switch {
+	case in.Macaroon == nil:
+		out.Macaroon.Status = "missing"
+	case in.Macaroon.Expired:
+		out.Macaroon.Status = "expired"
+	default:
+		out.Macaroon.Status = "valid"
+	}
+	if in.Macaroon != nil && in.Macaroon.Expiry != nil {
+		out.Macaroon.Expiry = common.FormatTime(in.Macaroon.Expiry, c.isoTime)
+	}
+	return out
+}
+
+func (c *showRemoteRelationCommand) formatEvent(in *params.RemoteRelationEventInfo) *RemoteRelationEventDetails {
+	if in == nil {
+		return nil
+	}
+	return &RemoteRelationEventDetails{
+		Time:          common.FormatTime(&in.Time, c.isoTime),
+		ChangedUnits:  in.ChangedUnits,
+		DepartedUnits: in.DepartedUnits,
+		Error:         in.Error,
+	}
+}
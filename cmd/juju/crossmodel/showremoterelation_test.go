@@ -0,0 +1,148 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package crossmodel_test
+
+import (
+	"time"
+
+	"github.com/juju/cmd"
+	"github.com/juju/cmd/cmdtesting"
+	"github.com/juju/errors"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/cmd/juju/crossmodel"
+)
+
+type showRemoteRelationSuite struct {
+	BaseCrossModelSuite
+	mockAPI *mockShowRemoteRelationAPI
+}
+
+var _ = gc.Suite(&showRemoteRelationSuite{})
+
+func (s *showRemoteRelationSuite) SetUpTest(c *gc.C) {
+	s.BaseCrossModelSuite.SetUpTest(c)
+	expiry := time.Date(2018, 1, 2, 4, 0, 0, 0, time.UTC)
+	s.mockAPI = &mockShowRemoteRelationAPI{
+		results: []params.RemoteRelationDiagnosticsResult{{
+			Result: &params.RemoteRelationDiagnostics{
+				RelationKey:       "wordpress:db mysql:server",
+				RelationId:        7,
+				Status:            "joined",
+				RemoteApplication: "mysql",
+				OfferUUID:         "offer-uuid",
+				OfferURL:          "other:fred/prod.mysql",
+				SourceModelTag:    "model-deadbeef-0bad-400d-8000-4b1d0d06f00d",
+				ApplicationToken:  "app-token",
+				RelationToken:     "rel-token",
+				Controller: &params.RemoteControllerDiagnostics{
+					ControllerTag: "controller-deadbeef-1bad-500d-9000-4b1d0d06f00d",
+					Alias:         "other",
+					Addrs:         []string{"10.0.0.1:17070"},
+					Error:         "dial tcp 10.0.0.1:17070: connection refused",
+				},
+				Macaroon: &params.RemoteMacaroonDiagnostics{
+					Expiry:  &expiry,
+					Expired: true,
+				},
+				LastSent: &params.RemoteRelationEventInfo{
+					Time:         time.Date(2018, 1, 2, 3, 4, 5, 0, time.UTC),
+					ChangedUnits: 2,
+					Error:        "connection refused",
+				},
+				PendingSent:     2,
+				IngressNetworks: []string{"10.0.0.0/24"},
+			},
+		}},
+	}
+}
+
+func (s *showRemoteRelationSuite) runShow(c *gc.C, args ...string) (*cmd.Context, error) {
+	return cmdtesting.RunCommand(c, crossmodel.NewShowRemoteRelationCommandForTest(s.store, s.mockAPI), args...)
+}
+
+func (s *showRemoteRelationSuite) TestInitInvalidName(c *gc.C) {
+	_, err := s.runShow(c, "mysql/0")
+	c.Assert(err, gc.ErrorMatches, `application name "mysql/0" not valid`)
+}
+
+func (s *showRemoteRelationSuite) TestShow(c *gc.C) {
+	ctx, err := s.runShow(c, "mysql", "--utc")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(s.mockAPI.appNames, jc.DeepEquals, []string{"mysql"})
+	c.Assert(cmdtesting.Stdout(ctx), gc.Equals, `
+wordpress:db mysql:server:
+  relation-id: 7
+  status: joined
+  remote-application: mysql
+  offer-url: other:fred/prod.mysql
+  offer-uuid: offer-uuid
+  remote-model-uuid: deadbeef-0bad-400d-8000-4b1d0d06f00d
+  role: consumer
+  tokens:
+    application: app-token
+    relation: rel-token
+  controller:
+    uuid: deadbeef-1bad-500d-9000-4b1d0d06f00d
+    alias: other
+    addresses:
+    - 10.0.0.1:17070
+    reachable: false
+    error: 'dial tcp 10.0.0.1:17070: connection refused'
+  macaroon:
+    status: expired
+    expiry: 2018-01-02 04:00:00Z
+  last-sent:
+    time: 2018-01-02 03:04:05Z
+    changed-units: 2
+    departed-units: 0
+    error: connection refused
+  pending-changes:
+    sent: 2
+    received: 0
+  ingress-networks:
+  - 10.0.0.0/24
+`[1:])
+}
+
+func (s *showRemoteRelationSuite) TestShowNone(c *gc.C) {
+	s.mockAPI.results = nil
+	ctx, err := s.runShow(c)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(s.mockAPI.appNames, gc.HasLen, 0)
+	c.Assert(cmdtesting.Stdout(ctx), gc.Equals, "")
+	c.Assert(cmdtesting.Stderr(ctx), gc.Equals, "No cross model relations found.\n")
+}
+
+func (s *showRemoteRelationSuite) TestShowResultError(c *gc.C) {
+	s.mockAPI.results = []params.RemoteRelationDiagnosticsResult{{
+		Error: &params.Error{Message: `remote application "foo" not found`},
+	}}
+	ctx, err := s.runShow(c, "foo")
+	c.Assert(err, gc.Equals, cmd.ErrSilent)
+	c.Assert(cmdtesting.Stderr(ctx), gc.Equals, "ERROR remote application \"foo\" not found\n")
+}
+
+func (s *showRemoteRelationSuite) TestShowAPIError(c *gc.C) {
+	s.mockAPI.err = errors.New("boom")
+	_, err := s.runShow(c)
+	c.Assert(err, gc.ErrorMatches, "boom")
+}
+
+type mockShowRemoteRelationAPI struct {
+	appNames []string
+	results  []params.RemoteRelationDiagnosticsResult
+	err      error
+}
+
+func (m *mockShowRemoteRelationAPI) Close() error {
+	return nil
+}
+
+func (m *mockShowRemoteRelationAPI) RemoteRelations(applicationNames ...string) ([]params.RemoteRelationDiagnosticsResult, error) {
+	m.appNames = applicationNames
+	return m.results, m.err
+}
@@ -14,16 +14,31 @@ import (
 )
 
 type firewallRule struct {
-	KnownService   string   `yaml:"known-service" json:"known-service"`
+	KnownService   string   `yaml:"known-service,omitempty" json:"known-service,omitempty"`
+	Name           string   `yaml:"name,omitempty" json:"name,omitempty"`
+	Ports          string   `yaml:"ports,omitempty" json:"ports,omitempty"`
+	Controller     bool     `yaml:"controller,omitempty" json:"controller,omitempty"`
 	WhitelistCIDRS []string `yaml:"whitelist-subnets,omitempty" json:"whitelist-subnets,omitempty"`
 }
 
+// id returns the name by which the rule is known.
+func (r firewallRule) id() string {
+	if r.Name != "" {
+		return r.Name
+	}
+	return r.KnownService
+}
+
 type firewallRules []firewallRule
 
 func (o firewallRules) Len() int      { return len(o) }
 func (o firewallRules) Swap(i, j int) { o[i], o[j] = o[j], o[i] }
 func (o firewallRules) Less(i, j int) bool {
-	return o[i].KnownService < o[j].KnownService
+	if o[i].id() != o[j].id() {
+		return o[i].id() < o[j].id()
+	}
+	// Model rules come before the controller rules they override.
+	return !o[i].Controller && o[j].Controller
 }
 
 func formatListTabular(writer io.Writer, value interface{}) error {
@@ -42,9 +57,34 @@ func formatFirewallRulesTabular(writer io.Writer, rules firewallRules) {
 
 	sort.Sort(rules)
 
-	w.Println("Service", "Whitelist subnets")
+	// Only show the port columns if there are named rules.
+	var showPorts bool
+	for _, rule := range rules {
+		if rule.Name != "" {
+			showPorts = true
+			break
+		}
+	}
+	if !showPorts {
+		w.Println("Service", "Whitelist subnets")
+		for _, rule := range rules {
+			w.Println(rule.KnownService, strings.Join(rule.WhitelistCIDRS, ","))
+		}
+		tw.Flush()
+		return
+	}
+
+	w.Println("Service", "Ports", "Scope", "Whitelist subnets")
 	for _, rule := range rules {
-		w.Println(rule.KnownService, strings.Join(rule.WhitelistCIDRS, ","))
+		scope := "model"
+		if rule.Controller {
+			scope = "controller"
+		}
+		ports := rule.Ports
+		if ports == "" {
+			ports = "-"
+		}
+		w.Println(rule.id(), ports, scope, strings.Join(rule.WhitelistCIDRS, ","))
 	}
 	tw.Flush()
 }
@@ -18,14 +18,16 @@ Prints the firewall rules.`[1:]
 
 var listRulesHelpDetails = `
 Lists the firewall rules which control ingress to well known services
-within a Juju model.
+and named port ranges within a Juju model. Named rules set for the
+controller apply to the model unless it has a rule of the same name.
 
 Examples:
     juju list-firewall-rules
     juju firewall-rules
 
 See also: 
-    set-firewall-rule`
+    set-firewall-rule
+    remove-firewall-rule`
 
 // NewListFirewallRulesCommand returns a command to list firewall rules.
 func NewListFirewallRulesCommand() cmd.Command {
@@ -95,8 +97,13 @@ func (c *listFirewallRulesCommand) Run(ctx *cmd.Context) error {
 	for i, r := range rulesResult {
 		rules[i] = firewallRule{
 			KnownService:   string(r.KnownService),
+			Name:           r.Name,
+			Controller:     r.Controller,
 			WhitelistCIDRS: r.WhitelistCIDRS,
 		}
+		if r.PortRange != nil {
+			rules[i].Ports = r.PortRange.NetworkPortRange().String()
+		}
 	}
 	return c.out.Write(ctx, rules)
 }
@@ -59,6 +59,32 @@ ssh              192.168.1.0/16,10.0.0.0/8
 	)
 }
 
+func (s *ListSuite) TestListTabularNamedRules(c *gc.C) {
+	s.mockAPI.rules = append(s.mockAPI.rules, params.FirewallRule{
+		Name:           "prometheus",
+		PortRange:      &params.PortRange{FromPort: 9100, ToPort: 9110, Protocol: "tcp"},
+		Controller:     true,
+		WhitelistCIDRS: []string{"10.0.0.0/8"},
+	}, params.FirewallRule{
+		Name:           "prometheus",
+		PortRange:      &params.PortRange{FromPort: 9100, ToPort: 9100, Protocol: "tcp"},
+		WhitelistCIDRS: []string{"10.0.5.0/24"},
+	})
+	s.assertValidList(
+		c,
+		[]string{"--format", "tabular"},
+		`
+Service          Ports          Scope       Whitelist subnets
+juju-controller  -              model       10.2.0.0/16
+prometheus       9100/tcp       model       10.0.5.0/24
+prometheus       9100-9110/tcp  controller  10.0.0.0/8
+ssh              -              model       192.168.1.0/16,10.0.0.0/8
+
+`[1:],
+		"",
+	)
+}
+
 func (s *ListSuite) TestListYAML(c *gc.C) {
 	s.assertValidList(
 		c,
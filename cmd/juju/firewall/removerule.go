@@ -0,0 +1,98 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package firewall
+
+import (
+	"github.com/juju/cmd"
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+
+	"github.com/juju/juju/api/firewallrules"
+	"github.com/juju/juju/cmd/juju/block"
+	"github.com/juju/juju/cmd/modelcmd"
+)
+
+var removeRuleHelpSummary = `
+Removes a named firewall rule.`[1:]
+
+var removeRuleHelpDetails = `
+Removes a firewall rule previously set for a port range using
+set-firewall-rule with --port. Once removed, ingress to ports in
+that range is no longer restricted by the rule. The rules for well
+known services, such as ssh, cannot be removed.
+
+With --controller, the named rule is removed from the controller
+rather than the current model.
+
+Examples:
+    juju remove-firewall-rule prometheus
+    juju remove-firewall-rule prometheus --controller
+
+See also: 
+    list-firewall-rules
+    set-firewall-rule`
+
+// NewRemoveFirewallRuleCommand returns a command to remove firewall rules.
+func NewRemoveFirewallRuleCommand() cmd.Command {
+	cmd := &removeFirewallRuleCommand{}
+	cmd.newAPIFunc = func() (RemoveFirewallRuleAPI, error) {
+		root, err := cmd.NewAPIRoot()
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		return firewallrules.NewClient(root), nil
+
+	}
+	return modelcmd.Wrap(cmd)
+}
+
+type removeFirewallRuleCommand struct {
+	modelcmd.ModelCommandBase
+	modelcmd.IAASOnlyCommand
+	name       string
+	controller bool
+
+	newAPIFunc func() (RemoveFirewallRuleAPI, error)
+}
+
+// Info implements cmd.Command.
+func (c *removeFirewallRuleCommand) Info() *cmd.Info {
+	return &cmd.Info{
+		Name:    "remove-firewall-rule",
+		Args:    "<rule-name>",
+		Purpose: removeRuleHelpSummary,
+		Doc:     removeRuleHelpDetails,
+	}
+}
+
+// SetFlags implements cmd.Command.
+func (c *removeFirewallRuleCommand) SetFlags(f *gnuflag.FlagSet) {
+	f.BoolVar(&c.controller, "controller", false, "remove the rule from the controller")
+}
+
+// Init implements cmd.Command.
+func (c *removeFirewallRuleCommand) Init(args []string) error {
+	if len(args) == 0 {
+		return errors.New("no firewall rule name specified")
+	}
+	c.name = args[0]
+	return cmd.CheckEmpty(args[1:])
+}
+
+// RemoveFirewallRuleAPI defines the API methods that the remove firewall rule command uses.
+type RemoveFirewallRuleAPI interface {
+	Close() error
+	RemoveFirewallRule(name string, controller bool) error
+}
+
+// Run implements cmd.Command.
+func (c *removeFirewallRuleCommand) Run(_ *cmd.Context) error {
+	client, err := c.newAPIFunc()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	err = client.RemoveFirewallRule(c.name, c.controller)
+	return block.ProcessBlockedError(err, block.BlockRemove)
+}
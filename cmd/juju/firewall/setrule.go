@@ -17,6 +17,7 @@ import (
 	"github.com/juju/juju/apiserver/params"
 	"github.com/juju/juju/cmd/juju/block"
 	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/network"
 )
 
 var setRuleHelpSummary = `
@@ -29,13 +30,27 @@ and a whitelist of allowed ingress subnets.
 The currently supported services are:
 %v
 
+Rules may also be set for arbitrary ports by giving the rule a
+name and specifying the port range with --port. Ingress to any
+ports opened by exposed applications within that range is then
+restricted to the whitelisted subnets. A port range is specified
+as <port>[-<port>][/<protocol>], the protocol defaulting to tcp.
+
+With --controller, a named rule is set for the controller and is
+used as a default by all models it hosts. Controller rules are not
+enforced: a model rule of the same name replaces the controller rule
+within that model. Setting controller rules requires superuser access.
+
 Examples:
     juju set-firewall-rule ssh --whitelist 192.168.1.0/16
     juju set-firewall-rule juju-controller --whitelist 192.168.1.0/16
     juju set-firewall-rule juju-application-offer --whitelist 192.168.1.0/16
+    juju set-firewall-rule prometheus --port 9100-9110 --whitelist 10.0.5.0/24
+    juju set-firewall-rule prometheus --port 9100 --whitelist 10.0.5.0/24 --controller
 
 See also: 
-    list-firewall-rules`
+    list-firewall-rules
+    remove-firewall-rule`
 
 // NewSetFirewallRuleCommand returns a command to set firewall rules.
 func NewSetFirewallRuleCommand() cmd.Command {
@@ -56,8 +71,11 @@ type setFirewallRuleCommand struct {
 	modelcmd.IAASOnlyCommand
 	service        string
 	whitelistValue string
+	portValue      string
+	controller     bool
 
 	whiteList  []string
+	portRange  *network.PortRange
 	newAPIFunc func() (SetFirewallRuleAPI, error)
 }
 
@@ -70,7 +88,7 @@ func (c *setFirewallRuleCommand) Info() *cmd.Info {
 	}
 	return &cmd.Info{
 		Name:    "set-firewall-rule",
-		Args:    "<service-name>, --whitelist <cidr>[,<cidr>...]",
+		Args:    "<service-name>|<rule-name> --whitelist <cidr>[,<cidr>...] [--port <port-range>]",
 		Purpose: setRuleHelpSummary,
 		Doc:     fmt.Sprintf(setRuleHelpDetails, strings.Join(supportedRules, "\n")),
 	}
@@ -79,6 +97,8 @@ func (c *setFirewallRuleCommand) Info() *cmd.Info {
 // SetFlags implements cmd.Command.
 func (c *setFirewallRuleCommand) SetFlags(f *gnuflag.FlagSet) {
 	f.StringVar(&c.whitelistValue, "whitelist", "", "list of subnets to whitelist")
+	f.StringVar(&c.portValue, "port", "", "port range for a named rule")
+	f.BoolVar(&c.controller, "controller", false, "set a named rule for all models on the controller")
 }
 
 // Init implements cmd.Command.
@@ -91,6 +111,20 @@ func (c *setFirewallRuleCommand) Init(args []string) (err error) {
 		if err := c.parseCIDRs(&c.whiteList, c.whitelistValue); err != nil {
 			return errors.Annotate(err, "invalid white-list subnet")
 		}
+		if c.portValue == "" {
+			if c.controller {
+				return errors.New("--controller may only be used with --port")
+			}
+			return nil
+		}
+		if err := params.KnownServiceValue(c.service).Validate(); err == nil {
+			return errors.Errorf("cannot specify a port range for well known service %q", c.service)
+		}
+		portRange, err := network.ParsePortRange(c.portValue)
+		if err != nil {
+			return errors.Annotate(err, "invalid port range")
+		}
+		c.portRange = &portRange
 		return nil
 	}
 	if len(args) == 0 {
@@ -118,6 +152,7 @@ func (c *setFirewallRuleCommand) parseCIDRs(cidrs *[]string, value string) error
 type SetFirewallRuleAPI interface {
 	Close() error
 	SetFirewallRule(service string, whiteListCidrs []string) error
+	SetPortFirewallRule(name string, portRange network.PortRange, whiteListCidrs []string, controller bool) error
 }
 
 func (c *setFirewallRuleCommand) Run(_ *cmd.Context) error {
@@ -126,6 +161,10 @@ func (c *setFirewallRuleCommand) Run(_ *cmd.Context) error {
 		return err
 	}
 	defer client.Close()
-	err = client.SetFirewallRule(c.service, c.whiteList)
+	if c.portRange != nil {
+		err = client.SetPortFirewallRule(c.service, *c.portRange, c.whiteList, c.controller)
+	} else {
+		err = client.SetFirewallRule(c.service, c.whiteList)
+	}
 	return block.ProcessBlockedError(err, block.BlockChange)
 }
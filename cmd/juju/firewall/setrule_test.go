@@ -13,6 +13,7 @@ import (
 
 	"github.com/juju/juju/apiserver/params"
 	"github.com/juju/juju/cmd/juju/firewall"
+	"github.com/juju/juju/network"
 )
 
 type SetRuleSuite struct {
@@ -51,6 +52,42 @@ func (s *SetRuleSuite) TestSetRule(c *gc.C) {
 	})
 }
 
+func (s *SetRuleSuite) TestSetPortRule(c *gc.C) {
+	_, err := s.runSetRule(c, "--whitelist", "10.0.5.0/24", "--port", "9100-9110", "prometheus")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(s.mockAPI.rule, jc.DeepEquals, params.FirewallRule{
+		Name:           "prometheus",
+		PortRange:      &params.PortRange{FromPort: 9100, ToPort: 9110, Protocol: "tcp"},
+		WhitelistCIDRS: []string{"10.0.5.0/24"},
+	})
+}
+
+func (s *SetRuleSuite) TestSetControllerPortRule(c *gc.C) {
+	_, err := s.runSetRule(c, "--whitelist", "10.0.5.0/24", "--port", "53/udp", "--controller", "dns")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(s.mockAPI.rule, jc.DeepEquals, params.FirewallRule{
+		Name:           "dns",
+		PortRange:      &params.PortRange{FromPort: 53, ToPort: 53, Protocol: "udp"},
+		Controller:     true,
+		WhitelistCIDRS: []string{"10.0.5.0/24"},
+	})
+}
+
+func (s *SetRuleSuite) TestInitInvalidPort(c *gc.C) {
+	_, err := s.runSetRule(c, "--whitelist", "10.0.5.0/24", "--port", "foo", "prometheus")
+	c.Assert(err, gc.ErrorMatches, `invalid port range: .*`)
+}
+
+func (s *SetRuleSuite) TestInitPortForWellKnownService(c *gc.C) {
+	_, err := s.runSetRule(c, "--whitelist", "10.0.5.0/24", "--port", "22", "ssh")
+	c.Assert(err, gc.ErrorMatches, `cannot specify a port range for well known service "ssh"`)
+}
+
+func (s *SetRuleSuite) TestInitControllerWithoutPort(c *gc.C) {
+	_, err := s.runSetRule(c, "--whitelist", "10.0.5.0/24", "--controller", "ssh")
+	c.Assert(err, gc.ErrorMatches, `--controller may only be used with --port`)
+}
+
 func (s *SetRuleSuite) TestSetError(c *gc.C) {
 	s.mockAPI.err = errors.New("fail")
 	_, err := s.runSetRule(c, "ssh", "--whitelist", "10.0.0.0/8")
@@ -80,3 +117,17 @@ func (s *mockSetRuleAPI) SetFirewallRule(service string, whiteListCidrs []string
 	}
 	return nil
 }
+
+func (s *mockSetRuleAPI) SetPortFirewallRule(name string, portRange network.PortRange, whiteListCidrs []string, controller bool) error {
+	if s.err != nil {
+		return s.err
+	}
+	paramsPortRange := params.FromNetworkPortRange(portRange)
+	s.rule = params.FirewallRule{
+		Name:           name,
+		PortRange:      &paramsPortRange,
+		Controller:     controller,
+		WhitelistCIDRS: whiteListCidrs,
+	}
+	return nil
+}
@@ -0,0 +1,496 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package model
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"sort"
+
+	"github.com/juju/cmd"
+	"github.com/juju/description"
+	"github.com/juju/errors"
+	"gopkg.in/juju/charm.v6"
+	csparams "gopkg.in/juju/charmrepo.v3/csclient/params"
+	"gopkg.in/juju/names.v2"
+	"gopkg.in/yaml.v2"
+
+	"github.com/juju/juju/api"
+	"github.com/juju/juju/api/application"
+	"github.com/juju/juju/api/applicationoffers"
+	"github.com/juju/juju/api/base"
+	"github.com/juju/juju/api/modelmanager"
+	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/charmstore"
+	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/constraints"
+	"github.com/juju/juju/core/crossmodel"
+	coremigration "github.com/juju/juju/core/migration"
+	"github.com/juju/juju/environs/config"
+	"github.com/juju/juju/instance"
+	"github.com/juju/juju/jujuclient"
+	"github.com/juju/juju/storage"
+)
+
+const cloneModelCommandDoc = `
+clone-model creates a new model with the same applications, charm and
+application config, relations, constraints and offers as an existing
+model in the same controller.
+
+The new model uses the cloud, region and credential of the source
+model. Units are added to freshly provisioned machines; no machines,
+unit data, storage contents or resources are copied from the source
+model. Relations to applications in other models are not cloned.
+
+Cloning a model requires admin access to the source model, and
+permission to add models to the controller.
+
+Examples:
+
+    juju clone-model production staging
+    juju clone-model -c mycontroller admin/production staging
+
+See also:
+    add-model
+    export-model
+`
+
+// NewCloneCommand returns a command to clone a model.
+func NewCloneCommand() cmd.Command {
+	return modelcmd.WrapController(&cloneCommand{})
+}
+
+// cloneCommand creates a new model from the applications, relations,
+// constraints and offers of an existing model.
+type cloneCommand struct {
+	modelcmd.ControllerCommandBase
+
+	api       CloneModelAPI
+	sourceAPI CloneSourceAPI
+	targetAPI CloneTargetAPI
+
+	source  string
+	newName string
+}
+
+// CloneModelAPI defines the controller API methods used by the
+// clone-model command.
+type CloneModelAPI interface {
+	Close() error
+	ExportModel(names.ModelTag) (coremigration.SerializedModel, error)
+	CreateModel(name, owner, cloud, cloudRegion string, cloudCredential names.CloudCredentialTag, config map[string]interface{}) (base.ModelInfo, error)
+	ListOffers(...crossmodel.ApplicationOfferFilter) ([]*crossmodel.ApplicationOfferDetails, error)
+	Offer(modelUUID, application string, endpoints []string, offerName string, desc string) ([]params.ErrorResult, error)
+}
+
+// CloneSourceAPI defines the API methods used by the clone-model
+// command to read from the source model.
+type CloneSourceAPI interface {
+	Close() error
+	OpenCharm(*charm.URL) (io.ReadCloser, error)
+}
+
+// CloneTargetAPI defines the API methods used by the clone-model
+// command to populate the new model.
+type CloneTargetAPI interface {
+	Close() error
+	AddCharm(*charm.URL, csparams.Channel) error
+	AddLocalCharm(*charm.URL, charm.Charm) (*charm.URL, error)
+	Deploy(application.DeployArgs) error
+	AddRelation(endpoints, viaCIDRs []string) (*params.AddRelationResults, error)
+	SetModelConstraints(constraints.Value) error
+}
+
+// Info implements Command.Info.
+func (c *cloneCommand) Info() *cmd.Info {
+	return &cmd.Info{
+		Name:    "clone-model",
+		Args:    "<source model name> <new model name>",
+		Purpose: "Creates a new model with the same applications as an existing model.",
+		Doc:     cloneModelCommandDoc,
+	}
+}
+
+// Init implements Command.Init.
+func (c *cloneCommand) Init(args []string) error {
+	switch len(args) {
+	case 0:
+		return errors.New("source model name is required")
+	case 1:
+		return errors.New("new model name is required")
+	}
+	c.source, c.newName = args[0], args[1]
+	if !names.IsValidModelName(c.newName) {
+		return errors.Errorf("%q is not a valid name: model names may only contain lowercase letters, digits and hyphens", c.newName)
+	}
+	return cmd.CheckEmpty(args[2:])
+}
+
+func (c *cloneCommand) getAPI() (CloneModelAPI, error) {
+	if c.api != nil {
+		return c.api, nil
+	}
+	root, err := c.NewAPIRoot()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return cloneControllerShim{
+		Client:       modelmanager.NewClient(root),
+		offersClient: applicationoffers.NewClient(root),
+	}, nil
+}
+
+func (c *cloneCommand) getSourceAPI() (CloneSourceAPI, error) {
+	if c.sourceAPI != nil {
+		return c.sourceAPI, nil
+	}
+	root, err := c.NewModelAPIRoot(c.source)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return root.Client(), nil
+}
+
+func (c *cloneCommand) getTargetAPI() (CloneTargetAPI, error) {
+	if c.targetAPI != nil {
+		return c.targetAPI, nil
+	}
+	root, err := c.NewModelAPIRoot(c.newName)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return cloneTargetShim{
+		Client:            root.Client(),
+		applicationClient: application.NewClient(root),
+	}, nil
+}
+
+// Run implements Command.Run.
+func (c *cloneCommand) Run(ctx *cmd.Context) error {
+	controllerName, err := c.ControllerName()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	accountDetails, err := c.CurrentAccountDetails()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	uuids, err := c.ModelUUIDs([]string{c.source})
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	client, err := c.getAPI()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer client.Close()
+
+	serialized, err := client.ExportModel(names.NewModelTag(uuids[0]))
+	if err != nil {
+		return errors.Annotatef(err, "exporting model %q", c.source)
+	}
+	source, err := description.Deserialize(serialized.Bytes)
+	if err != nil {
+		return errors.Annotatef(err, "reading model %q", c.source)
+	}
+
+	var credentialTag names.CloudCredentialTag
+	if cred := source.CloudCredential(); cred != nil {
+		credentialTag = names.NewCloudCredentialTag(fmt.Sprintf("%s/%s/%s", cred.Cloud(), cred.Owner(), cred.Name()))
+	}
+	model, err := client.CreateModel(
+		c.newName, accountDetails.User,
+		source.Cloud(), source.CloudRegion(), credentialTag,
+		cloneModelConfig(source.Config()),
+	)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if err := c.ClientStore().UpdateModel(controllerName, c.newName, jujuclient.ModelDetails{
+		ModelUUID: model.UUID,
+		ModelType: model.Type,
+	}); err != nil {
+		return errors.Trace(err)
+	}
+	ctx.Infof("Added '%s' model", c.newName)
+
+	if err := c.populate(ctx, client, source, model.UUID); err != nil {
+		return errors.Annotatef(err, "populating model %q", c.newName)
+	}
+	ctx.Infof("Cloned model '%s' to '%s'", c.source, c.newName)
+	return nil
+}
+
+// populate sets the constraints of the newly created model, and adds
+// the charms, applications, relations and offers of the source model.
+func (c *cloneCommand) populate(ctx *cmd.Context, client CloneModelAPI, source description.Model, modelUUID string) error {
+	target, err := c.getTargetAPI()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer target.Close()
+
+	// The model config is set when the model is created, but the
+	// constraints must also be set before anything is deployed, so
+	// that the machines are provisioned with them.
+	if err := target.SetModelConstraints(cloneConstraints(source.Constraints())); err != nil {
+		return errors.Annotate(err, "setting model constraints")
+	}
+
+	apps := source.Applications()
+	sort.Slice(apps, func(i, j int) bool {
+		return apps[i].Name() < apps[j].Name()
+	})
+	charmURLs, err := c.addCharms(target, apps)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	appNames := make(map[string]bool)
+	for _, app := range apps {
+		args, err := cloneDeployArgs(app, charmURLs[app.CharmURL()])
+		if err != nil {
+			return errors.Annotatef(err, "application %q", app.Name())
+		}
+		ctx.Verbosef("deploying %s", app.Name())
+		if err := target.Deploy(args); err != nil {
+			return errors.Annotatef(err, "deploying %q", app.Name())
+		}
+		appNames[app.Name()] = true
+	}
+
+	for _, rel := range source.Relations() {
+		endpoints := rel.Endpoints()
+		if len(endpoints) != 2 {
+			// Peer relations are created along with the application.
+			continue
+		}
+		var eps []string
+		for _, ep := range endpoints {
+			if !appNames[ep.ApplicationName()] {
+				// Relations with remote applications are not cloned.
+				break
+			}
+			eps = append(eps, ep.ApplicationName()+":"+ep.Name())
+		}
+		if len(eps) != 2 {
+			continue
+		}
+		if _, err := target.AddRelation(eps, nil); err != nil {
+			return errors.Annotatef(err, "adding relation %q", rel.Key())
+		}
+	}
+
+	sourceName, _ := source.Config()[config.NameKey].(string)
+	offers, err := client.ListOffers(crossmodel.ApplicationOfferFilter{
+		OwnerName: source.Owner().Id(),
+		ModelName: sourceName,
+	})
+	if err != nil {
+		return errors.Annotate(err, "listing offers")
+	}
+	for _, offer := range offers {
+		endpoints := make([]string, len(offer.Endpoints))
+		for i, ep := range offer.Endpoints {
+			endpoints[i] = ep.Name
+		}
+		results, err := client.Offer(modelUUID, offer.ApplicationName, endpoints, offer.OfferName, offer.ApplicationDescription)
+		if err != nil {
+			return errors.Annotatef(err, "offering %q", offer.OfferName)
+		}
+		if err := (params.ErrorResults{Results: results}).Combine(); err != nil {
+			return errors.Annotatef(err, "offering %q", offer.OfferName)
+		}
+	}
+	return nil
+}
+
+// addCharms adds the charms used by the given applications to the new
+// model, returning the URLs of the added charms keyed by their URL in
+// the source model. Local charms are copied from the source model, and
+// so may be given a new revision.
+func (c *cloneCommand) addCharms(target CloneTargetAPI, apps []description.Application) (map[string]*charm.URL, error) {
+	result := make(map[string]*charm.URL)
+	var source CloneSourceAPI
+	defer func() {
+		if source != nil {
+			source.Close()
+		}
+	}()
+	for _, app := range apps {
+		if _, ok := result[app.CharmURL()]; ok {
+			continue
+		}
+		curl, err := charm.ParseURL(app.CharmURL())
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		if curl.Schema != "local" {
+			if err := target.AddCharm(curl, csparams.Channel(app.Channel())); err != nil {
+				return nil, errors.Annotatef(err, "adding charm %q", curl)
+			}
+			result[app.CharmURL()] = curl
+			continue
+		}
+		if source == nil {
+			if source, err = c.getSourceAPI(); err != nil {
+				return nil, errors.Trace(err)
+			}
+		}
+		ch, err := downloadCharm(source, curl)
+		if err != nil {
+			return nil, errors.Annotatef(err, "downloading charm %q", curl)
+		}
+		newURL, err := target.AddLocalCharm(curl, ch)
+		if err != nil {
+			return nil, errors.Annotatef(err, "adding charm %q", curl)
+		}
+		result[app.CharmURL()] = newURL
+	}
+	return result, nil
+}
+
+func downloadCharm(source CloneSourceAPI, curl *charm.URL) (charm.Charm, error) {
+	r, err := source.OpenCharm(curl)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	defer r.Close()
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	ch, err := charm.ReadCharmArchiveBytes(data)
+	return ch, errors.Trace(err)
+}
+
+// cloneDeployArgs returns the arguments used to deploy a copy of the
+// given application, using the specified charm.
+func cloneDeployArgs(app description.Application, curl *charm.URL) (application.DeployArgs, error) {
+	args := application.DeployArgs{
+		CharmID: charmstore.CharmID{
+			URL:     curl,
+			Channel: csparams.Channel(app.Channel()),
+		},
+		ApplicationName:  app.Name(),
+		Series:           app.Series(),
+		Cons:             cloneConstraints(app.Constraints()),
+		EndpointBindings: app.EndpointBindings(),
+	}
+	if !app.Subordinate() {
+		args.NumUnits = len(app.Units())
+	}
+	if charmConfig := app.CharmConfig(); len(charmConfig) > 0 {
+		data, err := yaml.Marshal(map[string]interface{}{app.Name(): charmConfig})
+		if err != nil {
+			return application.DeployArgs{}, errors.Annotate(err, "marshalling charm config")
+		}
+		args.ConfigYAML = string(data)
+	}
+	if appConfig := app.ApplicationConfig(); len(appConfig) > 0 {
+		args.Config = make(map[string]string)
+		for k, v := range appConfig {
+			args.Config[k] = fmt.Sprint(v)
+		}
+	}
+	if storageCons := app.StorageConstraints(); len(storageCons) > 0 {
+		args.Storage = make(map[string]storage.Constraints)
+		for name, cons := range storageCons {
+			args.Storage[name] = storage.Constraints{
+				Pool:  cons.Pool(),
+				Size:  cons.Size(),
+				Count: cons.Count(),
+			}
+		}
+	}
+	return args, nil
+}
+
+// cloneModelConfig returns the source model config, without the
+// attributes which identify the source model.
+func cloneModelConfig(attrs map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{})
+	for k, v := range attrs {
+		switch k {
+		case config.NameKey, config.UUIDKey, config.TypeKey, config.AgentVersionKey:
+			continue
+		}
+		result[k] = v
+	}
+	return result
+}
+
+func cloneConstraints(cons description.Constraints) constraints.Value {
+	var result constraints.Value
+	if cons == nil {
+		return result
+	}
+	if arch := cons.Architecture(); arch != "" {
+		result.Arch = &arch
+	}
+	if container := instance.ContainerType(cons.Container()); container != "" {
+		result.Container = &container
+	}
+	if cores := cons.CpuCores(); cores != 0 {
+		result.CpuCores = &cores
+	}
+	if power := cons.CpuPower(); power != 0 {
+		result.CpuPower = &power
+	}
+	if inst := cons.InstanceType(); inst != "" {
+		result.InstanceType = &inst
+	}
+	if mem := cons.Memory(); mem != 0 {
+		result.Mem = &mem
+	}
+	if disk := cons.RootDisk(); disk != 0 {
+		result.RootDisk = &disk
+	}
+	if spaces := cons.Spaces(); len(spaces) > 0 {
+		result.Spaces = &spaces
+	}
+	if tags := cons.Tags(); len(tags) > 0 {
+		result.Tags = &tags
+	}
+	if virt := cons.VirtType(); virt != "" {
+		result.VirtType = &virt
+	}
+	return result
+}
+
+// cloneControllerShim combines the controller API clients used by
+// the clone-model command.
+type cloneControllerShim struct {
+	*modelmanager.Client
+	offersClient *applicationoffers.Client
+}
+
+// ListOffers is part of the CloneModelAPI interface.
+func (s cloneControllerShim) ListOffers(filters ...crossmodel.ApplicationOfferFilter) ([]*crossmodel.ApplicationOfferDetails, error) {
+	return s.offersClient.ListOffers(filters...)
+}
+
+// Offer is part of the CloneModelAPI interface.
+func (s cloneControllerShim) Offer(modelUUID, application string, endpoints []string, offerName string, desc string) ([]params.ErrorResult, error) {
+	return s.offersClient.Offer(modelUUID, application, endpoints, offerName, desc)
+}
+
+// cloneTargetShim combines the model API clients used to populate
+// the new model.
+type cloneTargetShim struct {
+	*api.Client
+	applicationClient *application.Client
+}
+
+// Deploy is part of the CloneTargetAPI interface.
+func (s cloneTargetShim) Deploy(args application.DeployArgs) error {
+	return s.applicationClient.Deploy(args)
+}
+
+// AddRelation is part of the CloneTargetAPI interface.
+func (s cloneTargetShim) AddRelation(endpoints, viaCIDRs []string) (*params.AddRelationResults, error) {
+	return s.applicationClient.AddRelation(endpoints, viaCIDRs)
+}
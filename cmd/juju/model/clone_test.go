@@ -0,0 +1,304 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package model_test
+
+import (
+	"github.com/juju/cmd"
+	"github.com/juju/cmd/cmdtesting"
+	"github.com/juju/description"
+	"github.com/juju/errors"
+	gitjujutesting "github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+	"gopkg.in/juju/charm.v6"
+	csparams "gopkg.in/juju/charmrepo.v3/csclient/params"
+	"gopkg.in/juju/names.v2"
+
+	"github.com/juju/juju/api/application"
+	"github.com/juju/juju/api/base"
+	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/charmstore"
+	"github.com/juju/juju/cmd/juju/model"
+	"github.com/juju/juju/constraints"
+	"github.com/juju/juju/core/crossmodel"
+	coremigration "github.com/juju/juju/core/migration"
+	coremodel "github.com/juju/juju/core/model"
+	"github.com/juju/juju/jujuclient"
+	"github.com/juju/juju/jujuclient/jujuclienttesting"
+	"github.com/juju/juju/testing"
+)
+
+const (
+	cloneSourceUUID = "fc4c4d6e-7c4a-4b44-8d3c-0e1b4e1b8f53"
+	cloneTargetUUID = "a8bbc26c-3d8f-4d9b-9a3e-7b3d5c6e2a11"
+)
+
+type CloneSuite struct {
+	testing.FakeJujuXDGDataHomeSuite
+	api    *fakeCloneAPI
+	target *fakeCloneTargetAPI
+	store  *jujuclient.MemStore
+}
+
+var _ = gc.Suite(&CloneSuite{})
+
+func (s *CloneSuite) SetUpTest(c *gc.C) {
+	s.FakeJujuXDGDataHomeSuite.SetUpTest(c)
+	s.store = jujuclienttesting.MinimalStore()
+	s.store.Models["arthur"].Models["king/sword"] = jujuclient.ModelDetails{
+		ModelUUID: cloneSourceUUID,
+		ModelType: coremodel.IAAS,
+	}
+	s.api = &fakeCloneAPI{
+		model: cloneSourceModel(c),
+		offers: []*crossmodel.ApplicationOfferDetails{{
+			OfferName:              "hosted-mysql",
+			ApplicationName:        "mysql",
+			ApplicationDescription: "a database",
+			Endpoints:              []charm.Relation{{Name: "server"}},
+		}},
+	}
+	s.target = &fakeCloneTargetAPI{}
+}
+
+func cloneSourceModel(c *gc.C) []byte {
+	m := description.NewModel(description.ModelArgs{
+		Type:        "iaas",
+		Cloud:       "dummy",
+		CloudRegion: "dummy-region",
+		Owner:       names.NewUserTag("king"),
+		Config: map[string]interface{}{
+			"name":           "sword",
+			"uuid":           cloneSourceUUID,
+			"type":           "dummy",
+			"agent-version":  "2.4.0",
+			"logging-config": "<root>=DEBUG",
+		},
+	})
+	m.SetCloudCredential(description.CloudCredentialArgs{
+		Owner:    names.NewUserTag("king"),
+		Cloud:    names.NewCloudTag("dummy"),
+		Name:     "default",
+		AuthType: "userpass",
+	})
+	m.SetConstraints(description.ConstraintsArgs{Memory: 2048})
+
+	mysql := m.AddApplication(description.ApplicationArgs{
+		Tag:         names.NewApplicationTag("mysql"),
+		Series:      "xenial",
+		CharmURL:    "cs:xenial/mysql-58",
+		Channel:     "stable",
+		CharmConfig: map[string]interface{}{"dataset-size": "50%"},
+		ApplicationConfig: map[string]interface{}{
+			"trust": true,
+		},
+		EndpointBindings: map[string]string{"server": "db"},
+	})
+	mysql.SetConstraints(description.ConstraintsArgs{CpuCores: 4})
+	mysql.AddUnit(description.UnitArgs{Tag: names.NewUnitTag("mysql/0")})
+	mysql.AddUnit(description.UnitArgs{Tag: names.NewUnitTag("mysql/1")})
+
+	wordpress := m.AddApplication(description.ApplicationArgs{
+		Tag:      names.NewApplicationTag("wordpress"),
+		Series:   "xenial",
+		CharmURL: "cs:xenial/wordpress-3",
+	})
+	wordpress.AddUnit(description.UnitArgs{Tag: names.NewUnitTag("wordpress/0")})
+
+	m.AddApplication(description.ApplicationArgs{
+		Tag:         names.NewApplicationTag("logging"),
+		Series:      "xenial",
+		Subordinate: true,
+		CharmURL:    "cs:xenial/logging-1",
+	})
+
+	rel := m.AddRelation(description.RelationArgs{Id: 1, Key: "wordpress:db mysql:server"})
+	rel.AddEndpoint(description.EndpointArgs{ApplicationName: "wordpress", Name: "db", Role: "requirer"})
+	rel.AddEndpoint(description.EndpointArgs{ApplicationName: "mysql", Name: "server", Role: "provider"})
+	peer := m.AddRelation(description.RelationArgs{Id: 2, Key: "mysql:cluster"})
+	peer.AddEndpoint(description.EndpointArgs{ApplicationName: "mysql", Name: "cluster", Role: "peer"})
+	remote := m.AddRelation(description.RelationArgs{Id: 3, Key: "wordpress:cache memcached:cache"})
+	remote.AddEndpoint(description.EndpointArgs{ApplicationName: "wordpress", Name: "cache", Role: "requirer"})
+	remote.AddEndpoint(description.EndpointArgs{ApplicationName: "memcached", Name: "cache", Role: "provider"})
+
+	bytes, err := description.Serialize(m)
+	c.Assert(err, jc.ErrorIsNil)
+	return bytes
+}
+
+func (s *CloneSuite) run(c *gc.C, args ...string) (*cmd.Context, error) {
+	command := model.NewCloneCommandForTest(s.api, nil, s.target, s.store)
+	return cmdtesting.RunCommand(c, command, args...)
+}
+
+func (s *CloneSuite) TestInitErrors(c *gc.C) {
+	for i, test := range []struct {
+		args []string
+		err  string
+	}{{
+		err: "source model name is required",
+	}, {
+		args: []string{"sword"},
+		err:  "new model name is required",
+	}, {
+		args: []string{"sword", "Shield"},
+		err:  `"Shield" is not a valid name: model names may only contain lowercase letters, digits and hyphens`,
+	}, {
+		args: []string{"sword", "shield", "extra"},
+		err:  `unrecognized args: \["extra"\]`,
+	}} {
+		c.Logf("test %d", i)
+		_, err := s.run(c, test.args...)
+		c.Check(err, gc.ErrorMatches, test.err)
+	}
+}
+
+func (s *CloneSuite) TestClone(c *gc.C) {
+	ctx, err := s.run(c, "sword", "shield")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(cmdtesting.Stderr(ctx), gc.Equals, `
+Added 'shield' model
+Cloned model 'sword' to 'shield'
+`[1:])
+
+	s.api.CheckCalls(c, []gitjujutesting.StubCall{
+		{"ExportModel", []interface{}{names.NewModelTag(cloneSourceUUID)}},
+		{"CreateModel", []interface{}{
+			"shield", "king", "dummy", "dummy-region",
+			names.NewCloudCredentialTag("dummy/king/default"),
+			map[string]interface{}{"logging-config": "<root>=DEBUG"},
+		}},
+		{"ListOffers", []interface{}{[]crossmodel.ApplicationOfferFilter{{
+			OwnerName: "king",
+			ModelName: "sword",
+		}}}},
+		{"Offer", []interface{}{cloneTargetUUID, "mysql", []string{"server"}, "hosted-mysql", "a database"}},
+		{"Close", nil},
+	})
+
+	details, err := s.store.ModelByName("arthur", "king/shield")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(details.ModelUUID, gc.Equals, cloneTargetUUID)
+
+	mem := uint64(2048)
+	cores := uint64(4)
+	s.target.CheckCalls(c, []gitjujutesting.StubCall{
+		{"SetModelConstraints", []interface{}{constraints.Value{Mem: &mem}}},
+		{"AddCharm", []interface{}{charm.MustParseURL("cs:xenial/logging-1"), csparams.Channel("")}},
+		{"AddCharm", []interface{}{charm.MustParseURL("cs:xenial/mysql-58"), csparams.Channel("stable")}},
+		{"AddCharm", []interface{}{charm.MustParseURL("cs:xenial/wordpress-3"), csparams.Channel("")}},
+		{"Deploy", []interface{}{application.DeployArgs{
+			CharmID:         charmstore.CharmID{URL: charm.MustParseURL("cs:xenial/logging-1")},
+			ApplicationName: "logging",
+			Series:          "xenial",
+		}}},
+		{"Deploy", []interface{}{application.DeployArgs{
+			CharmID: charmstore.CharmID{
+				URL:     charm.MustParseURL("cs:xenial/mysql-58"),
+				Channel: csparams.Channel("stable"),
+			},
+			ApplicationName:  "mysql",
+			Series:           "xenial",
+			NumUnits:         2,
+			ConfigYAML:       "mysql:\n  dataset-size: 50%\n",
+			Config:           map[string]string{"trust": "true"},
+			Cons:             constraints.Value{CpuCores: &cores},
+			EndpointBindings: map[string]string{"server": "db"},
+		}}},
+		{"Deploy", []interface{}{application.DeployArgs{
+			CharmID:         charmstore.CharmID{URL: charm.MustParseURL("cs:xenial/wordpress-3")},
+			ApplicationName: "wordpress",
+			Series:          "xenial",
+			NumUnits:        1,
+		}}},
+		{"AddRelation", []interface{}{[]string{"wordpress:db", "mysql:server"}, []string(nil)}},
+		{"Close", nil},
+	})
+}
+
+func (s *CloneSuite) TestCloneDeployError(c *gc.C) {
+	s.target.SetErrors(nil, nil, nil, nil, errors.New("boom"))
+	_, err := s.run(c, "sword", "shield")
+	c.Assert(err, gc.ErrorMatches, `populating model "shield": deploying "logging": boom`)
+}
+
+func (s *CloneSuite) TestCloneCreateModelError(c *gc.C) {
+	s.api.SetErrors(nil, errors.New("no add-model access"))
+	_, err := s.run(c, "sword", "shield")
+	c.Assert(err, gc.ErrorMatches, "no add-model access")
+	s.target.CheckNoCalls(c)
+}
+
+type fakeCloneAPI struct {
+	gitjujutesting.Stub
+	model  []byte
+	offers []*crossmodel.ApplicationOfferDetails
+}
+
+func (f *fakeCloneAPI) Close() error {
+	f.MethodCall(f, "Close")
+	return f.NextErr()
+}
+
+func (f *fakeCloneAPI) ExportModel(tag names.ModelTag) (coremigration.SerializedModel, error) {
+	f.MethodCall(f, "ExportModel", tag)
+	return coremigration.SerializedModel{Bytes: f.model}, f.NextErr()
+}
+
+func (f *fakeCloneAPI) CreateModel(
+	name, owner, cloud, cloudRegion string,
+	cloudCredential names.CloudCredentialTag,
+	config map[string]interface{},
+) (base.ModelInfo, error) {
+	f.MethodCall(f, "CreateModel", name, owner, cloud, cloudRegion, cloudCredential, config)
+	return base.ModelInfo{
+		Name: name,
+		UUID: cloneTargetUUID,
+		Type: coremodel.IAAS,
+	}, f.NextErr()
+}
+
+func (f *fakeCloneAPI) ListOffers(filters ...crossmodel.ApplicationOfferFilter) ([]*crossmodel.ApplicationOfferDetails, error) {
+	f.MethodCall(f, "ListOffers", filters)
+	return f.offers, f.NextErr()
+}
+
+func (f *fakeCloneAPI) Offer(modelUUID, application string, endpoints []string, offerName string, desc string) ([]params.ErrorResult, error) {
+	f.MethodCall(f, "Offer", modelUUID, application, endpoints, offerName, desc)
+	return []params.ErrorResult{{}}, f.NextErr()
+}
+
+type fakeCloneTargetAPI struct {
+	gitjujutesting.Stub
+}
+
+func (f *fakeCloneTargetAPI) Close() error {
+	f.MethodCall(f, "Close")
+	return f.NextErr()
+}
+
+func (f *fakeCloneTargetAPI) AddCharm(curl *charm.URL, channel csparams.Channel) error {
+	f.MethodCall(f, "AddCharm", curl, channel)
+	return f.NextErr()
+}
+
+func (f *fakeCloneTargetAPI) AddLocalCharm(curl *charm.URL, ch charm.Charm) (*charm.URL, error) {
+	f.MethodCall(f, "AddLocalCharm", curl, ch)
+	return curl, f.NextErr()
+}
+
+func (f *fakeCloneTargetAPI) Deploy(args application.DeployArgs) error {
+	f.MethodCall(f, "Deploy", args)
+	return f.NextErr()
+}
+
+func (f *fakeCloneTargetAPI) AddRelation(endpoints, viaCIDRs []string) (*params.AddRelationResults, error) {
+	f.MethodCall(f, "AddRelation", endpoints, viaCIDRs)
+	return &params.AddRelationResults{}, f.NextErr()
+}
+
+func (f *fakeCloneTargetAPI) SetModelConstraints(cons constraints.Value) error {
+	f.MethodCall(f, "SetModelConstraints", cons)
+	return f.NextErr()
+}
@@ -131,3 +131,14 @@ func NewModelGetConstraintsCommandForTest() cmd.Command {
 }
 
 var GetBudgetAPIClient = &getBudgetAPIClient
+
+// NewCloneCommandForTest returns a CloneCommand with the apis provided as specified.
+func NewCloneCommandForTest(api CloneModelAPI, sourceAPI CloneSourceAPI, targetAPI CloneTargetAPI, store jujuclient.ClientStore) cmd.Command {
+	cmd := &cloneCommand{
+		api:       api,
+		sourceAPI: sourceAPI,
+		targetAPI: targetAPI,
+	}
+	cmd.SetClientStore(store)
+	return modelcmd.WrapController(cmd)
+}
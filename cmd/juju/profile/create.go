@@ -0,0 +1,76 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package profile
+
+import (
+	"github.com/juju/cmd"
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+
+	"github.com/juju/juju/jujuclient"
+)
+
+const createDoc = `
+Create a new, empty, client profile. The profile has its own controllers,
+accounts, credentials and models. By default it also has its own cloud
+definitions; use --share-clouds to use those of the default profile.
+
+The new profile does not become current; use "juju profile switch".
+
+Examples:
+
+    juju profile create work
+    juju profile create staging --share-clouds
+
+See also:
+    profile list
+    profile switch
+`
+
+type createCommand struct {
+	cmd.CommandBase
+	name        string
+	shareClouds bool
+}
+
+func newCreateCommand() cmd.Command {
+	return &createCommand{}
+}
+
+// Info implements Command.Info.
+func (c *createCommand) Info() *cmd.Info {
+	return &cmd.Info{
+		Name:    "create",
+		Args:    "<profile name>",
+		Purpose: "Creates a client profile.",
+		Doc:     createDoc,
+	}
+}
+
+// SetFlags implements Command.SetFlags.
+func (c *createCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.CommandBase.SetFlags(f)
+	f.BoolVar(&c.shareClouds, "share-clouds", false, "Use the cloud definitions of the default profile")
+}
+
+// Init implements Command.Init.
+func (c *createCommand) Init(args []string) error {
+	if len(args) == 0 {
+		return errors.New("profile name required")
+	}
+	c.name = args[0]
+	if err := jujuclient.ValidateProfileName(c.name); err != nil {
+		return errors.Trace(err)
+	}
+	return cmd.CheckEmpty(args[1:])
+}
+
+// Run implements Command.Run.
+func (c *createCommand) Run(ctx *cmd.Context) error {
+	if err := jujuclient.CreateProfile(c.name, c.shareClouds); err != nil {
+		return errors.Trace(err)
+	}
+	ctx.Infof("Created profile %q", c.name)
+	return nil
+}
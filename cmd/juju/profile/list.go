@@ -0,0 +1,103 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package profile
+
+import (
+	"io"
+
+	"github.com/juju/cmd"
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+
+	"github.com/juju/juju/cmd/output"
+	"github.com/juju/juju/jujuclient"
+)
+
+const listDoc = `
+List the client profiles. The current profile is marked with an asterisk.
+
+Examples:
+
+    juju profile list
+    juju profile list --format yaml
+
+See also:
+    profile create
+    profile switch
+`
+
+// profileInfo holds the details of a profile for output.
+type profileInfo struct {
+	ShareClouds bool `yaml:"share-clouds" json:"share-clouds"`
+	Current     bool `yaml:"current,omitempty" json:"current,omitempty"`
+}
+
+type listCommand struct {
+	cmd.CommandBase
+	out cmd.Output
+}
+
+func newListCommand() cmd.Command {
+	return &listCommand{}
+}
+
+// Info implements Command.Info.
+func (c *listCommand) Info() *cmd.Info {
+	return &cmd.Info{
+		Name:    "list",
+		Purpose: "Lists client profiles.",
+		Doc:     listDoc,
+	}
+}
+
+// SetFlags implements Command.SetFlags.
+func (c *listCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.CommandBase.SetFlags(f)
+	c.out.AddFlags(f, "tabular", map[string]cmd.Formatter{
+		"yaml":    cmd.FormatYaml,
+		"json":    cmd.FormatJson,
+		"tabular": formatProfilesTabular,
+	})
+}
+
+// Run implements Command.Run.
+func (c *listCommand) Run(ctx *cmd.Context) error {
+	profiles, err := jujuclient.AllProfiles()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if c.out.Name() == "tabular" {
+		return c.out.Write(ctx, profiles)
+	}
+	result := make(map[string]profileInfo)
+	for _, p := range profiles {
+		result[p.Name] = profileInfo{
+			ShareClouds: p.ShareClouds,
+			Current:     p.Current,
+		}
+	}
+	return c.out.Write(ctx, result)
+}
+
+func formatProfilesTabular(writer io.Writer, value interface{}) error {
+	profiles, ok := value.([]jujuclient.ProfileDetails)
+	if !ok {
+		return errors.Errorf("expected value of type %T, got %T", profiles, value)
+	}
+	tw := output.TabWriter(writer)
+	w := output.Wrapper{tw}
+	w.Println("Profile", "Clouds")
+	for _, p := range profiles {
+		name := p.Name
+		if p.Current {
+			name += "*"
+		}
+		clouds := "own"
+		if p.ShareClouds {
+			clouds = "shared"
+		}
+		w.Println(name, clouds)
+	}
+	return tw.Flush()
+}
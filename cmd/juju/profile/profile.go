@@ -0,0 +1,42 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package profile
+
+import (
+	"github.com/juju/cmd"
+)
+
+var profileDoc = `
+Client profiles hold separate sets of controllers, accounts, credentials
+and current model selections, so that one client may be used against
+unrelated groups of controllers without them interfering. Profiles may
+optionally share the cloud definitions of the default profile.
+
+The profile in use is the current profile set by "juju profile switch",
+or the profile named by the JUJU_PROFILE environment variable if set.
+`
+
+// Command is the top-level command wrapping all client profile
+// functionality.
+type Command struct {
+	cmd.SuperCommand
+}
+
+// NewSuperCommand returns a new profile super-command.
+func NewSuperCommand() *Command {
+	profileCmd := &Command{
+		SuperCommand: *cmd.NewSuperCommand(
+			cmd.SuperCommandParams{
+				Name:        "profile",
+				Doc:         profileDoc,
+				UsagePrefix: "juju",
+				Purpose:     "Manage client profiles.",
+			},
+		),
+	}
+	profileCmd.Register(newListCommand())
+	profileCmd.Register(newSwitchCommand())
+	profileCmd.Register(newCreateCommand())
+	return profileCmd
+}
@@ -0,0 +1,90 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package profile_test
+
+import (
+	"github.com/juju/cmd/cmdtesting"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/cmd/juju/profile"
+	"github.com/juju/juju/juju/osenv"
+	"github.com/juju/juju/testing"
+)
+
+type profileSuite struct {
+	testing.FakeJujuXDGDataHomeSuite
+}
+
+var _ = gc.Suite(&profileSuite{})
+
+func (s *profileSuite) run(c *gc.C, args ...string) (string, string, error) {
+	ctx, err := cmdtesting.RunCommand(c, profile.NewSuperCommand(), args...)
+	return cmdtesting.Stdout(ctx), cmdtesting.Stderr(ctx), err
+}
+
+func (s *profileSuite) TestListDefault(c *gc.C) {
+	stdout, _, err := s.run(c, "list")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(stdout, gc.Equals, ""+
+		"Profile   Clouds\n"+
+		"default*  shared\n")
+}
+
+func (s *profileSuite) TestCreateAndSwitch(c *gc.C) {
+	_, stderr, err := s.run(c, "create", "work")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(stderr, gc.Equals, "Created profile \"work\"\n")
+	_, _, err = s.run(c, "create", "staging", "--share-clouds")
+	c.Assert(err, jc.ErrorIsNil)
+
+	_, stderr, err = s.run(c, "switch", "work")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(stderr, gc.Equals, "Switched to profile \"work\"\n")
+	current, err := osenv.JujuCurrentProfile()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(current, gc.Equals, "work")
+
+	stdout, _, err := s.run(c, "list")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(stdout, gc.Equals, ""+
+		"Profile  Clouds\n"+
+		"default  shared\n"+
+		"staging  shared\n"+
+		"work*    own\n")
+
+	stdout, _, err = s.run(c, "list", "--format", "yaml")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(stdout, gc.Equals, ""+
+		"default:\n"+
+		"  share-clouds: true\n"+
+		"staging:\n"+
+		"  share-clouds: true\n"+
+		"work:\n"+
+		"  share-clouds: false\n"+
+		"  current: true\n")
+}
+
+func (s *profileSuite) TestSwitchUnknown(c *gc.C) {
+	_, _, err := s.run(c, "switch", "work")
+	c.Assert(err, gc.ErrorMatches, `profile "work" not found`)
+}
+
+func (s *profileSuite) TestSwitchEnvironmentOverride(c *gc.C) {
+	_, _, err := s.run(c, "create", "work")
+	c.Assert(err, jc.ErrorIsNil)
+	s.PatchEnvironment(osenv.JujuProfileEnvKey, "default")
+	_, stderr, err := s.run(c, "switch", "work")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(stderr, jc.Contains, `JUJU_PROFILE is set to "default" and overrides the current profile`)
+}
+
+func (s *profileSuite) TestInitErrors(c *gc.C) {
+	_, _, err := s.run(c, "create")
+	c.Assert(err, gc.ErrorMatches, "profile name required")
+	_, _, err = s.run(c, "create", "Bad_Name")
+	c.Assert(err, gc.ErrorMatches, `profile name "Bad_Name" not valid`)
+	_, _, err = s.run(c, "switch", "work", "extra")
+	c.Assert(err, gc.ErrorMatches, `unrecognized args: \["extra"\]`)
+}
@@ -0,0 +1,72 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package profile
+
+import (
+	"os"
+
+	"github.com/juju/cmd"
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/juju/osenv"
+	"github.com/juju/juju/jujuclient"
+)
+
+const switchDoc = `
+Switch to the named client profile. Subsequent commands use the
+controllers, accounts and models of that profile. Use "default" to
+switch back to the default profile.
+
+If the JUJU_PROFILE environment variable is set, it takes precedence
+over the current profile.
+
+Examples:
+
+    juju profile switch work
+    juju profile switch default
+
+See also:
+    profile create
+    profile list
+`
+
+type switchCommand struct {
+	cmd.CommandBase
+	name string
+}
+
+func newSwitchCommand() cmd.Command {
+	return &switchCommand{}
+}
+
+// Info implements Command.Info.
+func (c *switchCommand) Info() *cmd.Info {
+	return &cmd.Info{
+		Name:    "switch",
+		Args:    "<profile name>",
+		Purpose: "Selects the current client profile.",
+		Doc:     switchDoc,
+	}
+}
+
+// Init implements Command.Init.
+func (c *switchCommand) Init(args []string) error {
+	if len(args) == 0 {
+		return errors.New("profile name required")
+	}
+	c.name = args[0]
+	return cmd.CheckEmpty(args[1:])
+}
+
+// Run implements Command.Run.
+func (c *switchCommand) Run(ctx *cmd.Context) error {
+	if err := jujuclient.SetCurrentProfile(c.name); err != nil {
+		return errors.Trace(err)
+	}
+	ctx.Infof("Switched to profile %q", c.name)
+	if env := os.Getenv(osenv.JujuProfileEnvKey); env != "" && env != c.name {
+		ctx.Warningf("%s is set to %q and overrides the current profile", osenv.JujuProfileEnvKey, env)
+	}
+	return nil
+}
@@ -9,6 +9,7 @@ import (
 
 	"gopkg.in/juju/names.v2"
 
+	"github.com/juju/juju/cmd/juju/storage"
 	"github.com/juju/juju/instance"
 	"github.com/juju/juju/status"
 )
@@ -21,6 +22,7 @@ type formattedStatus struct {
 	Offers             map[string]offerStatus             `json:"offers,omitempty" yaml:"offers,omitempty"`
 	Relations          []relationStatus                   `json:"-" yaml:"-"`
 	Controller         *controllerStatus                  `json:"controller,omitempty" yaml:"controller,omitempty"`
+	Storage            *storage.CombinedStorage           `json:"storage,omitempty" yaml:"storage,omitempty"`
 }
 
 type formattedMachineStatus struct {
@@ -190,11 +192,26 @@ type meterStatus struct {
 	Message string `json:"message,omitempty" yaml:"message,omitempty"`
 }
 
+type healthCheck struct {
+	Healthy  bool   `json:"healthy" yaml:"healthy"`
+	Failures int    `json:"failures,omitempty" yaml:"failures,omitempty"`
+	Message  string `json:"message,omitempty" yaml:"message,omitempty"`
+	Since    string `json:"since,omitempty" yaml:"since,omitempty"`
+}
+
+type unitHealth struct {
+	Current string                 `json:"current" yaml:"current"`
+	Checks  map[string]healthCheck `json:"checks,omitempty" yaml:"checks,omitempty"`
+}
+
 type unitStatus struct {
 	// New Juju Health Status fields.
 	WorkloadStatusInfo statusInfoContents `json:"workload-status,omitempty" yaml:"workload-status,omitempty"`
 	JujuStatusInfo     statusInfoContents `json:"juju-status,omitempty" yaml:"juju-status,omitempty"`
 	MeterStatus        *meterStatus       `json:"meter-status,omitempty" yaml:"meter-status,omitempty"`
+	Health             *unitHealth        `json:"health,omitempty" yaml:"health,omitempty"`
+
+	SubWorkloads map[string]statusInfoContents `json:"sub-workloads,omitempty" yaml:"sub-workloads,omitempty"`
 
 	Leader        bool                  `json:"leader,omitempty" yaml:"leader,omitempty"`
 	Charm         string                `json:"upgrading-from,omitempty" yaml:"upgrading-from,omitempty"`
@@ -358,6 +358,25 @@ func (sf *statusFormatter) formatUnit(info unitFormatInfo) unitStatus {
 		}
 	}
 
+	if info.unit.Health != nil {
+		out.Health = sf.formatUnitHealth(*info.unit.Health)
+	}
+
+	if len(info.unit.SubWorkloads) > 0 {
+		out.SubWorkloads = make(map[string]statusInfoContents)
+		for name, sw := range info.unit.SubWorkloads {
+			formatted := statusInfoContents{
+				Current: status.Status(sw.Status),
+				Message: sw.Info,
+				Version: sw.Version,
+			}
+			if sw.Since != nil {
+				formatted.Since = common.FormatTime(sw.Since, sf.isoTime)
+			}
+			out.SubWorkloads[name] = formatted
+		}
+	}
+
 	for k, m := range info.unit.Subordinates {
 		out.Subordinates[k] = sf.formatUnit(unitFormatInfo{
 			unit:            m,
@@ -369,6 +388,25 @@ func (sf *statusFormatter) formatUnit(info unitFormatInfo) unitStatus {
 	return out
 }
 
+func (sf *statusFormatter) formatUnitHealth(health params.UnitHealth) *unitHealth {
+	out := &unitHealth{
+		Current: health.Status,
+		Checks:  make(map[string]healthCheck),
+	}
+	for _, check := range health.Checks {
+		formatted := healthCheck{
+			Healthy:  check.Healthy,
+			Failures: check.Failures,
+			Message:  check.Message,
+		}
+		if !check.Since.IsZero() {
+			formatted.Since = common.FormatTime(&check.Since, sf.isoTime)
+		}
+		out.Checks[check.Name] = formatted
+	}
+	return out
+}
+
 func (sf *statusFormatter) getStatusInfoContents(inst params.DetailedStatus) statusInfoContents {
 	// TODO(perrito66) add status validation.
 	info := statusInfoContents{
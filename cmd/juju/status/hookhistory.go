@@ -0,0 +1,194 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package status
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+
+	"github.com/juju/cmd"
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+	"gopkg.in/juju/names.v2"
+
+	"github.com/juju/juju/api/hookhistory"
+	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/cmd/juju/common"
+	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/cmd/output"
+	"github.com/juju/juju/juju/osenv"
+)
+
+const hookHistoryDoc = `
+Shows the hooks, actions and commands most recently run by the agent of
+the specified unit, newest first. For each, the time it started and
+completed, whether it completed or failed, and the error with which it
+failed are shown.
+
+Hook history is pruned by the controller using the same age and size
+limits as status history; see "max-status-history-age" and
+"max-status-history-size".
+
+Examples:
+    juju show-hook-history mysql/0
+    juju show-hook-history mysql/0 -n 50
+    juju show-hook-history mysql/0 --format json
+
+See also:
+    show-status-log
+`
+
+// NewHookHistoryCommand returns a command that reports the hook
+// history of the specified unit.
+func NewHookHistoryCommand() cmd.Command {
+	return modelcmd.Wrap(&hookHistoryCommand{})
+}
+
+// HookHistoryAPI is the API surface for the show-hook-history command.
+type HookHistoryAPI interface {
+	HookHistory(unit names.UnitTag, size int) ([]params.HookHistoryEntry, error)
+	Close() error
+}
+
+type hookHistoryCommand struct {
+	modelcmd.ModelCommandBase
+	api      HookHistoryAPI
+	out      cmd.Output
+	size     int
+	isoTime  bool
+	unitName string
+}
+
+// Info implements Command.Info.
+func (c *hookHistoryCommand) Info() *cmd.Info {
+	return &cmd.Info{
+		Name:    "show-hook-history",
+		Args:    "<unit name>",
+		Purpose: "Output the hooks most recently run by the specified unit.",
+		Doc:     hookHistoryDoc,
+	}
+}
+
+// SetFlags implements Command.SetFlags.
+func (c *hookHistoryCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.ModelCommandBase.SetFlags(f)
+	f.IntVar(&c.size, "n", 20, "Returns the last N entries")
+	f.BoolVar(&c.isoTime, "utc", false, "Display time as UTC in RFC3339 format")
+	c.out.AddFlags(f, "tabular", map[string]cmd.Formatter{
+		"yaml":    cmd.FormatYaml,
+		"json":    cmd.FormatJson,
+		"tabular": c.formatTabular,
+	})
+}
+
+// Init implements Command.Init.
+func (c *hookHistoryCommand) Init(args []string) error {
+	switch {
+	case len(args) > 1:
+		return errors.Errorf("unexpected arguments after unit name.")
+	case len(args) == 0:
+		return errors.Errorf("unit name is missing.")
+	}
+	c.unitName = args[0]
+	if !names.IsValidUnit(c.unitName) {
+		return errors.NotValidf("unit name %q", c.unitName)
+	}
+	if c.size < 1 {
+		return errors.Errorf("-n must be a positive number")
+	}
+	// If use of ISO time not specified on command line,
+	// check env var.
+	if !c.isoTime {
+		var err error
+		envVarValue := os.Getenv(osenv.JujuStatusIsoTimeEnvKey)
+		if envVarValue != "" {
+			if c.isoTime, err = strconv.ParseBool(envVarValue); err != nil {
+				return errors.Annotatef(err, "invalid %s env var, expected true|false", osenv.JujuStatusIsoTimeEnvKey)
+			}
+		}
+	}
+	return nil
+}
+
+func (c *hookHistoryCommand) getAPI() (HookHistoryAPI, error) {
+	if c.api != nil {
+		return c.api, nil
+	}
+	root, err := c.NewAPIRoot()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return hookhistory.NewClient(root), nil
+}
+
+// Run implements Command.Run.
+func (c *hookHistoryCommand) Run(ctx *cmd.Context) error {
+	api, err := c.getAPI()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer api.Close()
+
+	history, err := api.HookHistory(names.NewUnitTag(c.unitName), c.size)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if len(history) == 0 {
+		ctx.Infof("No hook history available for %s.", c.unitName)
+		return nil
+	}
+	entries := make([]hookHistoryEntry, len(history))
+	for i, entry := range history {
+		entries[i] = c.formatEntry(entry)
+	}
+	return errors.Trace(c.out.Write(ctx, entries))
+}
+
+// hookHistoryEntry is the serialisation of a hook history entry.
+type hookHistoryEntry struct {
+	Operation  string `yaml:"operation" json:"operation"`
+	Hook       string `yaml:"hook,omitempty" json:"hook,omitempty"`
+	Relation   string `yaml:"relation,omitempty" json:"relation,omitempty"`
+	RemoteUnit string `yaml:"remote-unit,omitempty" json:"remote-unit,omitempty"`
+	Started    string `yaml:"started" json:"started"`
+	Completed  string `yaml:"completed" json:"completed"`
+	Duration   string `yaml:"duration" json:"duration"`
+	Result     string `yaml:"result" json:"result"`
+	Error      string `yaml:"error,omitempty" json:"error,omitempty"`
+}
+
+func (c *hookHistoryCommand) formatEntry(entry params.HookHistoryEntry) hookHistoryEntry {
+	return hookHistoryEntry{
+		Operation:  entry.Operation,
+		Hook:       entry.Hook,
+		Relation:   entry.Relation,
+		RemoteUnit: entry.RemoteUnit,
+		Started:    common.FormatTime(&entry.Started, c.isoTime),
+		Completed:  common.FormatTime(&entry.Completed, c.isoTime),
+		Duration:   fmt.Sprintf("%.3fs", entry.Completed.Sub(entry.Started).Seconds()),
+		Result:     entry.Result,
+		Error:      entry.Error,
+	}
+}
+
+func (c *hookHistoryCommand) formatTabular(writer io.Writer, value interface{}) error {
+	entries, ok := value.([]hookHistoryEntry)
+	if !ok {
+		return errors.Errorf("expected value of type %T, got %T", entries, value)
+	}
+	tw := output.TabWriter(writer)
+	w := output.Wrapper{tw}
+
+	w.Println("Completed", "Duration", "Operation", "Remote unit", "Result", "Error")
+	for _, entry := range entries {
+		operation := entry.Operation
+		if entry.Hook != "" {
+			operation = entry.Hook
+		}
+		w.Println(entry.Completed, entry.Duration, operation, entry.RemoteUnit, entry.Result, entry.Error)
+	}
+	return tw.Flush()
+}
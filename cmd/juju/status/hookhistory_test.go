@@ -0,0 +1,131 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package status_test
+
+import (
+	"time"
+
+	"github.com/juju/cmd"
+	"github.com/juju/cmd/cmdtesting"
+	"github.com/juju/errors"
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+	"gopkg.in/juju/names.v2"
+
+	"github.com/juju/juju/apiserver/params"
+	statuscmd "github.com/juju/juju/cmd/juju/status"
+)
+
+type HookHistorySuite struct {
+	testing.IsolationSuite
+	api *fakeHookHistoryAPI
+}
+
+var _ = gc.Suite(&HookHistorySuite{})
+
+func (s *HookHistorySuite) SetUpTest(c *gc.C) {
+	s.IsolationSuite.SetUpTest(c)
+	started := time.Date(2018, 5, 1, 12, 30, 15, 0, time.UTC)
+	s.api = &fakeHookHistoryAPI{
+		history: []params.HookHistoryEntry{{
+			Operation:  "run relation-changed (1; mysql/0) hook",
+			Hook:       "db-relation-changed",
+			Relation:   "wordpress:db mysql:server",
+			RemoteUnit: "mysql/0",
+			Started:    started,
+			Completed:  started.Add(2500 * time.Millisecond),
+			Result:     "failed",
+			Error:      "hook failed",
+		}, {
+			Operation: "run action 1234",
+			Started:   started.Add(-time.Minute),
+			Completed: started.Add(-time.Minute + time.Second),
+			Result:    "completed",
+		}},
+	}
+}
+
+func (s *HookHistorySuite) newCommand() cmd.Command {
+	return statuscmd.NewTestHookHistoryCommand(s.api)
+}
+
+func (s *HookHistorySuite) TestInit(c *gc.C) {
+	for i, test := range []struct {
+		args []string
+		err  string
+	}{{
+		err: "unit name is missing.",
+	}, {
+		args: []string{"mysql/0", "mysql/1"},
+		err:  "unexpected arguments after unit name.",
+	}, {
+		args: []string{"mysql"},
+		err:  `unit name "mysql" not valid`,
+	}, {
+		args: []string{"mysql/0", "-n", "0"},
+		err:  "-n must be a positive number",
+	}} {
+		c.Logf("test %d: %v", i, test.args)
+		_, err := cmdtesting.RunCommand(c, s.newCommand(), test.args...)
+		c.Check(err, gc.ErrorMatches, test.err)
+	}
+}
+
+func (s *HookHistorySuite) TestTabular(c *gc.C) {
+	ctx, err := cmdtesting.RunCommand(c, s.newCommand(), "wordpress/0", "--utc")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(s.api.unit, gc.Equals, names.NewUnitTag("wordpress/0"))
+	c.Check(s.api.size, gc.Equals, 20)
+	c.Check(cmdtesting.Stdout(ctx), gc.Equals, ""+
+		"Completed             Duration  Operation            Remote unit  Result     Error\n"+
+		"2018-05-01 12:30:17Z  2.500s    db-relation-changed  mysql/0      failed     hook failed\n"+
+		"2018-05-01 12:29:16Z  1.000s    run action 1234                   completed  \n")
+}
+
+func (s *HookHistorySuite) TestJSON(c *gc.C) {
+	ctx, err := cmdtesting.RunCommand(c, s.newCommand(), "wordpress/0", "--utc", "-n", "5", "--format", "json")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(s.api.size, gc.Equals, 5)
+	c.Check(cmdtesting.Stdout(ctx), gc.Equals, `[`+
+		`{"operation":"run relation-changed (1; mysql/0) hook","hook":"db-relation-changed",`+
+		`"relation":"wordpress:db mysql:server","remote-unit":"mysql/0",`+
+		`"started":"2018-05-01 12:30:15Z","completed":"2018-05-01 12:30:17Z",`+
+		`"duration":"2.500s","result":"failed","error":"hook failed"},`+
+		`{"operation":"run action 1234",`+
+		`"started":"2018-05-01 12:29:15Z","completed":"2018-05-01 12:29:16Z",`+
+		`"duration":"1.000s","result":"completed"}`+
+		"]\n")
+}
+
+func (s *HookHistorySuite) TestNoHistory(c *gc.C) {
+	s.api.history = nil
+	ctx, err := cmdtesting.RunCommand(c, s.newCommand(), "wordpress/0")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(cmdtesting.Stdout(ctx), gc.Equals, "")
+	c.Check(cmdtesting.Stderr(ctx), gc.Equals, "No hook history available for wordpress/0.\n")
+}
+
+func (s *HookHistorySuite) TestError(c *gc.C) {
+	s.api.err = errors.NotFoundf(`unit "wordpress/0"`)
+	_, err := cmdtesting.RunCommand(c, s.newCommand(), "wordpress/0")
+	c.Assert(err, gc.ErrorMatches, `unit "wordpress/0" not found`)
+}
+
+type fakeHookHistoryAPI struct {
+	unit    names.UnitTag
+	size    int
+	history []params.HookHistoryEntry
+	err     error
+}
+
+func (*fakeHookHistoryAPI) Close() error {
+	return nil
+}
+
+func (f *fakeHookHistoryAPI) HookHistory(unit names.UnitTag, size int) ([]params.HookHistoryEntry, error) {
+	f.unit = unit
+	f.size = size
+	return f.history, f.err
+}
@@ -17,6 +17,7 @@ import (
 	"gopkg.in/juju/charm.v6/hooks"
 
 	cmdcrossmodel "github.com/juju/juju/cmd/juju/crossmodel"
+	"github.com/juju/juju/cmd/juju/storage"
 	"github.com/juju/juju/cmd/output"
 	"github.com/juju/juju/core/crossmodel"
 	"github.com/juju/juju/core/relation"
@@ -95,6 +96,13 @@ func FormatTabular(writer io.Writer, forceColor bool, value interface{}) error {
 	}
 
 	endSection(tw)
+
+	if fs.Storage != nil {
+		fmt.Fprintln(writer)
+		if err := storage.FormatStorageListForStatusTabular(writer, *fs.Storage); err != nil {
+			return errors.Trace(err)
+		}
+	}
 	return nil
 }
 
@@ -215,6 +223,8 @@ func printApplications(tw *ansiterm.TabWriter, fs formattedStatus) {
 		endSection(tw)
 	}
 
+	printUnitHealth(tw, units)
+
 	if !metering {
 		return
 	}
@@ -331,6 +341,60 @@ func printOffers(tw *ansiterm.TabWriter, offers map[string]offerStatus) error {
 	return nil
 }
 
+// printUnitHealth prints the health of the units, and their
+// subordinates, whose charms declare health checks.
+func printUnitHealth(tw *ansiterm.TabWriter, units map[string]unitStatus) {
+	health := make(map[string]*unitHealth)
+	collect := func(name string, u unitStatus, _ int) {
+		if u.Health != nil {
+			health[name] = u.Health
+		}
+	}
+	for name, u := range units {
+		collect(name, u, 0)
+		recurseUnits(u, 1, collect)
+	}
+	if len(health) == 0 {
+		return
+	}
+
+	w := startSection(tw, false, "Unit", "Health", "Message")
+	for _, name := range naturalsort.Sort(stringKeysFromMap(health)) {
+		h := health[name]
+		w.Print(name)
+		w.PrintColor(fromHealthColor(h.Current), h.Current)
+		w.Println(unhealthyChecks(h))
+	}
+	endSection(tw)
+}
+
+// unhealthyChecks describes the failing checks of a unit.
+func unhealthyChecks(health *unitHealth) string {
+	var failing []string
+	for _, name := range naturalsort.Sort(stringKeysFromMap(health.Checks)) {
+		check := health.Checks[name]
+		if check.Healthy {
+			continue
+		}
+		if check.Message == "" {
+			failing = append(failing, name)
+		} else {
+			failing = append(failing, fmt.Sprintf("%s: %s", name, check.Message))
+		}
+	}
+	return strings.Join(failing, "; ")
+}
+
+func fromHealthColor(current string) *ansiterm.Context {
+	switch current {
+	case "healthy":
+		return output.GoodHighlight
+	case "unhealthy":
+		return output.ErrorHighlight
+	}
+	return nil
+}
+
 func fromMeterStatusColor(msColor string) *ansiterm.Context {
 	switch msColor {
 	case "green":
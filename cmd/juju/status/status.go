@@ -14,7 +14,9 @@ import (
 	"github.com/juju/gnuflag"
 	"github.com/juju/loggo"
 
+	"github.com/juju/juju/api/storage"
 	"github.com/juju/juju/apiserver/params"
+	jujustorage "github.com/juju/juju/cmd/juju/storage"
 	"github.com/juju/juju/cmd/modelcmd"
 	"github.com/juju/juju/juju/osenv"
 )
@@ -48,6 +50,9 @@ type statusCommand struct {
 
 	// relationsFlagProvidedF indicates whether 'relations' option was provided by the user.
 	relationsFlagProvidedF func() bool
+
+	// storage indicates if 'storage' section is displayed
+	storage bool
 }
 
 var usageSummary = `
@@ -89,11 +94,16 @@ In tabular format, 'Relations' section is not displayed by default.
 Use --relations option to see this section. This option is ignored in all other 
 formats.
 
+The 'Storage' section, which includes the reported usage of attached
+filesystems and volumes, is not displayed by default in any format. Use
+--storage option to see this section.
+
 Examples:
     juju show-status
     juju show-status mysql
     juju show-status nova-*
     juju show-status --relations
+    juju show-status --storage
 
 See also:
     machines
@@ -118,6 +128,7 @@ func (c *statusCommand) SetFlags(f *gnuflag.FlagSet) {
 	f.BoolVar(&c.color, "color", false, "Force use of ANSI color codes")
 
 	f.BoolVar(&c.relations, "relations", false, "Show 'relations' section")
+	f.BoolVar(&c.storage, "storage", false, "Show 'storage' section")
 
 	c.relationsFlagProvidedF = func() bool {
 		provided := false
@@ -162,6 +173,14 @@ var newAPIClientForStatus = func(c *statusCommand) (statusAPI, error) {
 	return c.NewAPIClient()
 }
 
+var newAPIClientForStorage = func(c *statusCommand) (jujustorage.StorageListAPI, error) {
+	root, err := c.NewAPIRoot()
+	if err != nil {
+		return nil, err
+	}
+	return storage.NewClient(root), nil
+}
+
 func (c *statusCommand) Run(ctx *cmd.Context) error {
 	apiclient, err := newAPIClientForStatus(c)
 	if err != nil {
@@ -200,6 +219,14 @@ func (c *statusCommand) Run(ctx *cmd.Context) error {
 	if err != nil {
 		return errors.Trace(err)
 	}
+
+	if c.storage {
+		storageInfo, err := c.getStorageInfo(ctx)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		formatted.Storage = storageInfo
+	}
 	err = c.out.Write(ctx, formatted)
 	if err != nil {
 		return err
@@ -226,6 +253,29 @@ func (c *statusCommand) Run(ctx *cmd.Context) error {
 	return nil
 }
 
+func (c *statusCommand) getStorageInfo(ctx *cmd.Context) (*jujustorage.CombinedStorage, error) {
+	apiclient, err := newAPIClientForStorage(c)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	defer apiclient.Close()
+
+	storageInfo, err := jujustorage.GetCombinedStorageInfo(jujustorage.GetCombinedStorageInfoParams{
+		Context:         ctx,
+		APIClient:       apiclient,
+		WantStorage:     true,
+		WantVolumes:     true,
+		WantFilesystems: true,
+	})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if storageInfo.Empty() {
+		return nil, nil
+	}
+	return storageInfo, nil
+}
+
 func (c *statusCommand) FormatTabular(writer io.Writer, value interface{}) error {
 	return FormatTabular(writer, c.color, value)
 }
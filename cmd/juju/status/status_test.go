@@ -24,6 +24,7 @@ import (
 
 	"github.com/juju/juju/apiserver/params"
 	"github.com/juju/juju/cmd/juju/common"
+	"github.com/juju/juju/cmd/juju/storage"
 	"github.com/juju/juju/cmd/modelcmd"
 	"github.com/juju/juju/constraints"
 	"github.com/juju/juju/core/crossmodel"
@@ -4470,6 +4471,193 @@ foo/1   up            things are looking up
 `[1:])
 }
 
+func (s *StatusSuite) TestFormatTabularHealth(c *gc.C) {
+	status := formattedStatus{
+		Applications: map[string]applicationStatus{
+			"foo": {
+				Units: map[string]unitStatus{
+					"foo/0": {
+						Health: &unitHealth{
+							Current: "healthy",
+							Checks: map[string]healthCheck{
+								"db": {Healthy: true},
+							},
+						},
+						Subordinates: map[string]unitStatus{
+							"logging/0": {
+								Health: &unitHealth{Current: "unknown"},
+							},
+						},
+					},
+					"foo/1": {
+						Health: &unitHealth{
+							Current: "unhealthy",
+							Checks: map[string]healthCheck{
+								"db":    {Failures: 3, Message: "connection refused"},
+								"http":  {Healthy: true},
+								"queue": {Failures: 1},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	out := &bytes.Buffer{}
+	err := FormatTabular(out, false, status)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(out.String(), gc.Equals, `
+Model  Controller  Cloud/Region  Version
+                                 
+
+App  Version  Status  Scale  Charm  Store  Rev  OS  Notes
+foo                     0/2                  0      
+
+Unit         Workload  Agent  Machine  Public address  Ports  Message
+foo/0                                                         
+  logging/0                                                   
+foo/1                                                         
+
+Unit       Health     Message
+foo/0      healthy    
+foo/1      unhealthy  db: connection refused; queue
+logging/0  unknown    
+`[1:])
+}
+
+func (s *StatusSuite) TestFormatTabularStorage(c *gc.C) {
+	usage := &storage.FilesystemUsage{UsedPercent: 95}
+	status := formattedStatus{
+		Storage: &storage.CombinedStorage{
+			StorageInstances: map[string]storage.StorageInfo{
+				"data/0": {
+					Kind:   "filesystem",
+					Status: storage.EntityStatus{Current: status.Attached},
+					Attachments: &storage.StorageAttachments{
+						Units: map[string]storage.UnitStorageAttachment{
+							"postgresql/0": {MachineId: "0", Location: "/srv/data"},
+						},
+					},
+				},
+			},
+			Filesystems: map[string]storage.FilesystemInfo{
+				"0": {
+					ProviderFilesystemId: "provider-supplied-filesystem-0",
+					Storage:              "data/0",
+					Size:                 1024,
+					Status: storage.EntityStatus{
+						Current: status.Warning,
+						Message: "95% used, above the warning threshold of 90%",
+					},
+					Attachments: &storage.FilesystemAttachments{
+						Machines: map[string]storage.MachineFilesystemAttachment{
+							"0": {MountPoint: "/srv/data", Usage: usage},
+						},
+						Units: map[string]storage.UnitStorageAttachment{
+							"postgresql/0": {MachineId: "0", Location: "/srv/data"},
+						},
+					},
+				},
+			},
+		},
+	}
+	out := &bytes.Buffer{}
+	err := FormatTabular(out, false, status)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(out.String(), gc.Equals, `
+Model  Controller  Cloud/Region  Version
+                                 
+
+[Storage]
+Unit          Id      Type        Provider id                     Size    Used  Status    Message
+postgresql/0  data/0  filesystem  provider-supplied-filesystem-0  1.0GiB  95%   attached  
+
+[Filesystems]
+Machine  Unit          Storage  Id  Volume  Provider id                     Mountpoint  Size    Used  State    Message
+0        postgresql/0  data/0   0           provider-supplied-filesystem-0  /srv/data   1.0GiB  95%   warning  95% used, above the warning threshold of 90%
+`[1:])
+}
+
+func (s *StatusSuite) TestStatusStorage(c *gc.C) {
+	client := fakeAPIClient{statusReturn: &params.FullStatus{}}
+	s.PatchValue(&newAPIClientForStatus, func(_ *statusCommand) (statusAPI, error) {
+		return &client, nil
+	})
+	storageClient := fakeStorageAPIClient{}
+	s.PatchValue(&newAPIClientForStorage, func(_ *statusCommand) (storage.StorageListAPI, error) {
+		return &storageClient, nil
+	})
+
+	code, stdout, _ := runStatus(c, "--format", "yaml", "--storage")
+	c.Assert(code, gc.Equals, 0)
+	c.Assert(string(stdout), jc.Contains, `
+storage:
+  filesystems:
+    "0":
+      attachments:
+        machines:
+          "0":
+            mount-point: /srv/data
+            read-only: false
+            usage:
+              used: 972
+              available: 51
+              used-percent: 95
+`[1:])
+	c.Assert(storageClient.closeCalled, jc.IsTrue)
+}
+
+func (s *StatusSuite) TestStatusNoStorage(c *gc.C) {
+	client := fakeAPIClient{statusReturn: &params.FullStatus{}}
+	s.PatchValue(&newAPIClientForStatus, func(_ *statusCommand) (statusAPI, error) {
+		return &client, nil
+	})
+	s.PatchValue(&newAPIClientForStorage, func(_ *statusCommand) (storage.StorageListAPI, error) {
+		c.Fatalf("unexpected call to newAPIClientForStorage")
+		return nil, nil
+	})
+
+	code, stdout, _ := runStatus(c, "--format", "yaml")
+	c.Assert(code, gc.Equals, 0)
+	c.Assert(string(stdout), gc.Not(jc.Contains), "storage:")
+}
+
+type fakeStorageAPIClient struct {
+	closeCalled bool
+}
+
+func (a *fakeStorageAPIClient) ListStorageDetails() ([]params.StorageDetails, error) {
+	return nil, nil
+}
+
+func (a *fakeStorageAPIClient) ListFilesystems(machines []string) ([]params.FilesystemDetailsListResult, error) {
+	return []params.FilesystemDetailsListResult{{Result: []params.FilesystemDetails{{
+		FilesystemTag: "filesystem-0",
+		Info:          params.FilesystemInfo{Size: 1024},
+		Status:        params.EntityStatus{Since: &time.Time{}},
+		MachineAttachments: map[string]params.FilesystemAttachmentDetails{
+			"machine-0": {
+				FilesystemAttachmentInfo: params.FilesystemAttachmentInfo{
+					MountPoint: "/srv/data",
+				},
+				Usage: &params.FilesystemUsage{
+					Used:      972 * 1024 * 1024,
+					Available: 51 * 1024 * 1024,
+				},
+			},
+		},
+	}}}}, nil
+}
+
+func (a *fakeStorageAPIClient) ListVolumes(machines []string) ([]params.VolumeDetailsListResult, error) {
+	return nil, nil
+}
+
+func (a *fakeStorageAPIClient) Close() error {
+	a.closeCalled = true
+	return nil
+}
+
 //
 // Filtering Feature
 //
@@ -5029,6 +5217,74 @@ func (s *StatusSuite) TestFormatProvisioningError(c *gc.C) {
 	})
 }
 
+func (s *StatusSuite) TestFormatUnitHealth(c *gc.C) {
+	since := time.Date(2018, 5, 1, 12, 30, 15, 0, time.UTC)
+	formatter := NewStatusFormatter(&params.FullStatus{}, true)
+	formatted := formatter.formatUnit(unitFormatInfo{
+		unit: params.UnitStatus{
+			Health: &params.UnitHealth{
+				Status: "unhealthy",
+				Checks: []params.HealthCheckResult{{
+					Name:    "http",
+					Healthy: true,
+					Since:   since,
+				}, {
+					Name:     "db",
+					Failures: 3,
+					Message:  "connection refused",
+					Since:    since.Add(time.Minute),
+				}},
+			},
+		},
+		unitName:        "mysql/0",
+		applicationName: "mysql",
+	})
+	c.Check(formatted.Health, jc.DeepEquals, &unitHealth{
+		Current: "unhealthy",
+		Checks: map[string]healthCheck{
+			"http": {Healthy: true, Since: "2018-05-01 12:30:15Z"},
+			"db": {
+				Failures: 3,
+				Message:  "connection refused",
+				Since:    "2018-05-01 12:31:15Z",
+			},
+		},
+	})
+}
+
+func (s *StatusSuite) TestFormatUnitSubWorkloads(c *gc.C) {
+	since := time.Date(2018, 5, 1, 12, 30, 15, 0, time.UTC)
+	formatter := NewStatusFormatter(&params.FullStatus{}, true)
+	formatted := formatter.formatUnit(unitFormatInfo{
+		unit: params.UnitStatus{
+			SubWorkloads: map[string]params.SubWorkloadStatus{
+				"telegraf": {
+					Version: "1.7.2",
+					Status:  "active",
+					Info:    "shipping metrics",
+					Since:   &since,
+				},
+				"filebeat": {
+					Status: "maintenance",
+				},
+			},
+		},
+		unitName:        "mysql/0",
+		applicationName: "mysql",
+	})
+	c.Check(formatted.SubWorkloads, jc.DeepEquals, map[string]statusInfoContents{
+		"telegraf": {
+			Current: status.Active,
+			Message: "shipping metrics",
+			Version: "1.7.2",
+			Since:   "2018-05-01 12:30:15Z",
+		},
+		"filebeat": {
+			Current: status.Maintenance,
+		},
+	})
+}
+
 func (s *StatusSuite) TestMissingControllerTimestampInFullStatus(c *gc.C) {
 	status := &params.FullStatus{
 		Model: params.ModelStatusInfo{
@@ -69,6 +69,34 @@ func NewAttachStorageCommandForTest(new NewEntityAttacherCloserFunc, store jujuc
 	return modelcmd.Wrap(cmd)
 }
 
+func NewResizeStorageCommandForTest(new NewStorageResizerCloserFunc, store jujuclient.ClientStore) cmd.Command {
+	cmd := &resizeStorageCommand{}
+	cmd.SetClientStore(store)
+	cmd.newStorageResizerCloser = new
+	return modelcmd.Wrap(cmd)
+}
+
+func NewSnapshotStorageCommandForTest(new NewStorageSnapshotterCloserFunc, store jujuclient.ClientStore) cmd.Command {
+	cmd := &snapshotStorageCommand{}
+	cmd.SetClientStore(store)
+	cmd.newStorageSnapshotterCloser = new
+	return modelcmd.Wrap(cmd)
+}
+
+func NewListSnapshotsCommandForTest(new NewSnapshotListerCloserFunc, store jujuclient.ClientStore) cmd.Command {
+	cmd := &listSnapshotsCommand{}
+	cmd.SetClientStore(store)
+	cmd.newSnapshotListerCloser = new
+	return modelcmd.Wrap(cmd)
+}
+
+func NewRestoreStorageCommandForTest(new NewStorageRestorerCloserFunc, store jujuclient.ClientStore) cmd.Command {
+	cmd := &restoreStorageCommand{}
+	cmd.SetClientStore(store)
+	cmd.newStorageRestorerCloser = new
+	return modelcmd.Wrap(cmd)
+}
+
 func NewDetachStorageCommandForTest(new NewEntityDetacherCloserFunc, store jujuclient.ClientStore) cmd.Command {
 	cmd := &detachStorageCommand{}
 	cmd.SetClientStore(store)
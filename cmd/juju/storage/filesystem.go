@@ -6,6 +6,7 @@ package storage
 import (
 	"fmt"
 
+	"github.com/dustin/go-humanize"
 	"github.com/juju/cmd"
 	"github.com/juju/errors"
 	"gopkg.in/juju/names.v2"
@@ -53,9 +54,45 @@ type FilesystemAttachments struct {
 }
 
 type MachineFilesystemAttachment struct {
-	MountPoint string `yaml:"mount-point" json:"mount-point"`
-	ReadOnly   bool   `yaml:"read-only" json:"read-only"`
-	Life       string `yaml:"life,omitempty" json:"life,omitempty"`
+	MountPoint string           `yaml:"mount-point" json:"mount-point"`
+	ReadOnly   bool             `yaml:"read-only" json:"read-only"`
+	Life       string           `yaml:"life,omitempty" json:"life,omitempty"`
+	Usage      *FilesystemUsage `yaml:"usage,omitempty" json:"usage,omitempty"`
+}
+
+// FilesystemUsage defines the serialization behaviour for the reported
+// usage of a filesystem attached to a machine.
+type FilesystemUsage struct {
+	// Used and Available are in MiB, like FilesystemInfo.Size.
+	Used        uint64 `yaml:"used" json:"used"`
+	Available   uint64 `yaml:"available" json:"available"`
+	UsedPercent int    `yaml:"used-percent" json:"used-percent"`
+	InodesUsed  uint64 `yaml:"inodes-used" json:"inodes-used"`
+	InodesFree  uint64 `yaml:"inodes-free" json:"inodes-free"`
+	Updated     string `yaml:"updated,omitempty" json:"updated,omitempty"`
+}
+
+func convertFilesystemUsage(usage *params.FilesystemUsage) *FilesystemUsage {
+	if usage == nil {
+		return nil
+	}
+	var percent uint64
+	if total := usage.Used + usage.Available; total > 0 {
+		percent = usage.Used * 100 / total
+	}
+	if usage.Inodes > 0 {
+		if inodePercent := usage.InodesUsed * 100 / usage.Inodes; inodePercent > percent {
+			percent = inodePercent
+		}
+	}
+	return &FilesystemUsage{
+		Used:        usage.Used / humanize.MiByte,
+		Available:   usage.Available / humanize.MiByte,
+		UsedPercent: int(percent),
+		InodesUsed:  usage.InodesUsed,
+		InodesFree:  usage.InodesFree,
+		Updated:     common.FormatTime(&usage.Updated, false),
+	}
 }
 
 // generateListFilesystemOutput returns a map filesystem IDs to filesystem info
@@ -132,6 +169,7 @@ func createFilesystemInfo(details params.FilesystemDetails) (names.FilesystemTag
 				attachment.MountPoint,
 				attachment.ReadOnly,
 				string(attachment.Life),
+				convertFilesystemUsage(attachment.Usage),
 			}
 		}
 		info.Attachments = &FilesystemAttachments{
@@ -116,6 +116,91 @@ func (s *ListSuite) TestFilesystemListTabular(c *gc.C) {
 	s.assertValidFilesystemList(c, []string{}, expectedFilesystemListTabular)
 }
 
+func (s *ListSuite) TestFilesystemListTabularUsage(c *gc.C) {
+	s.mockAPI.listFilesystems = func([]string) ([]params.FilesystemDetailsListResult, error) {
+		return []params.FilesystemDetailsListResult{{Result: []params.FilesystemDetails{{
+			FilesystemTag: "filesystem-0",
+			Info: params.FilesystemInfo{
+				FilesystemId: "provider-supplied-filesystem-0",
+				Size:         1024,
+			},
+			Status: createTestStatus(status.Warning, "95% used, above the warning threshold of 90%"),
+			MachineAttachments: map[string]params.FilesystemAttachmentDetails{
+				"machine-0": {
+					FilesystemAttachmentInfo: params.FilesystemAttachmentInfo{
+						MountPoint: "/srv/data",
+					},
+					Usage: &params.FilesystemUsage{
+						Size:      1024 * 1024 * 1024,
+						Used:      95 * 1024 * 1024,
+						Available: 5 * 1024 * 1024,
+					},
+				},
+			},
+		}, {
+			FilesystemTag: "filesystem-1",
+			Info: params.FilesystemInfo{
+				FilesystemId: "provider-supplied-filesystem-1",
+				Size:         2048,
+			},
+			Status: createTestStatus(status.Attached, ""),
+			MachineAttachments: map[string]params.FilesystemAttachmentDetails{
+				"machine-0": {
+					FilesystemAttachmentInfo: params.FilesystemAttachmentInfo{
+						MountPoint: "/srv/logs",
+					},
+				},
+			},
+		}}}}, nil
+	}
+	s.assertValidFilesystemList(c, []string{}, `
+[Filesystems]
+Machine  Unit  Storage  Id  Volume  Provider id                     Mountpoint  Size    Used  State     Message
+0                       0           provider-supplied-filesystem-0  /srv/data   1.0GiB  95%   warning   95% used, above the warning threshold of 90%
+0                       1           provider-supplied-filesystem-1  /srv/logs   2.0GiB        attached  
+
+`[1:])
+}
+
+func (s *ListSuite) TestFilesystemListYamlUsage(c *gc.C) {
+	s.mockAPI.listFilesystems = func([]string) ([]params.FilesystemDetailsListResult, error) {
+		return []params.FilesystemDetailsListResult{{Result: []params.FilesystemDetails{{
+			FilesystemTag: "filesystem-0",
+			Info:          params.FilesystemInfo{Size: 1024},
+			Status:        createTestStatus(status.Attached, ""),
+			MachineAttachments: map[string]params.FilesystemAttachmentDetails{
+				"machine-0": {
+					FilesystemAttachmentInfo: params.FilesystemAttachmentInfo{
+						MountPoint: "/srv/data",
+					},
+					Usage: &params.FilesystemUsage{
+						Used:       256 * 1024 * 1024,
+						Available:  768 * 1024 * 1024,
+						Inodes:     100,
+						InodesUsed: 60,
+						InodesFree: 40,
+					},
+				},
+			},
+		}}}}, nil
+	}
+	context, err := s.runFilesystemList(c, "--format", "yaml")
+	c.Assert(err, jc.ErrorIsNil)
+	var result struct {
+		Filesystems map[string]storage.FilesystemInfo
+	}
+	err = goyaml.Unmarshal([]byte(cmdtesting.Stdout(context)), &result)
+	c.Assert(err, jc.ErrorIsNil)
+	usage := result.Filesystems["0"].Attachments.Machines["0"].Usage
+	c.Assert(usage, gc.NotNil)
+	c.Assert(usage.Used, gc.Equals, uint64(256))
+	c.Assert(usage.Available, gc.Equals, uint64(768))
+	c.Assert(usage.InodesUsed, gc.Equals, uint64(60))
+	c.Assert(usage.InodesFree, gc.Equals, uint64(40))
+	// Inode usage is higher than space usage.
+	c.Assert(usage.UsedPercent, gc.Equals, 60)
+}
+
 func (s *ListSuite) assertUnmarshalledOutput(c *gc.C, unmarshal unmarshaller, expectedErr string, args ...string) {
 	context, err := s.runFilesystemList(c, args...)
 	c.Assert(err, jc.ErrorIsNil)
@@ -22,7 +22,6 @@ func formatFilesystemListTabular(writer io.Writer, infos map[string]FilesystemIn
 		fmt.Fprintln(tw, strings.Join(values, "\t"))
 	}
 	print("[Filesystems]")
-	print("Machine", "Unit", "Storage", "Id", "Volume", "Provider id", "Mountpoint", "Size", "State", "Message")
 
 	filesystemAttachmentInfos := make(filesystemAttachmentInfos, 0, len(infos))
 	for filesystemId, info := range infos {
@@ -55,17 +54,40 @@ func formatFilesystemListTabular(writer io.Writer, infos map[string]FilesystemIn
 	}
 	sort.Sort(filesystemAttachmentInfos)
 
+	// The "Used" column is only shown if usage has been
+	// reported for at least one of the filesystems.
+	var showUsage bool
+	for _, info := range filesystemAttachmentInfos {
+		if info.Usage != nil {
+			showUsage = true
+			break
+		}
+	}
+	if showUsage {
+		print("Machine", "Unit", "Storage", "Id", "Volume", "Provider id", "Mountpoint", "Size", "Used", "State", "Message")
+	} else {
+		print("Machine", "Unit", "Storage", "Id", "Volume", "Provider id", "Mountpoint", "Size", "State", "Message")
+	}
+
 	for _, info := range filesystemAttachmentInfos {
 		var size string
 		if info.Size > 0 {
 			size = humanize.IBytes(info.Size * humanize.MiByte)
 		}
-		print(
+		values := []string{
 			info.MachineId, info.UnitId, info.Storage,
 			info.FilesystemId, info.Volume, info.ProviderFilesystemId,
 			info.MountPoint, size,
-			string(info.Status.Current), info.Status.Message,
-		)
+		}
+		if showUsage {
+			var used string
+			if info.Usage != nil {
+				used = fmt.Sprintf("%d%%", info.Usage.UsedPercent)
+			}
+			values = append(values, used)
+		}
+		values = append(values, string(info.Status.Current), info.Status.Message)
+		print(values...)
 	}
 
 	return tw.Flush()
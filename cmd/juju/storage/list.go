@@ -95,35 +95,61 @@ func (c *listCommand) Run(ctx *cmd.Context) (err error) {
 		wantFilesystems = true
 	}
 
-	var combined combinedStorage
-	if wantFilesystems {
-		filesystems, err := generateListFilesystemsOutput(ctx, api, c.ids)
+	combined, err := GetCombinedStorageInfo(GetCombinedStorageInfoParams{
+		Context:         ctx,
+		APIClient:       api,
+		Ids:             c.ids,
+		WantStorage:     wantStorage,
+		WantVolumes:     wantVolumes,
+		WantFilesystems: wantFilesystems,
+	})
+	if err != nil {
+		return err
+	}
+	if combined.Empty() {
+		if c.out.Name() == "tabular" {
+			ctx.Infof("No storage to display.")
+		}
+		return nil
+	}
+	return c.out.Write(ctx, *combined)
+}
+
+// GetCombinedStorageInfoParams holds the parameters for
+// GetCombinedStorageInfo.
+type GetCombinedStorageInfoParams struct {
+	Context                                   *cmd.Context
+	APIClient                                 StorageListAPI
+	Ids                                       []string
+	WantStorage, WantVolumes, WantFilesystems bool
+}
+
+// GetCombinedStorageInfo returns a list of StorageInstances, Filesystems
+// and/or Volumes, as requested by the given parameters.
+func GetCombinedStorageInfo(p GetCombinedStorageInfoParams) (*CombinedStorage, error) {
+	combined := &CombinedStorage{}
+	if p.WantFilesystems {
+		filesystems, err := generateListFilesystemsOutput(p.Context, p.APIClient, p.Ids)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		combined.Filesystems = filesystems
 	}
-	if wantVolumes {
-		volumes, err := generateListVolumeOutput(ctx, api, c.ids)
+	if p.WantVolumes {
+		volumes, err := generateListVolumeOutput(p.Context, p.APIClient, p.Ids)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		combined.Volumes = volumes
 	}
-	if wantStorage {
-		storageInstances, err := generateListStorageOutput(ctx, api)
+	if p.WantStorage {
+		storageInstances, err := generateListStorageOutput(p.Context, p.APIClient)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		combined.StorageInstances = storageInstances
 	}
-	if combined.empty() {
-		if c.out.Name() == "tabular" {
-			ctx.Infof("No storage to display.")
-		}
-		return nil
-	}
-	return c.out.Write(ctx, combined)
+	return combined, nil
 }
 
 // StorageAPI defines the API methods that the storage commands use.
@@ -146,18 +172,20 @@ func generateListStorageOutput(ctx *cmd.Context, api StorageListAPI) (map[string
 	return formatStorageDetails(results)
 }
 
-type combinedStorage struct {
+// CombinedStorage holds a list of StorageInstances, Filesystems and Volumes.
+type CombinedStorage struct {
 	StorageInstances map[string]StorageInfo    `yaml:"storage,omitempty" json:"storage,omitempty"`
 	Filesystems      map[string]FilesystemInfo `yaml:"filesystems,omitempty" json:"filesystems,omitempty"`
 	Volumes          map[string]VolumeInfo     `yaml:"volumes,omitempty" json:"volumes,omitempty"`
 }
 
-func (c *combinedStorage) empty() bool {
+// Empty checks if CombinedStorage is empty.
+func (c *CombinedStorage) Empty() bool {
 	return len(c.StorageInstances) == 0 && len(c.Filesystems) == 0 && len(c.Volumes) == 0
 }
 
 func formatListTabular(writer io.Writer, value interface{}) error {
-	combined := value.(combinedStorage)
+	combined := value.(CombinedStorage)
 	var newline bool
 	if len(combined.StorageInstances) > 0 {
 		// If we're listing storage in tabular format, we combine all
@@ -191,3 +219,30 @@ func formatListTabular(writer io.Writer, value interface{}) error {
 	}
 	return nil
 }
+
+// FormatStorageListForStatusTabular writes a tabular summary of storage
+// instances and filesystems for the status command.
+func FormatStorageListForStatusTabular(writer io.Writer, combined CombinedStorage) error {
+	if len(combined.StorageInstances) > 0 {
+		if err := formatStorageListTabular(
+			writer,
+			combined.StorageInstances,
+			combined.Filesystems,
+			combined.Volumes,
+		); err != nil {
+			return err
+		}
+	}
+	if len(combined.Filesystems) > 0 {
+		// The filesystems are listed as well, as the storage
+		// table does not show filesystem status, such as a
+		// usage warning.
+		if len(combined.StorageInstances) > 0 {
+			fmt.Fprintln(writer)
+		}
+		if err := formatFilesystemListTabular(writer, combined.Filesystems); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -69,6 +69,96 @@ transcode/1   shared-fs/0   filesystem  provider-supplied-volume-4      1.0GiB
 `[1:])
 }
 
+func (s *ListSuite) TestListUsage(c *gc.C) {
+	s.mockAPI.listStorageDetails = func() ([]params.StorageDetails, error) {
+		return []params.StorageDetails{{
+			StorageTag: "storage-data-0",
+			OwnerTag:   "unit-postgresql-0",
+			Kind:       params.StorageKindFilesystem,
+			Status: params.EntityStatus{
+				Status: status.Attached,
+				Since:  &epoch,
+			},
+			Attachments: map[string]params.StorageAttachmentDetails{
+				"unit-postgresql-0": params.StorageAttachmentDetails{
+					StorageTag: "storage-data-0",
+					UnitTag:    "unit-postgresql-0",
+					MachineTag: "machine-0",
+					Location:   "/srv/data",
+				},
+			},
+		}, {
+			StorageTag: "storage-data-1",
+			OwnerTag:   "unit-postgresql-1",
+			Kind:       params.StorageKindFilesystem,
+			Status: params.EntityStatus{
+				Status: status.Attached,
+				Since:  &epoch,
+			},
+			Attachments: map[string]params.StorageAttachmentDetails{
+				"unit-postgresql-1": params.StorageAttachmentDetails{
+					StorageTag: "storage-data-1",
+					UnitTag:    "unit-postgresql-1",
+					MachineTag: "machine-1",
+					Location:   "/srv/data",
+				},
+			},
+		}}, nil
+	}
+	s.mockAPI.listFilesystems = func([]string) ([]params.FilesystemDetailsListResult, error) {
+		return []params.FilesystemDetailsListResult{{Result: []params.FilesystemDetails{{
+			FilesystemTag: "filesystem-0",
+			Info: params.FilesystemInfo{
+				FilesystemId: "provider-supplied-filesystem-0",
+				Size:         1024,
+			},
+			Status: createTestStatus(status.Attached, ""),
+			MachineAttachments: map[string]params.FilesystemAttachmentDetails{
+				"machine-0": {
+					FilesystemAttachmentInfo: params.FilesystemAttachmentInfo{
+						MountPoint: "/srv/data",
+					},
+					Usage: &params.FilesystemUsage{
+						Used:      512 * 1024 * 1024,
+						Available: 512 * 1024 * 1024,
+					},
+				},
+			},
+			Storage: &params.StorageDetails{
+				StorageTag: "storage-data-0",
+				Status:     createTestStatus(status.Attached, ""),
+			},
+		}, {
+			FilesystemTag: "filesystem-1",
+			Info: params.FilesystemInfo{
+				FilesystemId: "provider-supplied-filesystem-1",
+				Size:         1024,
+			},
+			Status: createTestStatus(status.Attached, ""),
+			MachineAttachments: map[string]params.FilesystemAttachmentDetails{
+				"machine-1": {},
+			},
+			Storage: &params.StorageDetails{
+				StorageTag: "storage-data-1",
+				Status:     createTestStatus(status.Attached, ""),
+			},
+		}}}}, nil
+	}
+	s.mockAPI.listVolumes = func([]string) ([]params.VolumeDetailsListResult, error) {
+		return nil, nil
+	}
+	s.assertValidList(
+		c,
+		nil,
+		`
+\[Storage\]
+Unit          Id      Type        Provider id                     Size    Used  Status    Message
+postgresql/0  data/0  filesystem  provider-supplied-filesystem-0  1.0GiB  50%   attached  
+postgresql/1  data/1  filesystem  provider-supplied-filesystem-1  1.0GiB        attached  
+
+`[1:])
+}
+
 func (s *ListSuite) TestListYAML(c *gc.C) {
 	s.assertValidList(
 		c,
@@ -317,10 +407,11 @@ func (s *ListSuite) assertValidList(c *gc.C, args []string, expectedValid string
 }
 
 type mockListAPI struct {
-	listErrors      bool
-	listFilesystems func([]string) ([]params.FilesystemDetailsListResult, error)
-	listVolumes     func([]string) ([]params.VolumeDetailsListResult, error)
-	omitPool        bool
+	listErrors         bool
+	listStorageDetails func() ([]params.StorageDetails, error)
+	listFilesystems    func([]string) ([]params.FilesystemDetailsListResult, error)
+	listVolumes        func([]string) ([]params.VolumeDetailsListResult, error)
+	omitPool           bool
 }
 
 func (s *mockListAPI) Close() error {
@@ -331,6 +422,9 @@ func (s *mockListAPI) ListStorageDetails() ([]params.StorageDetails, error) {
 	if s.listErrors {
 		return nil, errors.New("list fails")
 	}
+	if s.listStorageDetails != nil {
+		return s.listStorageDetails()
+	}
 
 	// postgresql/0 has "db-dir/1100"
 	// transcode/1 has "db-dir/1000"
@@ -4,6 +4,7 @@
 package storage
 
 import (
+	"fmt"
 	"io"
 	"sort"
 	"strconv"
@@ -28,10 +29,24 @@ func formatStorageListTabular(
 	storageProviderId := make(map[string]string)
 	storageSize := make(map[string]uint64)
 	storagePool := make(map[string]string)
+	storageUsage := make(map[string]map[string]*FilesystemUsage)
 	for _, f := range filesystems {
 		if f.Pool != "" {
 			storagePool[f.Storage] = f.Pool
 		}
+		if f.Attachments != nil {
+			for machineId, a := range f.Attachments.Machines {
+				if a.Usage == nil {
+					continue
+				}
+				byMachine := storageUsage[f.Storage]
+				if byMachine == nil {
+					byMachine = make(map[string]*FilesystemUsage)
+					storageUsage[f.Storage] = byMachine
+				}
+				byMachine[machineId] = a.Usage
+			}
+		}
 		storageProviderId[f.Storage] = f.ProviderFilesystemId
 		storageSize[f.Storage] = f.Size
 	}
@@ -47,6 +62,21 @@ func formatStorageListTabular(
 		if _, ok := storageSize[v.Storage]; !ok {
 			storageSize[v.Storage] = v.Size
 		}
+		// Likewise, we want to use the usage reported for
+		// the filesystem rather than the volume.
+		if _, ok := storageUsage[v.Storage]; !ok && v.Attachments != nil {
+			for machineId, a := range v.Attachments.Machines {
+				if a.Usage == nil {
+					continue
+				}
+				byMachine := storageUsage[v.Storage]
+				if byMachine == nil {
+					byMachine = make(map[string]*FilesystemUsage)
+					storageUsage[v.Storage] = byMachine
+				}
+				byMachine[machineId] = a.Usage
+			}
+		}
 	}
 
 	w.Print("Unit", "Id", "Type")
@@ -56,7 +86,14 @@ func formatStorageListTabular(
 		// We omit the column in that case.
 		w.Print("Pool")
 	}
-	w.Println("Provider id", "Size", "Status", "Message")
+	w.Print("Provider id", "Size")
+	if len(storageUsage) > 0 {
+		// Usage is only reported for mounted filesystems,
+		// including those mounted on volumes. We omit the
+		// column if none has been reported.
+		w.Print("Used")
+	}
+	w.Println("Status", "Message")
 
 	byUnit := make(map[string]map[string]storageAttachmentInfo)
 	for storageId, storageInfo := range storageInfo {
@@ -73,7 +110,7 @@ func formatStorageListTabular(
 			}
 			continue
 		}
-		for unitId := range storageInfo.Attachments.Units {
+		for unitId, unitInfo := range storageInfo.Attachments.Units {
 			byStorage := byUnit[unitId]
 			if byStorage == nil {
 				byStorage = make(map[string]storageAttachmentInfo)
@@ -82,6 +119,7 @@ func formatStorageListTabular(
 			byStorage[storageId] = storageAttachmentInfo{
 				storageId: storageId,
 				unitId:    unitId,
+				machineId: unitInfo.MachineId,
 				kind:      storageInfo.Kind,
 				status:    storageInfo.Status,
 			}
@@ -120,6 +158,13 @@ func formatStorageListTabular(
 				storageProviderId[info.storageId],
 				sizeStr,
 			)
+			if len(storageUsage) > 0 {
+				var usedStr string
+				if usage := storageUsage[info.storageId][info.machineId]; usage != nil {
+					usedStr = fmt.Sprintf("%d%%", usage.UsedPercent)
+				}
+				w.Print(usedStr)
+			}
 			w.PrintStatus(info.status.Current)
 			w.Println(info.status.Message)
 		}
@@ -132,6 +177,7 @@ func formatStorageListTabular(
 type storageAttachmentInfo struct {
 	storageId string
 	unitId    string
+	machineId string
 	kind      string
 	status    EntityStatus
 }
@@ -38,6 +38,10 @@ into named resources that can be used during deployment.
 Pools defined at the model level are easily reused across applications.
 Pool creation requires a pool name, the provider type and attributes for
 configuration as space-separated pairs, e.g. tags, size, path, etc.
+
+Examples:
+    juju create-storage-pool ebs-fast ebs volume-type=io1 iops=1000
+    juju create-storage-pool lvm-fast lvm volume-group=fast devices=sdb,sdc
 `
 
 // NewPoolCreateCommand returns a command that creates or defines a storage pool
@@ -0,0 +1,124 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package storage
+
+import (
+	"github.com/juju/cmd"
+	"github.com/juju/errors"
+	"github.com/juju/utils"
+	"gopkg.in/juju/names.v2"
+
+	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/cmd/juju/common"
+	"github.com/juju/juju/cmd/modelcmd"
+)
+
+// NewResizeStorageCommandWithAPI returns a command
+// used to grow storage instances.
+func NewResizeStorageCommandWithAPI() cmd.Command {
+	cmd := &resizeStorageCommand{}
+	cmd.newStorageResizerCloser = func() (StorageResizerCloser, error) {
+		return cmd.NewStorageAPI()
+	}
+	return modelcmd.Wrap(cmd)
+}
+
+// NewResizeStorageCommand returns a command used to
+// grow storage instances.
+func NewResizeStorageCommand(new NewStorageResizerCloserFunc) cmd.Command {
+	cmd := &resizeStorageCommand{}
+	cmd.newStorageResizerCloser = new
+	return modelcmd.Wrap(cmd)
+}
+
+const (
+	resizeStorageCommandDoc = `
+Grows a storage instance to the specified size. Specify the storage ID,
+as output by "juju storage", and the new size, which must be larger than
+the current size. Sizes may be given in MiB, or with a unit suffix of
+M, G, T, P, or E.
+
+Only volume-backed storage whose storage provider supports resizing
+can be grown. Once the volume has been resized, the unit's charm is
+notified with a storage-resized hook, so that it may grow the
+filesystem on the volume.
+
+Examples:
+    juju resize-storage pgdata/0 20G
+`
+
+	resizeStorageCommandArgs = `<storage> <size>`
+)
+
+// resizeStorageCommand grows storage instances.
+type resizeStorageCommand struct {
+	StorageCommandBase
+	newStorageResizerCloser NewStorageResizerCloserFunc
+	storageId               string
+	size                    uint64
+}
+
+// Init implements Command.Init.
+func (c *resizeStorageCommand) Init(args []string) error {
+	if len(args) < 2 {
+		return errors.New("resize-storage requires a storage ID and a size")
+	}
+	if !names.IsValidStorage(args[0]) {
+		return errors.NotValidf("storage ID %q", args[0])
+	}
+	size, err := utils.ParseSize(args[1])
+	if err != nil {
+		return errors.Annotatef(err, "parsing size %q", args[1])
+	}
+	if size == 0 {
+		return errors.New("size must be greater than zero")
+	}
+	c.storageId = args[0]
+	c.size = size
+	return cmd.CheckEmpty(args[2:])
+}
+
+// Info implements Command.Info.
+func (c *resizeStorageCommand) Info() *cmd.Info {
+	return &cmd.Info{
+		Name:    "resize-storage",
+		Purpose: "Grows storage to a larger size.",
+		Doc:     resizeStorageCommandDoc,
+		Args:    resizeStorageCommandArgs,
+	}
+}
+
+// Run implements Command.Run.
+func (c *resizeStorageCommand) Run(ctx *cmd.Context) error {
+	resizer, err := c.newStorageResizerCloser()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer resizer.Close()
+
+	if err := resizer.Resize(c.storageId, c.size); err != nil {
+		if params.IsCodeUnauthorized(err) {
+			common.PermissionsMessage(ctx.Stderr, "resize storage")
+		}
+		return err
+	}
+	ctx.Infof("resizing %s to %dMiB", c.storageId, c.size)
+	return nil
+}
+
+// NewStorageResizerCloserFunc is the type of a function that returns a
+// StorageResizerCloser.
+type NewStorageResizerCloserFunc func() (StorageResizerCloser, error)
+
+// StorageResizerCloser extends StorageResizer with a Closer method.
+type StorageResizerCloser interface {
+	StorageResizer
+	Close() error
+}
+
+// StorageResizer defines an interface for growing the storage
+// instance with the specified ID to the specified size in MiB.
+type StorageResizer interface {
+	Resize(storageId string, size uint64) error
+}
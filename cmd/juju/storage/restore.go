@@ -0,0 +1,118 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package storage
+
+import (
+	"github.com/juju/cmd"
+	"github.com/juju/errors"
+	"gopkg.in/juju/names.v2"
+
+	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/cmd/juju/common"
+	"github.com/juju/juju/cmd/modelcmd"
+)
+
+// NewRestoreStorageCommandWithAPI returns a command
+// used to restore storage instances from snapshots.
+func NewRestoreStorageCommandWithAPI() cmd.Command {
+	cmd := &restoreStorageCommand{}
+	cmd.newStorageRestorerCloser = func() (StorageRestorerCloser, error) {
+		return cmd.NewStorageAPI()
+	}
+	return modelcmd.Wrap(cmd)
+}
+
+// NewRestoreStorageCommand returns a command used to
+// restore storage instances from snapshots.
+func NewRestoreStorageCommand(new NewStorageRestorerCloserFunc) cmd.Command {
+	cmd := &restoreStorageCommand{}
+	cmd.newStorageRestorerCloser = new
+	return modelcmd.Wrap(cmd)
+}
+
+const (
+	restoreStorageCommandDoc = `
+Restores the volume backing a storage instance from a snapshot.
+Specify the storage ID, as output by "juju storage", and the snapshot
+ID, as output by "juju snapshots". The snapshot must have been taken
+from the volume backing the storage instance.
+
+The volume's current contents are overwritten. Stop any workloads
+using the storage before restoring it.
+
+Examples:
+    juju restore-storage pgdata/0 3
+
+See also:
+    snapshot-storage
+    snapshots
+`
+
+	restoreStorageCommandArgs = `<storage> <snapshot>`
+)
+
+// restoreStorageCommand restores storage instances from snapshots.
+type restoreStorageCommand struct {
+	StorageCommandBase
+	newStorageRestorerCloser NewStorageRestorerCloserFunc
+	storageId                string
+	snapshotId               string
+}
+
+// Init implements Command.Init.
+func (c *restoreStorageCommand) Init(args []string) error {
+	if len(args) < 2 {
+		return errors.New("restore-storage requires a storage ID and a snapshot ID")
+	}
+	if !names.IsValidStorage(args[0]) {
+		return errors.NotValidf("storage ID %q", args[0])
+	}
+	c.storageId = args[0]
+	c.snapshotId = args[1]
+	return cmd.CheckEmpty(args[2:])
+}
+
+// Info implements Command.Info.
+func (c *restoreStorageCommand) Info() *cmd.Info {
+	return &cmd.Info{
+		Name:    "restore-storage",
+		Purpose: "Restores storage from a snapshot.",
+		Doc:     restoreStorageCommandDoc,
+		Args:    restoreStorageCommandArgs,
+	}
+}
+
+// Run implements Command.Run.
+func (c *restoreStorageCommand) Run(ctx *cmd.Context) error {
+	restorer, err := c.newStorageRestorerCloser()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer restorer.Close()
+
+	if err := restorer.Restore(c.storageId, c.snapshotId); err != nil {
+		if params.IsCodeUnauthorized(err) {
+			common.PermissionsMessage(ctx.Stderr, "restore storage")
+		}
+		return err
+	}
+	ctx.Infof("restoring %s from snapshot %s", c.storageId, c.snapshotId)
+	return nil
+}
+
+// NewStorageRestorerCloserFunc is the type of a function that returns a
+// StorageRestorerCloser.
+type NewStorageRestorerCloserFunc func() (StorageRestorerCloser, error)
+
+// StorageRestorerCloser extends StorageRestorer with a Closer method.
+type StorageRestorerCloser interface {
+	StorageRestorer
+	Close() error
+}
+
+// StorageRestorer defines an interface for restoring the storage
+// instance with the specified ID from the specified volume snapshot.
+type StorageRestorer interface {
+	Restore(storageId, snapshotId string) error
+}
@@ -0,0 +1,86 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package storage_test
+
+import (
+	"github.com/juju/cmd/cmdtesting"
+	"github.com/juju/errors"
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/cmd/juju/storage"
+	"github.com/juju/juju/jujuclient/jujuclienttesting"
+)
+
+type RestoreStorageSuite struct {
+	testing.IsolationSuite
+}
+
+var _ = gc.Suite(&RestoreStorageSuite{})
+
+func (s *RestoreStorageSuite) TestRestore(c *gc.C) {
+	var fake fakeStorageRestorer
+	cmd := storage.NewRestoreStorageCommandForTest(fake.new, jujuclienttesting.MinimalStore())
+	ctx, err := cmdtesting.RunCommand(c, cmd, "foo/0", "3")
+	c.Assert(err, jc.ErrorIsNil)
+	fake.CheckCallNames(c, "NewStorageRestorerCloser", "Restore", "Close")
+	fake.CheckCall(c, 1, "Restore", "foo/0", "3")
+	c.Assert(cmdtesting.Stderr(ctx), gc.Equals, "restoring foo/0 from snapshot 3\n")
+}
+
+func (s *RestoreStorageSuite) TestRestoreError(c *gc.C) {
+	var fake fakeStorageRestorer
+	fake.SetErrors(nil, errors.New(`volume snapshot "3" not found`))
+	cmd := storage.NewRestoreStorageCommandForTest(fake.new, jujuclienttesting.MinimalStore())
+	_, err := cmdtesting.RunCommand(c, cmd, "foo/0", "3")
+	c.Assert(err, gc.ErrorMatches, `volume snapshot "3" not found`)
+	fake.CheckCallNames(c, "NewStorageRestorerCloser", "Restore", "Close")
+}
+
+func (s *RestoreStorageSuite) TestRestoreUnauthorizedError(c *gc.C) {
+	var fake fakeStorageRestorer
+	fake.SetErrors(nil, &params.Error{Code: params.CodeUnauthorized, Message: "nope"})
+	cmd := storage.NewRestoreStorageCommandForTest(fake.new, jujuclienttesting.MinimalStore())
+	ctx, err := cmdtesting.RunCommand(c, cmd, "foo/0", "3")
+	c.Assert(err, gc.ErrorMatches, "nope")
+	c.Assert(cmdtesting.Stderr(ctx), gc.Equals, `
+You do not have permission to restore storage.
+You may ask an administrator to grant you access with "juju grant".
+
+`)
+}
+
+func (s *RestoreStorageSuite) TestRestoreInitErrors(c *gc.C) {
+	s.testRestoreInitError(c, []string{}, "restore-storage requires a storage ID and a snapshot ID")
+	s.testRestoreInitError(c, []string{"foo/0"}, "restore-storage requires a storage ID and a snapshot ID")
+	s.testRestoreInitError(c, []string{"foo", "3"}, `storage ID "foo" not valid`)
+	s.testRestoreInitError(c, []string{"foo/0", "3", "extra"}, `unrecognized args: \["extra"\]`)
+}
+
+func (s *RestoreStorageSuite) testRestoreInitError(c *gc.C, args []string, expect string) {
+	cmd := storage.NewRestoreStorageCommandForTest(nil, jujuclienttesting.MinimalStore())
+	_, err := cmdtesting.RunCommand(c, cmd, args...)
+	c.Assert(err, gc.ErrorMatches, expect)
+}
+
+type fakeStorageRestorer struct {
+	testing.Stub
+}
+
+func (f *fakeStorageRestorer) new() (storage.StorageRestorerCloser, error) {
+	f.MethodCall(f, "NewStorageRestorerCloser")
+	return f, f.NextErr()
+}
+
+func (f *fakeStorageRestorer) Close() error {
+	f.MethodCall(f, "Close")
+	return f.NextErr()
+}
+
+func (f *fakeStorageRestorer) Restore(storageId, snapshotId string) error {
+	f.MethodCall(f, "Restore", storageId, snapshotId)
+	return f.NextErr()
+}
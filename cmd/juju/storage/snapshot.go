@@ -0,0 +1,134 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package storage
+
+import (
+	"github.com/juju/cmd"
+	"github.com/juju/errors"
+	"gopkg.in/juju/names.v2"
+
+	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/cmd/juju/common"
+	"github.com/juju/juju/cmd/modelcmd"
+)
+
+// NewSnapshotStorageCommandWithAPI returns a command
+// used to snapshot storage instances.
+func NewSnapshotStorageCommandWithAPI() cmd.Command {
+	cmd := &snapshotStorageCommand{}
+	cmd.newStorageSnapshotterCloser = func() (StorageSnapshotterCloser, error) {
+		return cmd.NewStorageAPI()
+	}
+	return modelcmd.Wrap(cmd)
+}
+
+// NewSnapshotStorageCommand returns a command used to
+// snapshot storage instances.
+func NewSnapshotStorageCommand(new NewStorageSnapshotterCloserFunc) cmd.Command {
+	cmd := &snapshotStorageCommand{}
+	cmd.newStorageSnapshotterCloser = new
+	return modelcmd.Wrap(cmd)
+}
+
+const (
+	snapshotStorageCommandDoc = `
+Takes a snapshot of the volume backing each of the specified storage
+instances. Specify one or more storage IDs, as output by "juju storage".
+
+Only volume-backed storage whose storage provider supports snapshots
+can be snapshotted. Snapshots are taken asynchronously by the storage
+provisioner; use "juju snapshots" to check on their progress.
+
+A snapshot may later be restored over the storage it was taken from
+with "juju restore-storage", or used to create new storage by
+specifying "snapshot:<id>" in a storage constraint.
+
+Examples:
+    juju snapshot-storage pgdata/0
+    juju snapshot-storage pgdata/0 pgdata/1
+
+See also:
+    snapshots
+    restore-storage
+`
+
+	snapshotStorageCommandArgs = `<storage> [<storage> ...]`
+)
+
+// snapshotStorageCommand snapshots storage instances.
+type snapshotStorageCommand struct {
+	StorageCommandBase
+	newStorageSnapshotterCloser NewStorageSnapshotterCloserFunc
+	storageIds                  []string
+}
+
+// Init implements Command.Init.
+func (c *snapshotStorageCommand) Init(args []string) error {
+	if len(args) < 1 {
+		return errors.New("snapshot-storage requires at least one storage ID")
+	}
+	for _, id := range args {
+		if !names.IsValidStorage(id) {
+			return errors.NotValidf("storage ID %q", id)
+		}
+	}
+	c.storageIds = args
+	return nil
+}
+
+// Info implements Command.Info.
+func (c *snapshotStorageCommand) Info() *cmd.Info {
+	return &cmd.Info{
+		Name:    "snapshot-storage",
+		Purpose: "Takes snapshots of storage.",
+		Doc:     snapshotStorageCommandDoc,
+		Args:    snapshotStorageCommandArgs,
+	}
+}
+
+// Run implements Command.Run.
+func (c *snapshotStorageCommand) Run(ctx *cmd.Context) error {
+	snapshotter, err := c.newStorageSnapshotterCloser()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer snapshotter.Close()
+
+	results, err := snapshotter.CreateSnapshots(c.storageIds)
+	if err != nil {
+		if params.IsCodeUnauthorized(err) {
+			common.PermissionsMessage(ctx.Stderr, "snapshot storage")
+		}
+		return err
+	}
+	var anyFailed bool
+	for i, result := range results {
+		if result.Error != nil {
+			ctx.Infof("failed to snapshot %s: %s", c.storageIds[i], result.Error)
+			anyFailed = true
+			continue
+		}
+		ctx.Infof("snapshotting %s as snapshot %s", c.storageIds[i], result.Result)
+	}
+	if anyFailed {
+		return cmd.ErrSilent
+	}
+	return nil
+}
+
+// NewStorageSnapshotterCloserFunc is the type of a function that returns a
+// StorageSnapshotterCloser.
+type NewStorageSnapshotterCloserFunc func() (StorageSnapshotterCloser, error)
+
+// StorageSnapshotterCloser extends StorageSnapshotter with a Closer method.
+type StorageSnapshotterCloser interface {
+	StorageSnapshotter
+	Close() error
+}
+
+// StorageSnapshotter defines an interface for snapshotting the storage
+// instances with the specified IDs.
+type StorageSnapshotter interface {
+	CreateSnapshots(storageIds []string) ([]params.StringResult, error)
+}
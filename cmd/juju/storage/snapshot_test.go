@@ -0,0 +1,107 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package storage_test
+
+import (
+	"github.com/juju/cmd"
+	"github.com/juju/cmd/cmdtesting"
+	"github.com/juju/errors"
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/cmd/juju/storage"
+	"github.com/juju/juju/jujuclient/jujuclienttesting"
+)
+
+type SnapshotStorageSuite struct {
+	testing.IsolationSuite
+}
+
+var _ = gc.Suite(&SnapshotStorageSuite{})
+
+func (s *SnapshotStorageSuite) TestSnapshot(c *gc.C) {
+	fake := fakeStorageSnapshotter{
+		results: []params.StringResult{{Result: "0"}, {Result: "1"}},
+	}
+	cmd := storage.NewSnapshotStorageCommandForTest(fake.new, jujuclienttesting.MinimalStore())
+	ctx, err := cmdtesting.RunCommand(c, cmd, "foo/0", "foo/1")
+	c.Assert(err, jc.ErrorIsNil)
+	fake.CheckCallNames(c, "NewStorageSnapshotterCloser", "CreateSnapshots", "Close")
+	fake.CheckCall(c, 1, "CreateSnapshots", []string{"foo/0", "foo/1"})
+	c.Assert(cmdtesting.Stderr(ctx), gc.Equals, `
+snapshotting foo/0 as snapshot 0
+snapshotting foo/1 as snapshot 1
+`[1:])
+}
+
+func (s *SnapshotStorageSuite) TestSnapshotResultError(c *gc.C) {
+	fake := fakeStorageSnapshotter{
+		results: []params.StringResult{
+			{Result: "0"},
+			{Error: &params.Error{Message: "volume snapshots not supported"}},
+		},
+	}
+	command := storage.NewSnapshotStorageCommandForTest(fake.new, jujuclienttesting.MinimalStore())
+	ctx, err := cmdtesting.RunCommand(c, command, "foo/0", "foo/1")
+	c.Assert(err, gc.Equals, cmd.ErrSilent)
+	c.Assert(cmdtesting.Stderr(ctx), gc.Equals, `
+snapshotting foo/0 as snapshot 0
+failed to snapshot foo/1: volume snapshots not supported
+`[1:])
+}
+
+func (s *SnapshotStorageSuite) TestSnapshotError(c *gc.C) {
+	var fake fakeStorageSnapshotter
+	fake.SetErrors(nil, errors.New("snapshotting storage on this juju controller not supported"))
+	cmd := storage.NewSnapshotStorageCommandForTest(fake.new, jujuclienttesting.MinimalStore())
+	_, err := cmdtesting.RunCommand(c, cmd, "foo/0")
+	c.Assert(err, gc.ErrorMatches, "snapshotting storage on this juju controller not supported")
+	fake.CheckCallNames(c, "NewStorageSnapshotterCloser", "CreateSnapshots", "Close")
+}
+
+func (s *SnapshotStorageSuite) TestSnapshotUnauthorizedError(c *gc.C) {
+	var fake fakeStorageSnapshotter
+	fake.SetErrors(nil, &params.Error{Code: params.CodeUnauthorized, Message: "nope"})
+	cmd := storage.NewSnapshotStorageCommandForTest(fake.new, jujuclienttesting.MinimalStore())
+	ctx, err := cmdtesting.RunCommand(c, cmd, "foo/0")
+	c.Assert(err, gc.ErrorMatches, "nope")
+	c.Assert(cmdtesting.Stderr(ctx), gc.Equals, `
+You do not have permission to snapshot storage.
+You may ask an administrator to grant you access with "juju grant".
+
+`)
+}
+
+func (s *SnapshotStorageSuite) TestSnapshotInitErrors(c *gc.C) {
+	s.testSnapshotInitError(c, []string{}, "snapshot-storage requires at least one storage ID")
+	s.testSnapshotInitError(c, []string{"foo/0", "bar"}, `storage ID "bar" not valid`)
+}
+
+func (s *SnapshotStorageSuite) testSnapshotInitError(c *gc.C, args []string, expect string) {
+	cmd := storage.NewSnapshotStorageCommandForTest(nil, jujuclienttesting.MinimalStore())
+	_, err := cmdtesting.RunCommand(c, cmd, args...)
+	c.Assert(err, gc.ErrorMatches, expect)
+}
+
+type fakeStorageSnapshotter struct {
+	testing.Stub
+	results []params.StringResult
+}
+
+func (f *fakeStorageSnapshotter) new() (storage.StorageSnapshotterCloser, error) {
+	f.MethodCall(f, "NewStorageSnapshotterCloser")
+	return f, f.NextErr()
+}
+
+func (f *fakeStorageSnapshotter) Close() error {
+	f.MethodCall(f, "Close")
+	return f.NextErr()
+}
+
+func (f *fakeStorageSnapshotter) CreateSnapshots(storageIds []string) ([]params.StringResult, error) {
+	f.MethodCall(f, "CreateSnapshots", storageIds)
+	return f.results, f.NextErr()
+}
@@ -0,0 +1,227 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package storage
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/dustin/go-humanize"
+	"github.com/juju/cmd"
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+	"github.com/juju/naturalsort"
+	"gopkg.in/juju/names.v2"
+
+	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/cmd/juju/common"
+	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/cmd/output"
+)
+
+// NewListSnapshotsCommandWithAPI returns a command
+// used to list volume snapshots.
+func NewListSnapshotsCommandWithAPI() cmd.Command {
+	cmd := &listSnapshotsCommand{}
+	cmd.newSnapshotListerCloser = func() (SnapshotListerCloser, error) {
+		return cmd.NewStorageAPI()
+	}
+	return modelcmd.Wrap(cmd)
+}
+
+// NewListSnapshotsCommand returns a command used to
+// list volume snapshots.
+func NewListSnapshotsCommand(new NewSnapshotListerCloserFunc) cmd.Command {
+	cmd := &listSnapshotsCommand{}
+	cmd.newSnapshotListerCloser = new
+	return modelcmd.Wrap(cmd)
+}
+
+const (
+	listSnapshotsCommandDoc = `
+Lists the snapshots taken of storage in the model. If storage IDs
+are specified, only snapshots of those storage instances are listed.
+
+A snapshot is "pending" until the storage provider has taken it,
+and "restoring" while it is being restored over its volume.
+
+Examples:
+    juju snapshots
+    juju snapshots pgdata/0
+
+See also:
+    snapshot-storage
+    restore-storage
+`
+
+	listSnapshotsCommandArgs = `[<storage> ...]`
+)
+
+// listSnapshotsCommand lists volume snapshots.
+type listSnapshotsCommand struct {
+	StorageCommandBase
+	newSnapshotListerCloser NewSnapshotListerCloserFunc
+	storageIds              []string
+	out                     cmd.Output
+}
+
+// Init implements Command.Init.
+func (c *listSnapshotsCommand) Init(args []string) error {
+	for _, id := range args {
+		if !names.IsValidStorage(id) {
+			return errors.NotValidf("storage ID %q", id)
+		}
+	}
+	c.storageIds = args
+	return nil
+}
+
+// Info implements Command.Info.
+func (c *listSnapshotsCommand) Info() *cmd.Info {
+	return &cmd.Info{
+		Name:    "snapshots",
+		Purpose: "Lists storage snapshots.",
+		Doc:     listSnapshotsCommandDoc,
+		Args:    listSnapshotsCommandArgs,
+		Aliases: []string{"list-snapshots"},
+	}
+}
+
+// SetFlags implements Command.SetFlags.
+func (c *listSnapshotsCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.StorageCommandBase.SetFlags(f)
+	c.out.AddFlags(f, "tabular", map[string]cmd.Formatter{
+		"yaml":    cmd.FormatYaml,
+		"json":    cmd.FormatJson,
+		"tabular": formatSnapshotListTabular,
+	})
+}
+
+// Run implements Command.Run.
+func (c *listSnapshotsCommand) Run(ctx *cmd.Context) error {
+	lister, err := c.newSnapshotListerCloser()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer lister.Close()
+
+	snapshots, err := lister.ListSnapshots(c.storageIds)
+	if err != nil {
+		if params.IsCodeUnauthorized(err) {
+			common.PermissionsMessage(ctx.Stderr, "list storage snapshots")
+		}
+		return err
+	}
+	if len(snapshots) == 0 {
+		ctx.Infof("No storage snapshots to display.")
+		return nil
+	}
+	infos, err := formatSnapshotInfo(snapshots)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	return c.out.Write(ctx, infos)
+}
+
+// SnapshotInfo defines the serialization behaviour of volume snapshot
+// information.
+type SnapshotInfo struct {
+	Storage    string `yaml:"storage,omitempty" json:"storage,omitempty"`
+	Volume     string `yaml:"volume" json:"volume"`
+	Pool       string `yaml:"pool,omitempty" json:"pool,omitempty"`
+	SnapshotId string `yaml:"snapshot-id,omitempty" json:"snapshot-id,omitempty"`
+	Size       uint64 `yaml:"size,omitempty" json:"size,omitempty"`
+	Status     string `yaml:"status" json:"status"`
+	Message    string `yaml:"message,omitempty" json:"message,omitempty"`
+	Created    string `yaml:"created" json:"created"`
+}
+
+const (
+	snapshotStatusPending   = "pending"
+	snapshotStatusRestoring = "restoring"
+	snapshotStatusAvailable = "available"
+)
+
+func formatSnapshotInfo(all []params.VolumeSnapshotDetails) (map[string]SnapshotInfo, error) {
+	output := make(map[string]SnapshotInfo)
+	for _, details := range all {
+		volumeId, err := idFromTag(details.VolumeTag)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		info := SnapshotInfo{
+			Volume:     volumeId,
+			Pool:       details.Pool,
+			SnapshotId: details.SnapshotId,
+			Size:       details.Size,
+			Message:    details.Message,
+			Created:    common.FormatTime(&details.Created, false),
+		}
+		if details.StorageTag != "" {
+			storageId, err := idFromTag(details.StorageTag)
+			if err != nil {
+				return nil, errors.Trace(err)
+			}
+			info.Storage = storageId
+		}
+		switch {
+		case details.Restoring:
+			info.Status = snapshotStatusRestoring
+		case details.SnapshotId == "":
+			info.Status = snapshotStatusPending
+		default:
+			info.Status = snapshotStatusAvailable
+		}
+		output[details.Id] = info
+	}
+	return output, nil
+}
+
+// formatSnapshotListTabular returns a tabular summary of volume snapshots.
+func formatSnapshotListTabular(writer io.Writer, value interface{}) error {
+	infos, ok := value.(map[string]SnapshotInfo)
+	if !ok {
+		return errors.Errorf("expected value of type %T, got %T", infos, value)
+	}
+	tw := output.TabWriter(writer)
+	print := func(values ...string) {
+		fmt.Fprintln(tw, strings.Join(values, "\t"))
+	}
+	print("Snapshot", "Storage", "Volume", "Pool", "Provider Id", "Size", "Created", "Status", "Message")
+
+	ids := make([]string, 0, len(infos))
+	for id := range infos {
+		ids = append(ids, id)
+	}
+	for _, id := range naturalsort.Sort(ids) {
+		info := infos[id]
+		var size string
+		if info.Size > 0 {
+			size = humanize.IBytes(info.Size * humanize.MiByte)
+		}
+		print(
+			id, info.Storage, info.Volume, info.Pool,
+			info.SnapshotId, size, info.Created,
+			info.Status, info.Message,
+		)
+	}
+	return tw.Flush()
+}
+
+// NewSnapshotListerCloserFunc is the type of a function that returns a
+// SnapshotListerCloser.
+type NewSnapshotListerCloserFunc func() (SnapshotListerCloser, error)
+
+// SnapshotListerCloser extends SnapshotLister with a Closer method.
+type SnapshotListerCloser interface {
+	SnapshotLister
+	Close() error
+}
+
+// SnapshotLister defines an interface for listing the volume snapshots
+// of the storage instances with the specified IDs.
+type SnapshotLister interface {
+	ListSnapshots(storageIds []string) ([]params.VolumeSnapshotDetails, error)
+}
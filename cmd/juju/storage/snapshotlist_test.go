@@ -0,0 +1,122 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package storage_test
+
+import (
+	"time"
+
+	"github.com/juju/cmd/cmdtesting"
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/cmd/juju/storage"
+	"github.com/juju/juju/jujuclient/jujuclienttesting"
+)
+
+type ListSnapshotsSuite struct {
+	testing.IsolationSuite
+	created time.Time
+	fake    fakeSnapshotLister
+}
+
+var _ = gc.Suite(&ListSnapshotsSuite{})
+
+func (s *ListSnapshotsSuite) SetUpTest(c *gc.C) {
+	s.IsolationSuite.SetUpTest(c)
+	s.PatchValue(&time.Local, time.FixedZone("Australia/Perth", 3600*8))
+	s.created = time.Date(2018, 1, 2, 3, 4, 5, 0, time.UTC)
+	s.fake = fakeSnapshotLister{
+		snapshots: []params.VolumeSnapshotDetails{{
+			Id:         "10",
+			VolumeTag:  "volume-1",
+			StorageTag: "storage-data-1",
+			Pool:       "loop",
+			Created:    s.created,
+			Message:    "copying",
+		}, {
+			Id:         "2",
+			VolumeTag:  "volume-0",
+			StorageTag: "storage-data-0",
+			Pool:       "loop",
+			Created:    s.created,
+			SnapshotId: "volume-0-snapshot-2",
+			Size:       1024,
+			Restoring:  true,
+		}},
+	}
+}
+
+func (s *ListSnapshotsSuite) TestListTabular(c *gc.C) {
+	cmd := storage.NewListSnapshotsCommandForTest(s.fake.new, jujuclienttesting.MinimalStore())
+	ctx, err := cmdtesting.RunCommand(c, cmd, "data/0", "data/1")
+	c.Assert(err, jc.ErrorIsNil)
+	s.fake.CheckCallNames(c, "NewSnapshotListerCloser", "ListSnapshots", "Close")
+	s.fake.CheckCall(c, 1, "ListSnapshots", []string{"data/0", "data/1"})
+	c.Assert(cmdtesting.Stdout(ctx), gc.Equals, `
+Snapshot  Storage  Volume  Pool  Provider Id          Size    Created                     Status     Message
+2         data/0   0       loop  volume-0-snapshot-2  1.0GiB  02 Jan 2018 11:04:05+08:00  restoring  
+10        data/1   1       loop                               02 Jan 2018 11:04:05+08:00  pending    copying
+`[1:])
+}
+
+func (s *ListSnapshotsSuite) TestListYAML(c *gc.C) {
+	cmd := storage.NewListSnapshotsCommandForTest(s.fake.new, jujuclienttesting.MinimalStore())
+	ctx, err := cmdtesting.RunCommand(c, cmd, "--format", "yaml")
+	c.Assert(err, jc.ErrorIsNil)
+	s.fake.CheckCall(c, 1, "ListSnapshots", []string{})
+	c.Assert(cmdtesting.Stdout(ctx), gc.Equals, `
+"2":
+  storage: data/0
+  volume: "0"
+  pool: loop
+  snapshot-id: volume-0-snapshot-2
+  size: 1024
+  status: restoring
+  created: 02 Jan 2018 11:04:05+08:00
+"10":
+  storage: data/1
+  volume: "1"
+  pool: loop
+  status: pending
+  message: copying
+  created: 02 Jan 2018 11:04:05+08:00
+`[1:])
+}
+
+func (s *ListSnapshotsSuite) TestListEmpty(c *gc.C) {
+	s.fake.snapshots = nil
+	cmd := storage.NewListSnapshotsCommandForTest(s.fake.new, jujuclienttesting.MinimalStore())
+	ctx, err := cmdtesting.RunCommand(c, cmd)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(cmdtesting.Stdout(ctx), gc.Equals, "")
+	c.Assert(cmdtesting.Stderr(ctx), gc.Equals, "No storage snapshots to display.\n")
+}
+
+func (s *ListSnapshotsSuite) TestListInitErrors(c *gc.C) {
+	cmd := storage.NewListSnapshotsCommandForTest(nil, jujuclienttesting.MinimalStore())
+	_, err := cmdtesting.RunCommand(c, cmd, "data")
+	c.Assert(err, gc.ErrorMatches, `storage ID "data" not valid`)
+}
+
+type fakeSnapshotLister struct {
+	testing.Stub
+	snapshots []params.VolumeSnapshotDetails
+}
+
+func (f *fakeSnapshotLister) new() (storage.SnapshotListerCloser, error) {
+	f.MethodCall(f, "NewSnapshotListerCloser")
+	return f, f.NextErr()
+}
+
+func (f *fakeSnapshotLister) Close() error {
+	f.MethodCall(f, "Close")
+	return f.NextErr()
+}
+
+func (f *fakeSnapshotLister) ListSnapshots(storageIds []string) ([]params.VolumeSnapshotDetails, error) {
+	f.MethodCall(f, "ListSnapshots", storageIds)
+	return f.snapshots, f.NextErr()
+}
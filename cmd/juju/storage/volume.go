@@ -61,11 +61,12 @@ type VolumeAttachments struct {
 }
 
 type MachineVolumeAttachment struct {
-	DeviceName string `yaml:"device,omitempty" json:"device,omitempty"`
-	DeviceLink string `yaml:"device-link,omitempty" json:"device-link,omitempty"`
-	BusAddress string `yaml:"bus-address,omitempty" json:"bus-address,omitempty"`
-	ReadOnly   bool   `yaml:"read-only" json:"read-only"`
-	Life       string `yaml:"life,omitempty" json:"life,omitempty"`
+	DeviceName string           `yaml:"device,omitempty" json:"device,omitempty"`
+	DeviceLink string           `yaml:"device-link,omitempty" json:"device-link,omitempty"`
+	BusAddress string           `yaml:"bus-address,omitempty" json:"bus-address,omitempty"`
+	ReadOnly   bool             `yaml:"read-only" json:"read-only"`
+	Life       string           `yaml:"life,omitempty" json:"life,omitempty"`
+	Usage      *FilesystemUsage `yaml:"usage,omitempty" json:"usage,omitempty"`
 	// TODO(axw) add machine volume attachment status when we have it
 }
 
@@ -147,6 +148,7 @@ func createVolumeInfo(details params.VolumeDetails) (names.VolumeTag, VolumeInfo
 				attachment.BusAddress,
 				attachment.ReadOnly,
 				string(attachment.Life),
+				convertFilesystemUsage(attachment.Usage),
 			}
 		}
 		info.Attachments = &VolumeAttachments{
@@ -118,6 +118,52 @@ func (s *ListSuite) TestVolumeListTabular(c *gc.C) {
 	s.assertValidVolumeList(c, []string{}, expectedVolumeListTabular)
 }
 
+func (s *ListSuite) TestVolumeListTabularUsage(c *gc.C) {
+	s.mockAPI.listVolumes = func([]string) ([]params.VolumeDetailsListResult, error) {
+		return []params.VolumeDetailsListResult{{Result: []params.VolumeDetails{{
+			VolumeTag: "volume-0",
+			Info: params.VolumeInfo{
+				VolumeId: "provider-supplied-volume-0",
+				Size:     1024,
+			},
+			Status: createTestStatus(status.Warning, "95% used, above the warning threshold of 90%"),
+			MachineAttachments: map[string]params.VolumeAttachmentDetails{
+				"machine-0": {
+					VolumeAttachmentInfo: params.VolumeAttachmentInfo{
+						DeviceName: "sdb",
+					},
+					Usage: &params.FilesystemUsage{
+						Size:      1024 * 1024 * 1024,
+						Used:      95 * 1024 * 1024,
+						Available: 5 * 1024 * 1024,
+					},
+				},
+			},
+		}, {
+			VolumeTag: "volume-1",
+			Info: params.VolumeInfo{
+				VolumeId: "provider-supplied-volume-1",
+				Size:     2048,
+			},
+			Status: createTestStatus(status.Attached, ""),
+			MachineAttachments: map[string]params.VolumeAttachmentDetails{
+				"machine-0": {
+					VolumeAttachmentInfo: params.VolumeAttachmentInfo{
+						DeviceName: "sdc",
+					},
+				},
+			},
+		}}}}, nil
+	}
+	s.assertValidVolumeList(c, []string{}, `
+[Volumes]
+Machine  Unit  Storage  Id  Provider Id                 Device  Size    Used  State     Message
+0                       0   provider-supplied-volume-0  sdb     1.0GiB  95%   warning   95% used, above the warning threshold of 90%
+0                       1   provider-supplied-volume-1  sdc     2.0GiB        attached  
+
+`[1:])
+}
+
 func (s *ListSuite) assertUnmarshalledVolumeOutput(c *gc.C, unmarshal unmarshaller, expectedErr string, args ...string) {
 	context, err := s.runVolumeList(c, args...)
 	c.Assert(err, jc.ErrorIsNil)
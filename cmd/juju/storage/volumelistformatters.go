@@ -22,7 +22,6 @@ func formatVolumeListTabular(writer io.Writer, infos map[string]VolumeInfo) erro
 		fmt.Fprintln(tw, strings.Join(values, "\t"))
 	}
 	print("[Volumes]")
-	print("Machine", "Unit", "Storage", "Id", "Provider Id", "Device", "Size", "State", "Message")
 
 	volumeAttachmentInfos := make(volumeAttachmentInfos, 0, len(infos))
 	for volumeId, info := range infos {
@@ -55,17 +54,40 @@ func formatVolumeListTabular(writer io.Writer, infos map[string]VolumeInfo) erro
 	}
 	sort.Sort(volumeAttachmentInfos)
 
+	// The "Used" column is only shown if usage has been
+	// reported for at least one of the volumes.
+	var showUsage bool
+	for _, info := range volumeAttachmentInfos {
+		if info.Usage != nil {
+			showUsage = true
+			break
+		}
+	}
+	if showUsage {
+		print("Machine", "Unit", "Storage", "Id", "Provider Id", "Device", "Size", "Used", "State", "Message")
+	} else {
+		print("Machine", "Unit", "Storage", "Id", "Provider Id", "Device", "Size", "State", "Message")
+	}
+
 	for _, info := range volumeAttachmentInfos {
 		var size string
 		if info.Size > 0 {
 			size = humanize.IBytes(info.Size * humanize.MiByte)
 		}
-		print(
+		values := []string{
 			info.MachineId, info.UnitId, info.Storage,
 			info.VolumeId, info.ProviderVolumeId,
 			info.DeviceName, size,
-			string(info.Status.Current), info.Status.Message,
-		)
+		}
+		if showUsage {
+			var used string
+			if info.Usage != nil {
+				used = fmt.Sprintf("%d%%", info.Usage.UsedPercent)
+			}
+			values = append(values, used)
+		}
+		values = append(values, string(info.Status.Current), info.Status.Message)
+		print(values...)
 	}
 
 	return tw.Flush()
@@ -0,0 +1,268 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package waitfor
+
+import (
+	"sort"
+	"strconv"
+
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/state/multiwatcher"
+	"github.com/juju/juju/status"
+)
+
+// modelState holds the entities of the model relevant to wait-for,
+// as reported by the AllWatcher.
+type modelState struct {
+	model        *multiwatcher.ModelInfo
+	applications map[string]*multiwatcher.ApplicationInfo
+	units        map[string]*multiwatcher.UnitInfo
+	machines     map[string]*multiwatcher.MachineInfo
+}
+
+func newModelState() *modelState {
+	return &modelState{
+		applications: make(map[string]*multiwatcher.ApplicationInfo),
+		units:        make(map[string]*multiwatcher.UnitInfo),
+		machines:     make(map[string]*multiwatcher.MachineInfo),
+	}
+}
+
+// apply updates the state with the given deltas.
+func (s *modelState) apply(deltas []multiwatcher.Delta) {
+	for _, d := range deltas {
+		switch info := d.Entity.(type) {
+		case *multiwatcher.ModelInfo:
+			if d.Removed {
+				s.model = nil
+			} else {
+				s.model = info
+			}
+		case *multiwatcher.ApplicationInfo:
+			if d.Removed {
+				delete(s.applications, info.Name)
+			} else {
+				s.applications[info.Name] = info
+			}
+		case *multiwatcher.UnitInfo:
+			if d.Removed {
+				delete(s.units, info.Name)
+			} else {
+				s.units[info.Name] = info
+			}
+		case *multiwatcher.MachineInfo:
+			if d.Removed {
+				delete(s.machines, info.Id)
+			} else {
+				s.machines[info.Id] = info
+			}
+		}
+	}
+}
+
+// applicationUnits returns the units of the named application,
+// sorted by name.
+func (s *modelState) applicationUnits(name string) []*multiwatcher.UnitInfo {
+	var units []*multiwatcher.UnitInfo
+	for _, u := range s.units {
+		if u.Application == name {
+			units = append(units, u)
+		}
+	}
+	sort.Slice(units, func(i, j int) bool {
+		return units[i].Name < units[j].Name
+	})
+	return units
+}
+
+// target describes how to wait for an entity of a particular kind.
+type target struct {
+	// fields holds the names of the fields which may be used in
+	// queries on the entity.
+	fields []string
+
+	// defaultQuery is the query used if none is specified.
+	defaultQuery string
+
+	// exists reports whether the named entity exists.
+	exists func(s *modelState, name string) bool
+
+	// values returns the values of a field of the named entity,
+	// which is known to exist.
+	values func(s *modelState, name, field string) []string
+
+	// checkError returns an error if the named entity, which
+	// is known to exist, is in an error state.
+	checkError func(s *modelState, name string) error
+}
+
+var targets = map[string]target{
+	"application": {
+		fields: []string{
+			"life", "status", "message", "exposed", "charm-url", "workload-version",
+			"units", "unit-workload-status", "unit-agent-status",
+		},
+		defaultQuery: "unit-workload-status==active && unit-agent-status==idle",
+		exists: func(s *modelState, name string) bool {
+			return s.applications[name] != nil
+		},
+		values:     applicationValues,
+		checkError: applicationError,
+	},
+	"unit": {
+		fields: []string{
+			"life", "application", "machine", "workload-status", "workload-message", "agent-status",
+		},
+		defaultQuery: "workload-status==active && agent-status==idle",
+		exists: func(s *modelState, name string) bool {
+			return s.units[name] != nil
+		},
+		values: func(s *modelState, name, field string) []string {
+			return unitValues(s.units[name], field)
+		},
+		checkError: func(s *modelState, name string) error {
+			return unitError(s.units[name])
+		},
+	},
+	"machine": {
+		fields: []string{
+			"life", "agent-status", "instance-status", "instance-id", "series",
+		},
+		defaultQuery: "agent-status==started",
+		exists: func(s *modelState, name string) bool {
+			return s.machines[name] != nil
+		},
+		values:     machineValues,
+		checkError: machineError,
+	},
+	"model": {
+		fields:       []string{"life", "status", "message"},
+		defaultQuery: "status==available",
+		exists: func(s *modelState, _ string) bool {
+			return s.model != nil
+		},
+		values:     modelValues,
+		checkError: modelError,
+	},
+}
+
+func applicationValues(s *modelState, name, field string) []string {
+	app := s.applications[name]
+	units := s.applicationUnits(name)
+	switch field {
+	case "life":
+		return []string{string(app.Life)}
+	case "status":
+		return []string{string(app.Status.Current)}
+	case "message":
+		return []string{app.Status.Message}
+	case "exposed":
+		return []string{strconv.FormatBool(app.Exposed)}
+	case "charm-url":
+		return []string{app.CharmURL}
+	case "workload-version":
+		return []string{app.WorkloadVersion}
+	case "units":
+		return []string{strconv.Itoa(len(units))}
+	case "unit-workload-status":
+		return unitsValues(units, "workload-status")
+	case "unit-agent-status":
+		return unitsValues(units, "agent-status")
+	}
+	return nil
+}
+
+func unitsValues(units []*multiwatcher.UnitInfo, field string) []string {
+	var values []string
+	for _, u := range units {
+		values = append(values, unitValues(u, field)...)
+	}
+	return values
+}
+
+func unitValues(unit *multiwatcher.UnitInfo, field string) []string {
+	switch field {
+	case "life":
+		return []string{string(unit.Life)}
+	case "application":
+		return []string{unit.Application}
+	case "machine":
+		return []string{unit.MachineId}
+	case "workload-status":
+		return []string{string(unit.WorkloadStatus.Current)}
+	case "workload-message":
+		return []string{unit.WorkloadStatus.Message}
+	case "agent-status":
+		return []string{string(unit.AgentStatus.Current)}
+	}
+	return nil
+}
+
+func machineValues(s *modelState, name, field string) []string {
+	machine := s.machines[name]
+	switch field {
+	case "life":
+		return []string{string(machine.Life)}
+	case "agent-status":
+		return []string{string(machine.AgentStatus.Current)}
+	case "instance-status":
+		return []string{string(machine.InstanceStatus.Current)}
+	case "instance-id":
+		return []string{machine.InstanceId}
+	case "series":
+		return []string{machine.Series}
+	}
+	return nil
+}
+
+func modelValues(s *modelState, _, field string) []string {
+	switch field {
+	case "life":
+		return []string{string(s.model.Life)}
+	case "status":
+		return []string{string(s.model.Status.Current)}
+	case "message":
+		return []string{s.model.Status.Message}
+	}
+	return nil
+}
+
+func applicationError(s *modelState, name string) error {
+	if app := s.applications[name]; app.Status.Current == status.Error {
+		return errors.Errorf("application %s is in error state: %s", name, app.Status.Message)
+	}
+	for _, u := range s.applicationUnits(name) {
+		if err := unitError(u); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	return nil
+}
+
+func unitError(unit *multiwatcher.UnitInfo) error {
+	for _, st := range []multiwatcher.StatusInfo{unit.WorkloadStatus, unit.AgentStatus} {
+		if st.Current == status.Error {
+			return errors.Errorf("unit %s is in error state: %s", unit.Name, st.Message)
+		}
+	}
+	return nil
+}
+
+func machineError(s *modelState, name string) error {
+	machine := s.machines[name]
+	for _, st := range []multiwatcher.StatusInfo{machine.AgentStatus, machine.InstanceStatus} {
+		if st.Current == status.Error || st.Current == status.ProvisioningError {
+			return errors.Errorf("machine %s is in error state: %s", name, st.Message)
+		}
+	}
+	return nil
+}
+
+func modelError(s *modelState, _ string) error {
+	if s.model.Status.Current == status.Error {
+		return errors.Errorf("model %s is in error state: %s", s.model.Name, s.model.Status.Message)
+	}
+	return nil
+}
@@ -0,0 +1,29 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package waitfor
+
+import (
+	"github.com/juju/cmd"
+	"github.com/juju/utils/clock"
+
+	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/jujuclient/jujuclienttesting"
+)
+
+// NewWaitForCommandForTest returns a wait-for command using the
+// given API and clock.
+func NewWaitForCommandForTest(api WaitForAPI, clock clock.Clock) cmd.Command {
+	c := &waitForCommand{api: api, clock: clock}
+	c.SetClientStore(jujuclienttesting.MinimalStore())
+	return modelcmd.Wrap(c)
+}
+
+// ParseQuery parses the query, returning it in canonical form.
+func ParseQuery(expr string, fields []string) (string, error) {
+	q, err := parseQuery(expr, fields)
+	if err != nil {
+		return "", err
+	}
+	return q.String(), nil
+}
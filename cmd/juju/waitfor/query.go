@@ -0,0 +1,118 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package waitfor
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/juju/errors"
+)
+
+// term is a single comparison of a status field with a value.
+type term struct {
+	field string
+	equal bool
+	value string
+}
+
+// String returns the term in the form it was given.
+func (t term) String() string {
+	op := "!="
+	if t.equal {
+		op = "=="
+	}
+	return fmt.Sprintf("%s%s%s", t.field, op, t.value)
+}
+
+// query is a conjunction of terms, all of which must hold for the
+// query to be satisfied.
+type query []term
+
+// parseQuery parses a query of the form
+//
+//	<field>==<value> && <field>!=<value> ...
+//
+// checking that each field is one of the given fields. Values
+// may be enclosed in double quotes.
+func parseQuery(expr string, fields []string) (query, error) {
+	known := make(map[string]bool)
+	for _, f := range fields {
+		known[f] = true
+	}
+	var q query
+	for _, part := range strings.Split(expr, "&&") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			return nil, errors.NotValidf("query %q with empty term", expr)
+		}
+		t, err := parseTerm(part)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		if !known[t.field] {
+			return nil, errors.Errorf("unknown field %q, expected one of %s", t.field, strings.Join(fields, ", "))
+		}
+		q = append(q, t)
+	}
+	return q, nil
+}
+
+func parseTerm(s string) (term, error) {
+	var t term
+	var i int
+	if i = strings.Index(s, "=="); i >= 0 {
+		t.equal = true
+	} else if i = strings.Index(s, "!="); i < 0 {
+		return term{}, errors.NotValidf("term %q without == or !=", s)
+	}
+	t.field = strings.TrimSpace(s[:i])
+	t.value = strings.TrimSpace(s[i+2:])
+	if len(t.value) >= 2 && strings.HasPrefix(t.value, `"`) && strings.HasSuffix(t.value, `"`) {
+		t.value = t.value[1 : len(t.value)-1]
+	}
+	if t.field == "" {
+		return term{}, errors.NotValidf("term %q without field", s)
+	}
+	return t, nil
+}
+
+// fieldValues returns the values of the named field. A field may
+// have several values, such as the workload status of each unit of
+// an application.
+type fieldValues func(field string) []string
+
+// eval reports whether the query holds. An "==" term holds if the
+// field has at least one value and all of its values are equal to
+// the term's value; a "!=" term holds if none of them are. eval
+// also returns the first term which does not hold, if any.
+func (q query) eval(values fieldValues) (bool, *term) {
+	for i, t := range q {
+		if !t.eval(values(t.field)) {
+			return false, &q[i]
+		}
+	}
+	return true, nil
+}
+
+func (t term) eval(values []string) bool {
+	if t.equal && len(values) == 0 {
+		return false
+	}
+	for _, v := range values {
+		if (v == t.value) != t.equal {
+			return false
+		}
+	}
+	return true
+}
+
+// String returns the query in the form it was given.
+func (q query) String() string {
+	terms := make([]string, len(q))
+	for i, t := range q {
+		terms[i] = t.String()
+	}
+	return strings.Join(terms, " && ")
+}
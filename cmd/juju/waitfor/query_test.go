@@ -0,0 +1,52 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package waitfor_test
+
+import (
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/cmd/juju/waitfor"
+)
+
+type querySuite struct{}
+
+var _ = gc.Suite(&querySuite{})
+
+var fields = []string{"status", "message"}
+
+func (s *querySuite) TestParseQuery(c *gc.C) {
+	for i, test := range []struct {
+		expr   string
+		result string
+		err    string
+	}{{
+		expr:   "status==active",
+		result: "status==active",
+	}, {
+		expr:   ` status == active &&message!="Ready to go" `,
+		result: "status==active && message!=Ready to go",
+	}, {
+		expr: "status=active",
+		err:  `term "status=active" without == or != not valid`,
+	}, {
+		expr: "status==active &&",
+		err:  `query "status==active &&" with empty term not valid`,
+	}, {
+		expr: "==active",
+		err:  `term "==active" without field not valid`,
+	}, {
+		expr: "life==alive",
+		err:  `unknown field "life", expected one of status, message`,
+	}} {
+		c.Logf("test %d: %q", i, test.expr)
+		result, err := waitfor.ParseQuery(test.expr, fields)
+		if test.err != "" {
+			c.Check(err, gc.ErrorMatches, test.err)
+			continue
+		}
+		c.Check(err, jc.ErrorIsNil)
+		c.Check(result, gc.Equals, test.result)
+	}
+}
@@ -0,0 +1,310 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package waitfor
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/juju/cmd"
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+	"github.com/juju/utils/clock"
+	"gopkg.in/juju/names.v2"
+
+	"github.com/juju/juju/api"
+	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/state/multiwatcher"
+)
+
+const waitForDoc = `
+Wait until an entity in the model satisfies a condition, or until it is
+removed. The model is watched for changes, so there is no need to poll
+"juju status".
+
+The entity is one of:
+
+    application <name>
+    unit <name>
+    machine <id>
+    model
+
+The condition is given with --query as a list of comparisons of status
+fields, joined by "&&". Each comparison is of the form <field>==<value>
+or <field>!=<value>. The fields are:
+
+    application: life, status, message, exposed, charm-url,
+                 workload-version, units, unit-workload-status,
+                 unit-agent-status
+    unit:        life, application, machine, workload-status,
+                 workload-message, agent-status
+    machine:     life, agent-status, instance-status, instance-id, series
+    model:       life, status, message
+
+The unit-workload-status and unit-agent-status fields of an application
+compare against every unit of the application; "==" requires that the
+application has units and that all of them match.
+
+If no query is given, the command waits for all units of an application
+to be active and idle, for a unit to be active and idle, for a machine
+to be started, or for the model to be available.
+
+The command fails with a non-zero exit code if the entity, or any unit
+of an application, enters an error state, or if the condition is not
+met within the timeout. When waiting for an entity to be removed, the
+command fails if the entity does not exist when it starts.
+
+Examples:
+
+    juju wait-for application mysql
+    juju wait-for application mysql --query 'units==3 && unit-workload-status==active'
+    juju wait-for unit mysql/0 --query 'workload-message=="Ready"'
+    juju wait-for machine 3 --timeout 30m
+    juju wait-for application mysql --removed
+
+See also:
+    status
+`
+
+// AllWatcher is the AllWatcher API used by the wait-for command.
+type AllWatcher interface {
+	Next() ([]multiwatcher.Delta, error)
+	Stop() error
+}
+
+// WaitForAPI is the API used by the wait-for command.
+type WaitForAPI interface {
+	WatchAll() (AllWatcher, error)
+	Close() error
+}
+
+// NewWaitForCommand returns a command which waits for a condition on an
+// entity in the model to be met.
+func NewWaitForCommand() cmd.Command {
+	return modelcmd.Wrap(&waitForCommand{clock: clock.WallClock})
+}
+
+type waitForCommand struct {
+	modelcmd.ModelCommandBase
+
+	api   WaitForAPI
+	clock clock.Clock
+
+	kind    string
+	name    string
+	expr    string
+	removed bool
+	timeout time.Duration
+
+	target target
+	query  query
+}
+
+// Info implements Command.Info.
+func (c *waitForCommand) Info() *cmd.Info {
+	return &cmd.Info{
+		Name:    "wait-for",
+		Args:    "(application|unit|machine) <name> | model",
+		Purpose: "Waits for an entity in the model to meet a condition.",
+		Doc:     waitForDoc,
+	}
+}
+
+// SetFlags implements Command.SetFlags.
+func (c *waitForCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.ModelCommandBase.SetFlags(f)
+	f.StringVar(&c.expr, "query", "", "The condition to wait for")
+	f.BoolVar(&c.removed, "removed", false, "Wait for the entity to be removed")
+	f.DurationVar(&c.timeout, "timeout", 10*time.Minute, "How long to wait before failing")
+}
+
+// Init implements Command.Init.
+func (c *waitForCommand) Init(args []string) error {
+	if len(args) == 0 {
+		return errors.New("no entity specified")
+	}
+	c.kind, args = args[0], args[1:]
+	var ok bool
+	if c.target, ok = targets[c.kind]; !ok {
+		var kinds []string
+		for kind := range targets {
+			kinds = append(kinds, kind)
+		}
+		sort.Strings(kinds)
+		return errors.Errorf("unknown entity %q, expected one of %s", c.kind, strings.Join(kinds, ", "))
+	}
+	if c.kind != "model" {
+		if len(args) == 0 {
+			return errors.Errorf("no %s name specified", c.kind)
+		}
+		c.name, args = args[0], args[1:]
+		if err := c.validateName(); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	if c.removed && c.expr != "" {
+		return errors.New("cannot specify both --query and --removed")
+	}
+	if c.timeout <= 0 {
+		return errors.NotValidf("timeout %v", c.timeout)
+	}
+	if !c.removed {
+		expr := c.expr
+		if expr == "" {
+			expr = c.target.defaultQuery
+		}
+		var err error
+		if c.query, err = parseQuery(expr, c.target.fields); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	return cmd.CheckEmpty(args)
+}
+
+func (c *waitForCommand) validateName() error {
+	var valid bool
+	switch c.kind {
+	case "application":
+		valid = names.IsValidApplication(c.name)
+	case "unit":
+		valid = names.IsValidUnit(c.name)
+	case "machine":
+		valid = names.IsValidMachine(c.name)
+	}
+	if !valid {
+		return errors.NotValidf("%s name %q", c.kind, c.name)
+	}
+	return nil
+}
+
+func (c *waitForCommand) getAPI() (WaitForAPI, error) {
+	if c.api != nil {
+		return c.api, nil
+	}
+	client, err := c.NewAPIClient()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return clientShim{client}, nil
+}
+
+type nextResult struct {
+	deltas []multiwatcher.Delta
+	err    error
+}
+
+// Run implements Command.Run.
+func (c *waitForCommand) Run(ctx *cmd.Context) error {
+	client, err := c.getAPI()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer client.Close()
+
+	watcher, err := client.WatchAll()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	// Stopping the watcher unblocks any pending call to Next.
+	defer watcher.Stop()
+
+	done := make(chan struct{})
+	defer close(done)
+	results := make(chan nextResult)
+	go func() {
+		for {
+			deltas, err := watcher.Next()
+			select {
+			case results <- nextResult{deltas, err}:
+			case <-done:
+				return
+			}
+			if err != nil {
+				return
+			}
+		}
+	}()
+
+	timeout := c.clock.After(c.timeout)
+	state := newModelState()
+	var unmet *term
+	initial := true
+	for {
+		select {
+		case result := <-results:
+			if result.err != nil {
+				return errors.Annotate(result.err, "watching model")
+			}
+			state.apply(result.deltas)
+			// The first deltas describe the whole model, so an
+			// entity missing from them does not exist.
+			if initial && c.removed && !c.target.exists(state, c.name) {
+				return errors.NotFoundf("%s", c.describe())
+			}
+			initial = false
+			var met bool
+			met, unmet, err = c.check(state)
+			if err != nil {
+				return errors.Trace(err)
+			}
+			if met {
+				ctx.Infof("%s: %s", c.describe(), c.condition())
+				return nil
+			}
+		case <-timeout:
+			msg := fmt.Sprintf("timed out after %v waiting for %s: %s", c.timeout, c.describe(), c.condition())
+			if unmet != nil {
+				msg += fmt.Sprintf(" (%s not met)", unmet)
+			}
+			return errors.New(msg)
+		}
+	}
+}
+
+// check reports whether the condition is met, returning the first
+// unmet term of the query if not, or an error if the entity is in an
+// error state.
+func (c *waitForCommand) check(state *modelState) (bool, *term, error) {
+	exists := c.target.exists(state, c.name)
+	if c.removed || !exists {
+		return c.removed && !exists, nil, nil
+	}
+	if err := c.target.checkError(state, c.name); err != nil {
+		return false, nil, errors.Trace(err)
+	}
+	met, unmet := c.query.eval(func(field string) []string {
+		return c.target.values(state, c.name, field)
+	})
+	return met, unmet, nil
+}
+
+func (c *waitForCommand) describe() string {
+	if c.kind == "model" {
+		return "model"
+	}
+	return c.kind + " " + c.name
+}
+
+func (c *waitForCommand) condition() string {
+	if c.removed {
+		return "removed"
+	}
+	return c.query.String()
+}
+
+// clientShim adapts the api.Client to the WaitForAPI interface.
+type clientShim struct {
+	*api.Client
+}
+
+// WatchAll is part of the WaitForAPI interface.
+func (c clientShim) WatchAll() (AllWatcher, error) {
+	w, err := c.Client.WatchAll()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return w, nil
+}
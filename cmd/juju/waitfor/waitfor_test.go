@@ -0,0 +1,240 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package waitfor_test
+
+import (
+	"time"
+
+	"github.com/juju/cmd/cmdtesting"
+	"github.com/juju/errors"
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/cmd/juju/waitfor"
+	"github.com/juju/juju/state/multiwatcher"
+	"github.com/juju/juju/status"
+	coretesting "github.com/juju/juju/testing"
+)
+
+type waitForSuite struct {
+	coretesting.FakeJujuXDGDataHomeSuite
+	api   *fakeWaitForAPI
+	clock *testing.Clock
+}
+
+var _ = gc.Suite(&waitForSuite{})
+
+func (s *waitForSuite) SetUpTest(c *gc.C) {
+	s.FakeJujuXDGDataHomeSuite.SetUpTest(c)
+	s.api = &fakeWaitForAPI{
+		watcher: &fakeAllWatcher{
+			deltas:  make(chan []multiwatcher.Delta, 10),
+			stopped: make(chan struct{}),
+		},
+	}
+	s.clock = testing.NewClock(time.Now())
+}
+
+func (s *waitForSuite) run(c *gc.C, args ...string) (string, error) {
+	ctx, err := cmdtesting.RunCommand(c, waitfor.NewWaitForCommandForTest(s.api, s.clock), args...)
+	if err != nil {
+		return "", err
+	}
+	return cmdtesting.Stderr(ctx), nil
+}
+
+func (s *waitForSuite) send(deltas ...multiwatcher.Delta) {
+	s.api.watcher.deltas <- deltas
+}
+
+func unit(name, workload, agent string) multiwatcher.Delta {
+	return multiwatcher.Delta{Entity: &multiwatcher.UnitInfo{
+		Name:           name,
+		Application:    "mysql",
+		WorkloadStatus: multiwatcher.StatusInfo{Current: status.Status(workload), Message: "hook failed"},
+		AgentStatus:    multiwatcher.StatusInfo{Current: status.Status(agent)},
+	}}
+}
+
+func application(name string) multiwatcher.Delta {
+	return multiwatcher.Delta{Entity: &multiwatcher.ApplicationInfo{
+		Name:   name,
+		Status: multiwatcher.StatusInfo{Current: status.Active},
+	}}
+}
+
+func (s *waitForSuite) TestInitErrors(c *gc.C) {
+	for i, test := range []struct {
+		args []string
+		err  string
+	}{{
+		err: "no entity specified",
+	}, {
+		args: []string{"relation"},
+		err:  `unknown entity "relation", expected one of application, machine, model, unit`,
+	}, {
+		args: []string{"unit"},
+		err:  "no unit name specified",
+	}, {
+		args: []string{"unit", "mysql"},
+		err:  `unit name "mysql" not valid`,
+	}, {
+		args: []string{"machine", "0", "--removed", "--query", "life==dead"},
+		err:  "cannot specify both --query and --removed",
+	}, {
+		args: []string{"machine", "0", "--query", "status==started"},
+		err:  `unknown field "status", expected one of life, agent-status, instance-status, instance-id, series`,
+	}, {
+		args: []string{"model", "--timeout", "0s"},
+		err:  "timeout 0s not valid",
+	}, {
+		args: []string{"model", "extra"},
+		err:  `unrecognized args: \["extra"\]`,
+	}} {
+		c.Logf("test %d: %v", i, test.args)
+		_, err := s.run(c, test.args...)
+		c.Check(err, gc.ErrorMatches, test.err)
+	}
+}
+
+func (s *waitForSuite) TestApplicationActiveAndIdle(c *gc.C) {
+	s.send(application("mysql"), unit("mysql/0", "active", "idle"), unit("mysql/1", "maintenance", "executing"))
+	s.send(unit("mysql/1", "active", "idle"))
+	stderr, err := s.run(c, "application", "mysql")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(stderr, gc.Equals, "application mysql: unit-workload-status==active && unit-agent-status==idle\n")
+	c.Assert(s.api.watcher.deltas, gc.HasLen, 0)
+	c.Assert(s.api.closed, jc.IsTrue)
+	s.api.watcher.checkStopped(c)
+}
+
+func (s *waitForSuite) TestApplicationWithoutUnits(c *gc.C) {
+	s.send(application("mysql"))
+	s.send(unit("mysql/0", "active", "idle"))
+	_, err := s.run(c, "application", "mysql")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(s.api.watcher.deltas, gc.HasLen, 0)
+}
+
+func (s *waitForSuite) TestUnitError(c *gc.C) {
+	s.send(application("mysql"), unit("mysql/0", "error", "idle"))
+	_, err := s.run(c, "application", "mysql")
+	c.Assert(err, gc.ErrorMatches, "unit mysql/0 is in error state: hook failed")
+}
+
+func (s *waitForSuite) TestQuery(c *gc.C) {
+	s.send(unit("mysql/0", "active", "executing"))
+	s.send(unit("mysql/0", "blocked", "executing"))
+	_, err := s.run(c, "unit", "mysql/0", "--query", "workload-status!=active && agent-status==executing")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(s.api.watcher.deltas, gc.HasLen, 0)
+}
+
+func (s *waitForSuite) TestMachineStarted(c *gc.C) {
+	machine := &multiwatcher.MachineInfo{Id: "0", AgentStatus: multiwatcher.StatusInfo{Current: status.Pending}}
+	s.send(multiwatcher.Delta{Entity: machine})
+	started := *machine
+	started.AgentStatus.Current = status.Started
+	s.send(multiwatcher.Delta{Entity: &started})
+	_, err := s.run(c, "machine", "0")
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *waitForSuite) TestMachineProvisioningError(c *gc.C) {
+	s.send(multiwatcher.Delta{Entity: &multiwatcher.MachineInfo{
+		Id:             "0",
+		InstanceStatus: multiwatcher.StatusInfo{Current: status.ProvisioningError, Message: "no capacity"},
+	}})
+	_, err := s.run(c, "machine", "0")
+	c.Assert(err, gc.ErrorMatches, "machine 0 is in error state: no capacity")
+}
+
+func (s *waitForSuite) TestRemoved(c *gc.C) {
+	s.send(application("mysql"))
+	s.send(multiwatcher.Delta{Removed: true, Entity: &multiwatcher.ApplicationInfo{Name: "mysql"}})
+	stderr, err := s.run(c, "application", "mysql", "--removed")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(stderr, gc.Equals, "application mysql: removed\n")
+	c.Assert(s.api.watcher.deltas, gc.HasLen, 0)
+}
+
+func (s *waitForSuite) TestRemovedNotFound(c *gc.C) {
+	s.send(application("mysql"))
+	_, err := s.run(c, "application", "wordpress", "--removed")
+	c.Assert(err, gc.ErrorMatches, "application wordpress not found")
+	c.Assert(err, jc.Satisfies, errors.IsNotFound)
+	s.api.watcher.checkStopped(c)
+}
+
+func (s *waitForSuite) TestTimeout(c *gc.C) {
+	s.send(unit("mysql/0", "waiting", "idle"))
+	errc := make(chan error)
+	go func() {
+		_, err := s.run(c, "unit", "mysql/0", "--timeout", "5m")
+		errc <- err
+	}()
+	err := s.clock.WaitAdvance(5*time.Minute, coretesting.LongWait, 1)
+	c.Assert(err, jc.ErrorIsNil)
+	select {
+	case err := <-errc:
+		c.Assert(err, gc.ErrorMatches, `timed out after 5m0s waiting for unit mysql/0: `+
+			`workload-status==active && agent-status==idle \(workload-status==active not met\)`)
+	case <-time.After(coretesting.LongWait):
+		c.Fatalf("timed out waiting for command")
+	}
+	s.api.watcher.checkStopped(c)
+}
+
+func (s *waitForSuite) TestWatchError(c *gc.C) {
+	s.api.watcher.err = errors.New("boom")
+	close(s.api.watcher.deltas)
+	_, err := s.run(c, "model")
+	c.Assert(err, gc.ErrorMatches, "watching model: boom")
+}
+
+type fakeWaitForAPI struct {
+	watcher *fakeAllWatcher
+	closed  bool
+}
+
+func (f *fakeWaitForAPI) WatchAll() (waitfor.AllWatcher, error) {
+	return f.watcher, nil
+}
+
+func (f *fakeWaitForAPI) Close() error {
+	f.closed = true
+	return nil
+}
+
+type fakeAllWatcher struct {
+	deltas  chan []multiwatcher.Delta
+	stopped chan struct{}
+	err     error
+}
+
+func (w *fakeAllWatcher) Next() ([]multiwatcher.Delta, error) {
+	select {
+	case deltas, ok := <-w.deltas:
+		if !ok {
+			return nil, w.err
+		}
+		return deltas, nil
+	case <-w.stopped:
+		return nil, errors.New("watcher stopped")
+	}
+}
+
+func (w *fakeAllWatcher) Stop() error {
+	close(w.stopped)
+	return nil
+}
+
+func (w *fakeAllWatcher) checkStopped(c *gc.C) {
+	select {
+	case <-w.stopped:
+	default:
+		c.Fatalf("watcher not stopped")
+	}
+}
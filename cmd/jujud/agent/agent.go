@@ -7,18 +7,21 @@ agent contains jujud's machine agent.
 package agent
 
 import (
+	"path/filepath"
 	"sync"
 
 	"github.com/juju/cmd"
 	"github.com/juju/errors"
 	"github.com/juju/gnuflag"
 	"github.com/juju/loggo"
+	"github.com/juju/utils/clock"
 	"github.com/juju/utils/featureflag"
 	"github.com/juju/version"
 	"gopkg.in/juju/names.v2"
 
 	"github.com/juju/juju/agent"
 	"github.com/juju/juju/cmd/jujud/util"
+	"github.com/juju/juju/core/machinelock"
 )
 
 // AgentConf is a terribly confused interface.
@@ -142,6 +145,18 @@ func setupAgentLogging(config agent.Config) {
 	}
 }
 
+// newMachineLock returns the machine lock used by the agent with
+// the given config. Lock acquisitions are logged to a file shared
+// by all the agents on the machine.
+func newMachineLock(config agent.Config) (machinelock.Lock, error) {
+	return machinelock.New(machinelock.Config{
+		AgentName:   config.Tag().String(),
+		LockName:    agent.MachineLockName,
+		Clock:       clock.WallClock,
+		LogFilename: filepath.Join(config.LogDir(), machinelock.Filename),
+	})
+}
+
 // GetJujuVersion gets the version of the agent from agent's config file
 func GetJujuVersion(machineAgent string, dataDir string) (version.Number, error) {
 	agentConf := NewAgentConf(dataDir)
@@ -22,6 +22,7 @@ import (
 	apicaasoperator "github.com/juju/juju/api/caasoperator"
 	"github.com/juju/juju/cmd/jujud/agent/caasoperator"
 	cmdutil "github.com/juju/juju/cmd/jujud/util"
+	"github.com/juju/juju/core/machinelock"
 	jujuversion "github.com/juju/juju/version"
 	jworker "github.com/juju/juju/worker"
 	"github.com/juju/juju/worker/dependency"
@@ -51,6 +52,8 @@ type CaasOperatorAgent struct {
 	upgradeComplete gate.Lock
 
 	prometheusRegistry *prometheus.Registry
+
+	machineLock machinelock.Lock
 }
 
 // NewCaasOperatorAgent creates a new CAASOperatorAgent instance properly initialized.
@@ -115,13 +118,19 @@ func (op *CaasOperatorAgent) Run(ctx *cmd.Context) error {
 	agentConfig := op.CurrentConfig()
 	op.upgradeComplete = upgradesteps.NewLock(agentConfig)
 
+	machineLock, err := newMachineLock(agentConfig)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	op.machineLock = machineLock
+
 	logger.Infof("caas operator %v start (%s [%s])", op.Tag().String(), jujuversion.Current, runtime.Compiler)
 	if flags := featureflag.String(); flags != "" {
 		logger.Warningf("developer feature flags enabled: %s", flags)
 	}
 
 	op.runner.StartWorker("api", op.Workers)
-	err := cmdutil.AgentDone(logger, op.runner.Wait())
+	err = cmdutil.AgentDone(logger, op.runner.Wait())
 	op.tomb.Kill(err)
 	return err
 }
@@ -136,6 +145,7 @@ func (op *CaasOperatorAgent) Workers() (worker.Worker, error) {
 		LeadershipGuarantee:  30 * time.Second,
 		UpgradeStepsLock:     op.upgradeComplete,
 		ValidateMigration:    op.validateMigration,
+		MachineLock:          op.machineLock,
 	})
 
 	config := dependency.EngineConfig{
@@ -159,6 +169,7 @@ func (op *CaasOperatorAgent) Workers() (worker.Worker, error) {
 		Engine:             engine,
 		NewSocketName:      DefaultIntrospectionSocketName,
 		PrometheusGatherer: op.prometheusRegistry,
+		MachineLock:        op.machineLock,
 		WorkerFunc:         introspection.NewWorker,
 	}); err != nil {
 		// If the introspection worker failed to start, we just log error
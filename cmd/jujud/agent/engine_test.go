@@ -45,6 +45,7 @@ var (
 		"application-scaler",
 		"state-cleaner",
 		"status-history-pruner",
+		"storage-monitor",
 		"storage-provisioner",
 		"unit-assigner",
 		"remote-relations",
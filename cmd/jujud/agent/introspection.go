@@ -14,6 +14,7 @@ import (
 	worker "gopkg.in/juju/worker.v1"
 
 	"github.com/juju/juju/agent"
+	"github.com/juju/juju/core/machinelock"
 	"github.com/juju/juju/core/presence"
 	"github.com/juju/juju/state"
 	"github.com/juju/juju/worker/dependency"
@@ -36,6 +37,7 @@ type introspectionConfig struct {
 	PubSubReporter     introspection.IntrospectionReporter
 	PrometheusGatherer prometheus.Gatherer
 	PresenceRecorder   presence.Recorder
+	MachineLock        machinelock.Lock
 	NewSocketName      func(names.Tag) string
 	WorkerFunc         func(config introspection.Config) (worker.Worker, error)
 }
@@ -60,6 +62,7 @@ func startIntrospection(cfg introspectionConfig) error {
 		PubSub:             cfg.PubSubReporter,
 		PrometheusGatherer: cfg.PrometheusGatherer,
 		Presence:           cfg.PresenceRecorder,
+		MachineLock:        cfg.MachineLock,
 	})
 	if err != nil {
 		return errors.Trace(err)
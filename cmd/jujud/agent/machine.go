@@ -51,6 +51,7 @@ import (
 	cmdutil "github.com/juju/juju/cmd/jujud/util"
 	"github.com/juju/juju/container"
 	"github.com/juju/juju/container/kvm"
+	"github.com/juju/juju/core/machinelock"
 	"github.com/juju/juju/core/presence"
 	"github.com/juju/juju/environs"
 	"github.com/juju/juju/instance"
@@ -359,6 +360,10 @@ type MachineAgent struct {
 	// Only API servers have hubs. This is temporary until the apiserver and
 	// peergrouper have manifolds.
 	centralHub *pubsub.StructuredHub
+
+	// machineLock is shared by the workers that need exclusive access
+	// to the machine, and is reported on by the introspection worker.
+	machineLock machinelock.Lock
 }
 
 // Wait waits for the machine agent to finish.
@@ -432,6 +437,12 @@ func (a *MachineAgent) Run(*cmd.Context) error {
 
 	setupAgentLogging(a.CurrentConfig())
 
+	machineLock, err := newMachineLock(a.CurrentConfig())
+	if err != nil {
+		return errors.Trace(err)
+	}
+	a.machineLock = machineLock
+
 	if err := introspection.WriteProfileFunctions(); err != nil {
 		// This isn't fatal, just annoying.
 		logger.Errorf("failed to write profile funcs: %v", err)
@@ -461,7 +472,7 @@ func (a *MachineAgent) Run(*cmd.Context) error {
 
 	// At this point, all workers will have been configured to start
 	close(a.workersStarted)
-	err := a.runner.Wait()
+	err = a.runner.Wait()
 	switch errors.Cause(err) {
 	case jworker.ErrTerminateAgent:
 		err = a.uninstallAgent()
@@ -548,6 +559,7 @@ func (a *MachineAgent) makeEngineCreator(previousAgentVersion version.Number) fu
 			CentralHub:           a.centralHub,
 			PubSubReporter:       pubsubReporter,
 			PresenceRecorder:     presenceRecorder,
+			MachineLock:          a.machineLock,
 			UpdateLoggerConfig:   updateAgentConfLogging,
 			NewAgentStatusSetter: func(apiConn api.Connection) (upgradesteps.StatusSetter, error) {
 				return a.machine(apiConn)
@@ -574,6 +586,7 @@ func (a *MachineAgent) makeEngineCreator(previousAgentVersion version.Number) fu
 			NewSocketName:      a.newIntrospectionSocketName,
 			PrometheusGatherer: a.prometheusRegistry,
 			PresenceRecorder:   presenceRecorder,
+			MachineLock:        a.machineLock,
 			WorkerFunc:         introspection.NewWorker,
 		}); err != nil {
 			// If the introspection worker failed to start, we just log error
@@ -860,7 +873,7 @@ func (a *MachineAgent) updateSupportedContainers(
 		Machine:             machine,
 		Provisioner:         pr,
 		Config:              agentConfig,
-		InitLockName:        agent.MachineLockName,
+		MachineLock:         a.machineLock,
 		CredentialAPI:       credentialAPI,
 	}
 	handler := provisioner.NewContainerSetupHandler(params)
@@ -26,6 +26,7 @@ import (
 	apideployer "github.com/juju/juju/api/deployer"
 	"github.com/juju/juju/cmd/jujud/agent/engine"
 	"github.com/juju/juju/container/lxd"
+	"github.com/juju/juju/core/machinelock"
 	"github.com/juju/juju/core/presence"
 	"github.com/juju/juju/feature"
 	"github.com/juju/juju/state"
@@ -76,6 +77,7 @@ import (
 	"github.com/juju/juju/worker/singular"
 	workerstate "github.com/juju/juju/worker/state"
 	"github.com/juju/juju/worker/stateconfigwatcher"
+	"github.com/juju/juju/worker/storagemonitor"
 	"github.com/juju/juju/worker/storageprovisioner"
 	"github.com/juju/juju/worker/terminationworker"
 	"github.com/juju/juju/worker/toolsversionchecker"
@@ -179,6 +181,11 @@ type ManifoldsConfig struct {
 	// PresenceRecorder
 	PresenceRecorder presence.Recorder
 
+	// MachineLock is used by the workers that need exclusive access
+	// to the machine, to serialise their work with that of the other
+	// agents on the machine.
+	MachineLock machinelock.Lock
+
 	// UpdateLoggerConfig is a function that will save the specified
 	// config value as the logging config in the agent.conf file.
 	UpdateLoggerConfig func(string) error
@@ -516,10 +523,9 @@ func Manifolds(config ManifoldsConfig) dependency.Manifolds {
 		// machine when requested. It needs an API connection and
 		// waits for upgrades to be complete.
 		rebootName: ifNotMigrating(reboot.Manifold(reboot.ManifoldConfig{
-			AgentName:       agentName,
-			APICallerName:   apiCallerName,
-			MachineLockName: coreagent.MachineLockName,
-			Clock:           config.Clock,
+			AgentName:     agentName,
+			APICallerName: apiCallerName,
+			MachineLock:   config.MachineLock,
 		})),
 
 		// The logging config updater is a leaf worker that indirectly
@@ -540,6 +546,14 @@ func Manifolds(config ManifoldsConfig) dependency.Manifolds {
 			APICallerName: apiCallerName,
 		})),
 
+		// The storagemonitor worker periodically measures the usage of
+		// the filesystems attached to the machine, and reports it to the
+		// controller.
+		storageMonitorName: ifNotMigrating(storagemonitor.Manifold(storagemonitor.ManifoldConfig{
+			AgentName:     agentName,
+			APICallerName: apiCallerName,
+		})),
+
 		// The proxy config updater is a leaf worker that sets http/https/apt/etc
 		// proxy settings.
 		proxyConfigUpdater: ifNotMigrating(proxyupdater.Manifold(proxyupdater.ManifoldConfig{
@@ -859,6 +873,7 @@ const (
 	rebootName                    = "reboot-executor"
 	loggingConfigUpdaterName      = "logging-config-updater"
 	diskManagerName               = "disk-manager"
+	storageMonitorName            = "storage-monitor"
 	proxyConfigUpdater            = "proxy-config-updater"
 	apiAddressUpdaterName         = "api-address-updater"
 	machinerName                  = "machiner"
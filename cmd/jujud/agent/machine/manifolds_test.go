@@ -88,6 +88,7 @@ func (*ManifoldsSuite) TestManifoldNames(c *gc.C) {
 		"ssh-identity-writer",
 		"state",
 		"state-config-watcher",
+		"storage-monitor",
 		"storage-provisioner",
 		"termination-signal-handler",
 		"tools-version-checker",
@@ -21,6 +21,7 @@ import (
 	"github.com/juju/juju/api/uniter"
 	"github.com/juju/juju/cmd/jujud/agent/unit"
 	cmdutil "github.com/juju/juju/cmd/jujud/util"
+	"github.com/juju/juju/core/machinelock"
 	"github.com/juju/juju/upgrades"
 	jworker "github.com/juju/juju/worker"
 	"github.com/juju/juju/worker/dependency"
@@ -56,6 +57,10 @@ type UnitAgent struct {
 	upgradeComplete             gate.Lock
 
 	prometheusRegistry *prometheus.Registry
+
+	// machineLock is shared by the workers that run hooks, and is
+	// reported on by the introspection worker.
+	machineLock machinelock.Lock
 }
 
 // NewUnitAgent creates a new UnitAgent value properly initialized.
@@ -146,10 +151,17 @@ func (a *UnitAgent) Run(ctx *cmd.Context) error {
 	if err := a.ReadConfig(a.Tag().String()); err != nil {
 		return err
 	}
-	setupAgentLogging(a.CurrentConfig())
+	agentConfig := a.CurrentConfig()
+	setupAgentLogging(agentConfig)
+
+	machineLock, err := newMachineLock(agentConfig)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	a.machineLock = machineLock
 
 	a.runner.StartWorker("api", a.APIWorkers)
-	err := cmdutil.AgentDone(logger, a.runner.Wait())
+	err = cmdutil.AgentDone(logger, a.runner.Wait())
 	a.tomb.Kill(err)
 	return err
 }
@@ -178,6 +190,7 @@ func (a *UnitAgent) APIWorkers() (worker.Worker, error) {
 		PreUpgradeSteps:      a.preUpgradeSteps,
 		UpgradeStepsLock:     a.upgradeComplete,
 		UpgradeCheckLock:     a.initialUpgradeCheckComplete,
+		MachineLock:          a.machineLock,
 	})
 
 	config := dependency.EngineConfig{
@@ -201,6 +214,7 @@ func (a *UnitAgent) APIWorkers() (worker.Worker, error) {
 		Engine:             engine,
 		NewSocketName:      DefaultIntrospectionSocketName,
 		PrometheusGatherer: a.prometheusRegistry,
+		MachineLock:        a.machineLock,
 		WorkerFunc:         introspection.NewWorker,
 	}); err != nil {
 		// If the introspection worker failed to start, we just log error
@@ -17,6 +17,7 @@ import (
 	"github.com/juju/juju/api/base"
 	msapi "github.com/juju/juju/api/meterstatus"
 	"github.com/juju/juju/cmd/jujud/agent/engine"
+	"github.com/juju/juju/core/machinelock"
 	"github.com/juju/juju/state"
 	"github.com/juju/juju/status"
 	"github.com/juju/juju/utils/proxy"
@@ -92,6 +93,10 @@ type ManifoldsConfig struct {
 	// worker to ensure that conditions are OK for an upgrade to
 	// proceed.
 	PreUpgradeSteps func(*state.State, coreagent.Config, bool, bool) error
+
+	// MachineLock is used by the workers that run hooks, to serialise
+	// their execution with that of the other agents on the machine.
+	MachineLock machinelock.Lock
 }
 
 // Manifolds returns a set of co-configured manifolds covering the various
@@ -296,7 +301,7 @@ func Manifolds(config ManifoldsConfig) dependency.Manifolds {
 		uniterName: ifNotMigrating(uniter.Manifold(uniter.ManifoldConfig{
 			AgentName:       agentName,
 			APICallerName:   apiCallerName,
-			MachineLockName: coreagent.MachineLockName,
+			MachineLock:     config.MachineLock,
 			Clock:           clock.WallClock,
 			LeadershipTrackerName: leadershipTrackerName,
 			CharmDirName:          charmDirName,
@@ -322,7 +327,7 @@ func Manifolds(config ManifoldsConfig) dependency.Manifolds {
 		meterStatusName: ifNotMigrating(meterstatus.Manifold(meterstatus.ManifoldConfig{
 			AgentName:                agentName,
 			APICallerName:            apiCallerName,
-			MachineLockName:          coreagent.MachineLockName,
+			MachineLock:              config.MachineLock,
 			Clock:                    clock.WallClock,
 			NewHookRunner:            meterstatus.NewHookRunner,
 			NewMeterStatusAPIClient:  msapi.NewClient,
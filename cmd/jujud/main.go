@@ -17,6 +17,7 @@ import (
 	"github.com/juju/errors"
 	"github.com/juju/loggo"
 	proxyutils "github.com/juju/proxy"
+	"github.com/juju/utils/clock"
 	"github.com/juju/utils/exec"
 
 	"github.com/juju/juju/agent"
@@ -26,6 +27,7 @@ import (
 	"github.com/juju/juju/cmd/jujud/introspect"
 	"github.com/juju/juju/cmd/jujud/updateseries"
 	components "github.com/juju/juju/component/all"
+	"github.com/juju/juju/core/machinelock"
 	"github.com/juju/juju/juju/names"
 	"github.com/juju/juju/juju/sockets"
 	// Import the providers.
@@ -225,10 +227,19 @@ func Main(args []string) int {
 	case names.Jujud:
 		code, err = jujuDMain(args, ctx)
 	case names.JujuRun:
-		run := &RunCommand{
-			MachineLockName: agent.MachineLockName,
+		var lock machinelock.Lock
+		lock, err = machinelock.New(machinelock.Config{
+			AgentName:   "juju-run",
+			LockName:    agent.MachineLockName,
+			Clock:       clock.WallClock,
+			LogFilename: filepath.Join(agent.DefaultPaths.LogDir, machinelock.Filename),
+		})
+		if err == nil {
+			run := &RunCommand{
+				MachineLock: lock,
+			}
+			code = cmd.Main(run, ctx, args[1:])
 		}
-		code = cmd.Main(run, ctx, args[1:])
 	case names.JujuDumpLogs:
 		code = cmd.Main(dumplogs.NewCommand(), ctx, args[1:])
 	case names.JujuIntrospect:
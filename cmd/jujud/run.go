@@ -8,26 +8,24 @@ import (
 	"os"
 	"strconv"
 	"strings"
-	"time"
 
 	"github.com/juju/cmd"
 	"github.com/juju/errors"
 	"github.com/juju/gnuflag"
-	"github.com/juju/mutex"
 	jujuos "github.com/juju/os"
-	"github.com/juju/utils/clock"
 	"github.com/juju/utils/exec"
 	"gopkg.in/juju/names.v2"
 
 	"github.com/juju/juju/agent"
 	cmdutil "github.com/juju/juju/cmd/jujud/util"
+	"github.com/juju/juju/core/machinelock"
 	"github.com/juju/juju/juju/sockets"
 	"github.com/juju/juju/worker/uniter"
 )
 
 type RunCommand struct {
 	cmd.CommandBase
-	MachineLockName string
+	MachineLock     machinelock.Lock
 	unit            names.UnitTag
 	commands        string
 	showHelp        bool
@@ -176,21 +174,15 @@ func (c *RunCommand) appendProxyToCommands() string {
 func (c *RunCommand) executeNoContext() (*exec.ExecResponse, error) {
 	// Acquire the uniter hook execution lock to make sure we don't
 	// stomp on each other.
-	spec := mutex.Spec{
-		Name:  c.MachineLockName,
-		Clock: clock.WallClock,
-		Delay: 250 * time.Millisecond,
+	spec := machinelock.Spec{
+		Worker:  "juju-run",
+		Comment: "running commands",
 	}
-	logger.Debugf("acquire lock %q for juju-run", c.MachineLockName)
-	releaser, err := mutex.Acquire(spec)
+	releaser, err := c.MachineLock.Acquire(spec)
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
-	logger.Debugf("lock %q acquired", c.MachineLockName)
-
-	// Defer the logging first so it is executed after the Release. LIFO.
-	defer logger.Debugf("release lock %q for juju-run", c.MachineLockName)
-	defer releaser.Release()
+	defer releaser()
 
 	runCmd := c.appendProxyToCommands()
 
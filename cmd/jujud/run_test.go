@@ -24,6 +24,7 @@ import (
 	"gopkg.in/juju/names.v2"
 
 	cmdutil "github.com/juju/juju/cmd/jujud/util"
+	"github.com/juju/juju/core/machinelock"
 	"github.com/juju/juju/testing"
 	"github.com/juju/juju/worker/uniter"
 )
@@ -32,11 +33,21 @@ const testLockName = "juju-run-test"
 
 type RunTestSuite struct {
 	testing.BaseSuite
+
+	machineLock machinelock.Lock
 }
 
 func (s *RunTestSuite) SetUpTest(c *gc.C) {
 	s.BaseSuite.SetUpTest(c)
 	s.PatchValue(&cmdutil.DataDir, c.MkDir())
+
+	var err error
+	s.machineLock, err = machinelock.New(machinelock.Config{
+		AgentName: "juju-run",
+		LockName:  testLockName,
+		Clock:     clock.WallClock,
+	})
+	c.Assert(err, jc.ErrorIsNil)
 }
 
 var _ = gc.Suite(&RunTestSuite{})
@@ -132,7 +143,7 @@ func (*RunTestSuite) TestArgParsing(c *gc.C) {
 
 func (s *RunTestSuite) runCommand() *RunCommand {
 	return &RunCommand{
-		MachineLockName: testLockName,
+		MachineLock: s.machineLock,
 	}
 }
 
@@ -0,0 +1,73 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/juju/cmd"
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/worker/uniter/runner/record"
+)
+
+const pluginName = "juju-replay-hook"
+
+func main() {
+	name := strings.TrimSuffix(filepath.Base(os.Args[0]), ".exe")
+	if name != pluginName {
+		// The plugin is linked to by the name of each hook tool
+		// while a hook is being replayed.
+		os.Exit(replayHookTool(name, os.Args[1:], os.Stdout, os.Stderr))
+	}
+	Main(os.Args)
+}
+
+// Main runs the replay-hook command. This function is not redundant
+// with main, because it provides an entry point for testing with
+// arbitrary command line arguments.
+func Main(args []string) {
+	ctx, err := cmd.DefaultContext()
+	if err != nil {
+		cmd.WriteError(os.Stderr, err)
+		os.Exit(2)
+	}
+	os.Exit(cmd.Main(newReplayHookCommand(), ctx, args[1:]))
+}
+
+// replayHookTool writes out the recorded response to a call of the
+// named hook tool, and returns its exit code. The recording and the
+// replay state are found through the environment set up by the
+// replay-hook command.
+func replayHookTool(command string, args []string, stdout, stderr io.Writer) int {
+	call, err := replayCall(command, args)
+	if err != nil {
+		fmt.Fprintf(stderr, "ERROR %s\n", err)
+		return 1
+	}
+	io.WriteString(stdout, call.Stdout)
+	io.WriteString(stderr, call.Stderr)
+	return call.Code
+}
+
+func replayCall(command string, args []string) (record.Call, error) {
+	recordingPath := os.Getenv(record.RecordingEnvKey)
+	statePath := os.Getenv(record.StateEnvKey)
+	if recordingPath == "" || statePath == "" {
+		return record.Call{}, errors.Errorf("%s must be run by %s", command, pluginName)
+	}
+	recording, err := record.Read(recordingPath)
+	if err != nil {
+		return record.Call{}, errors.Trace(err)
+	}
+	call, err := record.ReplayCall(recording, statePath, command, args)
+	if err != nil {
+		return record.Call{}, errors.Trace(err)
+	}
+	return call, nil
+}
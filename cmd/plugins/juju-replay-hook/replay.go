@@ -0,0 +1,147 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/juju/cmd"
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+
+	"github.com/juju/juju/worker/uniter/runner/jujuc"
+	"github.com/juju/juju/worker/uniter/runner/record"
+)
+
+// executable returns the path of the plugin executable, which is
+// linked to by the name of each hook tool. It is a variable so it
+// can be overridden in tests.
+var executable = os.Executable
+
+const replayHookDoc = `
+Replay a recorded hook execution against a local copy of a charm.
+
+The recorded hook is run from the charm directory in the environment
+it was recorded in. Hook tools are not run against a model; instead,
+each call to a hook tool is given the response recorded for the same
+call. When the hook finishes, its result is compared with the recorded
+result, and any recorded hook tool calls that the hook did not make
+are listed.
+
+Recordings are made by unit agents while the model's "hook-recording"
+config is true, and are fetched with "juju download-hook-recordings".
+
+Examples:
+
+    juju replay-hook 20180501-123015.000000000-config-changed.yaml
+    juju replay-hook --charm-dir ~/charms/mysql recording.yaml
+`
+
+func newReplayHookCommand() cmd.Command {
+	return &replayHookCommand{}
+}
+
+// replayHookCommand replays a recorded hook execution.
+type replayHookCommand struct {
+	cmd.CommandBase
+	charmDir      string
+	recordingPath string
+}
+
+func (c *replayHookCommand) Info() *cmd.Info {
+	return &cmd.Info{
+		Name:    "replay-hook",
+		Args:    "<recording>",
+		Purpose: "Replay a recorded hook execution.",
+		Doc:     replayHookDoc,
+	}
+}
+
+func (c *replayHookCommand) SetFlags(f *gnuflag.FlagSet) {
+	f.StringVar(&c.charmDir, "charm-dir", ".", "The directory of the charm to run the hook from")
+}
+
+func (c *replayHookCommand) Init(args []string) error {
+	if len(args) == 0 {
+		return errors.Errorf("no recording specified")
+	}
+	c.recordingPath, args = args[0], args[1:]
+	return cmd.CheckEmpty(args)
+}
+
+func (c *replayHookCommand) Run(ctx *cmd.Context) error {
+	recordingPath := ctx.AbsPath(c.recordingPath)
+	charmDir := ctx.AbsPath(c.charmDir)
+	recording, err := record.Read(recordingPath)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	tempDir, err := ioutil.TempDir("", "juju-replay-hook")
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer os.RemoveAll(tempDir)
+	toolsDir := filepath.Join(tempDir, "tools")
+	if err := c.linkHookTools(toolsDir); err != nil {
+		return errors.Annotate(err, "preparing hook tools")
+	}
+	statePath := filepath.Join(tempDir, "state.yaml")
+
+	hookPath := filepath.Join(charmDir, recording.Location, recording.Hook)
+	ps := exec.Command(hookPath)
+	ps.Env = record.ReplayEnv(recording, charmDir, toolsDir, recordingPath, statePath, os.Getenv("PATH"))
+	ps.Dir = charmDir
+	ps.Stdout = ctx.Stdout
+	ps.Stderr = ctx.Stderr
+	hookErr := ps.Run()
+
+	unreplayed, err := record.Unreplayed(recording, statePath)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	recorded := describeResult(recording.Error)
+	replayed := describeResult("")
+	if hookErr != nil {
+		replayed = describeResult(hookErr.Error())
+	}
+	ctx.Infof("recorded result: %s", recorded)
+	ctx.Infof("replayed result: %s", replayed)
+	for _, call := range unreplayed {
+		ctx.Infof("call not replayed: %s", strings.Join(append([]string{call.Command}, call.Args...), " "))
+	}
+	if recorded != replayed || len(unreplayed) > 0 {
+		return errors.Errorf("replay of %s differs from the recording", recording.Hook)
+	}
+	return nil
+}
+
+// linkHookTools creates a link to the plugin in dir by the name of
+// each hook tool, so that calls to the hook tools are replayed.
+func (c *replayHookCommand) linkHookTools(dir string) error {
+	exe, err := executable()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if err := os.Mkdir(dir, 0700); err != nil {
+		return errors.Trace(err)
+	}
+	for _, name := range jujuc.CommandNames() {
+		if err := os.Symlink(exe, filepath.Join(dir, name)); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	return nil
+}
+
+func describeResult(hookErr string) string {
+	if hookErr == "" {
+		return "success"
+	}
+	return hookErr
+}
@@ -0,0 +1,157 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"time"
+
+	"github.com/juju/cmd"
+	"github.com/juju/cmd/cmdtesting"
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/worker/uniter/runner/record"
+)
+
+type ReplayHookSuite struct {
+	testing.IsolationSuite
+	charmDir      string
+	recordingPath string
+}
+
+var _ = gc.Suite(&ReplayHookSuite{})
+
+func (s *ReplayHookSuite) SetUpTest(c *gc.C) {
+	s.IsolationSuite.SetUpTest(c)
+	if runtime.GOOS == "windows" {
+		c.Skip("hook replay is not supported on windows")
+	}
+	s.charmDir = c.MkDir()
+	s.recordingPath = filepath.Join(c.MkDir(), "recording.yaml")
+	s.PatchValue(&executable, func() (string, error) {
+		return "/bin/false", nil
+	})
+}
+
+func (s *ReplayHookSuite) writeRecording(c *gc.C, recording record.Recording) {
+	path, err := record.Write(filepath.Dir(s.recordingPath), recording, 1)
+	c.Assert(err, jc.ErrorIsNil)
+	err = os.Rename(path, s.recordingPath)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *ReplayHookSuite) writeHook(c *gc.C, script string) {
+	dir := filepath.Join(s.charmDir, "hooks")
+	err := os.Mkdir(dir, 0755)
+	c.Assert(err, jc.ErrorIsNil)
+	err = ioutil.WriteFile(filepath.Join(dir, "config-changed"), []byte("#!/bin/sh\n"+script+"\n"), 0755)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *ReplayHookSuite) recording(hookErr string, calls ...record.Call) record.Recording {
+	return record.Recording{
+		Unit:     "mysql/0",
+		Hook:     "config-changed",
+		Location: "hooks",
+		Started:  time.Date(2018, 5, 1, 12, 30, 15, 0, time.UTC),
+		Error:    hookErr,
+		Env:      map[string]string{"JUJU_UNIT_NAME": "mysql/0"},
+		Calls:    calls,
+	}
+}
+
+func (s *ReplayHookSuite) run(c *gc.C) (*cmd.Context, error) {
+	return cmdtesting.RunCommand(c, newReplayHookCommand(), "--charm-dir", s.charmDir, s.recordingPath)
+}
+
+func (s *ReplayHookSuite) TestInitNoRecording(c *gc.C) {
+	_, err := cmdtesting.RunCommand(c, newReplayHookCommand())
+	c.Assert(err, gc.ErrorMatches, "no recording specified")
+}
+
+func (s *ReplayHookSuite) TestInitExtraArgs(c *gc.C) {
+	_, err := cmdtesting.RunCommand(c, newReplayHookCommand(), "recording.yaml", "extra")
+	c.Assert(err, gc.ErrorMatches, `unrecognized args: \["extra"\]`)
+}
+
+func (s *ReplayHookSuite) TestReplayMatches(c *gc.C) {
+	s.writeRecording(c, s.recording("exit status 3"))
+	s.writeHook(c, `echo "unit $JUJU_UNIT_NAME in $JUJU_CHARM_DIR"; exit 3`)
+
+	ctx, err := s.run(c)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(cmdtesting.Stdout(ctx), gc.Equals, "unit mysql/0 in "+s.charmDir+"\n")
+	c.Assert(cmdtesting.Stderr(ctx), gc.Equals, ""+
+		"recorded result: exit status 3\n"+
+		"replayed result: exit status 3\n",
+	)
+}
+
+func (s *ReplayHookSuite) TestReplayResultDiffers(c *gc.C) {
+	s.writeRecording(c, s.recording(""))
+	s.writeHook(c, "exit 1")
+
+	ctx, err := s.run(c)
+	c.Assert(err, gc.ErrorMatches, "replay of config-changed differs from the recording")
+	c.Assert(cmdtesting.Stderr(ctx), gc.Equals, ""+
+		"recorded result: success\n"+
+		"replayed result: exit status 1\n",
+	)
+}
+
+func (s *ReplayHookSuite) TestReplayCallsNotMade(c *gc.C) {
+	s.writeRecording(c, s.recording("", record.Call{
+		Command: "config-get",
+		Args:    []string{"blog-title"},
+		Stdout:  "My Title\n",
+	}))
+	s.writeHook(c, "exit 0")
+
+	ctx, err := s.run(c)
+	c.Assert(err, gc.ErrorMatches, "replay of config-changed differs from the recording")
+	c.Assert(cmdtesting.Stderr(ctx), gc.Equals, ""+
+		"recorded result: success\n"+
+		"replayed result: success\n"+
+		"call not replayed: config-get blog-title\n",
+	)
+}
+
+func (s *ReplayHookSuite) TestReplayHookTool(c *gc.C) {
+	s.writeRecording(c, s.recording("", record.Call{
+		Command: "config-get",
+		Args:    []string{"blog-title"},
+		Stdout:  "My Title\n",
+		Stderr:  "warning\n",
+		Code:    2,
+	}))
+	s.PatchEnvironment(record.RecordingEnvKey, s.recordingPath)
+	s.PatchEnvironment(record.StateEnvKey, filepath.Join(c.MkDir(), "state.yaml"))
+
+	var stdout, stderr bytes.Buffer
+	code := replayHookTool("config-get", []string{"blog-title"}, &stdout, &stderr)
+	c.Assert(code, gc.Equals, 2)
+	c.Assert(stdout.String(), gc.Equals, "My Title\n")
+	c.Assert(stderr.String(), gc.Equals, "warning\n")
+
+	stdout.Reset()
+	stderr.Reset()
+	code = replayHookTool("config-get", []string{"other"}, &stdout, &stderr)
+	c.Assert(code, gc.Equals, 1)
+	c.Assert(stdout.String(), gc.Equals, "")
+	c.Assert(stderr.String(), gc.Equals, "ERROR recorded call \"config-get other\" not found\n")
+}
+
+func (s *ReplayHookSuite) TestReplayHookToolNotReplaying(c *gc.C) {
+	s.PatchEnvironment(record.RecordingEnvKey, "")
+	var stdout, stderr bytes.Buffer
+	code := replayHookTool("config-get", nil, &stdout, &stderr)
+	c.Assert(code, gc.Equals, 1)
+	c.Assert(stderr.String(), gc.Equals, "ERROR config-get must be run by juju-replay-hook\n")
+}
@@ -0,0 +1,12 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package machinelock
+
+import "github.com/juju/mutex"
+
+// NewTestLock returns a Lock that uses the given function
+// in place of mutex.Acquire.
+func NewTestLock(config Config, acquire func(mutex.Spec) (mutex.Releaser, error)) (Lock, error) {
+	return newLock(config, acquire)
+}
@@ -0,0 +1,310 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package machinelock provides a wrapper around the machine-wide mutex
+// that is shared by all the agents on a machine. The wrapper records who
+// is holding and who is waiting for the lock, along with the recent
+// history of acquisitions, so that contention between agents can be
+// diagnosed.
+package machinelock
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/loggo"
+	"github.com/juju/mutex"
+	"github.com/juju/utils/clock"
+	"gopkg.in/natefinch/lumberjack.v2"
+	"gopkg.in/yaml.v2"
+)
+
+var logger = loggo.GetLogger("juju.core.machinelock")
+
+// Filename is the name of the file, in the agents' log directory,
+// to which lock acquisitions are logged.
+const Filename = "machine-lock.log"
+
+const (
+	// acquireDelay is how long to wait between attempts
+	// to acquire the underlying mutex.
+	acquireDelay = 250 * time.Millisecond
+
+	// maxHistory is the number of completed acquisitions
+	// that are kept for reporting.
+	maxHistory = 10
+
+	// maxLogSize is the size, in megabytes, at which the log
+	// file is rotated, and maxLogBackups is the number of rotated
+	// files that are kept.
+	maxLogSize    = 10
+	maxLogBackups = 5
+
+	timeFormat = "2006-01-02 15:04:05"
+)
+
+// Lock is used to give external processes, such as the hooks run by
+// the uniter, exclusive access to the machine.
+type Lock interface {
+	// Acquire blocks until the lock is acquired, or the spec's
+	// Cancel channel is closed. On success, the returned function
+	// must be called to release the lock.
+	Acquire(spec Spec) (func(), error)
+
+	// Report returns a YAML description of the holder of the lock,
+	// the requests waiting for it and, optionally, its history.
+	Report(opts ...ReportOption) (string, error)
+}
+
+// Spec describes a request to acquire the lock.
+type Spec struct {
+	// Cancel, if closed, aborts the acquisition.
+	Cancel <-chan struct{}
+
+	// Worker is the name of the worker requesting the lock,
+	// for example "uniter" or "meterstatus".
+	Worker string
+
+	// Comment describes the operation that requires the lock,
+	// for example "run install hook".
+	Comment string
+}
+
+// Validate returns an error if the spec is not valid.
+func (s Spec) Validate() error {
+	if s.Worker == "" {
+		return errors.NotValidf("missing Worker")
+	}
+	return nil
+}
+
+// ReportOption modifies the content of a lock report.
+type ReportOption int
+
+const (
+	// ShowHistory includes the most recent acquisitions
+	// of the lock in the report.
+	ShowHistory ReportOption = iota
+)
+
+// Config holds the dependencies of a lock.
+type Config struct {
+	// AgentName is the name of the agent that owns the lock,
+	// and is included in reports and in the log file.
+	AgentName string
+
+	// LockName is the name of the underlying machine-wide mutex.
+	LockName string
+
+	// Clock is used to time waits and acquisitions.
+	Clock clock.Clock
+
+	// LogFilename, if set, is the path of the file to which a line
+	// is appended each time the lock is released. The file is
+	// expected to be shared by all the agents on the machine, and
+	// is rotated once it grows beyond maxLogSize megabytes.
+	LogFilename string
+}
+
+// Validate returns an error if the config cannot be used to create a lock.
+func (c Config) Validate() error {
+	if c.AgentName == "" {
+		return errors.NotValidf("missing AgentName")
+	}
+	if c.LockName == "" {
+		return errors.NotValidf("missing LockName")
+	}
+	if c.Clock == nil {
+		return errors.NotValidf("missing Clock")
+	}
+	return nil
+}
+
+// New returns a Lock that acquires the machine-wide mutex named in the
+// config, and records its use.
+func New(config Config) (Lock, error) {
+	return newLock(config, mutex.Acquire)
+}
+
+func newLock(config Config, acquire func(mutex.Spec) (mutex.Releaser, error)) (*lock, error) {
+	if err := config.Validate(); err != nil {
+		return nil, errors.Trace(err)
+	}
+	var logWriter io.WriteCloser
+	if config.LogFilename != "" {
+		logWriter = &lumberjack.Logger{
+			Filename:   config.LogFilename,
+			MaxSize:    maxLogSize,
+			MaxBackups: maxLogBackups,
+			Compress:   true,
+		}
+	}
+	return &lock{
+		config:    config,
+		acquire:   acquire,
+		logWriter: logWriter,
+		waiting:   make(map[*entry]bool),
+	}, nil
+}
+
+type lock struct {
+	config    Config
+	acquire   func(mutex.Spec) (mutex.Releaser, error)
+	logWriter io.WriteCloser
+
+	mu      sync.Mutex
+	holder  *entry
+	waiting map[*entry]bool
+	history []entry
+}
+
+type entry struct {
+	worker    string
+	comment   string
+	requested time.Time
+	acquired  time.Time
+	released  time.Time
+}
+
+func (e entry) String() string {
+	if e.comment == "" {
+		return e.worker
+	}
+	return fmt.Sprintf("%s (%s)", e.worker, e.comment)
+}
+
+// Acquire is part of the Lock interface.
+func (c *lock) Acquire(spec Spec) (func(), error) {
+	if err := spec.Validate(); err != nil {
+		return nil, errors.Trace(err)
+	}
+	e := &entry{
+		worker:    spec.Worker,
+		comment:   spec.Comment,
+		requested: c.config.Clock.Now(),
+	}
+	c.mu.Lock()
+	c.waiting[e] = true
+	c.mu.Unlock()
+
+	logger.Debugf("acquire machine lock for %s", e)
+	releaser, err := c.acquire(mutex.Spec{
+		Name:   c.config.LockName,
+		Clock:  c.config.Clock,
+		Delay:  acquireDelay,
+		Cancel: spec.Cancel,
+	})
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.waiting, e)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	e.acquired = c.config.Clock.Now()
+	c.holder = e
+	logger.Debugf("machine lock acquired for %s after %s", e, e.acquired.Sub(e.requested))
+
+	var once sync.Once
+	return func() {
+		once.Do(func() { c.release(e, releaser) })
+	}, nil
+}
+
+func (c *lock) release(e *entry, releaser mutex.Releaser) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e.released = c.config.Clock.Now()
+	// Write the log entry while still holding the machine lock,
+	// so that entries from different agents are written in the
+	// order in which they held it.
+	if err := c.writeLogEntry(*e); err != nil {
+		logger.Warningf("cannot write machine lock log entry: %v", err)
+	}
+	releaser.Release()
+	logger.Debugf("machine lock released for %s", e)
+
+	c.holder = nil
+	c.history = append(c.history, *e)
+	if len(c.history) > maxHistory {
+		c.history = c.history[len(c.history)-maxHistory:]
+	}
+}
+
+func (c *lock) writeLogEntry(e entry) error {
+	if c.logWriter == nil {
+		return nil
+	}
+	// The file is closed after every entry, as another agent may
+	// rotate it before this one next holds the machine lock.
+	defer c.logWriter.Close()
+	_, err := fmt.Fprintf(c.logWriter, "%s %s: %s\n", e.released.UTC().Format(timeFormat), c.config.AgentName, historyLine(e))
+	return errors.Trace(err)
+}
+
+func historyLine(e entry) string {
+	return fmt.Sprintf(
+		"%s, waited %s, held %s",
+		e, roundDuration(e.acquired.Sub(e.requested)), roundDuration(e.released.Sub(e.acquired)),
+	)
+}
+
+// roundDuration rounds the duration to the nearest millisecond,
+// as finer precision is just noise in a report.
+func roundDuration(d time.Duration) time.Duration {
+	return d.Round(time.Millisecond)
+}
+
+type report struct {
+	Holder  string   `yaml:"holder"`
+	Waiting []string `yaml:"waiting,omitempty"`
+	History []string `yaml:"history,omitempty"`
+}
+
+// Report is part of the Lock interface.
+func (c *lock) Report(opts ...ReportOption) (string, error) {
+	var showHistory bool
+	for _, opt := range opts {
+		switch opt {
+		case ShowHistory:
+			showHistory = true
+		}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := c.config.Clock.Now()
+
+	r := report{Holder: "none"}
+	if c.holder != nil {
+		r.Holder = fmt.Sprintf("%s, holding %s", c.holder, roundDuration(now.Sub(c.holder.acquired)))
+	}
+	waiting := make([]*entry, 0, len(c.waiting))
+	for e := range c.waiting {
+		waiting = append(waiting, e)
+	}
+	// Longest waiting first.
+	sort.Slice(waiting, func(i, j int) bool {
+		return waiting[i].requested.Before(waiting[j].requested)
+	})
+	for _, e := range waiting {
+		r.Waiting = append(r.Waiting, fmt.Sprintf("%s, waiting %s", e, roundDuration(now.Sub(e.requested))))
+	}
+	if showHistory {
+		// Most recent first.
+		for i := len(c.history) - 1; i >= 0; i-- {
+			e := c.history[i]
+			r.History = append(r.History, fmt.Sprintf("%s %s", e.released.UTC().Format(timeFormat), historyLine(e)))
+		}
+	}
+
+	out, err := yaml.Marshal(map[string]report{c.config.AgentName: r})
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	return string(out), nil
+}
@@ -0,0 +1,193 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package machinelock_test
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/mutex"
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/core/machinelock"
+	coretesting "github.com/juju/juju/testing"
+)
+
+type lockSuite struct {
+	testing.IsolationSuite
+	clock   *testing.Clock
+	config  machinelock.Config
+	acquire chan error
+	specs   []mutex.Spec
+	release int
+}
+
+var _ = gc.Suite(&lockSuite{})
+
+func (s *lockSuite) SetUpTest(c *gc.C) {
+	s.IsolationSuite.SetUpTest(c)
+	s.clock = testing.NewClock(time.Date(2018, 4, 10, 12, 0, 0, 0, time.UTC))
+	s.config = machinelock.Config{
+		AgentName:   "unit-mysql-0",
+		LockName:    "machine-lock",
+		Clock:       s.clock,
+		LogFilename: filepath.Join(c.MkDir(), "machine-lock.log"),
+	}
+	s.acquire = make(chan error, 1)
+	s.specs = nil
+	s.release = 0
+}
+
+func (s *lockSuite) newLock(c *gc.C) machinelock.Lock {
+	lock, err := machinelock.NewTestLock(s.config, func(spec mutex.Spec) (mutex.Releaser, error) {
+		s.specs = append(s.specs, spec)
+		if err := <-s.acquire; err != nil {
+			return nil, err
+		}
+		return releaserFunc(func() { s.release++ }), nil
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	return lock
+}
+
+func (s *lockSuite) TestValidateConfig(c *gc.C) {
+	s.config.AgentName = ""
+	_, err := machinelock.New(s.config)
+	c.Assert(err, gc.ErrorMatches, "missing AgentName not valid")
+	s.config.AgentName = "unit-mysql-0"
+	s.config.LockName = ""
+	_, err = machinelock.New(s.config)
+	c.Assert(err, gc.ErrorMatches, "missing LockName not valid")
+}
+
+func (s *lockSuite) TestAcquireMissingWorker(c *gc.C) {
+	lock := s.newLock(c)
+	_, err := lock.Acquire(machinelock.Spec{})
+	c.Assert(err, gc.ErrorMatches, "missing Worker not valid")
+}
+
+func (s *lockSuite) TestAcquireRelease(c *gc.C) {
+	lock := s.newLock(c)
+	cancel := make(chan struct{})
+	s.acquire <- nil
+	release, err := lock.Acquire(machinelock.Spec{
+		Cancel:  cancel,
+		Worker:  "uniter",
+		Comment: "run install hook",
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(s.specs, gc.HasLen, 1)
+	c.Assert(s.specs[0].Name, gc.Equals, "machine-lock")
+	c.Assert(s.specs[0].Cancel, gc.Equals, (<-chan struct{})(cancel))
+
+	s.clock.Advance(5 * time.Second)
+	report, err := lock.Report()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(report, gc.Equals, `
+unit-mysql-0:
+  holder: uniter (run install hook), holding 5s
+`[1:])
+
+	release()
+	// Releasing twice is harmless.
+	release()
+	c.Assert(s.release, gc.Equals, 1)
+
+	report, err = lock.Report(machinelock.ShowHistory)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(report, gc.Equals, `
+unit-mysql-0:
+  holder: none
+  history:
+  - 2018-04-10 12:00:05 uniter (run install hook), waited 0s, held 5s
+`[1:])
+
+	content, err := ioutil.ReadFile(s.config.LogFilename)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(string(content), gc.Equals,
+		"2018-04-10 12:00:05 unit-mysql-0: uniter (run install hook), waited 0s, held 5s\n")
+}
+
+func (s *lockSuite) TestReportWaiting(c *gc.C) {
+	lock := s.newLock(c)
+	acquired := make(chan error)
+	go func() {
+		release, err := lock.Acquire(machinelock.Spec{Worker: "meterstatus", Comment: "meter-status-changed"})
+		if err == nil {
+			release()
+		}
+		acquired <- err
+	}()
+
+	// Wait until the request is recorded as waiting.
+	for a := coretesting.LongAttempt.Start(); a.Next(); {
+		report, err := lock.Report()
+		c.Assert(err, jc.ErrorIsNil)
+		if report != "unit-mysql-0:\n  holder: none\n" {
+			break
+		}
+	}
+	s.clock.Advance(3 * time.Second)
+	report, err := lock.Report()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(report, gc.Equals, `
+unit-mysql-0:
+  holder: none
+  waiting:
+  - meterstatus (meter-status-changed), waiting 3s
+`[1:])
+
+	s.acquire <- nil
+	select {
+	case err := <-acquired:
+		c.Assert(err, jc.ErrorIsNil)
+	case <-time.After(coretesting.LongWait):
+		c.Fatalf("timed out waiting for lock")
+	}
+	report, err = lock.Report(machinelock.ShowHistory)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(report, gc.Equals, `
+unit-mysql-0:
+  holder: none
+  history:
+  - 2018-04-10 12:00:03 meterstatus (meter-status-changed), waited 3s, held 0s
+`[1:])
+}
+
+func (s *lockSuite) TestAcquireError(c *gc.C) {
+	lock := s.newLock(c)
+	s.acquire <- errors.New("cancelled")
+	_, err := lock.Acquire(machinelock.Spec{Worker: "uniter"})
+	c.Assert(err, gc.ErrorMatches, "cancelled")
+
+	report, err := lock.Report(machinelock.ShowHistory)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(report, gc.Equals, "unit-mysql-0:\n  holder: none\n")
+}
+
+func (s *lockSuite) TestHistoryLimited(c *gc.C) {
+	lock := s.newLock(c)
+	for i := 0; i < 12; i++ {
+		s.acquire <- nil
+		release, err := lock.Acquire(machinelock.Spec{Worker: "uniter"})
+		c.Assert(err, jc.ErrorIsNil)
+		s.clock.Advance(time.Second)
+		release()
+	}
+	report, err := lock.Report(machinelock.ShowHistory)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(report, jc.Contains, "- 2018-04-10 12:00:12 uniter, waited 0s, held 1s\n")
+	c.Assert(report, gc.Not(jc.Contains), "12:00:02 ")
+	c.Assert(report, jc.Contains, "12:00:03 ")
+}
+
+type releaserFunc func()
+
+func (f releaserFunc) Release() {
+	f()
+}
@@ -156,6 +156,11 @@ const (
 	// The default filesystem storage source.
 	StorageDefaultFilesystemSourceKey = "storage-default-filesystem-source"
 
+	// StorageUsageWarningThresholdKey is the key for the percentage of
+	// space or inodes used in a filesystem or volume above which its
+	// status is set to "warning".
+	StorageUsageWarningThresholdKey = "storage-usage-warning-threshold"
+
 	// ResourceTagsKey is an optional list or space-separated string
 	// of k=v pairs, defining the tags for ResourceTags.
 	ResourceTagsKey = "resource-tags"
@@ -213,6 +218,21 @@ const (
 	// originates if the model is deployed such that NAT or similar is in use.
 	EgressSubnets = "egress-subnets"
 
+	// RelationScopedIngressKey is the key for whether the firewaller
+	// restricts ingress to the ports of an unexposed application to the
+	// addresses of the units related to it.
+	RelationScopedIngressKey = "relation-scoped-ingress"
+
+	// HookRecordingKey is the key for whether unit agents record the
+	// environment and hook tool calls of each hook they run, so that
+	// failing hooks can be replayed elsewhere.
+	HookRecordingKey = "hook-recording"
+
+	// HookTimeoutKey is the key for how long a unit agent lets a hook run
+	// before killing it and marking it failed. Applications may override
+	// it with their own "hook-timeout" setting.
+	HookTimeoutKey = "hook-timeout"
+
 	// FanConfig defines the configuration for FAN network running in the model.
 	FanConfig = "fan-config"
 
@@ -392,6 +412,10 @@ const (
 	DefaultActionResultsAge = "336h" // 2 weeks
 
 	DefaultActionResultsSize = "5G"
+
+	// DefaultStorageUsageWarningThreshold is the default value for
+	// StorageUsageWarningThresholdKey.
+	DefaultStorageUsageWarningThreshold = 90
 )
 
 var defaultConfigValues = map[string]interface{}{
@@ -422,6 +446,9 @@ var defaultConfigValues = map[string]interface{}{
 	NetBondReconfigureDelayKey: 17,
 	ContainerNetworkingMethod:  "",
 
+	// Storage related config.
+	StorageUsageWarningThresholdKey: DefaultStorageUsageWarningThreshold,
+
 	"default-series":             jujuversion.SupportedLTS(),
 	ProvisionerHarvestModeKey:    HarvestDestroyed.String(),
 	ResourceTagsKey:              "",
@@ -434,6 +461,9 @@ var defaultConfigValues = map[string]interface{}{
 	TransmitVendorMetricsKey:     true,
 	UpdateStatusHookInterval:     DefaultUpdateStatusHookInterval,
 	EgressSubnets:                "",
+	RelationScopedIngressKey:     false,
+	HookRecordingKey:             false,
+	HookTimeoutKey:               "",
 	FanConfig:                    "",
 	CloudInitUserDataKey:         "",
 	ContainerInheritProperiesKey: "",
@@ -626,6 +656,26 @@ func Validate(cfg, old *Config) error {
 		}
 	}
 
+	if v, ok := cfg.defined[HookTimeoutKey].(string); ok && v != "" {
+		if d, err := time.ParseDuration(v); err != nil {
+			return errors.Annotate(err, "invalid hook timeout in model configuration")
+		} else if d < 0 {
+			return errors.Errorf("hook timeout %v cannot be negative", d)
+		}
+	}
+
+	// Ingress rules in global firewall mode apply to every machine in
+	// the model, so they cannot be scoped to related units.
+	if cfg.RelationScopedIngress() && cfg.FirewallMode() == FwGlobal {
+		return errors.Errorf("%s cannot be used with firewall-mode %q", RelationScopedIngressKey, FwGlobal)
+	}
+
+	if v, ok := cfg.defined[StorageUsageWarningThresholdKey].(int); ok {
+		if v < 0 || v > 100 {
+			return errors.Errorf("storage usage warning threshold %d must be between 0 and 100", v)
+		}
+	}
+
 	if v, ok := cfg.defined[EgressSubnets].(string); ok && v != "" {
 		cidrs := strings.Split(v, ",")
 		for _, cidr := range cidrs {
@@ -1220,6 +1270,17 @@ func (c *Config) StorageDefaultFilesystemSource() (string, bool) {
 	return bs, bs != ""
 }
 
+// StorageUsageWarningThreshold returns the percentage of space or inodes
+// used in a filesystem or volume above which its status is set to
+// "warning". A value of 0 disables the warning.
+func (c *Config) StorageUsageWarningThreshold() int {
+	value, ok := c.defined[StorageUsageWarningThresholdKey].(int)
+	if !ok {
+		return DefaultStorageUsageWarningThreshold
+	}
+	return value
+}
+
 // ResourceTags returns a set of tags to set on environment resources
 // that Juju creates and manages, if the provider supports them. These
 // tags have no special meaning to Juju, but may be used for existing
@@ -1305,6 +1366,28 @@ func (c *Config) EgressSubnets() []string {
 	return result
 }
 
+// RelationScopedIngress returns whether the ports opened by units of an
+// unexposed application are opened only to the addresses of the units
+// related to that application.
+func (c *Config) RelationScopedIngress() bool {
+	value, _ := c.defined[RelationScopedIngressKey].(bool)
+	return value
+}
+
+// HookRecording returns whether unit agents record the hooks they run.
+func (c *Config) HookRecording() bool {
+	value, _ := c.defined[HookRecordingKey].(bool)
+	return value
+}
+
+// HookTimeout returns how long a hook may run before it is killed,
+// or zero if hooks may run indefinitely.
+func (c *Config) HookTimeout() time.Duration {
+	// Value has already been validated.
+	val, _ := time.ParseDuration(c.asString(HookTimeoutKey))
+	return val
+}
+
 // FanConfig is the configuration of FAN network running in the model.
 func (c *Config) FanConfig() (network.FanConfig, error) {
 	// At this point we are sure that the line is valid.
@@ -1404,6 +1487,7 @@ var alwaysOptional = schema.Defaults{
 	// Environ providers will specify their own defaults.
 	StorageDefaultBlockSourceKey:      schema.Omit,
 	StorageDefaultFilesystemSourceKey: schema.Omit,
+	StorageUsageWarningThresholdKey:   schema.Omit,
 
 	"firewall-mode":              schema.Omit,
 	"logging-config":             schema.Omit,
@@ -1448,6 +1532,9 @@ var alwaysOptional = schema.Defaults{
 	MaxActionResultsSize:         schema.Omit,
 	UpdateStatusHookInterval:     schema.Omit,
 	EgressSubnets:                schema.Omit,
+	RelationScopedIngressKey:     schema.Omit,
+	HookRecordingKey:             schema.Omit,
+	HookTimeoutKey:               schema.Omit,
 	FanConfig:                    schema.Omit,
 	CloudInitUserDataKey:         schema.Omit,
 	ContainerInheritProperiesKey: schema.Omit,
@@ -1847,6 +1934,11 @@ global or per instance security groups.`,
 		Type:        environschema.Tstring,
 		Group:       environschema.EnvironGroup,
 	},
+	StorageUsageWarningThresholdKey: {
+		Description: "The percentage of space or inodes used in a filesystem or volume above which its status is set to warning, or 0 to disable",
+		Type:        environschema.Tint,
+		Group:       environschema.EnvironGroup,
+	},
 	"test-mode": {
 		Description: `Whether the model is intended for testing.
 If true, accessing the charm store does not affect statistical
@@ -1917,6 +2009,21 @@ data of the store. (default false)`,
 		Type:        environschema.Tstring,
 		Group:       environschema.EnvironGroup,
 	},
+	RelationScopedIngressKey: {
+		Description: "Whether ports of unexposed applications are opened only to the addresses of related units (instance firewall mode only)",
+		Type:        environschema.Tbool,
+		Group:       environschema.EnvironGroup,
+	},
+	HookRecordingKey: {
+		Description: "Whether unit agents record the environment and hook tool calls of each hook they run",
+		Type:        environschema.Tbool,
+		Group:       environschema.EnvironGroup,
+	},
+	HookTimeoutKey: {
+		Description: "How long a hook may run before it is killed and marked failed (e.g. 30m); empty means no limit",
+		Type:        environschema.Tstring,
+		Group:       environschema.EnvironGroup,
+	},
 	FanConfig: {
 		Description: "Configuration for fan networking for this model",
 		Type:        environschema.Tstring,
@@ -288,6 +288,21 @@ var configTests = []configTest{
 			"firewall-mode": "illegal",
 		}),
 		err: `firewall-mode: expected one of \[instance global none\], got "illegal"`,
+	}, {
+		about:       "Relation scoped ingress with instance firewall mode",
+		useDefaults: config.UseDefaults,
+		attrs: minimalConfigAttrs.Merge(testing.Attrs{
+			"firewall-mode":           config.FwInstance,
+			"relation-scoped-ingress": true,
+		}),
+	}, {
+		about:       "Relation scoped ingress with global firewall mode",
+		useDefaults: config.UseDefaults,
+		attrs: minimalConfigAttrs.Merge(testing.Attrs{
+			"firewall-mode":           config.FwGlobal,
+			"relation-scoped-ingress": true,
+		}),
+		err: `relation-scoped-ingress cannot be used with firewall-mode "global"`,
 	}, {
 		about:       "ssl-hostname-verification off",
 		useDefaults: config.UseDefaults,
@@ -571,6 +586,27 @@ var configTests = []configTest{
 		attrs: minimalConfigAttrs.Merge(testing.Attrs{
 			"backup-dir": "/foo/bar",
 		}),
+	}, {
+		about:       "Invalid storage-usage-warning-threshold",
+		useDefaults: config.UseDefaults,
+		attrs: minimalConfigAttrs.Merge(testing.Attrs{
+			"storage-usage-warning-threshold": 101,
+		}),
+		err: `storage usage warning threshold 101 must be between 0 and 100`,
+	}, {
+		about:       "Invalid hook-timeout",
+		useDefaults: config.UseDefaults,
+		attrs: minimalConfigAttrs.Merge(testing.Attrs{
+			"hook-timeout": "soon",
+		}),
+		err: `invalid hook timeout in model configuration: time: invalid duration soon`,
+	}, {
+		about:       "Negative hook-timeout",
+		useDefaults: config.UseDefaults,
+		attrs: minimalConfigAttrs.Merge(testing.Attrs{
+			"hook-timeout": "-5m",
+		}),
+		err: `hook timeout -5m0s cannot be negative`,
 	},
 }
 
@@ -1301,6 +1337,54 @@ func (s *ConfigSuite) TestEgressSubnets(c *gc.C) {
 	c.Assert(cfg.EgressSubnets(), gc.DeepEquals, []string{"10.0.0.1/32", "192.168.1.1/16"})
 }
 
+func (s *ConfigSuite) TestRelationScopedIngressDefault(c *gc.C) {
+	cfg := newTestConfig(c, testing.Attrs{})
+	c.Assert(cfg.RelationScopedIngress(), jc.IsFalse)
+}
+
+func (s *ConfigSuite) TestRelationScopedIngress(c *gc.C) {
+	cfg := newTestConfig(c, testing.Attrs{
+		"relation-scoped-ingress": true,
+	})
+	c.Assert(cfg.RelationScopedIngress(), jc.IsTrue)
+}
+
+func (s *ConfigSuite) TestHookRecordingDefault(c *gc.C) {
+	cfg := newTestConfig(c, testing.Attrs{})
+	c.Assert(cfg.HookRecording(), jc.IsFalse)
+}
+
+func (s *ConfigSuite) TestHookRecording(c *gc.C) {
+	cfg := newTestConfig(c, testing.Attrs{
+		"hook-recording": true,
+	})
+	c.Assert(cfg.HookRecording(), jc.IsTrue)
+}
+
+func (s *ConfigSuite) TestHookTimeoutDefault(c *gc.C) {
+	cfg := newTestConfig(c, testing.Attrs{})
+	c.Assert(cfg.HookTimeout(), gc.Equals, time.Duration(0))
+}
+
+func (s *ConfigSuite) TestHookTimeout(c *gc.C) {
+	cfg := newTestConfig(c, testing.Attrs{
+		"hook-timeout": "30m",
+	})
+	c.Assert(cfg.HookTimeout(), gc.Equals, 30*time.Minute)
+}
+
+func (s *ConfigSuite) TestStorageUsageWarningThresholdDefault(c *gc.C) {
+	cfg := newTestConfig(c, testing.Attrs{})
+	c.Assert(cfg.StorageUsageWarningThreshold(), gc.Equals, config.DefaultStorageUsageWarningThreshold)
+}
+
+func (s *ConfigSuite) TestStorageUsageWarningThreshold(c *gc.C) {
+	cfg := newTestConfig(c, testing.Attrs{
+		"storage-usage-warning-threshold": 75,
+	})
+	c.Assert(cfg.StorageUsageWarningThreshold(), gc.Equals, 75)
+}
+
 func (s *ConfigSuite) TestCloudInitUserDataFromEnvironment(c *gc.C) {
 	cfg := newTestConfig(c, testing.Attrs{
 		config.CloudInitUserDataKey: validCloudInitUserData,
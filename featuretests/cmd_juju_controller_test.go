@@ -264,7 +264,9 @@ func (s *cmdControllerSuite) testControllerDestroy(c *gc.C, forceAPI bool) {
 	if forceAPI {
 		// Remove bootstrap config from the client store,
 		// forcing the command to use the API.
-		err := os.Remove(jujuclient.JujuBootstrapConfigPath())
+		bootstrapConfigPath, err := jujuclient.JujuBootstrapConfigPath()
+		c.Assert(err, jc.ErrorIsNil)
+		err = os.Remove(bootstrapConfigPath)
 		c.Assert(err, jc.ErrorIsNil)
 	}
 
@@ -73,8 +73,10 @@ There are no models available. (.|\n)*
 
 	// Make sure that the saved server details are sufficient to connect
 	// to the api server.
+	cookiePath, err := jujuclient.JujuCookiePath("bob-controller")
+	c.Assert(err, jc.ErrorIsNil)
 	jar, err := cookiejar.New(&cookiejar.Options{
-		Filename: jujuclient.JujuCookiePath("bob-controller"),
+		Filename: cookiePath,
 	})
 	c.Assert(err, jc.ErrorIsNil)
 	dialOpts := api.DefaultDialOpts()
@@ -110,6 +110,10 @@ func (s *cmdJujuSuite) TestApplicationUnset(c *gc.C) {
 func (s *cmdJujuSuite) TestApplicationGetIAASModel(c *gc.C) {
 	expected := `application: dummy-application
 application-config:
+  hook-timeout:
+    description: How long hooks may run before being killed (e.g. 30m)
+    source: unset
+    type: string
   trust:
     default: false
     description: Does this application have access to trusted credentials
@@ -153,6 +157,10 @@ settings:
 func (s *cmdJujuSuite) TestApplicationGetCAASModel(c *gc.C) {
 	expected := `application: dummy-application
 application-config:
+  hook-timeout:
+    description: How long hooks may run before being killed (e.g. 30m)
+    source: unset
+    type: string
   juju-application-path:
     default: /
     description: the relative http path used to access an application
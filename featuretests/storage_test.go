@@ -242,14 +242,20 @@ block:
     it: works
 loop:
   provider: loop
+lvm:
+  provider: lvm
 machinescoped:
   provider: machinescoped
 modelscoped:
   provider: modelscoped
 modelscoped-block:
   provider: modelscoped-block
+modelscoped-shared:
+  provider: modelscoped-shared
 modelscoped-unreleasable:
   provider: modelscoped-unreleasable
+nfs:
+  provider: nfs
 rootfs:
   provider: rootfs
 static:
@@ -267,10 +273,13 @@ func (s *cmdStorageSuite) TestListPoolsTabular(c *gc.C) {
 Name                      Provider                  Attrs
 block                     loop                      it=works
 loop                      loop                      
+lvm                       lvm                       
 machinescoped             machinescoped             
 modelscoped               modelscoped               
 modelscoped-block         modelscoped-block         
+modelscoped-shared        modelscoped-shared        
 modelscoped-unreleasable  modelscoped-unreleasable  
+nfs                       nfs                       
 rootfs                    rootfs                    
 static                    static                    
 tmpfs                     tmpfs                     
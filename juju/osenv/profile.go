@@ -0,0 +1,136 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package osenv
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+
+	"github.com/juju/errors"
+	"gopkg.in/yaml.v2"
+)
+
+// DefaultProfile is the name of the client profile whose
+// data is held directly in the juju home directory.
+const DefaultProfile = "default"
+
+// ProfileDetails holds the settings for a client profile.
+type ProfileDetails struct {
+	// ShareClouds is true if the profile uses the cloud
+	// definitions of the default profile.
+	ShareClouds bool `yaml:"share-clouds,omitempty"`
+}
+
+// Profiles holds the client profiles, other than the
+// default profile, and the name of the current profile.
+type Profiles struct {
+	// CurrentProfile is the name of the current profile.
+	CurrentProfile string `yaml:"current-profile,omitempty"`
+
+	// Profiles holds the details of each profile, keyed
+	// by profile name.
+	Profiles map[string]ProfileDetails `yaml:"profiles,omitempty"`
+}
+
+var validProfileName = regexp.MustCompile(`^[a-z0-9]+(?:[a-z0-9-]*[a-z0-9])?$`)
+
+// ValidateProfileName validates the given client profile name.
+func ValidateProfileName(name string) error {
+	if !validProfileName.MatchString(name) {
+		return errors.NotValidf("profile name %q", name)
+	}
+	return nil
+}
+
+// JujuProfilesPath is the location of the file holding the
+// client profiles.
+func JujuProfilesPath() string {
+	return JujuXDGDataHomePath("profiles.yaml")
+}
+
+// ReadProfilesFile loads the client profiles from the given file.
+// If the file is not found, it is not an error.
+func ReadProfilesFile(file string) (*Profiles, error) {
+	profiles := &Profiles{}
+	data, err := ioutil.ReadFile(file)
+	if os.IsNotExist(err) {
+		return profiles, nil
+	}
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if err := yaml.Unmarshal(data, profiles); err != nil {
+		return nil, errors.Annotate(err, "cannot unmarshal profiles")
+	}
+	return profiles, nil
+}
+
+// JujuCurrentProfile returns the name of the client profile in use,
+// being the one named by $JUJU_PROFILE if set, and otherwise the
+// current profile recorded in the profiles file. It is an error
+// if the profile does not exist.
+func JujuCurrentProfile() (string, error) {
+	profiles, err := ReadProfilesFile(JujuProfilesPath())
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	name := os.Getenv(JujuProfileEnvKey)
+	if name == "" {
+		name = profiles.CurrentProfile
+	}
+	if name == "" || name == DefaultProfile {
+		return DefaultProfile, nil
+	}
+	if err := ValidateProfileName(name); err != nil {
+		return "", errors.Trace(err)
+	}
+	if _, ok := profiles.Profiles[name]; !ok {
+		return "", errors.NotFoundf("profile %q", name)
+	}
+	return name, nil
+}
+
+// JujuProfileDir returns the directory holding the client
+// data for the named profile.
+func JujuProfileDir(profile string) string {
+	if profile == DefaultProfile {
+		return JujuXDGDataHomeDir()
+	}
+	return JujuXDGDataHomePath("profiles", profile)
+}
+
+// JujuProfileDataPath returns the path to a file holding client
+// data, such as controllers and accounts, for the current profile.
+func JujuProfileDataPath(names ...string) (string, error) {
+	profile, err := JujuCurrentProfile()
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	all := append([]string{JujuProfileDir(profile)}, names...)
+	return filepath.Join(all...), nil
+}
+
+// JujuCloudsDataPath returns the path to a file holding cloud
+// definitions for the current profile. Profiles sharing clouds
+// use those of the default profile.
+func JujuCloudsDataPath(names ...string) (string, error) {
+	profile, err := JujuCurrentProfile()
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	if profile == DefaultProfile {
+		return JujuXDGDataHomePath(names...), nil
+	}
+	profiles, err := ReadProfilesFile(JujuProfilesPath())
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	if profiles.Profiles[profile].ShareClouds {
+		return JujuXDGDataHomePath(names...), nil
+	}
+	all := append([]string{JujuProfileDir(profile)}, names...)
+	return filepath.Join(all...), nil
+}
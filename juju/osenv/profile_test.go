@@ -0,0 +1,112 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package osenv_test
+
+import (
+	"io/ioutil"
+	"path/filepath"
+
+	"github.com/juju/errors"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/juju/osenv"
+	"github.com/juju/juju/testing"
+)
+
+type profileSuite struct {
+	testing.BaseSuite
+	home string
+}
+
+var _ = gc.Suite(&profileSuite{})
+
+func (s *profileSuite) SetUpTest(c *gc.C) {
+	s.BaseSuite.SetUpTest(c)
+	s.home = c.MkDir()
+	osenv.SetJujuXDGDataHome(s.home)
+	s.AddCleanup(func(*gc.C) { osenv.SetJujuXDGDataHome("") })
+}
+
+func (s *profileSuite) writeProfiles(c *gc.C, content string) {
+	err := ioutil.WriteFile(filepath.Join(s.home, "profiles.yaml"), []byte(content), 0600)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *profileSuite) TestDefaultProfile(c *gc.C) {
+	profile, err := osenv.JujuCurrentProfile()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(profile, gc.Equals, osenv.DefaultProfile)
+	s.assertPath(c, osenv.JujuProfileDataPath, "controllers.yaml", filepath.Join(s.home, "controllers.yaml"))
+	s.assertPath(c, osenv.JujuCloudsDataPath, "clouds.yaml", filepath.Join(s.home, "clouds.yaml"))
+}
+
+func (s *profileSuite) TestCurrentProfileFromFile(c *gc.C) {
+	s.writeProfiles(c, `
+current-profile: work
+profiles:
+  work: {}
+`)
+	profile, err := osenv.JujuCurrentProfile()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(profile, gc.Equals, "work")
+	s.assertPath(c, osenv.JujuProfileDataPath, "controllers.yaml",
+		filepath.Join(s.home, "profiles", "work", "controllers.yaml"))
+	s.assertPath(c, osenv.JujuCloudsDataPath, "clouds.yaml",
+		filepath.Join(s.home, "profiles", "work", "clouds.yaml"))
+}
+
+func (s *profileSuite) TestCurrentProfileFromEnvironment(c *gc.C) {
+	s.writeProfiles(c, `
+current-profile: work
+profiles:
+  work: {}
+  home:
+    share-clouds: true
+`)
+	s.PatchEnvironment(osenv.JujuProfileEnvKey, "home")
+	profile, err := osenv.JujuCurrentProfile()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(profile, gc.Equals, "home")
+	s.assertPath(c, osenv.JujuProfileDataPath, "accounts.yaml",
+		filepath.Join(s.home, "profiles", "home", "accounts.yaml"))
+	s.assertPath(c, osenv.JujuCloudsDataPath, "clouds.yaml", filepath.Join(s.home, "clouds.yaml"))
+}
+
+func (s *profileSuite) TestInvalidProfilesFile(c *gc.C) {
+	s.writeProfiles(c, "profiles: [")
+	_, err := osenv.JujuCurrentProfile()
+	c.Assert(err, gc.ErrorMatches, "cannot unmarshal profiles: .*")
+	_, err = osenv.JujuProfileDataPath("controllers.yaml")
+	c.Assert(err, gc.ErrorMatches, "cannot unmarshal profiles: .*")
+	_, err = osenv.JujuCloudsDataPath("clouds.yaml")
+	c.Assert(err, gc.ErrorMatches, "cannot unmarshal profiles: .*")
+}
+
+func (s *profileSuite) TestInvalidProfileFromEnvironment(c *gc.C) {
+	s.PatchEnvironment(osenv.JujuProfileEnvKey, "../../x")
+	_, err := osenv.JujuCurrentProfile()
+	c.Assert(err, gc.ErrorMatches, `profile name "../../x" not valid`)
+	_, err = osenv.JujuProfileDataPath("controllers.yaml")
+	c.Assert(err, gc.ErrorMatches, `profile name "../../x" not valid`)
+}
+
+func (s *profileSuite) TestUnknownProfile(c *gc.C) {
+	s.writeProfiles(c, `
+profiles:
+  work: {}
+`)
+	s.PatchEnvironment(osenv.JujuProfileEnvKey, "play")
+	_, err := osenv.JujuCurrentProfile()
+	c.Assert(err, jc.Satisfies, errors.IsNotFound)
+	c.Assert(err, gc.ErrorMatches, `profile "play" not found`)
+	_, err = osenv.JujuCloudsDataPath("clouds.yaml")
+	c.Assert(err, gc.ErrorMatches, `profile "play" not found`)
+}
+
+func (s *profileSuite) assertPath(c *gc.C, pathFunc func(...string) (string, error), name, expect string) {
+	path, err := pathFunc(name)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(path, gc.Equals, expect)
+}
@@ -43,6 +43,21 @@ const (
 	// timestamps to be written in RFC3339 format.
 	JujuStatusIsoTimeEnvKey = "JUJU_STATUS_ISO_TIME"
 
+	// JujuSecretStoreEnvKey is the env var which, if set, selects where
+	// the client stores passwords and cloud credential attributes instead
+	// of writing them in clear text to the files in $JUJU_DATA. The value
+	// is either "file", for a passphrase protected encrypted file, or
+	// "helper:<command>" to delegate to an external helper process.
+	JujuSecretStoreEnvKey = "JUJU_SECRET_STORE"
+
+	// JujuSecretStorePassphraseEnvKey is the env var holding the
+	// passphrase used to encrypt the secrets file.
+	JujuSecretStorePassphraseEnvKey = "JUJU_SECRET_STORE_PASSPHRASE"
+
+	// JujuProfileEnvKey is the env var which, if set, selects the
+	// client profile to use instead of the current profile.
+	JujuProfileEnvKey = "JUJU_PROFILE"
+
 	// XDGDataHome is a path where data for the running user
 	// should be stored according to the xdg standard.
 	XDGDataHome = "XDG_DATA_HOME"
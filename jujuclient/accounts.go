@@ -18,8 +18,8 @@ import (
 
 // JujuAccountsPath is the location where accounts information is
 // expected to be found.
-func JujuAccountsPath() string {
-	return osenv.JujuXDGDataHomePath("accounts.yaml")
+func JujuAccountsPath() (string, error) {
+	return osenv.JujuProfileDataPath("accounts.yaml")
 }
 
 // ReadAccountsFile loads all accounts defined in a given file.
@@ -67,7 +67,11 @@ func WriteAccountsFile(controllerAccounts map[string]AccountDetails) error {
 	if err != nil {
 		return errors.Annotate(err, "cannot marshal accounts")
 	}
-	return utils.AtomicWriteFile(JujuAccountsPath(), data, os.FileMode(0600))
+	path, err := JujuAccountsPath()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	return utils.AtomicWriteFile(path, data, os.FileMode(0600))
 }
 
 // ParseAccounts parses the given YAML bytes into accounts metadata.
@@ -28,7 +28,7 @@ func (s *AccountsSuite) SetUpTest(c *gc.C) {
 }
 
 func (s *AccountsSuite) TestAccountDetailsNoFile(c *gc.C) {
-	err := os.Remove(jujuclient.JujuAccountsPath())
+	err := os.Remove(dataPath(c, jujuclient.JujuAccountsPath))
 	c.Assert(err, jc.ErrorIsNil)
 	details, err := s.store.AccountDetails("not-found")
 	c.Assert(err, gc.ErrorMatches, "account details for controller not-found not found")
@@ -89,7 +89,7 @@ func (s *AccountsSuite) TestUpdateAccountOverwrites(c *gc.C) {
 }
 
 func (s *AccountsSuite) TestRemoveAccountNoFile(c *gc.C) {
-	err := os.Remove(jujuclient.JujuAccountsPath())
+	err := os.Remove(dataPath(c, jujuclient.JujuAccountsPath))
 	c.Assert(err, jc.ErrorIsNil)
 	err = s.store.RemoveAccount("not-found")
 	c.Assert(err, jc.Satisfies, errors.IsNotFound)
@@ -117,7 +117,7 @@ func (s *AccountsSuite) TestRemoveControllerRemovesaccounts(c *gc.C) {
 	err = store.RemoveController("kontroll")
 	c.Assert(err, jc.ErrorIsNil)
 
-	accounts, err := jujuclient.ReadAccountsFile(jujuclient.JujuAccountsPath())
+	accounts, err := jujuclient.ReadAccountsFile(dataPath(c, jujuclient.JujuAccountsPath))
 	c.Assert(err, jc.ErrorIsNil)
 	_, ok := accounts["kontroll"]
 	c.Assert(ok, jc.IsFalse) // kontroll accounts are removed
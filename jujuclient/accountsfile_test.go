@@ -72,19 +72,19 @@ func (s *AccountsFileSuite) TestReadNoFile(c *gc.C) {
 func (s *AccountsFileSuite) TestReadEmptyFile(c *gc.C) {
 	err := ioutil.WriteFile(osenv.JujuXDGDataHomePath("accounts.yaml"), []byte(""), 0600)
 	c.Assert(err, jc.ErrorIsNil)
-	accounts, err := jujuclient.ReadAccountsFile(jujuclient.JujuAccountsPath())
+	accounts, err := jujuclient.ReadAccountsFile(dataPath(c, jujuclient.JujuAccountsPath))
 	c.Assert(err, jc.ErrorIsNil)
 	c.Assert(accounts, gc.HasLen, 0)
 }
 
 func (s *AccountsFileSuite) TestMigrateLegacyLocal(c *gc.C) {
-	err := ioutil.WriteFile(jujuclient.JujuAccountsPath(), []byte(testLegacyAccountsYAML), 0644)
+	err := ioutil.WriteFile(dataPath(c, jujuclient.JujuAccountsPath), []byte(testLegacyAccountsYAML), 0644)
 	c.Assert(err, jc.ErrorIsNil)
 
-	accounts, err := jujuclient.ReadAccountsFile(jujuclient.JujuAccountsPath())
+	accounts, err := jujuclient.ReadAccountsFile(dataPath(c, jujuclient.JujuAccountsPath))
 	c.Assert(err, jc.ErrorIsNil)
 
-	migratedData, err := ioutil.ReadFile(jujuclient.JujuAccountsPath())
+	migratedData, err := ioutil.ReadFile(dataPath(c, jujuclient.JujuAccountsPath))
 	c.Assert(err, jc.ErrorIsNil)
 	migratedAccounts, err := jujuclient.ParseAccounts(migratedData)
 	c.Assert(err, jc.ErrorIsNil)
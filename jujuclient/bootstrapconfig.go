@@ -16,8 +16,8 @@ import (
 
 // JujuBootstrapConfigPath is the location where bootstrap config is
 // expected to be found.
-func JujuBootstrapConfigPath() string {
-	return osenv.JujuXDGDataHomePath("bootstrap-config.yaml")
+func JujuBootstrapConfigPath() (string, error) {
+	return osenv.JujuProfileDataPath("bootstrap-config.yaml")
 }
 
 // ReadBootstrapConfigFile loads all bootstrap configurations defined in a
@@ -44,7 +44,11 @@ func WriteBootstrapConfigFile(configs map[string]BootstrapConfig) error {
 	if err != nil {
 		return errors.Annotate(err, "cannot marshal bootstrap configurations")
 	}
-	return utils.AtomicWriteFile(JujuBootstrapConfigPath(), data, os.FileMode(0600))
+	path, err := JujuBootstrapConfigPath()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	return utils.AtomicWriteFile(path, data, os.FileMode(0600))
 }
 
 // ParseBootstrapConfig parses the given YAML bytes into bootstrap config
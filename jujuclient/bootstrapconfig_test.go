@@ -27,7 +27,7 @@ func (s *BootstrapConfigSuite) SetUpTest(c *gc.C) {
 }
 
 func (s *BootstrapConfigSuite) TestBootstrapConfigForControllerNoFile(c *gc.C) {
-	err := os.Remove(jujuclient.JujuBootstrapConfigPath())
+	err := os.Remove(dataPath(c, jujuclient.JujuBootstrapConfigPath))
 	c.Assert(err, jc.ErrorIsNil)
 	details, err := s.store.BootstrapConfigForController("not-found")
 	c.Assert(err, gc.ErrorMatches, "bootstrap config for controller not-found not found")
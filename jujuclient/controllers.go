@@ -16,8 +16,8 @@ import (
 
 // JujuControllersPath is the location where controllers information is
 // expected to be found.
-func JujuControllersPath() string {
-	return osenv.JujuXDGDataHomePath("controllers.yaml")
+func JujuControllersPath() (string, error) {
+	return osenv.JujuProfileDataPath("controllers.yaml")
 }
 
 // ReadControllersFile loads all controllers defined in a given file.
@@ -44,7 +44,11 @@ func WriteControllersFile(controllers *Controllers) error {
 	if err != nil {
 		return errors.Annotate(err, "cannot marshal yaml controllers")
 	}
-	return utils.AtomicWriteFile(JujuControllersPath(), data, os.FileMode(0600))
+	path, err := JujuControllersPath()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	return utils.AtomicWriteFile(path, data, os.FileMode(0600))
 }
 
 // ParseControllers parses the given YAML bytes into controllers metadata.
@@ -163,7 +163,9 @@ func (s *ControllersSuite) TestRemoveControllerRemovesCookieJar(c *gc.C) {
 	c.Assert(err, jc.ErrorIsNil)
 
 	// Sanity-check that the cookie jar file exists.
-	_, err = os.Stat(jujuclient.JujuCookiePath(name))
+	cookiePath, err := jujuclient.JujuCookiePath(name)
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = os.Stat(cookiePath)
 	c.Assert(err, jc.ErrorIsNil)
 
 	err = s.store.RemoveController(name)
@@ -174,7 +176,7 @@ func (s *ControllersSuite) TestRemoveControllerRemovesCookieJar(c *gc.C) {
 	c.Assert(found, gc.IsNil)
 
 	// Check that the cookie jar has been removed.
-	_, err = os.Stat(jujuclient.JujuCookiePath(name))
+	_, err = os.Stat(cookiePath)
 	c.Assert(err, jc.Satisfies, os.IsNotExist)
 }
 
@@ -192,7 +194,7 @@ func (s *ControllersSuite) TestSetCurrentController(c *gc.C) {
 	err = s.store.SetCurrentController(s.controllerName)
 	c.Assert(err, jc.ErrorIsNil)
 
-	controllers, err := jujuclient.ReadControllersFile(jujuclient.JujuControllersPath())
+	controllers, err := jujuclient.ReadControllersFile(dataPath(c, jujuclient.JujuControllersPath))
 	c.Assert(err, jc.ErrorIsNil)
 	c.Assert(controllers.CurrentController, gc.Equals, s.controllerName)
 }
@@ -17,8 +17,8 @@ import (
 
 // JujuCredentialsPath is the location where controllers information is
 // expected to be found.
-func JujuCredentialsPath() string {
-	return osenv.JujuXDGDataHomePath("credentials.yaml")
+func JujuCredentialsPath() (string, error) {
+	return osenv.JujuProfileDataPath("credentials.yaml")
 }
 
 // ReadCredentialsFile loads all credentials defined in a given file.
@@ -45,7 +45,11 @@ func WriteCredentialsFile(credentials map[string]cloud.CloudCredential) error {
 	if err != nil {
 		return errors.Annotate(err, "cannot marshal yaml credentials")
 	}
-	return utils.AtomicWriteFile(JujuCredentialsPath(), data, os.FileMode(0600))
+	path, err := JujuCredentialsPath()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	return utils.AtomicWriteFile(path, data, os.FileMode(0600))
 }
 
 // credentialsCollection is a struct containing cloud credential information,
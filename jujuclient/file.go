@@ -55,13 +55,13 @@ type store struct {
 	lockName string
 }
 
-// generateStoreLockName uses part of the hash of the controller path as the
-// name of the lock. This is to avoid contention between multiple users on a
-// single machine with different controller files, but also helps with
-// contention in tests.
+// generateStoreLockName uses part of the hash of the juju data directory
+// as the name of the lock. This is to avoid contention between multiple
+// users on a single machine with different data directories, but also
+// helps with contention in tests.
 func generateStoreLockName() string {
 	h := sha256.New()
-	h.Write([]byte(JujuControllersPath()))
+	h.Write([]byte(osenv.JujuXDGDataHomeDir()))
 	fullHash := fmt.Sprintf("%x", h.Sum(nil))
 	return fmt.Sprintf("store-lock-%x", fullHash[:8])
 }
@@ -87,7 +87,11 @@ func (s *store) AllControllers() (map[string]ControllerDetails, error) {
 		return nil, errors.Annotate(err, "cannot read all controllers")
 	}
 	defer releaser.Release()
-	controllers, err := ReadControllersFile(JujuControllersPath())
+	controllersPath, err := JujuControllersPath()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	controllers, err := ReadControllersFile(controllersPath)
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
@@ -101,7 +105,11 @@ func (s *store) CurrentController() (string, error) {
 		return "", errors.Annotate(err, "cannot get current controller name")
 	}
 	defer releaser.Release()
-	controllers, err := ReadControllersFile(JujuControllersPath())
+	controllersPath, err := JujuControllersPath()
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	controllers, err := ReadControllersFile(controllersPath)
 	if err != nil {
 		return "", errors.Trace(err)
 	}
@@ -123,7 +131,11 @@ func (s *store) ControllerByName(name string) (*ControllerDetails, error) {
 	}
 	defer releaser.Release()
 
-	controllers, err := ReadControllersFile(JujuControllersPath())
+	controllersPath, err := JujuControllersPath()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	controllers, err := ReadControllersFile(controllersPath)
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
@@ -148,7 +160,11 @@ func (s *store) AddController(name string, details ControllerDetails) error {
 	}
 	defer releaser.Release()
 
-	all, err := ReadControllersFile(JujuControllersPath())
+	controllersPath, err := JujuControllersPath()
+	if err != nil {
+		return errors.Annotate(err, "cannot get controllers")
+	}
+	all, err := ReadControllersFile(controllersPath)
 	if err != nil {
 		return errors.Annotate(err, "cannot get controllers")
 	}
@@ -187,7 +203,11 @@ func (s *store) UpdateController(name string, details ControllerDetails) error {
 	}
 	defer releaser.Release()
 
-	all, err := ReadControllersFile(JujuControllersPath())
+	controllersPath, err := JujuControllersPath()
+	if err != nil {
+		return errors.Annotate(err, "cannot get controllers")
+	}
+	all, err := ReadControllersFile(controllersPath)
 	if err != nil {
 		return errors.Annotate(err, "cannot get controllers")
 	}
@@ -223,7 +243,11 @@ func (s *store) SetCurrentController(name string) error {
 	}
 	defer releaser.Release()
 
-	controllers, err := ReadControllersFile(JujuControllersPath())
+	controllersPath, err := JujuControllersPath()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	controllers, err := ReadControllersFile(controllersPath)
 	if err != nil {
 		return errors.Trace(err)
 	}
@@ -249,7 +273,11 @@ func (s *store) RemoveController(name string) error {
 	}
 	defer releaser.Release()
 
-	controllers, err := ReadControllersFile(JujuControllersPath())
+	controllersPath, err := JujuControllersPath()
+	if err != nil {
+		return errors.Annotate(err, "cannot get controllers")
+	}
+	controllers, err := ReadControllersFile(controllersPath)
 	if err != nil {
 		return errors.Annotate(err, "cannot get controllers")
 	}
@@ -271,7 +299,11 @@ func (s *store) RemoveController(name string) error {
 	}
 
 	// Remove models for the controller.
-	controllerModels, err := ReadModelsFile(JujuModelsPath())
+	modelsPath, err := JujuModelsPath()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	controllerModels, err := ReadModelsFile(modelsPath)
 	if err != nil {
 		return errors.Trace(err)
 	}
@@ -285,7 +317,11 @@ func (s *store) RemoveController(name string) error {
 	}
 
 	// Remove accounts for the controller.
-	controllerAccounts, err := ReadAccountsFile(JujuAccountsPath())
+	accountsPath, err := JujuAccountsPath()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	controllerAccounts, err := ReadAccountsFile(accountsPath)
 	if err != nil {
 		return errors.Trace(err)
 	}
@@ -297,9 +333,24 @@ func (s *store) RemoveController(name string) error {
 			}
 		}
 	}
+	secrets, profile, err := profileSecretStore()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if secrets != nil {
+		for _, name := range names {
+			if err := secrets.RemoveSecret(accountSecretKey(profile, name)); err != nil {
+				return errors.Trace(err)
+			}
+		}
+	}
 
 	// Remove bootstrap config for the controller.
-	bootstrapConfigurations, err := ReadBootstrapConfigFile(JujuBootstrapConfigPath())
+	bootstrapConfigPath, err := JujuBootstrapConfigPath()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	bootstrapConfigurations, err := ReadBootstrapConfigFile(bootstrapConfigPath)
 	if err != nil {
 		return errors.Trace(err)
 	}
@@ -314,7 +365,11 @@ func (s *store) RemoveController(name string) error {
 
 	// Remove the controller cookie jars.
 	for _, name := range names {
-		err := os.Remove(JujuCookiePath(name))
+		path, err := JujuCookiePath(name)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		err = os.Remove(path)
 		if err != nil && !os.IsNotExist(err) {
 			return errors.Trace(err)
 		}
@@ -408,7 +463,11 @@ func (s *store) AllModels(controllerName string) (map[string]ModelDetails, error
 	}
 	defer releaser.Release()
 
-	all, err := ReadModelsFile(JujuModelsPath())
+	modelsPath, err := JujuModelsPath()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	all, err := ReadModelsFile(modelsPath)
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
@@ -434,7 +493,11 @@ func (s *store) CurrentModel(controllerName string) (string, error) {
 	}
 	defer releaser.Release()
 
-	all, err := ReadModelsFile(JujuModelsPath())
+	modelsPath, err := JujuModelsPath()
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	all, err := ReadModelsFile(modelsPath)
 	if err != nil {
 		return "", errors.Trace(err)
 	}
@@ -469,7 +532,11 @@ func (s *store) ModelByName(controllerName, modelName string) (*ModelDetails, er
 	}
 	defer releaser.Release()
 
-	all, err := ReadModelsFile(JujuModelsPath())
+	modelsPath, err := JujuModelsPath()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	all, err := ReadModelsFile(modelsPath)
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
@@ -529,7 +596,11 @@ func (s *store) RemoveModel(controllerName, modelName string) error {
 type updateModelFunc func(storedModels *ControllerModels) (bool, error)
 
 func updateModels(controllerName string, update updateModelFunc) error {
-	all, err := ReadModelsFile(JujuModelsPath())
+	modelsPath, err := JujuModelsPath()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	all, err := ReadModelsFile(modelsPath)
 	if err != nil {
 		return errors.Trace(err)
 	}
@@ -615,13 +686,27 @@ func (s *store) UpdateAccount(controllerName string, details AccountDetails) err
 	}
 	defer releaser.Release()
 
-	accounts, err := ReadAccountsFile(JujuAccountsPath())
+	accountsPath, err := JujuAccountsPath()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	accounts, err := ReadAccountsFile(accountsPath)
 	if err != nil {
 		return errors.Trace(err)
 	}
 	if accounts == nil {
 		accounts = make(map[string]AccountDetails)
 	}
+	secrets, profile, err := profileSecretStore()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if secrets != nil {
+		// The password is held in the secret store rather than the file.
+		if details, err = storeAccountSecret(secrets, profile, controllerName, details); err != nil {
+			return errors.Trace(err)
+		}
+	}
 	if oldDetails, ok := accounts[controllerName]; ok && details == oldDetails {
 		return nil
 	} else {
@@ -647,7 +732,11 @@ func (s *store) AccountDetails(controllerName string) (*AccountDetails, error) {
 	}
 	defer releaser.Release()
 
-	accounts, err := ReadAccountsFile(JujuAccountsPath())
+	accountsPath, err := JujuAccountsPath()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	accounts, err := ReadAccountsFile(accountsPath)
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
@@ -655,6 +744,15 @@ func (s *store) AccountDetails(controllerName string) (*AccountDetails, error) {
 	if !ok {
 		return nil, errors.NotFoundf("account details for controller %s", controllerName)
 	}
+	secrets, profile, err := profileSecretStore()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if secrets != nil {
+		if err := fillAccountSecret(secrets, profile, controllerName, &details); err != nil {
+			return nil, errors.Trace(err)
+		}
+	}
 	return &details, nil
 }
 
@@ -670,13 +768,26 @@ func (s *store) RemoveAccount(controllerName string) error {
 	}
 	defer releaser.Release()
 
-	accounts, err := ReadAccountsFile(JujuAccountsPath())
+	accountsPath, err := JujuAccountsPath()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	accounts, err := ReadAccountsFile(accountsPath)
 	if err != nil {
 		return errors.Trace(err)
 	}
 	if _, ok := accounts[controllerName]; !ok {
 		return errors.NotFoundf("account details for controller %s", controllerName)
 	}
+	secrets, profile, err := profileSecretStore()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if secrets != nil {
+		if err := secrets.RemoveSecret(accountSecretKey(profile, controllerName)); err != nil {
+			return errors.Trace(err)
+		}
+	}
 
 	delete(accounts, controllerName)
 	return errors.Trace(WriteAccountsFile(accounts))
@@ -690,7 +801,11 @@ func (s *store) UpdateCredential(cloudName string, details cloud.CloudCredential
 	}
 	defer releaser.Release()
 
-	all, err := ReadCredentialsFile(JujuCredentialsPath())
+	credentialsPath, err := JujuCredentialsPath()
+	if err != nil {
+		return errors.Annotate(err, "cannot get credentials")
+	}
+	all, err := ReadCredentialsFile(credentialsPath)
 	if err != nil {
 		return errors.Annotate(err, "cannot get credentials")
 	}
@@ -712,6 +827,17 @@ func (s *store) UpdateCredential(cloudName string, details cloud.CloudCredential
 			details.DefaultCredential = ""
 		}
 	}
+	secrets, profile, err := profileSecretStore()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if secrets != nil {
+		// The credential attributes are held in the secret store
+		// rather than the file.
+		if details, err = storeCredentialSecrets(secrets, profile, cloudName, details, all[cloudName]); err != nil {
+			return errors.Trace(err)
+		}
+	}
 	if len(details.AuthCredentials) > 0 {
 		all[cloudName] = details
 	} else {
@@ -736,10 +862,23 @@ func (s *store) CredentialForCloud(cloudName string) (*cloud.CloudCredential, er
 
 // AllCredentials implements CredentialGetter.
 func (s *store) AllCredentials() (map[string]cloud.CloudCredential, error) {
-	cloudCredentials, err := ReadCredentialsFile(JujuCredentialsPath())
+	credentialsPath, err := JujuCredentialsPath()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	cloudCredentials, err := ReadCredentialsFile(credentialsPath)
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
+	secrets, profile, err := profileSecretStore()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if secrets != nil {
+		if err := fillCredentialSecrets(secrets, profile, cloudCredentials); err != nil {
+			return nil, errors.Trace(err)
+		}
+	}
 	return cloudCredentials, nil
 }
 
@@ -758,7 +897,11 @@ func (s *store) UpdateBootstrapConfig(controllerName string, cfg BootstrapConfig
 	}
 	defer releaser.Release()
 
-	all, err := ReadBootstrapConfigFile(JujuBootstrapConfigPath())
+	bootstrapConfigPath, err := JujuBootstrapConfigPath()
+	if err != nil {
+		return errors.Annotate(err, "cannot get bootstrap config")
+	}
+	all, err := ReadBootstrapConfigFile(bootstrapConfigPath)
 	if err != nil {
 		return errors.Annotate(err, "cannot get bootstrap config")
 	}
@@ -772,7 +915,11 @@ func (s *store) UpdateBootstrapConfig(controllerName string, cfg BootstrapConfig
 
 // BootstrapConfigForController implements BootstrapConfigGetter.
 func (s *store) BootstrapConfigForController(controllerName string) (*BootstrapConfig, error) {
-	configs, err := ReadBootstrapConfigFile(JujuBootstrapConfigPath())
+	bootstrapConfigPath, err := JujuBootstrapConfigPath()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	configs, err := ReadBootstrapConfigFile(bootstrapConfigPath)
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
@@ -788,7 +935,10 @@ func (s *store) CookieJar(controllerName string) (CookieJar, error) {
 	if err := ValidateControllerName(controllerName); err != nil {
 		return nil, errors.Trace(err)
 	}
-	path := JujuCookiePath(controllerName)
+	path, err := JujuCookiePath(controllerName)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
 	jar, err := cookiejar.New(&cookiejar.Options{
 		Filename: path,
 	})
@@ -816,6 +966,6 @@ func (jar *cookieJar) Save() error {
 
 // JujuCookiePath is the location where cookies associated
 // with the given controller are expected to be found.
-func JujuCookiePath(controllerName string) string {
-	return osenv.JujuXDGDataHomePath("cookies", controllerName+".json")
+func JujuCookiePath(controllerName string) (string, error) {
+	return osenv.JujuProfileDataPath("cookies", controllerName+".json")
 }
@@ -20,9 +20,9 @@ import (
 
 // JujuModelsPath is the location where models information is
 // expected to be found.
-func JujuModelsPath() string {
+func JujuModelsPath() (string, error) {
 	// TODO(axw) models.yaml should go into XDG_CACHE_HOME.
-	return osenv.JujuXDGDataHomePath("models.yaml")
+	return osenv.JujuProfileDataPath("models.yaml")
 }
 
 // ReadModelsFile loads all models defined in a given file.
@@ -120,7 +120,11 @@ func WriteModelsFile(models map[string]*ControllerModels) error {
 	if err != nil {
 		return errors.Annotate(err, "cannot marshal models")
 	}
-	return utils.AtomicWriteFile(JujuModelsPath(), data, os.FileMode(0600))
+	path, err := JujuModelsPath()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	return utils.AtomicWriteFile(path, data, os.FileMode(0600))
 }
 
 // ParseModels parses the given YAML bytes into models metadata.
@@ -30,7 +30,7 @@ func (s *ModelsSuite) SetUpTest(c *gc.C) {
 }
 
 func (s *ModelsSuite) TestModelByNameNoFile(c *gc.C) {
-	err := os.Remove(jujuclient.JujuModelsPath())
+	err := os.Remove(dataPath(c, jujuclient.JujuModelsPath))
 	c.Assert(err, jc.ErrorIsNil)
 	details, err := s.store.ModelByName("not-found", "admin/admin")
 	c.Assert(err, gc.ErrorMatches, "model not-found:admin/admin not found")
@@ -57,7 +57,7 @@ func (s *ModelsSuite) TestModelByName(c *gc.C) {
 }
 
 func (s *ModelsSuite) TestAllModelsNoFile(c *gc.C) {
-	err := os.Remove(jujuclient.JujuModelsPath())
+	err := os.Remove(dataPath(c, jujuclient.JujuModelsPath))
 	c.Assert(err, jc.ErrorIsNil)
 	models, err := s.store.AllModels("not-found")
 	c.Assert(err, gc.ErrorMatches, "models for controller not-found not found")
@@ -99,7 +99,7 @@ func (s *ModelsSuite) TestSetCurrentModelModelNotFound(c *gc.C) {
 func (s *ModelsSuite) TestSetCurrentModel(c *gc.C) {
 	err := s.store.SetCurrentModel("kontroll", "admin/admin")
 	c.Assert(err, jc.ErrorIsNil)
-	all, err := jujuclient.ReadModelsFile(jujuclient.JujuModelsPath())
+	all, err := jujuclient.ReadModelsFile(dataPath(c, jujuclient.JujuModelsPath))
 	c.Assert(err, jc.ErrorIsNil)
 	c.Assert(all["kontroll"].CurrentModel, gc.Equals, "admin/admin")
 }
@@ -151,7 +151,7 @@ func (s *ModelsSuite) TestUpdateModelEmptyModels(c *gc.C) {
 	// This test exists to exercise a bug caused by the
 	// presence of a file with an empty "models" field,
 	// that would lead to a panic.
-	err := ioutil.WriteFile(jujuclient.JujuModelsPath(), []byte(`
+	err := ioutil.WriteFile(dataPath(c, jujuclient.JujuModelsPath), []byte(`
 controllers:
   ctrl:
     models:
@@ -169,7 +169,7 @@ controllers:
 }
 
 func (s *ModelsSuite) TestRemoveModelNoFile(c *gc.C) {
-	err := os.Remove(jujuclient.JujuModelsPath())
+	err := os.Remove(dataPath(c, jujuclient.JujuModelsPath))
 	c.Assert(err, jc.ErrorIsNil)
 	err = s.store.RemoveModel("not-found", "admin/admin")
 	c.Assert(err, jc.Satisfies, errors.IsNotFound)
@@ -202,7 +202,7 @@ func (s *ModelsSuite) TestRemoveControllerRemovesModels(c *gc.C) {
 	err = store.RemoveController("kontroll")
 	c.Assert(err, jc.ErrorIsNil)
 
-	models, err := jujuclient.ReadModelsFile(jujuclient.JujuModelsPath())
+	models, err := jujuclient.ReadModelsFile(dataPath(c, jujuclient.JujuModelsPath))
 	c.Assert(err, jc.ErrorIsNil)
 	_, ok := models["admin/kontroll"]
 	c.Assert(ok, jc.IsFalse) // kontroll models are removed
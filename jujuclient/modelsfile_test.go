@@ -89,19 +89,19 @@ func (s *ModelsFileSuite) TestReadNoFile(c *gc.C) {
 func (s *ModelsFileSuite) TestReadEmptyFile(c *gc.C) {
 	err := ioutil.WriteFile(osenv.JujuXDGDataHomePath("models.yaml"), []byte(""), 0600)
 	c.Assert(err, jc.ErrorIsNil)
-	models, err := jujuclient.ReadModelsFile(jujuclient.JujuModelsPath())
+	models, err := jujuclient.ReadModelsFile(dataPath(c, jujuclient.JujuModelsPath))
 	c.Assert(err, jc.ErrorIsNil)
 	c.Assert(models, gc.HasLen, 0)
 }
 
 func (s *ModelsFileSuite) TestMigrateLegacyLocal(c *gc.C) {
-	err := ioutil.WriteFile(jujuclient.JujuModelsPath(), []byte(testLegacyModelsYAML), 0644)
+	err := ioutil.WriteFile(dataPath(c, jujuclient.JujuModelsPath), []byte(testLegacyModelsYAML), 0644)
 	c.Assert(err, jc.ErrorIsNil)
 
-	models, err := jujuclient.ReadModelsFile(jujuclient.JujuModelsPath())
+	models, err := jujuclient.ReadModelsFile(dataPath(c, jujuclient.JujuModelsPath))
 	c.Assert(err, jc.ErrorIsNil)
 
-	migratedData, err := ioutil.ReadFile(jujuclient.JujuModelsPath())
+	migratedData, err := ioutil.ReadFile(dataPath(c, jujuclient.JujuModelsPath))
 	c.Assert(err, jc.ErrorIsNil)
 	migratedModels, err := jujuclient.ParseModels(migratedData)
 	c.Assert(err, jc.ErrorIsNil)
@@ -0,0 +1,121 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package jujuclient
+
+import (
+	"os"
+	"sort"
+
+	"github.com/juju/errors"
+	"github.com/juju/utils"
+	"gopkg.in/yaml.v2"
+
+	"github.com/juju/juju/juju/osenv"
+)
+
+// ValidateProfileName validates the given client profile name.
+func ValidateProfileName(name string) error {
+	return osenv.ValidateProfileName(name)
+}
+
+// ProfileDetails holds the details of a client profile.
+type ProfileDetails struct {
+	// Name is the name of the profile.
+	Name string
+
+	// ShareClouds is true if the profile uses the cloud
+	// definitions of the default profile.
+	ShareClouds bool
+
+	// Current is true if this is the current profile.
+	Current bool
+}
+
+// WriteProfilesFile marshals to YAML the given profiles
+// and writes it to the profiles file.
+func WriteProfilesFile(profiles *osenv.Profiles) error {
+	data, err := yaml.Marshal(profiles)
+	if err != nil {
+		return errors.Annotate(err, "cannot marshal profiles")
+	}
+	return utils.AtomicWriteFile(osenv.JujuProfilesPath(), data, os.FileMode(0600))
+}
+
+// AllProfiles returns the details of all client profiles, including
+// the default profile, sorted by name.
+func AllProfiles() ([]ProfileDetails, error) {
+	profiles, err := osenv.ReadProfilesFile(osenv.JujuProfilesPath())
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	current, err := osenv.JujuCurrentProfile()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	result := []ProfileDetails{{
+		Name:        osenv.DefaultProfile,
+		ShareClouds: true,
+		Current:     current == osenv.DefaultProfile,
+	}}
+	for name, details := range profiles.Profiles {
+		result = append(result, ProfileDetails{
+			Name:        name,
+			ShareClouds: details.ShareClouds,
+			Current:     current == name,
+		})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+	return result, nil
+}
+
+// CreateProfile creates a new, empty, client profile with the given
+// name. If shareClouds is true, the profile uses the cloud definitions
+// of the default profile rather than its own.
+func CreateProfile(name string, shareClouds bool) error {
+	if err := ValidateProfileName(name); err != nil {
+		return errors.Trace(err)
+	}
+	if name == osenv.DefaultProfile {
+		return errors.AlreadyExistsf("profile %q", name)
+	}
+	profiles, err := osenv.ReadProfilesFile(osenv.JujuProfilesPath())
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if _, ok := profiles.Profiles[name]; ok {
+		return errors.AlreadyExistsf("profile %q", name)
+	}
+	if err := os.MkdirAll(osenv.JujuProfileDir(name), 0700); err != nil {
+		return errors.Annotatef(err, "cannot create directory for profile %q", name)
+	}
+	if profiles.Profiles == nil {
+		profiles.Profiles = make(map[string]osenv.ProfileDetails)
+	}
+	profiles.Profiles[name] = osenv.ProfileDetails{ShareClouds: shareClouds}
+	return errors.Trace(WriteProfilesFile(profiles))
+}
+
+// SetCurrentProfile sets the named profile as the current profile.
+// $JUJU_PROFILE, if set, still takes precedence.
+func SetCurrentProfile(name string) error {
+	profiles, err := osenv.ReadProfilesFile(osenv.JujuProfilesPath())
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if name != osenv.DefaultProfile {
+		if _, ok := profiles.Profiles[name]; !ok {
+			return errors.NotFoundf("profile %q", name)
+		}
+	}
+	if name == osenv.DefaultProfile {
+		name = ""
+	}
+	if profiles.CurrentProfile == name {
+		return nil
+	}
+	profiles.CurrentProfile = name
+	return errors.Trace(WriteProfilesFile(profiles))
+}
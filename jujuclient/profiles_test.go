@@ -0,0 +1,143 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package jujuclient_test
+
+import (
+	"path/filepath"
+
+	"github.com/juju/errors"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/cloud"
+	"github.com/juju/juju/juju/osenv"
+	"github.com/juju/juju/jujuclient"
+	"github.com/juju/juju/testing"
+)
+
+type ProfilesSuite struct {
+	testing.FakeJujuXDGDataHomeSuite
+}
+
+var _ = gc.Suite(&ProfilesSuite{})
+
+func (s *ProfilesSuite) TestAllProfilesDefault(c *gc.C) {
+	profiles, err := jujuclient.AllProfiles()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(profiles, jc.DeepEquals, []jujuclient.ProfileDetails{
+		{Name: "default", ShareClouds: true, Current: true},
+	})
+}
+
+func (s *ProfilesSuite) TestCreateProfile(c *gc.C) {
+	err := jujuclient.CreateProfile("work", false)
+	c.Assert(err, jc.ErrorIsNil)
+	err = jujuclient.CreateProfile("home", true)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(filepath.Join(osenv.JujuXDGDataHomeDir(), "profiles", "work"), jc.IsDirectory)
+
+	profiles, err := jujuclient.AllProfiles()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(profiles, jc.DeepEquals, []jujuclient.ProfileDetails{
+		{Name: "default", ShareClouds: true, Current: true},
+		{Name: "home", ShareClouds: true},
+		{Name: "work"},
+	})
+}
+
+func (s *ProfilesSuite) TestCreateProfileExists(c *gc.C) {
+	err := jujuclient.CreateProfile("default", false)
+	c.Assert(err, jc.Satisfies, errors.IsAlreadyExists)
+	err = jujuclient.CreateProfile("work", false)
+	c.Assert(err, jc.ErrorIsNil)
+	err = jujuclient.CreateProfile("work", false)
+	c.Assert(err, gc.ErrorMatches, `profile "work" already exists`)
+}
+
+func (s *ProfilesSuite) TestCreateProfileInvalidName(c *gc.C) {
+	err := jujuclient.CreateProfile("../work", false)
+	c.Assert(err, gc.ErrorMatches, `profile name "../work" not valid`)
+}
+
+func (s *ProfilesSuite) TestSetCurrentProfile(c *gc.C) {
+	err := jujuclient.SetCurrentProfile("work")
+	c.Assert(err, jc.Satisfies, errors.IsNotFound)
+
+	err = jujuclient.CreateProfile("work", false)
+	c.Assert(err, jc.ErrorIsNil)
+	err = jujuclient.SetCurrentProfile("work")
+	c.Assert(err, jc.ErrorIsNil)
+	current, err := osenv.JujuCurrentProfile()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(current, gc.Equals, "work")
+
+	err = jujuclient.SetCurrentProfile("default")
+	c.Assert(err, jc.ErrorIsNil)
+	current, err = osenv.JujuCurrentProfile()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(current, gc.Equals, "default")
+}
+
+func (s *ProfilesSuite) TestProfileEnvironmentOverride(c *gc.C) {
+	err := jujuclient.CreateProfile("work", false)
+	c.Assert(err, jc.ErrorIsNil)
+	s.PatchEnvironment(osenv.JujuProfileEnvKey, "work")
+	profiles, err := jujuclient.AllProfiles()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(profiles, jc.DeepEquals, []jujuclient.ProfileDetails{
+		{Name: "default", ShareClouds: true},
+		{Name: "work", Current: true},
+	})
+}
+
+func (s *ProfilesSuite) TestProfilesIsolateControllers(c *gc.C) {
+	store := jujuclient.NewFileClientStore()
+	err := store.AddController("ctrl", jujuclient.ControllerDetails{
+		ControllerUUID: "f6b9ddc4-a7f8-4b86-8cb7-4a5cfd1fd3dd",
+		CACert:         "cert",
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = jujuclient.CreateProfile("work", false)
+	c.Assert(err, jc.ErrorIsNil)
+	err = jujuclient.SetCurrentProfile("work")
+	c.Assert(err, jc.ErrorIsNil)
+
+	c.Assert(dataPath(c, jujuclient.JujuControllersPath), gc.Equals,
+		filepath.Join(osenv.JujuXDGDataHomeDir(), "profiles", "work", "controllers.yaml"))
+	controllers, err := jujuclient.NewFileClientStore().AllControllers()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(controllers, gc.HasLen, 0)
+
+	err = jujuclient.SetCurrentProfile("default")
+	c.Assert(err, jc.ErrorIsNil)
+	controllers, err = jujuclient.NewFileClientStore().AllControllers()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(controllers, gc.HasLen, 1)
+}
+
+func (s *ProfilesSuite) TestProfilesShareClouds(c *gc.C) {
+	err := jujuclient.CreateProfile("shared", true)
+	c.Assert(err, jc.ErrorIsNil)
+	err = jujuclient.CreateProfile("private", false)
+	c.Assert(err, jc.ErrorIsNil)
+
+	s.PatchEnvironment(osenv.JujuProfileEnvKey, "shared")
+	c.Assert(dataPath(c, cloud.JujuPersonalCloudsPath), gc.Equals,
+		filepath.Join(osenv.JujuXDGDataHomeDir(), "clouds.yaml"))
+	c.Assert(dataPath(c, jujuclient.JujuCredentialsPath), gc.Equals,
+		filepath.Join(osenv.JujuXDGDataHomeDir(), "profiles", "shared", "credentials.yaml"))
+
+	s.PatchEnvironment(osenv.JujuProfileEnvKey, "private")
+	c.Assert(dataPath(c, cloud.JujuPersonalCloudsPath), gc.Equals,
+		filepath.Join(osenv.JujuXDGDataHomeDir(), "profiles", "private", "clouds.yaml"))
+}
+
+// dataPath returns the path to a client data file for the
+// current profile.
+func dataPath(c *gc.C, pathFunc func() (string, error)) string {
+	path, err := pathFunc()
+	c.Assert(err, jc.ErrorIsNil)
+	return path
+}
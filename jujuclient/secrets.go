@@ -0,0 +1,206 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package jujuclient
+
+import (
+	"encoding/json"
+	"os"
+	"strings"
+
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/cloud"
+	"github.com/juju/juju/juju/osenv"
+)
+
+// SecretStore instances hold the secret parts of the client data, being
+// account passwords and cloud credential attributes, so that the file
+// client store need not write them in clear text to the YAML files in
+// $JUJU_DATA.
+type SecretStore interface {
+	// Secret returns the secret with the given key. If there is
+	// no such secret, an error satisfying errors.IsNotFound is
+	// returned.
+	Secret(key string) (string, error)
+
+	// SetSecret creates or replaces the secret with the given key.
+	SetSecret(key, value string) error
+
+	// RemoveSecret removes the secret with the given key. Removing
+	// a secret which does not exist is not an error.
+	RemoveSecret(key string) error
+}
+
+const (
+	// SecretStoreFile is the $JUJU_SECRET_STORE value which selects
+	// the passphrase protected encrypted secrets file.
+	SecretStoreFile = "file"
+
+	// SecretStoreHelperPrefix prefixes the command of a secret store
+	// helper in $JUJU_SECRET_STORE.
+	SecretStoreHelperPrefix = "helper:"
+)
+
+// JujuSecretsPath is the location of the encrypted secrets file.
+func JujuSecretsPath() (string, error) {
+	return osenv.JujuProfileDataPath("secrets.enc")
+}
+
+// NewSecretStoreFromEnvironment returns the secret store selected by
+// $JUJU_SECRET_STORE. If the variable is not set, nil is returned and
+// secrets are stored in clear text along with the rest of the client data.
+func NewSecretStoreFromEnvironment() (SecretStore, error) {
+	value := os.Getenv(osenv.JujuSecretStoreEnvKey)
+	switch {
+	case value == "":
+		return nil, nil
+	case value == SecretStoreFile:
+		passphrase := os.Getenv(osenv.JujuSecretStorePassphraseEnvKey)
+		if passphrase == "" {
+			return nil, errors.Errorf(
+				"%s=%s requires a passphrase in %s",
+				osenv.JujuSecretStoreEnvKey, SecretStoreFile, osenv.JujuSecretStorePassphraseEnvKey,
+			)
+		}
+		path, err := JujuSecretsPath()
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		return NewEncryptedFileSecretStore(path, passphrase), nil
+	case strings.HasPrefix(value, SecretStoreHelperPrefix):
+		command := strings.TrimSpace(strings.TrimPrefix(value, SecretStoreHelperPrefix))
+		if command == "" {
+			return nil, errors.NotValidf("%s %q without a command", osenv.JujuSecretStoreEnvKey, value)
+		}
+		return NewHelperSecretStore(command), nil
+	}
+	return nil, errors.NotValidf("%s %q", osenv.JujuSecretStoreEnvKey, value)
+}
+
+// profileSecretStore returns the secret store selected by
+// $JUJU_SECRET_STORE, or nil if there is none, along with the name of
+// the current client profile. The keys of a profile's secrets include
+// its name, so that profiles sharing a secret store do not overwrite
+// one another's secrets.
+func profileSecretStore() (SecretStore, string, error) {
+	secrets, err := NewSecretStoreFromEnvironment()
+	if err != nil || secrets == nil {
+		return nil, "", errors.Trace(err)
+	}
+	profile, err := osenv.JujuCurrentProfile()
+	if err != nil {
+		return nil, "", errors.Trace(err)
+	}
+	return secrets, profile, nil
+}
+
+func accountSecretKey(profile, controllerName string) string {
+	return "profiles/" + profile + "/accounts/" + controllerName + "/password"
+}
+
+func credentialSecretKey(profile, cloudName, credentialName string) string {
+	return "profiles/" + profile + "/credentials/" + cloudName + "/" + credentialName
+}
+
+// storeAccountSecret moves the password in the account details into
+// the secret store, returning the details to be written to the file.
+func storeAccountSecret(secrets SecretStore, profile, controllerName string, details AccountDetails) (AccountDetails, error) {
+	key := accountSecretKey(profile, controllerName)
+	if details.Password == "" {
+		return details, errors.Trace(secrets.RemoveSecret(key))
+	}
+	if err := secrets.SetSecret(key, details.Password); err != nil {
+		return details, errors.Annotatef(err, "cannot store password for controller %s", controllerName)
+	}
+	details.Password = ""
+	return details, nil
+}
+
+// fillAccountSecret fills in the password for the account details from
+// the secret store. Passwords already held in the file are left alone so
+// that files written before the secret store was configured still work.
+func fillAccountSecret(secrets SecretStore, profile, controllerName string, details *AccountDetails) error {
+	if details.Password != "" {
+		return nil
+	}
+	password, err := secrets.Secret(accountSecretKey(profile, controllerName))
+	if errors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return errors.Annotatef(err, "cannot get password for controller %s", controllerName)
+	}
+	details.Password = password
+	return nil
+}
+
+// storeCredentialSecrets moves the attributes of the cloud's credentials
+// into the secret store, returning the credentials to be written to the
+// file. Secrets for credentials which have been removed are deleted.
+func storeCredentialSecrets(
+	secrets SecretStore, profile, cloudName string, details, existing cloud.CloudCredential,
+) (cloud.CloudCredential, error) {
+	for name := range existing.AuthCredentials {
+		if _, ok := details.AuthCredentials[name]; ok {
+			continue
+		}
+		if err := secrets.RemoveSecret(credentialSecretKey(profile, cloudName, name)); err != nil {
+			return details, errors.Trace(err)
+		}
+	}
+	if len(details.AuthCredentials) == 0 {
+		return details, nil
+	}
+	result := details
+	result.AuthCredentials = make(map[string]cloud.Credential)
+	for name, cred := range details.AuthCredentials {
+		attrs := cred.Attributes()
+		if len(attrs) == 0 {
+			result.AuthCredentials[name] = cred
+			continue
+		}
+		data, err := json.Marshal(attrs)
+		if err != nil {
+			return details, errors.Trace(err)
+		}
+		if err := secrets.SetSecret(credentialSecretKey(profile, cloudName, name), string(data)); err != nil {
+			return details, errors.Annotatef(err, "cannot store credential %q for cloud %s", name, cloudName)
+		}
+		result.AuthCredentials[name] = withAttributes(cred, nil)
+	}
+	return result, nil
+}
+
+// fillCredentialSecrets fills in the attributes of any credentials
+// without them from the secret store.
+func fillCredentialSecrets(secrets SecretStore, profile string, credentials map[string]cloud.CloudCredential) error {
+	for cloudName, details := range credentials {
+		for name, cred := range details.AuthCredentials {
+			if len(cred.Attributes()) > 0 {
+				continue
+			}
+			data, err := secrets.Secret(credentialSecretKey(profile, cloudName, name))
+			if errors.IsNotFound(err) {
+				continue
+			}
+			if err != nil {
+				return errors.Annotatef(err, "cannot get credential %q for cloud %s", name, cloudName)
+			}
+			var attrs map[string]string
+			if err := json.Unmarshal([]byte(data), &attrs); err != nil {
+				return errors.Annotatef(err, "cannot unmarshal credential %q for cloud %s", name, cloudName)
+			}
+			details.AuthCredentials[name] = withAttributes(cred, attrs)
+		}
+	}
+	return nil
+}
+
+// withAttributes returns a copy of the credential with the given attributes.
+func withAttributes(cred cloud.Credential, attrs map[string]string) cloud.Credential {
+	result := cloud.NewNamedCredential(cred.Label, cred.AuthType(), attrs, cred.Revoked)
+	result.Invalid = cred.Invalid
+	result.InvalidReason = cred.InvalidReason
+	return result
+}
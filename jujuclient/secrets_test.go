@@ -0,0 +1,269 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package jujuclient_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+
+	"github.com/juju/errors"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/cloud"
+	"github.com/juju/juju/juju/osenv"
+	"github.com/juju/juju/jujuclient"
+	"github.com/juju/juju/testing"
+)
+
+type SecretStoreSuite struct {
+	testing.FakeJujuXDGDataHomeSuite
+}
+
+var _ = gc.Suite(&SecretStoreSuite{})
+
+func (s *SecretStoreSuite) TestNoSecretStore(c *gc.C) {
+	secrets, err := jujuclient.NewSecretStoreFromEnvironment()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(secrets, gc.IsNil)
+}
+
+func (s *SecretStoreSuite) TestSecretStoreFileWithoutPassphrase(c *gc.C) {
+	s.PatchEnvironment(osenv.JujuSecretStoreEnvKey, "file")
+	_, err := jujuclient.NewSecretStoreFromEnvironment()
+	c.Assert(err, gc.ErrorMatches, "JUJU_SECRET_STORE=file requires a passphrase in JUJU_SECRET_STORE_PASSPHRASE")
+}
+
+func (s *SecretStoreSuite) TestSecretStoreInvalid(c *gc.C) {
+	s.PatchEnvironment(osenv.JujuSecretStoreEnvKey, "vault")
+	_, err := jujuclient.NewSecretStoreFromEnvironment()
+	c.Assert(err, gc.ErrorMatches, `JUJU_SECRET_STORE "vault" not valid`)
+
+	s.PatchEnvironment(osenv.JujuSecretStoreEnvKey, "helper:")
+	_, err = jujuclient.NewSecretStoreFromEnvironment()
+	c.Assert(err, gc.ErrorMatches, `JUJU_SECRET_STORE "helper:" without a command not valid`)
+}
+
+func (s *SecretStoreSuite) TestEncryptedFile(c *gc.C) {
+	path := filepath.Join(c.MkDir(), "secrets.enc")
+	secrets := jujuclient.NewEncryptedFileSecretStore(path, "sekrit")
+	_, err := secrets.Secret("foo")
+	c.Assert(err, jc.Satisfies, errors.IsNotFound)
+
+	err = secrets.SetSecret("foo", "bar")
+	c.Assert(err, jc.ErrorIsNil)
+	value, err := secrets.Secret("foo")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(value, gc.Equals, "bar")
+
+	data, err := ioutil.ReadFile(path)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(string(data), gc.Not(jc.Contains), "bar")
+	info, err := os.Stat(path)
+	c.Assert(err, jc.ErrorIsNil)
+	if runtime.GOOS != "windows" {
+		c.Assert(info.Mode().Perm(), gc.Equals, os.FileMode(0600))
+	}
+
+	err = secrets.RemoveSecret("foo")
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = secrets.Secret("foo")
+	c.Assert(err, jc.Satisfies, errors.IsNotFound)
+	err = secrets.RemoveSecret("foo")
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *SecretStoreSuite) TestEncryptedFileWrongPassphrase(c *gc.C) {
+	path := filepath.Join(c.MkDir(), "secrets.enc")
+	err := jujuclient.NewEncryptedFileSecretStore(path, "sekrit").SetSecret("foo", "bar")
+	c.Assert(err, jc.ErrorIsNil)
+
+	_, err = jujuclient.NewEncryptedFileSecretStore(path, "guess").Secret("foo")
+	c.Assert(err, gc.ErrorMatches, `cannot decrypt secrets file \(wrong passphrase\?\)`)
+}
+
+func (s *SecretStoreSuite) TestEncryptedFileKeyDerivedOnce(c *gc.C) {
+	var derivations int
+	scryptKey := *jujuclient.ScryptKey
+	s.PatchValue(jujuclient.ScryptKey, func(password, salt []byte, N, r, p, keyLen int) ([]byte, error) {
+		derivations++
+		return scryptKey(password, salt, N, r, p, keyLen)
+	})
+
+	path := filepath.Join(c.MkDir(), "secrets.enc")
+	err := jujuclient.NewEncryptedFileSecretStore(path, "once").SetSecret("foo", "bar")
+	c.Assert(err, jc.ErrorIsNil)
+	err = jujuclient.NewEncryptedFileSecretStore(path, "once").SetSecret("baz", "qux")
+	c.Assert(err, jc.ErrorIsNil)
+	value, err := jujuclient.NewEncryptedFileSecretStore(path, "once").Secret("foo")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(value, gc.Equals, "bar")
+	c.Assert(derivations, gc.Equals, 1)
+}
+
+// helperScript is a secret store helper keeping each secret
+// in a file named after its key, within the helper's directory.
+const helperScript = `#!/bin/sh
+dir=$(dirname "$0")/store
+mkdir -p "$dir"
+while read -r line; do
+	[ -z "$line" ] && break
+	case "$line" in
+	key=*) key=$(echo "${line#key=}" | tr / _) ;;
+	value=*) value="${line#value=}" ;;
+	esac
+done
+case "$1" in
+get) [ -f "$dir/$key" ] && echo "value=$(cat "$dir/$key")" ;;
+store) echo "$value" > "$dir/$key" ;;
+erase) rm -f "$dir/$key" ;;
+*) echo "unknown operation $1" >&2; exit 1 ;;
+esac
+exit 0
+`
+
+func (s *SecretStoreSuite) writeHelper(c *gc.C) string {
+	if runtime.GOOS == "windows" {
+		c.Skip("helper script requires a POSIX shell")
+	}
+	path := filepath.Join(c.MkDir(), "juju-secrets")
+	err := ioutil.WriteFile(path, []byte(helperScript), 0755)
+	c.Assert(err, jc.ErrorIsNil)
+	return path
+}
+
+func (s *SecretStoreSuite) TestHelper(c *gc.C) {
+	secrets := jujuclient.NewHelperSecretStore(s.writeHelper(c))
+	_, err := secrets.Secret("accounts/ctrl/password")
+	c.Assert(err, jc.Satisfies, errors.IsNotFound)
+
+	err = secrets.SetSecret("accounts/ctrl/password", "hunter2")
+	c.Assert(err, jc.ErrorIsNil)
+	value, err := secrets.Secret("accounts/ctrl/password")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(value, gc.Equals, "hunter2")
+
+	err = secrets.RemoveSecret("accounts/ctrl/password")
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = secrets.Secret("accounts/ctrl/password")
+	c.Assert(err, jc.Satisfies, errors.IsNotFound)
+}
+
+func (s *SecretStoreSuite) TestHelperFailure(c *gc.C) {
+	helper := s.writeHelper(c)
+	secrets := jujuclient.NewHelperSecretStore(helper)
+	err := secrets.SetSecret("foo", "bar\nbaz")
+	c.Assert(err, gc.ErrorMatches, `secret "foo" containing newlines not valid`)
+
+	// The helper's stderr is included in the error.
+	secrets = jujuclient.NewHelperSecretStore(helper + " extra")
+	_, err = secrets.Secret("foo")
+	c.Assert(err, gc.ErrorMatches, `secret store helper ".*juju-secrets" get: exit status 1: unknown operation extra`)
+}
+
+func (s *SecretStoreSuite) TestAccountPasswordInSecretStore(c *gc.C) {
+	s.PatchEnvironment(osenv.JujuSecretStoreEnvKey, "file")
+	s.PatchEnvironment(osenv.JujuSecretStorePassphraseEnvKey, "sekrit")
+	store := jujuclient.NewFileClientStore()
+
+	details := jujuclient.AccountDetails{User: "admin", Password: "hunter2"}
+	err := store.UpdateAccount("ctrl", details)
+	c.Assert(err, jc.ErrorIsNil)
+
+	// The password is not written to the accounts file.
+	accounts, err := jujuclient.ReadAccountsFile(dataPath(c, jujuclient.JujuAccountsPath))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(accounts["ctrl"], jc.DeepEquals, jujuclient.AccountDetails{User: "admin"})
+
+	result, err := store.AccountDetails("ctrl")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(*result, jc.DeepEquals, details)
+
+	err = store.RemoveAccount("ctrl")
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = jujuclient.NewEncryptedFileSecretStore(dataPath(c, jujuclient.JujuSecretsPath), "sekrit").Secret("profiles/default/accounts/ctrl/password")
+	c.Assert(err, jc.Satisfies, errors.IsNotFound)
+}
+
+func (s *SecretStoreSuite) TestAccountPasswordInFileStillRead(c *gc.C) {
+	err := jujuclient.WriteAccountsFile(map[string]jujuclient.AccountDetails{
+		"ctrl": {User: "admin", Password: "hunter2"},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	s.PatchEnvironment(osenv.JujuSecretStoreEnvKey, "file")
+	s.PatchEnvironment(osenv.JujuSecretStorePassphraseEnvKey, "sekrit")
+	result, err := jujuclient.NewFileClientStore().AccountDetails("ctrl")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.Password, gc.Equals, "hunter2")
+}
+
+func (s *SecretStoreSuite) TestCredentialsInSecretStore(c *gc.C) {
+	s.PatchEnvironment(osenv.JujuSecretStoreEnvKey, "helper:"+s.writeHelper(c))
+	store := jujuclient.NewFileCredentialStore()
+
+	attrs := map[string]string{"access-key": "key", "secret-key": "secret"}
+	err := store.UpdateCredential("aws", cloud.CloudCredential{
+		DefaultCredential: "bob",
+		AuthCredentials: map[string]cloud.Credential{
+			"bob": cloud.NewCredential(cloud.AccessKeyAuthType, attrs),
+		},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	// The attributes are not written to the credentials file.
+	data, err := ioutil.ReadFile(dataPath(c, jujuclient.JujuCredentialsPath))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(string(data), gc.Not(jc.Contains), "secret")
+
+	found, err := store.CredentialForCloud("aws")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(found.DefaultCredential, gc.Equals, "bob")
+	c.Assert(found.AuthCredentials["bob"].AuthType(), gc.Equals, cloud.AccessKeyAuthType)
+	c.Assert(found.AuthCredentials["bob"].Attributes(), jc.DeepEquals, attrs)
+
+	// Removing the credential removes its secret.
+	err = store.UpdateCredential("aws", cloud.CloudCredential{})
+	c.Assert(err, jc.ErrorIsNil)
+	secrets, err := jujuclient.NewSecretStoreFromEnvironment()
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = secrets.Secret("profiles/default/credentials/aws/bob")
+	c.Assert(err, jc.Satisfies, errors.IsNotFound)
+}
+
+func (s *SecretStoreSuite) TestSecretsPerProfile(c *gc.C) {
+	// The helper's secrets are shared by all profiles.
+	s.PatchEnvironment(osenv.JujuSecretStoreEnvKey, "helper:"+s.writeHelper(c))
+	err := jujuclient.CreateProfile("work", false)
+	c.Assert(err, jc.ErrorIsNil)
+
+	updateAccount := func(profile, password string) {
+		s.PatchEnvironment(osenv.JujuProfileEnvKey, profile)
+		err := jujuclient.NewFileClientStore().UpdateAccount("ctrl", jujuclient.AccountDetails{
+			User:     "admin",
+			Password: password,
+		})
+		c.Assert(err, jc.ErrorIsNil)
+	}
+	accountPassword := func(profile string) string {
+		s.PatchEnvironment(osenv.JujuProfileEnvKey, profile)
+		details, err := jujuclient.NewFileClientStore().AccountDetails("ctrl")
+		c.Assert(err, jc.ErrorIsNil)
+		return details.Password
+	}
+	updateAccount("default", "hunter2")
+	updateAccount("work", "swordfish")
+
+	// Each profile's account for the controller of the same
+	// name keeps its own password.
+	c.Assert(accountPassword("default"), gc.Equals, "hunter2")
+	c.Assert(accountPassword("work"), gc.Equals, "swordfish")
+
+	s.PatchEnvironment(osenv.JujuProfileEnvKey, "work")
+	err = jujuclient.NewFileClientStore().RemoveAccount("ctrl")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(accountPassword("default"), gc.Equals, "hunter2")
+}
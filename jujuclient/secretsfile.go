@@ -0,0 +1,196 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package jujuclient
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"sync"
+
+	"github.com/juju/errors"
+	"github.com/juju/utils"
+	"golang.org/x/crypto/nacl/secretbox"
+	"golang.org/x/crypto/scrypt"
+	"gopkg.in/yaml.v2"
+)
+
+// The scrypt parameters used to derive the secrets file key
+// from the passphrase.
+const (
+	scryptN      = 1 << 15
+	scryptR      = 8
+	scryptP      = 1
+	secretKeyLen = 32
+	saltLen      = 32
+	nonceLen     = 24
+)
+
+// NewEncryptedFileSecretStore returns a SecretStore which keeps secrets
+// in the file at the given path, encrypted with a key derived from the
+// passphrase.
+func NewEncryptedFileSecretStore(path, passphrase string) SecretStore {
+	return &encryptedFileSecretStore{
+		path:       path,
+		passphrase: passphrase,
+	}
+}
+
+type encryptedFileSecretStore struct {
+	path       string
+	passphrase string
+}
+
+// secretsFile is the on-disk format of the encrypted secrets file.
+type secretsFile struct {
+	Salt  []byte `yaml:"salt"`
+	Nonce []byte `yaml:"nonce"`
+	Data  []byte `yaml:"data"`
+}
+
+// Secret implements SecretStore.
+func (s *encryptedFileSecretStore) Secret(key string) (string, error) {
+	secrets, _, err := s.read()
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	value, ok := secrets[key]
+	if !ok {
+		return "", errors.NotFoundf("secret %q", key)
+	}
+	return value, nil
+}
+
+// SetSecret implements SecretStore.
+func (s *encryptedFileSecretStore) SetSecret(key, value string) error {
+	secrets, salt, err := s.read()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if current, ok := secrets[key]; ok && current == value {
+		return nil
+	}
+	secrets[key] = value
+	return errors.Trace(s.write(secrets, salt))
+}
+
+// RemoveSecret implements SecretStore.
+func (s *encryptedFileSecretStore) RemoveSecret(key string) error {
+	secrets, salt, err := s.read()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if _, ok := secrets[key]; !ok {
+		return nil
+	}
+	delete(secrets, key)
+	return errors.Trace(s.write(secrets, salt))
+}
+
+// read returns the secrets in the file, along with the salt from
+// which the key encrypting them was derived. The salt is nil if
+// the file does not exist.
+func (s *encryptedFileSecretStore) read() (map[string]string, []byte, error) {
+	secrets := make(map[string]string)
+	data, err := ioutil.ReadFile(s.path)
+	if os.IsNotExist(err) {
+		return secrets, nil, nil
+	}
+	if err != nil {
+		return nil, nil, errors.Trace(err)
+	}
+	var file secretsFile
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return nil, nil, errors.Annotate(err, "cannot unmarshal secrets file")
+	}
+	if len(file.Nonce) != nonceLen {
+		return nil, nil, errors.NotValidf("secrets file nonce")
+	}
+	key, err := s.key(file.Salt)
+	if err != nil {
+		return nil, nil, errors.Trace(err)
+	}
+	var nonce [nonceLen]byte
+	copy(nonce[:], file.Nonce)
+	plain, ok := secretbox.Open(nil, file.Data, &nonce, key)
+	if !ok {
+		return nil, nil, errors.New("cannot decrypt secrets file (wrong passphrase?)")
+	}
+	if err := json.Unmarshal(plain, &secrets); err != nil {
+		return nil, nil, errors.Annotate(err, "cannot unmarshal secrets")
+	}
+	return secrets, file.Salt, nil
+}
+
+// write encrypts the secrets into the file, with a key derived from
+// the given salt, or from a new one if the salt is nil.
+func (s *encryptedFileSecretStore) write(secrets map[string]string, salt []byte) error {
+	plain, err := json.Marshal(secrets)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	// The salt is kept so that the derived key can be reused, but
+	// a new nonce must be used each time the file is written.
+	file := secretsFile{
+		Salt:  salt,
+		Nonce: make([]byte, nonceLen),
+	}
+	if file.Salt == nil {
+		file.Salt = make([]byte, saltLen)
+		if _, err := io.ReadFull(rand.Reader, file.Salt); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	if _, err := io.ReadFull(rand.Reader, file.Nonce); err != nil {
+		return errors.Trace(err)
+	}
+	key, err := s.key(file.Salt)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	var nonce [nonceLen]byte
+	copy(nonce[:], file.Nonce)
+	file.Data = secretbox.Seal(nil, plain, &nonce, key)
+
+	data, err := yaml.Marshal(file)
+	if err != nil {
+		return errors.Annotate(err, "cannot marshal secrets file")
+	}
+	return utils.AtomicWriteFile(s.path, data, os.FileMode(0600))
+}
+
+// scryptKey is patched by tests.
+var scryptKey = scrypt.Key
+
+// secretKeys caches the keys derived from each passphrase and salt
+// for the life of the process, as deriving them is deliberately slow.
+var secretKeys = struct {
+	mu   sync.Mutex
+	keys map[secretKeyId]*[secretKeyLen]byte
+}{keys: make(map[secretKeyId]*[secretKeyLen]byte)}
+
+type secretKeyId struct {
+	passphrase string
+	salt       string
+}
+
+// key derives the encryption key from the passphrase and salt.
+func (s *encryptedFileSecretStore) key(salt []byte) (*[secretKeyLen]byte, error) {
+	id := secretKeyId{passphrase: s.passphrase, salt: string(salt)}
+	secretKeys.mu.Lock()
+	defer secretKeys.mu.Unlock()
+	if key, ok := secretKeys.keys[id]; ok {
+		return key, nil
+	}
+	derived, err := scryptKey([]byte(s.passphrase), salt, scryptN, scryptR, scryptP, secretKeyLen)
+	if err != nil {
+		return nil, errors.Annotate(err, "cannot derive secrets file key")
+	}
+	var key [secretKeyLen]byte
+	copy(key[:], derived)
+	secretKeys.keys[id] = &key
+	return &key, nil
+}
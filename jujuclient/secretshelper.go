@@ -0,0 +1,106 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package jujuclient
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os/exec"
+	"strings"
+
+	"github.com/juju/errors"
+)
+
+// NewHelperSecretStore returns a SecretStore which delegates to an external
+// helper process, in the manner of git credential helpers. The command is
+// run with one of "get", "store" or "erase" appended to its arguments, and
+// is passed lines of the form "<name>=<value>" on its standard input,
+// terminated by a blank line:
+//
+//	key=<secret key>
+//	value=<secret value>   (store only)
+//
+// For "get", the helper writes "value=<secret value>" to its standard
+// output, or nothing if there is no such secret. A helper exiting with a
+// non-zero status indicates failure.
+func NewHelperSecretStore(command string) SecretStore {
+	return &helperSecretStore{
+		args: strings.Fields(command),
+		run:  runHelper,
+	}
+}
+
+type helperSecretStore struct {
+	args []string
+	run  func(args []string, stdin []byte) ([]byte, error)
+}
+
+// Secret implements SecretStore.
+func (s *helperSecretStore) Secret(key string) (string, error) {
+	out, err := s.call("get", key, "")
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	scanner := bufio.NewScanner(bytes.NewReader(out))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.HasPrefix(line, "value=") {
+			return strings.TrimPrefix(line, "value="), nil
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return "", errors.Trace(err)
+	}
+	return "", errors.NotFoundf("secret %q", key)
+}
+
+// SetSecret implements SecretStore.
+func (s *helperSecretStore) SetSecret(key, value string) error {
+	if strings.ContainsAny(value, "\n\r") {
+		return errors.NotValidf("secret %q containing newlines", key)
+	}
+	_, err := s.call("store", key, value)
+	return errors.Trace(err)
+}
+
+// RemoveSecret implements SecretStore.
+func (s *helperSecretStore) RemoveSecret(key string) error {
+	_, err := s.call("erase", key, "")
+	return errors.Trace(err)
+}
+
+func (s *helperSecretStore) call(op, key, value string) ([]byte, error) {
+	if strings.ContainsAny(key, "\n\r") {
+		return nil, errors.NotValidf("secret key %q", key)
+	}
+	var stdin bytes.Buffer
+	fmt.Fprintf(&stdin, "key=%s\n", key)
+	if op == "store" {
+		fmt.Fprintf(&stdin, "value=%s\n", value)
+	}
+	stdin.WriteString("\n")
+
+	args := append(append([]string{}, s.args...), op)
+	out, err := s.run(args, stdin.Bytes())
+	if err != nil {
+		return nil, errors.Annotatef(err, "secret store helper %q %s", s.args[0], op)
+	}
+	return out, nil
+}
+
+func runHelper(args []string, stdin []byte) ([]byte, error) {
+	cmd := exec.Command(args[0], args[1:]...)
+	cmd.Stdin = bytes.NewReader(stdin)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return nil, errors.Errorf("%v: %s", err, msg)
+		}
+		return nil, errors.Trace(err)
+	}
+	return out, nil
+}
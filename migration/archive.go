@@ -0,0 +1,410 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package migration
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/url"
+	"path"
+	"sort"
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/version"
+	"gopkg.in/juju/charm.v6"
+	charmresource "gopkg.in/juju/charm.v6/resource"
+
+	"github.com/juju/juju/core/migration"
+	"github.com/juju/juju/resource"
+	"github.com/juju/juju/tools"
+)
+
+// The model archive is a zip file holding the serialized model
+// description, a manifest describing the binaries used by the model,
+// and the binaries themselves.
+const (
+	archiveModelFile    = "model.yaml"
+	archiveManifestFile = "manifest.json"
+	archiveVersion      = 1
+)
+
+// archiveManifest describes the binaries held in a model archive.
+type archiveManifest struct {
+	Version int `json:"version"`
+
+	// Charms maps charm URLs to the archive files holding them.
+	Charms map[string]string `json:"charms"`
+
+	// Tools maps agent binary versions to the archive files
+	// holding them.
+	Tools map[string]string `json:"tools"`
+
+	// Resources describes the resources used by the model. The
+	// content of each application resource is held in the archive
+	// file named by resourceFile.
+	Resources []archiveResource `json:"resources,omitempty"`
+}
+
+type archiveResource struct {
+	Application         string                     `json:"application"`
+	Name                string                     `json:"name"`
+	ApplicationRevision archiveRevision            `json:"application-revision"`
+	CharmStoreRevision  archiveRevision            `json:"charmstore-revision"`
+	UnitRevisions       map[string]archiveRevision `json:"unit-revisions,omitempty"`
+}
+
+type archiveRevision struct {
+	Revision    int       `json:"revision"`
+	Type        string    `json:"type"`
+	Path        string    `json:"path"`
+	Description string    `json:"description"`
+	Origin      string    `json:"origin"`
+	Fingerprint string    `json:"fingerprint"`
+	Size        int64     `json:"size"`
+	Timestamp   time.Time `json:"timestamp"`
+	Username    string    `json:"username,omitempty"`
+}
+
+// WriteArchiveConfig holds the model and the means of downloading its
+// binaries needed by WriteArchive.
+type WriteArchiveConfig struct {
+	Model migration.SerializedModel
+
+	CharmDownloader    CharmDownloader
+	ToolsDownloader    ToolsDownloader
+	ResourceDownloader ResourceDownloader
+}
+
+// Validate makes sure that all the config values are non-nil.
+func (c WriteArchiveConfig) Validate() error {
+	if len(c.Model.Bytes) == 0 {
+		return errors.NotValidf("empty Model")
+	}
+	if c.CharmDownloader == nil {
+		return errors.NotValidf("missing CharmDownloader")
+	}
+	if c.ToolsDownloader == nil {
+		return errors.NotValidf("missing ToolsDownloader")
+	}
+	if c.ResourceDownloader == nil {
+		return errors.NotValidf("missing ResourceDownloader")
+	}
+	return nil
+}
+
+// WriteArchive writes a self-contained archive of the model to w,
+// holding the model description along with the charms, agent binaries
+// and resources it uses. The archive may be read with OpenArchive, and
+// its binaries uploaded to another controller with UploadBinaries, so
+// that the model may be imported without the source and target
+// controllers communicating.
+func WriteArchive(w io.Writer, config WriteArchiveConfig) error {
+	if err := config.Validate(); err != nil {
+		return errors.Trace(err)
+	}
+	aw := &archiveWriter{
+		zip: zip.NewWriter(w),
+		manifest: archiveManifest{
+			Version: archiveVersion,
+			Charms:  make(map[string]string),
+			Tools:   make(map[string]string),
+		},
+	}
+	if err := aw.writeFile(archiveModelFile, config.Model.Bytes); err != nil {
+		return errors.Trace(err)
+	}
+	err := UploadBinaries(UploadBinariesConfig{
+		Charms:          append([]string(nil), config.Model.Charms...),
+		CharmDownloader: config.CharmDownloader,
+		CharmUploader:   aw,
+
+		Tools:           config.Model.Tools,
+		ToolsDownloader: config.ToolsDownloader,
+		ToolsUploader:   aw,
+
+		Resources:          config.Model.Resources,
+		ResourceDownloader: config.ResourceDownloader,
+		ResourceUploader:   aw,
+	})
+	if err != nil {
+		return errors.Annotate(err, "cannot archive binaries")
+	}
+	for _, res := range config.Model.Resources {
+		aw.manifest.Resources = append(aw.manifest.Resources, resourceToArchive(res))
+	}
+	manifest, err := json.MarshalIndent(aw.manifest, "", "  ")
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if err := aw.writeFile(archiveManifestFile, manifest); err != nil {
+		return errors.Trace(err)
+	}
+	return errors.Trace(aw.zip.Close())
+}
+
+// archiveWriter implements the CharmUploader, ToolsUploader and
+// ResourceUploader interfaces, writing the binaries to a model archive.
+type archiveWriter struct {
+	zip      *zip.Writer
+	manifest archiveManifest
+}
+
+func (w *archiveWriter) writeFile(name string, data []byte) error {
+	f, err := w.zip.Create(name)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	_, err = f.Write(data)
+	return errors.Trace(err)
+}
+
+func (w *archiveWriter) copyFile(name string, r io.Reader) error {
+	f, err := w.zip.Create(name)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	_, err = io.Copy(f, r)
+	return errors.Annotatef(err, "cannot write %s", name)
+}
+
+// UploadCharm is part of the CharmUploader interface.
+func (w *archiveWriter) UploadCharm(curl *charm.URL, content io.ReadSeeker) (*charm.URL, error) {
+	name := fmt.Sprintf("charms/%d.zip", len(w.manifest.Charms))
+	if err := w.copyFile(name, content); err != nil {
+		return nil, errors.Trace(err)
+	}
+	w.manifest.Charms[curl.String()] = name
+	return curl, nil
+}
+
+// UploadTools is part of the ToolsUploader interface.
+func (w *archiveWriter) UploadTools(content io.ReadSeeker, vers version.Binary, _ ...string) (tools.List, error) {
+	name := "tools/" + vers.String() + ".tar.gz"
+	if err := w.copyFile(name, content); err != nil {
+		return nil, errors.Trace(err)
+	}
+	w.manifest.Tools[vers.String()] = name
+	return nil, nil
+}
+
+// UploadResource is part of the ResourceUploader interface.
+func (w *archiveWriter) UploadResource(res resource.Resource, content io.ReadSeeker) error {
+	return errors.Trace(w.copyFile(resourceFile(res.ApplicationID, res.Name), content))
+}
+
+// SetPlaceholderResource is part of the ResourceUploader interface.
+// Placeholders have no content, and are recorded in the manifest.
+func (w *archiveWriter) SetPlaceholderResource(resource.Resource) error {
+	return nil
+}
+
+// SetUnitResource is part of the ResourceUploader interface. Unit
+// revisions have no content, and are recorded in the manifest.
+func (w *archiveWriter) SetUnitResource(string, resource.Resource) error {
+	return nil
+}
+
+func resourceFile(application, name string) string {
+	return path.Join("resources", application, name)
+}
+
+// Archive is a model archive written by WriteArchive. It implements
+// the CharmDownloader, ToolsDownloader and ResourceDownloader
+// interfaces, so that it may be used as the source of binaries in
+// UploadBinaries.
+type Archive struct {
+	// Model holds the serialized model and the details of its
+	// binaries. The tools URIs name files in the archive.
+	Model migration.SerializedModel
+
+	zip    *zip.ReadCloser
+	files  map[string]*zip.File
+	charms map[string]string
+}
+
+// OpenArchive opens the model archive at the given path. The archive
+// must be closed after use.
+func OpenArchive(filename string) (_ *Archive, err error) {
+	zr, err := zip.OpenReader(filename)
+	if err != nil {
+		return nil, errors.Annotate(err, "cannot open model archive")
+	}
+	defer func() {
+		if err != nil {
+			zr.Close()
+		}
+	}()
+	a := &Archive{
+		zip:   zr,
+		files: make(map[string]*zip.File),
+	}
+	for _, f := range zr.File {
+		a.files[f.Name] = f
+	}
+
+	data, err := a.readFile(archiveManifestFile)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	var manifest archiveManifest
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return nil, errors.Annotate(err, "cannot unmarshal model archive manifest")
+	}
+	if manifest.Version != archiveVersion {
+		return nil, errors.NotSupportedf("model archive version %d", manifest.Version)
+	}
+	if a.Model.Bytes, err = a.readFile(archiveModelFile); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	a.charms = manifest.Charms
+	for curl := range manifest.Charms {
+		a.Model.Charms = append(a.Model.Charms, curl)
+	}
+	sort.Strings(a.Model.Charms)
+	a.Model.Tools = make(map[version.Binary]string)
+	for v, name := range manifest.Tools {
+		vers, err := version.ParseBinary(v)
+		if err != nil {
+			return nil, errors.Annotate(err, "cannot parse agent binary version")
+		}
+		a.Model.Tools[vers] = name
+	}
+	for _, res := range manifest.Resources {
+		converted, err := resourceFromArchive(res)
+		if err != nil {
+			return nil, errors.Annotatef(err, "resource %s/%s", res.Application, res.Name)
+		}
+		a.Model.Resources = append(a.Model.Resources, converted)
+	}
+	return a, nil
+}
+
+// Close closes the archive.
+func (a *Archive) Close() error {
+	return a.zip.Close()
+}
+
+func (a *Archive) open(name string) (io.ReadCloser, error) {
+	f, ok := a.files[name]
+	if !ok {
+		return nil, errors.NotFoundf("%s in model archive", name)
+	}
+	return f.Open()
+}
+
+func (a *Archive) readFile(name string) ([]byte, error) {
+	r, err := a.open(name)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	defer r.Close()
+	return ioutil.ReadAll(r)
+}
+
+// OpenCharm is part of the CharmDownloader interface.
+func (a *Archive) OpenCharm(curl *charm.URL) (io.ReadCloser, error) {
+	name, ok := a.charms[curl.String()]
+	if !ok {
+		return nil, errors.NotFoundf("charm %s in model archive", curl)
+	}
+	return a.open(name)
+}
+
+// OpenURI is part of the ToolsDownloader interface. The URI is the
+// name of a file in the archive, as given in Model.Tools.
+func (a *Archive) OpenURI(uri string, _ url.Values) (io.ReadCloser, error) {
+	return a.open(uri)
+}
+
+// OpenResource is part of the ResourceDownloader interface.
+func (a *Archive) OpenResource(application, name string) (io.ReadCloser, error) {
+	return a.open(resourceFile(application, name))
+}
+
+func resourceToArchive(res migration.SerializedModelResource) archiveResource {
+	out := archiveResource{
+		Application:         res.ApplicationRevision.ApplicationID,
+		Name:                res.ApplicationRevision.Name,
+		ApplicationRevision: revisionToArchive(res.ApplicationRevision),
+		CharmStoreRevision:  revisionToArchive(res.CharmStoreRevision),
+	}
+	if len(res.UnitRevisions) > 0 {
+		out.UnitRevisions = make(map[string]archiveRevision)
+		for unit, rev := range res.UnitRevisions {
+			out.UnitRevisions[unit] = revisionToArchive(rev)
+		}
+	}
+	return out
+}
+
+func revisionToArchive(res resource.Resource) archiveRevision {
+	return archiveRevision{
+		Revision:    res.Revision,
+		Type:        res.Type.String(),
+		Path:        res.Path,
+		Description: res.Description,
+		Origin:      res.Origin.String(),
+		Fingerprint: res.Fingerprint.Hex(),
+		Size:        res.Size,
+		Timestamp:   res.Timestamp,
+		Username:    res.Username,
+	}
+}
+
+func resourceFromArchive(in archiveResource) (migration.SerializedModelResource, error) {
+	var out migration.SerializedModelResource
+	var err error
+	if out.ApplicationRevision, err = revisionFromArchive(in.Application, in.Name, in.ApplicationRevision); err != nil {
+		return out, errors.Annotate(err, "application revision")
+	}
+	if out.CharmStoreRevision, err = revisionFromArchive(in.Application, in.Name, in.CharmStoreRevision); err != nil {
+		return out, errors.Annotate(err, "charmstore revision")
+	}
+	out.UnitRevisions = make(map[string]resource.Resource)
+	for unit, rev := range in.UnitRevisions {
+		if out.UnitRevisions[unit], err = revisionFromArchive(in.Application, in.Name, rev); err != nil {
+			return out, errors.Annotate(err, "unit revision")
+		}
+	}
+	return out, nil
+}
+
+func revisionFromArchive(application, name string, rev archiveRevision) (resource.Resource, error) {
+	resourceType, err := charmresource.ParseType(rev.Type)
+	if err != nil {
+		return resource.Resource{}, errors.Trace(err)
+	}
+	origin, err := charmresource.ParseOrigin(rev.Origin)
+	if err != nil {
+		return resource.Resource{}, errors.Trace(err)
+	}
+	var fp charmresource.Fingerprint
+	if rev.Fingerprint != "" {
+		if fp, err = charmresource.ParseFingerprint(rev.Fingerprint); err != nil {
+			return resource.Resource{}, errors.Annotate(err, "invalid fingerprint")
+		}
+	}
+	return resource.Resource{
+		Resource: charmresource.Resource{
+			Meta: charmresource.Meta{
+				Name:        name,
+				Type:        resourceType,
+				Path:        rev.Path,
+				Description: rev.Description,
+			},
+			Origin:      origin,
+			Revision:    rev.Revision,
+			Size:        rev.Size,
+			Fingerprint: fp,
+		},
+		ApplicationID: application,
+		Username:      rev.Username,
+		Timestamp:     rev.Timestamp,
+	}, nil
+}
@@ -0,0 +1,136 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package migration_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	jc "github.com/juju/testing/checkers"
+	"github.com/juju/version"
+	gc "gopkg.in/check.v1"
+
+	coremigration "github.com/juju/juju/core/migration"
+	"github.com/juju/juju/migration"
+	"github.com/juju/juju/resource"
+	"github.com/juju/juju/resource/resourcetesting"
+	"github.com/juju/juju/testing"
+)
+
+type ArchiveSuite struct {
+	testing.BaseSuite
+}
+
+var _ = gc.Suite(&ArchiveSuite{})
+
+func (s *ArchiveSuite) writeArchive(c *gc.C, model coremigration.SerializedModel) (string, *fakeDownloader) {
+	downloader := &fakeDownloader{}
+	path := filepath.Join(c.MkDir(), "model.zip")
+	f, err := os.Create(path)
+	c.Assert(err, jc.ErrorIsNil)
+	defer f.Close()
+	err = migration.WriteArchive(f, migration.WriteArchiveConfig{
+		Model:              model,
+		CharmDownloader:    downloader,
+		ToolsDownloader:    downloader,
+		ResourceDownloader: downloader,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	return path, downloader
+}
+
+func (s *ArchiveSuite) TestWriteArchiveConfigValidate(c *gc.C) {
+	downloader := &fakeDownloader{}
+	valid := migration.WriteArchiveConfig{
+		Model:              coremigration.SerializedModel{Bytes: []byte("model")},
+		CharmDownloader:    downloader,
+		ToolsDownloader:    downloader,
+		ResourceDownloader: downloader,
+	}
+	c.Assert(valid.Validate(), jc.ErrorIsNil)
+
+	check := func(modify func(*migration.WriteArchiveConfig), missing string) {
+		config := valid
+		modify(&config)
+		c.Check(config.Validate(), gc.ErrorMatches, missing+" not valid")
+	}
+	check(func(c *migration.WriteArchiveConfig) { c.Model.Bytes = nil }, "empty Model")
+	check(func(c *migration.WriteArchiveConfig) { c.CharmDownloader = nil }, "missing CharmDownloader")
+	check(func(c *migration.WriteArchiveConfig) { c.ToolsDownloader = nil }, "missing ToolsDownloader")
+	check(func(c *migration.WriteArchiveConfig) { c.ResourceDownloader = nil }, "missing ResourceDownloader")
+}
+
+func (s *ArchiveSuite) TestRoundTrip(c *gc.C) {
+	appRes := resourcetesting.NewResource(c, nil, "blob", "app", "blob").Resource
+	unitRes := appRes
+	unitRes.Revision = 1
+	placeholder := resourcetesting.NewPlaceholderResource(c, "other", "app")
+	model := coremigration.SerializedModel{
+		Bytes:  []byte("model-uuid: some-uuid\n"),
+		Charms: []string{"local:trusty/magic-10", "cs:trusty/postgresql-42"},
+		Tools: map[version.Binary]string{
+			version.MustParseBinary("2.4.0-xenial-amd64"): "/tools/0",
+		},
+		Resources: []coremigration.SerializedModelResource{{
+			ApplicationRevision: appRes,
+			CharmStoreRevision:  appRes,
+			UnitRevisions:       map[string]resource.Resource{"app/0": unitRes},
+		}, {
+			ApplicationRevision: placeholder,
+			CharmStoreRevision:  placeholder,
+		}},
+	}
+	path, downloader := s.writeArchive(c, model)
+	c.Assert(downloader.charms, jc.SameContents, model.Charms)
+	c.Assert(downloader.uris, jc.DeepEquals, []string{"/tools/0"})
+	c.Assert(downloader.resources, jc.DeepEquals, []string{"app/blob"})
+
+	archive, err := migration.OpenArchive(path)
+	c.Assert(err, jc.ErrorIsNil)
+	defer archive.Close()
+	c.Assert(string(archive.Model.Bytes), gc.Equals, "model-uuid: some-uuid\n")
+	c.Assert(archive.Model.Charms, jc.DeepEquals, []string{"cs:trusty/postgresql-42", "local:trusty/magic-10"})
+	c.Assert(archive.Model.Tools, gc.HasLen, 1)
+	c.Assert(archive.Model.Resources, gc.HasLen, 2)
+	res := archive.Model.Resources[0]
+	c.Check(res.ApplicationRevision.ApplicationID, gc.Equals, "app")
+	c.Check(res.ApplicationRevision.Name, gc.Equals, "blob")
+	c.Check(res.ApplicationRevision.Fingerprint, gc.Equals, appRes.Fingerprint)
+	c.Check(res.ApplicationRevision.Timestamp.Equal(appRes.Timestamp), jc.IsTrue)
+	c.Check(res.UnitRevisions["app/0"].Revision, gc.Equals, 1)
+	c.Check(archive.Model.Resources[1].ApplicationRevision.IsPlaceholder(), jc.IsTrue)
+
+	// The archive is a source of binaries for UploadBinaries.
+	uploader := &fakeUploader{
+		tools:     make(map[version.Binary]string),
+		resources: make(map[string]string),
+	}
+	err = migration.UploadBinaries(migration.UploadBinariesConfig{
+		Charms:             archive.Model.Charms,
+		CharmDownloader:    archive,
+		CharmUploader:      uploader,
+		Tools:              archive.Model.Tools,
+		ToolsDownloader:    archive,
+		ToolsUploader:      uploader,
+		Resources:          archive.Model.Resources,
+		ResourceDownloader: archive,
+		ResourceUploader:   uploader,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(uploader.charms, jc.DeepEquals, []string{"cs:trusty/postgresql-42", "local:trusty/magic-10"})
+	c.Assert(uploader.tools, jc.DeepEquals, map[version.Binary]string{
+		version.MustParseBinary("2.4.0-xenial-amd64"): "/tools/0",
+	})
+	c.Assert(uploader.resources, jc.DeepEquals, map[string]string{"app/blob": "blob"})
+	c.Assert(uploader.unitResources, jc.DeepEquals, []string{"app/0-blob"})
+}
+
+func (s *ArchiveSuite) TestOpenArchiveNotArchive(c *gc.C) {
+	path := filepath.Join(c.MkDir(), "model.zip")
+	err := ioutil.WriteFile(path, []byte("not a zip"), 0600)
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = migration.OpenArchive(path)
+	c.Assert(err, gc.ErrorMatches, "cannot open model archive: .*")
+}
@@ -4,6 +4,7 @@
 package migration
 
 import (
+	"fmt"
 	"io"
 	"io/ioutil"
 	"net/url"
@@ -15,8 +16,11 @@ import (
 	"github.com/juju/naturalsort"
 	"github.com/juju/version"
 	"gopkg.in/juju/charm.v6"
+	"gopkg.in/juju/names.v2"
 
+	"github.com/juju/juju/cloud"
 	"github.com/juju/juju/core/migration"
+	"github.com/juju/juju/environs/config"
 	"github.com/juju/juju/resource"
 	"github.com/juju/juju/state"
 	"github.com/juju/juju/tools"
@@ -63,6 +67,56 @@ func ImportModel(st *state.State, bytes []byte) (*state.Model, *state.State, err
 	return dbModel, dbState, nil
 }
 
+// ImportValidationBackend defines the state methods used by
+// ValidateImport.
+type ImportValidationBackend interface {
+	Cloud(name string) (cloud.Cloud, error)
+}
+
+// ValidateImport checks that the serialized model could be imported by
+// ImportModel, without importing it. All of the problems found are
+// returned; an error is returned only if the checks could not be run.
+func ValidateImport(backend ImportValidationBackend, bytes []byte) ([]error, error) {
+	model, err := description.Deserialize(bytes)
+	if err != nil {
+		return []error{errors.Annotate(err, "cannot read model")}, nil
+	}
+	var problems []error
+	if err := model.Validate(); err != nil {
+		problems = append(problems, errors.Annotate(err, "model not valid"))
+	}
+	if len(model.RemoteApplications()) != 0 {
+		problems = append(problems, errors.New("model has remote applications"))
+	}
+	if model.Type() != "" {
+		if _, err := state.ParseModelType(model.Type()); err != nil {
+			problems = append(problems, errors.Trace(err))
+		}
+	}
+	if _, err := config.New(config.NoDefaults, model.Config()); err != nil {
+		problems = append(problems, errors.Annotate(err, "model config not valid"))
+	}
+	if creds := model.CloudCredential(); creds != nil {
+		credID := fmt.Sprintf("%s/%s/%s", creds.Cloud(), creds.Owner(), creds.Name())
+		if !names.IsValidCloudCredential(credID) {
+			problems = append(problems, errors.Errorf("model credentials id not valid: %q", credID))
+		}
+	}
+
+	modelCloud, err := backend.Cloud(model.Cloud())
+	if errors.IsNotFound(err) {
+		return append(problems, errors.Errorf("cloud %q not found", model.Cloud())), nil
+	} else if err != nil {
+		return nil, errors.Annotate(err, "retrieving cloud")
+	}
+	if region := model.CloudRegion(); region != "" {
+		if _, err := cloud.RegionByName(modelCloud.Regions, region); err != nil {
+			problems = append(problems, errors.Errorf("cloud %q has no region %q", model.Cloud(), region))
+		}
+	}
+	return problems, nil
+}
+
 // CharmDownlaoder defines a single method that is used to download a
 // charm from the source controller in a migration.
 type CharmDownloader interface {
@@ -18,6 +18,7 @@ import (
 	gc "gopkg.in/check.v1"
 	"gopkg.in/juju/charm.v6"
 
+	"github.com/juju/juju/cloud"
 	"github.com/juju/juju/component/all"
 	coremigration "github.com/juju/juju/core/migration"
 	"github.com/juju/juju/migration"
@@ -88,6 +89,36 @@ func (s *ImportSuite) TestImportModel(c *gc.C) {
 	c.Assert(dbConfig.Name(), gc.Equals, "new-model")
 }
 
+func (s *ImportSuite) TestValidateImport(c *gc.C) {
+	bytes, err := migration.ExportModel(s.State)
+	c.Assert(err, jc.ErrorIsNil)
+	problems, err := migration.ValidateImport(s.State, bytes)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(problems, gc.HasLen, 0)
+}
+
+func (s *ImportSuite) TestValidateImportBadBytes(c *gc.C) {
+	problems, err := migration.ValidateImport(s.State, []byte("not a model"))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(problems, gc.HasLen, 1)
+	c.Assert(problems[0], gc.ErrorMatches, "cannot read model: yaml: unmarshal errors:\n.*")
+}
+
+func (s *ImportSuite) TestValidateImportUnknownCloud(c *gc.C) {
+	bytes, err := migration.ExportModel(s.State)
+	c.Assert(err, jc.ErrorIsNil)
+	problems, err := migration.ValidateImport(noClouds{}, bytes)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(problems, gc.HasLen, 1)
+	c.Assert(problems[0], gc.ErrorMatches, `cloud "dummy" not found`)
+}
+
+type noClouds struct{}
+
+func (noClouds) Cloud(name string) (cloud.Cloud, error) {
+	return cloud.Cloud{}, errors.NotFoundf("cloud %q", name)
+}
+
 func (s *ImportSuite) TestUploadBinariesConfigValidate(c *gc.C) {
 	type T migration.UploadBinariesConfig // alias for brevity
 
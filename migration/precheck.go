@@ -118,7 +118,26 @@ func SourcePrecheck(
 	modelPresence ModelPresence,
 	controllerPresence ModelPresence,
 ) error {
-	ctx := precheckContext{backend, modelPresence}
+	ctx := precheckContext{backend: backend, presence: modelPresence}
+	return errors.Trace(ctx.sourcePrecheck(controllerPresence))
+}
+
+// SourcePrecheckProblems runs the same checks as SourcePrecheck, but
+// rather than stopping at the first problem found it returns all of
+// them. An error is returned only if the checks could not be run.
+func SourcePrecheckProblems(
+	backend PrecheckBackend,
+	modelPresence ModelPresence,
+	controllerPresence ModelPresence,
+) ([]error, error) {
+	ctx := precheckContext{backend: backend, presence: modelPresence, collect: true}
+	if err := ctx.sourcePrecheck(controllerPresence); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return ctx.problems, nil
+}
+
+func (ctx *precheckContext) sourcePrecheck(controllerPresence ModelPresence) error {
 	if err := ctx.checkModel(); err != nil {
 		return errors.Trace(err)
 	}
@@ -136,27 +155,52 @@ func SourcePrecheck(
 		return errors.Trace(err)
 	}
 
-	if cleanupNeeded, err := backend.NeedsCleanup(); err != nil {
+	if cleanupNeeded, err := ctx.backend.NeedsCleanup(); err != nil {
 		return errors.Annotate(err, "checking cleanups")
 	} else if cleanupNeeded {
-		return errors.New("cleanup needed")
+		if err := ctx.problem(errors.New("cleanup needed")); err != nil {
+			return errors.Trace(err)
+		}
 	}
 
 	// Check the source controller.
-	controllerBackend, err := backend.ControllerBackend()
+	controllerBackend, err := ctx.backend.ControllerBackend()
 	if err != nil {
 		return errors.Trace(err)
 	}
-	controllerCtx := precheckContext{controllerBackend, controllerPresence}
+	controllerCtx := precheckContext{
+		backend:  controllerBackend,
+		presence: controllerPresence,
+		collect:  ctx.collect,
+	}
 	if err := controllerCtx.checkController(); err != nil {
 		return errors.Annotate(err, "controller")
 	}
+	for _, problem := range controllerCtx.problems {
+		ctx.problems = append(ctx.problems, errors.Annotate(problem, "controller"))
+	}
 	return nil
 }
 
 type precheckContext struct {
 	backend  PrecheckBackend
 	presence ModelPresence
+
+	// collect is true if problems found by the checks should be
+	// recorded in problems, rather than returned as errors.
+	collect  bool
+	problems []error
+}
+
+// problem records a problem found by the checks if they are
+// collecting problems, returning nil. Otherwise it returns the
+// problem so that the checks stop.
+func (ctx *precheckContext) problem(err error) error {
+	if !ctx.collect {
+		return err
+	}
+	ctx.problems = append(ctx.problems, err)
+	return nil
 }
 
 func (ctx *precheckContext) checkModel() error {
@@ -165,10 +209,14 @@ func (ctx *precheckContext) checkModel() error {
 		return errors.Annotate(err, "retrieving model")
 	}
 	if model.Life() != state.Alive {
-		return errors.Errorf("model is %s", model.Life())
+		if err := ctx.problem(errors.Errorf("model is %s", model.Life())); err != nil {
+			return errors.Trace(err)
+		}
 	}
 	if model.MigrationMode() == state.MigrationModeImporting {
-		return errors.New("model is being imported as part of another migration")
+		if err := ctx.problem(errors.New("model is being imported as part of another migration")); err != nil {
+			return errors.Trace(err)
+		}
 	}
 	if credTag, found := model.CloudCredential(); found {
 		creds, err := ctx.backend.CloudCredential(credTag)
@@ -176,7 +224,9 @@ func (ctx *precheckContext) checkModel() error {
 			return errors.Trace(err)
 		}
 		if creds.Revoked {
-			return errors.New("model has revoked credentials")
+			if err := ctx.problem(errors.New("model has revoked credentials")); err != nil {
+				return errors.Trace(err)
+			}
 		}
 	}
 	return nil
@@ -186,6 +236,22 @@ func (ctx *precheckContext) checkModel() error {
 // sure that the preconditions for model migration are met. The
 // backend provided must be for the target controller.
 func TargetPrecheck(backend PrecheckBackend, pool Pool, modelInfo coremigration.ModelInfo, presence ModelPresence) error {
+	ctx := precheckContext{backend: backend, presence: presence}
+	return errors.Trace(ctx.targetPrecheck(pool, modelInfo))
+}
+
+// TargetPrecheckProblems runs the same checks as TargetPrecheck, but
+// rather than stopping at the first problem found it returns all of
+// them. An error is returned only if the checks could not be run.
+func TargetPrecheckProblems(backend PrecheckBackend, pool Pool, modelInfo coremigration.ModelInfo, presence ModelPresence) ([]error, error) {
+	ctx := precheckContext{backend: backend, presence: presence, collect: true}
+	if err := ctx.targetPrecheck(pool, modelInfo); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return ctx.problems, nil
+}
+
+func (ctx *precheckContext) targetPrecheck(pool Pool, modelInfo coremigration.ModelInfo) error {
 	if err := modelInfo.Validate(); err != nil {
 		return errors.Trace(err)
 	}
@@ -197,34 +263,41 @@ func TargetPrecheck(backend PrecheckBackend, pool Pool, modelInfo coremigration.
 	// window can upset the migrationmaster worker.
 	//
 	// See also https://lpad.tv/1611391
-	if migrating, err := backend.IsMigrationActive(modelInfo.UUID); err != nil {
+	if migrating, err := ctx.backend.IsMigrationActive(modelInfo.UUID); err != nil {
 		return errors.Annotate(err, "checking for active migration")
 	} else if migrating {
-		return errors.New("model is being migrated out of target controller")
+		if err := ctx.problem(errors.New("model is being migrated out of target controller")); err != nil {
+			return errors.Trace(err)
+		}
 	}
 
-	controllerVersion, err := backend.AgentVersion()
+	controllerVersion, err := ctx.backend.AgentVersion()
 	if err != nil {
 		return errors.Annotate(err, "retrieving model version")
 	}
 
 	if controllerVersion.Compare(modelInfo.AgentVersion) < 0 {
-		return errors.Errorf("model has higher version than target controller (%s > %s)",
+		err := errors.Errorf("model has higher version than target controller (%s > %s)",
 			modelInfo.AgentVersion, controllerVersion)
+		if err := ctx.problem(err); err != nil {
+			return errors.Trace(err)
+		}
 	}
 
 	if !controllerVersionCompatible(modelInfo.ControllerAgentVersion, controllerVersion) {
-		return errors.Errorf("source controller has higher version than target controller (%s > %s)",
+		err := errors.Errorf("source controller has higher version than target controller (%s > %s)",
 			modelInfo.ControllerAgentVersion, controllerVersion)
+		if err := ctx.problem(err); err != nil {
+			return errors.Trace(err)
+		}
 	}
 
-	controllerCtx := precheckContext{backend, presence}
-	if err := controllerCtx.checkController(); err != nil {
+	if err := ctx.checkController(); err != nil {
 		return errors.Trace(err)
 	}
 
 	// Check for conflicts with existing models
-	modelUUIDs, err := backend.AllModelUUIDs()
+	modelUUIDs, err := ctx.backend.AllModelUUIDs()
 	if err != nil {
 		return errors.Annotate(err, "retrieving models")
 	}
@@ -239,10 +312,15 @@ func TargetPrecheck(backend PrecheckBackend, pool Pool, modelInfo coremigration.
 		// from a previous migration attempt. It will be removed
 		// before the next import.
 		if model.UUID() == modelInfo.UUID && model.MigrationMode() != state.MigrationModeImporting {
-			return errors.Errorf("model with same UUID already exists (%s)", modelInfo.UUID)
+			err := errors.Errorf("model with same UUID already exists (%s)", modelInfo.UUID)
+			if err := ctx.problem(err); err != nil {
+				return errors.Trace(err)
+			}
 		}
 		if model.Name() == modelInfo.Name && model.Owner() == modelInfo.Owner {
-			return errors.Errorf("model named %q already exists", model.Name())
+			if err := ctx.problem(errors.Errorf("model named %q already exists", model.Name())); err != nil {
+				return errors.Trace(err)
+			}
 		}
 	}
 
@@ -272,13 +350,17 @@ func (ctx *precheckContext) checkController() error {
 		return errors.Annotate(err, "retrieving model")
 	}
 	if model.Life() != state.Alive {
-		return errors.Errorf("model is %s", model.Life())
+		if err := ctx.problem(errors.Errorf("model is %s", model.Life())); err != nil {
+			return errors.Trace(err)
+		}
 	}
 
 	if upgrading, err := ctx.backend.IsUpgrading(); err != nil {
 		return errors.Annotate(err, "checking for upgrades")
 	} else if upgrading {
-		return errors.New("upgrade in progress")
+		if err := ctx.problem(errors.New("upgrade in progress")); err != nil {
+			return errors.Trace(err)
+		}
 	}
 
 	return errors.Trace(ctx.checkMachines())
@@ -297,29 +379,40 @@ func (ctx *precheckContext) checkMachines() error {
 	modelPresenceContext := common.ModelPresenceContext{ctx.presence}
 	for _, machine := range machines {
 		if machine.Life() != state.Alive {
-			return errors.Errorf("machine %s is %s", machine.Id(), machine.Life())
+			if err := ctx.problem(errors.Errorf("machine %s is %s", machine.Id(), machine.Life())); err != nil {
+				return errors.Trace(err)
+			}
 		}
 
 		if statusInfo, err := machine.InstanceStatus(); err != nil {
 			return errors.Annotatef(err, "retrieving machine %s instance status", machine.Id())
 		} else if statusInfo.Status != status.Running {
-			return newStatusError("machine %s not running", machine.Id(), statusInfo.Status)
+			err := newStatusError("machine %s not running", machine.Id(), statusInfo.Status)
+			if err := ctx.problem(err); err != nil {
+				return errors.Trace(err)
+			}
 		}
 
 		if statusInfo, err := modelPresenceContext.MachineStatus(machine); err != nil {
 			return errors.Annotatef(err, "retrieving machine %s status", machine.Id())
 		} else if statusInfo.Status != status.Started {
-			return newStatusError("machine %s agent not functioning at this time",
+			err := newStatusError("machine %s agent not functioning at this time",
 				machine.Id(), statusInfo.Status)
+			if err := ctx.problem(err); err != nil {
+				return errors.Trace(err)
+			}
 		}
 
 		if rebootAction, err := machine.ShouldRebootOrShutdown(); err != nil {
 			return errors.Annotatef(err, "retrieving machine %s reboot status", machine.Id())
 		} else if rebootAction != state.ShouldDoNothing {
-			return errors.Errorf("machine %s is scheduled to %s", machine.Id(), rebootAction)
+			err := errors.Errorf("machine %s is scheduled to %s", machine.Id(), rebootAction)
+			if err := ctx.problem(err); err != nil {
+				return errors.Trace(err)
+			}
 		}
 
-		if err := checkAgentTools(modelVersion, machine, "machine "+machine.Id()); err != nil {
+		if err := ctx.checkAgentTools(modelVersion, machine, "machine "+machine.Id()); err != nil {
 			return errors.Trace(err)
 		}
 	}
@@ -339,7 +432,9 @@ func (ctx *precheckContext) checkApplications() (map[string][]PrecheckUnit, erro
 	appUnits := make(map[string][]PrecheckUnit, len(apps))
 	for _, app := range apps {
 		if app.Life() != state.Alive {
-			return nil, errors.Errorf("application %s is %s", app.Name(), app.Life())
+			if err := ctx.problem(errors.Errorf("application %s is %s", app.Name(), app.Life())); err != nil {
+				return nil, errors.Trace(err)
+			}
 		}
 		units, err := app.AllUnits()
 		if err != nil {
@@ -356,27 +451,34 @@ func (ctx *precheckContext) checkApplications() (map[string][]PrecheckUnit, erro
 
 func (ctx *precheckContext) checkUnits(app PrecheckApplication, units []PrecheckUnit, modelVersion version.Number) error {
 	if len(units) < app.MinUnits() {
-		return errors.Errorf("application %s is below its minimum units threshold", app.Name())
+		err := errors.Errorf("application %s is below its minimum units threshold", app.Name())
+		if err := ctx.problem(err); err != nil {
+			return errors.Trace(err)
+		}
 	}
 
 	appCharmURL, _ := app.CharmURL()
 
 	for _, unit := range units {
 		if unit.Life() != state.Alive {
-			return errors.Errorf("unit %s is %s", unit.Name(), unit.Life())
+			if err := ctx.problem(errors.Errorf("unit %s is %s", unit.Name(), unit.Life())); err != nil {
+				return errors.Trace(err)
+			}
 		}
 
 		if err := ctx.checkUnitAgentStatus(unit); err != nil {
 			return errors.Trace(err)
 		}
 
-		if err := checkAgentTools(modelVersion, unit, "unit "+unit.Name()); err != nil {
+		if err := ctx.checkAgentTools(modelVersion, unit, "unit "+unit.Name()); err != nil {
 			return errors.Trace(err)
 		}
 
 		unitCharmURL, _ := unit.CharmURL()
 		if appCharmURL.String() != unitCharmURL.String() {
-			return errors.Errorf("unit %s is upgrading", unit.Name())
+			if err := ctx.problem(errors.Errorf("unit %s is upgrading", unit.Name())); err != nil {
+				return errors.Trace(err)
+			}
 		}
 	}
 	return nil
@@ -393,20 +495,22 @@ func (ctx *precheckContext) checkUnitAgentStatus(unit PrecheckUnit) error {
 	case status.Idle, status.Executing:
 		// These two are fine.
 	default:
-		return newStatusError("unit %s not idle or executing", unit.Name(), agentStatus)
+		err := newStatusError("unit %s not idle or executing", unit.Name(), agentStatus)
+		return errors.Trace(ctx.problem(err))
 	}
 	return nil
 }
 
-func checkAgentTools(modelVersion version.Number, agent agentToolsGetter, agentLabel string) error {
+func (ctx *precheckContext) checkAgentTools(modelVersion version.Number, agent agentToolsGetter, agentLabel string) error {
 	tools, err := agent.AgentTools()
 	if err != nil {
 		return errors.Annotatef(err, "retrieving agent binaries for %s", agentLabel)
 	}
 	agentVersion := tools.Version.Number
 	if agentVersion != modelVersion {
-		return errors.Errorf("%s agent binaries don't match model (%s != %s)",
+		err := errors.Errorf("%s agent binaries don't match model (%s != %s)",
 			agentLabel, agentVersion, modelVersion)
+		return errors.Trace(ctx.problem(err))
 	}
 	return nil
 }
@@ -457,7 +561,10 @@ func (ctx *precheckContext) checkRelations(appUnits map[string][]PrecheckUnit) e
 					return errors.Trace(err)
 				}
 				if !inScope {
-					return errors.Errorf("unit %s hasn't joined relation %s yet", unit.Name(), rel)
+					err := errors.Errorf("unit %s hasn't joined relation %s yet", unit.Name(), rel)
+					if err := ctx.problem(err); err != nil {
+						return errors.Trace(err)
+					}
 				}
 			}
 		}
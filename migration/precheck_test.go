@@ -401,6 +401,49 @@ func (s *SourcePrecheckSuite) TestCrossModelUnitsNotYetInScope(c *gc.C) {
 	c.Assert(err, jc.ErrorIsNil)
 }
 
+func (*SourcePrecheckSuite) TestProblems(c *gc.C) {
+	backend := newFakeBackend()
+	backend.model.life = state.Dying
+	backend.machines = []migration.PrecheckMachine{
+		&fakeMachine{id: "0", life: state.Dying},
+		&fakeMachine{id: "1", rebootAction: state.ShouldReboot},
+	}
+	backend.cleanupNeeded = true
+	backend.controllerBackend.isUpgrading = true
+	problems, err := migration.SourcePrecheckProblems(backend, allAlivePresence(), allAlivePresence())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(errorStrings(problems), jc.DeepEquals, []string{
+		"model is dying",
+		"machine 0 is dying",
+		"machine 1 is scheduled to reboot",
+		"cleanup needed",
+		"controller: upgrade in progress",
+	})
+}
+
+func (*SourcePrecheckSuite) TestProblemsNone(c *gc.C) {
+	backend := newHappyBackend()
+	problems, err := migration.SourcePrecheckProblems(backend, allAlivePresence(), allAlivePresence())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(problems, gc.HasLen, 0)
+}
+
+func (*SourcePrecheckSuite) TestProblemsError(c *gc.C) {
+	backend := newFakeBackend()
+	backend.model.life = state.Dying
+	backend.cleanupErr = errors.New("boom")
+	_, err := migration.SourcePrecheckProblems(backend, allAlivePresence(), allAlivePresence())
+	c.Assert(err, gc.ErrorMatches, "checking cleanups: boom")
+}
+
+func errorStrings(errs []error) []string {
+	var out []string
+	for _, err := range errs {
+		out = append(out, err.Error())
+	}
+	return out
+}
+
 type TargetPrecheckSuite struct {
 	precheckBaseSuite
 	modelInfo coremigration.ModelInfo
@@ -628,6 +671,27 @@ func (s *TargetPrecheckSuite) TestUUIDAlreadyExistsButImporting(c *gc.C) {
 	c.Assert(err, jc.ErrorIsNil)
 }
 
+func (s *TargetPrecheckSuite) TestProblems(c *gc.C) {
+	pool := &fakePool{
+		models: []migration.PrecheckModel{
+			&fakeModel{uuid: modelUUID},
+			&fakeModel{uuid: "uuid", name: modelName, owner: modelOwner},
+		},
+	}
+	backend := newFakeBackend()
+	backend.models = pool.uuids()
+	backend.isUpgrading = true
+	s.modelInfo.AgentVersion.Patch++
+	problems, err := migration.TargetPrecheckProblems(backend, pool, s.modelInfo, allAlivePresence())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(errorStrings(problems), jc.DeepEquals, []string{
+		"model has higher version than target controller (1.2.4 > 1.2.3)",
+		"upgrade in progress",
+		"model with same UUID already exists (model-uuid)",
+		`model named "model-name" already exists`,
+	})
+}
+
 type precheckRunner func(migration.PrecheckBackend) error
 
 type precheckBaseSuite struct {
@@ -39,6 +39,7 @@ type storageProvider struct {
 }
 
 var _ storage.Provider = (*storageProvider)(nil)
+var _ storage.VolumeResizingProvider = (*storageProvider)(nil)
 
 func (g *storageProvider) ValidateConfig(cfg *storage.Config) error {
 	return nil
@@ -60,6 +61,11 @@ func (e *storageProvider) Releasable() bool {
 	return true
 }
 
+// ResizableVolumes is defined on the storage.VolumeResizingProvider interface.
+func (g *storageProvider) ResizableVolumes() bool {
+	return true
+}
+
 func (g *storageProvider) DefaultPools() []*storage.Config {
 	// TODO(perrito666) Add explicit pools.
 	return nil
@@ -409,6 +415,49 @@ func (v *volumeSource) describeOneVolume(volName string) (storage.DescribeVolume
 	return desc, nil
 }
 
+// ResizeVolumes is specified on the storage.VolumeResizer interface.
+func (v *volumeSource) ResizeVolumes(params []storage.VolumeResizeParams) ([]storage.ResizeVolumesResult, error) {
+	results := make([]storage.ResizeVolumesResult, len(params))
+	for i, p := range params {
+		volume, err := v.resizeOneVolume(p)
+		if err != nil {
+			results[i].Error = errors.Annotatef(err, "cannot resize volume %q", p.VolumeId)
+			continue
+		}
+		results[i].Volume = volume
+	}
+	return results, nil
+}
+
+func (v *volumeSource) resizeOneVolume(p storage.VolumeResizeParams) (*storage.Volume, error) {
+	zone, _, err := parseVolumeId(p.VolumeId)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	disk, err := v.gce.Disk(zone, p.VolumeId)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	// GCE disks are sized in whole GiB, so the
+	// requested size is rounded up.
+	sizeGb := mibToGib(p.Size)
+	if currentGb := mibToGib(disk.Size); sizeGb < currentGb {
+		return nil, errors.Errorf("cannot shrink volume from %dGiB to %dGiB", currentGb, sizeGb)
+	} else if sizeGb > currentGb {
+		if err := v.gce.ResizeDisk(zone, p.VolumeId, sizeGb); err != nil {
+			return nil, errors.Trace(err)
+		}
+	}
+	return &storage.Volume{
+		p.Tag,
+		storage.VolumeInfo{
+			VolumeId:   p.VolumeId,
+			Size:       sizeGb * 1024,
+			Persistent: true,
+		},
+	}, nil
+}
+
 // TODO(perrito666) These rules are yet to be defined.
 func (v *volumeSource) ValidateVolumeParams(params storage.VolumeParams) error {
 	return nil
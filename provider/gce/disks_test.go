@@ -300,6 +300,54 @@ func (s *volumeSourceSuite) TestDescribeVolumes(c *gc.C) {
 	c.Assert(call[0].ID, gc.Equals, volName)
 }
 
+func (s *volumeSourceSuite) TestResizeVolumes(c *gc.C) {
+	s.FakeConn.GoogleDisk = s.BaseDisk
+	volName := "home-zone--c930380d-8337-4bf5-b07a-9dbb5ae771e4"
+	resizer, ok := s.source.(storage.VolumeResizer)
+	c.Assert(ok, jc.IsTrue)
+	res, err := resizer.ResizeVolumes([]storage.VolumeResizeParams{{
+		Tag:      names.NewVolumeTag("0"),
+		VolumeId: volName,
+		Size:     3000,
+	}})
+	c.Check(err, jc.ErrorIsNil)
+	c.Assert(res, gc.HasLen, 1)
+	c.Assert(res[0].Error, jc.ErrorIsNil)
+	c.Assert(res[0].Volume, jc.DeepEquals, &storage.Volume{
+		names.NewVolumeTag("0"),
+		storage.VolumeInfo{
+			VolumeId:   volName,
+			Size:       3072,
+			Persistent: true,
+		},
+	})
+
+	resizeCalled, call := s.FakeConn.WasCalled("ResizeDisk")
+	c.Assert(resizeCalled, jc.IsTrue)
+	c.Assert(call, gc.HasLen, 1)
+	c.Assert(call[0].ZoneName, gc.Equals, "home-zone")
+	c.Assert(call[0].ID, gc.Equals, volName)
+	c.Assert(call[0].Size, gc.Equals, uint64(3))
+}
+
+func (s *volumeSourceSuite) TestResizeVolumesShrink(c *gc.C) {
+	s.BaseDisk.Size = 2048
+	s.FakeConn.GoogleDisk = s.BaseDisk
+	volName := "home-zone--c930380d-8337-4bf5-b07a-9dbb5ae771e4"
+	resizer := s.source.(storage.VolumeResizer)
+	res, err := resizer.ResizeVolumes([]storage.VolumeResizeParams{{
+		Tag:      names.NewVolumeTag("0"),
+		VolumeId: volName,
+		Size:     512,
+	}})
+	c.Check(err, jc.ErrorIsNil)
+	c.Assert(res, gc.HasLen, 1)
+	c.Assert(res[0].Error, gc.ErrorMatches, `cannot resize volume ".*": cannot shrink volume from 2GiB to 1GiB`)
+
+	resizeCalled, _ := s.FakeConn.WasCalled("ResizeDisk")
+	c.Assert(resizeCalled, jc.IsFalse)
+}
+
 func (s *volumeSourceSuite) TestAttachVolumes(c *gc.C) {
 	volName := "home-zone--c930380d-8337-4bf5-b07a-9dbb5ae771e4"
 	attachments := []storage.VolumeAttachmentParams{*s.attachmentParams}
@@ -65,6 +65,12 @@ func (gce *Connection) SetDiskLabels(zone, name, labelFingerprint string, labels
 	return errors.Annotatef(err, "cannot update labels for disk %q in zone %q", name, zone)
 }
 
+// ResizeDisk implements storage section of gceConnection.
+func (gce *Connection) ResizeDisk(zone, name string, sizeGb uint64) error {
+	err := gce.raw.ResizeDisk(gce.projectID, zone, name, int64(sizeGb))
+	return errors.Annotatef(err, "cannot resize disk %q in zone %q", name, zone)
+}
+
 // deviceName will generate a device name from the passed
 // <zone> and <diskId>, the device name must not be confused
 // with the volume name, as it is used mainly to name the
@@ -265,6 +265,18 @@ func (rc *rawConn) SetDiskLabels(project, zone, id, labelFingerprint string, lab
 	return errors.Trace(err)
 }
 
+func (rc *rawConn) ResizeDisk(project, zone, id string, sizeGb int64) error {
+	ds := rc.Disks
+	call := ds.Resize(project, zone, id, &compute.DisksResizeRequest{
+		SizeGb: sizeGb,
+	})
+	op, err := call.Do()
+	if err != nil {
+		return errors.Annotatef(err, "could not resize disk %q", id)
+	}
+	return errors.Trace(rc.waitOperation(project, op, attemptsLong))
+}
+
 func (rc *rawConn) AttachDisk(project, zone, instanceId string, disk *compute.AttachedDisk) error {
 	call := rc.Instances.AttachDisk(project, zone, instanceId, disk)
 	_, err := call.Do() // Perhaps return something from the Op
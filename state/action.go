@@ -104,6 +104,10 @@ type actionDoc struct {
 
 	// Results are the structured results from the action.
 	Results map[string]interface{} `bson:"results"`
+
+	// Timeout is how long the action may run before it is killed,
+	// or zero if there is no limit.
+	Timeout time.Duration `bson:"timeout,omitempty"`
 }
 
 // action represents an instruction to do some "action" and is expected
@@ -136,6 +140,12 @@ func (a *action) Parameters() map[string]interface{} {
 	return a.doc.Parameters
 }
 
+// Timeout returns how long the action may run before it is killed,
+// or zero if there is no limit.
+func (a *action) Timeout() time.Duration {
+	return a.doc.Timeout
+}
+
 // Enqueued returns the time the action was added to state as a pending
 // Action.
 func (a *action) Enqueued() time.Time {
@@ -260,8 +270,9 @@ func newAction(st *State, adoc actionDoc) Action {
 	}
 }
 
-// newActionDoc builds the actionDoc with the given name and parameters.
-func newActionDoc(mb modelBackend, receiverTag names.Tag, actionName string, parameters map[string]interface{}) (actionDoc, actionNotificationDoc, error) {
+// newActionDoc builds the actionDoc with the given name, parameters and
+// timeout.
+func newActionDoc(mb modelBackend, receiverTag names.Tag, actionName string, parameters map[string]interface{}, timeout time.Duration) (actionDoc, actionNotificationDoc, error) {
 	prefix := ensureActionMarker(receiverTag.Id())
 	actionId, err := NewUUID()
 	if err != nil {
@@ -277,6 +288,7 @@ func newActionDoc(mb modelBackend, receiverTag names.Tag, actionName string, par
 			Parameters: parameters,
 			Enqueued:   mb.nowToTheSecond(),
 			Status:     ActionPending,
+			Timeout:    timeout,
 		}, actionNotificationDoc{
 			DocId:     mb.docID(prefix + actionId.String()),
 			ModelUUID: modelUUID,
@@ -371,6 +383,12 @@ func (m *Model) FindActionsByName(name string) ([]Action, error) {
 
 // EnqueueAction
 func (m *Model) EnqueueAction(receiver names.Tag, actionName string, payload map[string]interface{}) (Action, error) {
+	return m.EnqueueActionWithTimeout(receiver, actionName, payload, 0)
+}
+
+// EnqueueActionWithTimeout queues an action that is killed if it runs
+// for longer than timeout. A zero timeout means no limit.
+func (m *Model) EnqueueActionWithTimeout(receiver names.Tag, actionName string, payload map[string]interface{}, timeout time.Duration) (Action, error) {
 	if len(actionName) == 0 {
 		return nil, errors.New("action name required")
 	}
@@ -380,7 +398,11 @@ func (m *Model) EnqueueAction(receiver names.Tag, actionName string, payload map
 		return nil, errors.Trace(err)
 	}
 
-	doc, ndoc, err := newActionDoc(m.st, receiver, actionName, payload)
+	if timeout < 0 {
+		return nil, errors.NotValidf("negative action timeout %v", timeout)
+	}
+
+	doc, ndoc, err := newActionDoc(m.st, receiver, actionName, payload, timeout)
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
@@ -313,6 +313,26 @@ func (s *ActionSuite) TestEnqueueActionRequiresName(c *gc.C) {
 	c.Assert(err, gc.ErrorMatches, "action name required")
 }
 
+func (s *ActionSuite) TestAddActionWithTimeout(c *gc.C) {
+	a, err := s.unit.AddActionWithTimeout("snapshot", nil, 5*time.Minute)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(a.Timeout(), gc.Equals, 5*time.Minute)
+
+	action, err := s.model.Action(a.Id())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(action.Timeout(), gc.Equals, 5*time.Minute)
+
+	// Actions added without a timeout may run indefinitely.
+	a, err = s.unit.AddAction("snapshot", nil)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(a.Timeout(), gc.Equals, time.Duration(0))
+}
+
+func (s *ActionSuite) TestEnqueueActionNegativeTimeout(c *gc.C) {
+	_, err := s.model.EnqueueActionWithTimeout(s.unit.Tag(), "snapshot", nil, -time.Second)
+	c.Assert(err, gc.ErrorMatches, "negative action timeout -1s not valid")
+}
+
 func (s *ActionSuite) TestAddActionAcceptsDuplicateNames(c *gc.C) {
 	name := "snapshot"
 	params1 := map[string]interface{}{"outfile": "outfile.tar.bz2"}
@@ -917,6 +937,9 @@ var _ state.ActionReceiver = (*mockAR)(nil)
 func (r mockAR) AddAction(name string, payload map[string]interface{}) (state.Action, error) {
 	return nil, nil
 }
+func (r mockAR) AddActionWithTimeout(name string, payload map[string]interface{}, timeout time.Duration) (state.Action, error) {
+	return nil, nil
+}
 func (r mockAR) CancelAction(state.Action) (state.Action, error) { return nil, nil }
 func (r mockAR) WatchActionNotifications() state.StringsWatcher  { return nil }
 func (r mockAR) Actions() ([]state.Action, error)                { return nil, nil }
@@ -94,6 +94,10 @@ func allCollections() collectionSchema {
 		// upgrades and schema migrations.
 		upgradeInfoC: {global: true},
 
+		// This collection holds the named firewall rules set for the
+		// controller, which apply to all hosted models.
+		controllerFirewallRulesC: {global: true},
+
 		// This collection holds a convenient representation of the content of
 		// the simplestreams data source pointing to binaries required by juju.
 		//
@@ -277,6 +281,10 @@ func allCollections() collectionSchema {
 		// meterStatusC is the collection used to store meter status information.
 		meterStatusC: {},
 
+		// unitHealthC holds the results of the health checks declared
+		// by each unit's charm, as reported by the unit agent.
+		unitHealthC: {},
+
 		// These collections hold reference counts which are used
 		// by the nsRefcounts struct.
 		refcountsC: {}, // Per model.
@@ -350,6 +358,11 @@ func allCollections() collectionSchema {
 			}},
 		},
 		volumeAttachmentsC: {},
+		volumeSnapshotsC: {
+			indexes: []mgo.Index{{
+				Key: []string{"model-uuid", "volumeid"},
+			}},
+		},
 
 		// -----
 
@@ -463,9 +476,29 @@ func allCollections() collectionSchema {
 		// relationNetworksC holds required ingress or egress cidrs for remote relations.
 		relationNetworksC: {},
 
+		// remoteRelationEventsC records the most recent settings
+		// changes exchanged with the remote side of a cross model
+		// relation, for diagnostic purposes.
+		remoteRelationEventsC: {},
+
 		// firewallRulesC holds firewall rules for defined service types.
 		firewallRulesC: {},
 
+		// hookHistoryC records the operations completed by
+		// each unit's uniter, for diagnostic purposes.
+		hookHistoryC: {
+			rawAccess: true,
+			indexes: []mgo.Index{{
+				Key: []string{"model-uuid", "globalkey", "completed"},
+			}, {
+				// used for model-specific pruning
+				Key: []string{"model-uuid", "-completed", "-_id"},
+			}, {
+				// used for global pruning (after size check)
+				Key: []string{"-completed"},
+			}},
+		},
+
 		// podSpecsC holds the CAAS pod specifications,
 		// for applications.
 		podSpecsC: {},
@@ -572,6 +605,7 @@ const (
 	usersC                   = "users"
 	volumeAttachmentsC       = "volumeattachments"
 	volumesC                 = "volumes"
+	volumeSnapshotsC         = "volumesnapshots"
 	// "resources" (see resource/persistence/mongo.go)
 
 	// Cross model relations
@@ -582,4 +616,12 @@ const (
 	externalControllersC = "externalControllers"
 	relationNetworksC    = "relationNetworks"
 	firewallRulesC       = "firewallRules"
+
+	controllerFirewallRulesC = "controllerFirewallRules"
+
+	remoteRelationEventsC = "remoteRelationEvents"
+
+	hookHistoryC = "hookHistory"
+
+	unitHealthC = "unitHealth"
 )
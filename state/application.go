@@ -359,6 +359,23 @@ func (a *Application) removeOps(asserts bson.D) ([]txn.Op, error) {
 	ops = append(ops, finalAppCharmRemoveOps(name, curl)...)
 
 	ops = append(ops, a.removeCloudServiceOps()...)
+
+	model, err := a.st.Model()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if model.Type() == ModelTypeIAAS {
+		im, err := model.IAASModel()
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		storageOps, err := removeApplicationStorageOps(im, a.ApplicationTag())
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		ops = append(ops, storageOps...)
+	}
+
 	globalKey := a.globalKey()
 	ops = append(ops,
 		removeEndpointBindingsOp(globalKey),
@@ -1299,6 +1316,11 @@ type applicationAddUnitOpsArgs struct {
 	storageCons   map[string]StorageConstraints
 	attachStorage []names.StorageTag
 
+	// sharedStorage holds the tags of shared storage instances
+	// created in the same transaction as the unit, which the unit
+	// should be attached to.
+	sharedStorage []names.StorageTag
+
 	// These optional attributes are relevant to CAAS models.
 	providerId *string
 	address    *string
@@ -1526,6 +1548,19 @@ func (a *Application) addUnitStorageOps(
 		numStorageAttachments++
 		storageTags[si.StorageName()] = append(storageTags[si.StorageName()], storageTag)
 	}
+
+	// Attach the application's shared storage to the unit. Shared
+	// storage remains owned by the application, so the unit's
+	// storage counts are unaffected.
+	sharedOps, numSharedAttachments, err := a.attachSharedStorageOps(
+		im, args, unitTag, charm, machineAssignable,
+	)
+	if err != nil {
+		return nil, -1, errors.Trace(err)
+	}
+	storageOps = append(storageOps, sharedOps...)
+	numStorageAttachments += numSharedAttachments
+
 	for name, tags := range storageTags {
 		count := len(tags)
 		charmStorage := charm.Meta().Storage[name]
@@ -1541,6 +1576,97 @@ func (a *Application) addUnitStorageOps(
 	return storageOps, numStorageAttachments, nil
 }
 
+// attachSharedStorageOps returns the operations necessary to attach the
+// application's shared storage instances to the specified unit, and the
+// number of storage attachments created.
+func (a *Application) attachSharedStorageOps(
+	im *IAASModel,
+	args applicationAddUnitOpsArgs,
+	unitTag names.UnitTag,
+	charm *Charm,
+	machineAssignable machineAssignable,
+) ([]txn.Op, int, error) {
+	var ops []txn.Op
+	for _, storageTag := range args.sharedStorage {
+		// The storage instances are being created in the same
+		// transaction, so we cannot assert anything about them.
+		ops = append(ops, txn.Op{
+			C:      storageInstancesC,
+			Id:     storageTag.Id(),
+			Update: bson.D{{"$inc", bson.D{{"attachmentcount", 1}}}},
+		}, createStorageAttachmentOp(storageTag, unitTag))
+	}
+	numStorageAttachments := len(args.sharedStorage)
+
+	shared, err := im.storageInstances(bson.D{
+		{"owner", a.ApplicationTag().String()},
+		{"life", Alive},
+	})
+	if err != nil {
+		return nil, -1, errors.Trace(err)
+	}
+	for _, si := range shared {
+		attachOps, err := im.attachStorageOps(
+			si,
+			unitTag,
+			a.doc.Series,
+			charm,
+			machineAssignable,
+		)
+		if err != nil {
+			return nil, -1, errors.Annotatef(
+				err, "attaching %s",
+				names.ReadableString(si.StorageTag()),
+			)
+		}
+		ops = append(ops, attachOps...)
+		numStorageAttachments++
+	}
+	return ops, numStorageAttachments, nil
+}
+
+// addSharedStorageOps returns the operations necessary to create the
+// application's shared storage instances, along with their tags. The
+// storage instances are owned by the application, and are attached to
+// each of its units.
+func (a *Application) addSharedStorageOps(
+	charmMeta *charm.Meta,
+	cons map[string]StorageConstraints,
+) ([]txn.Op, []names.StorageTag, error) {
+	im, err := a.st.IAASModel()
+	if err != nil {
+		return nil, nil, errors.Trace(err)
+	}
+	ops, storageTags, _, err := createStorageOps(
+		im,
+		a.ApplicationTag(),
+		charmMeta,
+		cons,
+		a.doc.Series,
+		nil, // machineAssignable
+	)
+	if err != nil {
+		return nil, nil, errors.Trace(err)
+	}
+	storageNames := make([]string, 0, len(storageTags))
+	for name := range storageTags {
+		storageNames = append(storageNames, name)
+	}
+	sort.Strings(storageNames)
+
+	var tags []names.StorageTag
+	for _, name := range storageNames {
+		nameTags := storageTags[name]
+		incRefOp, err := increfEntityStorageOp(a.st, a.ApplicationTag(), name, len(nameTags))
+		if err != nil {
+			return nil, nil, errors.Trace(err)
+		}
+		ops = append(ops, incRefOp)
+		tags = append(tags, nameTags...)
+	}
+	return ops, tags, nil
+}
+
 // applicationOffersRefCountKey returns a key for refcounting offers
 // for the specified application. Each time an offer is created, the
 // refcount is incremented, and the opposite happens on removal.
@@ -1646,6 +1772,10 @@ func (a *Application) removeUnitOps(u *Unit, asserts bson.D) ([]txn.Op, error) {
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
+	subWorkloadOps, err := removeSubWorkloadsOps(a.st, u.doc.Name)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
 
 	observedFieldsMatch := bson.D{
 		{"charmurl", u.doc.CharmURL},
@@ -1659,6 +1789,7 @@ func (a *Application) removeUnitOps(u *Unit, asserts bson.D) ([]txn.Op, error) {
 			Remove: true,
 		},
 		removeMeterStatusOp(a.st, u.globalMeterStatusKey()),
+		removeUnitHealthOp(a.st, u.globalKey()),
 		removeStatusOp(a.st, u.globalAgentKey()),
 		removeStatusOp(a.st, u.globalKey()),
 		removeConstraintsOp(u.globalAgentKey()),
@@ -1667,6 +1798,7 @@ func (a *Application) removeUnitOps(u *Unit, asserts bson.D) ([]txn.Op, error) {
 	}
 	ops = append(ops, portsOps...)
 	ops = append(ops, resOps...)
+	ops = append(ops, subWorkloadOps...)
 	ops = append(ops, hostOps...)
 
 	model, err := a.st.Model()
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"regexp"
 	"sort"
+	"strings"
 
 	"github.com/juju/collections/set"
 	"github.com/juju/errors"
@@ -396,6 +397,10 @@ func (s *applicationOffers) UpdateOffer(offerArgs crossmodel.AddApplicationOffer
 		return nil, errors.Trace(err)
 	}
 	doc := s.makeApplicationOfferDoc(s.st, offer.OfferUUID, offerArgs)
+	result, err := s.makeApplicationOffer(doc)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
 	var refOps []txn.Op
 	if offerArgs.ApplicationName != offer.ApplicationName {
 		incRefOp, err := incApplicationOffersRefOp(s.st, offerArgs.ApplicationName)
@@ -415,13 +420,17 @@ func (s *applicationOffers) UpdateOffer(offerArgs crossmodel.AddApplicationOffer
 			if err := checkModelActive(s.st); err != nil {
 				return nil, errors.Trace(err)
 			}
-			_, err := s.ApplicationOffer(offerArgs.OfferName)
+			offer, err = s.ApplicationOffer(offerArgs.OfferName)
 			if err != nil {
 				// This will either be NotFound or some other error.
 				// In either case, we return the error.
 				return nil, errors.Trace(err)
 			}
 		}
+		relationOps, err := s.removedEndpointsOps(offer, offerArgs)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
 		ops := []txn.Op{
 			model.assertActiveOp(),
 			{
@@ -432,13 +441,68 @@ func (s *applicationOffers) UpdateOffer(offerArgs crossmodel.AddApplicationOffer
 			},
 		}
 		ops = append(ops, refOps...)
+		ops = append(ops, relationOps...)
 		return ops, nil
 	}
 	err = s.st.db().Run(buildTxn)
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
-	return s.makeApplicationOffer(doc)
+	return result, nil
+}
+
+// removedEndpointsOps returns an error if any endpoint of the offer
+// which is not part of the updated offer is used by a relation to a
+// consuming model. Otherwise it returns ops asserting that the
+// relations of the offered application have not changed.
+func (s *applicationOffers) removedEndpointsOps(offer *crossmodel.ApplicationOffer, offerArgs crossmodel.AddApplicationOfferArgs) ([]txn.Op, error) {
+	removed := make(map[string]bool)
+	for alias, ep := range offer.Endpoints {
+		if offerArgs.ApplicationName == offer.ApplicationName && offerArgs.Endpoints[alias] == ep.Name {
+			continue
+		}
+		removed[ep.Name] = true
+	}
+	if len(removed) == 0 {
+		return nil, nil
+	}
+	// Load the application before reading the connections
+	// so we can do a consistency check on relation count.
+	app, err := s.st.Application(offer.ApplicationName)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	conns, err := s.st.OfferConnections(offer.OfferUUID)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	inUse := set.NewStrings()
+	for _, conn := range conns {
+		rel, err := s.st.KeyRelation(conn.RelationKey())
+		if errors.IsNotFound(err) {
+			continue
+		} else if err != nil {
+			return nil, errors.Trace(err)
+		}
+		ep, err := rel.Endpoint(offer.ApplicationName)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		if removed[ep.Name] {
+			inUse.Add(ep.Name)
+		}
+	}
+	if !inUse.IsEmpty() {
+		return nil, errors.Errorf(
+			"cannot remove endpoint%s %s: offer has active relations",
+			plural(inUse.Size()), strings.Join(inUse.SortedValues(), ", "),
+		)
+	}
+	return []txn.Op{{
+		C:      applicationsC,
+		Id:     offer.ApplicationName,
+		Assert: bson.D{{"relationcount", app.doc.RelationCount}},
+	}}, nil
 }
 
 func (s *applicationOffers) makeApplicationOfferDoc(mb modelBackend, uuid string, offer crossmodel.AddApplicationOfferArgs) applicationOfferDoc {
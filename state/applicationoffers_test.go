@@ -510,6 +510,51 @@ func (s *applicationOffersSuite) TestRemoveOffersWithConnections(c *gc.C) {
 	c.Assert(err, gc.ErrorMatches, `cannot delete application offer "hosted-mysql": offer has 1 relation`)
 }
 
+func (s *applicationOffersSuite) TestUpdateOfferRemoveEndpointWithConnections(c *gc.C) {
+	offer := s.createDefaultOffer(c)
+	s.addOfferConnection(c, offer.OfferUUID)
+	ao := state.NewApplicationOffers(s.State)
+	_, err := ao.UpdateOffer(crossmodel.AddApplicationOfferArgs{
+		OfferName:       "hosted-mysql",
+		ApplicationName: "mysql",
+		Endpoints:       map[string]string{"db-admin": "server-admin"},
+		Owner:           "admin",
+	})
+	c.Assert(err, gc.ErrorMatches, `cannot update application offer "mysql": cannot remove endpoint server: offer has active relations`)
+}
+
+func (s *applicationOffersSuite) TestUpdateOfferRemoveUnusedEndpointWithConnections(c *gc.C) {
+	offer := s.createDefaultOffer(c)
+	s.addOfferConnection(c, offer.OfferUUID)
+	ao := state.NewApplicationOffers(s.State)
+	updated, err := ao.UpdateOffer(crossmodel.AddApplicationOfferArgs{
+		OfferName:              "hosted-mysql",
+		ApplicationName:        "mysql",
+		ApplicationDescription: "a better database",
+		Endpoints:              map[string]string{"db": "server"},
+		Owner:                  "admin",
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(updated.ApplicationDescription, gc.Equals, "a better database")
+	c.Assert(updated.Endpoints, gc.HasLen, 1)
+	c.Assert(updated.Endpoints["db"].Name, gc.Equals, "server")
+}
+
+func (s *applicationOffersSuite) TestUpdateOfferUnknownEndpoint(c *gc.C) {
+	s.createDefaultOffer(c)
+	ao := state.NewApplicationOffers(s.State)
+	_, err := ao.UpdateOffer(crossmodel.AddApplicationOfferArgs{
+		OfferName:       "hosted-mysql",
+		ApplicationName: "mysql",
+		Endpoints:       map[string]string{"db": "foo"},
+		Owner:           "admin",
+	})
+	c.Assert(err, gc.ErrorMatches, `cannot update application offer "mysql": getting relation endpoint for relation "foo" and application "mysql": .*`)
+	offer, err := ao.ApplicationOffer("hosted-mysql")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(offer.Endpoints, gc.HasLen, 2)
+}
+
 func (s *applicationOffersSuite) TestRemoveOffersWithConnectionsForce(c *gc.C) {
 	offer := s.createDefaultOffer(c)
 	s.addOfferConnection(c, offer.OfferUUID)
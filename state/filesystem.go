@@ -93,6 +93,10 @@ type FilesystemAttachment interface {
 	// if it has not already been made. Params returns true if the returned
 	// parameters are usable for creating an attachment, otherwise false.
 	Params() (FilesystemAttachmentParams, bool)
+
+	// Usage returns the most recently reported usage of the filesystem
+	// on the machine, or a NotFound error if no usage has been reported.
+	Usage() (FilesystemUsage, error)
 }
 
 type filesystem struct {
@@ -134,6 +138,11 @@ type filesystemAttachmentDoc struct {
 	Life       Life                        `bson:"life"`
 	Info       *FilesystemAttachmentInfo   `bson:"info,omitempty"`
 	Params     *FilesystemAttachmentParams `bson:"params,omitempty"`
+
+	// Usage records the usage of the filesystem as most recently
+	// reported by the machine agent. It is not migrated, as it
+	// will be reported again by the machine agent.
+	Usage *FilesystemUsage `bson:"usage,omitempty"`
 }
 
 // FilesystemParams records parameters for provisioning a new filesystem.
@@ -155,6 +164,10 @@ type FilesystemParams struct {
 
 	Pool string `bson:"pool"`
 	Size uint64 `bson:"size"`
+
+	// Snapshot is the ID of the volume snapshot from which to
+	// create the filesystem's backing volume, if any.
+	Snapshot string `bson:"snapshot,omitempty"`
 }
 
 // FilesystemInfo describes information about a filesystem.
@@ -177,6 +190,50 @@ type FilesystemAttachmentInfo struct {
 	ReadOnly   bool   `bson:"read-only"`
 }
 
+// FilesystemUsage describes the space and inode usage of a mounted
+// filesystem, as reported by the agent of the machine it is attached to.
+// Usage is recorded for filesystem attachments, and for volume attachments
+// whose block device has a filesystem mounted on it.
+type FilesystemUsage struct {
+	// Size is the total size of the filesystem, in bytes.
+	Size uint64 `bson:"size"`
+
+	// Used is the number of bytes used in the filesystem.
+	Used uint64 `bson:"used"`
+
+	// Available is the number of bytes available to
+	// unprivileged users in the filesystem.
+	Available uint64 `bson:"available"`
+
+	// Inodes is the total number of inodes in the filesystem.
+	Inodes uint64 `bson:"inodes"`
+
+	// InodesUsed is the number of inodes used in the filesystem.
+	InodesUsed uint64 `bson:"inodes-used"`
+
+	// InodesFree is the number of inodes free in the filesystem.
+	InodesFree uint64 `bson:"inodes-free"`
+
+	// Updated is the time at which the usage was measured.
+	Updated time.Time `bson:"updated"`
+}
+
+// UsedPercent returns the greater of the percentage of space, and the
+// percentage of inodes, used in the filesystem. Filesystems that do not
+// report inode counts are judged on space alone.
+func (u FilesystemUsage) UsedPercent() int {
+	var percent int
+	if total := u.Used + u.Available; total > 0 {
+		percent = int(u.Used * 100 / total)
+	}
+	if u.Inodes > 0 {
+		if inodePercent := int(u.InodesUsed * 100 / u.Inodes); inodePercent > percent {
+			percent = inodePercent
+		}
+	}
+	return percent
+}
+
 // FilesystemAttachmentParams records parameters for attaching a filesystem to a
 // machine.
 type FilesystemAttachmentParams struct {
@@ -293,6 +350,14 @@ func (f *filesystemAttachment) Params() (FilesystemAttachmentParams, bool) {
 	return *f.doc.Params, true
 }
 
+// Usage is required to implement FilesystemAttachment.
+func (f *filesystemAttachment) Usage() (FilesystemUsage, error) {
+	if f.doc.Usage == nil {
+		return FilesystemUsage{}, errors.NotFoundf("usage of filesystem %q on %q", f.doc.Filesystem, f.doc.Machine)
+	}
+	return *f.doc.Usage, nil
+}
+
 // Filesystem returns the Filesystem with the specified name.
 func (im *IAASModel) Filesystem(tag names.FilesystemTag) (Filesystem, error) {
 	f, err := im.filesystemByTag(tag)
@@ -1010,10 +1075,11 @@ func (im *IAASModel) addFilesystemOps(params FilesystemParams, machineId string)
 			params.filesystemId = filesystemTag.String()
 		}
 		volumeParams := VolumeParams{
-			params.storage,
-			params.volumeInfo,
-			params.Pool,
-			params.Size,
+			storage:    params.storage,
+			volumeInfo: params.volumeInfo,
+			Pool:       params.Pool,
+			Size:       params.Size,
+			Snapshot:   params.Snapshot,
 		}
 		volumeOps, volumeTag, err = im.addVolumeOps(volumeParams, machineId)
 		if err != nil {
@@ -1021,6 +1087,10 @@ func (im *IAASModel) addFilesystemOps(params FilesystemParams, machineId string)
 		}
 		volumeId = volumeTag.Id()
 		ops = append(ops, volumeOps...)
+	} else if params.Snapshot != "" {
+		return nil, names.FilesystemTag{}, names.VolumeTag{}, errors.NotSupportedf(
+			"creating filesystem not backed by a volume from a snapshot",
+		)
 	}
 
 	statusDoc := statusDoc{
@@ -1054,6 +1124,37 @@ func (im *IAASModel) addFilesystemOps(params FilesystemParams, machineId string)
 	return ops, filesystemTag, volumeTag, nil
 }
 
+// addSharedFilesystemOps returns txn.Ops to create a new model-scoped
+// filesystem for shared storage, with the specified parameters. The
+// filesystem is created without any attachments; it is attached to
+// the machines of each unit of the owning application as they are
+// assigned.
+func (im *IAASModel) addSharedFilesystemOps(params FilesystemParams) ([]txn.Op, names.FilesystemTag, error) {
+	var err error
+	params, err = im.filesystemParamsWithDefaults(params, "")
+	if err != nil {
+		return nil, names.FilesystemTag{}, errors.Trace(err)
+	}
+	if _, err := im.validateFilesystemParams(params, ""); err != nil {
+		return nil, names.FilesystemTag{}, errors.Annotate(err, "validating filesystem params")
+	}
+	filesystemId, err := newFilesystemId(im.mb, "")
+	if err != nil {
+		return nil, names.FilesystemTag{}, errors.Annotate(err, "cannot generate filesystem name")
+	}
+	statusDoc := statusDoc{
+		Status:  status.Pending,
+		Updated: im.mb.clock().Now().UnixNano(),
+	}
+	doc := filesystemDoc{
+		FilesystemId: filesystemId,
+		StorageId:    params.storage.Id(),
+		Params:       &params,
+	}
+	ops := im.newFilesystemOps(doc, statusDoc)
+	return ops, names.NewFilesystemTag(filesystemId), nil
+}
+
 func (im *IAASModel) newFilesystemOps(doc filesystemDoc, status statusDoc) []txn.Op {
 	return []txn.Op{
 		createStatusOp(im.mb, filesystemGlobalKey(doc.FilesystemId), status),
@@ -1299,6 +1400,79 @@ func setFilesystemAttachmentInfoOps(
 	}}
 }
 
+// SetFilesystemAttachmentUsage records the usage of the specified
+// filesystem on the specified machine, as measured by the machine agent.
+// The filesystem attachment must be provisioned.
+//
+// The status of the filesystem is then updated to reflect the usage
+// reported for all of its attachments; see storageUsageStatus.
+func (im *IAASModel) SetFilesystemAttachmentUsage(
+	machineTag names.MachineTag,
+	filesystemTag names.FilesystemTag,
+	usage FilesystemUsage,
+) (err error) {
+	defer errors.DeferredAnnotatef(&err, "cannot set usage for filesystem attachment %s:%s", filesystemTag.Id(), machineTag.Id())
+	buildTxn := func(attempt int) ([]txn.Op, error) {
+		fsa, err := im.FilesystemAttachment(machineTag, filesystemTag)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		if fsa.Life() == Dead {
+			return nil, errors.New("filesystem attachment is dead")
+		}
+		if _, err := fsa.Info(); err != nil {
+			return nil, errors.Trace(err)
+		}
+		return []txn.Op{{
+			C:  filesystemAttachmentsC,
+			Id: filesystemAttachmentId(machineTag.Id(), filesystemTag.Id()),
+			Assert: bson.D{
+				{"life", bson.D{{"$ne", Dead}}},
+				{"info", bson.D{{"$exists", true}}},
+			},
+			Update: bson.D{{"$set", bson.D{{"usage", &usage}}}},
+		}}, nil
+	}
+	if err := im.mb.db().Run(buildTxn); err != nil {
+		return errors.Trace(err)
+	}
+	return errors.Trace(im.updateFilesystemUsageStatus(filesystemTag))
+}
+
+// updateFilesystemUsageStatus sets the status of the specified filesystem
+// according to the usage reported for each of its attachments. A shared
+// filesystem is attached to multiple machines, each of which reports the
+// usage of the same filesystem; the status must not depend on which of
+// them reported last.
+func (im *IAASModel) updateFilesystemUsageStatus(tag names.FilesystemTag) error {
+	modelConfig, err := im.ModelConfig()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	attachments, err := im.FilesystemAttachments(tag)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	var usages []FilesystemUsage
+	for _, attachment := range attachments {
+		if attachment.Life() != Alive {
+			continue
+		}
+		if usage, err := attachment.Usage(); err == nil {
+			usages = append(usages, usage)
+		}
+	}
+	current, err := im.FilesystemStatus(tag)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	next, ok := storageUsageStatus(current, usages, modelConfig.StorageUsageWarningThreshold())
+	if !ok {
+		return nil
+	}
+	return errors.Trace(im.SetFilesystemStatus(tag, next.Status, next.Message, nil, nil))
+}
+
 // filesystemMountPoint returns a mount point to use for the given charm
 // storage. For stores with potentially multiple instances, the instance
 // name is appended to the location.
@@ -1453,7 +1627,7 @@ func (im *IAASModel) FilesystemStatus(tag names.FilesystemTag) (status.StatusInf
 func (im *IAASModel) SetFilesystemStatus(tag names.FilesystemTag, fsStatus status.Status, info string, data map[string]interface{}, updated *time.Time) error {
 	switch fsStatus {
 	case status.Attaching, status.Attached, status.Detaching, status.Detached, status.Destroying:
-	case status.Error:
+	case status.Error, status.Warning:
 		if info == "" {
 			return errors.Errorf("cannot set status %q without info", fsStatus)
 		}
@@ -4,6 +4,8 @@
 package state_test
 
 import (
+	"time"
+
 	"github.com/juju/errors"
 	jc "github.com/juju/testing/checkers"
 	gc "gopkg.in/check.v1"
@@ -695,6 +697,68 @@ func (s *FilesystemStateSuite) TestSetFilesystemInfoVolumeAttachmentNotProvision
 	c.Assert(err, gc.ErrorMatches, `cannot set info for filesystem "0": backing volume "0" is not attached`)
 }
 
+func (s *FilesystemStateSuite) TestSetFilesystemAttachmentUsage(c *gc.C) {
+	_, filesystemAttachment, _ := s.addUnitWithFilesystem(c, "rootfs", false)
+	_, err := filesystemAttachment.Usage()
+	c.Assert(err, jc.Satisfies, errors.IsNotFound)
+
+	usage := state.FilesystemUsage{
+		Size:       1024,
+		Used:       768,
+		Available:  256,
+		Inodes:     100,
+		InodesUsed: 10,
+		InodesFree: 90,
+		Updated:    time.Unix(1000, 0).UTC(),
+	}
+	err = s.IAASModel.SetFilesystemAttachmentUsage(
+		filesystemAttachment.Machine(),
+		filesystemAttachment.Filesystem(),
+		usage,
+	)
+	c.Assert(err, jc.ErrorIsNil)
+
+	filesystemAttachment = s.filesystemAttachment(c, filesystemAttachment.Machine(), filesystemAttachment.Filesystem())
+	stored, err := filesystemAttachment.Usage()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(stored.Updated.Equal(usage.Updated), jc.IsTrue)
+	stored.Updated = usage.Updated
+	c.Assert(stored, jc.DeepEquals, usage)
+	c.Assert(stored.UsedPercent(), gc.Equals, 75)
+}
+
+func (s *FilesystemStateSuite) TestSetFilesystemAttachmentUsageNotProvisioned(c *gc.C) {
+	_, filesystemAttachment, _, _ := s.addUnitWithFilesystemUnprovisioned(c, "rootfs", false)
+	err := s.IAASModel.SetFilesystemAttachmentUsage(
+		filesystemAttachment.Machine(),
+		filesystemAttachment.Filesystem(),
+		state.FilesystemUsage{},
+	)
+	c.Assert(err, gc.ErrorMatches, `cannot set usage for filesystem attachment 0/0:0: filesystem attachment "0/0" on "0" not provisioned`)
+}
+
+func (s *FilesystemStateSuite) TestFilesystemUsageUsedPercent(c *gc.C) {
+	for i, test := range []struct {
+		usage   state.FilesystemUsage
+		percent int
+	}{{
+		usage:   state.FilesystemUsage{},
+		percent: 0,
+	}, {
+		usage:   state.FilesystemUsage{Used: 50, Available: 150},
+		percent: 25,
+	}, {
+		usage:   state.FilesystemUsage{Used: 50, Available: 150, Inodes: 10, InodesUsed: 9},
+		percent: 90,
+	}, {
+		usage:   state.FilesystemUsage{Used: 95, Available: 5, Inodes: 10, InodesUsed: 1},
+		percent: 95,
+	}} {
+		c.Logf("test %d: %+v", i, test.usage)
+		c.Check(test.usage.UsedPercent(), gc.Equals, test.percent)
+	}
+}
+
 func (s *FilesystemStateSuite) TestDestroyFilesystem(c *gc.C) {
 	filesystem, _ := s.setupFilesystemAttachment(c, "rootfs")
 	assertDestroy := func() {
@@ -5,11 +5,14 @@ package state
 
 import (
 	"net"
+	"regexp"
 
 	"github.com/juju/errors"
 	"gopkg.in/mgo.v2"
 	"gopkg.in/mgo.v2/bson"
 	"gopkg.in/mgo.v2/txn"
+
+	"github.com/juju/juju/network"
 )
 
 // FirewallRule instances describe the ingress networks
@@ -25,27 +28,81 @@ import (
 // - ssh
 // - juju-controller
 // - juju-application-offer
+//
+// Alternatively, a rule may be given a Name instead of a
+// WellKnownService, in which case it applies to an arbitrary
+// port range and restricts ingress to any ports opened within
+// that range to the whitelisted CIDRs.
 type FirewallRule struct {
 	// WellKnownService is the known service for the firewall rules entity.
 	WellKnownService WellKnownServiceType
 
+	// Name is the name of a rule applying to an arbitrary port range.
+	Name string
+
+	// PortRange is the port range to which a named rule applies.
+	PortRange network.PortRange
+
 	// WhitelistCIDRS is the whitelist CIDRs for the rule.
 	WhitelistCIDRs []string
 }
 
+// Id returns the identifier of the rule, being its name
+// or the well known service to which it applies.
+func (r FirewallRule) Id() string {
+	if r.Name != "" {
+		return r.Name
+	}
+	return string(r.WellKnownService)
+}
+
 type firewallRulesDoc struct {
 	Id               string   `bson:"_id"`
-	WellKnownService string   `bson:"known-service"`
+	WellKnownService string   `bson:"known-service,omitempty"`
+	Name             string   `bson:"name,omitempty"`
+	Protocol         string   `bson:"protocol,omitempty"`
+	FromPort         int      `bson:"from-port,omitempty"`
+	ToPort           int      `bson:"to-port,omitempty"`
 	WhitelistCIDRS   []string `bson:"whitelist-cidrs"`
 }
 
 func (r *firewallRulesDoc) toRule() *FirewallRule {
 	return &FirewallRule{
 		WellKnownService: WellKnownServiceType(r.WellKnownService),
-		WhitelistCIDRs:   r.WhitelistCIDRS,
+		Name:             r.Name,
+		PortRange: network.PortRange{
+			Protocol: r.Protocol,
+			FromPort: r.FromPort,
+			ToPort:   r.ToPort,
+		},
+		WhitelistCIDRs: r.WhitelistCIDRS,
 	}
 }
 
+var validFirewallRuleName = regexp.MustCompile(`^[a-z][a-z0-9]*(-[a-z0-9]+)*$`)
+
+func (r FirewallRule) validate() error {
+	if r.Name == "" {
+		return errors.Trace(r.WellKnownService.validate())
+	}
+	if r.WellKnownService != "" {
+		return errors.NotValidf("firewall rule with both name %q and well known service %q", r.Name, r.WellKnownService)
+	}
+	if !validFirewallRuleName.MatchString(r.Name) {
+		return errors.NotValidf("firewall rule name %q", r.Name)
+	}
+	if err := WellKnownServiceType(r.Name).validate(); err == nil {
+		return errors.NotValidf("firewall rule name %q clashing with well known service", r.Name)
+	}
+	if err := r.PortRange.Validate(); err != nil {
+		return errors.Annotatef(err, "firewall rule %q", r.Name)
+	}
+	if len(r.WhitelistCIDRs) == 0 {
+		return errors.NotValidf("firewall rule %q without whitelist CIDRs", r.Name)
+	}
+	return nil
+}
+
 // FirewallRuler instances provide access to firewall rules in state.
 type FirewallRuler interface {
 	Save(service WellKnownServiceType, whiteListCidrs []string) (FirewallRule, error)
@@ -76,28 +133,45 @@ func (v WellKnownServiceType) validate() error {
 }
 
 type firewallRulesState struct {
-	st *State
+	st         *State
+	collection string
 }
 
-// NewFirewallRules creates a FirewallRule instance backed by a state.
+// NewFirewallRules creates a FirewallRule instance backed by a state,
+// holding the rules for the state's model.
 func NewFirewallRules(st *State) *firewallRulesState {
-	return &firewallRulesState{st: st}
+	return &firewallRulesState{st: st, collection: firewallRulesC}
+}
+
+// NewControllerFirewallRules creates a FirewallRule instance backed by
+// the controller state, holding the named rules set for the controller,
+// which apply to all of its models. They are stored apart from the rules
+// of the controller model, so that those do not apply to other models.
+func NewControllerFirewallRules(st *State) *firewallRulesState {
+	return &firewallRulesState{st: st, collection: controllerFirewallRulesC}
 }
 
 // Save stores the specified firewall rule.
 func (fw *firewallRulesState) Save(rule FirewallRule) error {
-	if err := rule.WellKnownService.validate(); err != nil {
+	if err := rule.validate(); err != nil {
 		return errors.Trace(err)
 	}
+	if fw.collection == controllerFirewallRulesC && rule.Name == "" {
+		return errors.NotValidf("controller firewall rule for well known service %q", rule.WellKnownService)
+	}
 	for _, cidr := range rule.WhitelistCIDRs {
 		if _, _, err := net.ParseCIDR(cidr); err != nil {
 			return errors.NotValidf("CIDR %q", cidr)
 		}
 	}
-	serviceStr := string(rule.WellKnownService)
+	serviceStr := rule.Id()
 	doc := firewallRulesDoc{
 		Id:               serviceStr,
-		WellKnownService: serviceStr,
+		WellKnownService: string(rule.WellKnownService),
+		Name:             rule.Name,
+		Protocol:         rule.PortRange.Protocol,
+		FromPort:         rule.PortRange.FromPort,
+		ToPort:           rule.PortRange.ToPort,
 		WhitelistCIDRS:   rule.WhitelistCIDRs,
 	}
 	buildTxn := func(int) ([]txn.Op, error) {
@@ -109,24 +183,30 @@ func (fw *firewallRulesState) Save(rule FirewallRule) error {
 			return nil, errors.Trace(err)
 		}
 
-		_, err = fw.Rule(rule.WellKnownService)
+		_, err = fw.rule(serviceStr)
 		if err != nil && !errors.IsNotFound(err) {
 			return nil, errors.Trace(err)
 		}
 		var ops []txn.Op
 		if err == nil {
+			update := bson.D{{"whitelist-cidrs", rule.WhitelistCIDRs}}
+			if rule.Name != "" {
+				update = append(update,
+					bson.DocElem{"protocol", doc.Protocol},
+					bson.DocElem{"from-port", doc.FromPort},
+					bson.DocElem{"to-port", doc.ToPort},
+				)
+			}
 			ops = []txn.Op{{
-				C:      firewallRulesC,
+				C:      fw.collection,
 				Id:     serviceStr,
 				Assert: txn.DocExists,
-				Update: bson.D{
-					{"$set", bson.D{{"whitelist-cidrs", rule.WhitelistCIDRs}}},
-				},
+				Update: bson.D{{"$set", update}},
 			}, model.assertActiveOp()}
 		} else {
 			doc.WhitelistCIDRS = rule.WhitelistCIDRs
 			ops = []txn.Op{{
-				C:      firewallRulesC,
+				C:      fw.collection,
 				Id:     doc.Id,
 				Assert: txn.DocMissing,
 				Insert: doc,
@@ -143,13 +223,30 @@ func (fw *firewallRulesState) Save(rule FirewallRule) error {
 
 // Rule returns the firewall rule for the specified service.
 func (fw *firewallRulesState) Rule(service WellKnownServiceType) (*FirewallRule, error) {
-	coll, closer := fw.st.db().GetCollection(firewallRulesC)
+	rule, err := fw.rule(string(service))
+	if errors.IsNotFound(err) {
+		return nil, errors.NotFoundf("firewall rules for service %v", service)
+	}
+	return rule, errors.Trace(err)
+}
+
+// NamedRule returns the firewall rule with the specified name.
+func (fw *firewallRulesState) NamedRule(name string) (*FirewallRule, error) {
+	rule, err := fw.rule(name)
+	if err == nil && rule.Name == "" {
+		err = errors.NotFoundf("firewall rule %q", name)
+	}
+	return rule, errors.Trace(err)
+}
+
+func (fw *firewallRulesState) rule(id string) (*FirewallRule, error) {
+	coll, closer := fw.st.db().GetCollection(fw.collection)
 	defer closer()
 
 	var doc firewallRulesDoc
-	err := coll.FindId(string(service)).One(&doc)
+	err := coll.FindId(id).One(&doc)
 	if err == mgo.ErrNotFound {
-		return nil, errors.NotFoundf("firewall rules for service %v", service)
+		return nil, errors.NotFoundf("firewall rule %q", id)
 	}
 	if err != nil {
 		return nil, errors.Trace(err)
@@ -157,9 +254,58 @@ func (fw *firewallRulesState) Rule(service WellKnownServiceType) (*FirewallRule,
 	return doc.toRule(), nil
 }
 
+// Remove removes the firewall rule with the specified name. The rules
+// for well known services cannot be removed, and removing a rule which
+// does not exist returns an error satisfying errors.IsNotFound.
+func (fw *firewallRulesState) Remove(name string) error {
+	buildTxn := func(int) ([]txn.Op, error) {
+		rule, err := fw.rule(name)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		if rule.Name == "" {
+			return nil, errors.NotValidf("removing firewall rule for well known service %q", name)
+		}
+		return []txn.Op{{
+			C:      fw.collection,
+			Id:     name,
+			Assert: bson.D{{"known-service", bson.D{{"$exists", false}}}},
+			Remove: true,
+		}}, nil
+	}
+	err := fw.st.db().Run(buildTxn)
+	return errors.Annotatef(err, "cannot remove firewall rule %q", name)
+}
+
+// NamedRules returns all the named firewall rules, which apply
+// to arbitrary port ranges.
+func (fw *firewallRulesState) NamedRules() ([]*FirewallRule, error) {
+	rules, err := fw.AllRules()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	var result []*FirewallRule
+	for _, rule := range rules {
+		if rule.Name != "" {
+			result = append(result, rule)
+		}
+	}
+	return result, nil
+}
+
+// Watch returns a NotifyWatcher which notifies when any
+// firewall rule in the model, or for the controller, is
+// created, changed or removed.
+func (fw *firewallRulesState) Watch() NotifyWatcher {
+	if fw.collection == controllerFirewallRulesC {
+		return newNotifyCollWatcher(fw.st, fw.collection, nil)
+	}
+	return newNotifyCollWatcher(fw.st, fw.collection, isLocalID(fw.st))
+}
+
 // AllRules returns all the firewall rules.
 func (fw *firewallRulesState) AllRules() ([]*FirewallRule, error) {
-	coll, closer := fw.st.db().GetCollection(firewallRulesC)
+	coll, closer := fw.st.db().GetCollection(fw.collection)
 	defer closer()
 
 	var docs []firewallRulesDoc
@@ -11,7 +11,9 @@ import (
 	gc "gopkg.in/check.v1"
 	"gopkg.in/mgo.v2/bson"
 
+	"github.com/juju/juju/network"
 	"github.com/juju/juju/state"
+	statetesting "github.com/juju/juju/state/testing"
 )
 
 type FirewallRulesSuite struct {
@@ -132,3 +134,209 @@ func (s *FirewallRulesSuite) TestUpdate(c *gc.C) {
 	c.Assert(err, jc.ErrorIsNil)
 	s.assertSavedRules(c, state.JujuApplicationOfferRule, []string{"192.168.2.0/16"})
 }
+
+func (s *FirewallRulesSuite) TestSaveNamedRule(c *gc.C) {
+	rules := state.NewFirewallRules(s.State)
+	err := rules.Save(state.FirewallRule{
+		Name:           "prometheus",
+		PortRange:      network.PortRange{FromPort: 9100, ToPort: 9110, Protocol: "tcp"},
+		WhitelistCIDRs: []string{"10.0.5.0/24"},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	result, err := rules.NamedRule("prometheus")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(*result, jc.DeepEquals, state.FirewallRule{
+		Name:           "prometheus",
+		PortRange:      network.PortRange{FromPort: 9100, ToPort: 9110, Protocol: "tcp"},
+		WhitelistCIDRs: []string{"10.0.5.0/24"},
+	})
+}
+
+func (s *FirewallRulesSuite) TestUpdateNamedRule(c *gc.C) {
+	rules := state.NewFirewallRules(s.State)
+	err := rules.Save(state.FirewallRule{
+		Name:           "prometheus",
+		PortRange:      network.PortRange{FromPort: 9100, ToPort: 9100, Protocol: "tcp"},
+		WhitelistCIDRs: []string{"10.0.5.0/24"},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	err = rules.Save(state.FirewallRule{
+		Name:           "prometheus",
+		PortRange:      network.PortRange{FromPort: 9100, ToPort: 9200, Protocol: "tcp"},
+		WhitelistCIDRs: []string{"10.0.6.0/24"},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	result, err := rules.NamedRule("prometheus")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.PortRange, jc.DeepEquals, network.PortRange{FromPort: 9100, ToPort: 9200, Protocol: "tcp"})
+	c.Assert(result.WhitelistCIDRs, jc.DeepEquals, []string{"10.0.6.0/24"})
+}
+
+func (s *FirewallRulesSuite) TestSaveNamedRuleInvalid(c *gc.C) {
+	rules := state.NewFirewallRules(s.State)
+	for i, t := range []struct {
+		rule   state.FirewallRule
+		expect string
+	}{{
+		rule: state.FirewallRule{
+			Name:           "Bad_Name",
+			PortRange:      network.PortRange{FromPort: 80, ToPort: 80, Protocol: "tcp"},
+			WhitelistCIDRs: []string{"10.0.5.0/24"},
+		},
+		expect: `firewall rule name "Bad_Name" not valid`,
+	}, {
+		rule: state.FirewallRule{
+			Name:           "ssh",
+			PortRange:      network.PortRange{FromPort: 22, ToPort: 22, Protocol: "tcp"},
+			WhitelistCIDRs: []string{"10.0.5.0/24"},
+		},
+		expect: `firewall rule name "ssh" clashing with well known service not valid`,
+	}, {
+		rule: state.FirewallRule{
+			Name:           "web",
+			PortRange:      network.PortRange{FromPort: 90, ToPort: 80, Protocol: "tcp"},
+			WhitelistCIDRs: []string{"10.0.5.0/24"},
+		},
+		expect: `firewall rule "web": .*`,
+	}, {
+		rule: state.FirewallRule{
+			Name:      "web",
+			PortRange: network.PortRange{FromPort: 80, ToPort: 80, Protocol: "tcp"},
+		},
+		expect: `firewall rule "web" without whitelist CIDRs not valid`,
+	}} {
+		c.Logf("test %d", i)
+		err := rules.Save(t.rule)
+		c.Check(err, gc.ErrorMatches, t.expect)
+	}
+}
+
+func (s *FirewallRulesSuite) TestRemoveNamedRule(c *gc.C) {
+	rules := state.NewFirewallRules(s.State)
+	err := rules.Save(state.FirewallRule{
+		Name:           "prometheus",
+		PortRange:      network.PortRange{FromPort: 9100, ToPort: 9100, Protocol: "tcp"},
+		WhitelistCIDRs: []string{"10.0.5.0/24"},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	err = rules.Remove("prometheus")
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = rules.NamedRule("prometheus")
+	c.Assert(err, jc.Satisfies, errors.IsNotFound)
+
+	err = rules.Remove("prometheus")
+	c.Assert(err, jc.Satisfies, errors.IsNotFound)
+	c.Assert(err, gc.ErrorMatches, `cannot remove firewall rule "prometheus": firewall rule "prometheus" not found`)
+}
+
+func (s *FirewallRulesSuite) TestRemoveWellKnownServiceRule(c *gc.C) {
+	rules := state.NewFirewallRules(s.State)
+	err := rules.Save(state.FirewallRule{
+		WellKnownService: state.SSHRule,
+		WhitelistCIDRs:   []string{"192.168.1.0/16"},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	err = rules.Remove("ssh")
+	c.Assert(err, gc.ErrorMatches, `cannot remove firewall rule "ssh": removing firewall rule for well known service "ssh" not valid`)
+	_, err = rules.Rule(state.SSHRule)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *FirewallRulesSuite) TestNamedRules(c *gc.C) {
+	rules := state.NewFirewallRules(s.State)
+	err := rules.Save(state.FirewallRule{
+		WellKnownService: state.SSHRule,
+		WhitelistCIDRs:   []string{"192.168.1.0/16"},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	err = rules.Save(state.FirewallRule{
+		Name:           "prometheus",
+		PortRange:      network.PortRange{FromPort: 9100, ToPort: 9100, Protocol: "tcp"},
+		WhitelistCIDRs: []string{"10.0.5.0/24"},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	result, err := rules.NamedRules()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result, gc.HasLen, 1)
+	c.Assert(result[0].Name, gc.Equals, "prometheus")
+
+	_, err = rules.NamedRule("ssh")
+	c.Assert(err, jc.Satisfies, errors.IsNotFound)
+}
+
+func (s *FirewallRulesSuite) TestControllerRules(c *gc.C) {
+	controllerRules := state.NewControllerFirewallRules(s.State)
+	err := controllerRules.Save(state.FirewallRule{
+		Name:           "prometheus",
+		PortRange:      network.PortRange{FromPort: 9100, ToPort: 9100, Protocol: "tcp"},
+		WhitelistCIDRs: []string{"10.0.5.0/24"},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	result, err := controllerRules.NamedRules()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result, gc.HasLen, 1)
+	c.Assert(result[0].Name, gc.Equals, "prometheus")
+
+	// The controller rules are kept apart from those of the
+	// controller model.
+	result, err = state.NewFirewallRules(s.State).AllRules()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result, gc.HasLen, 0)
+
+	err = controllerRules.Save(state.FirewallRule{
+		WellKnownService: state.SSHRule,
+		WhitelistCIDRs:   []string{"192.168.1.0/16"},
+	})
+	c.Assert(err, gc.ErrorMatches, `controller firewall rule for well known service "ssh" not valid`)
+
+	err = controllerRules.Remove("prometheus")
+	c.Assert(err, jc.ErrorIsNil)
+	result, err = controllerRules.NamedRules()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result, gc.HasLen, 0)
+}
+
+func (s *FirewallRulesSuite) TestWatchControllerRules(c *gc.C) {
+	rules := state.NewControllerFirewallRules(s.State)
+	w := rules.Watch()
+	defer statetesting.AssertStop(c, w)
+	wc := statetesting.NewNotifyWatcherC(c, s.State, w)
+	wc.AssertOneChange()
+
+	err := rules.Save(state.FirewallRule{
+		Name:           "prometheus",
+		PortRange:      network.PortRange{FromPort: 9100, ToPort: 9100, Protocol: "tcp"},
+		WhitelistCIDRs: []string{"10.0.5.0/24"},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	wc.AssertOneChange()
+
+	// Changes to the controller model's rules are not reported.
+	err = state.NewFirewallRules(s.State).Save(state.FirewallRule{
+		Name:           "node-exporter",
+		PortRange:      network.PortRange{FromPort: 9101, ToPort: 9101, Protocol: "tcp"},
+		WhitelistCIDRs: []string{"10.0.5.0/24"},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	wc.AssertNoChange()
+}
+
+func (s *FirewallRulesSuite) TestWatch(c *gc.C) {
+	rules := state.NewFirewallRules(s.State)
+	w := rules.Watch()
+	defer statetesting.AssertStop(c, w)
+	wc := statetesting.NewNotifyWatcherC(c, s.State, w)
+	wc.AssertOneChange()
+
+	err := rules.Save(state.FirewallRule{
+		Name:           "prometheus",
+		PortRange:      network.PortRange{FromPort: 9100, ToPort: 9100, Protocol: "tcp"},
+		WhitelistCIDRs: []string{"10.0.5.0/24"},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	wc.AssertOneChange()
+
+	err = rules.Remove("prometheus")
+	c.Assert(err, jc.ErrorIsNil)
+	wc.AssertOneChange()
+}
@@ -0,0 +1,140 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/loggo"
+	"gopkg.in/mgo.v2/bson"
+)
+
+// HookHistoryEntry describes an operation completed by a unit's uniter,
+// such as the running of a hook or an action.
+type HookHistoryEntry struct {
+	// Operation describes the operation, as reported by the uniter.
+	Operation string
+
+	// Hook is the kind of hook run by the operation, if any.
+	Hook string
+
+	// Relation is the key of the relation for a relation hook.
+	Relation string
+
+	// RemoteUnit is the name of the remote unit for a relation hook,
+	// if any.
+	RemoteUnit string
+
+	// Started and Completed record when the operation ran.
+	Started   time.Time
+	Completed time.Time
+
+	// Result summarises the outcome of the operation.
+	Result string
+
+	// Error holds the error with which the operation failed, if any.
+	Error string
+}
+
+type hookHistoryDoc struct {
+	ModelUUID  string `bson:"model-uuid"`
+	GlobalKey  string `bson:"globalkey"`
+	Operation  string `bson:"operation"`
+	Hook       string `bson:"hook,omitempty"`
+	Relation   string `bson:"relation,omitempty"`
+	RemoteUnit string `bson:"remote-unit,omitempty"`
+	Started    int64  `bson:"started"`
+	Completed  int64  `bson:"completed"`
+	Result     string `bson:"result"`
+	Error      string `bson:"error,omitempty"`
+}
+
+// AddHookHistory records an operation completed by the unit's uniter.
+func (u *Unit) AddHookHistory(entry HookHistoryEntry) error {
+	history, closer := u.st.db().GetCollection(hookHistoryC)
+	defer closer()
+
+	doc := &hookHistoryDoc{
+		GlobalKey:  u.globalKey(),
+		Operation:  entry.Operation,
+		Hook:       entry.Hook,
+		Relation:   entry.Relation,
+		RemoteUnit: entry.RemoteUnit,
+		Started:    entry.Started.UnixNano(),
+		Completed:  entry.Completed.UnixNano(),
+		Result:     entry.Result,
+		Error:      entry.Error,
+	}
+	if err := history.Writeable().Insert(doc); err != nil {
+		return errors.Annotatef(err, "cannot add hook history for unit %q", u)
+	}
+	return nil
+}
+
+// HookHistory returns the operations most recently completed by the
+// unit's uniter, newest first. If size is positive, at most that many
+// entries are returned.
+func (u *Unit) HookHistory(size int) ([]HookHistoryEntry, error) {
+	history, closer := u.st.db().GetCollection(hookHistoryC)
+	defer closer()
+
+	query := history.Find(bson.D{{globalKeyField, u.globalKey()}}).Sort("-completed")
+	if size > 0 {
+		query = query.Limit(size)
+	}
+	var docs []hookHistoryDoc
+	if err := query.All(&docs); err != nil {
+		return nil, errors.Annotatef(err, "cannot get hook history for unit %q", u)
+	}
+	entries := make([]HookHistoryEntry, len(docs))
+	for i, doc := range docs {
+		entries[i] = HookHistoryEntry{
+			Operation:  doc.Operation,
+			Hook:       doc.Hook,
+			Relation:   doc.Relation,
+			RemoteUnit: doc.RemoteUnit,
+			Started:    time.Unix(0, doc.Started).UTC(),
+			Completed:  time.Unix(0, doc.Completed).UTC(),
+			Result:     doc.Result,
+			Error:      doc.Error,
+		}
+	}
+	return entries, nil
+}
+
+// eraseHookHistory removes all hook history documents for the
+// given global key, in batches, as eraseStatusHistory does.
+func eraseHookHistory(mb modelBackend, globalKey string) error {
+	history, closer := mb.db().GetCollection(hookHistoryC)
+	defer closer()
+
+	iter := history.Find(bson.D{{
+		globalKeyField, globalKey,
+	}}).Select(bson.M{"_id": 1}).Iter()
+	defer iter.Close()
+
+	logFormat := "deleted %d hook history documents for " + fmt.Sprintf("%q", globalKey)
+	deleted, err := deleteInBatches(
+		history.Writeable().Underlying(), iter,
+		logFormat, loggo.DEBUG,
+		noEarlyFinish,
+	)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if deleted > 0 {
+		logger.Debugf(logFormat, deleted)
+	}
+	return nil
+}
+
+// PruneHookHistory removes hook history entries until only the ones
+// newer than now - maxHistoryTime remain, and the history is smaller
+// than maxHistoryMB.
+func PruneHookHistory(st *State, maxHistoryTime time.Duration, maxHistoryMB int) error {
+	err := pruneCollection(st, maxHistoryTime, maxHistoryMB, hookHistoryC, "completed", NanoSeconds)
+	return errors.Trace(err)
+}
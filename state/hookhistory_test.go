@@ -0,0 +1,113 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state_test
+
+import (
+	"time"
+
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/state"
+	"github.com/juju/juju/testing/factory"
+)
+
+type HookHistorySuite struct {
+	ConnSuite
+	unit *state.Unit
+}
+
+var _ = gc.Suite(&HookHistorySuite{})
+
+func (s *HookHistorySuite) SetUpTest(c *gc.C) {
+	s.ConnSuite.SetUpTest(c)
+	s.unit = s.Factory.MakeUnit(c, nil)
+}
+
+func (s *HookHistorySuite) addEntries(c *gc.C, unit *state.Unit, count int, completed time.Time) []state.HookHistoryEntry {
+	entries := make([]state.HookHistoryEntry, count)
+	for i := range entries {
+		entry := state.HookHistoryEntry{
+			Operation: "run update-status hook",
+			Hook:      "update-status",
+			Started:   completed.Add(time.Duration(i)*time.Minute - time.Second).UTC(),
+			Completed: completed.Add(time.Duration(i) * time.Minute).UTC(),
+			Result:    "completed",
+		}
+		err := unit.AddHookHistory(entry)
+		c.Assert(err, jc.ErrorIsNil)
+		entries[i] = entry
+	}
+	return entries
+}
+
+func (s *HookHistorySuite) TestHookHistoryEmpty(c *gc.C) {
+	entries, err := s.unit.HookHistory(0)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(entries, gc.HasLen, 0)
+}
+
+func (s *HookHistorySuite) TestAddHookHistory(c *gc.C) {
+	started := time.Date(2018, 5, 1, 12, 30, 15, 0, time.UTC)
+	entry := state.HookHistoryEntry{
+		Operation:  "run relation-changed (1; mysql/0) hook",
+		Hook:       "db-relation-changed",
+		Relation:   "wordpress:db mysql:server",
+		RemoteUnit: "mysql/0",
+		Started:    started,
+		Completed:  started.Add(3 * time.Second),
+		Result:     "failed",
+		Error:      "exit status 1",
+	}
+	err := s.unit.AddHookHistory(entry)
+	c.Assert(err, jc.ErrorIsNil)
+
+	entries, err := s.unit.HookHistory(0)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(entries, jc.DeepEquals, []state.HookHistoryEntry{entry})
+}
+
+func (s *HookHistorySuite) TestHookHistoryNewestFirst(c *gc.C) {
+	added := s.addEntries(c, s.unit, 5, s.Clock.Now())
+	app, err := s.unit.Application()
+	c.Assert(err, jc.ErrorIsNil)
+	other := s.Factory.MakeUnit(c, &factory.UnitParams{Application: app})
+	s.addEntries(c, other, 3, s.Clock.Now())
+
+	entries, err := s.unit.HookHistory(0)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(entries, jc.DeepEquals, []state.HookHistoryEntry{
+		added[4], added[3], added[2], added[1], added[0],
+	})
+
+	entries, err = s.unit.HookHistory(2)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(entries, jc.DeepEquals, []state.HookHistoryEntry{added[4], added[3]})
+}
+
+func (s *HookHistorySuite) TestPruneHookHistoryByDate(c *gc.C) {
+	now := s.Clock.Now()
+	s.addEntries(c, s.unit, 5, now.Add(-48*time.Hour))
+	recent := s.addEntries(c, s.unit, 3, now.Add(-time.Hour))
+
+	err := state.PruneHookHistory(s.State, 24*time.Hour, 1024)
+	c.Assert(err, jc.ErrorIsNil)
+
+	entries, err := s.unit.HookHistory(0)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(entries, jc.DeepEquals, []state.HookHistoryEntry{
+		recent[2], recent[1], recent[0],
+	})
+}
+
+func (s *HookHistorySuite) TestHookHistoryErasedWhenUnitDestroyed(c *gc.C) {
+	s.addEntries(c, s.unit, 3, s.Clock.Now())
+
+	err := s.unit.Destroy()
+	c.Assert(err, jc.ErrorIsNil)
+
+	entries, err := s.unit.HookHistory(0)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(entries, gc.HasLen, 0)
+}
@@ -143,6 +143,11 @@ type ActionReceiver interface {
 	// ActionReceiver.
 	AddAction(name string, payload map[string]interface{}) (Action, error)
 
+	// AddActionWithTimeout queues an action with the given name and
+	// payload for this ActionReceiver, which is killed if it runs for
+	// longer than timeout. A zero timeout means no limit.
+	AddActionWithTimeout(name string, payload map[string]interface{}, timeout time.Duration) (Action, error)
+
 	// CancelAction removes a pending Action from the queue for this
 	// ActionReceiver and marks it as cancelled.
 	CancelAction(action Action) (Action, error)
@@ -194,6 +199,10 @@ type Action interface {
 	// definition of the Action.
 	Parameters() map[string]interface{}
 
+	// Timeout returns how long the action may run before it is killed,
+	// or zero if there is no limit.
+	Timeout() time.Duration
+
 	// Enqueued returns the time the action was added to state as a pending
 	// Action.
 	Enqueued() time.Time
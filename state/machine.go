@@ -1924,6 +1924,11 @@ func (m *Machine) VolumeAttachments() ([]VolumeAttachment, error) {
 
 // AddAction is part of the ActionReceiver interface.
 func (m *Machine) AddAction(name string, payload map[string]interface{}) (Action, error) {
+	return m.AddActionWithTimeout(name, payload, 0)
+}
+
+// AddActionWithTimeout is part of the ActionReceiver interface.
+func (m *Machine) AddActionWithTimeout(name string, payload map[string]interface{}, timeout time.Duration) (Action, error) {
 	spec, ok := actions.PredefinedActionsSpec[name]
 	if !ok {
 		return nil, errors.Errorf("cannot add action %q to a machine; only predefined actions allowed", name)
@@ -1944,7 +1949,7 @@ func (m *Machine) AddAction(name string, payload map[string]interface{}) (Action
 		return nil, errors.Trace(err)
 	}
 
-	return model.EnqueueAction(m.Tag(), name, payloadWithDefaults)
+	return model.EnqueueActionWithTimeout(m.Tag(), name, payloadWithDefaults, timeout)
 }
 
 // CancelAction is part of the ActionReceiver interface.
@@ -820,11 +820,30 @@ func (e *exporter) addApplication(ctx addApplicationContext) error {
 			return errors.Trace(err)
 		}
 		exUnit.SetConstraints(constraintsArgs)
+
+		// The model description has no place for sub-workloads, so
+		// they are not migrated; the charm must report them again.
+		e.dropSubWorkloads(unit)
 	}
 
 	return nil
 }
 
+func (e *exporter) dropSubWorkloads(unit *Unit) {
+	prefix := subWorkloadsGlobalKeyPrefix(unit.Name())
+	for key := range e.status {
+		if strings.HasPrefix(key, prefix) {
+			e.logger.Warningf("not exporting sub-workload %q of unit %q", strings.TrimPrefix(key, prefix), unit.Name())
+			delete(e.status, key)
+		}
+	}
+	for key := range e.statusHistory {
+		if strings.HasPrefix(key, prefix) {
+			delete(e.statusHistory, key)
+		}
+	}
+}
+
 func (e *exporter) unitWorkloadVersion(unit *Unit) (string, error) {
 	// Rather than call unit.WorkloadVersion(), which does a database
 	// query, we go directly to the status value that is stored.
@@ -967,6 +986,14 @@ func (e *exporter) relations() error {
 				Limit:           ep.Limit,
 				Scope:           string(ep.Scope),
 			})
+			// The model description has no place for the settings an
+			// application publishes in a relation, so they are not
+			// migrated; its leader must publish them again.
+			appSettingsKey := relationApplicationSettingsKey(relation.Id(), ep.ApplicationName)
+			if _, found := e.modelSettings[appSettingsKey]; found {
+				e.logger.Warningf("not exporting settings of application %q in relation %q", ep.ApplicationName, relation)
+				delete(e.modelSettings, appSettingsKey)
+			}
 			// We expect a relationScope and settings for each of the
 			// units of the specified application, unless it is a
 			// remote application.
@@ -1171,6 +1198,11 @@ func (e *exporter) actions() error {
 	}
 	e.logger.Debugf("read %d actions", len(actions))
 	for _, action := range actions {
+		// The model description has no place for action timeouts,
+		// so pending actions run without one after migration.
+		if timeout := action.Timeout(); timeout != 0 {
+			e.logger.Warningf("not exporting timeout %v of action %q", timeout, action.Id())
+		}
 		results, message := action.Results()
 		e.model.AddAction(description.ActionArgs{
 			Receiver:   action.Receiver(),
@@ -1710,6 +1742,9 @@ func (e *exporter) storage() error {
 	if err := e.volumes(); err != nil {
 		return errors.Trace(err)
 	}
+	if err := e.volumeSnapshots(); err != nil {
+		return errors.Trace(err)
+	}
 	if err := e.filesystems(); err != nil {
 		return errors.Trace(err)
 	}
@@ -1778,6 +1813,9 @@ func (e *exporter) addVolume(vol *volume, volAttachments []volumeAttachmentDoc)
 		logger.Debugf("  params %#v", params)
 		args.Size = params.Size
 		args.Pool = params.Pool
+		if params.Snapshot != "" {
+			e.logger.Warningf("volume %s will be created empty rather than from snapshot %q", vol.doc.Name, params.Snapshot)
+		}
 	}
 
 	globalKey := vol.globalKey()
@@ -1816,6 +1854,24 @@ func (e *exporter) addVolume(vol *volume, volAttachments []volumeAttachmentDoc)
 	return nil
 }
 
+func (e *exporter) volumeSnapshots() error {
+	coll, closer := e.st.db().GetCollection(volumeSnapshotsC)
+	defer closer()
+
+	var doc volumeSnapshotDoc
+	iter := coll.Find(nil).Sort("_id").Iter()
+	defer iter.Close()
+	for iter.Next(&doc) {
+		// The model description has no place for volume snapshots,
+		// so they are not migrated.
+		e.logger.Warningf("not exporting snapshot %q of volume %s", doc.Id, doc.Volume)
+	}
+	if err := iter.Close(); err != nil {
+		return errors.Annotate(err, "failed to read volume snapshots")
+	}
+	return nil
+}
+
 func (e *exporter) readVolumeAttachments() (map[string][]volumeAttachmentDoc, error) {
 	coll, closer := e.st.db().GetCollection(volumeAttachmentsC)
 	defer closer()
@@ -1886,6 +1942,9 @@ func (e *exporter) addFilesystem(fs *filesystem, fsAttachments []filesystemAttac
 		logger.Debugf("  params %#v", params)
 		args.Size = params.Size
 		args.Pool = params.Pool
+		if params.Snapshot != "" {
+			e.logger.Warningf("filesystem %s will be created empty rather than from snapshot %q", fs.doc.FilesystemId, params.Snapshot)
+		}
 	}
 
 	globalKey := fs.globalKey()
@@ -489,6 +489,24 @@ func (s *MigrationExportSuite) TestUnits(c *gc.C) {
 	s.assertMigrateUnits(c, s.State)
 }
 
+func (s *MigrationExportSuite) TestUnitSubWorkloads(c *gc.C) {
+	unit := s.Factory.MakeUnit(c, nil)
+	err := unit.SetSubWorkload("telegraf", "1.7.2", status.StatusInfo{
+		Status:  status.Active,
+		Message: "shipping metrics",
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	err = unit.SetSubWorkload("filebeat", "", status.StatusInfo{Status: status.Maintenance})
+	c.Assert(err, jc.ErrorIsNil)
+
+	// The sub-workloads are dropped, but do not stop the export.
+	model, err := s.State.Export()
+	c.Assert(err, jc.ErrorIsNil)
+	exUnits := model.Applications()[0].Units()
+	c.Assert(exUnits, gc.HasLen, 1)
+	c.Check(exUnits[0].Name(), gc.Equals, unit.Name())
+}
+
 func (s *MigrationExportSuite) TestCAASUnits(c *gc.C) {
 	caasSt := s.Factory.MakeModel(c, &factory.ModelParams{
 		Name: "caas-model",
@@ -711,6 +729,24 @@ func (s *MigrationExportSuite) TestRelations(c *gc.C) {
 	c.Check(status.Value(), gc.Equals, "joining")
 }
 
+func (s *MigrationExportSuite) TestRelationApplicationSettings(c *gc.C) {
+	state.AddTestingApplication(c, s.State, "wordpress", state.AddTestingCharm(c, s.State, "wordpress"))
+	state.AddTestingApplication(c, s.State, "mysql", state.AddTestingCharm(c, s.State, "mysql"))
+	eps, err := s.State.InferEndpoints("mysql", "wordpress")
+	c.Assert(err, jc.ErrorIsNil)
+	rel, err := s.State.AddRelation(eps...)
+	c.Assert(err, jc.ErrorIsNil)
+	err = rel.UpdateApplicationSettings("mysql", &fakeToken{}, map[string]string{"host": "10.0.0.1"})
+	c.Assert(err, jc.ErrorIsNil)
+
+	// The application settings are dropped, but do not stop the export.
+	model, err := s.State.Export()
+	c.Assert(err, jc.ErrorIsNil)
+	rels := model.Relations()
+	c.Assert(rels, gc.HasLen, 1)
+	c.Check(rels[0].Endpoints(), gc.HasLen, 2)
+}
+
 func (s *MigrationExportSuite) TestSubordinateRelations(c *gc.C) {
 	wordpress := state.AddTestingApplication(c, s.State, "wordpress", state.AddTestingCharm(c, s.State, "wordpress"))
 	mysql := state.AddTestingApplication(c, s.State, "mysql", state.AddTestingCharm(c, s.State, "mysql"))
@@ -1063,6 +1099,21 @@ func (s *MigrationExportSuite) TestActions(c *gc.C) {
 	c.Check(action.Message(), gc.Equals, "")
 }
 
+func (s *MigrationExportSuite) TestActionTimeout(c *gc.C) {
+	machine := s.Factory.MakeMachine(c, nil)
+	m, err := s.State.Model()
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = m.EnqueueActionWithTimeout(machine.MachineTag(), "foo", nil, 5*time.Minute)
+	c.Assert(err, jc.ErrorIsNil)
+
+	// The timeout is dropped, but the action is still exported.
+	model, err := s.State.Export()
+	c.Assert(err, jc.ErrorIsNil)
+	actions := model.Actions()
+	c.Assert(actions, gc.HasLen, 1)
+	c.Check(actions[0].Name(), gc.Equals, "foo")
+}
+
 func (s *MigrationExportSuite) TestActionsSkipped(c *gc.C) {
 	machine := s.Factory.MakeMachine(c, &factory.MachineParams{
 		Constraints: constraints.MustParse("arch=amd64 mem=8G"),
@@ -1166,6 +1217,29 @@ func (s *MigrationExportSuite) TestVolumes(c *gc.C) {
 	c.Check(status.Value(), gc.Equals, "pending")
 }
 
+func (s *MigrationExportSuite) TestVolumeSnapshotsNotExported(c *gc.C) {
+	s.Factory.MakeMachine(c, &factory.MachineParams{
+		Volumes: []state.MachineVolumeParams{{
+			Volume: state.VolumeParams{Size: 1234},
+		}},
+	})
+	volTag := names.NewVolumeTag("0/0")
+	err := s.IAASModel.SetVolumeInfo(volTag, state.VolumeInfo{Size: 1500, VolumeId: "volume id"})
+	c.Assert(err, jc.ErrorIsNil)
+	snapshot, err := s.IAASModel.SnapshotVolume(volTag)
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.IAASModel.SetVolumeSnapshotInfo(snapshot.Id(), state.VolumeSnapshotInfo{
+		SnapshotId: "snap-0",
+		Size:       1500,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	// The snapshot is dropped, but does not stop the export.
+	model, err := s.State.Export()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(model.Volumes(), gc.HasLen, 1)
+}
+
 func (s *MigrationExportSuite) TestFilesystems(c *gc.C) {
 	machine := s.Factory.MakeMachine(c, &factory.MachineParams{
 		Filesystems: []state.MachineFilesystemParams{{
@@ -689,6 +689,30 @@ func (s *MigrationImportSuite) TestUnits(c *gc.C) {
 	s.assertUnitsMigrated(c, s.State, constraints.MustParse("arch=amd64 mem=8G"))
 }
 
+func (s *MigrationImportSuite) TestUnitSubWorkloads(c *gc.C) {
+	unit := s.Factory.MakeUnit(c, nil)
+	err := unit.SetSubWorkload("telegraf", "1.7.2", status.StatusInfo{
+		Status:  status.Active,
+		Message: "shipping metrics",
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	err = unit.SetSubWorkload("telegraf", "", status.StatusInfo{Status: status.Blocked})
+	c.Assert(err, jc.ErrorIsNil)
+
+	_, newSt := s.importModel(c, s.State)
+
+	// The sub-workloads are not migrated; the charm must report
+	// them again.
+	newUnit, err := newSt.Unit(unit.Name())
+	c.Assert(err, jc.ErrorIsNil)
+	subWorkloads, err := newUnit.SubWorkloads()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(subWorkloads, gc.HasLen, 0)
+	history, err := newUnit.SubWorkloadStatusHistory("telegraf", status.StatusHistoryFilter{Size: 10})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(history, gc.HasLen, 0)
+}
+
 func (s *MigrationImportSuite) TestCAASUnits(c *gc.C) {
 	caasSt := s.Factory.MakeModel(c, &factory.ModelParams{
 		Name: "caas-model",
@@ -831,6 +855,29 @@ func (s *MigrationImportSuite) TestRelations(c *gc.C) {
 	c.Assert(settings.Map(), gc.DeepEquals, relSettings)
 }
 
+func (s *MigrationImportSuite) TestRelationApplicationSettings(c *gc.C) {
+	state.AddTestingApplication(c, s.State, "wordpress", state.AddTestingCharm(c, s.State, "wordpress"))
+	state.AddTestingApplication(c, s.State, "mysql", state.AddTestingCharm(c, s.State, "mysql"))
+	eps, err := s.State.InferEndpoints("mysql", "wordpress")
+	c.Assert(err, jc.ErrorIsNil)
+	rel, err := s.State.AddRelation(eps...)
+	c.Assert(err, jc.ErrorIsNil)
+	err = rel.UpdateApplicationSettings("mysql", &fakeToken{}, map[string]string{"host": "10.0.0.1"})
+	c.Assert(err, jc.ErrorIsNil)
+
+	_, newSt := s.importModel(c, s.State)
+
+	newRel, err := newSt.Relation(rel.Id())
+	c.Assert(err, jc.ErrorIsNil)
+	// The settings are not migrated; the leader must publish them again.
+	settings, err := newRel.ApplicationSettings("mysql")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(settings, gc.HasLen, 0)
+	settings, err = newRel.ApplicationSettings("wordpress")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(settings, gc.HasLen, 0)
+}
+
 func (s *MigrationImportSuite) assertRelationsMissingStatus(c *gc.C, hasUnits bool) {
 	wordpress := state.AddTestingApplication(c, s.State, "wordpress", state.AddTestingCharm(c, s.State, "wordpress"))
 	state.AddTestingApplication(c, s.State, "mysql", state.AddTestingCharm(c, s.State, "mysql"))
@@ -1243,6 +1290,25 @@ func (s *MigrationImportSuite) TestAction(c *gc.C) {
 	c.Check(action.Status(), gc.Equals, state.ActionPending)
 }
 
+func (s *MigrationImportSuite) TestActionTimeout(c *gc.C) {
+	machine := s.Factory.MakeMachine(c, nil)
+	m, err := s.State.Model()
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = m.EnqueueActionWithTimeout(machine.MachineTag(), "foo", nil, 5*time.Minute)
+	c.Assert(err, jc.ErrorIsNil)
+
+	newModel, newState := s.importModel(c, s.State)
+	defer func() {
+		c.Assert(newState.Close(), jc.ErrorIsNil)
+	}()
+
+	// The action is imported without its timeout.
+	actions, err := newModel.AllActions()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(actions, gc.HasLen, 1)
+	c.Check(actions[0].Timeout(), gc.Equals, time.Duration(0))
+}
+
 func (s *MigrationImportSuite) TestVolumes(c *gc.C) {
 	machine := s.Factory.MakeMachine(c, &factory.MachineParams{
 		Volumes: []state.MachineVolumeParams{{
@@ -1311,6 +1377,28 @@ func (s *MigrationImportSuite) TestVolumes(c *gc.C) {
 	c.Check(attParams.ReadOnly, jc.IsTrue)
 }
 
+func (s *MigrationImportSuite) TestVolumeSnapshotsNotImported(c *gc.C) {
+	s.Factory.MakeMachine(c, &factory.MachineParams{
+		Volumes: []state.MachineVolumeParams{{
+			Volume: state.VolumeParams{Size: 1234},
+		}},
+	})
+	volTag := names.NewVolumeTag("0/0")
+	err := s.IAASModel.SetVolumeInfo(volTag, state.VolumeInfo{Size: 1500, VolumeId: "volume id"})
+	c.Assert(err, jc.ErrorIsNil)
+	snapshot, err := s.IAASModel.SnapshotVolume(volTag)
+	c.Assert(err, jc.ErrorIsNil)
+
+	_, newSt := s.importModel(c, s.State)
+	newIM, err := newSt.IAASModel()
+	c.Assert(err, jc.ErrorIsNil)
+
+	_, err = newIM.VolumeSnapshot(snapshot.Id())
+	c.Check(err, jc.Satisfies, errors.IsNotFound)
+	_, err = newIM.Volume(volTag)
+	c.Check(err, jc.ErrorIsNil)
+}
+
 func (s *MigrationImportSuite) TestFilesystems(c *gc.C) {
 	machine := s.Factory.MakeMachine(c, &factory.MachineParams{
 		Filesystems: []state.MachineFilesystemParams{{
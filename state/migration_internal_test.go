@@ -88,6 +88,8 @@ func (s *MigrationSuite) TestKnownCollections(c *gc.C) {
 		autocertCacheC,
 		// We don't export the controller model at this stage.
 		controllersC,
+		// Controller firewall rules are controller global, not migrated.
+		controllerFirewallRulesC,
 		// Clouds aren't migrated. They must exist in the
 		// target controller already.
 		cloudsC,
@@ -188,6 +190,13 @@ func (s *MigrationSuite) TestKnownCollections(c *gc.C) {
 		// we include the name of the leader unit. On import, a new lease
 		// is created for the leader unit.
 		leasesC,
+
+		// Hook history is diagnostic only, and is not migrated.
+		hookHistoryC,
+
+		// Unit health is reported afresh by each unit agent once
+		// the migration completes.
+		unitHealthC,
 	)
 
 	// THIS SET WILL BE REMOVED WHEN MIGRATIONS ARE COMPLETE
@@ -201,6 +210,11 @@ func (s *MigrationSuite) TestKnownCollections(c *gc.C) {
 		externalControllersC,
 		relationNetworksC,
 		firewallRulesC,
+		remoteRelationEventsC,
+
+		// Volume snapshots are not migrated, as the model
+		// description has no place for them.
+		volumeSnapshotsC,
 	)
 
 	envCollections := set.NewStrings()
@@ -683,6 +697,8 @@ func (s *MigrationSuite) TestSSHHostKeyDocFields(c *gc.C) {
 func (s *MigrationSuite) TestActionDocFields(c *gc.C) {
 	ignored := set.NewStrings(
 		"ModelUUID",
+		// Timeout isn't supported by the description package yet.
+		"Timeout",
 	)
 	migrated := set.NewStrings(
 		"DocId",
@@ -706,6 +722,9 @@ func (s *MigrationSuite) TestVolumeDocFields(c *gc.C) {
 		"Life",
 		"MachineId", // recreated from pool properties
 		"Releasing", // only when dying; can't migrate dying storage
+		// RequestedSize is transient; an in-progress resize
+		// must be requested again after migration.
+		"RequestedSize",
 	)
 	migrated := set.NewStrings(
 		"Name",
@@ -718,8 +737,10 @@ func (s *MigrationSuite) TestVolumeDocFields(c *gc.C) {
 	// The info and params fields ar structs.
 	s.AssertExportedFields(c, VolumeInfo{}, set.NewStrings(
 		"HardwareId", "WWN", "Size", "Pool", "VolumeId", "Persistent"))
+	// Volume snapshots are not migrated, so volumes pending
+	// creation from a snapshot are created empty.
 	s.AssertExportedFields(c, VolumeParams{}, set.NewStrings(
-		"Size", "Pool"))
+		"Size", "Pool", "Snapshot"))
 }
 
 func (s *MigrationSuite) TestVolumeAttachmentDocFields(c *gc.C) {
@@ -727,6 +748,8 @@ func (s *MigrationSuite) TestVolumeAttachmentDocFields(c *gc.C) {
 		"ModelUUID",
 		"DocID",
 		"Life",
+		// Usage is reported periodically by the machine agent.
+		"Usage",
 	)
 	migrated := set.NewStrings(
 		"Volume",
@@ -763,7 +786,7 @@ func (s *MigrationSuite) TestFilesystemDocFields(c *gc.C) {
 	s.AssertExportedFields(c, FilesystemInfo{}, set.NewStrings(
 		"Size", "Pool", "FilesystemId"))
 	s.AssertExportedFields(c, FilesystemParams{}, set.NewStrings(
-		"Size", "Pool"))
+		"Size", "Pool", "Snapshot"))
 }
 
 func (s *MigrationSuite) TestFilesystemAttachmentDocFields(c *gc.C) {
@@ -771,6 +794,8 @@ func (s *MigrationSuite) TestFilesystemAttachmentDocFields(c *gc.C) {
 		"ModelUUID",
 		"DocID",
 		"Life",
+		// Usage is reported periodically by the machine agent.
+		"Usage",
 	)
 	migrated := set.NewStrings(
 		"Filesystem",
@@ -16,6 +16,7 @@ import (
 	"gopkg.in/mgo.v2/bson"
 	"gopkg.in/mgo.v2/txn"
 
+	"github.com/juju/juju/core/leadership"
 	"github.com/juju/juju/permission"
 	"github.com/juju/juju/status"
 )
@@ -351,6 +352,7 @@ func (r *Relation) removeOps(ignoreApplication string, departingUnitName string)
 	}
 	ops = append(ops, removeStatusOp(r.st, r.globalScope()))
 	ops = append(ops, removeRelationNetworksOps(r.st, r.doc.Key)...)
+	ops = append(ops, removeRemoteRelationEventsOp(r.st, r.doc.Key))
 	re := r.st.RemoteEntities()
 	tokenOps := re.removeRemoteEntityOps(r.Tag())
 	ops = append(ops, tokenOps...)
@@ -531,6 +533,158 @@ func (r *Relation) IsCrossModel() (bool, error) {
 	return false, nil
 }
 
+// AllUnitSettings returns the settings of each unit, on either side
+// of the relation, that has joined the relation's scope, keyed by
+// unit name.
+func (r *Relation) AllUnitSettings() (map[string]map[string]interface{}, error) {
+	relationScopes, closer := r.st.db().GetCollection(relationScopesC)
+	defer closer()
+
+	sel := bson.D{
+		{"key", bson.D{{"$regex", "^" + r.globalScope() + "#"}}},
+		{"departing", bson.D{{"$ne", true}}},
+	}
+	var docs []relationScopeDoc
+	if err := relationScopes.Find(sel).All(&docs); err != nil {
+		return nil, errors.Annotatef(err, "cannot read scope of relation %q", r)
+	}
+	result := make(map[string]map[string]interface{})
+	for _, doc := range docs {
+		settings, err := readSettings(r.st.db(), settingsC, doc.Key)
+		if errors.IsNotFound(err) {
+			continue
+		} else if err != nil {
+			return nil, errors.Annotatef(err, "cannot read settings for unit %q in relation %q", doc.unitName(), r)
+		}
+		result[doc.unitName()] = settings.Map()
+	}
+	return result, nil
+}
+
+// relationApplicationSettingsKey returns the key of the settings an
+// application publishes in the relation with the given id.
+func relationApplicationSettingsKey(id int, appName string) string {
+	return fmt.Sprintf("%s#%s", relationGlobalScope(id), appName)
+}
+
+// ApplicationSettings returns the settings the named application has
+// published in the relation. Settings that have never been written
+// are reported as empty.
+func (r *Relation) ApplicationSettings(appName string) (map[string]interface{}, error) {
+	if _, err := r.Endpoint(appName); err != nil {
+		return nil, errors.Trace(err)
+	}
+	key := relationApplicationSettingsKey(r.doc.Id, appName)
+	settings, err := readSettings(r.st.db(), settingsC, key)
+	if errors.IsNotFound(err) {
+		return map[string]interface{}{}, nil
+	} else if err != nil {
+		return nil, errors.Annotatef(err, "cannot read settings for application %q in relation %q", appName, r)
+	}
+	return settings.Map(), nil
+}
+
+// UpdateApplicationSettings changes the settings the named application
+// publishes in the relation. Keys with empty values are removed. The
+// change is only made if the supplied token shows that the caller is
+// still the application's leader.
+func (r *Relation) UpdateApplicationSettings(appName string, token leadership.Token, updates map[string]string) error {
+	buildTxn, err := r.updateApplicationSettingsTxn(appName, updates)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	err = r.st.db().Run(buildTxnWithLeadership(buildTxn, token))
+	return errors.Annotatef(err, "cannot update settings for application %q in relation %q", appName, r)
+}
+
+// UpdateRemoteApplicationSettings changes the settings published in
+// the relation by the named remote application, as reported by the
+// model offering it. Keys with empty values are removed.
+func (r *Relation) UpdateRemoteApplicationSettings(appName string, updates map[string]string) error {
+	if _, err := r.st.RemoteApplication(appName); err != nil {
+		return errors.Trace(err)
+	}
+	buildTxn, err := r.updateApplicationSettingsTxn(appName, updates)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	err = r.st.db().Run(buildTxn)
+	return errors.Annotatef(err, "cannot update settings for application %q in relation %q", appName, r)
+}
+
+func (r *Relation) updateApplicationSettingsTxn(appName string, updates map[string]string) (jujutxn.TransactionSource, error) {
+	if _, err := r.Endpoint(appName); err != nil {
+		return nil, errors.Trace(err)
+	}
+	key := relationApplicationSettingsKey(r.doc.Id, appName)
+	sets := bson.M{}
+	unsets := bson.M{}
+	for unescapedKey, value := range updates {
+		key := escapeReplacer.Replace(unescapedKey)
+		if value == "" {
+			unsets[key] = 1
+		} else {
+			sets[key] = value
+		}
+	}
+
+	isNullChange := func(rawMap map[string]interface{}) bool {
+		for key := range unsets {
+			if _, found := rawMap[key]; found {
+				return false
+			}
+		}
+		for key, value := range sets {
+			if current := rawMap[key]; current != value {
+				return false
+			}
+		}
+		return true
+	}
+
+	buildTxn := func(attempt int) ([]txn.Op, error) {
+		if attempt > 0 {
+			if err := r.Refresh(); err != nil {
+				return nil, errors.Trace(err)
+			}
+		}
+		if r.Life() == Dead {
+			return nil, errors.Errorf("relation is dead")
+		}
+		ops := []txn.Op{{
+			C:      relationsC,
+			Id:     r.doc.DocID,
+			Assert: notDeadDoc,
+		}}
+		doc, err := readSettingsDoc(r.st.db(), settingsC, key)
+		switch {
+		case errors.IsNotFound(err):
+			if len(sets) == 0 {
+				return nil, jujutxn.ErrNoOperations
+			}
+			values := make(map[string]interface{})
+			for key, value := range updates {
+				if value != "" {
+					values[key] = value
+				}
+			}
+			return append(ops, createSettingsOp(settingsC, key, values)), nil
+		case err != nil:
+			return nil, errors.Trace(err)
+		}
+		if isNullChange(doc.Settings) {
+			return nil, jujutxn.ErrNoOperations
+		}
+		return append(ops, txn.Op{
+			C:      settingsC,
+			Id:     key,
+			Assert: bson.D{{"version", doc.Version}},
+			Update: setUnsetUpdateSettings(sets, unsets),
+		}), nil
+	}
+	return buildTxn, nil
+}
+
 func (r *Relation) unit(
 	unitName string,
 	principal string,
@@ -645,3 +645,112 @@ func (s *RelationSuite) TestResumeRelationNoConsumeAccessRace(c *gc.C) {
 	c.Assert(err, gc.ErrorMatches,
 		`cannot resume relation "wordpress:db mysql:server" where user "fred" does not have consume permission`)
 }
+
+func (s *RelationSuite) TestApplicationSettings(c *gc.C) {
+	prr := newProReqRelation(c, &s.ConnSuite, charm.ScopeGlobal)
+
+	settings, err := prr.rel.ApplicationSettings("mysql")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(settings, gc.HasLen, 0)
+
+	err = prr.rel.UpdateApplicationSettings("mysql", &fakeToken{}, map[string]string{
+		"host": "10.0.0.1",
+		"port": "3306",
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	err = prr.rel.UpdateApplicationSettings("mysql", &fakeToken{}, map[string]string{
+		"port":   "",
+		"schema": "wordpress",
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	settings, err = prr.rel.ApplicationSettings("mysql")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(settings, jc.DeepEquals, map[string]interface{}{
+		"host":   "10.0.0.1",
+		"schema": "wordpress",
+	})
+
+	// Each application has its own settings.
+	settings, err = prr.rel.ApplicationSettings("wordpress")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(settings, gc.HasLen, 0)
+}
+
+func (s *RelationSuite) TestApplicationSettingsNotInRelation(c *gc.C) {
+	prr := newProReqRelation(c, &s.ConnSuite, charm.ScopeGlobal)
+	_, err := prr.rel.ApplicationSettings("riak")
+	c.Assert(err, jc.Satisfies, errors.IsNotFound)
+	err = prr.rel.UpdateApplicationSettings("riak", &fakeToken{}, map[string]string{"foo": "bar"})
+	c.Assert(err, jc.Satisfies, errors.IsNotFound)
+}
+
+func (s *RelationSuite) TestUpdateApplicationSettingsNoChange(c *gc.C) {
+	prr := newProReqRelation(c, &s.ConnSuite, charm.ScopeGlobal)
+
+	// Unsetting settings that were never written is not an error.
+	err := prr.rel.UpdateApplicationSettings("mysql", &fakeToken{}, map[string]string{"host": ""})
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = prr.rel.UpdateApplicationSettings("mysql", &fakeToken{}, map[string]string{"host": "10.0.0.1"})
+	c.Assert(err, jc.ErrorIsNil)
+	err = prr.rel.UpdateApplicationSettings("mysql", &fakeToken{}, map[string]string{"host": "10.0.0.1"})
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *RelationSuite) TestUpdateApplicationSettingsNotLeader(c *gc.C) {
+	prr := newProReqRelation(c, &s.ConnSuite, charm.ScopeGlobal)
+	err := prr.rel.UpdateApplicationSettings("mysql", &failToken{}, map[string]string{"host": "10.0.0.1"})
+	c.Assert(err, gc.ErrorMatches, `cannot update settings for application "mysql" in relation "wordpress:db mysql:server": prerequisites failed: something bad happened`)
+
+	settings, err := prr.rel.ApplicationSettings("mysql")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(settings, gc.HasLen, 0)
+}
+
+func (s *RelationSuite) TestUpdateApplicationSettingsDeadRelation(c *gc.C) {
+	prr := newProReqRelation(c, &s.ConnSuite, charm.ScopeGlobal)
+	err := prr.pru0.EnterScope(nil)
+	c.Assert(err, jc.ErrorIsNil)
+	err = prr.rel.Destroy()
+	c.Assert(err, jc.ErrorIsNil)
+	err = prr.pru0.LeaveScope()
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = prr.rel.UpdateApplicationSettings("mysql", &fakeToken{}, map[string]string{"host": "10.0.0.1"})
+	c.Assert(err, jc.Satisfies, errors.IsNotFound)
+}
+
+func (s *RelationSuite) TestUpdateRemoteApplicationSettings(c *gc.C) {
+	rwordpress, err := s.State.AddRemoteApplication(state.AddRemoteApplicationParams{
+		Name:            "remote-wordpress",
+		SourceModel:     names.NewModelTag("source-model"),
+		IsConsumerProxy: true,
+		OfferUUID:       "offer-uuid",
+		Endpoints: []charm.Relation{{
+			Interface: "mysql",
+			Limit:     1,
+			Name:      "db",
+			Role:      charm.RoleRequirer,
+			Scope:     charm.ScopeGlobal,
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	wordpressEP, err := rwordpress.Endpoint("db")
+	c.Assert(err, jc.ErrorIsNil)
+	mysql := s.AddTestingApplication(c, "mysql", s.AddTestingCharm(c, "mysql"))
+	mysqlEP, err := mysql.Endpoint("server")
+	c.Assert(err, jc.ErrorIsNil)
+	rel, err := s.State.AddRelation(wordpressEP, mysqlEP)
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = rel.UpdateRemoteApplicationSettings("remote-wordpress", map[string]string{"user": "admin"})
+	c.Assert(err, jc.ErrorIsNil)
+	settings, err := rel.ApplicationSettings("remote-wordpress")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(settings, jc.DeepEquals, map[string]interface{}{"user": "admin"})
+
+	// The settings of local applications cannot be updated this way.
+	err = rel.UpdateRemoteApplicationSettings("mysql", map[string]string{"user": "admin"})
+	c.Assert(err, jc.Satisfies, errors.IsNotFound)
+}
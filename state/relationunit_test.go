@@ -232,6 +232,91 @@ func (s *RelationUnitSuite) testProReqSettings(c *gc.C, pru0, pru1, rru0, rru1 *
 	}
 }
 
+func (s *RelationUnitSuite) TestAllUnitSettings(c *gc.C) {
+	prr := newProReqRelation(c, &s.ConnSuite, charm.ScopeGlobal)
+	settings, err := prr.rel.AllUnitSettings()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(settings, gc.HasLen, 0)
+
+	err = prr.pru0.EnterScope(map[string]interface{}{"host": "10.0.0.1"})
+	c.Assert(err, jc.ErrorIsNil)
+	err = prr.pru1.EnterScope(map[string]interface{}{"host": "10.0.0.2"})
+	c.Assert(err, jc.ErrorIsNil)
+	err = prr.rru0.EnterScope(nil)
+	c.Assert(err, jc.ErrorIsNil)
+
+	// Units that are departing the relation are not included.
+	err = prr.pru1.PrepareLeaveScope()
+	c.Assert(err, jc.ErrorIsNil)
+
+	settings, err = prr.rel.AllUnitSettings()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(settings, jc.DeepEquals, map[string]map[string]interface{}{
+		"mysql/0":     {"host": "10.0.0.1"},
+		"wordpress/0": {},
+	})
+}
+
+func (s *RelationUnitSuite) TestWatchApplicationSettings(c *gc.C) {
+	prr := newProReqRelation(c, &s.ConnSuite, charm.ScopeGlobal)
+	err := prr.rel.UpdateApplicationSettings("wordpress", &fakeToken{}, map[string]string{"user": "admin"})
+	c.Assert(err, jc.ErrorIsNil)
+
+	// The initial event reports the counterpart application's
+	// settings, if it has published any.
+	mysqlw := prr.pru0.Watch()
+	defer testing.AssertStop(c, mysqlw)
+	mysqlwc := testing.NewRelationUnitsWatcherC(c, s.State, mysqlw)
+	mysqlwc.AssertAppChange("wordpress")
+	mysqlwc.AssertNoChange()
+
+	wordpressw := prr.rru0.Watch()
+	defer testing.AssertStop(c, wordpressw)
+	wordpresswc := testing.NewRelationUnitsWatcherC(c, s.State, wordpressw)
+	wordpresswc.AssertChange(nil, nil)
+	wordpresswc.AssertNoChange()
+
+	// Changes are only reported to the units of the counterpart
+	// application.
+	err = prr.rel.UpdateApplicationSettings("mysql", &fakeToken{}, map[string]string{"host": "10.0.0.1"})
+	c.Assert(err, jc.ErrorIsNil)
+	wordpresswc.AssertAppChange("mysql")
+	wordpresswc.AssertNoChange()
+	mysqlwc.AssertNoChange()
+
+	err = prr.rel.UpdateApplicationSettings("wordpress", &fakeToken{}, map[string]string{"user": ""})
+	c.Assert(err, jc.ErrorIsNil)
+	mysqlwc.AssertAppChange("wordpress")
+	mysqlwc.AssertNoChange()
+	wordpresswc.AssertNoChange()
+}
+
+func (s *RelationUnitSuite) TestPeerWatchApplicationSettings(c *gc.C) {
+	pr := newPeerRelation(c, s.State)
+	w := pr.ru0.Watch()
+	defer testing.AssertStop(c, w)
+	wc := testing.NewRelationUnitsWatcherC(c, s.State, w)
+	wc.AssertChange(nil, nil)
+	wc.AssertNoChange()
+
+	// A peer's own application is its counterpart, so changes
+	// to its settings are reported to every peer.
+	err := pr.rel.UpdateApplicationSettings("riak", &fakeToken{}, map[string]string{"ring-size": "64"})
+	c.Assert(err, jc.ErrorIsNil)
+	wc.AssertAppChange("riak")
+	wc.AssertNoChange()
+
+	w1 := pr.ru1.Watch()
+	defer testing.AssertStop(c, w1)
+	wc1 := testing.NewRelationUnitsWatcherC(c, s.State, w1)
+	wc1.AssertChange(nil, nil)
+	wc1.AssertNoChange()
+	err = pr.rel.UpdateApplicationSettings("riak", &fakeToken{}, map[string]string{"ring-size": "128"})
+	c.Assert(err, jc.ErrorIsNil)
+	wc.AssertAppChange("riak")
+	wc1.AssertAppChange("riak")
+}
+
 func (s *RelationUnitSuite) TestContainerSettings(c *gc.C) {
 	prr := newProReqRelation(c, &s.ConnSuite, charm.ScopeContainer)
 	rus := RUs{prr.pru0, prr.pru1, prr.rru0, prr.rru1}
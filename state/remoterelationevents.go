@@ -0,0 +1,203 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"time"
+
+	"github.com/juju/errors"
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+	"gopkg.in/mgo.v2/txn"
+)
+
+// RemoteRelationEventDirection identifies whether an event was sent to,
+// or received from, the remote side of a cross model relation.
+type RemoteRelationEventDirection string
+
+const (
+	// RemoteRelationEventSent is used for local settings changes
+	// published to the remote model.
+	RemoteRelationEventSent = RemoteRelationEventDirection("sent")
+
+	// RemoteRelationEventReceived is used for remote settings changes
+	// consumed into the local model.
+	RemoteRelationEventReceived = RemoteRelationEventDirection("received")
+)
+
+// Validate returns an error if the direction is not known.
+func (d RemoteRelationEventDirection) Validate() error {
+	switch d {
+	case RemoteRelationEventSent, RemoteRelationEventReceived:
+		return nil
+	}
+	return errors.NotValidf("remote relation event direction %q", d)
+}
+
+// RemoteRelationEvent describes a single relation settings change
+// exchanged with the remote side of a cross model relation.
+type RemoteRelationEvent struct {
+	// Time is when the event was exchanged.
+	Time time.Time
+
+	// ChangedUnits is the number of units whose settings changed.
+	ChangedUnits int
+
+	// DepartedUnits is the number of units which departed.
+	DepartedUnits int
+
+	// Error, if not empty, is the reason the event could not
+	// be delivered.
+	Error string
+}
+
+// RemoteRelationEvents holds the most recent events exchanged in each
+// direction for a cross model relation.
+type RemoteRelationEvents struct {
+	// LastSent is the last event published to the remote model.
+	LastSent *RemoteRelationEvent
+
+	// LastReceived is the last event consumed from the remote model.
+	LastReceived *RemoteRelationEvent
+
+	// PendingSent is the number of unit changes in the last
+	// undelivered outgoing event; zero once delivery succeeds.
+	PendingSent int
+
+	// PendingReceived is the number of unit changes in the last
+	// unprocessed incoming event; zero once processing succeeds.
+	PendingReceived int
+}
+
+type remoteRelationEventDoc struct {
+	Time          time.Time `bson:"time"`
+	ChangedUnits  int       `bson:"changed-units"`
+	DepartedUnits int       `bson:"departed-units"`
+	Error         string    `bson:"error,omitempty"`
+	Pending       int       `bson:"pending"`
+}
+
+type remoteRelationEventsDoc struct {
+	DocID        string                  `bson:"_id"`
+	RelationKey  string                  `bson:"relation-key"`
+	LastSent     *remoteRelationEventDoc `bson:"sent,omitempty"`
+	LastReceived *remoteRelationEventDoc `bson:"received,omitempty"`
+}
+
+func (doc *remoteRelationEventDoc) event() *RemoteRelationEvent {
+	if doc == nil {
+		return nil
+	}
+	return &RemoteRelationEvent{
+		Time:          doc.Time.UTC(),
+		ChangedUnits:  doc.ChangedUnits,
+		DepartedUnits: doc.DepartedUnits,
+		Error:         doc.Error,
+	}
+}
+
+func (doc *remoteRelationEventDoc) pending() int {
+	if doc == nil {
+		return 0
+	}
+	return doc.Pending
+}
+
+// RecordRemoteRelationEvent records the latest event exchanged in the
+// specified direction for the cross model relation with the given key.
+// A failed event is considered pending until a later event in the same
+// direction succeeds.
+func (st *State) RecordRemoteRelationEvent(
+	relationKey string, direction RemoteRelationEventDirection, event RemoteRelationEvent,
+) error {
+	if err := direction.Validate(); err != nil {
+		return errors.Trace(err)
+	}
+	eventDoc := &remoteRelationEventDoc{
+		Time:          event.Time.UTC(),
+		ChangedUnits:  event.ChangedUnits,
+		DepartedUnits: event.DepartedUnits,
+		Error:         event.Error,
+	}
+	if event.Error != "" {
+		eventDoc.Pending = event.ChangedUnits + event.DepartedUnits
+	}
+	docID := st.docID(relationKey)
+	buildTxn := func(int) ([]txn.Op, error) {
+		if _, err := st.KeyRelation(relationKey); err != nil {
+			return nil, errors.Trace(err)
+		}
+		relationExistsAssert := txn.Op{
+			C:      relationsC,
+			Id:     docID,
+			Assert: txn.DocExists,
+		}
+		_, err := st.remoteRelationEventsDoc(relationKey)
+		if errors.IsNotFound(err) {
+			doc := remoteRelationEventsDoc{
+				DocID:       docID,
+				RelationKey: relationKey,
+			}
+			if direction == RemoteRelationEventSent {
+				doc.LastSent = eventDoc
+			} else {
+				doc.LastReceived = eventDoc
+			}
+			return []txn.Op{relationExistsAssert, {
+				C:      remoteRelationEventsC,
+				Id:     docID,
+				Assert: txn.DocMissing,
+				Insert: doc,
+			}}, nil
+		} else if err != nil {
+			return nil, errors.Trace(err)
+		}
+		return []txn.Op{relationExistsAssert, {
+			C:      remoteRelationEventsC,
+			Id:     docID,
+			Assert: txn.DocExists,
+			Update: bson.D{{"$set", bson.D{{string(direction), eventDoc}}}},
+		}}, nil
+	}
+	err := st.db().Run(buildTxn)
+	return errors.Annotatef(err, "cannot record %s event for relation %q", direction, relationKey)
+}
+
+// RemoteRelationEvents returns the events most recently exchanged
+// for the cross model relation with the given key.
+func (st *State) RemoteRelationEvents(relationKey string) (RemoteRelationEvents, error) {
+	doc, err := st.remoteRelationEventsDoc(relationKey)
+	if err != nil {
+		return RemoteRelationEvents{}, errors.Trace(err)
+	}
+	return RemoteRelationEvents{
+		LastSent:        doc.LastSent.event(),
+		LastReceived:    doc.LastReceived.event(),
+		PendingSent:     doc.LastSent.pending(),
+		PendingReceived: doc.LastReceived.pending(),
+	}, nil
+}
+
+func (st *State) remoteRelationEventsDoc(relationKey string) (*remoteRelationEventsDoc, error) {
+	coll, closer := st.db().GetCollection(remoteRelationEventsC)
+	defer closer()
+
+	var doc remoteRelationEventsDoc
+	err := coll.FindId(relationKey).One(&doc)
+	if err == mgo.ErrNotFound {
+		return nil, errors.NotFoundf("remote relation events for %q", relationKey)
+	}
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &doc, nil
+}
+
+func removeRemoteRelationEventsOp(st *State, relationKey string) txn.Op {
+	return txn.Op{
+		C:      remoteRelationEventsC,
+		Id:     st.docID(relationKey),
+		Remove: true,
+	}
+}
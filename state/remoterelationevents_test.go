@@ -0,0 +1,112 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state_test
+
+import (
+	"time"
+
+	"github.com/juju/errors"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/state"
+)
+
+type remoteRelationEventsSuite struct {
+	ConnSuite
+	relation *state.Relation
+}
+
+var _ = gc.Suite(&remoteRelationEventsSuite{})
+
+func (s *remoteRelationEventsSuite) SetUpTest(c *gc.C) {
+	s.ConnSuite.SetUpTest(c)
+	wordpress := s.AddTestingApplication(c, "wordpress", s.AddTestingCharm(c, "wordpress"))
+	wordpressEP, err := wordpress.Endpoint("db")
+	c.Assert(err, jc.ErrorIsNil)
+	mysql := s.AddTestingApplication(c, "mysql", s.AddTestingCharm(c, "mysql"))
+	mysqlEP, err := mysql.Endpoint("server")
+	c.Assert(err, jc.ErrorIsNil)
+	s.relation, err = s.State.AddRelation(wordpressEP, mysqlEP)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *remoteRelationEventsSuite) TestNotFound(c *gc.C) {
+	_, err := s.State.RemoteRelationEvents(s.relation.String())
+	c.Assert(err, jc.Satisfies, errors.IsNotFound)
+}
+
+func (s *remoteRelationEventsSuite) TestRecord(c *gc.C) {
+	now := time.Date(2018, 1, 2, 3, 4, 5, 0, time.UTC)
+	err := s.State.RecordRemoteRelationEvent(s.relation.String(), state.RemoteRelationEventSent, state.RemoteRelationEvent{
+		Time:          now,
+		ChangedUnits:  2,
+		DepartedUnits: 1,
+		Error:         "boom",
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.State.RecordRemoteRelationEvent(s.relation.String(), state.RemoteRelationEventReceived, state.RemoteRelationEvent{
+		Time:         now.Add(time.Minute),
+		ChangedUnits: 1,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	events, err := s.State.RemoteRelationEvents(s.relation.String())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(events, jc.DeepEquals, state.RemoteRelationEvents{
+		LastSent: &state.RemoteRelationEvent{
+			Time:          now,
+			ChangedUnits:  2,
+			DepartedUnits: 1,
+			Error:         "boom",
+		},
+		LastReceived: &state.RemoteRelationEvent{
+			Time:         now.Add(time.Minute),
+			ChangedUnits: 1,
+		},
+		PendingSent: 3,
+	})
+}
+
+func (s *remoteRelationEventsSuite) TestRecordSuccessClearsPending(c *gc.C) {
+	now := time.Date(2018, 1, 2, 3, 4, 5, 0, time.UTC)
+	err := s.State.RecordRemoteRelationEvent(s.relation.String(), state.RemoteRelationEventSent, state.RemoteRelationEvent{
+		Time:         now,
+		ChangedUnits: 2,
+		Error:        "boom",
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.State.RecordRemoteRelationEvent(s.relation.String(), state.RemoteRelationEventSent, state.RemoteRelationEvent{
+		Time:         now.Add(time.Minute),
+		ChangedUnits: 2,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	events, err := s.State.RemoteRelationEvents(s.relation.String())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(events.PendingSent, gc.Equals, 0)
+	c.Assert(events.LastSent.Error, gc.Equals, "")
+	c.Assert(events.LastSent.Time, gc.Equals, now.Add(time.Minute))
+}
+
+func (s *remoteRelationEventsSuite) TestRecordInvalidDirection(c *gc.C) {
+	err := s.State.RecordRemoteRelationEvent(s.relation.String(), "sideways", state.RemoteRelationEvent{})
+	c.Assert(err, gc.ErrorMatches, `remote relation event direction "sideways" not valid`)
+}
+
+func (s *remoteRelationEventsSuite) TestRecordMissingRelation(c *gc.C) {
+	err := s.State.RecordRemoteRelationEvent("wordpress:db foo:server", state.RemoteRelationEventSent, state.RemoteRelationEvent{})
+	c.Assert(err, gc.ErrorMatches, `cannot record sent event for relation "wordpress:db foo:server": relation "wordpress:db foo:server" not found`)
+}
+
+func (s *remoteRelationEventsSuite) TestRemovedWithRelation(c *gc.C) {
+	err := s.State.RecordRemoteRelationEvent(s.relation.String(), state.RemoteRelationEventSent, state.RemoteRelationEvent{
+		Time: time.Now(),
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.relation.Destroy()
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = s.State.RemoteRelationEvents(s.relation.String())
+	c.Assert(err, jc.Satisfies, errors.IsNotFound)
+}
@@ -1197,12 +1197,26 @@ func (st *State) AddApplication(args AddApplicationArgs) (_ *Application, err er
 			ops = append(ops, resOps...)
 		}
 
+		// Collect shared storage addition operations. Shared
+		// storage is owned by the application, and attached to
+		// each of its units.
+		var sharedStorage []names.StorageTag
+		if model.Type() == ModelTypeIAAS {
+			storageOps, storageTags, err := app.addSharedStorageOps(args.Charm.Meta(), args.Storage)
+			if err != nil {
+				return nil, errors.Trace(err)
+			}
+			ops = append(ops, storageOps...)
+			sharedStorage = storageTags
+		}
+
 		// Collect unit-adding operations.
 		for x := 0; x < args.NumUnits; x++ {
 			unitName, unitOps, err := app.addApplicationUnitOps(applicationAddUnitOpsArgs{
 				cons:          args.Constraints,
 				storageCons:   args.Storage,
 				attachStorage: args.AttachStorage,
+				sharedStorage: sharedStorage,
 			})
 			if err != nil {
 				return nil, errors.Trace(err)
@@ -71,6 +71,19 @@ func (s *FilesystemStatusSuite) TestSetErrorStatusWithoutInfo(c *gc.C) {
 	s.checkInitialStatus(c)
 }
 
+func (s *FilesystemStatusSuite) TestSetWarningStatusWithoutInfo(c *gc.C) {
+	now := testing.ZeroTime()
+	sInfo := status.StatusInfo{
+		Status:  status.Warning,
+		Message: "",
+		Since:   &now,
+	}
+	err := s.filesystem.SetStatus(sInfo)
+	c.Check(err, gc.ErrorMatches, `cannot set status "warning" without info`)
+
+	s.checkInitialStatus(c)
+}
+
 func (s *FilesystemStatusSuite) TestSetUnknownStatus(c *gc.C) {
 	now := testing.ZeroTime()
 	sInfo := status.StatusInfo{
@@ -17,6 +17,7 @@ import (
 	"gopkg.in/mgo.v2/txn"
 
 	"github.com/juju/juju/environs/config"
+	"github.com/juju/juju/status"
 	"github.com/juju/juju/storage"
 	"github.com/juju/juju/storage/poolmanager"
 	"github.com/juju/juju/storage/provider"
@@ -177,8 +178,9 @@ type storageInstanceDoc struct {
 // storageInstanceConstraints contains a subset of StorageConstraints,
 // for a single storage instance.
 type storageInstanceConstraints struct {
-	Pool string `bson:"pool"`
-	Size uint64 `bson:"size"`
+	Pool     string `bson:"pool"`
+	Size     uint64 `bson:"size"`
+	Snapshot string `bson:"snapshot,omitempty"`
 }
 
 type storageAttachment struct {
@@ -243,6 +245,45 @@ func (im *IAASModel) storageInstance(tag names.StorageTag) (*storageInstance, er
 	return &s, nil
 }
 
+// ResizeStorageInstance requests that the volume backing the specified
+// storage instance be grown to the given size in MiB. Filesystem storage
+// can only be resized if the filesystem is backed by a volume.
+func (im *IAASModel) ResizeStorageInstance(tag names.StorageTag, size uint64) (err error) {
+	defer errors.DeferredAnnotatef(&err, "resizing %s", names.ReadableString(tag))
+	si, err := im.storageInstance(tag)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	volumeTag, err := im.storageInstanceBackingVolume(si)
+	if err == ErrNoBackingVolume {
+		return errors.NotSupportedf("resizing filesystem not backed by a volume")
+	} else if err != nil {
+		return errors.Trace(err)
+	}
+	return im.ResizeVolume(volumeTag, size)
+}
+
+// storageInstanceBackingVolume returns the tag of the volume backing the
+// specified storage instance. If the storage instance is a filesystem
+// that is not backed by a volume, ErrNoBackingVolume is returned.
+func (im *IAASModel) storageInstanceBackingVolume(si *storageInstance) (names.VolumeTag, error) {
+	switch si.Kind() {
+	case StorageKindBlock:
+		v, err := im.storageInstanceVolume(si.StorageTag())
+		if err != nil {
+			return names.VolumeTag{}, errors.Trace(err)
+		}
+		return v.VolumeTag(), nil
+	case StorageKindFilesystem:
+		f, err := im.storageInstanceFilesystem(si.StorageTag())
+		if err != nil {
+			return names.VolumeTag{}, errors.Trace(err)
+		}
+		return f.Volume()
+	}
+	return names.VolumeTag{}, errors.NotSupportedf("%s storage", si.Kind())
+}
+
 // AllStorageInstances lists all storage instances currently in state
 // for this Juju model.
 func (im *IAASModel) AllStorageInstances() ([]StorageInstance, error) {
@@ -522,6 +563,16 @@ func validateRemoveOwnerStorageInstanceOps(si *storageInstance) ([]txn.Op, error
 		if app.Life() != Alive {
 			return nil, nil
 		}
+		if app.doc.UnitCount == 0 {
+			// Shared storage is only used by the application's
+			// units, so charm storage requirements only need to
+			// be satisfied while it has units.
+			return []txn.Op{{
+				C:      applicationsC,
+				Id:     app.doc.DocID,
+				Assert: bson.D{{"unitcount", 0}},
+			}}, nil
+		}
 		ch, _, err := app.Charm()
 		if err != nil {
 			return nil, errors.Trace(err)
@@ -729,12 +780,27 @@ func createStorageOps(
 				Owner:       owner,
 				StorageName: t.storageName,
 				Constraints: storageInstanceConstraints{
-					Pool: cons.Pool,
-					Size: cons.Size,
+					Pool:     cons.Pool,
+					Size:     cons.Size,
+					Snapshot: cons.Snapshot,
 				},
 			}
 			var machineOps []txn.Op
-			if unitTag, ok := entityTag.(names.UnitTag); ok {
+			if _, ok := entityTag.(names.ApplicationTag); ok {
+				// Shared storage is attached to each of the
+				// application's machines, so its filesystem is
+				// created up front rather than with a machine.
+				machineOps, _, err = im.addSharedFilesystemOps(FilesystemParams{
+					storage: storageTag,
+					Pool:    cons.Pool,
+					Size:    cons.Size,
+				})
+				if err != nil {
+					return fail(errors.Annotatef(
+						err, "creating filesystem for storage %s", id,
+					))
+				}
+			} else if unitTag, ok := entityTag.(names.UnitTag); ok {
 				doc.AttachmentCount = 1
 				ops = append(ops, createStorageAttachmentOp(storageTag, unitTag))
 				numStorageAttachments++
@@ -763,13 +829,8 @@ func createStorageOps(
 		}
 	}
 
-	// TODO(axw) create storage attachments for each shared storage
-	// instance owned by the application.
-	//
-	// TODO(axw) prevent creation of shared storage after application
-	// creation, because the only sane time to add storage attachments
-	// is when units are added to said application.
-
+	// Storage attachments for shared storage instances are created
+	// as units are added to the application.
 	return ops, storageTags, numStorageAttachments, nil
 }
 
@@ -1493,6 +1554,27 @@ func removeStorageInstancesOps(im *IAASModel, owner names.Tag) ([]txn.Op, error)
 	return ops, nil
 }
 
+// removeApplicationStorageOps returns the transaction operations to remove
+// all shared storage instances owned by the specified application.
+func removeApplicationStorageOps(im *IAASModel, app names.ApplicationTag) ([]txn.Op, error) {
+	storageInstances, err := im.storageInstances(bson.D{{"owner", app.String()}})
+	if err != nil {
+		return nil, errors.Annotatef(err, "cannot get storage instances for %s", names.ReadableString(app))
+	}
+	var ops []txn.Op
+	for _, si := range storageInstances {
+		// The application's units have all been removed by
+		// now, so the storage instances have no attachments.
+		hasNoAttachments := bson.D{{"attachmentcount", 0}}
+		storageInstanceOps, err := removeStorageInstanceOps(si, hasNoAttachments)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		ops = append(ops, storageInstanceOps...)
+	}
+	return ops, nil
+}
+
 // storageConstraintsDoc contains storage constraints for an entity.
 type storageConstraintsDoc struct {
 	DocID       string                        `bson:"_id"`
@@ -1512,6 +1594,10 @@ type StorageConstraints struct {
 
 	// Count is the required number of storage instances.
 	Count uint64 `bson:"count"`
+
+	// Snapshot is the ID of the volume snapshot from which to
+	// create the storage instances, if any.
+	Snapshot string `bson:"snapshot,omitempty"`
 }
 
 func createStorageConstraintsOp(key string, cons map[string]StorageConstraints) txn.Op {
@@ -1593,13 +1679,6 @@ func validateStorageConstraintsAgainstCharm(
 		if !ok {
 			return errors.Errorf("charm %q has no store called %q", charmMeta.Name, name)
 		}
-		if charmStorage.Shared {
-			// TODO(axw) implement shared storage support.
-			return errors.Errorf(
-				"charm %q store %q: shared storage support not implemented",
-				charmMeta.Name, name,
-			)
-		}
 		if err := validateCharmStorageCount(charmStorage, cons.Count); err != nil {
 			return errors.Annotatef(err, "charm %q store %q", charmMeta.Name, name)
 		}
@@ -1615,10 +1694,75 @@ func validateStorageConstraintsAgainstCharm(
 		if err := validateStoragePool(im, cons.Pool, kind, nil); err != nil {
 			return err
 		}
+		if charmStorage.Shared && cons.Count > 0 {
+			if err := validateSharedStoragePool(im, cons.Pool, kind); err != nil {
+				return errors.Annotatef(err, "charm %q store %q", charmMeta.Name, name)
+			}
+		} else if cons.Count > 0 {
+			if err := validateUnsharedStoragePool(im, cons.Pool); err != nil {
+				return errors.Annotatef(err, "charm %q store %q", charmMeta.Name, name)
+			}
+		}
+		if cons.Snapshot != "" {
+			if err := validateStorageSnapshot(im, cons, kind); err != nil {
+				return errors.Annotatef(err, "charm %q store %q", charmMeta.Name, name)
+			}
+		}
 	}
 	return nil
 }
 
+// validateSharedStoragePool validates that shared storage of the
+// specified kind can be provisioned from the specified pool. Shared
+// storage must be a filesystem, provided by a storage provider whose
+// filesystems may be attached to multiple machines.
+func validateSharedStoragePool(im *IAASModel, poolName string, kind storage.StorageKind) error {
+	if kind != storage.StorageKindFilesystem {
+		return errors.NotSupportedf("shared %s storage", kind)
+	}
+	providerType, provider, err := poolStorageProvider(im, poolName)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if shared, ok := provider.(storage.SharedFilesystemProvider); !ok || !shared.SharedFilesystems() {
+		return errors.NotSupportedf(
+			"shared storage with storage provider %q", providerType,
+		)
+	}
+	return nil
+}
+
+// validateUnsharedStoragePool validates that non-shared storage can be
+// provisioned from the specified pool. Storage providers that may only
+// be used for shared storage are rejected.
+func validateUnsharedStoragePool(im *IAASModel, poolName string) error {
+	providerType, provider, err := poolStorageProvider(im, poolName)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if shared, ok := provider.(storage.SharedFilesystemProvider); ok && shared.SharedFilesystemsOnly() {
+		return errors.NotSupportedf(
+			"non-shared storage with storage provider %q", providerType,
+		)
+	}
+	return nil
+}
+
+// validateStorageSnapshot validates that storage of the specified
+// kind can be created from the snapshot referred to by cons.
+func validateStorageSnapshot(im *IAASModel, cons StorageConstraints, kind storage.StorageKind) error {
+	if kind == storage.StorageKindFilesystem {
+		_, provider, err := poolStorageProvider(im, cons.Pool)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		if provider.Supports(storage.StorageKindFilesystem) {
+			return errors.NotSupportedf("creating filesystem not backed by a volume from a snapshot")
+		}
+	}
+	return validateVolumeSnapshotParams(im, cons.Snapshot, cons.Pool, cons.Size)
+}
+
 func validateCharmStorageCountChange(charmStorage charm.Storage, current, n int) error {
 	action := "attach"
 	absn := n
@@ -1748,6 +1892,36 @@ func poolStorageProvider(im *IAASModel, poolName string) (storage.ProviderType,
 	return providerType, provider, nil
 }
 
+// storageUsageStatus returns the status that a filesystem or volume should
+// have, given its current status and the usage reported for each of its
+// attachments. The status is "warning" while the highest reported usage is
+// above the threshold, and "attached" otherwise. Statuses other than
+// "attached" and "warning" are owned by the storage provisioner, and are
+// left untouched. The boolean result reports whether or not the status
+// should be changed.
+func storageUsageStatus(current status.StatusInfo, usages []FilesystemUsage, threshold int) (status.StatusInfo, bool) {
+	if current.Status != status.Attached && current.Status != status.Warning {
+		return status.StatusInfo{}, false
+	}
+	var percent int
+	for _, usage := range usages {
+		if usagePercent := usage.UsedPercent(); usagePercent > percent {
+			percent = usagePercent
+		}
+	}
+	next := status.StatusInfo{Status: status.Attached}
+	if threshold > 0 && percent > threshold {
+		next = status.StatusInfo{
+			Status:  status.Warning,
+			Message: fmt.Sprintf("%d%% used, above the warning threshold of %d%%", percent, threshold),
+		}
+	}
+	if next.Status == current.Status && next.Message == current.Message {
+		return status.StatusInfo{}, false
+	}
+	return next, true
+}
+
 // ErrNoDefaultStoragePool is returned when a storage pool is required but none
 // is specified nor available as a default.
 var ErrNoDefaultStoragePool = fmt.Errorf("no storage pool specified and no default available")
@@ -1762,17 +1936,23 @@ func addDefaultStorageConstraints(im *IAASModel, allCons map[string]StorageConst
 
 	for name, charmStorage := range charmMeta.Storage {
 		cons, ok := allCons[name]
-		if !ok {
-			if charmStorage.Shared {
-				// TODO(axw) get the model's default shared storage
-				// pool, and create constraints here.
-				return errors.Errorf(
-					"no constraints specified for shared charm storage %q",
-					name,
-				)
+		if !ok && charmStorage.Shared {
+			// There is no default pool for shared storage, as
+			// it requires a provider whose filesystems can be
+			// attached to multiple machines.
+			if charmStorage.CountMin == 0 {
+				continue
 			}
+			return errors.Errorf(
+				"no constraints specified for shared charm storage %q",
+				name,
+			)
+		}
+		cons, err := storageConstraintsWithSnapshot(im, cons)
+		if err != nil {
+			return errors.Annotatef(err, "getting snapshot for %q storage", name)
 		}
-		cons, err := storageConstraintsWithDefaults(conf, charmStorage, name, cons)
+		cons, err = storageConstraintsWithDefaults(conf, charmStorage, name, cons)
 		if err != nil {
 			return errors.Trace(err)
 		}
@@ -1914,8 +2094,22 @@ func (im *IAASModel) addStorageForUnitOps(
 	if !ok {
 		return nil, nil, errors.NotFoundf("charm storage %q", storageName)
 	}
+	if charmStorageMeta.Shared {
+		// Shared storage is owned by the application, and is
+		// only created along with the application.
+		return nil, nil, errors.NotSupportedf("adding shared storage to a unit")
+	}
 	ops := u.assertCharmOps(ch)
 
+	if cons.Snapshot != "" {
+		// The pool and size of storage created from a snapshot
+		// default to those of the snapshotted volume.
+		cons, err = storageConstraintsWithSnapshot(im, cons)
+		if err != nil {
+			return nil, nil, errors.Trace(err)
+		}
+	}
+
 	if cons.Pool == "" || cons.Size == 0 {
 		// Either pool or size, or both, were not specified. Take the
 		// values from the unit's recorded storage constraints.
@@ -1957,6 +2151,11 @@ func (im *IAASModel) addStorageForUnitOps(
 	if cons.Count == 0 {
 		return nil, nil, errors.NotValidf("adding storage where instance count is 0")
 	}
+	if cons.Snapshot != "" {
+		if err := validateStorageSnapshot(im, cons, storageKind(charmStorageMeta.Type)); err != nil {
+			return nil, nil, errors.Trace(err)
+		}
+	}
 
 	tags, addUnitStorageOps, err := im.addUnitStorageOps(charmMeta, u, storageName, cons, -1)
 	if err != nil {
@@ -15,9 +15,11 @@ import (
 	"gopkg.in/juju/names.v2"
 	"gopkg.in/mgo.v2"
 
+	"github.com/juju/juju/instance"
 	"github.com/juju/juju/provider/dummy"
 	"github.com/juju/juju/state"
 	"github.com/juju/juju/state/testing"
+	"github.com/juju/juju/status"
 	"github.com/juju/juju/storage"
 	"github.com/juju/juju/storage/poolmanager"
 	"github.com/juju/juju/storage/provider"
@@ -950,6 +952,205 @@ func (s *StorageStateSuite) TestAddUnitAttachStorage(c *gc.C) {
 	c.Assert(owner, gc.Equals, u2.UnitTag())
 }
 
+func (s *StorageStateSuite) addSharedStorageApplication(c *gc.C, kind, pool string, numUnits int) (*state.Application, error) {
+	ch := s.createStorageCharm(c, "storage-shared", charm.Storage{
+		Name:     "data",
+		Type:     charm.StorageType(kind),
+		Shared:   true,
+		CountMin: 1,
+		CountMax: 1,
+	})
+	return s.State.AddApplication(state.AddApplicationArgs{
+		Name:   "storage-shared",
+		Series: "quantal",
+		Charm:  ch,
+		Storage: map[string]state.StorageConstraints{
+			"data": makeStorageCons(pool, 1024, 1),
+		},
+		NumUnits: numUnits,
+	})
+}
+
+func (s *StorageStateSuite) assertStorageAttachments(c *gc.C, tag names.StorageTag, expect ...names.UnitTag) {
+	attachments, err := s.IAASModel.StorageAttachments(tag)
+	c.Assert(err, jc.ErrorIsNil)
+	units := make([]names.UnitTag, len(attachments))
+	for i, a := range attachments {
+		units[i] = a.Unit()
+	}
+	c.Assert(units, jc.SameContents, expect)
+}
+
+func (s *StorageStateSuite) TestAddApplicationSharedStorage(c *gc.C) {
+	app, err := s.addSharedStorageApplication(c, "filesystem", "modelscoped-shared", 2)
+	c.Assert(err, jc.ErrorIsNil)
+
+	// The storage instance is owned by the application, and
+	// attached to each of its units.
+	storageTag := names.NewStorageTag("data/0")
+	storageInstance, err := s.IAASModel.StorageInstance(storageTag)
+	c.Assert(err, jc.ErrorIsNil)
+	owner, hasOwner := storageInstance.Owner()
+	c.Assert(hasOwner, jc.IsTrue)
+	c.Assert(owner, gc.Equals, app.ApplicationTag())
+	c.Assert(storageInstance.Kind(), gc.Equals, state.StorageKindFilesystem)
+	s.assertStorageAttachments(c, storageTag,
+		names.NewUnitTag("storage-shared/0"),
+		names.NewUnitTag("storage-shared/1"),
+	)
+
+	// The filesystem is created with the storage instance,
+	// and is model-scoped so it may be attached to multiple
+	// machines.
+	filesystem := s.storageInstanceFilesystem(c, storageTag)
+	c.Assert(filesystem.FilesystemTag(), gc.Equals, names.NewFilesystemTag("0"))
+	s.assertFilesystemUnprovisioned(c, filesystem.FilesystemTag())
+
+	// Units added later are attached to the same storage.
+	u, err := app.AddUnit(state.AddUnitParams{})
+	c.Assert(err, jc.ErrorIsNil)
+	s.assertStorageAttachments(c, storageTag,
+		names.NewUnitTag("storage-shared/0"),
+		names.NewUnitTag("storage-shared/1"),
+		u.UnitTag(),
+	)
+	all, err := s.IAASModel.AllStorageInstances()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(all, gc.HasLen, 1)
+}
+
+func (s *StorageStateSuite) TestAddApplicationSharedStorageAssignUnits(c *gc.C) {
+	app, err := s.addSharedStorageApplication(c, "filesystem", "modelscoped-shared", 2)
+	c.Assert(err, jc.ErrorIsNil)
+	units, err := app.AllUnits()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(units, gc.HasLen, 2)
+
+	filesystem := s.storageInstanceFilesystem(c, names.NewStorageTag("data/0"))
+	var machines []names.MachineTag
+	for _, u := range units {
+		err := s.State.AssignUnit(u, state.AssignCleanEmpty)
+		c.Assert(err, jc.ErrorIsNil)
+		machines = append(machines, unitMachine(c, s.State, u).MachineTag())
+	}
+	c.Assert(machines[0], gc.Not(gc.Equals), machines[1])
+
+	// The one filesystem is attached to both machines.
+	attachments, err := s.IAASModel.FilesystemAttachments(filesystem.FilesystemTag())
+	c.Assert(err, jc.ErrorIsNil)
+	attached := make([]names.MachineTag, len(attachments))
+	for i, a := range attachments {
+		attached[i] = a.Machine()
+	}
+	c.Assert(attached, jc.SameContents, machines)
+}
+
+func (s *StorageStateSuite) TestSharedFilesystemUsageStatus(c *gc.C) {
+	app, err := s.addSharedStorageApplication(c, "filesystem", "modelscoped-shared", 2)
+	c.Assert(err, jc.ErrorIsNil)
+	units, err := app.AllUnits()
+	c.Assert(err, jc.ErrorIsNil)
+
+	filesystemTag := s.storageInstanceFilesystem(c, names.NewStorageTag("data/0")).FilesystemTag()
+	err = s.IAASModel.SetFilesystemInfo(filesystemTag, state.FilesystemInfo{FilesystemId: "fs-0"})
+	c.Assert(err, jc.ErrorIsNil)
+	var machines []names.MachineTag
+	for i, u := range units {
+		err := s.State.AssignUnit(u, state.AssignCleanEmpty)
+		c.Assert(err, jc.ErrorIsNil)
+		machine := unitMachine(c, s.State, u)
+		err = machine.SetProvisioned(instance.Id(fmt.Sprint("inst-", i)), "fake_nonce", nil)
+		c.Assert(err, jc.ErrorIsNil)
+		err = s.IAASModel.SetFilesystemAttachmentInfo(
+			machine.MachineTag(), filesystemTag,
+			state.FilesystemAttachmentInfo{MountPoint: "/srv"},
+		)
+		c.Assert(err, jc.ErrorIsNil)
+		machines = append(machines, machine.MachineTag())
+	}
+	err = s.IAASModel.SetFilesystemStatus(filesystemTag, status.Attached, "", nil, nil)
+	c.Assert(err, jc.ErrorIsNil)
+
+	assertStatus := func(expect status.Status, message string) {
+		info, err := s.IAASModel.FilesystemStatus(filesystemTag)
+		c.Assert(err, jc.ErrorIsNil)
+		c.Assert(info.Status, gc.Equals, expect)
+		c.Assert(info.Message, gc.Equals, message)
+	}
+	setUsage := func(machine names.MachineTag, used uint64) {
+		err := s.IAASModel.SetFilesystemAttachmentUsage(machine, filesystemTag, state.FilesystemUsage{
+			Size: 100, Used: used, Available: 100 - used,
+		})
+		c.Assert(err, jc.ErrorIsNil)
+	}
+
+	// The status reflects the highest usage reported by any of the
+	// machines, regardless of which of them reported last.
+	setUsage(machines[0], 95)
+	assertStatus(status.Warning, "95% used, above the warning threshold of 90%")
+	setUsage(machines[1], 10)
+	assertStatus(status.Warning, "95% used, above the warning threshold of 90%")
+	setUsage(machines[0], 10)
+	assertStatus(status.Attached, "")
+}
+
+func (s *StorageStateSuite) TestAddApplicationSharedStorageUnsupportedProvider(c *gc.C) {
+	_, err := s.addSharedStorageApplication(c, "filesystem", "modelscoped", 1)
+	c.Assert(err, gc.ErrorMatches, `cannot add application "storage-shared": `+
+		`charm "storage-shared" store "data": shared storage with storage provider "modelscoped" not supported`)
+}
+
+func (s *StorageStateSuite) TestAddApplicationUnsharedStorageSharedOnlyProvider(c *gc.C) {
+	ch := s.AddTestingCharm(c, "storage-filesystem")
+	_, err := s.State.AddApplication(state.AddApplicationArgs{
+		Name:   "storage-filesystem",
+		Series: "quantal",
+		Charm:  ch,
+		Storage: map[string]state.StorageConstraints{
+			"data": makeStorageCons("nfs", 1024, 1),
+		},
+	})
+	c.Assert(err, gc.ErrorMatches, `cannot add application "storage-filesystem": `+
+		`charm "storage-filesystem" store "data": non-shared storage with storage provider "nfs" not supported`)
+}
+
+func (s *StorageStateSuite) TestAddApplicationSharedBlockStorage(c *gc.C) {
+	_, err := s.addSharedStorageApplication(c, "block", "modelscoped-shared", 1)
+	c.Assert(err, gc.ErrorMatches, `cannot add application "storage-shared": `+
+		`charm "storage-shared" store "data": shared block storage not supported`)
+}
+
+func (s *StorageStateSuite) TestAddStorageForUnitShared(c *gc.C) {
+	app, err := s.addSharedStorageApplication(c, "filesystem", "modelscoped-shared", 1)
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = s.IAASModel.AddStorageForUnit(
+		names.NewUnitTag(app.Name()+"/0"), "data", makeStorageCons("modelscoped-shared", 1024, 1),
+	)
+	c.Assert(err, jc.Satisfies, errors.IsNotSupported)
+}
+
+func (s *StorageStateSuite) TestRemoveApplicationRemovesSharedStorage(c *gc.C) {
+	app, err := s.addSharedStorageApplication(c, "filesystem", "modelscoped-shared", 1)
+	c.Assert(err, jc.ErrorIsNil)
+	storageTag := names.NewStorageTag("data/0")
+	filesystem := s.storageInstanceFilesystem(c, storageTag)
+
+	err = app.Destroy()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(s.storageInstanceExists(c, storageTag), jc.IsTrue)
+
+	// Removing the last unit removes the application, and
+	// with it the shared storage.
+	s.obliterateUnit(c, names.NewUnitTag(app.Name()+"/0"))
+	err = app.Refresh()
+	c.Assert(err, jc.Satisfies, errors.IsNotFound)
+	c.Assert(s.storageInstanceExists(c, storageTag), jc.IsFalse)
+	// The filesystem has no attachments, so it is left for the
+	// storage provisioner to destroy.
+	filesystem = s.filesystem(c, filesystem.FilesystemTag())
+	c.Assert(filesystem.Life(), gc.Equals, state.Dead)
+}
+
 func (s *StorageStateSuite) TestConcurrentDestroyStorageInstanceRemoveStorageAttachmentsRemovesInstance(c *gc.C) {
 	_, u, storageTag := s.setupSingleStorage(c, "block", "loop-pool")
 	err := u.Destroy()
@@ -0,0 +1,266 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/juju/errors"
+	"github.com/juju/loggo"
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+	"gopkg.in/mgo.v2/txn"
+
+	"github.com/juju/juju/status"
+)
+
+// SubWorkload holds the version and status reported by a unit's charm
+// for one of the workloads it manages alongside its main one, such as
+// a monitoring agent or a log shipper.
+type SubWorkload struct {
+	// Name identifies the sub-workload within the unit.
+	Name string
+
+	// Version is the version of the sub-workload, if known.
+	Version string
+
+	// Status holds the status of the sub-workload.
+	Status status.StatusInfo
+}
+
+// subWorkloadVersionKey is the key of the status data under which the
+// version of a sub-workload is recorded.
+const subWorkloadVersionKey = "version"
+
+var validSubWorkloadName = regexp.MustCompile("^[a-z][a-z0-9]*(-[a-z0-9]+)*$")
+
+// IsValidSubWorkloadName reports whether name may be used to identify
+// a sub-workload.
+func IsValidSubWorkloadName(name string) bool {
+	return validSubWorkloadName.MatchString(name)
+}
+
+// subWorkloadsGlobalKeyPrefix returns the prefix of the global database
+// keys of the statuses of the named unit's sub-workloads.
+func subWorkloadsGlobalKeyPrefix(unitName string) string {
+	return unitGlobalKey(unitName) + "#sat#sub-workload#"
+}
+
+// subWorkloadGlobalKey returns the global database key for the status
+// of the named sub-workload of the named unit.
+func subWorkloadGlobalKey(unitName, name string) string {
+	return subWorkloadsGlobalKeyPrefix(unitName) + name
+}
+
+// SetSubWorkload records the version and status of the named
+// sub-workload of the unit, adding the sub-workload if necessary.
+func (u *Unit) SetSubWorkload(name, version string, info status.StatusInfo) (err error) {
+	defer errors.DeferredAnnotatef(&err, "cannot set status of sub-workload %q of unit %q", name, u)
+	if !IsValidSubWorkloadName(name) {
+		return errors.NotValidf("sub-workload name %q", name)
+	}
+	if !status.ValidWorkloadStatus(info.Status) {
+		return errors.Errorf("invalid status %q", info.Status)
+	}
+	doc := statusDoc{
+		Status:     info.Status,
+		StatusInfo: info.Message,
+		Updated:    timeOrNow(info.Since, u.st.clock()).UnixNano(),
+	}
+	key := subWorkloadGlobalKey(u.doc.Name, name)
+	statuses, closer := u.st.db().GetCollection(statusesC)
+	defer closer()
+	buildTxn := func(attempt int) ([]txn.Op, error) {
+		if attempt > 0 {
+			if err := u.Refresh(); err != nil {
+				return nil, errors.Trace(err)
+			}
+		}
+		if u.Life() == Dead {
+			return nil, errors.Errorf("unit is dead")
+		}
+		ops := []txn.Op{{
+			C:      unitsC,
+			Id:     u.doc.DocID,
+			Assert: notDeadDoc,
+		}}
+		var existing struct {
+			StatusData map[string]interface{} `bson:"statusdata"`
+			TxnRevno   int64                  `bson:"txn-revno"`
+		}
+		err := statuses.FindId(key).One(&existing)
+		if err == mgo.ErrNotFound {
+			doc.StatusData = subWorkloadStatusData(version)
+			return append(ops, createStatusOp(u.st, key, doc)), nil
+		} else if err != nil {
+			return nil, errors.Trace(err)
+		}
+		// A sub-workload keeps the version reported earlier unless
+		// a new one is given.
+		storedVersion := version
+		if storedVersion == "" {
+			storedVersion, _ = existing.StatusData[subWorkloadVersionKey].(string)
+		}
+		doc.StatusData = subWorkloadStatusData(storedVersion)
+		return append(ops, txn.Op{
+			C:      statusesC,
+			Id:     key,
+			Assert: bson.D{{"txn-revno", existing.TxnRevno}},
+			Update: bson.D{{"$set", &doc}},
+		}), nil
+	}
+	if err := u.st.db().Run(buildTxn); err != nil {
+		return errors.Trace(err)
+	}
+	if _, err := probablyUpdateStatusHistory(u.st.db(), key, doc); err != nil {
+		logger.Errorf("failed to write status history for %q: %v", key, err)
+	}
+	return nil
+}
+
+// RemoveSubWorkload removes the named sub-workload of the unit, along
+// with its status history. It returns an error satisfying
+// errors.IsNotFound if the unit has no such sub-workload.
+func (u *Unit) RemoveSubWorkload(name string) (err error) {
+	defer errors.DeferredAnnotatef(&err, "cannot remove sub-workload %q of unit %q", name, u)
+	key := subWorkloadGlobalKey(u.doc.Name, name)
+	buildTxn := func(attempt int) ([]txn.Op, error) {
+		txnRevno, err := readTxnRevno(u.st.db(), statusesC, key)
+		if errors.Cause(err) == mgo.ErrNotFound {
+			return nil, errors.NotFoundf("sub-workload %q", name)
+		} else if err != nil {
+			return nil, errors.Trace(err)
+		}
+		op := removeStatusOp(u.st, key)
+		op.Assert = bson.D{{"txn-revno", txnRevno}}
+		return []txn.Op{op}, nil
+	}
+	if err := u.st.db().Run(buildTxn); err != nil {
+		return errors.Trace(err)
+	}
+	return errors.Trace(eraseStatusHistory(u.st, key))
+}
+
+// SubWorkloads returns the sub-workloads reported by the unit's charm,
+// ordered by name.
+func (u *Unit) SubWorkloads() ([]SubWorkload, error) {
+	statuses, closer := u.st.db().GetCollection(statusesC)
+	defer closer()
+
+	prefix := subWorkloadsGlobalKeyPrefix(u.doc.Name)
+	var docs []statusDocWithID
+	err := statuses.Find(bson.D{{"_id", bson.D{{"$regex", "^" + u.st.docID(prefix)}}}}).All(&docs)
+	if err != nil {
+		return nil, errors.Annotatef(err, "cannot get sub-workloads of unit %q", u)
+	}
+	result := make([]SubWorkload, len(docs))
+	for i, doc := range docs {
+		result[i] = doc.asSubWorkload(strings.TrimPrefix(u.st.localID(doc.ID), prefix))
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+	return result, nil
+}
+
+// SubWorkloadStatusHistory returns a slice of at most filter.Size
+// StatusInfo items or items as old as filter.Date or items newer than
+// now - filter.Delta time representing past statuses of the named
+// sub-workload of the unit.
+func (u *Unit) SubWorkloadStatusHistory(name string, filter status.StatusHistoryFilter) ([]status.StatusInfo, error) {
+	args := &statusHistoryArgs{
+		db:        u.st.db(),
+		globalKey: subWorkloadGlobalKey(u.doc.Name, name),
+		filter:    filter,
+	}
+	return statusHistory(args)
+}
+
+// UnitSubWorkloads returns the sub-workloads reported by the named
+// unit's charm, ordered by name.
+func (m *ModelStatus) UnitSubWorkloads(unitName string) []SubWorkload {
+	prefix := subWorkloadsGlobalKeyPrefix(unitName)
+	var result []SubWorkload
+	for key, doc := range m.docs {
+		if strings.HasPrefix(key, prefix) {
+			result = append(result, doc.asSubWorkload(strings.TrimPrefix(key, prefix)))
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+	return result
+}
+
+// subWorkloadStatusData returns the status data recording the given
+// version of a sub-workload.
+func subWorkloadStatusData(version string) map[string]interface{} {
+	data := make(map[string]interface{})
+	if version != "" {
+		data[subWorkloadVersionKey] = version
+	}
+	return data
+}
+
+func (doc *statusDocWithID) asSubWorkload(name string) SubWorkload {
+	info := doc.asStatusInfo()
+	version, _ := info.Data[subWorkloadVersionKey].(string)
+	info.Data = nil
+	return SubWorkload{
+		Name:    name,
+		Version: version,
+		Status:  info,
+	}
+}
+
+// removeSubWorkloadsOps returns the operations needed to remove the
+// statuses of the named unit's sub-workloads.
+func removeSubWorkloadsOps(st *State, unitName string) ([]txn.Op, error) {
+	statuses, closer := st.db().GetCollection(statusesC)
+	defer closer()
+
+	prefix := subWorkloadsGlobalKeyPrefix(unitName)
+	var docs []struct {
+		ID string `bson:"_id"`
+	}
+	err := statuses.Find(bson.D{{"_id", bson.D{{"$regex", "^" + st.docID(prefix)}}}}).Select(bson.M{"_id": 1}).All(&docs)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	ops := make([]txn.Op, len(docs))
+	for i, doc := range docs {
+		ops[i] = removeStatusOp(st, st.localID(doc.ID))
+	}
+	return ops, nil
+}
+
+// eraseSubWorkloadsHistory removes the status history of the named
+// unit's sub-workloads.
+func eraseSubWorkloadsHistory(mb modelBackend, unitName string) error {
+	history, closer := mb.db().GetCollection(statusesHistoryC)
+	defer closer()
+
+	prefix := subWorkloadsGlobalKeyPrefix(unitName)
+	iter := history.Find(bson.D{{
+		globalKeyField, bson.D{{"$regex", "^" + prefix}},
+	}}).Select(bson.M{"_id": 1}).Iter()
+	defer iter.Close()
+
+	logFormat := "deleted %d status history documents for sub-workloads of " + fmt.Sprintf("%q", unitName)
+	deleted, err := deleteInBatches(
+		history.Writeable().Underlying(), iter,
+		logFormat, loggo.DEBUG,
+		noEarlyFinish,
+	)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if deleted > 0 {
+		logger.Debugf(logFormat, deleted)
+	}
+	return nil
+}
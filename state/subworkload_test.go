@@ -0,0 +1,179 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state_test
+
+import (
+	"time"
+
+	"github.com/juju/errors"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/state"
+	"github.com/juju/juju/status"
+)
+
+type SubWorkloadSuite struct {
+	ConnSuite
+	unit *state.Unit
+}
+
+var _ = gc.Suite(&SubWorkloadSuite{})
+
+func (s *SubWorkloadSuite) SetUpTest(c *gc.C) {
+	s.ConnSuite.SetUpTest(c)
+	s.unit = s.Factory.MakeUnit(c, nil)
+}
+
+func (s *SubWorkloadSuite) TestSubWorkloadsUnset(c *gc.C) {
+	subWorkloads, err := s.unit.SubWorkloads()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(subWorkloads, gc.HasLen, 0)
+}
+
+func (s *SubWorkloadSuite) TestSetSubWorkload(c *gc.C) {
+	since := time.Date(2018, 5, 1, 12, 30, 15, 0, time.UTC)
+	err := s.unit.SetSubWorkload("telegraf", "1.7.2", status.StatusInfo{
+		Status:  status.Active,
+		Message: "shipping metrics",
+		Since:   &since,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.unit.SetSubWorkload("filebeat", "", status.StatusInfo{
+		Status: status.Maintenance,
+		Since:  &since,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	subWorkloads, err := s.unit.SubWorkloads()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(subWorkloads, gc.HasLen, 2)
+	c.Assert(subWorkloads[0].Name, gc.Equals, "filebeat")
+	c.Assert(subWorkloads[0].Version, gc.Equals, "")
+	c.Assert(subWorkloads[0].Status.Status, gc.Equals, status.Maintenance)
+	c.Assert(subWorkloads[1].Name, gc.Equals, "telegraf")
+	c.Assert(subWorkloads[1].Version, gc.Equals, "1.7.2")
+	c.Assert(subWorkloads[1].Status.Status, gc.Equals, status.Active)
+	c.Assert(subWorkloads[1].Status.Message, gc.Equals, "shipping metrics")
+	c.Assert(subWorkloads[1].Status.Since.Equal(since), jc.IsTrue)
+
+	// Setting the status again updates the existing sub-workload.
+	err = s.unit.SetSubWorkload("telegraf", "1.7.3", status.StatusInfo{
+		Status:  status.Blocked,
+		Message: "no output configured",
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	subWorkloads, err = s.unit.SubWorkloads()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(subWorkloads, gc.HasLen, 2)
+	c.Assert(subWorkloads[1].Version, gc.Equals, "1.7.3")
+	c.Assert(subWorkloads[1].Status.Status, gc.Equals, status.Blocked)
+	c.Assert(subWorkloads[1].Status.Message, gc.Equals, "no output configured")
+
+	history, err := s.unit.SubWorkloadStatusHistory("telegraf", status.StatusHistoryFilter{Size: 10})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(history, gc.HasLen, 2)
+	c.Assert(history[0].Status, gc.Equals, status.Blocked)
+	c.Assert(history[1].Status, gc.Equals, status.Active)
+}
+
+func (s *SubWorkloadSuite) TestSetSubWorkloadKeepsVersion(c *gc.C) {
+	err := s.unit.SetSubWorkload("telegraf", "1.7.2", status.StatusInfo{Status: status.Active})
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.unit.SetSubWorkload("telegraf", "", status.StatusInfo{
+		Status:  status.Blocked,
+		Message: "no output configured",
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	subWorkloads, err := s.unit.SubWorkloads()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(subWorkloads, gc.HasLen, 1)
+	c.Assert(subWorkloads[0].Version, gc.Equals, "1.7.2")
+	c.Assert(subWorkloads[0].Status.Status, gc.Equals, status.Blocked)
+	c.Assert(subWorkloads[0].Status.Message, gc.Equals, "no output configured")
+}
+
+func (s *SubWorkloadSuite) TestRemoveSubWorkload(c *gc.C) {
+	err := s.unit.SetSubWorkload("telegraf", "1.7.2", status.StatusInfo{Status: status.Active})
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.unit.SetSubWorkload("filebeat", "", status.StatusInfo{Status: status.Active})
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = s.unit.RemoveSubWorkload("telegraf")
+	c.Assert(err, jc.ErrorIsNil)
+
+	subWorkloads, err := s.unit.SubWorkloads()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(subWorkloads, gc.HasLen, 1)
+	c.Assert(subWorkloads[0].Name, gc.Equals, "filebeat")
+	history, err := s.unit.SubWorkloadStatusHistory("telegraf", status.StatusHistoryFilter{Size: 10})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(history, gc.HasLen, 0)
+
+	// Setting the status again adds the sub-workload afresh.
+	err = s.unit.SetSubWorkload("telegraf", "", status.StatusInfo{Status: status.Active})
+	c.Assert(err, jc.ErrorIsNil)
+	subWorkloads, err = s.unit.SubWorkloads()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(subWorkloads, gc.HasLen, 2)
+	c.Assert(subWorkloads[1].Name, gc.Equals, "telegraf")
+	c.Assert(subWorkloads[1].Version, gc.Equals, "")
+}
+
+func (s *SubWorkloadSuite) TestRemoveSubWorkloadNotFound(c *gc.C) {
+	err := s.unit.RemoveSubWorkload("telegraf")
+	c.Assert(err, gc.ErrorMatches, `cannot remove sub-workload "telegraf" of unit "[^"]+": sub-workload "telegraf" not found`)
+	c.Assert(err, jc.Satisfies, errors.IsNotFound)
+}
+
+func (s *SubWorkloadSuite) TestSubWorkloadsInModelStatus(c *gc.C) {
+	err := s.unit.SetSubWorkload("telegraf", "1.7.2", status.StatusInfo{
+		Status:  status.Active,
+		Message: "shipping metrics",
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	model, err := s.State.Model()
+	c.Assert(err, jc.ErrorIsNil)
+	modelStatus, err := model.LoadModelStatus()
+	c.Assert(err, jc.ErrorIsNil)
+	subWorkloads := modelStatus.UnitSubWorkloads(s.unit.Name())
+	c.Assert(subWorkloads, gc.HasLen, 1)
+	c.Assert(subWorkloads[0].Name, gc.Equals, "telegraf")
+	c.Assert(subWorkloads[0].Version, gc.Equals, "1.7.2")
+	c.Assert(subWorkloads[0].Status.Status, gc.Equals, status.Active)
+	c.Assert(subWorkloads[0].Status.Message, gc.Equals, "shipping metrics")
+}
+
+func (s *SubWorkloadSuite) TestSetSubWorkloadInvalidName(c *gc.C) {
+	err := s.unit.SetSubWorkload("Telegraf", "", status.StatusInfo{Status: status.Active})
+	c.Assert(err, gc.ErrorMatches, `cannot set status of sub-workload "Telegraf" of unit "[^"]+": sub-workload name "Telegraf" not valid`)
+}
+
+func (s *SubWorkloadSuite) TestSetSubWorkloadInvalidStatus(c *gc.C) {
+	err := s.unit.SetSubWorkload("telegraf", "", status.StatusInfo{Status: status.Idle})
+	c.Assert(err, gc.ErrorMatches, `cannot set status of sub-workload "telegraf" of unit "[^"]+": invalid status "idle"`)
+}
+
+func (s *SubWorkloadSuite) TestSetSubWorkloadDeadUnit(c *gc.C) {
+	err := s.unit.EnsureDead()
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.unit.SetSubWorkload("telegraf", "", status.StatusInfo{Status: status.Active})
+	c.Assert(err, gc.ErrorMatches, `cannot set status of sub-workload "telegraf" of unit "[^"]+": unit is dead`)
+}
+
+func (s *SubWorkloadSuite) TestSubWorkloadsRemovedWithUnit(c *gc.C) {
+	err := s.unit.SetSubWorkload("telegraf", "", status.StatusInfo{Status: status.Active})
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = s.unit.EnsureDead()
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.unit.Remove()
+	c.Assert(err, jc.ErrorIsNil)
+
+	subWorkloads, err := s.unit.SubWorkloads()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(subWorkloads, gc.HasLen, 0)
+}
@@ -304,6 +304,25 @@ func (c RelationUnitsWatcherC) AssertChange(changed []string, departed []string)
 	}
 }
 
+// AssertAppChange asserts that a change to the settings of the given
+// applications, and no units, was reported by the watcher.
+func (c RelationUnitsWatcherC) AssertAppChange(appChanged ...string) {
+	c.State.StartSync()
+	select {
+	case actual, ok := <-c.Watcher.Changes():
+		c.Assert(ok, jc.IsTrue)
+		c.Assert(actual.Changed, gc.HasLen, 0)
+		c.Assert(actual.Departed, gc.HasLen, 0)
+		var names []string
+		for name := range actual.AppChanged {
+			names = append(names, name)
+		}
+		c.Assert(names, jc.SameContents, appChanged)
+	case <-time.After(testing.LongWait):
+		c.Fatalf("watcher did not send change")
+	}
+}
+
 func (c RelationUnitsWatcherC) AssertClosed() {
 	select {
 	case _, ok := <-c.Watcher.Changes():
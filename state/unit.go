@@ -485,6 +485,12 @@ func (u *Unit) eraseHistory() error {
 	if err := eraseStatusHistory(u.st, u.globalWorkloadVersionKey()); err != nil {
 		return errors.Annotate(err, "version")
 	}
+	if err := eraseSubWorkloadsHistory(u.st, u.doc.Name); err != nil {
+		return errors.Annotate(err, "sub-workloads")
+	}
+	if err := eraseHookHistory(u.st, u.globalKey()); err != nil {
+		return errors.Annotate(err, "hooks")
+	}
 	return nil
 }
 
@@ -2218,9 +2224,10 @@ func machineStorageParamsForStorageInstance(
 			}
 		} else if errors.IsNotFound(err) {
 			filesystemParams := FilesystemParams{
-				storage: storage.StorageTag(),
-				Pool:    storage.doc.Constraints.Pool,
-				Size:    storage.doc.Constraints.Size,
+				storage:  storage.StorageTag(),
+				Pool:     storage.doc.Constraints.Pool,
+				Size:     storage.doc.Constraints.Size,
+				Snapshot: storage.doc.Constraints.Snapshot,
 			}
 			filesystems = append(filesystems, MachineFilesystemParams{
 				filesystemParams, filesystemAttachmentParams,
@@ -2263,9 +2270,10 @@ func machineStorageParamsForStorageInstance(
 			volumeAttachments[volume.VolumeTag()] = volumeAttachmentParams
 		} else if errors.IsNotFound(err) {
 			volumeParams := VolumeParams{
-				storage: storage.StorageTag(),
-				Pool:    storage.doc.Constraints.Pool,
-				Size:    storage.doc.Constraints.Size,
+				storage:  storage.StorageTag(),
+				Pool:     storage.doc.Constraints.Pool,
+				Size:     storage.doc.Constraints.Size,
+				Snapshot: storage.doc.Constraints.Snapshot,
 			}
 			volumes = append(volumes, MachineVolumeParams{
 				volumeParams, volumeAttachmentParams,
@@ -2598,6 +2606,11 @@ type ActionSpecsByName map[string]charm.ActionSpec
 // this Unit, and returns its ID.  Note that the use of spec.InsertDefaults
 // mutates payload.
 func (u *Unit) AddAction(name string, payload map[string]interface{}) (Action, error) {
+	return u.AddActionWithTimeout(name, payload, 0)
+}
+
+// AddActionWithTimeout is part of the ActionReceiver interface.
+func (u *Unit) AddActionWithTimeout(name string, payload map[string]interface{}, timeout time.Duration) (Action, error) {
 	if len(name) == 0 {
 		return nil, errors.New("no action name given")
 	}
@@ -2629,7 +2642,7 @@ func (u *Unit) AddAction(name string, payload map[string]interface{}) (Action, e
 		return nil, errors.Trace(err)
 	}
 
-	return model.EnqueueAction(u.Tag(), name, payloadWithDefaults)
+	return model.EnqueueActionWithTimeout(u.Tag(), name, payloadWithDefaults, timeout)
 }
 
 // ActionSpecs gets the ActionSpec map for the Unit's charm.
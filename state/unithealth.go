@@ -0,0 +1,187 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"time"
+
+	"github.com/juju/errors"
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+	"gopkg.in/mgo.v2/txn"
+)
+
+// The health of a unit is one of these values.
+const (
+	// HealthUnknown is the health of a unit whose charm declares
+	// no health checks, or whose agent has not yet reported them.
+	HealthUnknown = "unknown"
+
+	// HealthHealthy is the health of a unit all of whose health
+	// checks are passing.
+	HealthHealthy = "healthy"
+
+	// HealthUnhealthy is the health of a unit with one or more
+	// failing health checks.
+	HealthUnhealthy = "unhealthy"
+)
+
+// HealthCheckResult holds the outcome of one of the health checks
+// declared by a unit's charm.
+type HealthCheckResult struct {
+	// Name is the name of the check.
+	Name string
+
+	// Healthy reports whether the check is passing.
+	Healthy bool
+
+	// Failures is the number of consecutive times the check
+	// has failed.
+	Failures int
+
+	// Message describes the most recent failure of the check.
+	Message string
+
+	// Since records when the check last became healthy or
+	// unhealthy.
+	Since time.Time
+}
+
+// UnitHealth holds the results of the health checks declared by
+// a unit's charm.
+type UnitHealth struct {
+	Checks []HealthCheckResult
+}
+
+// Status summarises the unit's health as one of HealthUnknown,
+// HealthHealthy or HealthUnhealthy.
+func (h UnitHealth) Status() string {
+	if len(h.Checks) == 0 {
+		return HealthUnknown
+	}
+	for _, check := range h.Checks {
+		if !check.Healthy {
+			return HealthUnhealthy
+		}
+	}
+	return HealthHealthy
+}
+
+type unitHealthDoc struct {
+	DocID     string           `bson:"_id"`
+	ModelUUID string           `bson:"model-uuid"`
+	Checks    []healthCheckDoc `bson:"checks"`
+}
+
+type healthCheckDoc struct {
+	Name     string `bson:"name"`
+	Healthy  bool   `bson:"healthy"`
+	Failures int    `bson:"failures"`
+	Message  string `bson:"message,omitempty"`
+	Since    int64  `bson:"since"`
+}
+
+// SetHealth records the results of the health checks declared by the
+// unit's charm, replacing any previously recorded.
+func (u *Unit) SetHealth(health UnitHealth) error {
+	checks := make([]healthCheckDoc, len(health.Checks))
+	for i, check := range health.Checks {
+		if check.Name == "" {
+			return errors.NotValidf("health check with empty name")
+		}
+		checks[i] = healthCheckDoc{
+			Name:     check.Name,
+			Healthy:  check.Healthy,
+			Failures: check.Failures,
+			Message:  check.Message,
+			Since:    check.Since.UnixNano(),
+		}
+	}
+	buildTxn := func(attempt int) ([]txn.Op, error) {
+		if attempt > 0 {
+			if err := u.Refresh(); err != nil {
+				return nil, errors.Trace(err)
+			}
+		}
+		if u.Life() == Dead {
+			return nil, errors.Errorf("unit is dead")
+		}
+		ops := []txn.Op{{
+			C:      unitsC,
+			Id:     u.doc.DocID,
+			Assert: notDeadDoc,
+		}}
+		_, err := u.getUnitHealthDoc()
+		switch {
+		case errors.IsNotFound(err):
+			ops = append(ops, txn.Op{
+				C:      unitHealthC,
+				Id:     u.st.docID(u.globalKey()),
+				Assert: txn.DocMissing,
+				Insert: &unitHealthDoc{
+					ModelUUID: u.st.ModelUUID(),
+					Checks:    checks,
+				},
+			})
+		case err != nil:
+			return nil, errors.Trace(err)
+		default:
+			ops = append(ops, txn.Op{
+				C:      unitHealthC,
+				Id:     u.st.docID(u.globalKey()),
+				Assert: txn.DocExists,
+				Update: bson.D{{"$set", bson.D{{"checks", checks}}}},
+			})
+		}
+		return ops, nil
+	}
+	return errors.Annotatef(u.st.db().Run(buildTxn), "cannot set health for unit %q", u)
+}
+
+// Health returns the results of the health checks declared by the
+// unit's charm, as most recently recorded by SetHealth.
+func (u *Unit) Health() (UnitHealth, error) {
+	doc, err := u.getUnitHealthDoc()
+	if errors.IsNotFound(err) {
+		return UnitHealth{}, nil
+	} else if err != nil {
+		return UnitHealth{}, errors.Annotatef(err, "cannot get health for unit %q", u)
+	}
+	health := UnitHealth{
+		Checks: make([]HealthCheckResult, len(doc.Checks)),
+	}
+	for i, check := range doc.Checks {
+		health.Checks[i] = HealthCheckResult{
+			Name:     check.Name,
+			Healthy:  check.Healthy,
+			Failures: check.Failures,
+			Message:  check.Message,
+			Since:    time.Unix(0, check.Since).UTC(),
+		}
+	}
+	return health, nil
+}
+
+func (u *Unit) getUnitHealthDoc() (*unitHealthDoc, error) {
+	unitHealth, closer := u.st.db().GetCollection(unitHealthC)
+	defer closer()
+	var doc unitHealthDoc
+	err := unitHealth.FindId(u.globalKey()).One(&doc)
+	if err == mgo.ErrNotFound {
+		return nil, errors.NotFoundf("health for unit %q", u)
+	} else if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &doc, nil
+}
+
+// removeUnitHealthOp returns the operation needed to remove the unit
+// health document associated with the given globalKey.
+func removeUnitHealthOp(mb modelBackend, globalKey string) txn.Op {
+	return txn.Op{
+		C:      unitHealthC,
+		Id:     mb.docID(globalKey),
+		Remove: true,
+	}
+}
@@ -0,0 +1,97 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state_test
+
+import (
+	"time"
+
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/state"
+)
+
+type UnitHealthSuite struct {
+	ConnSuite
+	unit *state.Unit
+}
+
+var _ = gc.Suite(&UnitHealthSuite{})
+
+func (s *UnitHealthSuite) SetUpTest(c *gc.C) {
+	s.ConnSuite.SetUpTest(c)
+	s.unit = s.Factory.MakeUnit(c, nil)
+}
+
+func (s *UnitHealthSuite) TestHealthUnset(c *gc.C) {
+	health, err := s.unit.Health()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(health.Checks, gc.HasLen, 0)
+	c.Assert(health.Status(), gc.Equals, state.HealthUnknown)
+}
+
+func (s *UnitHealthSuite) TestSetHealth(c *gc.C) {
+	since := time.Date(2018, 5, 1, 12, 30, 15, 0, time.UTC)
+	health := state.UnitHealth{
+		Checks: []state.HealthCheckResult{{
+			Name:    "http",
+			Healthy: true,
+			Since:   since,
+		}, {
+			Name:     "db",
+			Failures: 3,
+			Message:  "dial tcp 127.0.0.1:5432: connection refused",
+			Since:    since.Add(time.Minute),
+		}},
+	}
+	err := s.unit.SetHealth(health)
+	c.Assert(err, jc.ErrorIsNil)
+
+	got, err := s.unit.Health()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(got, jc.DeepEquals, health)
+	c.Assert(got.Status(), gc.Equals, state.HealthUnhealthy)
+
+	// Setting health again replaces the previous results.
+	health.Checks = health.Checks[:1]
+	err = s.unit.SetHealth(health)
+	c.Assert(err, jc.ErrorIsNil)
+
+	got, err = s.unit.Health()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(got, jc.DeepEquals, health)
+	c.Assert(got.Status(), gc.Equals, state.HealthHealthy)
+}
+
+func (s *UnitHealthSuite) TestSetHealthInvalidCheck(c *gc.C) {
+	err := s.unit.SetHealth(state.UnitHealth{
+		Checks: []state.HealthCheckResult{{Healthy: true}},
+	})
+	c.Assert(err, gc.ErrorMatches, "health check with empty name not valid")
+}
+
+func (s *UnitHealthSuite) TestSetHealthDeadUnit(c *gc.C) {
+	err := s.unit.EnsureDead()
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.unit.SetHealth(state.UnitHealth{
+		Checks: []state.HealthCheckResult{{Name: "http", Healthy: true}},
+	})
+	c.Assert(err, gc.ErrorMatches, `cannot set health for unit "[^"]+": unit is dead`)
+}
+
+func (s *UnitHealthSuite) TestHealthRemovedWithUnit(c *gc.C) {
+	err := s.unit.SetHealth(state.UnitHealth{
+		Checks: []state.HealthCheckResult{{Name: "http", Healthy: true}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = s.unit.EnsureDead()
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.unit.Remove()
+	c.Assert(err, jc.ErrorIsNil)
+
+	health, err := s.unit.Health()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(health.Checks, gc.HasLen, 0)
+}
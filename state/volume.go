@@ -53,6 +53,10 @@ type Volume interface {
 	// Releasing reports whether or not the volume is to be released
 	// from the model when it is Dying/Dead.
 	Releasing() bool
+
+	// RequestedSize returns the size in MiB that the volume has been
+	// requested to grow to, and true; or false if no resize is pending.
+	RequestedSize() (uint64, bool)
 }
 
 // VolumeAttachment describes an attachment of a volume to a machine.
@@ -76,6 +80,11 @@ type VolumeAttachment interface {
 	// if it has not already been made. Params returns true if the returned
 	// parameters are usable for creating an attachment, otherwise false.
 	Params() (VolumeAttachmentParams, bool)
+
+	// Usage returns the most recently reported usage of the filesystem
+	// mounted on the volume's block device on the machine, or a NotFound
+	// error if no usage has been reported.
+	Usage() (FilesystemUsage, error)
 }
 
 type volume struct {
@@ -99,6 +108,11 @@ type volumeDoc struct {
 	Info            *VolumeInfo   `bson:"info,omitempty"`
 	Params          *VolumeParams `bson:"params,omitempty"`
 
+	// RequestedSize is the size in MiB that the volume has been
+	// requested to grow to. It is cleared once the volume's info
+	// records a size at least as large.
+	RequestedSize uint64 `bson:"requestedsize,omitempty"`
+
 	// MachineId is the ID of the machine that a non-detachable
 	// volume is initially attached to. We use this to identify
 	// the volume as being non-detachable, and to determine
@@ -116,6 +130,12 @@ type volumeAttachmentDoc struct {
 	Life      Life                    `bson:"life"`
 	Info      *VolumeAttachmentInfo   `bson:"info,omitempty"`
 	Params    *VolumeAttachmentParams `bson:"params,omitempty"`
+
+	// Usage records the usage of the filesystem mounted on the
+	// volume's block device, as most recently reported by the
+	// machine agent. It is not migrated, as it will be reported
+	// again by the machine agent.
+	Usage *FilesystemUsage `bson:"usage,omitempty"`
 }
 
 // VolumeParams records parameters for provisioning a new volume.
@@ -131,6 +151,10 @@ type VolumeParams struct {
 
 	Pool string `bson:"pool"`
 	Size uint64 `bson:"size"`
+
+	// Snapshot is the ID of the volume snapshot from which to
+	// create the volume, if any.
+	Snapshot string `bson:"snapshot,omitempty"`
 }
 
 // VolumeInfo describes information about a volume.
@@ -212,6 +236,14 @@ func (v *volume) Releasing() bool {
 	return v.doc.Releasing
 }
 
+// RequestedSize is required to implement Volume.
+func (v *volume) RequestedSize() (uint64, bool) {
+	if v.doc.RequestedSize == 0 {
+		return 0, false
+	}
+	return v.doc.RequestedSize, true
+}
+
 // Status is required to implement StatusGetter.
 func (v *volume) Status() (status.StatusInfo, error) {
 	return v.im.VolumeStatus(v.VolumeTag())
@@ -253,6 +285,14 @@ func (v *volumeAttachment) Params() (VolumeAttachmentParams, bool) {
 	return *v.doc.Params, true
 }
 
+// Usage is required to implement VolumeAttachment.
+func (v *volumeAttachment) Usage() (FilesystemUsage, error) {
+	if v.doc.Usage == nil {
+		return FilesystemUsage{}, errors.NotFoundf("usage of volume %q on %q", v.doc.Volume, v.doc.Machine)
+	}
+	return *v.doc.Usage, nil
+}
+
 // Volume returns the Volume with the specified name.
 func (im *IAASModel) Volume(tag names.VolumeTag) (Volume, error) {
 	v, err := im.volumeByTag(tag)
@@ -888,6 +928,11 @@ func (im *IAASModel) validateVolumeParams(params VolumeParams, machineId string)
 	if params.Size == 0 {
 		return "", errors.New("invalid size 0")
 	}
+	if params.Snapshot != "" {
+		if err := validateVolumeSnapshotParams(im, params.Snapshot, params.Pool, params.Size); err != nil {
+			return "", errors.Trace(err)
+		}
+	}
 	return machineId, nil
 }
 
@@ -1021,6 +1066,76 @@ func setVolumeAttachmentInfoOps(machine names.MachineTag, volume names.VolumeTag
 	}}
 }
 
+// SetVolumeAttachmentUsage records the usage of the filesystem mounted on
+// the block device of the specified volume on the specified machine, as
+// measured by the machine agent. The volume attachment must be provisioned.
+//
+// The status of the volume is then updated to reflect the usage reported
+// for all of its attachments; see storageUsageStatus.
+func (im *IAASModel) SetVolumeAttachmentUsage(
+	machineTag names.MachineTag,
+	volumeTag names.VolumeTag,
+	usage FilesystemUsage,
+) (err error) {
+	defer errors.DeferredAnnotatef(&err, "cannot set usage for volume attachment %s:%s", volumeTag.Id(), machineTag.Id())
+	buildTxn := func(attempt int) ([]txn.Op, error) {
+		va, err := im.VolumeAttachment(machineTag, volumeTag)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		if va.Life() == Dead {
+			return nil, errors.New("volume attachment is dead")
+		}
+		if _, err := va.Info(); err != nil {
+			return nil, errors.Trace(err)
+		}
+		return []txn.Op{{
+			C:  volumeAttachmentsC,
+			Id: volumeAttachmentId(machineTag.Id(), volumeTag.Id()),
+			Assert: bson.D{
+				{"life", bson.D{{"$ne", Dead}}},
+				{"info", bson.D{{"$exists", true}}},
+			},
+			Update: bson.D{{"$set", bson.D{{"usage", &usage}}}},
+		}}, nil
+	}
+	if err := im.mb.db().Run(buildTxn); err != nil {
+		return errors.Trace(err)
+	}
+	return errors.Trace(im.updateVolumeUsageStatus(volumeTag))
+}
+
+// updateVolumeUsageStatus sets the status of the specified volume
+// according to the usage reported for each of its attachments.
+func (im *IAASModel) updateVolumeUsageStatus(tag names.VolumeTag) error {
+	modelConfig, err := im.ModelConfig()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	attachments, err := im.VolumeAttachments(tag)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	var usages []FilesystemUsage
+	for _, attachment := range attachments {
+		if attachment.Life() != Alive {
+			continue
+		}
+		if usage, err := attachment.Usage(); err == nil {
+			usages = append(usages, usage)
+		}
+	}
+	current, err := im.VolumeStatus(tag)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	next, ok := storageUsageStatus(current, usages, modelConfig.StorageUsageWarningThreshold())
+	if !ok {
+		return nil
+	}
+	return errors.Trace(im.SetVolumeStatus(tag, next.Status, next.Message, nil, nil))
+}
+
 // setProvisionedVolumeInfo sets the initial info for newly
 // provisioned volumes. If non-empty, machineId must be the
 // machine ID associated with the volumes.
@@ -1060,11 +1175,23 @@ func (im *IAASModel) SetVolumeInfo(tag names.VolumeTag, info VolumeInfo) (err er
 			if err != nil {
 				return nil, err
 			}
+			// Storage providers do not know which pool a volume
+			// was created from, so updates made after provisioning
+			// (e.g. when resizing) retain the existing pool.
+			if info.Pool == "" {
+				info.Pool = oldInfo.Pool
+			}
 			if err := validateVolumeInfoChange(info, oldInfo); err != nil {
 				return nil, err
 			}
 		}
-		ops = append(ops, setVolumeInfoOps(tag, info, unsetParams)...)
+		// If the volume has been grown to the requested
+		// size, then the resize request is complete.
+		var unsetRequestedSize bool
+		if requestedSize, ok := v.RequestedSize(); ok && info.Size >= requestedSize {
+			unsetRequestedSize = true
+		}
+		ops = append(ops, setVolumeInfoOps(tag, info, unsetParams, unsetRequestedSize)...)
 		return ops, nil
 	}
 	return im.mb.db().Run(buildTxn)
@@ -1086,15 +1213,22 @@ func validateVolumeInfoChange(newInfo, oldInfo VolumeInfo) error {
 	return nil
 }
 
-func setVolumeInfoOps(tag names.VolumeTag, info VolumeInfo, unsetParams bool) []txn.Op {
+func setVolumeInfoOps(tag names.VolumeTag, info VolumeInfo, unsetParams, unsetRequestedSize bool) []txn.Op {
 	asserts := isAliveDoc
 	update := bson.D{
 		{"$set", bson.D{{"info", &info}}},
 	}
+	var unset bson.D
 	if unsetParams {
 		asserts = append(asserts, bson.DocElem{"info", bson.D{{"$exists", false}}})
 		asserts = append(asserts, bson.DocElem{"params", bson.D{{"$exists", true}}})
-		update = append(update, bson.DocElem{"$unset", bson.D{{"params", nil}}})
+		unset = append(unset, bson.DocElem{"params", nil})
+	}
+	if unsetRequestedSize {
+		unset = append(unset, bson.DocElem{"requestedsize", nil})
+	}
+	if len(unset) > 0 {
+		update = append(update, bson.DocElem{"$unset", unset})
 	}
 	return []txn.Op{{
 		C:      volumesC,
@@ -1104,6 +1238,50 @@ func setVolumeInfoOps(tag names.VolumeTag, info VolumeInfo, unsetParams bool) []
 	}}
 }
 
+// ResizeVolume requests that the specified volume be grown to the given
+// size in MiB. The volume must be alive and provisioned, and the size
+// must be larger than the volume's current size. The storage provisioner
+// responsible for the volume grows it, and then records the new size
+// with SetVolumeInfo.
+func (im *IAASModel) ResizeVolume(tag names.VolumeTag, size uint64) (err error) {
+	defer errors.DeferredAnnotatef(&err, "cannot resize volume %s", tag.Id())
+	buildTxn := func(attempt int) ([]txn.Op, error) {
+		v, err := im.volumeByTag(tag)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		if v.Life() != Alive {
+			return nil, errors.New("volume is not alive")
+		}
+		info, err := v.Info()
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		if size <= info.Size {
+			return nil, errors.Errorf(
+				"new size %dMiB must be larger than current size %dMiB", size, info.Size,
+			)
+		}
+		if requestedSize, ok := v.RequestedSize(); ok && requestedSize == size {
+			return nil, jujutxn.ErrNoOperations
+		}
+		providerType, provider, err := poolStorageProvider(im, info.Pool)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		if resizer, ok := provider.(storage.VolumeResizingProvider); !ok || !resizer.ResizableVolumes() {
+			return nil, errors.NotSupportedf("resizing volumes with storage provider %q", providerType)
+		}
+		return []txn.Op{{
+			C:      volumesC,
+			Id:     v.doc.Name,
+			Assert: append(isAliveDoc, bson.DocElem{"info.size", info.Size}),
+			Update: bson.D{{"$set", bson.D{{"requestedsize", size}}}},
+		}}, nil
+	}
+	return im.mb.db().Run(buildTxn)
+}
+
 // AllVolumes returns all Volumes scoped to the model.
 func (im *IAASModel) AllVolumes() ([]Volume, error) {
 	volumes, err := im.volumes(nil)
@@ -1126,7 +1304,7 @@ func (im *IAASModel) VolumeStatus(tag names.VolumeTag) (status.StatusInfo, error
 func (im *IAASModel) SetVolumeStatus(tag names.VolumeTag, volumeStatus status.Status, info string, data map[string]interface{}, updated *time.Time) error {
 	switch volumeStatus {
 	case status.Attaching, status.Attached, status.Detaching, status.Detached, status.Destroying:
-	case status.Error:
+	case status.Error, status.Warning:
 		if info == "" {
 			return errors.Errorf("cannot set status %q without info", volumeStatus)
 		}
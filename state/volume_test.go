@@ -14,6 +14,7 @@ import (
 	"github.com/juju/juju/provider/dummy"
 	"github.com/juju/juju/state"
 	"github.com/juju/juju/state/testing"
+	"github.com/juju/juju/status"
 	"github.com/juju/juju/storage"
 	"github.com/juju/juju/storage/poolmanager"
 	"github.com/juju/juju/storage/provider"
@@ -231,14 +232,143 @@ func (s *VolumeStateSuite) TestSetVolumeInfoImmutable(c *gc.C) {
 	c.Assert(err, jc.ErrorIsNil)
 
 	// The first call to SetVolumeInfo takes the pool name from
-	// the params; the second does not, but it must not change
-	// either. Callers are expected to get the existing info and
-	// update it, leaving immutable values intact.
+	// the params; subsequent calls retain it if unspecified, but
+	// it must not change.
+	volumeInfoSet.Pool = "modelscoped"
 	err = s.IAASModel.SetVolumeInfo(volume.VolumeTag(), volumeInfoSet)
-	c.Assert(err, gc.ErrorMatches, `cannot set info for volume "0/0": cannot change pool from "loop-pool" to ""`)
+	c.Assert(err, gc.ErrorMatches, `cannot set info for volume "0/0": cannot change pool from "loop-pool" to "modelscoped"`)
 
-	volumeInfoSet.Pool = "loop-pool"
-	s.assertVolumeInfo(c, volumeTag, volumeInfoSet)
+	volumeInfoSet.VolumeId = "vol-ume2"
+	volumeInfoSet.Pool = ""
+	err = s.IAASModel.SetVolumeInfo(volume.VolumeTag(), volumeInfoSet)
+	c.Assert(err, gc.ErrorMatches, `cannot set info for volume "0/0": cannot change volume ID from "vol-ume" to "vol-ume2"`)
+
+	s.assertVolumeInfo(c, volumeTag, state.VolumeInfo{Size: 123, VolumeId: "vol-ume", Pool: "loop-pool"})
+}
+
+func (s *VolumeStateSuite) TestSetVolumeInfoRetainsPool(c *gc.C) {
+	_, u, storageTag := s.setupSingleStorage(c, "block", "loop-pool")
+	err := s.State.AssignUnit(u, state.AssignCleanEmpty)
+	c.Assert(err, jc.ErrorIsNil)
+	volumeTag := s.storageInstanceVolume(c, storageTag).VolumeTag()
+
+	err = s.IAASModel.SetVolumeInfo(volumeTag, state.VolumeInfo{Size: 123, VolumeId: "vol-ume"})
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.IAASModel.SetVolumeInfo(volumeTag, state.VolumeInfo{Size: 456, VolumeId: "vol-ume"})
+	c.Assert(err, jc.ErrorIsNil)
+	s.assertVolumeInfo(c, volumeTag, state.VolumeInfo{Size: 456, VolumeId: "vol-ume", Pool: "loop-pool"})
+}
+
+func (s *VolumeStateSuite) TestResizeVolume(c *gc.C) {
+	_, u, storageTag := s.setupSingleStorage(c, "block", "loop-pool")
+	err := s.State.AssignUnit(u, state.AssignCleanEmpty)
+	c.Assert(err, jc.ErrorIsNil)
+	volumeTag := s.storageInstanceVolume(c, storageTag).VolumeTag()
+
+	err = s.IAASModel.SetVolumeInfo(volumeTag, state.VolumeInfo{Size: 123, VolumeId: "vol-ume"})
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.IAASModel.ResizeVolume(volumeTag, 456)
+	c.Assert(err, jc.ErrorIsNil)
+
+	volume := s.volume(c, volumeTag)
+	size, ok := volume.RequestedSize()
+	c.Assert(ok, jc.IsTrue)
+	c.Assert(size, gc.Equals, uint64(456))
+
+	// Recording the new size completes the resize.
+	err = s.IAASModel.SetVolumeInfo(volumeTag, state.VolumeInfo{
+		Size: 456, VolumeId: "vol-ume", Pool: "loop-pool",
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	volume = s.volume(c, volumeTag)
+	_, ok = volume.RequestedSize()
+	c.Assert(ok, jc.IsFalse)
+}
+
+func (s *VolumeStateSuite) TestResizeVolumeNotLarger(c *gc.C) {
+	_, u, storageTag := s.setupSingleStorage(c, "block", "loop-pool")
+	err := s.State.AssignUnit(u, state.AssignCleanEmpty)
+	c.Assert(err, jc.ErrorIsNil)
+	volumeTag := s.storageInstanceVolume(c, storageTag).VolumeTag()
+
+	err = s.IAASModel.SetVolumeInfo(volumeTag, state.VolumeInfo{Size: 123, VolumeId: "vol-ume"})
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.IAASModel.ResizeVolume(volumeTag, 123)
+	c.Assert(err, gc.ErrorMatches, `cannot resize volume 0/0: new size 123MiB must be larger than current size 123MiB`)
+}
+
+func (s *VolumeStateSuite) TestResizeVolumeNotProvisioned(c *gc.C) {
+	_, u, storageTag := s.setupSingleStorage(c, "block", "loop-pool")
+	err := s.State.AssignUnit(u, state.AssignCleanEmpty)
+	c.Assert(err, jc.ErrorIsNil)
+	volumeTag := s.storageInstanceVolume(c, storageTag).VolumeTag()
+
+	err = s.IAASModel.ResizeVolume(volumeTag, 456)
+	c.Assert(err, jc.Satisfies, errors.IsNotProvisioned)
+}
+
+func (s *VolumeStateSuite) TestResizeVolumeNotSupported(c *gc.C) {
+	_, u, storageTag := s.setupSingleStorage(c, "block", "modelscoped-block")
+	err := s.State.AssignUnit(u, state.AssignCleanEmpty)
+	c.Assert(err, jc.ErrorIsNil)
+	volumeTag := s.storageInstanceVolume(c, storageTag).VolumeTag()
+
+	err = s.IAASModel.SetVolumeInfo(volumeTag, state.VolumeInfo{Size: 123, VolumeId: "vol-ume"})
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.IAASModel.ResizeVolume(volumeTag, 456)
+	c.Assert(err, jc.Satisfies, errors.IsNotSupported)
+	c.Assert(err, gc.ErrorMatches, `cannot resize volume 0/0: resizing volumes with storage provider "modelscoped-block" not supported`)
+
+	_, ok := s.volume(c, volumeTag).RequestedSize()
+	c.Assert(ok, jc.IsFalse)
+}
+
+func (s *VolumeStateSuite) TestResizeStorageInstanceBlock(c *gc.C) {
+	_, u, storageTag := s.setupSingleStorage(c, "block", "loop-pool")
+	err := s.State.AssignUnit(u, state.AssignCleanEmpty)
+	c.Assert(err, jc.ErrorIsNil)
+	volumeTag := s.storageInstanceVolume(c, storageTag).VolumeTag()
+	err = s.IAASModel.SetVolumeInfo(volumeTag, state.VolumeInfo{Size: 123, VolumeId: "vol-ume"})
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = s.IAASModel.ResizeStorageInstance(storageTag, 1024)
+	c.Assert(err, jc.ErrorIsNil)
+	size, ok := s.volume(c, volumeTag).RequestedSize()
+	c.Assert(ok, jc.IsTrue)
+	c.Assert(size, gc.Equals, uint64(1024))
+}
+
+func (s *VolumeStateSuite) TestResizeStorageInstanceFilesystemNoBackingVolume(c *gc.C) {
+	_, u, storageTag := s.setupSingleStorage(c, "filesystem", "rootfs")
+	err := s.State.AssignUnit(u, state.AssignCleanEmpty)
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = s.IAASModel.ResizeStorageInstance(storageTag, 1024)
+	c.Assert(err, jc.Satisfies, errors.IsNotSupported)
+	c.Assert(err, gc.ErrorMatches, `resizing storage data/0: resizing filesystem not backed by a volume not supported`)
+}
+
+func (s *VolumeStateSuite) TestWatchModelVolumeResizes(c *gc.C) {
+	_, u, storageTag := s.setupSingleStorageDetachable(c, "block", "modelscoped")
+	err := s.State.AssignUnit(u, state.AssignCleanEmpty)
+	c.Assert(err, jc.ErrorIsNil)
+	volumeTag := s.storageInstanceVolume(c, storageTag).VolumeTag()
+
+	w := s.IAASModel.WatchModelVolumeResizes()
+	defer testing.AssertStop(c, w)
+	wc := testing.NewStringsWatcherC(c, s.State, w)
+	wc.AssertChangeInSingleEvent(volumeTag.Id()) // initial
+	wc.AssertNoChange()
+
+	err = s.IAASModel.SetVolumeInfo(volumeTag, state.VolumeInfo{Size: 123, VolumeId: "vol-ume"})
+	c.Assert(err, jc.ErrorIsNil)
+	wc.AssertChangeInSingleEvent(volumeTag.Id())
+	wc.AssertNoChange()
+
+	err = s.IAASModel.ResizeVolume(volumeTag, 456)
+	c.Assert(err, jc.ErrorIsNil)
+	wc.AssertChangeInSingleEvent(volumeTag.Id())
+	wc.AssertNoChange()
 }
 
 func (s *VolumeStateSuite) TestWatchVolumeAttachment(c *gc.C) {
@@ -624,6 +754,42 @@ func (s *VolumeStateSuite) TestSetVolumeAttachmentInfoVolumeNotProvisioned(c *gc
 	c.Assert(err, gc.ErrorMatches, `cannot set info for volume attachment 0/0:0: volume "0/0" not provisioned`)
 }
 
+func (s *VolumeStateSuite) TestSetVolumeAttachmentUsage(c *gc.C) {
+	_, u, storageTag := s.setupSingleStorage(c, "block", "modelscoped")
+	s.provisionStorageVolume(c, u, storageTag)
+	machineTag := unitMachine(c, s.State, u).MachineTag()
+	volumeTag := s.storageInstanceVolume(c, storageTag).VolumeTag()
+	err := s.IAASModel.SetVolumeStatus(volumeTag, status.Attached, "", nil, nil)
+	c.Assert(err, jc.ErrorIsNil)
+
+	volumeAttachment := s.volumeAttachment(c, machineTag, volumeTag)
+	_, err = volumeAttachment.Usage()
+	c.Assert(err, jc.Satisfies, errors.IsNotFound)
+
+	usage := state.FilesystemUsage{Size: 1024, Used: 973, Available: 51}
+	err = s.IAASModel.SetVolumeAttachmentUsage(machineTag, volumeTag, usage)
+	c.Assert(err, jc.ErrorIsNil)
+
+	volumeAttachment = s.volumeAttachment(c, machineTag, volumeTag)
+	stored, err := volumeAttachment.Usage()
+	c.Assert(err, jc.ErrorIsNil)
+	stored.Updated = usage.Updated
+	c.Assert(stored, jc.DeepEquals, usage)
+
+	info, err := s.IAASModel.VolumeStatus(volumeTag)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(info.Status, gc.Equals, status.Warning)
+	c.Assert(info.Message, gc.Equals, "95% used, above the warning threshold of 90%")
+}
+
+func (s *VolumeStateSuite) TestSetVolumeAttachmentUsageNotProvisioned(c *gc.C) {
+	volume, machine := s.setupModelScopedVolumeAttachment(c)
+	err := s.IAASModel.SetVolumeAttachmentUsage(
+		machine.MachineTag(), volume.VolumeTag(), state.FilesystemUsage{},
+	)
+	c.Assert(err, gc.ErrorMatches, `cannot set usage for volume attachment 0:0: volume attachment "0" on "0" not provisioned`)
+}
+
 func (s *VolumeStateSuite) TestDestroyVolume(c *gc.C) {
 	volume, _ := s.setupMachineScopedVolumeAttachment(c)
 	assertDestroy := func() {
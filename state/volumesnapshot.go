@@ -0,0 +1,443 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/juju/errors"
+	jujutxn "github.com/juju/txn"
+	"gopkg.in/juju/names.v2"
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+	"gopkg.in/mgo.v2/txn"
+)
+
+// VolumeSnapshot describes a point-in-time copy of a volume's contents.
+//
+// Snapshots are requested by users, and taken by the storage provisioner
+// responsible for the volume. Snapshots outlive the volumes they were
+// taken of, and may be used to restore a volume's contents, or to create
+// new volumes.
+type VolumeSnapshot interface {
+	// Id returns the model-unique ID of the snapshot.
+	Id() string
+
+	// Volume returns the tag of the volume that the snapshot was
+	// taken of.
+	Volume() names.VolumeTag
+
+	// StorageInstance returns the tag of the storage instance that the
+	// volume was assigned to when the snapshot was requested. If the
+	// volume was not assigned to a storage instance, an error satisfying
+	// errors.IsNotAssigned will be returned.
+	StorageInstance() (names.StorageTag, error)
+
+	// Pool returns the name of the storage pool that the volume
+	// was provisioned from.
+	Pool() string
+
+	// Created returns the time at which the snapshot was requested.
+	Created() time.Time
+
+	// Info returns the snapshot's VolumeSnapshotInfo, or a
+	// NotProvisioned error if the snapshot has not yet been taken.
+	Info() (VolumeSnapshotInfo, error)
+
+	// Restoring reports whether or not the volume is to be restored
+	// from the snapshot.
+	Restoring() bool
+
+	// Message returns the error message from the most recent failed
+	// attempt to take or restore the snapshot, if any.
+	Message() string
+}
+
+// VolumeSnapshotInfo describes information about a volume snapshot.
+type VolumeSnapshotInfo struct {
+	// SnapshotId is the provider-allocated unique ID of the snapshot.
+	SnapshotId string `bson:"snapshotid"`
+
+	// Size is the size of the snapshotted volume, in MiB.
+	Size uint64 `bson:"size"`
+}
+
+type volumeSnapshot struct {
+	doc volumeSnapshotDoc
+}
+
+// volumeSnapshotDoc records information about a volume snapshot.
+type volumeSnapshotDoc struct {
+	DocID     string              `bson:"_id"`
+	Id        string              `bson:"id"`
+	ModelUUID string              `bson:"model-uuid"`
+	Volume    string              `bson:"volumeid"`
+	StorageId string              `bson:"storageid,omitempty"`
+	Pool      string              `bson:"pool"`
+	Created   time.Time           `bson:"created"`
+	Info      *VolumeSnapshotInfo `bson:"info,omitempty"`
+	Restoring bool                `bson:"restoring,omitempty"`
+	Message   string              `bson:"message,omitempty"`
+}
+
+// Id is required to implement VolumeSnapshot.
+func (s *volumeSnapshot) Id() string {
+	return s.doc.Id
+}
+
+// Volume is required to implement VolumeSnapshot.
+func (s *volumeSnapshot) Volume() names.VolumeTag {
+	return names.NewVolumeTag(s.doc.Volume)
+}
+
+// StorageInstance is required to implement VolumeSnapshot.
+func (s *volumeSnapshot) StorageInstance() (names.StorageTag, error) {
+	if s.doc.StorageId == "" {
+		msg := fmt.Sprintf("volume snapshot %q is not assigned to any storage instance", s.doc.Id)
+		return names.StorageTag{}, errors.NewNotAssigned(nil, msg)
+	}
+	return names.NewStorageTag(s.doc.StorageId), nil
+}
+
+// Pool is required to implement VolumeSnapshot.
+func (s *volumeSnapshot) Pool() string {
+	return s.doc.Pool
+}
+
+// Created is required to implement VolumeSnapshot.
+func (s *volumeSnapshot) Created() time.Time {
+	return s.doc.Created
+}
+
+// Info is required to implement VolumeSnapshot.
+func (s *volumeSnapshot) Info() (VolumeSnapshotInfo, error) {
+	if s.doc.Info == nil {
+		return VolumeSnapshotInfo{}, errors.NotProvisionedf("volume snapshot %q", s.doc.Id)
+	}
+	return *s.doc.Info, nil
+}
+
+// Restoring is required to implement VolumeSnapshot.
+func (s *volumeSnapshot) Restoring() bool {
+	return s.doc.Restoring
+}
+
+// Message is required to implement VolumeSnapshot.
+func (s *volumeSnapshot) Message() string {
+	return s.doc.Message
+}
+
+// VolumeSnapshot returns the VolumeSnapshot with the specified ID.
+func (im *IAASModel) VolumeSnapshot(id string) (VolumeSnapshot, error) {
+	s, err := im.volumeSnapshot(id)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return s, nil
+}
+
+func (im *IAASModel) volumeSnapshot(id string) (*volumeSnapshot, error) {
+	coll, closer := im.mb.db().GetCollection(volumeSnapshotsC)
+	defer closer()
+
+	var doc volumeSnapshotDoc
+	err := coll.FindId(id).One(&doc)
+	if err == mgo.ErrNotFound {
+		return nil, errors.NotFoundf("volume snapshot %q", id)
+	} else if err != nil {
+		return nil, errors.Annotatef(err, "getting volume snapshot %q", id)
+	}
+	return &volumeSnapshot{doc}, nil
+}
+
+// AllVolumeSnapshots returns all of the volume snapshots in the model.
+func (im *IAASModel) AllVolumeSnapshots() ([]VolumeSnapshot, error) {
+	coll, closer := im.mb.db().GetCollection(volumeSnapshotsC)
+	defer closer()
+
+	var docs []volumeSnapshotDoc
+	if err := coll.Find(nil).All(&docs); err != nil {
+		return nil, errors.Annotate(err, "querying volume snapshots")
+	}
+	snapshots := make([]VolumeSnapshot, len(docs))
+	for i, doc := range docs {
+		snapshots[i] = &volumeSnapshot{doc}
+	}
+	return snapshots, nil
+}
+
+// SnapshotStorageInstance requests a snapshot of the volume backing the
+// specified storage instance. Filesystem storage can only be snapshotted
+// if the filesystem is backed by a volume.
+func (im *IAASModel) SnapshotStorageInstance(tag names.StorageTag) (_ VolumeSnapshot, err error) {
+	defer errors.DeferredAnnotatef(&err, "snapshotting %s", names.ReadableString(tag))
+	si, err := im.storageInstance(tag)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	volumeTag, err := im.storageInstanceBackingVolume(si)
+	if err == ErrNoBackingVolume {
+		return nil, errors.NotSupportedf("snapshotting filesystem not backed by a volume")
+	} else if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return im.SnapshotVolume(volumeTag)
+}
+
+// SnapshotVolume requests a snapshot of the specified volume. The volume
+// must be alive and provisioned. The storage provisioner responsible for
+// the volume takes the snapshot, and then records the snapshot's details
+// with SetVolumeSnapshotInfo.
+func (im *IAASModel) SnapshotVolume(tag names.VolumeTag) (_ VolumeSnapshot, err error) {
+	defer errors.DeferredAnnotatef(&err, "cannot snapshot volume %s", tag.Id())
+	var doc volumeSnapshotDoc
+	buildTxn := func(attempt int) ([]txn.Op, error) {
+		v, err := im.volumeByTag(tag)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		if v.Life() != Alive {
+			return nil, errors.New("volume is not alive")
+		}
+		info, err := v.Info()
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		seq, err := sequence(im.mb, "volumesnapshot")
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		doc = volumeSnapshotDoc{
+			Id:        fmt.Sprint(seq),
+			Volume:    tag.Id(),
+			StorageId: v.doc.StorageId,
+			Pool:      info.Pool,
+			Created:   im.mb.clock().Now().UTC(),
+		}
+		return []txn.Op{{
+			C:      volumesC,
+			Id:     v.doc.Name,
+			Assert: isAliveDoc,
+		}, {
+			C:      volumeSnapshotsC,
+			Id:     doc.Id,
+			Assert: txn.DocMissing,
+			Insert: &doc,
+		}}, nil
+	}
+	if err := im.mb.db().Run(buildTxn); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &volumeSnapshot{doc}, nil
+}
+
+// SetVolumeSnapshotInfo records the details of a volume snapshot that
+// has been taken. Any error message previously recorded for the snapshot
+// is cleared.
+func (im *IAASModel) SetVolumeSnapshotInfo(id string, info VolumeSnapshotInfo) (err error) {
+	defer errors.DeferredAnnotatef(&err, "cannot set info for volume snapshot %q", id)
+	if info.SnapshotId == "" {
+		return errors.New("snapshot ID not set")
+	}
+	buildTxn := func(attempt int) ([]txn.Op, error) {
+		s, err := im.volumeSnapshot(id)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		if oldInfo, err := s.Info(); err == nil {
+			if oldInfo.SnapshotId != info.SnapshotId {
+				return nil, errors.Errorf(
+					"cannot change snapshot ID from %q to %q",
+					oldInfo.SnapshotId, info.SnapshotId,
+				)
+			}
+			if oldInfo == info && s.doc.Message == "" {
+				return nil, jujutxn.ErrNoOperations
+			}
+		}
+		return []txn.Op{{
+			C:      volumeSnapshotsC,
+			Id:     id,
+			Assert: txn.DocExists,
+			Update: bson.D{
+				{"$set", bson.D{{"info", &info}}},
+				{"$unset", bson.D{{"message", nil}}},
+			},
+		}}, nil
+	}
+	return im.mb.db().Run(buildTxn)
+}
+
+// SetVolumeSnapshotMessage records the error message from a failed
+// attempt to take or restore a volume snapshot.
+func (im *IAASModel) SetVolumeSnapshotMessage(id, message string) (err error) {
+	defer errors.DeferredAnnotatef(&err, "cannot set message for volume snapshot %q", id)
+	if _, err := im.volumeSnapshot(id); err != nil {
+		return errors.Trace(err)
+	}
+	return im.mb.db().RunTransaction([]txn.Op{{
+		C:      volumeSnapshotsC,
+		Id:     id,
+		Assert: txn.DocExists,
+		Update: bson.D{{"$set", bson.D{{"message", message}}}},
+	}})
+}
+
+// RestoreStorageInstance requests that the contents of the volume backing
+// the specified storage instance be restored from the specified snapshot.
+// The snapshot must have been taken of the same volume.
+func (im *IAASModel) RestoreStorageInstance(tag names.StorageTag, snapshotId string) (err error) {
+	defer errors.DeferredAnnotatef(&err, "restoring %s", names.ReadableString(tag))
+	si, err := im.storageInstance(tag)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	volumeTag, err := im.storageInstanceBackingVolume(si)
+	if err == ErrNoBackingVolume {
+		return errors.NotSupportedf("restoring filesystem not backed by a volume")
+	} else if err != nil {
+		return errors.Trace(err)
+	}
+	return im.RestoreVolume(volumeTag, snapshotId)
+}
+
+// RestoreVolume requests that the contents of the specified volume be
+// restored from the specified snapshot. The volume must be alive, and
+// the snapshot must have been taken of the volume. The storage provisioner
+// responsible for the volume restores it, and then records the completion
+// with SetVolumeSnapshotRestored.
+func (im *IAASModel) RestoreVolume(tag names.VolumeTag, snapshotId string) (err error) {
+	defer errors.DeferredAnnotatef(&err, "cannot restore volume %s", tag.Id())
+	buildTxn := func(attempt int) ([]txn.Op, error) {
+		v, err := im.volumeByTag(tag)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		if v.Life() != Alive {
+			return nil, errors.New("volume is not alive")
+		}
+		s, err := im.volumeSnapshot(snapshotId)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		if s.Volume() != tag {
+			return nil, errors.Errorf(
+				"snapshot %q was taken of volume %s",
+				snapshotId, s.doc.Volume,
+			)
+		}
+		if _, err := s.Info(); err != nil {
+			return nil, errors.Trace(err)
+		}
+		if s.Restoring() {
+			return nil, jujutxn.ErrNoOperations
+		}
+		return []txn.Op{{
+			C:      volumesC,
+			Id:     v.doc.Name,
+			Assert: isAliveDoc,
+		}, {
+			C:      volumeSnapshotsC,
+			Id:     snapshotId,
+			Assert: bson.D{{"info", bson.D{{"$exists", true}}}},
+			Update: bson.D{
+				{"$set", bson.D{{"restoring", true}}},
+				{"$unset", bson.D{{"message", nil}}},
+			},
+		}}, nil
+	}
+	return im.mb.db().Run(buildTxn)
+}
+
+// SetVolumeSnapshotRestored records that the volume has been restored
+// from the specified snapshot.
+func (im *IAASModel) SetVolumeSnapshotRestored(id string) (err error) {
+	defer errors.DeferredAnnotatef(&err, "cannot complete restore from volume snapshot %q", id)
+	buildTxn := func(attempt int) ([]txn.Op, error) {
+		s, err := im.volumeSnapshot(id)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		if !s.Restoring() {
+			return nil, jujutxn.ErrNoOperations
+		}
+		return []txn.Op{{
+			C:      volumeSnapshotsC,
+			Id:     id,
+			Assert: bson.D{{"restoring", true}},
+			Update: bson.D{{"$unset", bson.D{
+				{"restoring", nil},
+				{"message", nil},
+			}}},
+		}}, nil
+	}
+	return im.mb.db().Run(buildTxn)
+}
+
+// validateVolumeSnapshotParams validates that a volume with the
+// specified pool and size can be created from the specified snapshot.
+func validateVolumeSnapshotParams(im *IAASModel, snapshotId, poolName string, size uint64) error {
+	s, err := im.volumeSnapshot(snapshotId)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	info, err := s.Info()
+	if errors.IsNotProvisioned(err) {
+		return errors.Errorf("volume snapshot %q has not been taken yet", snapshotId)
+	} else if err != nil {
+		return errors.Trace(err)
+	}
+	providerType, provider, err := poolStorageProvider(im, poolName)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if !provider.Dynamic() {
+		// Volumes are created from snapshots by the storage
+		// provisioner, which cannot create non-dynamic volumes.
+		return errors.NotSupportedf(
+			"creating volumes from snapshots with non-dynamic storage provider %q",
+			providerType,
+		)
+	}
+	snapshotProviderType, _, err := poolStorageProvider(im, s.Pool())
+	if err != nil {
+		return errors.Annotatef(err, "getting storage provider for volume snapshot %q", snapshotId)
+	}
+	if providerType != snapshotProviderType {
+		return errors.Errorf(
+			"volume snapshot %q was taken by the %q provider, pool %q uses the %q provider",
+			snapshotId, snapshotProviderType, poolName, providerType,
+		)
+	}
+	if size < info.Size {
+		return errors.Errorf(
+			"size %dMiB is smaller than volume snapshot %q (%dMiB)",
+			size, snapshotId, info.Size,
+		)
+	}
+	return nil
+}
+
+// storageConstraintsWithSnapshot returns constraints derived from cons,
+// with the pool and size taken from the volume snapshot that cons refers
+// to, if they are not specified.
+func storageConstraintsWithSnapshot(im *IAASModel, cons StorageConstraints) (StorageConstraints, error) {
+	if cons.Snapshot == "" || (cons.Pool != "" && cons.Size != 0) {
+		return cons, nil
+	}
+	s, err := im.volumeSnapshot(cons.Snapshot)
+	if err != nil {
+		return cons, errors.Trace(err)
+	}
+	if cons.Pool == "" {
+		cons.Pool = s.Pool()
+	}
+	if cons.Size == 0 {
+		if info, err := s.Info(); err == nil {
+			cons.Size = info.Size
+		}
+	}
+	return cons, nil
+}
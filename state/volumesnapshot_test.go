@@ -0,0 +1,272 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state_test
+
+import (
+	"github.com/juju/errors"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+	"gopkg.in/juju/names.v2"
+
+	"github.com/juju/juju/state"
+	"github.com/juju/juju/state/testing"
+)
+
+type VolumeSnapshotSuite struct {
+	StorageStateSuiteBase
+}
+
+var _ = gc.Suite(&VolumeSnapshotSuite{})
+
+func (s *VolumeSnapshotSuite) setupProvisionedVolume(c *gc.C) (*state.Unit, names.StorageTag, names.VolumeTag) {
+	_, u, storageTag := s.setupSingleStorage(c, "block", "loop-pool")
+	err := s.State.AssignUnit(u, state.AssignCleanEmpty)
+	c.Assert(err, jc.ErrorIsNil)
+	volumeTag := s.storageInstanceVolume(c, storageTag).VolumeTag()
+	err = s.IAASModel.SetVolumeInfo(volumeTag, state.VolumeInfo{Size: 1024, VolumeId: "vol-ume"})
+	c.Assert(err, jc.ErrorIsNil)
+	return u, storageTag, volumeTag
+}
+
+func (s *VolumeSnapshotSuite) takeSnapshot(c *gc.C, storageTag names.StorageTag) state.VolumeSnapshot {
+	snapshot, err := s.IAASModel.SnapshotStorageInstance(storageTag)
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.IAASModel.SetVolumeSnapshotInfo(snapshot.Id(), state.VolumeSnapshotInfo{
+		SnapshotId: "snap-" + snapshot.Id(),
+		Size:       1024,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	return snapshot
+}
+
+func (s *VolumeSnapshotSuite) TestSnapshotStorageInstance(c *gc.C) {
+	_, storageTag, volumeTag := s.setupProvisionedVolume(c)
+
+	snapshot, err := s.IAASModel.SnapshotStorageInstance(storageTag)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(snapshot.Id(), gc.Equals, "0")
+	c.Assert(snapshot.Volume(), gc.Equals, volumeTag)
+	c.Assert(snapshot.Pool(), gc.Equals, "loop-pool")
+	c.Assert(snapshot.Created().IsZero(), jc.IsFalse)
+	snapshotStorage, err := snapshot.StorageInstance()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(snapshotStorage, gc.Equals, storageTag)
+	_, err = snapshot.Info()
+	c.Assert(err, jc.Satisfies, errors.IsNotProvisioned)
+
+	err = s.IAASModel.SetVolumeSnapshotInfo(snapshot.Id(), state.VolumeSnapshotInfo{
+		SnapshotId: "snap-0",
+		Size:       1024,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	snapshot, err = s.IAASModel.VolumeSnapshot("0")
+	c.Assert(err, jc.ErrorIsNil)
+	info, err := snapshot.Info()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(info, jc.DeepEquals, state.VolumeSnapshotInfo{SnapshotId: "snap-0", Size: 1024})
+
+	all, err := s.IAASModel.AllVolumeSnapshots()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(all, gc.HasLen, 1)
+	c.Assert(all[0].Id(), gc.Equals, "0")
+}
+
+func (s *VolumeSnapshotSuite) TestSnapshotStorageInstanceNotProvisioned(c *gc.C) {
+	_, u, storageTag := s.setupSingleStorage(c, "block", "loop-pool")
+	err := s.State.AssignUnit(u, state.AssignCleanEmpty)
+	c.Assert(err, jc.ErrorIsNil)
+
+	_, err = s.IAASModel.SnapshotStorageInstance(storageTag)
+	c.Assert(err, jc.Satisfies, errors.IsNotProvisioned)
+}
+
+func (s *VolumeSnapshotSuite) TestSnapshotStorageInstanceFilesystemNoBackingVolume(c *gc.C) {
+	_, u, storageTag := s.setupSingleStorage(c, "filesystem", "rootfs")
+	err := s.State.AssignUnit(u, state.AssignCleanEmpty)
+	c.Assert(err, jc.ErrorIsNil)
+
+	_, err = s.IAASModel.SnapshotStorageInstance(storageTag)
+	c.Assert(err, jc.Satisfies, errors.IsNotSupported)
+	c.Assert(err, gc.ErrorMatches, `snapshotting storage data/0: snapshotting filesystem not backed by a volume not supported`)
+}
+
+func (s *VolumeSnapshotSuite) TestVolumeSnapshotNotFound(c *gc.C) {
+	_, err := s.IAASModel.VolumeSnapshot("42")
+	c.Assert(err, jc.Satisfies, errors.IsNotFound)
+	c.Assert(err, gc.ErrorMatches, `volume snapshot "42" not found`)
+}
+
+func (s *VolumeSnapshotSuite) TestSetVolumeSnapshotInfoImmutable(c *gc.C) {
+	_, storageTag, _ := s.setupProvisionedVolume(c)
+	snapshot := s.takeSnapshot(c, storageTag)
+
+	err := s.IAASModel.SetVolumeSnapshotInfo(snapshot.Id(), state.VolumeSnapshotInfo{
+		SnapshotId: "snap-other",
+		Size:       1024,
+	})
+	c.Assert(err, gc.ErrorMatches, `cannot set info for volume snapshot "0": cannot change snapshot ID from "snap-0" to "snap-other"`)
+}
+
+func (s *VolumeSnapshotSuite) TestSetVolumeSnapshotMessage(c *gc.C) {
+	_, storageTag, _ := s.setupProvisionedVolume(c)
+	snapshot, err := s.IAASModel.SnapshotStorageInstance(storageTag)
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = s.IAASModel.SetVolumeSnapshotMessage(snapshot.Id(), "out of quota")
+	c.Assert(err, jc.ErrorIsNil)
+	snapshot, err = s.IAASModel.VolumeSnapshot(snapshot.Id())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(snapshot.Message(), gc.Equals, "out of quota")
+
+	// Recording the snapshot's info clears the message.
+	err = s.IAASModel.SetVolumeSnapshotInfo(snapshot.Id(), state.VolumeSnapshotInfo{
+		SnapshotId: "snap-0",
+		Size:       1024,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	snapshot, err = s.IAASModel.VolumeSnapshot(snapshot.Id())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(snapshot.Message(), gc.Equals, "")
+}
+
+func (s *VolumeSnapshotSuite) TestRestoreStorageInstance(c *gc.C) {
+	_, storageTag, _ := s.setupProvisionedVolume(c)
+	snapshot := s.takeSnapshot(c, storageTag)
+
+	err := s.IAASModel.RestoreStorageInstance(storageTag, snapshot.Id())
+	c.Assert(err, jc.ErrorIsNil)
+	snapshot, err = s.IAASModel.VolumeSnapshot(snapshot.Id())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(snapshot.Restoring(), jc.IsTrue)
+
+	err = s.IAASModel.SetVolumeSnapshotRestored(snapshot.Id())
+	c.Assert(err, jc.ErrorIsNil)
+	snapshot, err = s.IAASModel.VolumeSnapshot(snapshot.Id())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(snapshot.Restoring(), jc.IsFalse)
+}
+
+func (s *VolumeSnapshotSuite) TestRestoreStorageInstanceNotTaken(c *gc.C) {
+	_, storageTag, _ := s.setupProvisionedVolume(c)
+	snapshot, err := s.IAASModel.SnapshotStorageInstance(storageTag)
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = s.IAASModel.RestoreStorageInstance(storageTag, snapshot.Id())
+	c.Assert(err, jc.Satisfies, errors.IsNotProvisioned)
+}
+
+func (s *VolumeSnapshotSuite) TestRestoreStorageInstanceOtherVolume(c *gc.C) {
+	u, storageTag, _ := s.setupProvisionedVolume(c)
+	snapshot := s.takeSnapshot(c, storageTag)
+
+	storageTags, err := s.IAASModel.AddStorageForUnit(u.UnitTag(), "allecto", state.StorageConstraints{
+		Pool:  "loop-pool",
+		Size:  1024,
+		Count: 1,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(storageTags, gc.HasLen, 1)
+	otherVolume := s.storageInstanceVolume(c, storageTags[0])
+	err = s.IAASModel.SetVolumeInfo(otherVolume.VolumeTag(), state.VolumeInfo{Size: 1024, VolumeId: "vol-other"})
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = s.IAASModel.RestoreStorageInstance(storageTags[0], snapshot.Id())
+	c.Assert(err, gc.ErrorMatches, `restoring storage .*: cannot restore volume .*: snapshot "0" was taken of volume 0/0`)
+}
+
+func (s *VolumeSnapshotSuite) TestAddStorageForUnitFromSnapshot(c *gc.C) {
+	u, storageTag, _ := s.setupProvisionedVolume(c)
+	snapshot := s.takeSnapshot(c, storageTag)
+
+	// The pool and size default to those of the snapshot.
+	storageTags, err := s.IAASModel.AddStorageForUnit(u.UnitTag(), "allecto", state.StorageConstraints{
+		Snapshot: snapshot.Id(),
+		Count:    1,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(storageTags, gc.HasLen, 1)
+	volume := s.storageInstanceVolume(c, storageTags[0])
+	params, ok := volume.Params()
+	c.Assert(ok, jc.IsTrue)
+	c.Assert(params, jc.DeepEquals, state.VolumeParams{
+		Pool:     "loop-pool",
+		Size:     1024,
+		Snapshot: snapshot.Id(),
+	})
+}
+
+func (s *VolumeSnapshotSuite) TestAddStorageForUnitFromSnapshotTooSmall(c *gc.C) {
+	u, storageTag, _ := s.setupProvisionedVolume(c)
+	snapshot := s.takeSnapshot(c, storageTag)
+
+	_, err := s.IAASModel.AddStorageForUnit(u.UnitTag(), "allecto", state.StorageConstraints{
+		Snapshot: snapshot.Id(),
+		Size:     512,
+		Count:    1,
+	})
+	c.Assert(err, gc.ErrorMatches, `adding "allecto" storage to storage-block/0: size 512MiB is smaller than volume snapshot "0" \(1024MiB\)`)
+}
+
+func (s *VolumeSnapshotSuite) TestAddStorageForUnitFromSnapshotNotTaken(c *gc.C) {
+	u, storageTag, _ := s.setupProvisionedVolume(c)
+	snapshot, err := s.IAASModel.SnapshotStorageInstance(storageTag)
+	c.Assert(err, jc.ErrorIsNil)
+
+	_, err = s.IAASModel.AddStorageForUnit(u.UnitTag(), "allecto", state.StorageConstraints{
+		Snapshot: snapshot.Id(),
+		Size:     1024,
+		Count:    1,
+	})
+	c.Assert(err, gc.ErrorMatches, `adding "allecto" storage to storage-block/0: volume snapshot "0" has not been taken yet`)
+}
+
+func (s *VolumeSnapshotSuite) TestAddApplicationFromSnapshotNonDynamic(c *gc.C) {
+	_, storageTag, _ := s.setupProvisionedVolume(c)
+	snapshot := s.takeSnapshot(c, storageTag)
+
+	ch := s.AddTestingCharm(c, "storage-block")
+	_, err := s.State.AddApplication(state.AddApplicationArgs{
+		Name:  "storage-block2",
+		Charm: ch,
+		Storage: map[string]state.StorageConstraints{
+			"data": {Pool: "static", Size: 1024, Count: 1, Snapshot: snapshot.Id()},
+		},
+	})
+	c.Assert(err, gc.ErrorMatches, `.*creating volumes from snapshots with non-dynamic storage provider "static" not supported`)
+}
+
+func (s *VolumeSnapshotSuite) TestWatchModelVolumeSnapshots(c *gc.C) {
+	_, u, storageTag := s.setupSingleStorageDetachable(c, "block", "modelscoped")
+	err := s.State.AssignUnit(u, state.AssignCleanEmpty)
+	c.Assert(err, jc.ErrorIsNil)
+	volumeTag := s.storageInstanceVolume(c, storageTag).VolumeTag()
+	err = s.IAASModel.SetVolumeInfo(volumeTag, state.VolumeInfo{Size: 1024, VolumeId: "vol-ume"})
+	c.Assert(err, jc.ErrorIsNil)
+
+	w := s.IAASModel.WatchModelVolumeSnapshots()
+	defer testing.AssertStop(c, w)
+	wc := testing.NewStringsWatcherC(c, s.State, w)
+	wc.AssertChangeInSingleEvent() // initial
+	wc.AssertNoChange()
+
+	snapshot, err := s.IAASModel.SnapshotStorageInstance(storageTag)
+	c.Assert(err, jc.ErrorIsNil)
+	wc.AssertChangeInSingleEvent(snapshot.Id())
+	wc.AssertNoChange()
+
+	err = s.IAASModel.SetVolumeSnapshotInfo(snapshot.Id(), state.VolumeSnapshotInfo{
+		SnapshotId: "snap-0",
+		Size:       1024,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	wc.AssertChangeInSingleEvent(snapshot.Id())
+	wc.AssertNoChange()
+
+	// Snapshots of machine-scoped volumes are not reported.
+	machineWatcher := s.IAASModel.WatchMachineVolumeSnapshots(names.NewMachineTag("0"))
+	defer testing.AssertStop(c, machineWatcher)
+	mwc := testing.NewStringsWatcherC(c, s.State, machineWatcher)
+	mwc.AssertChangeInSingleEvent() // initial
+	mwc.AssertNoChange()
+}
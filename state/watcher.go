@@ -232,14 +232,27 @@ func (im *IAASModel) watchModelMachinestorage(collection string) StringsWatcher
 	mb := im.mb
 	pattern := fmt.Sprintf("^%s$", mb.docID(names.NumberSnippet))
 	members := bson.D{{"_id", bson.D{{"$regex", pattern}}}}
-	filter := func(id interface{}) bool {
+	return newLifecycleWatcher(mb, collection, members, modelMachinestorageFilter(mb), nil)
+}
+
+func modelMachinestorageFilter(mb modelBackend) func(interface{}) bool {
+	return func(id interface{}) bool {
 		k, err := mb.strictLocalID(id.(string))
 		if err != nil {
 			return false
 		}
 		return !strings.Contains(k, "/")
 	}
-	return newLifecycleWatcher(mb, collection, members, filter, nil)
+}
+
+// WatchModelVolumeResizes returns a StringsWatcher that notifies of
+// changes to model-scoped volumes, including requests to resize them.
+// Consumers should check each reported volume's RequestedSize.
+func (im *IAASModel) WatchModelVolumeResizes() StringsWatcher {
+	return newCollectionWatcher(im.mb, colWCfg{
+		col:    volumesC,
+		filter: modelMachinestorageFilter(im.mb),
+	})
 }
 
 // WatchMachineVolumes returns a StringsWatcher that notifies of changes to
@@ -258,15 +271,66 @@ func (im *IAASModel) watchMachineStorage(m names.MachineTag, collection string)
 	mb := im.mb
 	pattern := fmt.Sprintf("^%s/%s$", mb.docID(m.Id()), names.NumberSnippet)
 	members := bson.D{{"_id", bson.D{{"$regex", pattern}}}}
+	return newLifecycleWatcher(mb, collection, members, machineStorageFilter(mb, m), nil)
+}
+
+func machineStorageFilter(mb modelBackend, m names.MachineTag) func(interface{}) bool {
 	prefix := m.Id() + "/"
-	filter := func(id interface{}) bool {
+	return func(id interface{}) bool {
 		k, err := mb.strictLocalID(id.(string))
 		if err != nil {
 			return false
 		}
 		return strings.HasPrefix(k, prefix)
 	}
-	return newLifecycleWatcher(mb, collection, members, filter, nil)
+}
+
+// WatchMachineVolumeResizes returns a StringsWatcher that notifies of
+// changes to the volumes scoped to the specified machine, including
+// requests to resize them. Consumers should check each reported
+// volume's RequestedSize.
+func (im *IAASModel) WatchMachineVolumeResizes(m names.MachineTag) StringsWatcher {
+	return newCollectionWatcher(im.mb, colWCfg{
+		col:    volumesC,
+		filter: machineStorageFilter(im.mb, m),
+	})
+}
+
+// WatchModelVolumeSnapshots returns a StringsWatcher that notifies of
+// changes to snapshots of model-scoped volumes, including requests to
+// take and restore them. Consumers should check each reported snapshot's
+// Info and Restoring.
+func (im *IAASModel) WatchModelVolumeSnapshots() StringsWatcher {
+	return im.watchVolumeSnapshots(modelMachinestorageFilter(im.mb))
+}
+
+// WatchMachineVolumeSnapshots returns a StringsWatcher that notifies of
+// changes to snapshots of the volumes scoped to the specified machine,
+// including requests to take and restore them. Consumers should check
+// each reported snapshot's Info and Restoring.
+func (im *IAASModel) WatchMachineVolumeSnapshots(m names.MachineTag) StringsWatcher {
+	return im.watchVolumeSnapshots(machineStorageFilter(im.mb, m))
+}
+
+// watchVolumeSnapshots returns a StringsWatcher that notifies of changes
+// to the volume snapshots whose volume IDs are accepted by volumeFilter.
+func (im *IAASModel) watchVolumeSnapshots(volumeFilter func(interface{}) bool) StringsWatcher {
+	mb := im.mb
+	filter := func(id interface{}) bool {
+		coll, closer := mb.db().GetCollection(volumeSnapshotsC)
+		defer closer()
+		var doc struct {
+			Volume string `bson:"volumeid"`
+		}
+		if err := coll.FindId(id).Select(bson.D{{"volumeid", 1}}).One(&doc); err != nil {
+			return false
+		}
+		return volumeFilter(mb.docID(doc.Volume))
+	}
+	return newCollectionWatcher(mb, colWCfg{
+		col:    volumeSnapshotsC,
+		filter: filter,
+	})
 }
 
 // WatchModelVolumeAttachments returns a StringsWatcher that notifies of
@@ -913,10 +977,11 @@ func (w *RelationScopeWatcher) loop() error {
 
 // relationUnitsWatcher sends notifications of units entering and leaving the
 // scope of a RelationUnit, and changes to the settings of those units known
-// to have entered.
+// to have entered, and of the applications they belong to.
 type relationUnitsWatcher struct {
 	commonWatcher
 	sw       *RelationScopeWatcher
+	appKeys  map[string]string
 	watching set.Strings
 	updates  chan watcher.Change
 	out      chan params.RelationUnitsChange
@@ -925,7 +990,10 @@ type relationUnitsWatcher struct {
 // Watch returns a watcher that notifies of changes to conterpart units in
 // the relation.
 func (ru *RelationUnit) Watch() RelationUnitsWatcher {
-	return newRelationUnitsWatcher(ru.st, ru.WatchScope())
+	role := counterpartRole(ru.endpoint.Role)
+	// In a peer relation the unit's own application is its
+	// counterpart, so every peer sees changes to its settings.
+	return newRelationUnitsWatcher(ru.st, ru.WatchScope(), ru.relation.applicationSettingsKeys(role))
 }
 
 // WatchUnits returns a watcher that notifies of changes to the units of the
@@ -948,13 +1016,32 @@ func (r *Relation) watchUnits(applicationName string, counterpart bool) (Relatio
 		role = counterpartRole(role)
 	}
 	rsw := watchRelationScope(r.st, r.globalScope(), role, "")
-	return newRelationUnitsWatcher(r.st, rsw), nil
+	return newRelationUnitsWatcher(r.st, rsw, r.applicationSettingsKeys(role)), nil
 }
 
-func newRelationUnitsWatcher(backend modelBackend, sw *RelationScopeWatcher) RelationUnitsWatcher {
+// applicationSettingsKeys returns the names of the applications
+// with the given role in the relation, keyed on the keys of the
+// settings they publish in it.
+func (r *Relation) applicationSettingsKeys(role charm.RelationRole) map[string]string {
+	keys := make(map[string]string)
+	for _, ep := range r.doc.Endpoints {
+		if ep.Role == role {
+			keys[relationApplicationSettingsKey(r.doc.Id, ep.ApplicationName)] = ep.ApplicationName
+		}
+	}
+	return keys
+}
+
+func newRelationUnitsWatcher(backend modelBackend, sw *RelationScopeWatcher, appKeys map[string]string) RelationUnitsWatcher {
+	// Changes to the settings docs are reported by doc id.
+	appDocIDs := make(map[string]string)
+	for key, appName := range appKeys {
+		appDocIDs[backend.docID(key)] = appName
+	}
 	w := &relationUnitsWatcher{
 		commonWatcher: newCommonWatcher(backend),
 		sw:            sw,
+		appKeys:       appDocIDs,
 		watching:      make(set.Strings),
 		updates:       make(chan watcher.Change),
 		out:           make(chan params.RelationUnitsChange),
@@ -975,7 +1062,7 @@ func (w *relationUnitsWatcher) Changes() <-chan params.RelationUnitsChange {
 }
 
 func emptyRelationUnitsChanges(changes *params.RelationUnitsChange) bool {
-	return len(changes.Changed)+len(changes.Departed) == 0
+	return len(changes.Changed)+len(changes.Departed)+len(changes.AppChanged) == 0
 }
 
 func setRelationUnitChangeVersion(changes *params.RelationUnitsChange, key string, version int64) {
@@ -1002,6 +1089,43 @@ func (w *relationUnitsWatcher) mergeSettings(changes *params.RelationUnitsChange
 	return doc.TxnRevno, nil
 }
 
+// mergeAppSettings reads the relation settings node for the application
+// with the supplied doc id, and sets a value in the AppChanged field keyed
+// on the application's name. It returns the mgo/txn revision number of
+// the settings node, or -1 if the application has published no settings.
+func (w *relationUnitsWatcher) mergeAppSettings(changes *params.RelationUnitsChange, docID string) (int64, error) {
+	var doc struct {
+		TxnRevno int64 `bson:"txn-revno"`
+		Version  int64 `bson:"version"`
+	}
+	err := readSettingsDocInto(w.backend.db(), settingsC, docID, &doc)
+	if errors.IsNotFound(err) {
+		return -1, nil
+	} else if err != nil {
+		return -1, err
+	}
+	if changes.AppChanged == nil {
+		changes.AppChanged = map[string]int64{}
+	}
+	changes.AppChanged[w.appKeys[docID]] = doc.Version
+	return doc.TxnRevno, nil
+}
+
+// watchAppSettings starts settings watches on the applications whose
+// units are being watched, and records their current settings versions
+// in the supplied RelationUnitsChange event.
+func (w *relationUnitsWatcher) watchAppSettings(changes *params.RelationUnitsChange) error {
+	for docID := range w.appKeys {
+		revno, err := w.mergeAppSettings(changes, docID)
+		if err != nil {
+			return err
+		}
+		w.watcher.Watch(settingsC, docID, revno, w.updates)
+		w.watching.Add(docID)
+	}
+	return nil
+}
+
 // mergeScope starts and stops settings watches on the units entering and
 // leaving the scope in the supplied RelationScopeChange event, and applies
 // the expressed changes to the supplied RelationUnitsChange event.
@@ -1057,6 +1181,9 @@ func (w *relationUnitsWatcher) loop() (err error) {
 		changes     params.RelationUnitsChange
 		out         chan<- params.RelationUnitsChange
 	)
+	if err := w.watchAppSettings(&changes); err != nil {
+		return err
+	}
 	for {
 		select {
 		case <-w.watcher.Dead():
@@ -1080,7 +1207,11 @@ func (w *relationUnitsWatcher) loop() (err error) {
 			if !ok {
 				logger.Warningf("ignoring bad relation scope id: %#v", c.Id)
 			}
-			if _, err := w.mergeSettings(&changes, id); err != nil {
+			if _, ok := w.appKeys[id]; ok {
+				if _, err := w.mergeAppSettings(&changes, id); err != nil {
+					return err
+				}
+			} else if _, err := w.mergeSettings(&changes, id); err != nil {
 				return err
 			}
 			out = w.out
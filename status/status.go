@@ -167,6 +167,11 @@ const (
 	// Detached indicates that the storage is not attached to
 	// any machine.
 	Detached Status = "detached"
+
+	// Warning indicates that the storage is attached to a
+	// machine, but its usage has exceeded the model's storage
+	// usage warning threshold.
+	Warning Status = "warning"
 )
 
 const (
@@ -27,6 +27,10 @@ type Constraints struct {
 
 	// Count is the number of instances of the storage to create.
 	Count uint64
+
+	// Snapshot is the ID of the volume snapshot from which to
+	// create the storage, or "" if the storage should be empty.
+	Snapshot string
 }
 
 var (
@@ -35,11 +39,13 @@ var (
 	sizeRE  = regexp.MustCompile("^-?[0-9]+(?:\\.[0-9]+)?[MGTPEZY](?:i?B)?$")
 )
 
+const snapshotPrefix = "snapshot:"
+
 // ParseConstraints parses the specified string and creates a
 // Constraints structure.
 //
 // The acceptable format for storage constraints is a comma separated
-// sequence of: POOL, COUNT, and SIZE, where
+// sequence of: POOL, COUNT, SIZE and SNAPSHOT, where
 //
 //    POOL identifies the storage pool. POOL can be a string
 //    starting with a letter, followed by zero or more digits
@@ -53,6 +59,11 @@ var (
 //    create. SIZE is a floating point number and multiplier from
 //    the set (M, G, T, P, E, Z, Y), which are all treated as
 //    powers of 1024.
+//
+//    SNAPSHOT is "snapshot:" followed by the ID of a volume
+//    snapshot from which to create the storage instances. If
+//    POOL or SIZE are unspecified, they are taken from the
+//    snapshot.
 func ParseConstraints(s string) (Constraints, error) {
 	var cons Constraints
 	fields := strings.Split(s, ",")
@@ -60,6 +71,13 @@ func ParseConstraints(s string) (Constraints, error) {
 		if field == "" {
 			continue
 		}
+		if strings.HasPrefix(field, snapshotPrefix) {
+			cons.Snapshot = strings.TrimPrefix(field, snapshotPrefix)
+			if cons.Snapshot == "" {
+				return cons, errors.New("cannot parse snapshot: snapshot ID not specified")
+			}
+			continue
+		}
 		if IsValidPoolName(field) {
 			if cons.Pool != "" {
 				logger.Debugf("pool name is already set to %q, ignoring %q", cons.Pool, field)
@@ -84,7 +102,7 @@ func ParseConstraints(s string) (Constraints, error) {
 		}
 		logger.Debugf("ignoring unknown storage constraint %q", field)
 	}
-	if cons.Count == 0 && cons.Size == 0 && cons.Pool == "" {
+	if cons.Count == 0 && cons.Size == 0 && cons.Pool == "" && cons.Snapshot == "" {
 		return Constraints{}, errors.New("storage constraints require at least one field to be specified")
 	}
 	if cons.Count == 0 {
@@ -75,6 +75,20 @@ func (s *ConstraintsSuite) TestParseConstraintsSizeRange(c *gc.C) {
 	s.testParseError(c, "p,-100M", `cannot parse size: expected a non-negative number, got "-100M"`)
 }
 
+func (s *ConstraintsSuite) TestParseConstraintsSnapshot(c *gc.C) {
+	s.testParse(c, "snapshot:3", storage.Constraints{
+		Count:    1,
+		Snapshot: "3",
+	})
+	s.testParse(c, "p,2G,snapshot:3", storage.Constraints{
+		Pool:     "p",
+		Count:    1,
+		Size:     2048,
+		Snapshot: "3",
+	})
+	s.testParseError(c, "p,snapshot:", `cannot parse snapshot: snapshot ID not specified`)
+}
+
 func (*ConstraintsSuite) testParse(c *gc.C, s string, expect storage.Constraints) {
 	cons, err := storage.ParseConstraints(s)
 	c.Check(err, jc.ErrorIsNil)
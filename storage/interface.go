@@ -86,6 +86,32 @@ type Provider interface {
 	ValidateConfig(*Config) error
 }
 
+// SharedFilesystemProvider is an optional interface that may be
+// implemented by storage providers whose filesystems can be attached
+// to multiple machines at once. Only such providers may be used for
+// charm storage declared as shared.
+type SharedFilesystemProvider interface {
+	// SharedFilesystems reports whether or not the filesystems
+	// created by the provider may be attached to multiple machines
+	// concurrently.
+	SharedFilesystems() bool
+
+	// SharedFilesystemsOnly reports whether or not the provider may
+	// only be used for shared storage, i.e. storage that is owned by
+	// an application rather than a unit.
+	SharedFilesystemsOnly() bool
+}
+
+// VolumeResizingProvider is an optional interface that may be
+// implemented by storage providers whose volume sources can grow
+// provisioned volumes. Only volumes managed by such providers may
+// be resized.
+type VolumeResizingProvider interface {
+	// ResizableVolumes reports whether or not the volume sources
+	// created by the provider implement VolumeResizer.
+	ResizableVolumes() bool
+}
+
 // VolumeSource provides an interface for creating, destroying, describing,
 // attaching and detaching volumes in the environment. A VolumeSource is
 // configured in a particular way, and corresponds to a storage "pool".
@@ -213,6 +239,31 @@ type VolumeImporter interface {
 	) (VolumeInfo, error)
 }
 
+// VolumeResizer provides an interface for growing volumes that
+// have already been provisioned.
+type VolumeResizer interface {
+	// ResizeVolumes grows the volumes with the specified parameters,
+	// returning the updated volume information. Volumes cannot be
+	// shrunk; implementations should return an error if the
+	// requested size is smaller than the volume's current size.
+	ResizeVolumes(params []VolumeResizeParams) ([]ResizeVolumesResult, error)
+}
+
+// VolumeSnapshotter provides an interface for taking snapshots of
+// volumes, and restoring volumes from them. Volume sources that
+// implement VolumeSnapshotter must also support creating volumes
+// from snapshots, as requested by VolumeParams.SnapshotId.
+type VolumeSnapshotter interface {
+	// CreateVolumeSnapshots takes snapshots of the volumes with the
+	// specified parameters, returning the snapshot information.
+	CreateVolumeSnapshots(params []VolumeSnapshotParams) ([]CreateVolumeSnapshotsResult, error)
+
+	// RestoreVolumeSnapshots replaces the contents of the volumes
+	// with the specified parameters with the contents of the
+	// corresponding snapshots.
+	RestoreVolumeSnapshots(params []VolumeSnapshotParams) ([]error, error)
+}
+
 // VolumeParams is a fully specified set of parameters for volume creation,
 // derived from one or more of user-specified storage constraints, a
 // storage pool definition, and charm storage metadata.
@@ -247,6 +298,45 @@ type VolumeParams struct {
 	// once the instance is created there are still unprovisioned volumes,
 	// the dynamic storage provisioner will take care of creating them.
 	Attachment *VolumeAttachmentParams
+
+	// SnapshotId is the unique provider-supplied ID of the snapshot
+	// from which to create the volume, or empty if the volume should
+	// be created empty. Only volume sources that implement
+	// VolumeSnapshotter support creating volumes from snapshots.
+	SnapshotId string
+}
+
+// VolumeResizeParams is a set of parameters for resizing a volume.
+type VolumeResizeParams struct {
+	// Tag is the unique tag assigned by Juju for the volume.
+	Tag names.VolumeTag
+
+	// VolumeId is the unique provider-supplied ID for the volume.
+	VolumeId string
+
+	// Attributes is a set of provider-specific options for the
+	// volume, taken from the configuration of its storage pool.
+	Attributes map[string]interface{}
+
+	// Size is the requested size of the volume in MiB.
+	Size uint64
+}
+
+// VolumeSnapshotParams is a set of parameters for taking a snapshot of a
+// volume, or restoring a volume from a snapshot.
+type VolumeSnapshotParams struct {
+	// Id is the unique ID assigned by Juju for the snapshot.
+	Id string
+
+	// Volume is the unique tag assigned by Juju for the volume.
+	Volume names.VolumeTag
+
+	// VolumeId is the unique provider-supplied ID for the volume.
+	VolumeId string
+
+	// SnapshotId is the unique provider-supplied ID for the snapshot.
+	// This is only set when restoring a volume from a snapshot.
+	SnapshotId string
 }
 
 // VolumeAttachmentParams is a set of parameters for volume attachment or
@@ -352,6 +442,21 @@ type AttachVolumesResult struct {
 	Error            error
 }
 
+// ResizeVolumesResult contains the result of a VolumeResizer.ResizeVolumes
+// call for one volume. Volume should only be used if Error is nil.
+type ResizeVolumesResult struct {
+	Volume *Volume
+	Error  error
+}
+
+// CreateVolumeSnapshotsResult contains the result of a
+// VolumeSnapshotter.CreateVolumeSnapshots call for one volume.
+// VolumeSnapshot should only be used if Error is nil.
+type CreateVolumeSnapshotsResult struct {
+	VolumeSnapshot *VolumeSnapshot
+	Error          error
+}
+
 // CreateFilesystemsResult contains the result of a FilesystemSource.CreateFilesystems call
 // for one filesystem. Filesystem should only be used if Error is nil.
 type CreateFilesystemsResult struct {
@@ -16,6 +16,11 @@ var (
 		LoopProviderType:   &loopProvider{logAndExec},
 		RootfsProviderType: &rootfsProvider{logAndExec},
 		TmpfsProviderType:  &tmpfsProvider{logAndExec},
+		// The LVM provider registered here cannot discover unused
+		// disks; the machine storage provisioner overrides it with
+		// one that can.
+		LVMProviderType: &lvmProvider{run: logAndExec},
+		NFSProviderType: &nfsProvider{logAndExec},
 	}
 )
 
@@ -33,6 +33,8 @@ func (s *providerCommonSuite) TestCommonProvidersExported(c *gc.C) {
 		provider.LoopProviderType,
 		provider.RootfsProviderType,
 		provider.TmpfsProviderType,
+		provider.LVMProviderType,
+		provider.NFSProviderType,
 	})
 }
 
@@ -15,6 +15,16 @@ func StorageProviders() storage.ProviderRegistry {
 				StorageScope: storage.ScopeEnviron,
 				IsDynamic:    true,
 				IsReleasable: true,
+				IsResizable:  true,
+			},
+			"modelscoped-shared": &StorageProvider{
+				StorageScope: storage.ScopeEnviron,
+				IsDynamic:    true,
+				IsReleasable: true,
+				IsShared:     true,
+				SupportsFunc: func(k storage.StorageKind) bool {
+					return k == storage.StorageKindFilesystem
+				},
 			},
 			"modelscoped-unreleasable": &StorageProvider{
 				StorageScope: storage.ScopeEnviron,
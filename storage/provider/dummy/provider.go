@@ -10,7 +10,11 @@ import (
 	"github.com/juju/juju/storage"
 )
 
-var _ storage.Provider = (*StorageProvider)(nil)
+var (
+	_ storage.Provider                 = (*StorageProvider)(nil)
+	_ storage.SharedFilesystemProvider = (*StorageProvider)(nil)
+	_ storage.VolumeResizingProvider   = (*StorageProvider)(nil)
+)
 
 // StorageProvider is an implementation of storage.Provider, suitable for testing.
 // Each method's default behaviour may be overridden by setting the corresponding
@@ -29,6 +33,18 @@ type StorageProvider struct {
 	// supports releasing storage.
 	IsReleasable bool
 
+	// IsShared defines whether or not the provider reports that its
+	// filesystems may be attached to multiple machines.
+	IsShared bool
+
+	// IsSharedOnly defines whether or not the provider reports that it
+	// may only be used for shared storage.
+	IsSharedOnly bool
+
+	// IsResizable defines whether or not the provider reports that its
+	// volumes may be resized.
+	IsResizable bool
+
 	// DefaultPools_ will be returned by DefaultPools.
 	DefaultPools_ []*storage.Config
 
@@ -103,6 +119,24 @@ func (p *StorageProvider) Releasable() bool {
 	return p.IsReleasable
 }
 
+// SharedFilesystems is defined on storage.SharedFilesystemProvider.
+func (p *StorageProvider) SharedFilesystems() bool {
+	p.MethodCall(p, "SharedFilesystems")
+	return p.IsShared
+}
+
+// SharedFilesystemsOnly is defined on storage.SharedFilesystemProvider.
+func (p *StorageProvider) SharedFilesystemsOnly() bool {
+	p.MethodCall(p, "SharedFilesystemsOnly")
+	return p.IsSharedOnly
+}
+
+// ResizableVolumes is defined on storage.VolumeResizingProvider.
+func (p *StorageProvider) ResizableVolumes() bool {
+	p.MethodCall(p, "ResizableVolumes")
+	return p.IsResizable
+}
+
 // DefaultPool is defined on storage.Provider.
 func (p *StorageProvider) DefaultPools() []*storage.Config {
 	p.MethodCall(p, "DefaultPools")
@@ -33,6 +33,32 @@ func LoopProvider(
 	return &loopProvider{run}
 }
 
+func LVMProvider(
+	run func(string, ...string) (string, error),
+	listBlockDevices func() ([]storage.BlockDevice, error),
+) storage.Provider {
+	return &lvmProvider{run, listBlockDevices}
+}
+
+func LVMVolumeSource(
+	run func(string, ...string) (string, error),
+	listBlockDevices func() ([]storage.BlockDevice, error),
+) storage.VolumeSource {
+	return &lvmVolumeSource{run, listBlockDevices}
+}
+
+func NFSProvider(run func(string, ...string) (string, error)) storage.Provider {
+	return &nfsProvider{run}
+}
+
+func NFSFilesystemSource(run func(string, ...string) (string, error)) (storage.FilesystemSource, *MockDirFuncs) {
+	dirFuncs := &MockDirFuncs{
+		osDirFuncs{run},
+		set.NewStrings(),
+	}
+	return &nfsFilesystemSource{dirFuncs, run}, dirFuncs
+}
+
 func NewMockManagedFilesystemSource(
 	run func(string, ...string) (string, error),
 	volumeBlockDevices map[names.VolumeTag]storage.BlockDevice,
@@ -29,6 +29,7 @@ type loopProvider struct {
 }
 
 var _ storage.Provider = (*loopProvider)(nil)
+var _ storage.VolumeResizingProvider = (*loopProvider)(nil)
 
 // ValidateConfig is defined on the Provider interface.
 func (*loopProvider) ValidateConfig(*storage.Config) error {
@@ -89,6 +90,11 @@ func (*loopProvider) Releasable() bool {
 	return false
 }
 
+// ResizableVolumes is defined on the VolumeResizingProvider interface.
+func (*loopProvider) ResizableVolumes() bool {
+	return true
+}
+
 // DefaultPools is defined on the Provider interface.
 func (*loopProvider) DefaultPools() []*storage.Config {
 	return nil
@@ -103,6 +109,8 @@ type loopVolumeSource struct {
 }
 
 var _ storage.VolumeSource = (*loopVolumeSource)(nil)
+var _ storage.VolumeResizer = (*loopVolumeSource)(nil)
+var _ storage.VolumeSnapshotter = (*loopVolumeSource)(nil)
 
 // CreateVolumes is defined on the VolumeSource interface.
 func (lvs *loopVolumeSource) CreateVolumes(args []storage.VolumeParams) ([]storage.CreateVolumesResult, error) {
@@ -123,6 +131,17 @@ func (lvs *loopVolumeSource) createVolume(params storage.VolumeParams) (storage.
 	if err := ensureDir(lvs.dirFuncs, filepath.Dir(loopFilePath)); err != nil {
 		return storage.Volume{}, errors.Trace(err)
 	}
+	if params.SnapshotId != "" {
+		snapshotFilePath, err := lvs.snapshotFilePath(params.SnapshotId)
+		if err != nil {
+			return storage.Volume{}, errors.Trace(err)
+		}
+		if err := copyBlockFile(lvs.run, snapshotFilePath, loopFilePath); err != nil {
+			return storage.Volume{}, errors.Annotatef(err, "restoring snapshot %q", params.SnapshotId)
+		}
+	}
+	// When creating from a snapshot, fallocate grows the
+	// copied file to the requested size if necessary.
 	if err := createBlockFile(lvs.run, loopFilePath, params.Size); err != nil {
 		return storage.Volume{}, errors.Annotate(err, "could not create block file")
 	}
@@ -247,6 +266,159 @@ func (lvs *loopVolumeSource) detachVolume(tag names.VolumeTag) error {
 	return nil
 }
 
+// ResizeVolumes is defined on the VolumeResizer interface.
+func (lvs *loopVolumeSource) ResizeVolumes(args []storage.VolumeResizeParams) ([]storage.ResizeVolumesResult, error) {
+	results := make([]storage.ResizeVolumesResult, len(args))
+	for i, arg := range args {
+		volume, err := lvs.resizeVolume(arg)
+		if err != nil {
+			results[i].Error = errors.Annotatef(err, "resizing volume %s", arg.Tag.Id())
+			continue
+		}
+		results[i].Volume = &volume
+	}
+	return results, nil
+}
+
+func (lvs *loopVolumeSource) resizeVolume(arg storage.VolumeResizeParams) (storage.Volume, error) {
+	loopFilePath := lvs.volumeFilePath(arg.Tag)
+	info, err := os.Stat(loopFilePath)
+	if err != nil {
+		return storage.Volume{}, errors.Annotate(err, "getting loop backing file size")
+	}
+	if currentSize := uint64(info.Size()) / (1024 * 1024); arg.Size < currentSize {
+		return storage.Volume{}, errors.Errorf(
+			"cannot shrink volume from %dMiB to %dMiB", currentSize, arg.Size,
+		)
+	}
+	if err := createBlockFile(lvs.run, loopFilePath, arg.Size); err != nil {
+		return storage.Volume{}, errors.Annotate(err, "could not grow block file")
+	}
+	// Loop devices do not notice that their backing file has grown
+	// until they are told to re-read its capacity.
+	deviceNames, err := associatedLoopDevices(lvs.run, loopFilePath)
+	if err != nil {
+		return storage.Volume{}, errors.Annotate(err, "locating loop device")
+	}
+	for _, deviceName := range deviceNames {
+		if _, err := lvs.run("losetup", "-c", path.Join("/dev", deviceName)); err != nil {
+			return storage.Volume{}, errors.Annotatef(err, "updating capacity of loop device %q", deviceName)
+		}
+	}
+	return storage.Volume{
+		arg.Tag,
+		storage.VolumeInfo{
+			VolumeId: arg.VolumeId,
+			Size:     arg.Size,
+		},
+	}, nil
+}
+
+// CreateVolumeSnapshots is defined on the VolumeSnapshotter interface.
+func (lvs *loopVolumeSource) CreateVolumeSnapshots(args []storage.VolumeSnapshotParams) ([]storage.CreateVolumeSnapshotsResult, error) {
+	results := make([]storage.CreateVolumeSnapshotsResult, len(args))
+	for i, arg := range args {
+		snapshot, err := lvs.createVolumeSnapshot(arg)
+		if err != nil {
+			results[i].Error = errors.Annotatef(err, "snapshotting volume %s", arg.Volume.Id())
+			continue
+		}
+		results[i].VolumeSnapshot = &snapshot
+	}
+	return results, nil
+}
+
+func (lvs *loopVolumeSource) createVolumeSnapshot(arg storage.VolumeSnapshotParams) (storage.VolumeSnapshot, error) {
+	// The snapshot is named after the ID Juju assigns, so that
+	// retrying after a failure overwrites any partial copy.
+	snapshotId := arg.Volume.String() + "-snapshot-" + arg.Id
+	snapshotFilePath, err := lvs.snapshotFilePath(snapshotId)
+	if err != nil {
+		return storage.VolumeSnapshot{}, errors.Trace(err)
+	}
+	if err := ensureDir(lvs.dirFuncs, filepath.Dir(snapshotFilePath)); err != nil {
+		return storage.VolumeSnapshot{}, errors.Trace(err)
+	}
+	loopFilePath := lvs.volumeFilePath(arg.Volume)
+	info, err := os.Stat(loopFilePath)
+	if err != nil {
+		return storage.VolumeSnapshot{}, errors.Annotate(err, "getting loop backing file size")
+	}
+	if err := copyBlockFile(lvs.run, loopFilePath, snapshotFilePath); err != nil {
+		return storage.VolumeSnapshot{}, errors.Trace(err)
+	}
+	return storage.VolumeSnapshot{
+		Id:     arg.Id,
+		Volume: arg.Volume,
+		VolumeSnapshotInfo: storage.VolumeSnapshotInfo{
+			SnapshotId: snapshotId,
+			Size:       uint64(info.Size()) / (1024 * 1024),
+		},
+	}, nil
+}
+
+// RestoreVolumeSnapshots is defined on the VolumeSnapshotter interface.
+func (lvs *loopVolumeSource) RestoreVolumeSnapshots(args []storage.VolumeSnapshotParams) ([]error, error) {
+	results := make([]error, len(args))
+	for i, arg := range args {
+		if err := lvs.restoreVolumeSnapshot(arg); err != nil {
+			results[i] = errors.Annotatef(err, "restoring volume %s", arg.Volume.Id())
+		}
+	}
+	return results, nil
+}
+
+func (lvs *loopVolumeSource) restoreVolumeSnapshot(arg storage.VolumeSnapshotParams) error {
+	snapshotFilePath, err := lvs.snapshotFilePath(arg.SnapshotId)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	loopFilePath := lvs.volumeFilePath(arg.Volume)
+	info, err := os.Stat(loopFilePath)
+	if err != nil {
+		return errors.Annotate(err, "getting loop backing file size")
+	}
+	// The backing file is overwritten in place, so that any loop
+	// devices attached to it see the restored contents. If the
+	// volume has grown since the snapshot was taken, it is grown
+	// back to its current size afterwards.
+	if err := copyBlockFile(lvs.run, snapshotFilePath, loopFilePath); err != nil {
+		return errors.Trace(err)
+	}
+	currentSize := uint64(info.Size()) / (1024 * 1024)
+	if err := createBlockFile(lvs.run, loopFilePath, currentSize); err != nil {
+		return errors.Annotate(err, "could not grow block file")
+	}
+	deviceNames, err := associatedLoopDevices(lvs.run, loopFilePath)
+	if err != nil {
+		return errors.Annotate(err, "locating loop device")
+	}
+	for _, deviceName := range deviceNames {
+		if _, err := lvs.run("losetup", "-c", path.Join("/dev", deviceName)); err != nil {
+			return errors.Annotatef(err, "updating capacity of loop device %q", deviceName)
+		}
+	}
+	return nil
+}
+
+// snapshotFilePath returns the path of the file holding the
+// snapshot with the specified provider ID.
+func (lvs *loopVolumeSource) snapshotFilePath(snapshotId string) (string, error) {
+	if snapshotId == "" || filepath.Base(snapshotId) != snapshotId {
+		return "", errors.NotValidf("snapshot ID %q", snapshotId)
+	}
+	return filepath.Join(lvs.storageDir, "snapshots", snapshotId), nil
+}
+
+// copyBlockFile copies the contents of one loop backing file over
+// another, preserving any holes in the source file.
+func copyBlockFile(run runCommandFunc, sourcePath, destPath string) error {
+	if _, err := run("cp", "--sparse=always", sourcePath, destPath); err != nil {
+		return errors.Annotatef(err, "copying loop backing file %q to %q", sourcePath, destPath)
+	}
+	return nil
+}
+
 // createBlockFile creates a file at the specified path, with the
 // given size in mebibytes.
 func createBlockFile(run runCommandFunc, filePath string, sizeInMiB uint64) error {
@@ -149,6 +149,146 @@ func (s *loopSuite) TestDestroyVolumesInvalidVolumeId(c *gc.C) {
 	c.Assert(errs[0], gc.ErrorMatches, `.* invalid loop volume ID "\.\./super/important/stuff"`)
 }
 
+func (s *loopSuite) TestResizeVolumes(c *gc.C) {
+	source, _ := s.loopVolumeSource(c)
+	fileName := filepath.Join(s.storageDir, "volume-0")
+	err := ioutil.WriteFile(fileName, make([]byte, 1024*1024), 0644)
+	c.Assert(err, jc.ErrorIsNil)
+
+	s.commands.expect("fallocate", "-l", "4MiB", fileName)
+	cmd := s.commands.expect("losetup", "-j", fileName)
+	cmd.respond("/dev/loop0: foo\n", nil)
+	s.commands.expect("losetup", "-c", "/dev/loop0")
+
+	resizer := source.(storage.VolumeResizer)
+	results, err := resizer.ResizeVolumes([]storage.VolumeResizeParams{{
+		Tag:      names.NewVolumeTag("0"),
+		VolumeId: "volume-0",
+		Size:     4,
+	}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, gc.HasLen, 1)
+	c.Assert(results[0].Error, jc.ErrorIsNil)
+	c.Assert(results[0].Volume, jc.DeepEquals, &storage.Volume{
+		names.NewVolumeTag("0"),
+		storage.VolumeInfo{
+			VolumeId: "volume-0",
+			Size:     4,
+		},
+	})
+}
+
+func (s *loopSuite) TestResizeVolumesShrink(c *gc.C) {
+	source, _ := s.loopVolumeSource(c)
+	fileName := filepath.Join(s.storageDir, "volume-0")
+	err := ioutil.WriteFile(fileName, make([]byte, 2*1024*1024), 0644)
+	c.Assert(err, jc.ErrorIsNil)
+
+	resizer := source.(storage.VolumeResizer)
+	results, err := resizer.ResizeVolumes([]storage.VolumeResizeParams{{
+		Tag:      names.NewVolumeTag("0"),
+		VolumeId: "volume-0",
+		Size:     1,
+	}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, gc.HasLen, 1)
+	c.Assert(results[0].Error, gc.ErrorMatches, "resizing volume 0: cannot shrink volume from 2MiB to 1MiB")
+}
+
+func (s *loopSuite) TestCreateVolumesFromSnapshot(c *gc.C) {
+	source, _ := s.loopVolumeSource(c)
+	fileName := filepath.Join(s.storageDir, "volume-1")
+	snapshotFileName := filepath.Join(s.storageDir, "snapshots", "volume-0-snapshot-2")
+	s.commands.expect("cp", "--sparse=always", snapshotFileName, fileName)
+	s.commands.expect("fallocate", "-l", "4MiB", fileName)
+
+	results, err := source.CreateVolumes([]storage.VolumeParams{{
+		Tag:        names.NewVolumeTag("1"),
+		Size:       4,
+		SnapshotId: "volume-0-snapshot-2",
+	}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, gc.HasLen, 1)
+	c.Assert(results[0].Error, jc.ErrorIsNil)
+}
+
+func (s *loopSuite) TestCreateVolumesInvalidSnapshotId(c *gc.C) {
+	source, _ := s.loopVolumeSource(c)
+	results, err := source.CreateVolumes([]storage.VolumeParams{{
+		Tag:        names.NewVolumeTag("1"),
+		Size:       4,
+		SnapshotId: "../super/important/stuff",
+	}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, gc.HasLen, 1)
+	c.Assert(results[0].Error, gc.ErrorMatches, `creating volume: snapshot ID "\.\./super/important/stuff" not valid`)
+}
+
+func (s *loopSuite) TestCreateVolumeSnapshots(c *gc.C) {
+	source, _ := s.loopVolumeSource(c)
+	fileName := filepath.Join(s.storageDir, "volume-0")
+	err := ioutil.WriteFile(fileName, make([]byte, 2*1024*1024), 0644)
+	c.Assert(err, jc.ErrorIsNil)
+
+	snapshotFileName := filepath.Join(s.storageDir, "snapshots", "volume-0-snapshot-2")
+	s.commands.expect("cp", "--sparse=always", fileName, snapshotFileName)
+
+	snapshotter := source.(storage.VolumeSnapshotter)
+	results, err := snapshotter.CreateVolumeSnapshots([]storage.VolumeSnapshotParams{{
+		Id:       "2",
+		Volume:   names.NewVolumeTag("0"),
+		VolumeId: "volume-0",
+	}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, gc.HasLen, 1)
+	c.Assert(results[0].Error, jc.ErrorIsNil)
+	c.Assert(results[0].VolumeSnapshot, jc.DeepEquals, &storage.VolumeSnapshot{
+		Id:     "2",
+		Volume: names.NewVolumeTag("0"),
+		VolumeSnapshotInfo: storage.VolumeSnapshotInfo{
+			SnapshotId: "volume-0-snapshot-2",
+			Size:       2,
+		},
+	})
+}
+
+func (s *loopSuite) TestCreateVolumeSnapshotsNoVolume(c *gc.C) {
+	source, _ := s.loopVolumeSource(c)
+	snapshotter := source.(storage.VolumeSnapshotter)
+	results, err := snapshotter.CreateVolumeSnapshots([]storage.VolumeSnapshotParams{{
+		Id:       "2",
+		Volume:   names.NewVolumeTag("0"),
+		VolumeId: "volume-0",
+	}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, gc.HasLen, 1)
+	c.Assert(results[0].Error, gc.ErrorMatches, "snapshotting volume 0: getting loop backing file size: .*")
+}
+
+func (s *loopSuite) TestRestoreVolumeSnapshots(c *gc.C) {
+	source, _ := s.loopVolumeSource(c)
+	fileName := filepath.Join(s.storageDir, "volume-0")
+	err := ioutil.WriteFile(fileName, make([]byte, 4*1024*1024), 0644)
+	c.Assert(err, jc.ErrorIsNil)
+
+	snapshotFileName := filepath.Join(s.storageDir, "snapshots", "volume-0-snapshot-2")
+	s.commands.expect("cp", "--sparse=always", snapshotFileName, fileName)
+	s.commands.expect("fallocate", "-l", "4MiB", fileName)
+	cmd := s.commands.expect("losetup", "-j", fileName)
+	cmd.respond("/dev/loop0: foo\n", nil)
+	s.commands.expect("losetup", "-c", "/dev/loop0")
+
+	snapshotter := source.(storage.VolumeSnapshotter)
+	errs, err := snapshotter.RestoreVolumeSnapshots([]storage.VolumeSnapshotParams{{
+		Id:         "2",
+		Volume:     names.NewVolumeTag("0"),
+		VolumeId:   "volume-0",
+		SnapshotId: "volume-0-snapshot-2",
+	}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(errs, jc.DeepEquals, []error{nil})
+}
+
 func (s *loopSuite) TestDescribeVolumes(c *gc.C) {
 	source, _ := s.loopVolumeSource(c)
 	_, err := source.DescribeVolumes([]string{"a", "b"})
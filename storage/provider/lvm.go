@@ -0,0 +1,430 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/juju/errors"
+	"github.com/juju/schema"
+
+	"github.com/juju/juju/storage"
+)
+
+const (
+	// LVMProviderType is the provider type of the LVM provider,
+	// which creates logical volumes in a volume group on the
+	// machine.
+	LVMProviderType = storage.ProviderType("lvm")
+
+	// LVMVolumeGroup is the name of the pool configuration attribute
+	// holding the name of the volume group to create logical volumes
+	// in. If the volume group does not exist, it is created.
+	LVMVolumeGroup = "volume-group"
+
+	// LVMDevices is the name of the pool configuration attribute
+	// holding the devices with which to create the volume group if
+	// it does not exist. Devices may be separated by commas or
+	// whitespace. If no devices are specified, the volume group is
+	// created with the unused disks discovered on the machine.
+	LVMDevices = "devices"
+
+	// defaultLVMVolumeGroup is the volume group used when the
+	// pool does not specify one.
+	defaultLVMVolumeGroup = "juju"
+)
+
+// validVolumeGroup matches the volume group names accepted by LVM.
+var validVolumeGroup = regexp.MustCompile(`^[a-zA-Z0-9+_.][a-zA-Z0-9+_.-]*$`)
+
+var lvmConfigFields = schema.Fields{
+	LVMVolumeGroup: schema.String(),
+	LVMDevices:     schema.String(),
+}
+
+var lvmConfigChecker = schema.FieldMap(
+	lvmConfigFields,
+	schema.Defaults{
+		LVMVolumeGroup: defaultLVMVolumeGroup,
+		LVMDevices:     "",
+	},
+)
+
+type lvmConfig struct {
+	volumeGroup string
+	devices     []string
+}
+
+func newLVMConfig(attrs map[string]interface{}) (*lvmConfig, error) {
+	out, err := lvmConfigChecker.Coerce(attrs, nil)
+	if err != nil {
+		return nil, errors.Annotate(err, "validating LVM storage config")
+	}
+	coerced := out.(map[string]interface{})
+	volumeGroup := coerced[LVMVolumeGroup].(string)
+	if !validVolumeGroup.MatchString(volumeGroup) {
+		return nil, errors.NotValidf("volume group name %q", volumeGroup)
+	}
+	devices := strings.FieldsFunc(coerced[LVMDevices].(string), func(r rune) bool {
+		return r == ',' || unicode.IsSpace(r)
+	})
+	for i, device := range devices {
+		if !strings.HasPrefix(device, "/") {
+			devices[i] = "/dev/" + device
+		}
+	}
+	return &lvmConfig{
+		volumeGroup: volumeGroup,
+		devices:     devices,
+	}, nil
+}
+
+// ListBlockDevicesFunc is the type of a function that lists the
+// block devices attached to the machine.
+type ListBlockDevicesFunc func() ([]storage.BlockDevice, error)
+
+// lvmProvider creates volume sources which use LVM logical volumes.
+type lvmProvider struct {
+	// run is a function used for running commands on the local machine.
+	run runCommandFunc
+
+	// listBlockDevices, if non-nil, is used to discover the unused
+	// disks with which to create volume groups.
+	listBlockDevices ListBlockDevicesFunc
+}
+
+var _ storage.Provider = (*lvmProvider)(nil)
+var _ storage.VolumeResizingProvider = (*lvmProvider)(nil)
+
+// NewLVMProvider returns a storage.Provider that creates logical
+// volumes, using the given function to discover the disks with which
+// to create volume groups when a pool does not specify any devices.
+func NewLVMProvider(listBlockDevices ListBlockDevicesFunc) storage.Provider {
+	return &lvmProvider{logAndExec, listBlockDevices}
+}
+
+// ValidateConfig is defined on the Provider interface.
+func (*lvmProvider) ValidateConfig(cfg *storage.Config) error {
+	_, err := newLVMConfig(cfg.Attrs())
+	return errors.Trace(err)
+}
+
+// VolumeSource is defined on the Provider interface.
+func (p *lvmProvider) VolumeSource(sourceConfig *storage.Config) (storage.VolumeSource, error) {
+	// The volume group and devices are taken from the attributes
+	// of each volume, as the source config does not include the
+	// pool configuration.
+	return &lvmVolumeSource{p.run, p.listBlockDevices}, nil
+}
+
+// FilesystemSource is defined on the Provider interface.
+func (*lvmProvider) FilesystemSource(providerConfig *storage.Config) (storage.FilesystemSource, error) {
+	return nil, errors.NotSupportedf("filesystems")
+}
+
+// Supports is defined on the Provider interface.
+func (*lvmProvider) Supports(k storage.StorageKind) bool {
+	return k == storage.StorageKindBlock
+}
+
+// Scope is defined on the Provider interface.
+func (*lvmProvider) Scope() storage.Scope {
+	return storage.ScopeMachine
+}
+
+// Dynamic is defined on the Provider interface.
+func (*lvmProvider) Dynamic() bool {
+	return true
+}
+
+// Releasable is defined on the Provider interface.
+func (*lvmProvider) Releasable() bool {
+	return false
+}
+
+// ResizableVolumes is defined on the VolumeResizingProvider interface.
+func (*lvmProvider) ResizableVolumes() bool {
+	return true
+}
+
+// DefaultPools is defined on the Provider interface.
+func (*lvmProvider) DefaultPools() []*storage.Config {
+	return nil
+}
+
+// lvmVolumeSource creates and manages logical volumes on the
+// local machine. Volume IDs are of the form "<vg>/<lv>".
+type lvmVolumeSource struct {
+	run              runCommandFunc
+	listBlockDevices ListBlockDevicesFunc
+}
+
+var _ storage.VolumeSource = (*lvmVolumeSource)(nil)
+var _ storage.VolumeResizer = (*lvmVolumeSource)(nil)
+
+// CreateVolumes is defined on the VolumeSource interface.
+func (s *lvmVolumeSource) CreateVolumes(args []storage.VolumeParams) ([]storage.CreateVolumesResult, error) {
+	results := make([]storage.CreateVolumesResult, len(args))
+	for i, arg := range args {
+		volume, err := s.createVolume(arg)
+		if err != nil {
+			results[i].Error = errors.Annotate(err, "creating volume")
+			continue
+		}
+		results[i].Volume = &volume
+	}
+	return results, nil
+}
+
+func (s *lvmVolumeSource) createVolume(params storage.VolumeParams) (storage.Volume, error) {
+	cfg, err := newLVMConfig(params.Attributes)
+	if err != nil {
+		return storage.Volume{}, errors.Trace(err)
+	}
+	if err := s.ensureVolumeGroup(cfg); err != nil {
+		return storage.Volume{}, errors.Trace(err)
+	}
+	// Logical volumes are named after the volume tag, so that
+	// each of a unit's volumes gets its own logical volume, and
+	// so that a retried creation finds the logical volume created
+	// by an earlier attempt.
+	lvName := params.Tag.String()
+	volumeId := cfg.volumeGroup + "/" + lvName
+	if s.logicalVolumeExists(volumeId) {
+		logger.Debugf("logical volume %s already exists", volumeId)
+	} else if _, err := s.run(
+		"lvcreate", "--yes",
+		"--name", lvName,
+		"--size", fmt.Sprintf("%dm", params.Size),
+		cfg.volumeGroup,
+	); err != nil {
+		return storage.Volume{}, errors.Annotatef(err, "creating logical volume %s", volumeId)
+	}
+	return storage.Volume{
+		params.Tag,
+		storage.VolumeInfo{
+			VolumeId: volumeId,
+			Size:     params.Size,
+		},
+	}, nil
+}
+
+// ensureVolumeGroup creates the configured volume group if it
+// does not already exist.
+func (s *lvmVolumeSource) ensureVolumeGroup(cfg *lvmConfig) error {
+	if _, err := s.run("vgs", "--noheadings", "-o", "vg_name", cfg.volumeGroup); err == nil {
+		return nil
+	}
+	devices := cfg.devices
+	if len(devices) == 0 {
+		var err error
+		devices, err = s.unusedDisks()
+		if err != nil {
+			return errors.Annotate(err, "discovering unused disks")
+		}
+	}
+	if len(devices) == 0 {
+		return errors.Errorf(
+			"volume group %q not found, and no devices available to create it",
+			cfg.volumeGroup,
+		)
+	}
+	args := append([]string{cfg.volumeGroup}, devices...)
+	if _, err := s.run("vgcreate", args...); err != nil {
+		return errors.Annotatef(err, "creating volume group %q", cfg.volumeGroup)
+	}
+	return nil
+}
+
+// unusedDisks returns the paths of the disks on the machine that
+// are not in use, and have no filesystem on them. LVM refuses to
+// create physical volumes on disks with partition tables, so those
+// need not be excluded here.
+func (s *lvmVolumeSource) unusedDisks() ([]string, error) {
+	if s.listBlockDevices == nil {
+		return nil, nil
+	}
+	blockDevices, err := s.listBlockDevices()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	var devices []string
+	for _, dev := range blockDevices {
+		if dev.InUse || dev.FilesystemType != "" || dev.MountPoint != "" {
+			continue
+		}
+		// Loop devices and device-mapper devices are backed by
+		// other storage, which may itself be managed by Juju.
+		if strings.HasPrefix(dev.DeviceName, "loop") || strings.HasPrefix(dev.DeviceName, "dm-") {
+			continue
+		}
+		devices = append(devices, "/dev/"+dev.DeviceName)
+	}
+	return devices, nil
+}
+
+func (s *lvmVolumeSource) logicalVolumeExists(volumeId string) bool {
+	_, err := s.run("lvs", "--noheadings", "-o", "lv_name", volumeId)
+	return err == nil
+}
+
+// ListVolumes is defined on the VolumeSource interface.
+func (s *lvmVolumeSource) ListVolumes() ([]string, error) {
+	return nil, errors.NotImplementedf("ListVolumes")
+}
+
+// DescribeVolumes is defined on the VolumeSource interface.
+func (s *lvmVolumeSource) DescribeVolumes(volumeIds []string) ([]storage.DescribeVolumesResult, error) {
+	return nil, errors.NotImplementedf("DescribeVolumes")
+}
+
+// DestroyVolumes is defined on the VolumeSource interface.
+func (s *lvmVolumeSource) DestroyVolumes(volumeIds []string) ([]error, error) {
+	results := make([]error, len(volumeIds))
+	for i, volumeId := range volumeIds {
+		if err := s.destroyVolume(volumeId); err != nil {
+			results[i] = errors.Annotatef(err, "destroying %q", volumeId)
+		}
+	}
+	return results, nil
+}
+
+func (s *lvmVolumeSource) destroyVolume(volumeId string) error {
+	if err := validateLVMVolumeId(volumeId); err != nil {
+		return errors.Trace(err)
+	}
+	if !s.logicalVolumeExists(volumeId) {
+		return nil
+	}
+	if _, err := s.run("lvremove", "--yes", volumeId); err != nil {
+		return errors.Annotate(err, "removing logical volume")
+	}
+	return nil
+}
+
+// ReleaseVolumes is defined on the VolumeSource interface.
+func (s *lvmVolumeSource) ReleaseVolumes(volumeIds []string) ([]error, error) {
+	return make([]error, len(volumeIds)), nil
+}
+
+// ValidateVolumeParams is defined on the VolumeSource interface.
+func (s *lvmVolumeSource) ValidateVolumeParams(params storage.VolumeParams) error {
+	// The volume group may not exist until the first volume is
+	// created in it, so we cannot check available space here.
+	_, err := newLVMConfig(params.Attributes)
+	return errors.Trace(err)
+}
+
+// AttachVolumes is defined on the VolumeSource interface.
+func (s *lvmVolumeSource) AttachVolumes(args []storage.VolumeAttachmentParams) ([]storage.AttachVolumesResult, error) {
+	results := make([]storage.AttachVolumesResult, len(args))
+	for i, arg := range args {
+		attachment, err := s.attachVolume(arg)
+		if err != nil {
+			results[i].Error = errors.Annotatef(err, "attaching volume %v", arg.Volume.Id())
+			continue
+		}
+		results[i].VolumeAttachment = attachment
+	}
+	return results, nil
+}
+
+func (s *lvmVolumeSource) attachVolume(arg storage.VolumeAttachmentParams) (*storage.VolumeAttachment, error) {
+	if err := validateLVMVolumeId(arg.VolumeId); err != nil {
+		return nil, errors.Trace(err)
+	}
+	permission := "rw"
+	if arg.ReadOnly {
+		permission = "r"
+	}
+	if _, err := s.run(
+		"lvchange",
+		"--activate", "y",
+		"--permission", permission,
+		arg.VolumeId,
+	); err != nil {
+		return nil, errors.Annotate(err, "activating logical volume")
+	}
+	// The kernel names logical volumes "dm-N", which may change
+	// across reboots, so we identify the device by the link that
+	// udev creates for it instead.
+	return &storage.VolumeAttachment{
+		arg.Volume,
+		arg.Machine,
+		storage.VolumeAttachmentInfo{
+			DeviceLink: "/dev/" + arg.VolumeId,
+			ReadOnly:   arg.ReadOnly,
+		},
+	}, nil
+}
+
+// DetachVolumes is defined on the VolumeSource interface.
+func (s *lvmVolumeSource) DetachVolumes(args []storage.VolumeAttachmentParams) ([]error, error) {
+	results := make([]error, len(args))
+	for i, arg := range args {
+		if err := s.detachVolume(arg.VolumeId); err != nil {
+			results[i] = errors.Annotatef(err, "detaching volume %s", arg.Volume.Id())
+		}
+	}
+	return results, nil
+}
+
+func (s *lvmVolumeSource) detachVolume(volumeId string) error {
+	if err := validateLVMVolumeId(volumeId); err != nil {
+		return errors.Trace(err)
+	}
+	if _, err := s.run("lvchange", "--activate", "n", volumeId); err != nil {
+		return errors.Annotate(err, "deactivating logical volume")
+	}
+	return nil
+}
+
+// ResizeVolumes is defined on the VolumeResizer interface.
+func (s *lvmVolumeSource) ResizeVolumes(args []storage.VolumeResizeParams) ([]storage.ResizeVolumesResult, error) {
+	results := make([]storage.ResizeVolumesResult, len(args))
+	for i, arg := range args {
+		volume, err := s.resizeVolume(arg)
+		if err != nil {
+			results[i].Error = errors.Annotatef(err, "resizing volume %s", arg.Tag.Id())
+			continue
+		}
+		results[i].Volume = &volume
+	}
+	return results, nil
+}
+
+func (s *lvmVolumeSource) resizeVolume(arg storage.VolumeResizeParams) (storage.Volume, error) {
+	if err := validateLVMVolumeId(arg.VolumeId); err != nil {
+		return storage.Volume{}, errors.Trace(err)
+	}
+	// lvextend refuses to shrink logical volumes, so we
+	// need not check the current size here.
+	if _, err := s.run(
+		"lvextend",
+		"--size", fmt.Sprintf("%dm", arg.Size),
+		arg.VolumeId,
+	); err != nil {
+		return storage.Volume{}, errors.Annotate(err, "extending logical volume")
+	}
+	return storage.Volume{
+		arg.Tag,
+		storage.VolumeInfo{
+			VolumeId: arg.VolumeId,
+			Size:     arg.Size,
+		},
+	}, nil
+}
+
+// validateLVMVolumeId checks that the volume ID is of the form
+// "<vg>/<lv>", as created by lvmVolumeSource.
+func validateLVMVolumeId(volumeId string) error {
+	parts := strings.Split(volumeId, "/")
+	if len(parts) != 2 || !validVolumeGroup.MatchString(parts[0]) || parts[1] == "" {
+		return errors.NotValidf("LVM volume ID %q", volumeId)
+	}
+	return nil
+}
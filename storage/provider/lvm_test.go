@@ -0,0 +1,302 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package provider_test
+
+import (
+	"github.com/juju/errors"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+	"gopkg.in/juju/names.v2"
+
+	"github.com/juju/juju/storage"
+	"github.com/juju/juju/storage/provider"
+	"github.com/juju/juju/testing"
+)
+
+var _ = gc.Suite(&lvmSuite{})
+
+type lvmSuite struct {
+	testing.BaseSuite
+	commands     *mockRunCommand
+	blockDevices []storage.BlockDevice
+}
+
+func (s *lvmSuite) SetUpTest(c *gc.C) {
+	s.BaseSuite.SetUpTest(c)
+	s.commands = &mockRunCommand{c: c}
+	s.blockDevices = nil
+}
+
+func (s *lvmSuite) TearDownTest(c *gc.C) {
+	s.commands.assertDrained()
+	s.BaseSuite.TearDownTest(c)
+}
+
+func (s *lvmSuite) listBlockDevices() ([]storage.BlockDevice, error) {
+	return s.blockDevices, nil
+}
+
+func (s *lvmSuite) lvmProvider() storage.Provider {
+	return provider.LVMProvider(s.commands.run, s.listBlockDevices)
+}
+
+func (s *lvmSuite) lvmVolumeSource() storage.VolumeSource {
+	return provider.LVMVolumeSource(s.commands.run, s.listBlockDevices)
+}
+
+func (s *lvmSuite) TestValidateConfig(c *gc.C) {
+	p := s.lvmProvider()
+	cfg, err := storage.NewConfig("name", provider.LVMProviderType, map[string]interface{}{})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(p.ValidateConfig(cfg), jc.ErrorIsNil)
+
+	cfg, err = storage.NewConfig("name", provider.LVMProviderType, map[string]interface{}{
+		"volume-group": "fast",
+		"devices":      "sdb, /dev/sdc",
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(p.ValidateConfig(cfg), jc.ErrorIsNil)
+}
+
+func (s *lvmSuite) TestValidateConfigInvalidVolumeGroup(c *gc.C) {
+	p := s.lvmProvider()
+	cfg, err := storage.NewConfig("name", provider.LVMProviderType, map[string]interface{}{
+		"volume-group": "-nope",
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	err = p.ValidateConfig(cfg)
+	c.Assert(err, gc.ErrorMatches, `volume group name "-nope" not valid`)
+}
+
+func (s *lvmSuite) TestValidateConfigInvalidDevices(c *gc.C) {
+	p := s.lvmProvider()
+	cfg, err := storage.NewConfig("name", provider.LVMProviderType, map[string]interface{}{
+		"devices": 123,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	err = p.ValidateConfig(cfg)
+	c.Assert(err, gc.ErrorMatches, `validating LVM storage config: devices: expected string, got int\(123\)`)
+}
+
+func (s *lvmSuite) TestSupports(c *gc.C) {
+	p := s.lvmProvider()
+	c.Assert(p.Supports(storage.StorageKindBlock), jc.IsTrue)
+	c.Assert(p.Supports(storage.StorageKindFilesystem), jc.IsFalse)
+}
+
+func (s *lvmSuite) TestScope(c *gc.C) {
+	p := s.lvmProvider()
+	c.Assert(p.Scope(), gc.Equals, storage.ScopeMachine)
+	c.Assert(p.Dynamic(), jc.IsTrue)
+}
+
+func (s *lvmSuite) TestCreateVolumesExistingVolumeGroup(c *gc.C) {
+	source := s.lvmVolumeSource()
+	for _, id := range []string{"0", "1"} {
+		s.commands.expect("vgs", "--noheadings", "-o", "vg_name", "fast").respond("fast", nil)
+		s.commands.expect("lvs", "--noheadings", "-o", "lv_name", "fast/volume-"+id).respond(
+			"", errors.New("Failed to find logical volume"),
+		)
+		s.commands.expect("lvcreate", "--yes", "--name", "volume-"+id, "--size", "1024m", "fast")
+	}
+
+	attrs := map[string]interface{}{"volume-group": "fast"}
+	results, err := source.CreateVolumes([]storage.VolumeParams{{
+		Tag:        names.NewVolumeTag("0"),
+		Size:       1024,
+		Attributes: attrs,
+	}, {
+		Tag:        names.NewVolumeTag("1"),
+		Size:       1024,
+		Attributes: attrs,
+	}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, jc.DeepEquals, []storage.CreateVolumesResult{{
+		Volume: &storage.Volume{
+			names.NewVolumeTag("0"),
+			storage.VolumeInfo{VolumeId: "fast/volume-0", Size: 1024},
+		},
+	}, {
+		Volume: &storage.Volume{
+			names.NewVolumeTag("1"),
+			storage.VolumeInfo{VolumeId: "fast/volume-1", Size: 1024},
+		},
+	}})
+}
+
+func (s *lvmSuite) TestCreateVolumesConfiguredDevices(c *gc.C) {
+	source := s.lvmVolumeSource()
+	s.commands.expect("vgs", "--noheadings", "-o", "vg_name", "fast").respond(
+		"", errors.New(`Volume group "fast" not found`),
+	)
+	s.commands.expect("vgcreate", "fast", "/dev/sdb", "/dev/sdc")
+	s.commands.expect("lvs", "--noheadings", "-o", "lv_name", "fast/volume-0").respond(
+		"", errors.New("Failed to find logical volume"),
+	)
+	s.commands.expect("lvcreate", "--yes", "--name", "volume-0", "--size", "2m", "fast")
+
+	results, err := source.CreateVolumes([]storage.VolumeParams{{
+		Tag:  names.NewVolumeTag("0"),
+		Size: 2,
+		Attributes: map[string]interface{}{
+			"volume-group": "fast",
+			"devices":      "sdb,/dev/sdc",
+		},
+	}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, gc.HasLen, 1)
+	c.Assert(results[0].Error, jc.ErrorIsNil)
+}
+
+func (s *lvmSuite) TestCreateVolumesDiscoveredDevices(c *gc.C) {
+	s.blockDevices = []storage.BlockDevice{
+		{DeviceName: "sda", InUse: true},
+		{DeviceName: "sdb"},
+		{DeviceName: "sdc", FilesystemType: "ext4"},
+		{DeviceName: "sdd", MountPoint: "/srv"},
+		{DeviceName: "loop0"},
+		{DeviceName: "dm-0"},
+		{DeviceName: "sde"},
+	}
+	source := s.lvmVolumeSource()
+	s.commands.expect("vgs", "--noheadings", "-o", "vg_name", "juju").respond(
+		"", errors.New(`Volume group "juju" not found`),
+	)
+	s.commands.expect("vgcreate", "juju", "/dev/sdb", "/dev/sde")
+	s.commands.expect("lvs", "--noheadings", "-o", "lv_name", "juju/volume-0-1").respond(
+		"", errors.New("Failed to find logical volume"),
+	)
+	s.commands.expect("lvcreate", "--yes", "--name", "volume-0-1", "--size", "2m", "juju")
+
+	results, err := source.CreateVolumes([]storage.VolumeParams{{
+		Tag:  names.NewVolumeTag("0/1"),
+		Size: 2,
+	}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, gc.HasLen, 1)
+	c.Assert(results[0].Error, jc.ErrorIsNil)
+	c.Assert(results[0].Volume.VolumeId, gc.Equals, "juju/volume-0-1")
+}
+
+func (s *lvmSuite) TestCreateVolumesNoDevices(c *gc.C) {
+	source := s.lvmVolumeSource()
+	s.commands.expect("vgs", "--noheadings", "-o", "vg_name", "juju").respond(
+		"", errors.New(`Volume group "juju" not found`),
+	)
+	results, err := source.CreateVolumes([]storage.VolumeParams{{
+		Tag:  names.NewVolumeTag("0"),
+		Size: 2,
+	}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, gc.HasLen, 1)
+	c.Assert(results[0].Error, gc.ErrorMatches,
+		`creating volume: volume group "juju" not found, and no devices available to create it`,
+	)
+}
+
+func (s *lvmSuite) TestCreateVolumesExistingLogicalVolume(c *gc.C) {
+	source := s.lvmVolumeSource()
+	s.commands.expect("vgs", "--noheadings", "-o", "vg_name", "juju").respond("juju", nil)
+	s.commands.expect("lvs", "--noheadings", "-o", "lv_name", "juju/volume-0").respond("volume-0", nil)
+	results, err := source.CreateVolumes([]storage.VolumeParams{{
+		Tag:  names.NewVolumeTag("0"),
+		Size: 2,
+	}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, gc.HasLen, 1)
+	c.Assert(results[0].Error, jc.ErrorIsNil)
+	c.Assert(results[0].Volume.VolumeId, gc.Equals, "juju/volume-0")
+}
+
+func (s *lvmSuite) TestDestroyVolumes(c *gc.C) {
+	source := s.lvmVolumeSource()
+	s.commands.expect("lvs", "--noheadings", "-o", "lv_name", "juju/volume-0").respond("volume-0", nil)
+	s.commands.expect("lvremove", "--yes", "juju/volume-0")
+	s.commands.expect("lvs", "--noheadings", "-o", "lv_name", "juju/volume-1").respond(
+		"", errors.New("Failed to find logical volume"),
+	)
+
+	errs, err := source.DestroyVolumes([]string{"juju/volume-0", "juju/volume-1", "volume-2"})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(errs, gc.HasLen, 3)
+	c.Assert(errs[0], jc.ErrorIsNil)
+	c.Assert(errs[1], jc.ErrorIsNil)
+	c.Assert(errs[2], gc.ErrorMatches, `destroying "volume-2": LVM volume ID "volume-2" not valid`)
+}
+
+func (s *lvmSuite) TestAttachVolumes(c *gc.C) {
+	source := s.lvmVolumeSource()
+	s.commands.expect("lvchange", "--activate", "y", "--permission", "rw", "juju/volume-0")
+	s.commands.expect("lvchange", "--activate", "y", "--permission", "r", "juju/volume-1")
+
+	results, err := source.AttachVolumes([]storage.VolumeAttachmentParams{{
+		Volume:   names.NewVolumeTag("0"),
+		VolumeId: "juju/volume-0",
+		AttachmentParams: storage.AttachmentParams{
+			Machine: names.NewMachineTag("0"),
+		},
+	}, {
+		Volume:   names.NewVolumeTag("1"),
+		VolumeId: "juju/volume-1",
+		AttachmentParams: storage.AttachmentParams{
+			Machine:  names.NewMachineTag("0"),
+			ReadOnly: true,
+		},
+	}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, jc.DeepEquals, []storage.AttachVolumesResult{{
+		VolumeAttachment: &storage.VolumeAttachment{
+			names.NewVolumeTag("0"),
+			names.NewMachineTag("0"),
+			storage.VolumeAttachmentInfo{
+				DeviceLink: "/dev/juju/volume-0",
+			},
+		},
+	}, {
+		VolumeAttachment: &storage.VolumeAttachment{
+			names.NewVolumeTag("1"),
+			names.NewMachineTag("0"),
+			storage.VolumeAttachmentInfo{
+				DeviceLink: "/dev/juju/volume-1",
+				ReadOnly:   true,
+			},
+		},
+	}})
+}
+
+func (s *lvmSuite) TestDetachVolumes(c *gc.C) {
+	source := s.lvmVolumeSource()
+	s.commands.expect("lvchange", "--activate", "n", "juju/volume-0").respond(
+		"", errors.New("Logical volume juju/volume-0 in use"),
+	)
+	errs, err := source.DetachVolumes([]storage.VolumeAttachmentParams{{
+		Volume:   names.NewVolumeTag("0"),
+		VolumeId: "juju/volume-0",
+	}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(errs, gc.HasLen, 1)
+	c.Assert(errs[0], gc.ErrorMatches,
+		"detaching volume 0: deactivating logical volume: Logical volume juju/volume-0 in use",
+	)
+}
+
+func (s *lvmSuite) TestResizeVolumes(c *gc.C) {
+	source := s.lvmVolumeSource()
+	resizer, ok := source.(storage.VolumeResizer)
+	c.Assert(ok, jc.IsTrue)
+	s.commands.expect("lvextend", "--size", "4096m", "juju/volume-0")
+
+	results, err := resizer.ResizeVolumes([]storage.VolumeResizeParams{{
+		Tag:      names.NewVolumeTag("0"),
+		VolumeId: "juju/volume-0",
+		Size:     4096,
+	}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, jc.DeepEquals, []storage.ResizeVolumesResult{{
+		Volume: &storage.Volume{
+			names.NewVolumeTag("0"),
+			storage.VolumeInfo{VolumeId: "juju/volume-0", Size: 4096},
+		},
+	}})
+}
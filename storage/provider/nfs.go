@@ -0,0 +1,271 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package provider
+
+import (
+	"path"
+	"strings"
+
+	"github.com/juju/errors"
+	"github.com/juju/schema"
+
+	"github.com/juju/juju/storage"
+)
+
+const (
+	// NFSProviderType is the provider type of the NFS provider,
+	// which attaches exports from an existing NFS server. The
+	// filesystems may be attached to multiple machines at once.
+	NFSProviderType = storage.ProviderType("nfs")
+
+	// NFSServer is the name of the pool configuration attribute
+	// holding the hostname or address of the NFS server.
+	NFSServer = "server"
+
+	// NFSExport is the name of the pool configuration attribute
+	// holding the absolute path of the export on the NFS server.
+	NFSExport = "export"
+)
+
+var nfsConfigFields = schema.Fields{
+	NFSServer: schema.String(),
+	NFSExport: schema.String(),
+}
+
+var nfsConfigChecker = schema.FieldMap(
+	nfsConfigFields,
+	schema.Defaults{},
+)
+
+type nfsConfig struct {
+	server string
+	export string
+}
+
+func newNFSConfig(attrs map[string]interface{}) (*nfsConfig, error) {
+	out, err := nfsConfigChecker.Coerce(attrs, nil)
+	if err != nil {
+		return nil, errors.Annotate(err, "validating NFS storage config")
+	}
+	coerced := out.(map[string]interface{})
+	server := coerced[NFSServer].(string)
+	if server == "" {
+		return nil, errors.NotValidf("empty NFS server")
+	}
+	export := coerced[NFSExport].(string)
+	if !path.IsAbs(export) {
+		return nil, errors.NotValidf("NFS export %q (must be an absolute path)", export)
+	}
+	return &nfsConfig{
+		server: server,
+		export: path.Clean(export),
+	}, nil
+}
+
+// filesystemId returns the provider ID for a filesystem backed by
+// the configured export, which is the source used to mount it.
+func (cfg *nfsConfig) filesystemId() string {
+	return cfg.server + ":" + cfg.export
+}
+
+// nfsProvider creates filesystem sources which mount exports from
+// an existing NFS server.
+type nfsProvider struct {
+	// run is a function used for running commands on the local machine.
+	run runCommandFunc
+}
+
+var (
+	_ storage.Provider                 = (*nfsProvider)(nil)
+	_ storage.SharedFilesystemProvider = (*nfsProvider)(nil)
+)
+
+// ValidateConfig is defined on the Provider interface.
+func (*nfsProvider) ValidateConfig(cfg *storage.Config) error {
+	_, err := newNFSConfig(cfg.Attrs())
+	return errors.Trace(err)
+}
+
+// VolumeSource is defined on the Provider interface.
+func (*nfsProvider) VolumeSource(providerConfig *storage.Config) (storage.VolumeSource, error) {
+	return nil, errors.NotSupportedf("volumes")
+}
+
+// FilesystemSource is defined on the Provider interface.
+func (p *nfsProvider) FilesystemSource(sourceConfig *storage.Config) (storage.FilesystemSource, error) {
+	// The server and export are taken from the attributes of
+	// each filesystem, as the source config does not include
+	// the pool configuration.
+	return &nfsFilesystemSource{&osDirFuncs{p.run}, p.run}, nil
+}
+
+// Supports is defined on the Provider interface.
+func (*nfsProvider) Supports(k storage.StorageKind) bool {
+	return k == storage.StorageKindFilesystem
+}
+
+// Scope is defined on the Provider interface.
+func (*nfsProvider) Scope() storage.Scope {
+	return storage.ScopeEnviron
+}
+
+// Dynamic is defined on the Provider interface.
+func (*nfsProvider) Dynamic() bool {
+	return true
+}
+
+// Releasable is defined on the Provider interface.
+func (*nfsProvider) Releasable() bool {
+	return true
+}
+
+// DefaultPools is defined on the Provider interface.
+func (*nfsProvider) DefaultPools() []*storage.Config {
+	// NFS pools require a server and export, so there
+	// is no sensible default.
+	return nil
+}
+
+// SharedFilesystems is defined on the SharedFilesystemProvider interface.
+func (*nfsProvider) SharedFilesystems() bool {
+	return true
+}
+
+// SharedFilesystemsOnly is defined on the SharedFilesystemProvider interface.
+func (*nfsProvider) SharedFilesystemsOnly() bool {
+	// Machine-scoped storage provisioners only mount model-scoped
+	// filesystems without a backing volume if they are shared, so
+	// an NFS export assigned to a unit would never be mounted.
+	return true
+}
+
+// nfsFilesystemSource mounts NFS exports. Filesystem IDs are of the
+// form "<server>:<export>".
+type nfsFilesystemSource struct {
+	dirFuncs dirFuncs
+	run      runCommandFunc
+}
+
+var _ storage.FilesystemSource = (*nfsFilesystemSource)(nil)
+
+// ValidateFilesystemParams is defined on the FilesystemSource interface.
+func (s *nfsFilesystemSource) ValidateFilesystemParams(params storage.FilesystemParams) error {
+	_, err := newNFSConfig(params.Attributes)
+	return errors.Trace(err)
+}
+
+// CreateFilesystems is defined on the FilesystemSource interface.
+func (s *nfsFilesystemSource) CreateFilesystems(args []storage.FilesystemParams) ([]storage.CreateFilesystemsResult, error) {
+	results := make([]storage.CreateFilesystemsResult, len(args))
+	for i, arg := range args {
+		cfg, err := newNFSConfig(arg.Attributes)
+		if err != nil {
+			results[i].Error = errors.Annotate(err, "creating filesystem")
+			continue
+		}
+		// The export already exists on the server; there is
+		// nothing to create. The size cannot be enforced, so
+		// we record the requested size.
+		results[i].Filesystem = &storage.Filesystem{
+			Tag: arg.Tag,
+			FilesystemInfo: storage.FilesystemInfo{
+				FilesystemId: cfg.filesystemId(),
+				Size:         arg.Size,
+			},
+		}
+	}
+	return results, nil
+}
+
+// DestroyFilesystems is defined on the FilesystemSource interface.
+func (s *nfsFilesystemSource) DestroyFilesystems(filesystemIds []string) ([]error, error) {
+	// The export is managed outside of Juju, so we leave
+	// the data on the server untouched.
+	return make([]error, len(filesystemIds)), nil
+}
+
+// ReleaseFilesystems is defined on the FilesystemSource interface.
+func (s *nfsFilesystemSource) ReleaseFilesystems(filesystemIds []string) ([]error, error) {
+	return make([]error, len(filesystemIds)), nil
+}
+
+// AttachFilesystems is defined on the FilesystemSource interface.
+func (s *nfsFilesystemSource) AttachFilesystems(args []storage.FilesystemAttachmentParams) ([]storage.AttachFilesystemsResult, error) {
+	results := make([]storage.AttachFilesystemsResult, len(args))
+	for i, arg := range args {
+		attachment, err := s.attachFilesystem(arg)
+		if err != nil {
+			results[i].Error = errors.Annotatef(err, "attaching filesystem %v", arg.Filesystem.Id())
+			continue
+		}
+		results[i].FilesystemAttachment = attachment
+	}
+	return results, nil
+}
+
+func (s *nfsFilesystemSource) attachFilesystem(arg storage.FilesystemAttachmentParams) (*storage.FilesystemAttachment, error) {
+	if arg.Path == "" {
+		return nil, errNoMountPoint
+	}
+	if err := validateNFSFilesystemId(arg.FilesystemId); err != nil {
+		return nil, errors.Trace(err)
+	}
+	if err := s.dirFuncs.mkDirAll(arg.Path, 0755); err != nil {
+		return nil, errors.Annotate(err, "creating mount point")
+	}
+	mounted, mountSource, err := isMounted(s.dirFuncs, arg.Path)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if mounted {
+		if mountSource != arg.FilesystemId {
+			return nil, errors.Errorf(
+				"%q is already mounted from %q",
+				arg.Path, mountSource,
+			)
+		}
+		logger.Debugf("%q already mounted at %q", mountSource, arg.Path)
+	} else {
+		args := []string{"-t", "nfs"}
+		if arg.ReadOnly {
+			args = append(args, "-o", "ro")
+		}
+		args = append(args, arg.FilesystemId, arg.Path)
+		if _, err := s.run("mount", args...); err != nil {
+			return nil, errors.Annotate(err, "mount failed")
+		}
+		logger.Infof("mounted %q at %q", arg.FilesystemId, arg.Path)
+	}
+	return &storage.FilesystemAttachment{
+		arg.Filesystem,
+		arg.Machine,
+		storage.FilesystemAttachmentInfo{
+			arg.Path,
+			arg.ReadOnly,
+		},
+	}, nil
+}
+
+// DetachFilesystems is defined on the FilesystemSource interface.
+func (s *nfsFilesystemSource) DetachFilesystems(args []storage.FilesystemAttachmentParams) ([]error, error) {
+	results := make([]error, len(args))
+	for i, arg := range args {
+		if err := maybeUnmount(s.run, s.dirFuncs, arg.Path); err != nil {
+			results[i] = errors.Annotatef(err, "detaching filesystem %v", arg.Filesystem.Id())
+		}
+	}
+	return results, nil
+}
+
+// validateNFSFilesystemId checks that the filesystem ID is of the
+// form "<server>:<export>", as created by nfsFilesystemSource.
+func validateNFSFilesystemId(filesystemId string) error {
+	// The server may be an IPv6 address, so we split on the
+	// first ":/" rather than the first ":".
+	i := strings.Index(filesystemId, ":/")
+	if i <= 0 {
+		return errors.NotValidf("NFS filesystem ID %q", filesystemId)
+	}
+	return nil
+}
@@ -0,0 +1,240 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package provider_test
+
+import (
+	"github.com/juju/errors"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+	"gopkg.in/juju/names.v2"
+
+	"github.com/juju/juju/storage"
+	"github.com/juju/juju/storage/provider"
+	"github.com/juju/juju/testing"
+)
+
+var _ = gc.Suite(&nfsSuite{})
+
+type nfsSuite struct {
+	testing.BaseSuite
+	commands *mockRunCommand
+}
+
+func (s *nfsSuite) SetUpTest(c *gc.C) {
+	s.BaseSuite.SetUpTest(c)
+	s.commands = &mockRunCommand{c: c}
+}
+
+func (s *nfsSuite) TearDownTest(c *gc.C) {
+	s.commands.assertDrained()
+	s.BaseSuite.TearDownTest(c)
+}
+
+func (s *nfsSuite) nfsProvider() storage.Provider {
+	return provider.NFSProvider(s.commands.run)
+}
+
+func (s *nfsSuite) nfsFilesystemSource() storage.FilesystemSource {
+	source, _ := provider.NFSFilesystemSource(s.commands.run)
+	return source
+}
+
+func (s *nfsSuite) TestValidateConfig(c *gc.C) {
+	p := s.nfsProvider()
+	cfg, err := storage.NewConfig("name", provider.NFSProviderType, map[string]interface{}{
+		"server": "nfs.example.com",
+		"export": "/srv/data",
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(p.ValidateConfig(cfg), jc.ErrorIsNil)
+}
+
+func (s *nfsSuite) TestValidateConfigMissingServer(c *gc.C) {
+	p := s.nfsProvider()
+	cfg, err := storage.NewConfig("name", provider.NFSProviderType, map[string]interface{}{
+		"export": "/srv/data",
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	err = p.ValidateConfig(cfg)
+	c.Assert(err, gc.ErrorMatches, `validating NFS storage config: server: expected string, got nothing`)
+}
+
+func (s *nfsSuite) TestValidateConfigEmptyServer(c *gc.C) {
+	p := s.nfsProvider()
+	cfg, err := storage.NewConfig("name", provider.NFSProviderType, map[string]interface{}{
+		"server": "",
+		"export": "/srv/data",
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	err = p.ValidateConfig(cfg)
+	c.Assert(err, gc.ErrorMatches, `empty NFS server not valid`)
+}
+
+func (s *nfsSuite) TestValidateConfigRelativeExport(c *gc.C) {
+	p := s.nfsProvider()
+	cfg, err := storage.NewConfig("name", provider.NFSProviderType, map[string]interface{}{
+		"server": "nfs.example.com",
+		"export": "srv/data",
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	err = p.ValidateConfig(cfg)
+	c.Assert(err, gc.ErrorMatches, `NFS export "srv/data" \(must be an absolute path\) not valid`)
+}
+
+func (s *nfsSuite) TestSupports(c *gc.C) {
+	p := s.nfsProvider()
+	c.Assert(p.Supports(storage.StorageKindBlock), jc.IsFalse)
+	c.Assert(p.Supports(storage.StorageKindFilesystem), jc.IsTrue)
+}
+
+func (s *nfsSuite) TestScope(c *gc.C) {
+	p := s.nfsProvider()
+	c.Assert(p.Scope(), gc.Equals, storage.ScopeEnviron)
+}
+
+func (s *nfsSuite) TestSharedFilesystems(c *gc.C) {
+	p := s.nfsProvider()
+	shared, ok := p.(storage.SharedFilesystemProvider)
+	c.Assert(ok, jc.IsTrue)
+	c.Assert(shared.SharedFilesystems(), jc.IsTrue)
+	c.Assert(shared.SharedFilesystemsOnly(), jc.IsTrue)
+}
+
+func (s *nfsSuite) TestVolumeSource(c *gc.C) {
+	p := s.nfsProvider()
+	_, err := p.VolumeSource(nil)
+	c.Assert(err, jc.Satisfies, errors.IsNotSupported)
+}
+
+func (s *nfsSuite) TestCreateFilesystems(c *gc.C) {
+	source := s.nfsFilesystemSource()
+	results, err := source.CreateFilesystems([]storage.FilesystemParams{{
+		Tag:  names.NewFilesystemTag("0"),
+		Size: 1024,
+		Attributes: map[string]interface{}{
+			"server": "nfs.example.com",
+			"export": "/srv/data/",
+		},
+	}, {
+		Tag:        names.NewFilesystemTag("1"),
+		Size:       1024,
+		Attributes: map[string]interface{}{"server": "nfs.example.com"},
+	}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, gc.HasLen, 2)
+	c.Assert(results[0], jc.DeepEquals, storage.CreateFilesystemsResult{
+		Filesystem: &storage.Filesystem{
+			Tag: names.NewFilesystemTag("0"),
+			FilesystemInfo: storage.FilesystemInfo{
+				FilesystemId: "nfs.example.com:/srv/data",
+				Size:         1024,
+			},
+		},
+	})
+	c.Assert(results[1].Error, gc.ErrorMatches, "creating filesystem: validating NFS storage config: export: expected string, got nothing")
+}
+
+func (s *nfsSuite) TestDestroyFilesystems(c *gc.C) {
+	source := s.nfsFilesystemSource()
+	results, err := source.DestroyFilesystems([]string{"nfs.example.com:/srv/data"})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, jc.DeepEquals, []error{nil})
+}
+
+func (s *nfsSuite) TestAttachFilesystems(c *gc.C) {
+	source := s.nfsFilesystemSource()
+	cmd := s.commands.expect("df", "--output=source", "/srv")
+	cmd.respond("headers\n/dev/sda1", nil)
+	cmd = s.commands.expect("df", "--output=source", "/srv/shared")
+	cmd.respond("headers\n/dev/sda1", nil)
+	s.commands.expect("mount", "-t", "nfs", "-o", "ro", "nfs.example.com:/srv/data", "/srv/shared")
+
+	results, err := source.AttachFilesystems([]storage.FilesystemAttachmentParams{{
+		Filesystem:   names.NewFilesystemTag("0"),
+		FilesystemId: "nfs.example.com:/srv/data",
+		AttachmentParams: storage.AttachmentParams{
+			Machine:  names.NewMachineTag("1"),
+			ReadOnly: true,
+		},
+		Path: "/srv/shared",
+	}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, jc.DeepEquals, []storage.AttachFilesystemsResult{{
+		FilesystemAttachment: &storage.FilesystemAttachment{
+			Filesystem: names.NewFilesystemTag("0"),
+			Machine:    names.NewMachineTag("1"),
+			FilesystemAttachmentInfo: storage.FilesystemAttachmentInfo{
+				Path:     "/srv/shared",
+				ReadOnly: true,
+			},
+		},
+	}})
+}
+
+func (s *nfsSuite) TestAttachFilesystemsAlreadyMounted(c *gc.C) {
+	source := s.nfsFilesystemSource()
+	cmd := s.commands.expect("df", "--output=source", "/srv")
+	cmd.respond("headers\n/dev/sda1", nil)
+	cmd = s.commands.expect("df", "--output=source", "/srv/shared")
+	cmd.respond("headers\nnfs.example.com:/srv/data", nil)
+
+	results, err := source.AttachFilesystems([]storage.FilesystemAttachmentParams{{
+		Filesystem:   names.NewFilesystemTag("0"),
+		FilesystemId: "nfs.example.com:/srv/data",
+		AttachmentParams: storage.AttachmentParams{
+			Machine: names.NewMachineTag("1"),
+		},
+		Path: "/srv/shared",
+	}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results[0].Error, jc.ErrorIsNil)
+	c.Assert(results[0].FilesystemAttachment, gc.NotNil)
+}
+
+func (s *nfsSuite) TestAttachFilesystemsMountedElsewhere(c *gc.C) {
+	source := s.nfsFilesystemSource()
+	cmd := s.commands.expect("df", "--output=source", "/srv")
+	cmd.respond("headers\n/dev/sda1", nil)
+	cmd = s.commands.expect("df", "--output=source", "/srv/shared")
+	cmd.respond("headers\n/dev/sdb1", nil)
+
+	results, err := source.AttachFilesystems([]storage.FilesystemAttachmentParams{{
+		Filesystem:   names.NewFilesystemTag("0"),
+		FilesystemId: "nfs.example.com:/srv/data",
+		Path:         "/srv/shared",
+	}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results[0].Error, gc.ErrorMatches, `attaching filesystem 0: "/srv/shared" is already mounted from "/dev/sdb1"`)
+}
+
+func (s *nfsSuite) TestAttachFilesystemsInvalidId(c *gc.C) {
+	source := s.nfsFilesystemSource()
+	results, err := source.AttachFilesystems([]storage.FilesystemAttachmentParams{{
+		Filesystem:   names.NewFilesystemTag("0"),
+		FilesystemId: "/srv/data",
+		Path:         "/srv/shared",
+	}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results[0].Error, gc.ErrorMatches, `attaching filesystem 0: NFS filesystem ID "/srv/data" not valid`)
+}
+
+func (s *nfsSuite) TestAttachFilesystemsNoPathSpecified(c *gc.C) {
+	source := s.nfsFilesystemSource()
+	results, err := source.AttachFilesystems([]storage.FilesystemAttachmentParams{{
+		Filesystem:   names.NewFilesystemTag("0"),
+		FilesystemId: "nfs.example.com:/srv/data",
+	}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results[0].Error, gc.ErrorMatches, "attaching filesystem 0: filesystem mount point not specified")
+}
+
+func (s *nfsSuite) TestDetachFilesystems(c *gc.C) {
+	source := s.nfsFilesystemSource()
+	testDetachFilesystems(c, s.commands, source, true)
+}
+
+func (s *nfsSuite) TestDetachFilesystemsUnattached(c *gc.C) {
+	source := s.nfsFilesystemSource()
+	testDetachFilesystems(c, s.commands, source, false)
+}
@@ -47,4 +47,8 @@ type StorageAttachmentInfo struct {
 	// for a filesystem-kind storage attachment, and the device path
 	// for a block-kind.
 	Location string
+
+	// Size is the size of the storage in MiB. For volume-backed
+	// storage, this is the size of the volume.
+	Size uint64
 }
@@ -19,6 +19,10 @@ type RelationUnitsChange struct {
 	// Departed holds a set of units that have previously been reported to
 	// be in scope, but which no longer are.
 	Departed []string
+
+	// AppChanged holds the latest known settings version of each
+	// application whose settings in the relation have changed.
+	AppChanged map[string]int64
 }
 
 // RelationUnitsChannel is a change channel as described in the CoreWatcher docs.
@@ -15,6 +15,7 @@ import (
 
 	"github.com/juju/juju/api/base"
 	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/core/machinelock"
 	coretesting "github.com/juju/juju/testing"
 	"github.com/juju/juju/worker/caasoperator"
 	"github.com/juju/juju/worker/dependency"
@@ -59,7 +60,7 @@ func (s *ManifoldSuite) SetUpTest(c *gc.C) {
 		ClockName:             "clock",
 		CharmDirName:          "charm-dir",
 		HookRetryStrategyName: "hook-retry-strategy",
-		MachineLockName:       "machine-lock",
+		MachineLock:           &fakeMachineLock{},
 		NewWorker:             s.newWorker,
 		NewClient:             s.newClient,
 		NewCharmDownloader:    s.newCharmDownloader,
@@ -157,10 +158,10 @@ func (s *ManifoldSuite) TestStart(c *gc.C) {
 		UnitRemover:        &s.client,
 		ApplicationWatcher: &s.client,
 		UniterParams: &uniter.UniterParams{
-			DataDir:         s.dataDir,
-			MachineLockName: "machine-lock",
-			CharmDirGuard:   &mockCharmDirGuard{},
-			Clock:           s.clock,
+			DataDir:       s.dataDir,
+			MachineLock:   &fakeMachineLock{},
+			CharmDirGuard: &mockCharmDirGuard{},
+			Clock:         s.clock,
 		},
 	})
 }
@@ -171,3 +172,7 @@ func (s *ManifoldSuite) startWorkerClean(c *gc.C) worker.Worker {
 	workertest.CheckAlive(c, w)
 	return w
 }
+
+type fakeMachineLock struct {
+	machinelock.Lock
+}
@@ -5,6 +5,7 @@ package charmrunner
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/juju/errors"
 )
@@ -47,3 +48,25 @@ func IsMissingHookError(err error) bool {
 func NewMissingHookError(hookName string) error {
 	return &missingHookError{hookName}
 }
+
+type hookTimeoutError struct {
+	hookName string
+	timeout  time.Duration
+}
+
+func (e *hookTimeoutError) Error() string {
+	return fmt.Sprintf("%s timed out after %v", e.hookName, e.timeout)
+}
+
+// IsHookTimeoutError returns whether err was caused by a hook being
+// killed for running longer than its timeout.
+func IsHookTimeoutError(err error) bool {
+	_, ok := errors.Cause(err).(*hookTimeoutError)
+	return ok
+}
+
+// NewHookTimeoutError returns an error indicating that the named hook
+// was killed after running for longer than timeout.
+func NewHookTimeoutError(hookName string, timeout time.Duration) error {
+	return &hookTimeoutError{hookName, timeout}
+}
@@ -28,6 +28,7 @@ const (
 
 	typeDisk = "disk"
 	typeLoop = "loop"
+	typeLVM  = "lvm"
 )
 
 func init() {
@@ -96,11 +97,12 @@ func listBlockDevices() ([]storage.BlockDevice, error) {
 			}
 		}
 
-		// We may later want to expand this, e.g. to handle lvm,
-		// dmraid, crypt, etc., but this is enough to cover bases
-		// for now.
+		// We may later want to expand this, e.g. to handle dmraid,
+		// crypt, etc., but this is enough to cover bases for now.
+		// Logical volumes are included so that filesystems can be
+		// managed on volumes created by the LVM storage provider.
 		switch deviceType {
-		case typeLoop:
+		case typeLoop, typeLVM:
 		case typeDisk:
 			// Floppy disks, which have major device number 2,
 			// should be ignored.
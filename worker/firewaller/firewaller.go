@@ -45,8 +45,16 @@ type FirewallerAPI interface {
 	MacaroonForRelation(relationKey string) (*macaroon.Macaroon, error)
 	SetRelationStatus(relationKey string, status relation.Status, message string) error
 	FirewallRules(applicationNames ...string) ([]params.FirewallRule, error)
+	ModelFirewallRules() ([]params.FirewallRule, error)
+	WatchModelFirewallRules() (watcher.NotifyWatcher, error)
+	WatchForModelConfigChanges() (watcher.NotifyWatcher, error)
+	ModelConfig() (*config.Config, error)
 }
 
+// ErrRelationScopedIngressChanged indicates that the firewaller has
+// stopped because the relation-scoped-ingress model config changed.
+var ErrRelationScopedIngressChanged = errors.New("relation-scoped-ingress changed")
+
 // CrossModelFirewallerFacade exposes firewaller functionality on the
 // remote offering model to a worker.
 type CrossModelFirewallerFacade interface {
@@ -86,6 +94,10 @@ type Config struct {
 
 	NewCrossModelFacadeFunc newCrossModelFacadeFunc
 
+	// RelationScopedIngress, if true, causes the ports of unexposed
+	// applications to be opened to the addresses of related units.
+	RelationScopedIngress bool
+
 	Clock clock.Clock
 
 	CredentialAPI common.CredentialAPI
@@ -139,6 +151,16 @@ type Firewaller struct {
 	globalMode           bool
 	globalIngressRuleRef map[string]int // map of rule names to count of occurrences
 
+	modelConfigWatcher    watcher.NotifyWatcher
+	relationScopedIngress bool
+	relatedIngressChange  chan *relatedIngressChange
+
+	// portRulesWatcher notifies of changes to the named firewall
+	// rules restricting ingress to port ranges. It is nil if the
+	// controller does not support such rules.
+	portRulesWatcher watcher.NotifyWatcher
+	portRules        []portFirewallRule
+
 	modelUUID                  string
 	newRemoteFirewallerAPIFunc newCrossModelFacadeFunc
 	remoteRelationsWatcher     watcher.StringsWatcher
@@ -172,6 +194,8 @@ func NewFirewaller(cfg Config) (worker.Worker, error) {
 		unitds:                     make(map[names.UnitTag]*unitData),
 		applicationids:             make(map[names.ApplicationTag]*applicationData),
 		exposedChange:              make(chan *exposedChange),
+		relationScopedIngress:      cfg.RelationScopedIngress,
+		relatedIngressChange:       make(chan *relatedIngressChange),
 		relationIngress:            make(map[names.RelationTag]*remoteRelationData),
 		localRelationsChange:       make(chan *remoteRelationNetworkChange),
 		pollClock:                  clk,
@@ -226,6 +250,27 @@ func (fw *Firewaller) setUp() error {
 		return errors.Trace(err)
 	}
 
+	fw.modelConfigWatcher, err = fw.firewallerApi.WatchForModelConfigChanges()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if err := fw.catacomb.Add(fw.modelConfigWatcher); err != nil {
+		return errors.Trace(err)
+	}
+
+	fw.portRulesWatcher, err = fw.firewallerApi.WatchModelFirewallRules()
+	if errors.IsNotSupported(err) {
+		logger.Debugf("firewall rules for port ranges not supported by the controller")
+	} else if err != nil {
+		return errors.Trace(err)
+	} else if err := fw.catacomb.Add(fw.portRulesWatcher); err != nil {
+		return errors.Trace(err)
+	} else if err := fw.loadPortRules(); err != nil {
+		// The rules must be known before the initial reconciliation,
+		// or ports they restrict would be opened to the world.
+		return errors.Trace(err)
+	}
+
 	fw.remoteRelationsWatcher, err = fw.remoteRelationsApi.WatchRemoteRelations()
 	if err != nil {
 		return errors.Trace(err)
@@ -244,6 +289,10 @@ func (fw *Firewaller) loop() error {
 	}
 	var reconciled bool
 	portsChange := fw.portsWatcher.Changes()
+	var portRulesChange watcher.NotifyChannel
+	if fw.portRulesWatcher != nil {
+		portRulesChange = fw.portRulesWatcher.Changes()
+	}
 	for {
 		select {
 		case <-fw.catacomb.Dying():
@@ -311,10 +360,114 @@ func (fw *Firewaller) loop() error {
 			if err := fw.flushUnits(unitds); err != nil {
 				return errors.Annotate(err, "cannot change firewall ports")
 			}
+		case change := <-fw.relatedIngressChange:
+			change.applicationd.relatedIngress = change.networks
+			unitds := []*unitData{}
+			for _, unitd := range change.applicationd.unitds {
+				unitds = append(unitds, unitd)
+			}
+			if err := fw.flushUnits(unitds); err != nil {
+				return errors.Annotate(err, "cannot change firewall ports")
+			}
+		case _, ok := <-fw.modelConfigWatcher.Changes():
+			if !ok {
+				return errors.New("model config watcher closed")
+			}
+			if err := fw.modelConfigChanged(); err != nil {
+				return errors.Trace(err)
+			}
+		case _, ok := <-portRulesChange:
+			if !ok {
+				return errors.New("firewall rules watcher closed")
+			}
+			if err := fw.portRulesChanged(); err != nil {
+				return errors.Trace(err)
+			}
 		}
 	}
 }
 
+// portFirewallRule restricts ingress to ports opened within
+// the port range to the whitelisted CIDRs.
+type portFirewallRule struct {
+	name      string
+	portRange network.PortRange
+	whitelist []string
+}
+
+// portRulesChanged reloads the named firewall rules applying to the
+// model and updates the ingress rules of all known machines.
+func (fw *Firewaller) portRulesChanged() error {
+	if err := fw.loadPortRules(); err != nil {
+		return errors.Trace(err)
+	}
+	for _, machined := range fw.machineds {
+		if err := fw.flushMachine(machined); err != nil {
+			return errors.Annotate(err, "cannot change firewall ports")
+		}
+	}
+	return nil
+}
+
+// loadPortRules reads the named firewall rules applying to the model.
+func (fw *Firewaller) loadPortRules() error {
+	rules, err := fw.firewallerApi.ModelFirewallRules()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	fw.portRules = make([]portFirewallRule, 0, len(rules))
+	for _, rule := range rules {
+		if rule.PortRange == nil {
+			continue
+		}
+		fw.portRules = append(fw.portRules, portFirewallRule{
+			name:      rule.Name,
+			portRange: rule.PortRange.NetworkPortRange(),
+			whitelist: rule.WhitelistCIDRS,
+		})
+	}
+	logger.Debugf("firewall rules for port ranges: %+v", fw.portRules)
+	return nil
+}
+
+// whitelistForPortRange returns the CIDRs to which ingress from anywhere
+// to the port range is restricted by any named firewall rules, or nil if
+// no rule applies to the port range. A rule applies to port ranges that
+// lie entirely within its own.
+func (fw *Firewaller) whitelistForPortRange(portRange network.PortRange) []string {
+	cidrs := set.NewStrings()
+	for _, rule := range fw.portRules {
+		if portRangeContains(rule.portRange, portRange) {
+			cidrs = cidrs.Union(set.NewStrings(rule.whitelist...))
+		}
+	}
+	if cidrs.IsEmpty() {
+		return nil
+	}
+	return cidrs.SortedValues()
+}
+
+// portRangeContains returns whether the port range b lies entirely
+// within a.
+func portRangeContains(a, b network.PortRange) bool {
+	return a.Protocol == b.Protocol && a.FromPort <= b.FromPort && b.ToPort <= a.ToPort
+}
+
+// modelConfigChanged checks whether the relation-scoped-ingress setting
+// differs from the one the firewaller was started with, in which case the
+// firewaller needs to be restarted to track the related unit addresses.
+func (fw *Firewaller) modelConfigChanged() error {
+	cfg, err := fw.firewallerApi.ModelConfig()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if cfg.RelationScopedIngress() != fw.relationScopedIngress {
+		logger.Infof("relation-scoped-ingress changed to %v, restarting", cfg.RelationScopedIngress())
+		return ErrRelationScopedIngressChanged
+	}
+	return nil
+}
+
 func (fw *Firewaller) relationIngressChanged(change *remoteRelationNetworkChange) error {
 	logger.Debugf("process remote relation ingress change for %v", change.relationTag)
 	relData, ok := fw.relationIngress[change.relationTag]
@@ -504,6 +657,9 @@ func (fw *Firewaller) reconcileGlobal() error {
 		machines = append(machines, machined)
 	}
 	want, err := fw.gatherIngressRules(machines...)
+	if err != nil {
+		return err
+	}
 	initialPortRanges, err := fw.environFirewaller.IngressRules(fw.cloudCallContext)
 	if err != nil {
 		return err
@@ -760,11 +916,25 @@ func (fw *Firewaller) gatherIngressRules(machines ...*machineData) ([]network.In
 				if err := fw.updateForRemoteRelationIngress(unitd.applicationd.application.Tag(), cidrs); err != nil {
 					return nil, errors.Trace(err)
 				}
+				// And if ingress is scoped to relations, allow access
+				// from the related units in this model.
+				if fw.relationScopedIngress {
+					if err := fw.updateForRelatedIngress(unitd.applicationd, cidrs); err != nil {
+						return nil, errors.Trace(err)
+					}
+				}
 				logger.Debugf("CIDRS for %v: %v", unitTag, cidrs.Values())
 			}
 			if cidrs.Size() > 0 {
 				for portRange := range portRanges {
 					sourceCidrs := cidrs.SortedValues()
+					// Ingress from everywhere may be restricted by
+					// firewall rules for the port range.
+					if unitd.applicationd.exposed {
+						if whitelist := fw.whitelistForPortRange(portRange); whitelist != nil {
+							sourceCidrs = whitelist
+						}
+					}
 					rule, err := network.NewIngressRule(portRange.Protocol, portRange.FromPort, portRange.ToPort, sourceCidrs...)
 					if err != nil {
 						return nil, errors.Trace(err)
@@ -834,6 +1004,23 @@ func (fw *Firewaller) updateForRemoteRelationIngress(appTag names.ApplicationTag
 	return nil
 }
 
+// updateForRelatedIngress adds the addresses of the units related to the
+// application within the model to the specified cidrs.
+func (fw *Firewaller) updateForRelatedIngress(applicationd *applicationData, cidrs set.Strings) error {
+	newCidrs := applicationd.relatedIngress
+	if newCidrs.Size() > maxAllowedCIDRS {
+		merged, err := cidrman.MergeCIDRs(newCidrs.Values())
+		if err != nil {
+			return errors.Trace(err)
+		}
+		newCidrs = set.NewStrings(merged...)
+	}
+	for _, cidr := range newCidrs.Values() {
+		cidrs.Add(cidr)
+	}
+	return nil
+}
+
 // flushGlobalPorts opens and closes global ports in the environment.
 // It keeps a reference count for ports so that only 0-to-1 and 1-to-0 events
 // modify the environment.
@@ -1080,6 +1267,13 @@ type exposedChange struct {
 	exposed      bool
 }
 
+// relatedIngressChange contains the changed addresses of the units
+// related to one specific application.
+type relatedIngressChange struct {
+	applicationd *applicationData
+	networks     set.Strings
+}
+
 // applicationData holds application details and watches exposure changes.
 type applicationData struct {
 	catacomb    catacomb.Catacomb
@@ -1087,6 +1281,10 @@ type applicationData struct {
 	application *firewaller.Application
 	exposed     bool
 	unitds      map[names.UnitTag]*unitData
+
+	// relatedIngress holds the addresses of the units related
+	// to the application, when ingress is scoped to relations.
+	relatedIngress set.Strings
 }
 
 // watchLoop watches the application's exposed flag for changes.
@@ -1101,10 +1299,24 @@ func (ad *applicationData) watchLoop(exposed bool) error {
 	if err := ad.catacomb.Add(appWatcher); err != nil {
 		return errors.Trace(err)
 	}
+	relatedIngressChanges, err := ad.watchRelatedIngress()
+	if err != nil {
+		return errors.Trace(err)
+	}
 	for {
 		select {
 		case <-ad.catacomb.Dying():
 			return ad.catacomb.ErrDying()
+		case cidrs, ok := <-relatedIngressChanges:
+			if !ok {
+				return errors.New("related ingress watcher closed")
+			}
+			logger.Debugf("related ingress addresses for %v changed: %v", ad.application.Tag(), cidrs)
+			select {
+			case <-ad.catacomb.Dying():
+				return ad.catacomb.ErrDying()
+			case ad.fw.relatedIngressChange <- &relatedIngressChange{ad, set.NewStrings(cidrs...)}:
+			}
 		case _, ok := <-appWatcher.Changes():
 			if !ok {
 				return errors.New("application watcher closed")
@@ -1133,6 +1345,27 @@ func (ad *applicationData) watchLoop(exposed bool) error {
 	}
 }
 
+// watchRelatedIngress starts watching the addresses of the units related
+// to the application, if ingress is scoped to relations. A nil channel is
+// returned if there is nothing to watch.
+func (ad *applicationData) watchRelatedIngress() (<-chan []string, error) {
+	if !ad.fw.relationScopedIngress {
+		return nil, nil
+	}
+	w, err := ad.application.WatchRelatedIngressAddresses()
+	if errors.IsNotSupported(err) {
+		logger.Warningf("relation scoped ingress not supported by the controller: %v", err)
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if err := ad.catacomb.Add(w); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return w.Changes(), nil
+}
+
 // Kill is part of the worker.Worker interface.
 func (ad *applicationData) Kill() {
 	ad.catacomb.Kill(nil)
@@ -195,6 +195,7 @@ func (s *firewallerBaseSuite) startInstance(c *gc.C, m *state.Machine) instance.
 
 type InstanceModeSuite struct {
 	firewallerBaseSuite
+	relationScopedIngress bool
 }
 
 var _ = gc.Suite(&InstanceModeSuite{})
@@ -238,8 +239,9 @@ func (s *InstanceModeSuite) newFirewallerWithClock(c *gc.C, clock clock.Clock) w
 		NewCrossModelFacadeFunc: func(*api.Info) (firewaller.CrossModelFirewallerFacadeCloser, error) {
 			return s.crossmodelFirewaller, nil
 		},
-		Clock:         s.clock,
-		CredentialAPI: s.credentialsFacade,
+		Clock:                 s.clock,
+		CredentialAPI:         s.credentialsFacade,
+		RelationScopedIngress: s.relationScopedIngress,
 	}
 	fw, err := firewaller.NewFirewaller(cfg)
 	c.Assert(err, jc.ErrorIsNil)
@@ -898,6 +900,117 @@ func (s *InstanceModeSuite) TestRemoteRelationRequirerRoleConsumingSide(c *gc.C)
 	}
 }
 
+func (s *InstanceModeSuite) TestRelationScopedIngress(c *gc.C) {
+	err := s.Model.UpdateModelConfig(map[string]interface{}{"relation-scoped-ingress": true}, nil)
+	c.Assert(err, jc.ErrorIsNil)
+	s.relationScopedIngress = true
+	fw := s.newFirewaller(c)
+	defer statetesting.AssertKillAndWait(c, fw)
+
+	mysql := s.AddTestingApplication(c, "mysql", s.AddTestingCharm(c, "mysql"))
+	wordpress := s.AddTestingApplication(c, "wordpress", s.AddTestingCharm(c, "wordpress"))
+	eps, err := s.State.InferEndpoints("wordpress", "mysql")
+	c.Assert(err, jc.ErrorIsNil)
+	rel, err := s.State.AddRelation(eps...)
+	c.Assert(err, jc.ErrorIsNil)
+
+	u, m := s.addUnit(c, mysql)
+	inst := s.startInstance(c, m)
+	err = u.OpenPort("tcp", 3306)
+	c.Assert(err, jc.ErrorIsNil)
+
+	// No related units are in scope, so nothing is opened.
+	s.assertPorts(c, inst, m.Id(), nil)
+
+	wpm := s.Factory.MakeMachine(c, &factory.MachineParams{
+		Addresses: []network.Address{network.NewAddress("10.0.0.4")},
+	})
+	wpu, err := wordpress.AddUnit(state.AddUnitParams{})
+	c.Assert(err, jc.ErrorIsNil)
+	err = wpu.AssignToMachine(wpm)
+	c.Assert(err, jc.ErrorIsNil)
+	ru, err := rel.Unit(wpu)
+	c.Assert(err, jc.ErrorIsNil)
+	err = ru.EnterScope(map[string]interface{}{})
+	c.Assert(err, jc.ErrorIsNil)
+
+	s.assertPorts(c, inst, m.Id(), []network.IngressRule{
+		network.MustNewIngressRule("tcp", 3306, 3306, "10.0.0.4/32"),
+	})
+
+	// Exposing the application opens the port to the world.
+	err = mysql.SetExposed()
+	c.Assert(err, jc.ErrorIsNil)
+	s.assertPorts(c, inst, m.Id(), []network.IngressRule{
+		network.MustNewIngressRule("tcp", 3306, 3306, "0.0.0.0/0"),
+	})
+	err = mysql.ClearExposed()
+	c.Assert(err, jc.ErrorIsNil)
+	s.assertPorts(c, inst, m.Id(), []network.IngressRule{
+		network.MustNewIngressRule("tcp", 3306, 3306, "10.0.0.4/32"),
+	})
+
+	// When the related unit leaves, access is revoked.
+	err = ru.LeaveScope()
+	c.Assert(err, jc.ErrorIsNil)
+	s.assertPorts(c, inst, m.Id(), nil)
+}
+
+func (s *InstanceModeSuite) TestNamedFirewallRule(c *gc.C) {
+	fw := s.newFirewaller(c)
+	defer statetesting.AssertKillAndWait(c, fw)
+
+	app := s.AddTestingApplication(c, "wordpress", s.AddTestingCharm(c, "wordpress"))
+	err := app.SetExposed()
+	c.Assert(err, jc.ErrorIsNil)
+	u, m := s.addUnit(c, app)
+	inst := s.startInstance(c, m)
+	err = u.OpenPorts("tcp", 9100, 9105)
+	c.Assert(err, jc.ErrorIsNil)
+	err = u.OpenPort("tcp", 80)
+	c.Assert(err, jc.ErrorIsNil)
+
+	s.assertPorts(c, inst, m.Id(), []network.IngressRule{
+		network.MustNewIngressRule("tcp", 80, 80, "0.0.0.0/0"),
+		network.MustNewIngressRule("tcp", 9100, 9105, "0.0.0.0/0"),
+	})
+
+	// A rule which only overlaps the opened port range does not
+	// apply to it.
+	rules := state.NewFirewallRules(s.State)
+	err = rules.Save(state.FirewallRule{
+		Name:           "prometheus",
+		PortRange:      network.PortRange{FromPort: 9100, ToPort: 9100, Protocol: "tcp"},
+		WhitelistCIDRs: []string{"10.0.5.0/24"},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	s.assertPorts(c, inst, m.Id(), []network.IngressRule{
+		network.MustNewIngressRule("tcp", 80, 80, "0.0.0.0/0"),
+		network.MustNewIngressRule("tcp", 9100, 9105, "0.0.0.0/0"),
+	})
+
+	// A rule containing the opened port range restricts ingress
+	// to the whitelist, leaving other ports alone.
+	err = rules.Save(state.FirewallRule{
+		Name:           "prometheus",
+		PortRange:      network.PortRange{FromPort: 9000, ToPort: 9199, Protocol: "tcp"},
+		WhitelistCIDRs: []string{"10.0.5.0/24"},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	s.assertPorts(c, inst, m.Id(), []network.IngressRule{
+		network.MustNewIngressRule("tcp", 80, 80, "0.0.0.0/0"),
+		network.MustNewIngressRule("tcp", 9100, 9105, "10.0.5.0/24"),
+	})
+
+	// Removing the rule opens the port range to the world again.
+	err = rules.Remove("prometheus")
+	c.Assert(err, jc.ErrorIsNil)
+	s.assertPorts(c, inst, m.Id(), []network.IngressRule{
+		network.MustNewIngressRule("tcp", 80, 80, "0.0.0.0/0"),
+		network.MustNewIngressRule("tcp", 9100, 9105, "0.0.0.0/0"),
+	})
+}
+
 func (s *InstanceModeSuite) TestRemoteRelationWorkerError(c *gc.C) {
 	published := make(chan bool)
 	ingressRequired := true
@@ -1367,6 +1480,42 @@ func (s *GlobalModeSuite) TestStartWithUnexposedApplication(c *gc.C) {
 	})
 }
 
+func (s *GlobalModeSuite) TestNamedFirewallRule(c *gc.C) {
+	rules := state.NewFirewallRules(s.State)
+	err := rules.Save(state.FirewallRule{
+		Name:           "prometheus",
+		PortRange:      network.PortRange{FromPort: 9100, ToPort: 9100, Protocol: "tcp"},
+		WhitelistCIDRs: []string{"10.0.5.0/24"},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	app := s.AddTestingApplication(c, "wordpress", s.charm)
+	err = app.SetExposed()
+	c.Assert(err, jc.ErrorIsNil)
+	u, m := s.addUnit(c, app)
+	s.startInstance(c, m)
+	err = u.OpenPort("tcp", 9100)
+	c.Assert(err, jc.ErrorIsNil)
+	err = u.OpenPort("tcp", 80)
+	c.Assert(err, jc.ErrorIsNil)
+
+	// The rule applies from the initial reconciliation onwards.
+	fw := s.newFirewaller(c)
+	defer statetesting.AssertKillAndWait(c, fw)
+	s.assertEnvironPorts(c, []network.IngressRule{
+		network.MustNewIngressRule("tcp", 80, 80, "0.0.0.0/0"),
+		network.MustNewIngressRule("tcp", 9100, 9100, "10.0.5.0/24"),
+	})
+
+	// Removing the rule opens the port to the world again.
+	err = rules.Remove("prometheus")
+	c.Assert(err, jc.ErrorIsNil)
+	s.assertEnvironPorts(c, []network.IngressRule{
+		network.MustNewIngressRule("tcp", 80, 80, "0.0.0.0/0"),
+		network.MustNewIngressRule("tcp", 9100, 9100, "0.0.0.0/0"),
+	})
+}
+
 func (s *GlobalModeSuite) TestRestart(c *gc.C) {
 	// Start firewaller and open ports.
 	fw := s.newFirewaller(c)
@@ -39,10 +39,20 @@ func Manifold(cfg ManifoldConfig) dependency.Manifold {
 			cfg.APICallerName,
 			cfg.EnvironName,
 		},
-		Start: cfg.start,
+		Start:  cfg.start,
+		Filter: bounceErrChanged,
 	}
 }
 
+// bounceErrChanged converts ErrRelationScopedIngressChanged to
+// dependency.ErrBounce.
+func bounceErrChanged(err error) error {
+	if errors.Cause(err) == ErrRelationScopedIngressChanged {
+		return dependency.ErrBounce
+	}
+	return err
+}
+
 // Validate is called by start to check for bad configuration.
 func (cfg ManifoldConfig) Validate() error {
 	if cfg.AgentName == "" {
@@ -129,6 +139,7 @@ func (cfg ManifoldConfig) start(context dependency.Context) (worker.Worker, erro
 		EnvironFirewaller:  fwEnv,
 		EnvironInstances:   environ,
 		Mode:               mode,
+		RelationScopedIngress:   environ.Config().RelationScopedIngress(),
 		NewCrossModelFacadeFunc: crossmodelFirewallerFacadeFunc(cfg.NewControllerConnection),
 		CredentialAPI:           credentialAPI,
 	})
@@ -107,6 +107,12 @@ juju-statetracker-report () {
   jujuMachineOrUnit debug/pprof/juju/state/tracker?debug=1 $@
 }
 
+juju-machine-lock () {
+  for agent in ` + "`ls /var/lib/juju/agents`" + `; do
+    juju-introspect --agent=$agent machinelock/ 2>/dev/null
+  done
+}
+
 export -f jujuAgentCall
 export -f jujuMachineAgentName
 export -f jujuMachineOrUnit
@@ -119,4 +125,5 @@ export -f juju-statepool-report
 export -f juju-statetracker-report
 export -f juju-pubsub-report
 export -f juju-presence-report
+export -f juju-machine-lock
 `
@@ -19,6 +19,7 @@ import (
 	"gopkg.in/yaml.v2"
 
 	"github.com/juju/juju/cmd/output"
+	"github.com/juju/juju/core/machinelock"
 	"github.com/juju/juju/core/presence"
 	"github.com/juju/juju/worker/introspection/pprof"
 )
@@ -46,6 +47,7 @@ type Config struct {
 	PubSub             IntrospectionReporter
 	PrometheusGatherer prometheus.Gatherer
 	Presence           presence.Recorder
+	MachineLock        machinelock.Lock
 }
 
 // Validate checks the config values to assert they are valid to create the worker.
@@ -68,6 +70,7 @@ type socketListener struct {
 	pubsub             IntrospectionReporter
 	prometheusGatherer prometheus.Gatherer
 	presence           presence.Recorder
+	machineLock        machinelock.Lock
 	done               chan struct{}
 }
 
@@ -100,6 +103,7 @@ func NewWorker(config Config) (worker.Worker, error) {
 		pubsub:             config.PubSub,
 		prometheusGatherer: config.PrometheusGatherer,
 		presence:           config.Presence,
+		machineLock:        config.MachineLock,
 		done:               make(chan struct{}),
 	}
 	go w.serve()
@@ -116,6 +120,7 @@ func (w *socketListener) serve() {
 			PubSub:             w.pubsub,
 			PrometheusGatherer: w.prometheusGatherer,
 			Presence:           w.presence,
+			MachineLock:        w.machineLock,
 		}, mux.Handle)
 
 	srv := http.Server{Handler: mux}
@@ -153,6 +158,7 @@ type ReportSources struct {
 	PubSub             IntrospectionReporter
 	PrometheusGatherer prometheus.Gatherer
 	Presence           presence.Recorder
+	MachineLock        machinelock.Lock
 }
 
 // AddHandlers calls the given function with http.Handlers
@@ -182,6 +188,10 @@ func RegisterHTTPHandlers(
 	if sources.Presence != nil {
 		handle("/presence/", presenceHandler{sources.Presence})
 	}
+	// Only agents that run hooks have a machine lock to report on.
+	if sources.MachineLock != nil {
+		handle("/machinelock/", machineLockHandler{sources.MachineLock})
+	}
 }
 
 type depengineHandler struct {
@@ -227,6 +237,23 @@ func (h introspectionReporterHandler) ServeHTTP(w http.ResponseWriter, r *http.R
 	fmt.Fprint(w, h.reporter.IntrospectionReport())
 }
 
+type machineLockHandler struct {
+	lock machinelock.Lock
+}
+
+// ServeHTTP is part of the http.Handler interface.
+func (h machineLockHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	report, err := h.lock.Report(machinelock.ShowHistory)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprintf(w, "error: %v\n", err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	fmt.Fprint(w, report)
+}
+
 type presenceHandler struct {
 	presence presence.Recorder
 }
@@ -14,14 +14,16 @@ import (
 	"runtime"
 	"time"
 
+	"github.com/juju/errors"
 	"github.com/juju/testing"
 	jc "github.com/juju/testing/checkers"
 	"github.com/prometheus/client_golang/prometheus"
 	gc "gopkg.in/check.v1"
 	worker "gopkg.in/juju/worker.v1"
 
-	// Bring in the state package for the tracker profile.
+	"github.com/juju/juju/core/machinelock"
 	"github.com/juju/juju/core/presence"
+	// Bring in the state package for the tracker profile.
 	_ "github.com/juju/juju/state"
 	"github.com/juju/juju/worker/introspection"
 	"github.com/juju/juju/worker/workertest"
@@ -60,6 +62,7 @@ type introspectionSuite struct {
 	reporter introspection.DepEngineReporter
 	gatherer prometheus.Gatherer
 	recorder presence.Recorder
+	lock     machinelock.Lock
 }
 
 var _ = gc.Suite(&introspectionSuite{})
@@ -72,6 +75,7 @@ func (s *introspectionSuite) SetUpTest(c *gc.C) {
 	s.reporter = nil
 	s.worker = nil
 	s.recorder = nil
+	s.lock = nil
 	s.gatherer = newPrometheusGatherer()
 	s.startWorker(c)
 }
@@ -83,6 +87,7 @@ func (s *introspectionSuite) startWorker(c *gc.C) {
 		DepEngine:          s.reporter,
 		PrometheusGatherer: s.gatherer,
 		Presence:           s.recorder,
+		MachineLock:        s.lock,
 	})
 	c.Assert(err, jc.ErrorIsNil)
 	s.worker = w
@@ -196,6 +201,34 @@ func (s *introspectionSuite) TestEnabledPresenceReporter(c *gc.C) {
 	matches(c, buf, "agent-1  server  42       alive")
 }
 
+func (s *introspectionSuite) TestMissingMachineLock(c *gc.C) {
+	buf := s.call(c, "/machinelock/")
+	matches(c, buf, "404 Not Found")
+	matches(c, buf, "page not found")
+}
+
+func (s *introspectionSuite) TestMachineLock(c *gc.C) {
+	workertest.CheckKill(c, s.worker)
+	s.lock = &fakeLock{report: "unit-foo-0:\n  holder: none\n"}
+	s.startWorker(c)
+
+	buf := s.call(c, "/machinelock/")
+	matches(c, buf, "200 OK")
+	matches(c, buf, "unit-foo-0:")
+	matches(c, buf, "holder: none")
+	c.Check(s.lock.(*fakeLock).opts, jc.DeepEquals, []machinelock.ReportOption{machinelock.ShowHistory})
+}
+
+func (s *introspectionSuite) TestMachineLockError(c *gc.C) {
+	workertest.CheckKill(c, s.worker)
+	s.lock = &fakeLock{err: errors.New("boom")}
+	s.startWorker(c)
+
+	buf := s.call(c, "/machinelock/")
+	matches(c, buf, "500 Internal Server Error")
+	matches(c, buf, "error: boom")
+}
+
 func (s *introspectionSuite) TestPrometheusMetrics(c *gc.C) {
 	buf := s.call(c, "/metrics/")
 	c.Assert(buf, gc.NotNil)
@@ -228,6 +261,18 @@ func (r *reporter) Report() map[string]interface{} {
 	return r.values
 }
 
+type fakeLock struct {
+	machinelock.Lock
+	report string
+	err    error
+	opts   []machinelock.ReportOption
+}
+
+func (l *fakeLock) Report(opts ...machinelock.ReportOption) (string, error) {
+	l.opts = opts
+	return l.report, l.err
+}
+
 func newPrometheusGatherer() prometheus.Gatherer {
 	counter := prometheus.NewCounter(prometheus.CounterOpts{Name: "tau", Help: "Tau."})
 	counter.Add(6.283185)
@@ -81,6 +81,12 @@ func (ctx *limitedContext) HasExecutionSetUnitStatus() bool { return false }
 // ResetExecutionSetUnitStatus implements runner.Context.
 func (ctx *limitedContext) ResetExecutionSetUnitStatus() {}
 
+// HookRecording implements runner.Context.
+func (ctx *limitedContext) HookRecording() bool { return false }
+
+// HookTimeout implements runner.Context.
+func (ctx *limitedContext) HookTimeout() time.Duration { return 0 }
+
 // Id implements runner.Context.
 func (ctx *limitedContext) Id() string { return ctx.id }
 
@@ -4,15 +4,13 @@
 package meterstatus
 
 import (
-	"time"
-
 	"github.com/juju/errors"
-	"github.com/juju/mutex"
 	"github.com/juju/utils/clock"
 	"gopkg.in/juju/charm.v6/hooks"
 	"gopkg.in/juju/names.v2"
 
 	"github.com/juju/juju/agent"
+	"github.com/juju/juju/core/machinelock"
 	"github.com/juju/juju/worker/uniter"
 	"github.com/juju/juju/worker/uniter/runner"
 )
@@ -24,36 +22,33 @@ type HookRunner interface {
 
 // hookRunner implements functionality for running a hook.
 type hookRunner struct {
-	machineLockName string
-	config          agent.Config
-	tag             names.UnitTag
-	clock           clock.Clock
+	machineLock machinelock.Lock
+	config      agent.Config
+	tag         names.UnitTag
+	clock       clock.Clock
 }
 
-func NewHookRunner(tag names.UnitTag, lockName string, config agent.Config, clock clock.Clock) HookRunner {
+func NewHookRunner(tag names.UnitTag, lock machinelock.Lock, config agent.Config, clock clock.Clock) HookRunner {
 	return &hookRunner{
-		tag:             tag,
-		machineLockName: lockName,
-		config:          config,
-		clock:           clock,
+		tag:         tag,
+		machineLock: lock,
+		config:      config,
+		clock:       clock,
 	}
 }
 
 // acquireExecutionLock acquires the machine-level execution lock and returns a function to be used
 // to unlock it.
-func (w *hookRunner) acquireExecutionLock(interrupt <-chan struct{}) (mutex.Releaser, error) {
-	spec := mutex.Spec{
-		Name:   w.machineLockName,
-		Clock:  w.clock,
-		Delay:  250 * time.Millisecond,
-		Cancel: interrupt,
+func (w *hookRunner) acquireExecutionLock(interrupt <-chan struct{}) (func(), error) {
+	spec := machinelock.Spec{
+		Cancel:  interrupt,
+		Worker:  "meterstatus",
+		Comment: string(hooks.MeterStatusChanged),
 	}
-	logger.Debugf("acquire lock %q for meter status hook execution", w.machineLockName)
-	releaser, err := mutex.Acquire(spec)
+	releaser, err := w.machineLock.Acquire(spec)
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
-	logger.Debugf("lock %q acquired", w.machineLockName)
 	return releaser, nil
 }
 
@@ -70,8 +65,6 @@ func (w *hookRunner) RunHook(code, info string, interrupt <-chan struct{}) (runE
 	if err != nil {
 		return errors.Annotate(err, "failed to acquire machine lock")
 	}
-	// Defer the logging first so it is executed after the Release. LIFO.
-	defer logger.Debugf("release lock %q for meter status hook execution", w.machineLockName)
-	defer releaser.Release()
+	defer releaser()
 	return r.RunHook(string(hooks.MeterStatusChanged))
 }
@@ -88,6 +88,12 @@ func (ctx *hookContext) HasExecutionSetUnitStatus() bool { return false }
 // ResetExecutionSetUnitStatus implements runner.Context.
 func (ctx *hookContext) ResetExecutionSetUnitStatus() {}
 
+// HookRecording implements runner.Context.
+func (ctx *hookContext) HookRecording() bool { return false }
+
+// HookTimeout implements runner.Context.
+func (ctx *hookContext) HookTimeout() time.Duration { return 0 }
+
 // Id implements runner.Context.
 func (ctx *hookContext) Id() string { return ctx.id }
 
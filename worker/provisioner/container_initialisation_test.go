@@ -27,6 +27,7 @@ import (
 	apiprovisioner "github.com/juju/juju/api/provisioner"
 	"github.com/juju/juju/apiserver/params"
 	"github.com/juju/juju/container"
+	"github.com/juju/juju/core/machinelock"
 	"github.com/juju/juju/environs"
 	"github.com/juju/juju/instance"
 	supportedversion "github.com/juju/juju/juju/version"
@@ -108,6 +109,12 @@ func (s *ContainerSetupSuite) setupContainerWorker(c *gc.C, tag names.MachineTag
 	err = machine.SetSupportedContainers(instance.ContainerTypes...)
 	c.Assert(err, jc.ErrorIsNil)
 	cfg := s.AgentConfigForTag(c, tag)
+	machineLock, err := machinelock.New(machinelock.Config{
+		AgentName: tag.String(),
+		LockName:  s.lockName,
+		Clock:     clock.WallClock,
+	})
+	c.Assert(err, jc.ErrorIsNil)
 
 	watcherName := fmt.Sprintf("%s-container-watcher", machine.Id())
 	params := provisioner.ContainerSetupParams{
@@ -117,7 +124,7 @@ func (s *ContainerSetupSuite) setupContainerWorker(c *gc.C, tag names.MachineTag
 		Machine:             machine,
 		Provisioner:         pr,
 		Config:              cfg,
-		InitLockName:        s.lockName,
+		MachineLock:         machineLock,
 		CredentialAPI:       &credentialAPIForTest{},
 	}
 	handler := provisioner.NewContainerSetupHandler(params)
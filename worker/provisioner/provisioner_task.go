@@ -623,12 +623,12 @@ func (task *provisionerTask) constructStartInstanceParams(
 			return environs.StartInstanceParams{}, errors.Errorf("volume attachment params specifies instance ID")
 		}
 		volumes[i] = storage.VolumeParams{
-			volumeTag,
-			v.Size,
-			storage.ProviderType(v.Provider),
-			v.Attributes,
-			v.Tags,
-			&storage.VolumeAttachmentParams{
+			Tag:          volumeTag,
+			Size:         v.Size,
+			Provider:     storage.ProviderType(v.Provider),
+			Attributes:   v.Attributes,
+			ResourceTags: v.Tags,
+			Attachment: &storage.VolumeAttachmentParams{
 				AttachmentParams: storage.AttachmentParams{
 					Machine:  machineTag,
 					ReadOnly: v.Attachment.ReadOnly,
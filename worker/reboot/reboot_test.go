@@ -13,6 +13,7 @@ import (
 
 	"github.com/juju/juju/api"
 	apireboot "github.com/juju/juju/api/reboot"
+	"github.com/juju/juju/core/machinelock"
 	"github.com/juju/juju/instance"
 	jujutesting "github.com/juju/juju/juju/testing"
 	"github.com/juju/juju/juju/version"
@@ -83,15 +84,25 @@ func (s *rebootSuite) TearDownTest(c *gc.C) {
 // If more tests are added here, they each need their own lock name to avoid blocking
 // forever on windows.
 
+func (s *rebootSuite) newLock(c *gc.C, name string) machinelock.Lock {
+	lock, err := machinelock.New(machinelock.Config{
+		AgentName: "test",
+		LockName:  name,
+		Clock:     s.clock,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	return lock
+}
+
 func (s *rebootSuite) TestStartStop(c *gc.C) {
-	worker, err := reboot.NewReboot(s.rebootState, s.AgentConfigForTag(c, s.machine.Tag()), "test-reboot-start-stop", s.clock)
+	worker, err := reboot.NewReboot(s.rebootState, s.AgentConfigForTag(c, s.machine.Tag()), s.newLock(c, "test-reboot-start-stop"))
 	c.Assert(err, jc.ErrorIsNil)
 	worker.Kill()
 	c.Assert(worker.Wait(), gc.IsNil)
 }
 
 func (s *rebootSuite) TestWorkerCatchesRebootEvent(c *gc.C) {
-	wrk, err := reboot.NewReboot(s.rebootState, s.AgentConfigForTag(c, s.machine.Tag()), "test-reboot-event", s.clock)
+	wrk, err := reboot.NewReboot(s.rebootState, s.AgentConfigForTag(c, s.machine.Tag()), s.newLock(c, "test-reboot-event"))
 	c.Assert(err, jc.ErrorIsNil)
 	err = s.rebootState.RequestReboot()
 	c.Assert(err, jc.ErrorIsNil)
@@ -99,7 +110,7 @@ func (s *rebootSuite) TestWorkerCatchesRebootEvent(c *gc.C) {
 }
 
 func (s *rebootSuite) TestContainerCatchesParentFlag(c *gc.C) {
-	wrk, err := reboot.NewReboot(s.ctRebootState, s.AgentConfigForTag(c, s.ct.Tag()), "test-reboot-container", s.clock)
+	wrk, err := reboot.NewReboot(s.ctRebootState, s.AgentConfigForTag(c, s.ct.Tag()), s.newLock(c, "test-reboot-container"))
 	c.Assert(err, jc.ErrorIsNil)
 	err = s.rebootState.RequestReboot()
 	c.Assert(err, jc.ErrorIsNil)
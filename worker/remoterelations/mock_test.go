@@ -138,6 +138,20 @@ func (m *mockRelationsFacade) GetToken(entity names.Tag) (string, error) {
 	return "token-" + entity.Id(), nil
 }
 
+func (m *mockRelationsFacade) RelationApplicationSettings(relationApps []params.RelationApplication) ([]params.SettingsResult, error) {
+	m.stub.MethodCall(m, "RelationApplicationSettings", relationApps)
+	if err := m.stub.NextErr(); err != nil {
+		return nil, err
+	}
+	result := make([]params.SettingsResult, len(relationApps))
+	for i := range relationApps {
+		result[i].Settings = map[string]string{
+			"app": "data",
+		}
+	}
+	return result, nil
+}
+
 func (m *mockRelationsFacade) RelationUnitSettings(relationUnits []params.RelationUnit) ([]params.SettingsResult, error) {
 	m.stub.MethodCall(m, "RelationUnitSettings", relationUnits)
 	if err := m.stub.NextErr(); err != nil {
@@ -237,6 +251,11 @@ func (m *mockRelationsFacade) ConsumeRemoteRelationChange(change params.RemoteRe
 	return nil
 }
 
+func (m *mockRelationsFacade) RecordRemoteRelationEvent(event params.RemoteRelationEventArg) error {
+	m.stub.MethodCall(m, "RecordRemoteRelationEvent", event)
+	return m.stub.NextErr()
+}
+
 func (m *mockRelationsFacade) ControllerAPIInfoForModel(modelUUID string) (*api.Info, error) {
 	m.stub.MethodCall(m, "ControllerAPIInfoForModel", modelUUID)
 	if err := m.stub.NextErr(); err != nil {
@@ -347,6 +366,23 @@ func (m *mockRemoteRelationsFacade) WatchOfferStatus(arg params.OfferArg) (watch
 	return m.offersStatusWatchers[arg.OfferUUID], nil
 }
 
+// RelationApplicationSettings returns the settings of the given applications in the remote model.
+func (m *mockRemoteRelationsFacade) RelationApplicationSettings(relationApps []params.RemoteRelationApplication) ([]params.SettingsResult, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.stub.MethodCall(m, "RelationApplicationSettings", relationApps)
+	if err := m.stub.NextErr(); err != nil {
+		return nil, err
+	}
+	result := make([]params.SettingsResult, len(relationApps))
+	for i := range relationApps {
+		result[i].Settings = map[string]string{
+			"app": "data",
+		}
+	}
+	return result, nil
+}
+
 // RelationUnitSettings returns the relation unit settings for the given relation units in the remote model.
 func (m *mockRemoteRelationsFacade) RelationUnitSettings(relationUnits []params.RemoteRelationUnit) ([]params.SettingsResult, error) {
 	m.mu.Lock()
@@ -18,6 +18,7 @@ import (
 )
 
 type relationUnitsSettingsFunc func([]string) ([]params.SettingsResult, error)
+type relationApplicationSettingsFunc func([]string) ([]params.SettingsResult, error)
 
 // relationUnitsWorker uses instances of watcher.RelationUnitsWatcher to
 // listen to changes to relation settings in a model, local or remote.
@@ -34,6 +35,7 @@ type relationUnitsWorker struct {
 	remoteRelationToken string
 
 	unitSettingsFunc relationUnitsSettingsFunc
+	appSettingsFunc  relationApplicationSettingsFunc
 }
 
 func newRelationUnitsWorker(
@@ -44,6 +46,7 @@ func newRelationUnitsWorker(
 	ruw watcher.RelationUnitsWatcher,
 	changes chan<- params.RemoteRelationChangeEvent,
 	unitSettingsFunc relationUnitsSettingsFunc,
+	appSettingsFunc relationApplicationSettingsFunc,
 ) (*relationUnitsWorker, error) {
 	w := &relationUnitsWorker{
 		relationTag:         relationTag,
@@ -53,6 +56,7 @@ func newRelationUnitsWorker(
 		ruw:                 ruw,
 		changes:             changes,
 		unitSettingsFunc:    unitSettingsFunc,
+		appSettingsFunc:     appSettingsFunc,
 	}
 	err := catacomb.Invoke(catacomb.Plan{
 		Site: &w.catacomb,
@@ -106,7 +110,7 @@ func (w *relationUnitsWorker) relationUnitsChangeEvent(
 	change watcher.RelationUnitsChange,
 ) (*params.RemoteRelationChangeEvent, error) {
 	logger.Debugf("update relation units for %v", w.relationTag)
-	if len(change.Changed)+len(change.Departed) == 0 {
+	if len(change.Changed)+len(change.Departed)+len(change.AppChanged) == 0 {
 		return nil, nil
 	}
 	// Ensure all the changed units have been exported.
@@ -165,5 +169,45 @@ func (w *relationUnitsWorker) relationUnitsChangeEvent(
 			event.ChangedUnits = append(event.ChangedUnits, change)
 		}
 	}
+
+	if len(change.AppChanged) > 0 {
+		settings, err := w.applicationSettings(change.AppChanged)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		event.ApplicationSettings = settings
+	}
+	if len(change.Changed)+len(change.Departed) == 0 && event.ApplicationSettings == nil {
+		return nil, nil
+	}
 	return event, nil
 }
+
+// applicationSettings returns the current settings of the application
+// whose settings have changed. A nil map is returned if the model
+// holding the application does not support application settings.
+func (w *relationUnitsWorker) applicationSettings(appChanged map[string]int64) (map[string]interface{}, error) {
+	appNames := make([]string, 0, len(appChanged))
+	for name := range appChanged {
+		appNames = append(appNames, name)
+	}
+	if len(appNames) != 1 {
+		return nil, errors.Errorf("expected settings changes for 1 application, got %d", len(appNames))
+	}
+	results, err := w.appSettingsFunc(appNames)
+	if errors.IsNotImplemented(err) {
+		logger.Debugf("application settings not supported for %v: %v", w.relationTag, err)
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Annotate(err, "fetching relation application settings")
+	}
+	if results[0].Error != nil {
+		return nil, errors.Annotatef(results[0].Error, "fetching relation application settings for %v", appNames[0])
+	}
+	settings := make(map[string]interface{})
+	for k, v := range results[0].Settings {
+		settings[k] = v
+	}
+	return settings, nil
+}
@@ -5,6 +5,7 @@ package remoterelations
 
 import (
 	"github.com/juju/errors"
+	"github.com/juju/utils/clock"
 	"gopkg.in/juju/names.v2"
 	"gopkg.in/juju/worker.v1"
 	"gopkg.in/macaroon.v2-unstable"
@@ -44,6 +45,10 @@ type remoteApplicationWorker struct {
 	remoteModelFacade RemoteModelRelationsFacadeCloser
 
 	newRemoteModelRelationsFacadeFunc newRemoteRelationsFacadeFunc
+
+	// clock is used to timestamp the relation events
+	// recorded for diagnostic purposes.
+	clock clock.Clock
 }
 
 // relation holds attributes relevant to a particular
@@ -157,13 +162,17 @@ func (w *remoteApplicationWorker) loop() (err error) {
 			}
 		case change := <-w.localRelationChanges:
 			logger.Debugf("local relation units changed -> publishing: %#v", change)
-			if err := w.remoteModelFacade.PublishRelationChange(change); err != nil {
+			err := w.remoteModelFacade.PublishRelationChange(change)
+			w.recordRelationEvent(params.RemoteRelationEventSent, change, err)
+			if err != nil {
 				w.checkOfferPermissionDenied(err, change.ApplicationToken, change.RelationToken)
 				return errors.Annotatef(err, "publishing relation change %+v to remote model %v", change, w.remoteModelUUID)
 			}
 		case change := <-w.remoteRelationChanges:
 			logger.Debugf("remote relation units changed -> consuming: %#v", change)
-			if err := w.localModelFacade.ConsumeRemoteRelationChange(change); err != nil {
+			err := w.localModelFacade.ConsumeRemoteRelationChange(change)
+			w.recordRelationEvent(params.RemoteRelationEventReceived, change, err)
+			if err != nil {
 				return errors.Annotatef(err, "consuming relation change %+v from remote model %v", change, w.remoteModelUUID)
 			}
 		case changes := <-offerStatusChanges:
@@ -177,6 +186,25 @@ func (w *remoteApplicationWorker) loop() (err error) {
 	}
 }
 
+// recordRelationEvent records the outcome of exchanging a relation change
+// with the remote model so it can be reported when diagnosing the relation.
+// Failing to record the event is not fatal to the worker.
+func (w *remoteApplicationWorker) recordRelationEvent(direction params.RemoteRelationEventDirection, change params.RemoteRelationChangeEvent, eventErr error) {
+	event := params.RemoteRelationEventArg{
+		RelationToken: change.RelationToken,
+		Direction:     direction,
+		Time:          w.clock.Now(),
+		ChangedUnits:  len(change.ChangedUnits),
+		DepartedUnits: len(change.DepartedUnits),
+	}
+	if eventErr != nil {
+		event.Error = eventErr.Error()
+	}
+	if err := w.localModelFacade.RecordRemoteRelationEvent(event); err != nil {
+		logger.Warningf("recording %s event for relation %v: %v", direction, change.RelationToken, err)
+	}
+}
+
 func (w *remoteApplicationWorker) processRelationDying(key string, relations map[string]*relation) error {
 	logger.Debugf("relation %v dying", key)
 	relation, ok := relations[key]
@@ -321,6 +349,16 @@ func (w *remoteApplicationWorker) startUnitsWorkers(
 		}
 		return w.localModelFacade.RelationUnitSettings(relationUnits)
 	}
+	localAppSettingsFunc := func(appNames []string) ([]params.SettingsResult, error) {
+		relationApps := make([]params.RelationApplication, len(appNames))
+		for i, appName := range appNames {
+			relationApps[i] = params.RelationApplication{
+				Relation:    relationTag.String(),
+				Application: appName,
+			}
+		}
+		return w.localModelFacade.RelationApplicationSettings(relationApps)
+	}
 	localUnitsWorker, err := newRelationUnitsWorker(
 		relationTag,
 		applicationToken,
@@ -329,6 +367,7 @@ func (w *remoteApplicationWorker) startUnitsWorkers(
 		localRelationUnitsWatcher,
 		w.localRelationChanges,
 		localUnitSettingsFunc,
+		localAppSettingsFunc,
 	)
 	if err != nil {
 		return nil, nil, errors.Trace(err)
@@ -362,6 +401,17 @@ func (w *remoteApplicationWorker) startUnitsWorkers(
 		}
 		return w.remoteModelFacade.RelationUnitSettings(relationUnits)
 	}
+	remoteAppSettingsFunc := func(appNames []string) ([]params.SettingsResult, error) {
+		relationApps := make([]params.RemoteRelationApplication, len(appNames))
+		for i, appName := range appNames {
+			relationApps[i] = params.RemoteRelationApplication{
+				RelationToken: relationToken,
+				Application:   appName,
+				Macaroons:     macaroon.Slice{mac},
+			}
+		}
+		return w.remoteModelFacade.RelationApplicationSettings(relationApps)
+	}
 	remoteUnitsWorker, err := newRelationUnitsWorker(
 		relationTag,
 		remoteAppToken,
@@ -370,6 +420,7 @@ func (w *remoteApplicationWorker) startUnitsWorkers(
 		remoteRelationUnitsWatcher,
 		w.remoteRelationChanges,
 		remoteUnitSettingsFunc,
+		remoteAppSettingsFunc,
 	)
 	if err != nil {
 		return nil, nil, errors.Trace(err)
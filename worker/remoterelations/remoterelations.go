@@ -49,6 +49,10 @@ type RemoteModelRelationsFacade interface {
 	// RelationUnitSettings returns the relation unit settings for the given relation units in the remote model.
 	RelationUnitSettings([]params.RemoteRelationUnit) ([]params.SettingsResult, error)
 
+	// RelationApplicationSettings returns the settings published by the
+	// given applications in relations in the remote model.
+	RelationApplicationSettings([]params.RemoteRelationApplication) ([]params.SettingsResult, error)
+
 	// WatchRelationSuspendedStatus starts a RelationStatusWatcher for watching the
 	// relations of each specified application in the remote model.
 	WatchRelationSuspendedStatus(arg params.RemoteEntityArg) (watcher.RelationStatusWatcher, error)
@@ -78,6 +82,10 @@ type RemoteRelationsFacade interface {
 	// given relation units in the local model.
 	RelationUnitSettings([]params.RelationUnit) ([]params.SettingsResult, error)
 
+	// RelationApplicationSettings returns the settings published by the
+	// given applications in relations in the local model.
+	RelationApplicationSettings([]params.RelationApplication) ([]params.SettingsResult, error)
+
 	// Relations returns information about the relations
 	// with the specified keys in the local model.
 	Relations(keys []string) ([]params.RemoteRelationResult, error)
@@ -109,6 +117,10 @@ type RemoteRelationsFacade interface {
 
 	// SetRemoteApplicationStatus sets the status for the specified remote application.
 	SetRemoteApplicationStatus(applicationName string, status status.Status, message string) error
+
+	// RecordRemoteRelationEvent records an event exchanged with the
+	// remote side of a relation, for use when diagnosing the relation.
+	RecordRemoteRelationEvent(event params.RemoteRelationEventArg) error
 }
 
 type newRemoteRelationsFacadeFunc func(*api.Info) (RemoteModelRelationsFacadeCloser, error)
@@ -264,6 +276,7 @@ func (w *Worker) handleApplicationChanges(applicationIds []string) error {
 				remoteRelationChanges:             make(chan params.RemoteRelationChangeEvent),
 				localModelFacade:                  w.config.RelationsFacade,
 				newRemoteModelRelationsFacadeFunc: w.config.NewRemoteModelFacadeFunc,
+				clock:                             w.config.Clock,
 			}
 			if err := catacomb.Invoke(catacomb.Plan{
 				Site: &appWorker.catacomb,
@@ -407,6 +407,50 @@ func (s *remoteRelationsSuite) TestLocalRelationsChangedNotifies(c *gc.C) {
 				Macaroons:     macaroon.Slice{mac},
 			},
 		}},
+		{"RecordRemoteRelationEvent", []interface{}{
+			params.RemoteRelationEventArg{
+				RelationToken: "token-db2:db django:db",
+				Direction:     params.RemoteRelationEventSent,
+				ChangedUnits:  1,
+				DepartedUnits: 1,
+			},
+		}},
+	}
+	s.waitForWorkerStubCalls(c, expected)
+}
+
+func (s *remoteRelationsSuite) TestLocalRelationsApplicationSettingsChangedNotifies(c *gc.C) {
+	w := s.assertRemoteRelationsWorkers(c)
+	defer workertest.CleanKill(c, w)
+	s.stub.ResetCalls()
+
+	unitsWatcher, _ := s.relationsFacade.relationsUnitsWatcher("db2:db django:db")
+	unitsWatcher.changes <- watcher.RelationUnitsChange{
+		AppChanged: map[string]int64{"django": 3},
+	}
+
+	mac, err := apitesting.NewMacaroon("apimac")
+	c.Assert(err, jc.ErrorIsNil)
+	expected := []jujutesting.StubCall{
+		{"RelationApplicationSettings", []interface{}{
+			[]params.RelationApplication{{
+				Relation:    "relation-db2.db#django.db",
+				Application: "django"}}}},
+		{"PublishRelationChange", []interface{}{
+			params.RemoteRelationChangeEvent{
+				ApplicationToken:    "token-django",
+				RelationToken:       "token-db2:db django:db",
+				DepartedUnits:       []int{},
+				ApplicationSettings: map[string]interface{}{"app": "data"},
+				Macaroons:           macaroon.Slice{mac},
+			},
+		}},
+		{"RecordRemoteRelationEvent", []interface{}{
+			params.RemoteRelationEventArg{
+				RelationToken: "token-db2:db django:db",
+				Direction:     params.RemoteRelationEventSent,
+			},
+		}},
 	}
 	s.waitForWorkerStubCalls(c, expected)
 }
@@ -442,6 +486,14 @@ func (s *remoteRelationsSuite) TestRemoteRelationsChangedConsumes(c *gc.C) {
 				Macaroons:     macaroon.Slice{mac},
 			},
 		}},
+		{"RecordRemoteRelationEvent", []interface{}{
+			params.RemoteRelationEventArg{
+				RelationToken: "token-db2:db django:db",
+				Direction:     params.RemoteRelationEventReceived,
+				ChangedUnits:  1,
+				DepartedUnits: 1,
+			},
+		}},
 	}
 	s.waitForWorkerStubCalls(c, expected)
 }
@@ -466,6 +518,12 @@ func (s *remoteRelationsSuite) TestRemoteRelationsDyingConsumes(c *gc.C) {
 				Suspended:        &suspended,
 			},
 		}},
+		{"RecordRemoteRelationEvent", []interface{}{
+			params.RemoteRelationEventArg{
+				RelationToken: "token-db2:db django:db",
+				Direction:     params.RemoteRelationEventReceived,
+			},
+		}},
 	}
 	s.waitForWorkerStubCalls(c, expected)
 }
@@ -493,6 +551,14 @@ func (s *remoteRelationsSuite) TestRemoteRelationsChangedError(c *gc.C) {
 				Macaroons:        macaroon.Slice{apiMac},
 			},
 		}},
+		{"RecordRemoteRelationEvent", []interface{}{
+			params.RemoteRelationEventArg{
+				RelationToken: "token-db2:db django:db",
+				Direction:     params.RemoteRelationEventSent,
+				DepartedUnits: 1,
+				Error:         "failed",
+			},
+		}},
 		{"Close", nil},
 	}
 
@@ -0,0 +1,41 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package storagemonitor
+
+import (
+	"github.com/juju/errors"
+	"github.com/juju/utils/clock"
+	"gopkg.in/juju/names.v2"
+	worker "gopkg.in/juju/worker.v1"
+
+	"github.com/juju/juju/agent"
+	"github.com/juju/juju/api/base"
+	apistoragemonitor "github.com/juju/juju/api/storagemonitor"
+	"github.com/juju/juju/cmd/jujud/agent/engine"
+	"github.com/juju/juju/worker/dependency"
+)
+
+// ManifoldConfig defines the names of the manifolds on which a Manifold will depend.
+type ManifoldConfig engine.AgentAPIManifoldConfig
+
+// Manifold returns a dependency manifold that runs a storagemonitor worker,
+// using the resource names defined in the supplied config.
+func Manifold(config ManifoldConfig) dependency.Manifold {
+	typedConfig := engine.AgentAPIManifoldConfig(config)
+	return engine.AgentAPIManifold(typedConfig, newWorker)
+}
+
+// newWorker trivially wraps NewWorker for use in a engine.AgentAPIManifold.
+func newWorker(a agent.Agent, apiCaller base.APICaller) (worker.Worker, error) {
+	t := a.CurrentConfig().Tag()
+	tag, ok := t.(names.MachineTag)
+	if !ok {
+		return nil, errors.Errorf("expected MachineTag, got %#v", t)
+	}
+	return NewWorker(Config{
+		Facade:          apistoragemonitor.NewFacade(apiCaller, tag),
+		FilesystemUsage: DefaultFilesystemUsage,
+		Clock:           clock.WallClock,
+	})
+}
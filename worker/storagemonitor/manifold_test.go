@@ -0,0 +1,76 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package storagemonitor_test
+
+import (
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+	"gopkg.in/juju/names.v2"
+	worker "gopkg.in/juju/worker.v1"
+
+	"github.com/juju/juju/agent"
+	basetesting "github.com/juju/juju/api/base/testing"
+	apistoragemonitor "github.com/juju/juju/api/storagemonitor"
+	coretesting "github.com/juju/juju/testing"
+	"github.com/juju/juju/worker/storagemonitor"
+)
+
+type manifoldSuite struct {
+	coretesting.BaseSuite
+}
+
+var _ = gc.Suite(&manifoldSuite{})
+
+func (s *manifoldSuite) TestMachineStorageMonitor(c *gc.C) {
+	called := false
+	apiCaller := basetesting.APICallerFunc(
+		func(objType string,
+			version int,
+			id, request string,
+			a, response interface{},
+		) error {
+			// We don't test the api call. We test that NewWorker is
+			// passed the expected arguments.
+			return nil
+		})
+
+	s.PatchValue(&storagemonitor.NewWorker, func(config storagemonitor.Config) (worker.Worker, error) {
+		called = true
+		c.Assert(config.FilesystemUsage, gc.NotNil)
+		c.Assert(config.Clock, gc.NotNil)
+		api, ok := config.Facade.(*apistoragemonitor.Facade)
+		c.Assert(ok, jc.IsTrue)
+		c.Assert(api, gc.NotNil)
+		return nil, nil
+	})
+
+	a := &dummyAgent{tag: names.NewMachineTag("1")}
+	_, err := storagemonitor.NewWorkerFunc(a, apiCaller)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(called, jc.IsTrue)
+}
+
+func (s *manifoldSuite) TestNonMachineAgent(c *gc.C) {
+	a := &dummyAgent{tag: names.NewUnitTag("mysql/0")}
+	_, err := storagemonitor.NewWorkerFunc(a, nil)
+	c.Assert(err, gc.ErrorMatches, "expected MachineTag, got .*")
+}
+
+type dummyAgent struct {
+	agent.Agent
+	tag names.Tag
+}
+
+func (a dummyAgent) CurrentConfig() agent.Config {
+	return dummyCfg{tag: a.tag}
+}
+
+type dummyCfg struct {
+	agent.Config
+	tag names.Tag
+}
+
+func (c dummyCfg) Tag() names.Tag {
+	return c.tag
+}
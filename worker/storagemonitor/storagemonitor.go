@@ -0,0 +1,179 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package storagemonitor provides a worker that periodically measures
+// the space and inode usage of the filesystems attached to a machine,
+// and of the filesystems mounted on the block devices of volumes
+// attached to the machine, and reports it to the controller.
+package storagemonitor
+
+import (
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/loggo"
+	"github.com/juju/utils/clock"
+	"gopkg.in/juju/worker.v1"
+
+	"github.com/juju/juju/apiserver/params"
+	jworker "github.com/juju/juju/worker"
+)
+
+var logger = loggo.GetLogger("juju.worker.storagemonitor")
+
+// reportUsagePeriod is the time period between storage usage reports.
+const reportUsagePeriod = 5 * time.Minute
+
+// Facade is an interface that is supplied to NewWorker for listing
+// the filesystems and volumes attached to the machine, and recording
+// their usage.
+type Facade interface {
+	FilesystemAttachments() ([]params.FilesystemAttachment, error)
+	SetFilesystemAttachmentUsage([]params.FilesystemAttachmentUsage) ([]params.ErrorResult, error)
+	MountedVolumeAttachments() ([]params.MountedVolumeAttachment, error)
+	SetVolumeAttachmentUsage([]params.VolumeAttachmentUsage) ([]params.ErrorResult, error)
+}
+
+// FilesystemUsageFunc is the type of a function that is supplied to
+// NewWorker for measuring the usage of the filesystem mounted at the
+// specified path on the local host.
+type FilesystemUsageFunc func(path string) (params.FilesystemUsage, error)
+
+// DefaultFilesystemUsage is the default function for measuring
+// filesystem usage on the operating system of the local host.
+var DefaultFilesystemUsage FilesystemUsageFunc = filesystemUsage
+
+// Config holds the dependencies of a storagemonitor worker.
+type Config struct {
+	Facade          Facade
+	FilesystemUsage FilesystemUsageFunc
+	Clock           clock.Clock
+}
+
+// Validate returns an error if the config cannot be used
+// to start a storagemonitor worker.
+func (config Config) Validate() error {
+	if config.Facade == nil {
+		return errors.NotValidf("nil Facade")
+	}
+	if config.FilesystemUsage == nil {
+		return errors.NotValidf("nil FilesystemUsage")
+	}
+	if config.Clock == nil {
+		return errors.NotValidf("nil Clock")
+	}
+	return nil
+}
+
+// NewWorker returns a worker that periodically measures the usage of
+// the filesystems and volumes attached to the machine, and records it
+// in state.
+var NewWorker = func(config Config) (worker.Worker, error) {
+	if err := config.Validate(); err != nil {
+		return nil, errors.Trace(err)
+	}
+	f := func(stop <-chan struct{}) error {
+		return doWork(config)
+	}
+	return jworker.NewPeriodicWorker(f, reportUsagePeriod, jworker.NewTimer), nil
+}
+
+func doWork(config Config) error {
+	if err := reportFilesystemUsage(config); err != nil {
+		return errors.Trace(err)
+	}
+	return errors.Trace(reportVolumeUsage(config))
+}
+
+func reportFilesystemUsage(config Config) error {
+	attachments, err := config.Facade.FilesystemAttachments()
+	if err != nil {
+		return errors.Annotate(err, "getting filesystem attachments")
+	}
+	var usages []params.FilesystemAttachmentUsage
+	for _, attachment := range attachments {
+		if attachment.Info.MountPoint == "" {
+			continue
+		}
+		usage, err := measureUsage(config, attachment.FilesystemTag, attachment.Info.MountPoint)
+		if errors.IsNotSupported(err) {
+			logger.Debugf("cannot measure filesystem usage: %v", err)
+			return nil
+		} else if usage == nil {
+			continue
+		}
+		usages = append(usages, params.FilesystemAttachmentUsage{
+			FilesystemTag: attachment.FilesystemTag,
+			MachineTag:    attachment.MachineTag,
+			Usage:         *usage,
+		})
+	}
+	if len(usages) == 0 {
+		return nil
+	}
+	results, err := config.Facade.SetFilesystemAttachmentUsage(usages)
+	if err != nil {
+		return errors.Annotate(err, "setting filesystem usage")
+	}
+	for i, result := range results {
+		if result.Error != nil {
+			logger.Warningf("setting usage of %s: %v", usages[i].FilesystemTag, result.Error)
+		}
+	}
+	return nil
+}
+
+func reportVolumeUsage(config Config) error {
+	attachments, err := config.Facade.MountedVolumeAttachments()
+	if err != nil {
+		return errors.Annotate(err, "getting mounted volume attachments")
+	}
+	var usages []params.VolumeAttachmentUsage
+	for _, attachment := range attachments {
+		usage, err := measureUsage(config, attachment.VolumeTag, attachment.MountPoint)
+		if errors.IsNotSupported(err) {
+			logger.Debugf("cannot measure volume usage: %v", err)
+			return nil
+		} else if usage == nil {
+			continue
+		}
+		usages = append(usages, params.VolumeAttachmentUsage{
+			VolumeTag:  attachment.VolumeTag,
+			MachineTag: attachment.MachineTag,
+			Usage:      *usage,
+		})
+	}
+	if len(usages) == 0 {
+		return nil
+	}
+	results, err := config.Facade.SetVolumeAttachmentUsage(usages)
+	if err != nil {
+		return errors.Annotate(err, "setting volume usage")
+	}
+	for i, result := range results {
+		if result.Error != nil {
+			logger.Warningf("setting usage of %s: %v", usages[i].VolumeTag, result.Error)
+		}
+	}
+	return nil
+}
+
+// measureUsage measures the usage of the filesystem mounted at the
+// specified path. If the usage cannot be measured on this operating
+// system, measureUsage returns a NotSupported error; if it cannot be
+// measured for any other reason, the error is logged and measureUsage
+// returns nil.
+func measureUsage(config Config, tag, path string) (*params.FilesystemUsage, error) {
+	usage, err := config.FilesystemUsage(path)
+	if errors.IsNotSupported(err) {
+		return nil, err
+	} else if err != nil {
+		// The filesystem may have been unmounted since we
+		// listed the attachments; it will no longer be
+		// listed on the next pass.
+		logger.Warningf("measuring usage of %s at %q: %v", tag, path, err)
+		return nil, nil
+	}
+	usage.Updated = config.Clock.Now()
+	return &usage, nil
+}
@@ -0,0 +1,172 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package storagemonitor_test
+
+import (
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/apiserver/params"
+	coretesting "github.com/juju/juju/testing"
+	"github.com/juju/juju/worker/storagemonitor"
+)
+
+var _ = gc.Suite(&StorageMonitorWorkerSuite{})
+
+type StorageMonitorWorkerSuite struct {
+	coretesting.BaseSuite
+	facade *mockFacade
+	clock  *testing.Clock
+	config storagemonitor.Config
+}
+
+func (s *StorageMonitorWorkerSuite) SetUpTest(c *gc.C) {
+	s.BaseSuite.SetUpTest(c)
+	s.facade = &mockFacade{
+		attachments: []params.FilesystemAttachment{{
+			FilesystemTag: "filesystem-0",
+			MachineTag:    "machine-0",
+			Info:          params.FilesystemAttachmentInfo{MountPoint: "/srv/0"},
+		}, {
+			FilesystemTag: "filesystem-1",
+			MachineTag:    "machine-0",
+		}},
+		volumeAttachments: []params.MountedVolumeAttachment{{
+			VolumeTag:  "volume-0",
+			MachineTag: "machine-0",
+			MountPoint: "/mnt/0",
+		}},
+	}
+	s.clock = testing.NewClock(time.Date(2018, 4, 1, 0, 0, 0, 0, time.UTC))
+	s.config = storagemonitor.Config{
+		Facade: s.facade,
+		FilesystemUsage: func(path string) (params.FilesystemUsage, error) {
+			return params.FilesystemUsage{Size: 100, Used: 25, Available: 75}, nil
+		},
+		Clock: s.clock,
+	}
+}
+
+func (s *StorageMonitorWorkerSuite) TestValidate(c *gc.C) {
+	s.config.Facade = nil
+	_, err := storagemonitor.NewWorker(s.config)
+	c.Assert(err, gc.ErrorMatches, "nil Facade not valid")
+}
+
+func (s *StorageMonitorWorkerSuite) TestWorker(c *gc.C) {
+	done := make(chan struct{})
+	s.facade.setUsage = func([]params.FilesystemAttachmentUsage) {
+		close(done)
+	}
+	w, err := storagemonitor.NewWorker(s.config)
+	c.Assert(err, jc.ErrorIsNil)
+	defer w.Wait()
+	defer w.Kill()
+
+	select {
+	case <-done:
+	case <-time.After(coretesting.LongWait):
+		c.Fatalf("timed out waiting for storagemonitor to report usage")
+	}
+}
+
+func (s *StorageMonitorWorkerSuite) TestDoWork(c *gc.C) {
+	var paths []string
+	s.config.FilesystemUsage = func(path string) (params.FilesystemUsage, error) {
+		paths = append(paths, path)
+		return params.FilesystemUsage{Size: 100, Used: 25, Available: 75}, nil
+	}
+	err := storagemonitor.DoWork(s.config)
+	c.Assert(err, jc.ErrorIsNil)
+
+	// Attachments without a mount point are not measured.
+	c.Assert(paths, jc.DeepEquals, []string{"/srv/0", "/mnt/0"})
+	c.Assert(s.facade.usages, jc.DeepEquals, [][]params.FilesystemAttachmentUsage{{{
+		FilesystemTag: "filesystem-0",
+		MachineTag:    "machine-0",
+		Usage: params.FilesystemUsage{
+			Size:      100,
+			Used:      25,
+			Available: 75,
+			Updated:   s.clock.Now(),
+		},
+	}}})
+	c.Assert(s.facade.volumeUsages, jc.DeepEquals, [][]params.VolumeAttachmentUsage{{{
+		VolumeTag:  "volume-0",
+		MachineTag: "machine-0",
+		Usage: params.FilesystemUsage{
+			Size:      100,
+			Used:      25,
+			Available: 75,
+			Updated:   s.clock.Now(),
+		},
+	}}})
+}
+
+func (s *StorageMonitorWorkerSuite) TestDoWorkUsageError(c *gc.C) {
+	s.config.FilesystemUsage = func(path string) (params.FilesystemUsage, error) {
+		return params.FilesystemUsage{}, errors.New("boom")
+	}
+	err := storagemonitor.DoWork(s.config)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(s.facade.usages, gc.HasLen, 0)
+	c.Assert(s.facade.volumeUsages, gc.HasLen, 0)
+}
+
+func (s *StorageMonitorWorkerSuite) TestDoWorkNotSupported(c *gc.C) {
+	s.config.FilesystemUsage = func(path string) (params.FilesystemUsage, error) {
+		return params.FilesystemUsage{}, errors.NotSupportedf("filesystem usage")
+	}
+	err := storagemonitor.DoWork(s.config)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(s.facade.usages, gc.HasLen, 0)
+}
+
+func (s *StorageMonitorWorkerSuite) TestDoWorkAttachmentsError(c *gc.C) {
+	s.facade.err = errors.New("boom")
+	err := storagemonitor.DoWork(s.config)
+	c.Assert(err, gc.ErrorMatches, "getting filesystem attachments: boom")
+}
+
+func (s *StorageMonitorWorkerSuite) TestDoWorkVolumeAttachmentsError(c *gc.C) {
+	s.facade.volumeErr = errors.New("boom")
+	err := storagemonitor.DoWork(s.config)
+	c.Assert(err, gc.ErrorMatches, "getting mounted volume attachments: boom")
+	c.Assert(s.facade.usages, gc.HasLen, 1)
+}
+
+type mockFacade struct {
+	attachments       []params.FilesystemAttachment
+	volumeAttachments []params.MountedVolumeAttachment
+	usages            [][]params.FilesystemAttachmentUsage
+	volumeUsages      [][]params.VolumeAttachmentUsage
+	setUsage          func([]params.FilesystemAttachmentUsage)
+	err               error
+	volumeErr         error
+}
+
+func (f *mockFacade) FilesystemAttachments() ([]params.FilesystemAttachment, error) {
+	return f.attachments, f.err
+}
+
+func (f *mockFacade) SetFilesystemAttachmentUsage(usages []params.FilesystemAttachmentUsage) ([]params.ErrorResult, error) {
+	f.usages = append(f.usages, usages)
+	if f.setUsage != nil {
+		f.setUsage(usages)
+	}
+	return make([]params.ErrorResult, len(usages)), nil
+}
+
+func (f *mockFacade) MountedVolumeAttachments() ([]params.MountedVolumeAttachment, error) {
+	return f.volumeAttachments, f.volumeErr
+}
+
+func (f *mockFacade) SetVolumeAttachmentUsage(usages []params.VolumeAttachmentUsage) ([]params.ErrorResult, error) {
+	f.volumeUsages = append(f.volumeUsages, usages)
+	return make([]params.ErrorResult, len(usages)), nil
+}
@@ -0,0 +1,72 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package storagemonitor
+
+import (
+	"os"
+	"path/filepath"
+	"syscall"
+
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/apiserver/params"
+)
+
+func filesystemUsage(path string) (params.FilesystemUsage, error) {
+	mounted, err := isMountPoint(path)
+	if err != nil {
+		return params.FilesystemUsage{}, errors.Trace(err)
+	}
+	if !mounted {
+		// Measuring an unmounted directory would report the
+		// usage of the filesystem containing it.
+		return params.FilesystemUsage{}, errors.Errorf("%q is not mounted", path)
+	}
+	var st syscall.Statfs_t
+	if err := syscall.Statfs(path, &st); err != nil {
+		return params.FilesystemUsage{}, errors.Annotatef(err, "statfs %q", path)
+	}
+	blockSize := uint64(st.Frsize)
+	if blockSize == 0 {
+		blockSize = uint64(st.Bsize)
+	}
+	return params.FilesystemUsage{
+		Size:       st.Blocks * blockSize,
+		Used:       (st.Blocks - st.Bfree) * blockSize,
+		Available:  st.Bavail * blockSize,
+		Inodes:     st.Files,
+		InodesUsed: st.Files - st.Ffree,
+		InodesFree: st.Ffree,
+	}, nil
+}
+
+// isMountPoint reports whether or not the specified path is the root
+// of a mounted filesystem, by comparing its device with its parent's.
+func isMountPoint(path string) (bool, error) {
+	parent := filepath.Dir(path)
+	if parent == path {
+		return true, nil
+	}
+	pathDevice, err := device(path)
+	if err != nil {
+		return false, errors.Trace(err)
+	}
+	parentDevice, err := device(parent)
+	if err != nil {
+		return false, errors.Trace(err)
+	}
+	return pathDevice != parentDevice, nil
+}
+
+func device(path string) (uint64, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return 0, errors.Trace(err)
+	}
+	st, ok := info.Sys().(*syscall.Stat_t)
+	if !ok {
+		return 0, errors.Errorf("cannot determine device of %q", path)
+	}
+	return uint64(st.Dev), nil
+}
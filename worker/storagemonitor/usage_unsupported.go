@@ -0,0 +1,18 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// +build !linux
+
+package storagemonitor
+
+import (
+	"runtime"
+
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/apiserver/params"
+)
+
+func filesystemUsage(path string) (params.FilesystemUsage, error) {
+	return params.FilesystemUsage{}, errors.NotSupportedf("filesystem usage on %s", runtime.GOOS)
+}
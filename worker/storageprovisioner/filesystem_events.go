@@ -158,9 +158,14 @@ func updatePendingFilesystemAttachment(
 	params storage.FilesystemAttachmentParams,
 ) {
 	var incomplete bool
+	shared := isSharedFilesystemAttachment(ctx, params.Filesystem)
 	filesystem, ok := ctx.filesystems[params.Filesystem]
 	if !ok {
-		incomplete = true
+		// Shared filesystems are provisioned by the model-scoped
+		// storage provisioner, so the machine-scoped storage
+		// provisioner will not observe them. The filesystem is
+		// fetched when the attachment operation is executed.
+		incomplete = !shared
 	} else {
 		params.FilesystemId = filesystem.FilesystemId
 		if filesystem.Volume != (names.VolumeTag{}) {
@@ -177,7 +182,7 @@ func updatePendingFilesystemAttachment(
 		watchMachine(ctx, params.Machine)
 		incomplete = true
 	}
-	if params.FilesystemId == "" {
+	if params.FilesystemId == "" && !shared {
 		incomplete = true
 	}
 	if incomplete {
@@ -188,6 +193,20 @@ func updatePendingFilesystemAttachment(
 	scheduleOperations(ctx, &attachFilesystemOp{args: params})
 }
 
+// isSharedFilesystemAttachment reports whether or not the specified
+// filesystem, attached to a machine managed by a machine-scoped storage
+// provisioner, is a model-scoped filesystem shared between machines.
+// Such filesystems have no backing volume, and are attached by the
+// machine-scoped storage provisioner so that they may be mounted on
+// each machine. The controller decides which filesystems are shared,
+// and reports it in the filesystem attachment parameters.
+func isSharedFilesystemAttachment(ctx *context, tag names.FilesystemTag) bool {
+	if _, ok := ctx.config.Scope.(names.MachineTag); !ok {
+		return false
+	}
+	return ctx.sharedFilesystems.Contains(tag)
+}
+
 // removePendingFilesystemAttachment removes the specified pending filesystem
 // attachment from the incomplete set and/or the schedule if it exists
 // there.
@@ -395,6 +414,9 @@ func filesystemAttachmentParams(
 		if err != nil {
 			return nil, errors.Annotate(err, "getting filesystem attachment parameters")
 		}
+		if result.Result.Shared {
+			ctx.sharedFilesystems.Add(params.Filesystem)
+		}
 		attachmentParams[i] = params
 	}
 	return attachmentParams, nil
@@ -116,6 +116,9 @@ func createFilesystems(ctx *context, ops map[names.FilesystemTag]*createFilesyst
 
 // attachFilesystems creates filesystem attachments with the specified parameters.
 func attachFilesystems(ctx *context, ops map[params.MachineStorageId]*attachFilesystemOp) error {
+	if err := refreshSharedFilesystems(ctx, ops); err != nil {
+		return errors.Trace(err)
+	}
 	filesystemAttachmentParams := make([]storage.FilesystemAttachmentParams, 0, len(ops))
 	for _, op := range ops {
 		args := op.args
@@ -184,6 +187,58 @@ func attachFilesystems(ctx *context, ops map[params.MachineStorageId]*attachFile
 	return nil
 }
 
+// refreshSharedFilesystems fetches information about shared filesystems
+// that have not been observed by the storage provisioner, and updates the
+// attachment operations with the filesystem IDs. Operations for shared
+// filesystems that have not yet been provisioned are removed from ops
+// and rescheduled.
+func refreshSharedFilesystems(ctx *context, ops map[params.MachineStorageId]*attachFilesystemOp) error {
+	var ids []params.MachineStorageId
+	var tags []names.FilesystemTag
+	for id, op := range ops {
+		if op.args.FilesystemId != "" {
+			continue
+		}
+		if !isSharedFilesystemAttachment(ctx, op.args.Filesystem) {
+			continue
+		}
+		ids = append(ids, id)
+		tags = append(tags, op.args.Filesystem)
+	}
+	if len(tags) == 0 {
+		return nil
+	}
+	results, err := ctx.config.Filesystems.Filesystems(tags)
+	if err != nil {
+		return errors.Annotate(err, "getting filesystem information")
+	}
+	var reschedule []scheduleOp
+	for i, result := range results {
+		op := ops[ids[i]]
+		if result.Error != nil {
+			if !params.IsCodeNotProvisioned(result.Error) {
+				return errors.Annotatef(
+					result.Error, "getting information for filesystem %s", tags[i].Id(),
+				)
+			}
+			// The filesystem has not yet been provisioned by the
+			// model-scoped storage provisioner; try again later.
+			logger.Debugf("filesystem %s is not yet provisioned, will retry", tags[i].Id())
+			delete(ops, ids[i])
+			reschedule = append(reschedule, op)
+			continue
+		}
+		filesystem, err := filesystemFromParams(result.Result)
+		if err != nil {
+			return errors.Annotate(err, "getting filesystem info")
+		}
+		ctx.filesystems[filesystem.Tag] = filesystem
+		op.args.FilesystemId = filesystem.FilesystemId
+	}
+	scheduleOperations(ctx, reschedule...)
+	return nil
+}
+
 // removeFilesystems destroys or releases filesystems with the specified parameters.
 func removeFilesystems(ctx *context, ops map[names.FilesystemTag]*removeFilesystemOp) error {
 	tags := make([]names.FilesystemTag, 0, len(ops))
@@ -352,6 +407,9 @@ func detachFilesystems(ctx *context, ops map[params.MachineStorageId]*detachFile
 	}
 	for _, id := range remove {
 		delete(ctx.filesystemAttachments, id)
+		if tag, err := names.ParseFilesystemTag(id.AttachmentTag); err == nil {
+			ctx.sharedFilesystems.Remove(tag)
+		}
 	}
 	return nil
 }
@@ -15,8 +15,10 @@ import (
 	"github.com/juju/juju/api/base"
 	"github.com/juju/juju/api/storageprovisioner"
 	"github.com/juju/juju/cmd/jujud/agent/engine"
+	"github.com/juju/juju/storage"
 	"github.com/juju/juju/storage/provider"
 	"github.com/juju/juju/worker/dependency"
+	"github.com/juju/juju/worker/diskmanager"
 )
 
 // MachineManifoldConfig defines a storage provisioner's configuration and dependencies.
@@ -43,13 +45,23 @@ func (config MachineManifoldConfig) newWorker(a agent.Agent, apiCaller base.APIC
 	}
 
 	storageDir := filepath.Join(cfg.DataDir(), "storage")
+	// The LVM provider creates volume groups out of the
+	// unused disks discovered by the disk manager.
+	registry := storage.ChainedProviderRegistry{
+		storage.StaticProviderRegistry{map[storage.ProviderType]storage.Provider{
+			provider.LVMProviderType: provider.NewLVMProvider(
+				provider.ListBlockDevicesFunc(diskmanager.DefaultListBlockDevices),
+			),
+		}},
+		provider.CommonStorageProviders(),
+	}
 	w, err := NewStorageProvisioner(Config{
 		Scope:       tag,
 		StorageDir:  storageDir,
 		Volumes:     api,
 		Filesystems: api,
 		Life:        api,
-		Registry:    provider.CommonStorageProviders(),
+		Registry:    registry,
 		Machines:    api,
 		Status:      api,
 		Clock:       config.Clock,
@@ -96,15 +96,22 @@ func (w *mockAttachmentsWatcher) Changes() watcher.MachineStorageIdsChannel {
 
 type mockVolumeAccessor struct {
 	volumesWatcher         *mockStringsWatcher
+	resizesWatcher         *mockStringsWatcher
+	snapshotsWatcher       *mockStringsWatcher
 	attachmentsWatcher     *mockAttachmentsWatcher
 	blockDevicesWatcher    *mockNotifyWatcher
 	provisionedMachines    map[string]instance.Id
 	provisionedVolumes     map[string]params.Volume
 	provisionedAttachments map[params.MachineStorageId]params.VolumeAttachment
 	blockDevices           map[params.MachineStorageId]storage.BlockDevice
+	pendingResizes         map[string]uint64
+	pendingSnapshots       map[string]params.VolumeSnapshotParams
 
-	setVolumeInfo           func([]params.Volume) ([]params.ErrorResult, error)
-	setVolumeAttachmentInfo func([]params.VolumeAttachment) ([]params.ErrorResult, error)
+	setVolumeInfo              func([]params.Volume) ([]params.ErrorResult, error)
+	setVolumeAttachmentInfo    func([]params.VolumeAttachment) ([]params.ErrorResult, error)
+	setVolumeSnapshotInfo      func([]params.VolumeSnapshot) ([]params.ErrorResult, error)
+	setVolumeSnapshotMessages  func([]params.VolumeSnapshotMessage) ([]params.ErrorResult, error)
+	setVolumeSnapshotsRestored func([]string) ([]params.ErrorResult, error)
 }
 
 func (m *mockVolumeAccessor) provisionVolume(tag names.VolumeTag) params.Volume {
@@ -122,6 +129,14 @@ func (w *mockVolumeAccessor) WatchVolumes() (watcher.StringsWatcher, error) {
 	return w.volumesWatcher, nil
 }
 
+func (w *mockVolumeAccessor) WatchVolumeResizes() (watcher.StringsWatcher, error) {
+	return w.resizesWatcher, nil
+}
+
+func (w *mockVolumeAccessor) WatchVolumeSnapshots() (watcher.StringsWatcher, error) {
+	return w.snapshotsWatcher, nil
+}
+
 func (w *mockVolumeAccessor) WatchVolumeAttachments() (watcher.MachineStorageIdsWatcher, error) {
 	return w.attachmentsWatcher, nil
 }
@@ -220,6 +235,44 @@ func (v *mockVolumeAccessor) RemoveVolumeParams(volumes []names.VolumeTag) ([]pa
 	return result, nil
 }
 
+func (v *mockVolumeAccessor) VolumeResizeParams(volumes []names.VolumeTag) ([]params.VolumeResizeParamsResult, error) {
+	var result []params.VolumeResizeParamsResult
+	for _, tag := range volumes {
+		size, ok := v.pendingResizes[tag.String()]
+		if !ok {
+			result = append(result, params.VolumeResizeParamsResult{
+				Error: common.ServerError(errors.NotFoundf("pending resize for %s", names.ReadableString(tag))),
+			})
+			continue
+		}
+		result = append(result, params.VolumeResizeParamsResult{Result: params.VolumeResizeParams{
+			VolumeTag: tag.String(),
+			VolumeId:  v.provisionedVolumes[tag.String()].Info.VolumeId,
+			Provider:  "dummy",
+			Attributes: map[string]interface{}{
+				"persistent": tag.String() == "volume-1",
+			},
+			Size: size,
+		}})
+	}
+	return result, nil
+}
+
+func (v *mockVolumeAccessor) VolumeSnapshotParams(ids []string) ([]params.VolumeSnapshotParamsResult, error) {
+	var result []params.VolumeSnapshotParamsResult
+	for _, id := range ids {
+		snapshotParams, ok := v.pendingSnapshots[id]
+		if !ok {
+			result = append(result, params.VolumeSnapshotParamsResult{
+				Error: common.ServerError(errors.NotFoundf("pending operation for volume snapshot %q", id)),
+			})
+			continue
+		}
+		result = append(result, params.VolumeSnapshotParamsResult{Result: snapshotParams})
+	}
+	return result, nil
+}
+
 func (v *mockVolumeAccessor) VolumeAttachmentParams(ids []params.MachineStorageId) ([]params.VolumeAttachmentParamsResult, error) {
 	var result []params.VolumeAttachmentParamsResult
 	for _, id := range ids {
@@ -251,15 +304,40 @@ func (v *mockVolumeAccessor) SetVolumeAttachmentInfo(volumeAttachments []params.
 	return make([]params.ErrorResult, len(volumeAttachments)), nil
 }
 
+func (v *mockVolumeAccessor) SetVolumeSnapshotInfo(snapshots []params.VolumeSnapshot) ([]params.ErrorResult, error) {
+	if v.setVolumeSnapshotInfo != nil {
+		return v.setVolumeSnapshotInfo(snapshots)
+	}
+	return make([]params.ErrorResult, len(snapshots)), nil
+}
+
+func (v *mockVolumeAccessor) SetVolumeSnapshotMessages(messages []params.VolumeSnapshotMessage) ([]params.ErrorResult, error) {
+	if v.setVolumeSnapshotMessages != nil {
+		return v.setVolumeSnapshotMessages(messages)
+	}
+	return make([]params.ErrorResult, len(messages)), nil
+}
+
+func (v *mockVolumeAccessor) SetVolumeSnapshotsRestored(ids []string) ([]params.ErrorResult, error) {
+	if v.setVolumeSnapshotsRestored != nil {
+		return v.setVolumeSnapshotsRestored(ids)
+	}
+	return make([]params.ErrorResult, len(ids)), nil
+}
+
 func newMockVolumeAccessor() *mockVolumeAccessor {
 	return &mockVolumeAccessor{
 		volumesWatcher:         newMockStringsWatcher(),
+		resizesWatcher:         newMockStringsWatcher(),
+		snapshotsWatcher:       newMockStringsWatcher(),
 		attachmentsWatcher:     newMockAttachmentsWatcher(),
 		blockDevicesWatcher:    newMockNotifyWatcher(),
 		provisionedMachines:    make(map[string]instance.Id),
 		provisionedVolumes:     make(map[string]params.Volume),
 		provisionedAttachments: make(map[params.MachineStorageId]params.VolumeAttachment),
 		blockDevices:           make(map[params.MachineStorageId]storage.BlockDevice),
+		pendingResizes:         make(map[string]uint64),
+		pendingSnapshots:       make(map[string]params.VolumeSnapshotParams),
 	}
 }
 
@@ -269,6 +347,7 @@ type mockFilesystemAccessor struct {
 	provisionedMachines    map[string]instance.Id
 	provisionedFilesystems map[string]params.Filesystem
 	provisionedAttachments map[params.MachineStorageId]params.FilesystemAttachment
+	sharedFilesystems      map[string]bool
 
 	setFilesystemInfo           func([]params.Filesystem) ([]params.ErrorResult, error)
 	setFilesystemAttachmentInfo func([]params.FilesystemAttachment) ([]params.ErrorResult, error)
@@ -374,6 +453,7 @@ func (f *mockFilesystemAccessor) FilesystemAttachmentParams(ids []params.Machine
 			InstanceId:    string(instanceId),
 			Provider:      "dummy",
 			ReadOnly:      true,
+			Shared:        f.sharedFilesystems[id.AttachmentTag],
 		}})
 	}
 	return result, nil
@@ -400,6 +480,7 @@ func newMockFilesystemAccessor() *mockFilesystemAccessor {
 		provisionedMachines:    make(map[string]instance.Id),
 		provisionedFilesystems: make(map[string]params.Filesystem),
 		provisionedAttachments: make(map[params.MachineStorageId]params.FilesystemAttachment),
+		sharedFilesystems:      make(map[string]bool),
 	}
 }
 
@@ -472,6 +553,9 @@ type dummyProvider struct {
 	attachVolumesFunc            func([]storage.VolumeAttachmentParams) ([]storage.AttachVolumesResult, error)
 	attachFilesystemsFunc        func([]storage.FilesystemAttachmentParams) ([]storage.AttachFilesystemsResult, error)
 	detachVolumesFunc            func([]storage.VolumeAttachmentParams) ([]error, error)
+	resizeVolumesFunc            func([]storage.VolumeResizeParams) ([]storage.ResizeVolumesResult, error)
+	createVolumeSnapshotsFunc    func([]storage.VolumeSnapshotParams) ([]storage.CreateVolumeSnapshotsResult, error)
+	restoreVolumeSnapshotsFunc   func([]storage.VolumeSnapshotParams) ([]error, error)
 	detachFilesystemsFunc        func([]storage.FilesystemAttachmentParams) ([]error, error)
 	destroyVolumesFunc           func([]string) ([]error, error)
 	releaseVolumesFunc           func([]string) ([]error, error)
@@ -594,6 +678,50 @@ func (s *dummyVolumeSource) DetachVolumes(params []storage.VolumeAttachmentParam
 	return make([]error, len(params)), nil
 }
 
+// ResizeVolumes grows volumes to the requested sizes.
+func (s *dummyVolumeSource) ResizeVolumes(params []storage.VolumeResizeParams) ([]storage.ResizeVolumesResult, error) {
+	if s.provider.resizeVolumesFunc != nil {
+		return s.provider.resizeVolumesFunc(params)
+	}
+	results := make([]storage.ResizeVolumesResult, len(params))
+	for i, p := range params {
+		results[i].Volume = &storage.Volume{
+			p.Tag,
+			storage.VolumeInfo{
+				VolumeId: p.VolumeId,
+				Size:     p.Size,
+			},
+		}
+	}
+	return results, nil
+}
+
+// CreateVolumeSnapshots takes snapshots of volumes.
+func (s *dummyVolumeSource) CreateVolumeSnapshots(params []storage.VolumeSnapshotParams) ([]storage.CreateVolumeSnapshotsResult, error) {
+	if s.provider.createVolumeSnapshotsFunc != nil {
+		return s.provider.createVolumeSnapshotsFunc(params)
+	}
+	results := make([]storage.CreateVolumeSnapshotsResult, len(params))
+	for i, p := range params {
+		results[i].VolumeSnapshot = &storage.VolumeSnapshot{
+			Id:     p.Id,
+			Volume: p.Volume,
+			VolumeSnapshotInfo: storage.VolumeSnapshotInfo{
+				SnapshotId: "snap-" + p.Id,
+			},
+		}
+	}
+	return results, nil
+}
+
+// RestoreVolumeSnapshots restores volumes from snapshots.
+func (s *dummyVolumeSource) RestoreVolumeSnapshots(params []storage.VolumeSnapshotParams) ([]error, error) {
+	if s.provider.restoreVolumeSnapshotsFunc != nil {
+		return s.provider.restoreVolumeSnapshotsFunc(params)
+	}
+	return make([]error, len(params)), nil
+}
+
 func (s *dummyFilesystemSource) ValidateFilesystemParams(params storage.FilesystemParams) error {
 	if s.provider != nil && s.provider.validateFilesystemParamsFunc != nil {
 		return s.provider.validateFilesystemParamsFunc(params)
@@ -59,6 +59,15 @@ type VolumeAccessor interface {
 	// that this storage provisioner is responsible for.
 	WatchVolumeAttachments() (watcher.MachineStorageIdsWatcher, error)
 
+	// WatchVolumeResizes watches for requests to resize volumes that
+	// this storage provisioner is responsible for.
+	WatchVolumeResizes() (watcher.StringsWatcher, error)
+
+	// WatchVolumeSnapshots watches for requests to take or restore
+	// snapshots of volumes that this storage provisioner is
+	// responsible for.
+	WatchVolumeSnapshots() (watcher.StringsWatcher, error)
+
 	// Volumes returns details of volumes with the specified tags.
 	Volumes([]names.VolumeTag) ([]params.VolumeResult, error)
 
@@ -78,6 +87,14 @@ type VolumeAccessor interface {
 	// releasing the volumes with the specified tags.
 	RemoveVolumeParams([]names.VolumeTag) ([]params.RemoveVolumeParamsResult, error)
 
+	// VolumeResizeParams returns the parameters for resizing the
+	// volumes with the specified tags.
+	VolumeResizeParams([]names.VolumeTag) ([]params.VolumeResizeParamsResult, error)
+
+	// VolumeSnapshotParams returns the parameters for taking or
+	// restoring the volume snapshots with the specified IDs.
+	VolumeSnapshotParams([]string) ([]params.VolumeSnapshotParamsResult, error)
+
 	// VolumeAttachmentParams returns the parameters for creating the
 	// volume attachments with the specified tags.
 	VolumeAttachmentParams([]params.MachineStorageId) ([]params.VolumeAttachmentParamsResult, error)
@@ -88,6 +105,18 @@ type VolumeAccessor interface {
 	// SetVolumeAttachmentInfo records the details of newly provisioned
 	// volume attachments.
 	SetVolumeAttachmentInfo([]params.VolumeAttachment) ([]params.ErrorResult, error)
+
+	// SetVolumeSnapshotInfo records the details of newly
+	// taken volume snapshots.
+	SetVolumeSnapshotInfo([]params.VolumeSnapshot) ([]params.ErrorResult, error)
+
+	// SetVolumeSnapshotMessages records messages against volume
+	// snapshots that could not be taken or restored.
+	SetVolumeSnapshotMessages([]params.VolumeSnapshotMessage) ([]params.ErrorResult, error)
+
+	// SetVolumeSnapshotsRestored records that volumes have been
+	// restored from the volume snapshots with the specified IDs.
+	SetVolumeSnapshotsRestored([]string) ([]params.ErrorResult, error)
 }
 
 // FilesystemAccessor defines an interface used to allow a storage provisioner
@@ -205,6 +234,8 @@ func (w *storageProvisioner) Wait() error {
 func (w *storageProvisioner) loop() error {
 	var (
 		volumesChanges               watcher.StringsChannel
+		volumeResizesChanges         watcher.StringsChannel
+		volumeSnapshotsChanges       watcher.StringsChannel
 		filesystemsChanges           watcher.StringsChannel
 		volumeAttachmentsChanges     watcher.MachineStorageIdsChannel
 		filesystemAttachmentsChanges watcher.MachineStorageIdsChannel
@@ -234,6 +265,24 @@ func (w *storageProvisioner) loop() error {
 	}
 	volumesChanges = volumesWatcher.Changes()
 
+	volumeResizesWatcher, err := w.config.Volumes.WatchVolumeResizes()
+	if err != nil {
+		return errors.Annotate(err, "watching volume resizes")
+	}
+	if err := w.catacomb.Add(volumeResizesWatcher); err != nil {
+		return errors.Trace(err)
+	}
+	volumeResizesChanges = volumeResizesWatcher.Changes()
+
+	volumeSnapshotsWatcher, err := w.config.Volumes.WatchVolumeSnapshots()
+	if err != nil {
+		return errors.Annotate(err, "watching volume snapshots")
+	}
+	if err := w.catacomb.Add(volumeSnapshotsWatcher); err != nil {
+		return errors.Trace(err)
+	}
+	volumeSnapshotsChanges = volumeSnapshotsWatcher.Changes()
+
 	filesystemsWatcher, err := w.config.Filesystems.WatchFilesystems()
 	if err != nil {
 		return errors.Annotate(err, "watching filesystems")
@@ -278,6 +327,7 @@ func (w *storageProvisioner) loop() error {
 		incompleteFilesystemParams:           make(map[names.FilesystemTag]storage.FilesystemParams),
 		incompleteFilesystemAttachmentParams: make(map[params.MachineStorageId]storage.FilesystemAttachmentParams),
 		pendingVolumeBlockDevices:            names.NewSet(),
+		sharedFilesystems:                    names.NewSet(),
 	}
 	ctx.managedFilesystemSource = newManagedFilesystemSource(
 		ctx.volumeBlockDevices, ctx.filesystems,
@@ -299,6 +349,20 @@ func (w *storageProvisioner) loop() error {
 			if err := volumesChanged(&ctx, changes); err != nil {
 				return errors.Trace(err)
 			}
+		case changes, ok := <-volumeResizesChanges:
+			if !ok {
+				return errors.New("volume resizes watcher closed")
+			}
+			if err := volumeResizesChanged(&ctx, changes); err != nil {
+				return errors.Trace(err)
+			}
+		case changes, ok := <-volumeSnapshotsChanges:
+			if !ok {
+				return errors.New("volume snapshots watcher closed")
+			}
+			if err := volumeSnapshotsChanged(&ctx, changes); err != nil {
+				return errors.Trace(err)
+			}
 		case changes, ok := <-volumeAttachmentsChanges:
 			if !ok {
 				return errors.New("volume attachments watcher closed")
@@ -345,6 +409,8 @@ func processSchedule(ctx *context) error {
 	ready := ctx.schedule.Ready(ctx.config.Clock.Now())
 	createVolumeOps := make(map[names.VolumeTag]*createVolumeOp)
 	removeVolumeOps := make(map[names.VolumeTag]*removeVolumeOp)
+	resizeVolumeOps := make(map[names.VolumeTag]*resizeVolumeOp)
+	snapshotVolumeOps := make(map[string]*snapshotVolumeOp)
 	attachVolumeOps := make(map[params.MachineStorageId]*attachVolumeOp)
 	detachVolumeOps := make(map[params.MachineStorageId]*detachVolumeOp)
 	createFilesystemOps := make(map[names.FilesystemTag]*createFilesystemOp)
@@ -359,6 +425,10 @@ func processSchedule(ctx *context) error {
 			createVolumeOps[key.(names.VolumeTag)] = op
 		case *removeVolumeOp:
 			removeVolumeOps[key.(names.VolumeTag)] = op
+		case *resizeVolumeOp:
+			resizeVolumeOps[op.args.Tag] = op
+		case *snapshotVolumeOp:
+			snapshotVolumeOps[op.args.Id] = op
 		case *attachVolumeOp:
 			attachVolumeOps[key.(params.MachineStorageId)] = op
 		case *detachVolumeOp:
@@ -383,6 +453,16 @@ func processSchedule(ctx *context) error {
 			return errors.Annotate(err, "creating volumes")
 		}
 	}
+	if len(resizeVolumeOps) > 0 {
+		if err := resizeVolumes(ctx, resizeVolumeOps); err != nil {
+			return errors.Annotate(err, "resizing volumes")
+		}
+	}
+	if len(snapshotVolumeOps) > 0 {
+		if err := snapshotVolumes(ctx, snapshotVolumeOps); err != nil {
+			return errors.Annotate(err, "snapshotting volumes")
+		}
+	}
 	if len(detachVolumeOps) > 0 {
 		if err := detachVolumes(ctx, detachVolumeOps); err != nil {
 			return errors.Annotate(err, "detaching volumes")
@@ -487,6 +567,11 @@ type context struct {
 	// block devices we wish to enquire.
 	pendingVolumeBlockDevices names.Set
 
+	// sharedFilesystems contains the tags of filesystems that the
+	// controller has reported as shared between machines, when
+	// fetching filesystem attachment parameters.
+	sharedFilesystems names.Set
+
 	// managedFilesystemSource is a storage.FilesystemSource that
 	// manages filesystems backed by volumes attached to the host
 	// machine.
@@ -1053,6 +1053,67 @@ func (s *storageProvisionerSuite) TestCreateVolumeBackedFilesystem(c *gc.C) {
 	}})
 }
 
+func (s *storageProvisionerSuite) TestAttachSharedFilesystem(c *gc.C) {
+	infoSet := make(chan interface{})
+	filesystemAccessor := newMockFilesystemAccessor()
+	filesystemAccessor.setFilesystemAttachmentInfo = func(attachments []params.FilesystemAttachment) ([]params.ErrorResult, error) {
+		infoSet <- attachments
+		return nil, nil
+	}
+
+	// The shared filesystem is model-scoped, and provisioned by the
+	// model-scoped storage provisioner, so the machine-scoped storage
+	// provisioner never observes it through the filesystems watcher.
+	// The controller reports it as shared in the attachment params.
+	filesystemAccessor.sharedFilesystems["filesystem-1"] = true
+	filesystemAccessor.provisionedFilesystems["filesystem-1"] = params.Filesystem{
+		FilesystemTag: "filesystem-1",
+		Info: params.FilesystemInfo{
+			FilesystemId: "nfs.example.com:/srv/data",
+		},
+	}
+	filesystemAccessor.provisionedMachines["machine-0"] = instance.Id("already-provisioned-0")
+
+	var attachArgs []storage.FilesystemAttachmentParams
+	s.provider.attachFilesystemsFunc = func(args []storage.FilesystemAttachmentParams) ([]storage.AttachFilesystemsResult, error) {
+		attachArgs = append(attachArgs, args...)
+		results := make([]storage.AttachFilesystemsResult, len(args))
+		for i, arg := range args {
+			results[i].FilesystemAttachment = &storage.FilesystemAttachment{
+				arg.Filesystem,
+				arg.Machine,
+				storage.FilesystemAttachmentInfo{
+					Path: arg.Path,
+				},
+			}
+		}
+		return results, nil
+	}
+
+	args := &workerArgs{
+		scope:       names.NewMachineTag("0"),
+		filesystems: filesystemAccessor,
+		registry:    s.registry,
+	}
+	worker := newStorageProvisioner(c, args)
+	defer func() { c.Assert(worker.Wait(), gc.IsNil) }()
+	defer worker.Kill()
+
+	filesystemAccessor.attachmentsWatcher.changes <- []watcher.MachineStorageId{{
+		MachineTag: "machine-0", AttachmentTag: "filesystem-1",
+	}}
+	info := waitChannel(c, infoSet, "waiting for filesystem attachments to be set")
+	c.Assert(info, jc.DeepEquals, []params.FilesystemAttachment{{
+		MachineTag:    "machine-0",
+		FilesystemTag: "filesystem-1",
+		Info: params.FilesystemAttachmentInfo{
+			MountPoint: "storage-dir/1",
+		},
+	}})
+	c.Assert(attachArgs, gc.HasLen, 1)
+	c.Assert(attachArgs[0].FilesystemId, gc.Equals, "nfs.example.com:/srv/data")
+}
+
 func (s *storageProvisionerSuite) TestAttachVolumeBackedFilesystem(c *gc.C) {
 	infoSet := make(chan interface{})
 	filesystemAccessor := newMockFilesystemAccessor()
@@ -1677,6 +1738,201 @@ func (s *storageProvisionerSuite) TestDestroyVolumesRetry(c *gc.C) {
 	})
 }
 
+func (s *storageProvisionerSuite) TestResizeVolumes(c *gc.C) {
+	volumeAccessor := newMockVolumeAccessor()
+	volumeAccessor.provisionVolume(names.NewVolumeTag("1"))
+	volumeAccessor.pendingResizes["volume-1"] = 2048
+
+	resizedChan := make(chan interface{}, 1)
+	s.provider.resizeVolumesFunc = func(args []storage.VolumeResizeParams) ([]storage.ResizeVolumesResult, error) {
+		resizedChan <- args
+		results := make([]storage.ResizeVolumesResult, len(args))
+		for i, arg := range args {
+			results[i].Volume = &storage.Volume{
+				arg.Tag,
+				storage.VolumeInfo{VolumeId: arg.VolumeId, Size: arg.Size},
+			}
+		}
+		return results, nil
+	}
+
+	volumeInfoSet := make(chan interface{}, 1)
+	volumeAccessor.setVolumeInfo = func(volumes []params.Volume) ([]params.ErrorResult, error) {
+		volumeInfoSet <- volumes
+		return make([]params.ErrorResult, len(volumes)), nil
+	}
+
+	args := &workerArgs{volumes: volumeAccessor, registry: s.registry}
+	worker := newStorageProvisioner(c, args)
+	defer func() { c.Assert(worker.Wait(), gc.IsNil) }()
+	defer worker.Kill()
+
+	// Volumes without a pending resize are ignored.
+	volumeAccessor.resizesWatcher.changes <- []string{"1", "2"}
+	resized := waitChannel(c, resizedChan, "waiting for volume to be resized")
+	c.Assert(resized, jc.DeepEquals, []storage.VolumeResizeParams{{
+		Tag:        names.NewVolumeTag("1"),
+		VolumeId:   "vol-1",
+		Attributes: map[string]interface{}{"persistent": true},
+		Size:       2048,
+	}})
+	volumes := waitChannel(c, volumeInfoSet, "waiting for volume info to be set")
+	c.Assert(volumes, jc.DeepEquals, []params.Volume{{
+		VolumeTag: "volume-1",
+		Info:      params.VolumeInfo{VolumeId: "vol-1", Size: 2048},
+	}})
+	assertNoEvent(c, resizedChan, "volumes resized")
+	c.Assert(args.statusSetter.args, jc.DeepEquals, []params.EntityStatusArgs{
+		{Tag: "volume-1", Status: "detached"},
+	})
+}
+
+func (s *storageProvisionerSuite) TestResizeVolumesRetry(c *gc.C) {
+	volumeAccessor := newMockVolumeAccessor()
+	volumeAccessor.provisionVolume(names.NewVolumeTag("1"))
+	volumeAccessor.pendingResizes["volume-1"] = 2048
+
+	clock := &mockClock{}
+	var resizeVolumeTimes []time.Time
+	s.provider.resizeVolumesFunc = func(args []storage.VolumeResizeParams) ([]storage.ResizeVolumesResult, error) {
+		resizeVolumeTimes = append(resizeVolumeTimes, clock.Now())
+		if len(resizeVolumeTimes) < 3 {
+			return []storage.ResizeVolumesResult{{Error: errors.New("badness")}}, nil
+		}
+		return []storage.ResizeVolumesResult{{
+			Volume: &storage.Volume{
+				args[0].Tag,
+				storage.VolumeInfo{VolumeId: args[0].VolumeId, Size: args[0].Size},
+			},
+		}}, nil
+	}
+
+	volumeInfoSet := make(chan interface{}, 1)
+	volumeAccessor.setVolumeInfo = func(volumes []params.Volume) ([]params.ErrorResult, error) {
+		volumeInfoSet <- volumes
+		return make([]params.ErrorResult, len(volumes)), nil
+	}
+
+	args := &workerArgs{volumes: volumeAccessor, clock: clock, registry: s.registry}
+	worker := newStorageProvisioner(c, args)
+	defer func() { c.Assert(worker.Wait(), gc.IsNil) }()
+	defer worker.Kill()
+
+	volumeAccessor.resizesWatcher.changes <- []string{"1"}
+	waitChannel(c, volumeInfoSet, "waiting for volume info to be set")
+	c.Assert(resizeVolumeTimes, gc.HasLen, 3)
+	c.Assert(resizeVolumeTimes[0], gc.Equals, time.Time{})
+	c.Assert(resizeVolumeTimes[1].Sub(resizeVolumeTimes[0]), gc.Equals, 30*time.Second)
+	c.Assert(resizeVolumeTimes[2].Sub(resizeVolumeTimes[1]), gc.Equals, time.Minute)
+	c.Assert(args.statusSetter.args, jc.DeepEquals, []params.EntityStatusArgs{
+		{Tag: "volume-1", Status: "error", Info: "badness"},
+		{Tag: "volume-1", Status: "error", Info: "badness"},
+		{Tag: "volume-1", Status: "detached"},
+	})
+}
+
+func (s *storageProvisionerSuite) TestSnapshotVolumes(c *gc.C) {
+	volumeAccessor := newMockVolumeAccessor()
+	volumeAccessor.pendingSnapshots["0"] = params.VolumeSnapshotParams{
+		Id:        "0",
+		VolumeTag: "volume-1",
+		VolumeId:  "vol-1",
+		Provider:  "dummy",
+	}
+
+	snapshottedChan := make(chan interface{}, 1)
+	s.provider.createVolumeSnapshotsFunc = func(args []storage.VolumeSnapshotParams) ([]storage.CreateVolumeSnapshotsResult, error) {
+		snapshottedChan <- args
+		return []storage.CreateVolumeSnapshotsResult{{
+			VolumeSnapshot: &storage.VolumeSnapshot{
+				Id:     args[0].Id,
+				Volume: args[0].Volume,
+				VolumeSnapshotInfo: storage.VolumeSnapshotInfo{
+					SnapshotId: "snap-0",
+					Size:       1024,
+				},
+			},
+		}}, nil
+	}
+
+	snapshotInfoSet := make(chan interface{}, 1)
+	volumeAccessor.setVolumeSnapshotInfo = func(snapshots []params.VolumeSnapshot) ([]params.ErrorResult, error) {
+		snapshotInfoSet <- snapshots
+		return make([]params.ErrorResult, len(snapshots)), nil
+	}
+
+	args := &workerArgs{volumes: volumeAccessor, registry: s.registry}
+	worker := newStorageProvisioner(c, args)
+	defer func() { c.Assert(worker.Wait(), gc.IsNil) }()
+	defer worker.Kill()
+
+	// Snapshots with nothing pending are ignored.
+	volumeAccessor.snapshotsWatcher.changes <- []string{"0", "1"}
+	snapshotted := waitChannel(c, snapshottedChan, "waiting for volume to be snapshotted")
+	c.Assert(snapshotted, jc.DeepEquals, []storage.VolumeSnapshotParams{{
+		Id:       "0",
+		Volume:   names.NewVolumeTag("1"),
+		VolumeId: "vol-1",
+	}})
+	snapshots := waitChannel(c, snapshotInfoSet, "waiting for volume snapshot info to be set")
+	c.Assert(snapshots, jc.DeepEquals, []params.VolumeSnapshot{{
+		Id:         "0",
+		SnapshotId: "snap-0",
+		Size:       1024,
+	}})
+	assertNoEvent(c, snapshottedChan, "volumes snapshotted")
+}
+
+func (s *storageProvisionerSuite) TestRestoreVolumeSnapshotsRetry(c *gc.C) {
+	volumeAccessor := newMockVolumeAccessor()
+	volumeAccessor.pendingSnapshots["0"] = params.VolumeSnapshotParams{
+		Id:         "0",
+		VolumeTag:  "volume-1",
+		VolumeId:   "vol-1",
+		Provider:   "dummy",
+		SnapshotId: "snap-0",
+		Restore:    true,
+	}
+
+	clock := &mockClock{}
+	var restoreTimes []time.Time
+	s.provider.restoreVolumeSnapshotsFunc = func(args []storage.VolumeSnapshotParams) ([]error, error) {
+		restoreTimes = append(restoreTimes, clock.Now())
+		if len(restoreTimes) < 3 {
+			return []error{errors.New("badness")}, nil
+		}
+		return []error{nil}, nil
+	}
+
+	var messages []params.VolumeSnapshotMessage
+	volumeAccessor.setVolumeSnapshotMessages = func(args []params.VolumeSnapshotMessage) ([]params.ErrorResult, error) {
+		messages = append(messages, args...)
+		return make([]params.ErrorResult, len(args)), nil
+	}
+	restoredSet := make(chan interface{}, 1)
+	volumeAccessor.setVolumeSnapshotsRestored = func(ids []string) ([]params.ErrorResult, error) {
+		restoredSet <- ids
+		return make([]params.ErrorResult, len(ids)), nil
+	}
+
+	args := &workerArgs{volumes: volumeAccessor, clock: clock, registry: s.registry}
+	worker := newStorageProvisioner(c, args)
+	defer func() { c.Assert(worker.Wait(), gc.IsNil) }()
+	defer worker.Kill()
+
+	volumeAccessor.snapshotsWatcher.changes <- []string{"0"}
+	restored := waitChannel(c, restoredSet, "waiting for volume to be restored")
+	c.Assert(restored, jc.DeepEquals, []string{"0"})
+	c.Assert(restoreTimes, gc.HasLen, 3)
+	c.Assert(restoreTimes[0], gc.Equals, time.Time{})
+	c.Assert(restoreTimes[1].Sub(restoreTimes[0]), gc.Equals, 30*time.Second)
+	c.Assert(restoreTimes[2].Sub(restoreTimes[1]), gc.Equals, time.Minute)
+	c.Assert(messages, jc.DeepEquals, []params.VolumeSnapshotMessage{
+		{Id: "0", Message: "badness"},
+		{Id: "0", Message: "badness"},
+	})
+}
+
 func (s *storageProvisionerSuite) TestDestroyFilesystems(c *gc.C) {
 	unprovisionedFilesystem := names.NewFilesystemTag("0")
 	provisionedDestroyFilesystem := names.NewFilesystemTag("1")
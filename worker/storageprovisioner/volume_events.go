@@ -54,6 +54,95 @@ func volumesChanged(ctx *context, changes []string) error {
 	return nil
 }
 
+// volumeResizesChanged is called when the volumes with the provided IDs
+// may have had resize requests made or withdrawn.
+func volumeResizesChanged(ctx *context, changes []string) error {
+	tags := make([]names.VolumeTag, len(changes))
+	for i, change := range changes {
+		tags[i] = names.NewVolumeTag(change)
+	}
+	results, err := ctx.config.Volumes.VolumeResizeParams(tags)
+	if err != nil {
+		return errors.Annotate(err, "getting volume resize params")
+	}
+	var ops []scheduleOp
+	for i, result := range results {
+		key := resizeVolumeKey{tags[i]}
+		if result.Error != nil {
+			if params.IsCodeNotFound(result.Error) {
+				// There is no pending resize for the volume,
+				// or the volume is no longer alive.
+				ctx.schedule.Remove(key)
+				continue
+			}
+			return errors.Annotatef(
+				result.Error, "getting resize params for %s",
+				names.ReadableString(tags[i]),
+			)
+		}
+		op := &resizeVolumeOp{
+			args: storage.VolumeResizeParams{
+				Tag:        tags[i],
+				VolumeId:   result.Result.VolumeId,
+				Attributes: result.Result.Attributes,
+				Size:       result.Result.Size,
+			},
+			provider: storage.ProviderType(result.Result.Provider),
+		}
+		// Replace any resize already scheduled for the
+		// volume, as the requested size may have changed.
+		ctx.schedule.Remove(key)
+		ops = append(ops, op)
+	}
+	scheduleOperations(ctx, ops...)
+	return nil
+}
+
+// volumeSnapshotsChanged is called when the volume snapshots with the
+// provided IDs may have been requested, taken or restored.
+func volumeSnapshotsChanged(ctx *context, changes []string) error {
+	results, err := ctx.config.Volumes.VolumeSnapshotParams(changes)
+	if err != nil {
+		return errors.Annotate(err, "getting volume snapshot params")
+	}
+	var ops []scheduleOp
+	for i, result := range results {
+		key := snapshotVolumeKey{changes[i]}
+		if result.Error != nil {
+			if params.IsCodeNotFound(result.Error) {
+				// There is nothing pending for the snapshot,
+				// or its volume is no longer alive.
+				ctx.schedule.Remove(key)
+				continue
+			}
+			return errors.Annotatef(
+				result.Error, "getting params for volume snapshot %q", changes[i],
+			)
+		}
+		volumeTag, err := names.ParseVolumeTag(result.Result.VolumeTag)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		op := &snapshotVolumeOp{
+			args: storage.VolumeSnapshotParams{
+				Id:         result.Result.Id,
+				Volume:     volumeTag,
+				VolumeId:   result.Result.VolumeId,
+				SnapshotId: result.Result.SnapshotId,
+			},
+			provider: storage.ProviderType(result.Result.Provider),
+			restore:  result.Result.Restore,
+		}
+		// Replace any operation already scheduled for the
+		// snapshot, as it may have changed from taking the
+		// snapshot to restoring it.
+		ctx.schedule.Remove(key)
+		ops = append(ops, op)
+	}
+	scheduleOperations(ctx, ops...)
+	return nil
+}
+
 // volumeAttachmentsChanged is called when the lifecycle states of the volume
 // attachments with the provided IDs have been seen to have changed.
 func volumeAttachmentsChanged(ctx *context, watcherIds []watcher.MachineStorageId) error {
@@ -498,12 +587,13 @@ func volumeParamsFromParams(in params.VolumeParams) (storage.VolumeParams, error
 		}
 	}
 	return storage.VolumeParams{
-		volumeTag,
-		in.Size,
-		providerType,
-		in.Attributes,
-		in.Tags,
-		attachment,
+		Tag:          volumeTag,
+		Size:         in.Size,
+		Provider:     providerType,
+		Attributes:   in.Attributes,
+		ResourceTags: in.Tags,
+		Attachment:   attachment,
+		SnapshotId:   in.SnapshotId,
 	}, nil
 }
 
@@ -121,6 +121,254 @@ func createVolumes(ctx *context, ops map[names.VolumeTag]*createVolumeOp) error
 	return nil
 }
 
+// resizeVolumes grows volumes to the sizes in the specified parameters.
+func resizeVolumes(ctx *context, ops map[names.VolumeTag]*resizeVolumeOp) error {
+	volumeParams := make([]storage.VolumeParams, 0, len(ops))
+	for _, op := range ops {
+		volumeParams = append(volumeParams, storage.VolumeParams{
+			Tag:        op.args.Tag,
+			Provider:   op.provider,
+			Attributes: op.args.Attributes,
+		})
+	}
+	paramsBySource, volumeSources, err := volumeParamsBySource(
+		ctx.config.StorageDir, volumeParams, ctx.config.Registry,
+	)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	var reschedule []scheduleOp
+	var volumes []storage.Volume
+	var statuses []params.EntityStatusArgs
+	for sourceName, volumeParams := range paramsBySource {
+		resizeParams := make([]storage.VolumeResizeParams, len(volumeParams))
+		for i, p := range volumeParams {
+			resizeParams[i] = ops[p.Tag].args
+		}
+		logger.Debugf("resizing volumes: %v", resizeParams)
+		resizer, ok := volumeSources[sourceName].(storage.VolumeResizer)
+		if !ok {
+			for _, p := range resizeParams {
+				statuses = append(statuses, params.EntityStatusArgs{
+					Tag:    p.Tag.String(),
+					Status: status.Error.String(),
+					Info:   "resizing volumes not supported",
+				})
+			}
+			continue
+		}
+		results, err := resizer.ResizeVolumes(resizeParams)
+		if err != nil {
+			return errors.Annotatef(err, "resizing volumes from source %q", sourceName)
+		}
+		for i, result := range results {
+			tag := resizeParams[i].Tag
+			if result.Error != nil {
+				// Reschedule the volume resize, reporting the
+				// error against the volume's status.
+				reschedule = append(reschedule, ops[tag])
+				statuses = append(statuses, params.EntityStatusArgs{
+					Tag:    tag.String(),
+					Status: status.Error.String(),
+					Info:   result.Error.Error(),
+				})
+				logger.Debugf(
+					"failed to resize %s: %v",
+					names.ReadableString(tag), result.Error,
+				)
+				continue
+			}
+			volume := *result.Volume
+			if old, ok := ctx.volumes[tag]; ok {
+				// Resizing does not change the identity of the
+				// volume, so carry over anything the volume
+				// source did not report.
+				if volume.HardwareId == "" {
+					volume.HardwareId = old.HardwareId
+				}
+				if volume.WWN == "" {
+					volume.WWN = old.WWN
+				}
+				volume.Persistent = volume.Persistent || old.Persistent
+			}
+			volumes = append(volumes, volume)
+			// Clear any error reported by a previous attempt.
+			statuses = append(statuses, params.EntityStatusArgs{
+				Tag:    tag.String(),
+				Status: resizedVolumeStatus(ctx, tag).String(),
+			})
+		}
+	}
+	scheduleOperations(ctx, reschedule...)
+	setStatus(ctx, statuses)
+	if len(volumes) == 0 {
+		return nil
+	}
+	errorResults, err := ctx.config.Volumes.SetVolumeInfo(volumesFromStorage(volumes))
+	if err != nil {
+		return errors.Annotate(err, "publishing volumes to state")
+	}
+	for i, result := range errorResults {
+		if result.Error != nil {
+			logger.Errorf(
+				"publishing volume %s to state: %v",
+				volumes[i].Tag.Id(),
+				result.Error,
+			)
+			continue
+		}
+		updateVolume(ctx, volumes[i])
+	}
+	return nil
+}
+
+// resizedVolumeStatus returns the status to record for a volume
+// that has been successfully resized.
+func resizedVolumeStatus(ctx *context, tag names.VolumeTag) status.Status {
+	for id := range ctx.volumeAttachments {
+		if id.AttachmentTag == tag.String() {
+			return status.Attached
+		}
+	}
+	return status.Detached
+}
+
+// snapshotVolumes takes or restores the volume snapshots with the
+// specified parameters.
+func snapshotVolumes(ctx *context, ops map[string]*snapshotVolumeOp) error {
+	volumeParams := make([]storage.VolumeParams, 0, len(ops))
+	for _, op := range ops {
+		volumeParams = append(volumeParams, storage.VolumeParams{
+			Tag:      op.args.Volume,
+			Provider: op.provider,
+		})
+	}
+	_, volumeSources, err := volumeParamsBySource(
+		ctx.config.StorageDir, volumeParams, ctx.config.Registry,
+	)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	// There may be several snapshots of the same volume, so
+	// the operations are grouped by source directly.
+	opsBySource := make(map[string][]*snapshotVolumeOp)
+	for _, op := range ops {
+		sourceName := string(op.provider)
+		if volumeSources[sourceName] == nil {
+			continue
+		}
+		opsBySource[sourceName] = append(opsBySource[sourceName], op)
+	}
+	var reschedule []scheduleOp
+	var snapshots []params.VolumeSnapshot
+	var restored []string
+	var messages []params.VolumeSnapshotMessage
+	for sourceName, ops := range opsBySource {
+		snapshotter, ok := volumeSources[sourceName].(storage.VolumeSnapshotter)
+		if !ok {
+			for _, op := range ops {
+				messages = append(messages, params.VolumeSnapshotMessage{
+					Id:      op.args.Id,
+					Message: "volume snapshots not supported",
+				})
+			}
+			continue
+		}
+		var createOps, restoreOps []*snapshotVolumeOp
+		for _, op := range ops {
+			if op.restore {
+				restoreOps = append(restoreOps, op)
+			} else {
+				createOps = append(createOps, op)
+			}
+		}
+		failed := func(op *snapshotVolumeOp, err error) {
+			// Reschedule the operation, reporting
+			// the error against the snapshot.
+			reschedule = append(reschedule, op)
+			messages = append(messages, params.VolumeSnapshotMessage{
+				Id:      op.args.Id,
+				Message: err.Error(),
+			})
+			logger.Debugf("failed to take or restore volume snapshot %q: %v", op.args.Id, err)
+		}
+		if len(createOps) > 0 {
+			snapshotParams := make([]storage.VolumeSnapshotParams, len(createOps))
+			for i, op := range createOps {
+				snapshotParams[i] = op.args
+			}
+			logger.Debugf("creating volume snapshots: %v", snapshotParams)
+			results, err := snapshotter.CreateVolumeSnapshots(snapshotParams)
+			if err != nil {
+				return errors.Annotatef(err, "creating volume snapshots from source %q", sourceName)
+			}
+			for i, result := range results {
+				if result.Error != nil {
+					failed(createOps[i], result.Error)
+					continue
+				}
+				snapshots = append(snapshots, params.VolumeSnapshot{
+					Id:         result.VolumeSnapshot.Id,
+					SnapshotId: result.VolumeSnapshot.SnapshotId,
+					Size:       result.VolumeSnapshot.Size,
+				})
+			}
+		}
+		if len(restoreOps) > 0 {
+			snapshotParams := make([]storage.VolumeSnapshotParams, len(restoreOps))
+			for i, op := range restoreOps {
+				snapshotParams[i] = op.args
+			}
+			logger.Debugf("restoring volume snapshots: %v", snapshotParams)
+			errs, err := snapshotter.RestoreVolumeSnapshots(snapshotParams)
+			if err != nil {
+				return errors.Annotatef(err, "restoring volume snapshots from source %q", sourceName)
+			}
+			for i, err := range errs {
+				if err != nil {
+					failed(restoreOps[i], err)
+					continue
+				}
+				restored = append(restored, restoreOps[i].args.Id)
+			}
+		}
+	}
+	scheduleOperations(ctx, reschedule...)
+	if len(messages) > 0 {
+		errorResults, err := ctx.config.Volumes.SetVolumeSnapshotMessages(messages)
+		if err != nil {
+			return errors.Annotate(err, "publishing volume snapshot messages to state")
+		}
+		logVolumeSnapshotErrors(errorResults, func(i int) string { return messages[i].Id })
+	}
+	if len(snapshots) > 0 {
+		errorResults, err := ctx.config.Volumes.SetVolumeSnapshotInfo(snapshots)
+		if err != nil {
+			return errors.Annotate(err, "publishing volume snapshots to state")
+		}
+		logVolumeSnapshotErrors(errorResults, func(i int) string { return snapshots[i].Id })
+	}
+	if len(restored) > 0 {
+		errorResults, err := ctx.config.Volumes.SetVolumeSnapshotsRestored(restored)
+		if err != nil {
+			return errors.Annotate(err, "publishing restored volumes to state")
+		}
+		logVolumeSnapshotErrors(errorResults, func(i int) string { return restored[i] })
+	}
+	return nil
+}
+
+func logVolumeSnapshotErrors(errorResults []params.ErrorResult, id func(int) string) {
+	for i, result := range errorResults {
+		if result.Error != nil {
+			logger.Errorf(
+				"publishing volume snapshot %s to state: %v",
+				id(i), result.Error,
+			)
+		}
+	}
+}
+
 // attachVolumes creates volume attachments with the specified parameters.
 func attachVolumes(ctx *context, ops map[params.MachineStorageId]*attachVolumeOp) error {
 	volumeAttachmentParams := make([]storage.VolumeAttachmentParams, 0, len(ops))
@@ -406,8 +654,14 @@ func validateVolumeParams(
 ) ([]storage.VolumeParams, []error) {
 	valid := make([]storage.VolumeParams, 0, len(volumeParams))
 	results := make([]error, len(volumeParams))
+	_, canSnapshot := volumeSource.(storage.VolumeSnapshotter)
 	for i, params := range volumeParams {
-		err := volumeSource.ValidateVolumeParams(params)
+		var err error
+		if params.SnapshotId != "" && !canSnapshot {
+			err = errors.NotSupportedf("creating volumes from snapshots")
+		} else {
+			err = volumeSource.ValidateVolumeParams(params)
+		}
 		if err == nil {
 			valid = append(valid, params)
 		}
@@ -498,6 +752,39 @@ func (op *removeVolumeOp) key() interface{} {
 	return op.tag
 }
 
+type resizeVolumeOp struct {
+	exponentialBackoff
+	args     storage.VolumeResizeParams
+	provider storage.ProviderType
+}
+
+// resizeVolumeKey is the schedule key for a volume resize operation,
+// distinct from the key used for creating or removing the volume.
+type resizeVolumeKey struct {
+	tag names.VolumeTag
+}
+
+func (op *resizeVolumeOp) key() interface{} {
+	return resizeVolumeKey{op.args.Tag}
+}
+
+type snapshotVolumeOp struct {
+	exponentialBackoff
+	args     storage.VolumeSnapshotParams
+	provider storage.ProviderType
+	restore  bool
+}
+
+// snapshotVolumeKey is the schedule key for an operation taking or
+// restoring a volume snapshot.
+type snapshotVolumeKey struct {
+	id string
+}
+
+func (op *snapshotVolumeOp) key() interface{} {
+	return snapshotVolumeKey{op.args.Id}
+}
+
 type attachVolumeOp struct {
 	exponentialBackoff
 	args storage.VolumeAttachmentParams
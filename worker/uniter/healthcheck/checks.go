@@ -0,0 +1,224 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package healthcheck runs the health checks declared by a charm, and
+// reports when each check becomes healthy or unhealthy.
+//
+// A charm declares its health checks in the health-checks section of
+// its metadata.yaml, for example:
+//
+//	health-checks:
+//	  website:
+//	    http:
+//	      url: http://localhost:8080/health
+//	    interval: 10s
+//	  database:
+//	    tcp:
+//	      port: 5432
+//	    threshold: 5
+//	  queue:
+//	    exec:
+//	      command: scripts/check-queue
+//	    timeout: 30s
+//
+// Each check has exactly one probe. An exec probe runs a command from
+// the charm directory, and passes if it exits with status 0; an http
+// probe passes if a GET request of the URL returns a 2xx or 3xx status;
+// and a tcp probe passes if a connection can be made to the port.
+// Probes are run every interval, and a check becomes unhealthy once
+// its probe has failed threshold times in a row. A check becomes
+// healthy as soon as its probe passes.
+package healthcheck
+
+import (
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/juju/errors"
+	"gopkg.in/yaml.v2"
+)
+
+// MetadataFile is the name of the file, in the root of a charm, in
+// which the charm declares its health checks.
+const MetadataFile = "metadata.yaml"
+
+const (
+	// DefaultInterval is the interval between probes of a check
+	// that does not specify one.
+	DefaultInterval = 30 * time.Second
+
+	// DefaultTimeout is how long a probe may take, for a check
+	// that does not specify a timeout.
+	DefaultTimeout = 10 * time.Second
+
+	// DefaultThreshold is the number of consecutive failures after
+	// which a check that does not specify a threshold is unhealthy.
+	DefaultThreshold = 3
+)
+
+// Check is a health check declared by a charm.
+type Check struct {
+	// Name is the name of the check.
+	Name string
+
+	// Exactly one of Exec, HTTP and TCP is set, and
+	// describes how the check is probed.
+	Exec *ExecProbe
+	HTTP *HTTPProbe
+	TCP  *TCPProbe
+
+	// Interval is the time between probes.
+	Interval time.Duration
+
+	// Timeout is how long a probe may take before it fails.
+	Timeout time.Duration
+
+	// Threshold is the number of consecutive failed probes
+	// after which the check is unhealthy.
+	Threshold int
+}
+
+// ExecProbe runs a command from the charm directory.
+type ExecProbe struct {
+	Command string `yaml:"command"`
+}
+
+// HTTPProbe makes a GET request of a URL.
+type HTTPProbe struct {
+	URL string `yaml:"url"`
+}
+
+// TCPProbe connects to a port. Host defaults to localhost.
+type TCPProbe struct {
+	Host string `yaml:"host,omitempty"`
+	Port int    `yaml:"port"`
+}
+
+// metadataDoc holds the section of a charm's metadata in which
+// the charm declares its health checks.
+type metadataDoc struct {
+	HealthChecks map[string]checkDoc `yaml:"health-checks"`
+}
+
+type checkDoc struct {
+	Exec      *ExecProbe `yaml:"exec,omitempty"`
+	HTTP      *HTTPProbe `yaml:"http,omitempty"`
+	TCP       *TCPProbe  `yaml:"tcp,omitempty"`
+	Interval  string     `yaml:"interval,omitempty"`
+	Timeout   string     `yaml:"timeout,omitempty"`
+	Threshold int        `yaml:"threshold,omitempty"`
+}
+
+// ReadChecks returns the health checks declared in the metadata of
+// the charm in the given directory, sorted by name.
+func ReadChecks(charmDir string) ([]Check, error) {
+	data, err := ioutil.ReadFile(filepath.Join(charmDir, MetadataFile))
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, errors.Trace(err)
+	}
+	checks, err := ParseChecks(data)
+	if err != nil {
+		return nil, errors.Annotatef(err, "reading %s", MetadataFile)
+	}
+	return checks, nil
+}
+
+// ParseChecks parses the health checks declared in charm metadata,
+// and returns them sorted by name. The rest of the metadata is
+// ignored.
+func ParseChecks(data []byte) ([]Check, error) {
+	var doc metadataDoc
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, errors.Trace(err)
+	}
+	checks := make([]Check, 0, len(doc.HealthChecks))
+	for name, checkDoc := range doc.HealthChecks {
+		check, err := parseCheck(name, checkDoc)
+		if err != nil {
+			return nil, errors.Annotatef(err, "check %q", name)
+		}
+		checks = append(checks, check)
+	}
+	sort.Slice(checks, func(i, j int) bool {
+		return checks[i].Name < checks[j].Name
+	})
+	return checks, nil
+}
+
+func parseCheck(name string, doc checkDoc) (Check, error) {
+	check := Check{
+		Name:      name,
+		Exec:      doc.Exec,
+		HTTP:      doc.HTTP,
+		TCP:       doc.TCP,
+		Interval:  DefaultInterval,
+		Timeout:   DefaultTimeout,
+		Threshold: DefaultThreshold,
+	}
+	if name == "" {
+		return Check{}, errors.NotValidf("empty name")
+	}
+	probes := 0
+	if check.Exec != nil {
+		probes++
+		if check.Exec.Command == "" {
+			return Check{}, errors.NotValidf("exec probe without command")
+		}
+	}
+	if check.HTTP != nil {
+		probes++
+		u, err := url.Parse(check.HTTP.URL)
+		if err != nil {
+			return Check{}, errors.Annotate(err, "parsing http probe url")
+		}
+		if u.Scheme != "http" && u.Scheme != "https" {
+			return Check{}, errors.NotValidf("http probe url %q", check.HTTP.URL)
+		}
+	}
+	if check.TCP != nil {
+		probes++
+		if check.TCP.Port <= 0 || check.TCP.Port > 65535 {
+			return Check{}, errors.NotValidf("tcp probe port %d", check.TCP.Port)
+		}
+		if check.TCP.Host == "" {
+			check.TCP.Host = "localhost"
+		}
+	}
+	if probes != 1 {
+		return Check{}, errors.Errorf("expected exactly one of exec, http or tcp, got %d", probes)
+	}
+	var err error
+	if doc.Interval != "" {
+		if check.Interval, err = parsePositiveDuration(doc.Interval); err != nil {
+			return Check{}, errors.Annotate(err, "parsing interval")
+		}
+	}
+	if doc.Timeout != "" {
+		if check.Timeout, err = parsePositiveDuration(doc.Timeout); err != nil {
+			return Check{}, errors.Annotate(err, "parsing timeout")
+		}
+	}
+	if doc.Threshold < 0 {
+		return Check{}, errors.NotValidf("negative threshold")
+	} else if doc.Threshold > 0 {
+		check.Threshold = doc.Threshold
+	}
+	return check, nil
+}
+
+func parsePositiveDuration(s string) (time.Duration, error) {
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return 0, errors.Trace(err)
+	}
+	if d <= 0 {
+		return 0, errors.NotValidf("non-positive duration %q", s)
+	}
+	return d, nil
+}
@@ -0,0 +1,142 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package healthcheck_test
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"time"
+
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/worker/uniter/healthcheck"
+)
+
+type ChecksSuite struct {
+	testing.IsolationSuite
+}
+
+var _ = gc.Suite(&ChecksSuite{})
+
+func (s *ChecksSuite) TestParseChecks(c *gc.C) {
+	checks, err := healthcheck.ParseChecks([]byte(`
+name: wordpress
+summary: blog
+health-checks:
+  website:
+    http:
+      url: http://localhost:8080/health
+    interval: 10s
+  database:
+    tcp:
+      port: 5432
+    threshold: 5
+  queue:
+    exec:
+      command: scripts/check-queue
+    timeout: 1m
+`))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(checks, jc.DeepEquals, []healthcheck.Check{{
+		Name:      "database",
+		TCP:       &healthcheck.TCPProbe{Host: "localhost", Port: 5432},
+		Interval:  healthcheck.DefaultInterval,
+		Timeout:   healthcheck.DefaultTimeout,
+		Threshold: 5,
+	}, {
+		Name:      "queue",
+		Exec:      &healthcheck.ExecProbe{Command: "scripts/check-queue"},
+		Interval:  healthcheck.DefaultInterval,
+		Timeout:   time.Minute,
+		Threshold: healthcheck.DefaultThreshold,
+	}, {
+		Name:      "website",
+		HTTP:      &healthcheck.HTTPProbe{URL: "http://localhost:8080/health"},
+		Interval:  10 * time.Second,
+		Timeout:   healthcheck.DefaultTimeout,
+		Threshold: healthcheck.DefaultThreshold,
+	}})
+}
+
+func (s *ChecksSuite) TestParseChecksInvalid(c *gc.C) {
+	for i, test := range []struct {
+		yaml   string
+		expect string
+	}{{
+		yaml:   "health-checks: {a: {}}",
+		expect: `check "a": expected exactly one of exec, http or tcp, got 0`,
+	}, {
+		yaml:   "health-checks: {a: {exec: {command: x}, tcp: {port: 80}}}",
+		expect: `check "a": expected exactly one of exec, http or tcp, got 2`,
+	}, {
+		yaml:   "health-checks: {a: {exec: {}}}",
+		expect: `check "a": exec probe without command not valid`,
+	}, {
+		yaml:   "health-checks: {a: {http: {url: 'ftp://example.com'}}}",
+		expect: `check "a": http probe url "ftp://example.com" not valid`,
+	}, {
+		yaml:   "health-checks: {a: {tcp: {port: 70000}}}",
+		expect: `check "a": tcp probe port 70000 not valid`,
+	}, {
+		yaml:   "health-checks: {a: {tcp: {port: 80}, interval: 0s}}",
+		expect: `check "a": parsing interval: non-positive duration "0s" not valid`,
+	}, {
+		yaml:   "health-checks: {a: {tcp: {port: 80}, timeout: soon}}",
+		expect: `check "a": parsing timeout: time: invalid duration .*`,
+	}, {
+		yaml:   "health-checks: {a: {tcp: {port: 80}, threshold: -1}}",
+		expect: `check "a": negative threshold not valid`,
+	}} {
+		c.Logf("test %d: %s", i, test.yaml)
+		_, err := healthcheck.ParseChecks([]byte(test.yaml))
+		c.Check(err, gc.ErrorMatches, test.expect)
+	}
+}
+
+func (s *ChecksSuite) TestParseChecksNone(c *gc.C) {
+	checks, err := healthcheck.ParseChecks([]byte("name: wordpress\nsummary: blog\n"))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(checks, gc.HasLen, 0)
+}
+
+func (s *ChecksSuite) TestReadChecksMissingFile(c *gc.C) {
+	checks, err := healthcheck.ReadChecks(c.MkDir())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(checks, gc.HasLen, 0)
+}
+
+func (s *ChecksSuite) TestReadChecks(c *gc.C) {
+	dir := c.MkDir()
+	err := ioutil.WriteFile(filepath.Join(dir, healthcheck.MetadataFile), []byte(`
+name: postgresql
+summary: database
+health-checks:
+  database:
+    tcp:
+      host: 10.0.0.1
+      port: 5432
+`), 0644)
+	c.Assert(err, jc.ErrorIsNil)
+
+	checks, err := healthcheck.ReadChecks(dir)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(checks, jc.DeepEquals, []healthcheck.Check{{
+		Name:      "database",
+		TCP:       &healthcheck.TCPProbe{Host: "10.0.0.1", Port: 5432},
+		Interval:  healthcheck.DefaultInterval,
+		Timeout:   healthcheck.DefaultTimeout,
+		Threshold: healthcheck.DefaultThreshold,
+	}})
+}
+
+func (s *ChecksSuite) TestReadChecksInvalid(c *gc.C) {
+	dir := c.MkDir()
+	err := ioutil.WriteFile(filepath.Join(dir, healthcheck.MetadataFile), []byte("health-checks: {a: {}}"), 0644)
+	c.Assert(err, jc.ErrorIsNil)
+
+	_, err = healthcheck.ReadChecks(dir)
+	c.Assert(err, gc.ErrorMatches, `reading metadata.yaml: check "a": .*`)
+}
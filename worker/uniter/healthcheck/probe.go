@@ -0,0 +1,132 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package healthcheck
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/juju/errors"
+	"github.com/juju/utils/clock"
+	"github.com/juju/utils/exec"
+)
+
+// Prober runs the probes of health checks.
+type Prober interface {
+	// Probe runs the probe of the given check, and returns an
+	// error describing why it failed, if it did. A probe that
+	// takes longer than the check's timeout fails, and one that
+	// is still running when abort is closed is stopped.
+	Probe(check Check, abort <-chan struct{}) error
+}
+
+// NewProber returns a Prober that runs exec probes from the given
+// charm directory.
+func NewProber(charmDir string, clock clock.Clock) Prober {
+	return &prober{
+		charmDir: charmDir,
+		clock:    clock,
+	}
+}
+
+type prober struct {
+	charmDir string
+	clock    clock.Clock
+}
+
+// Probe is part of the Prober interface.
+func (p *prober) Probe(check Check, abort <-chan struct{}) error {
+	var probe func(Check, <-chan struct{}) error
+	switch {
+	case check.Exec != nil:
+		probe = p.probeExec
+	case check.HTTP != nil:
+		probe = p.probeHTTP
+	case check.TCP != nil:
+		probe = p.probeTCP
+	default:
+		return errors.Errorf("check %q has no probe", check.Name)
+	}
+
+	// cancel is closed to stop the probe, either because it
+	// has timed out or because it has been aborted.
+	cancel := make(chan struct{})
+	timedOut := make(chan struct{})
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-p.clock.After(check.Timeout):
+			close(timedOut)
+		case <-abort:
+		case <-done:
+			return
+		}
+		close(cancel)
+	}()
+
+	err := probe(check, cancel)
+	select {
+	case <-timedOut:
+		return errors.Errorf("timed out after %v", check.Timeout)
+	default:
+	}
+	return err
+}
+
+func (p *prober) probeExec(check Check, cancel <-chan struct{}) error {
+	cmd := exec.RunParams{
+		Commands:    check.Exec.Command,
+		WorkingDir:  p.charmDir,
+		Environment: append(os.Environ(), "CHARM_DIR="+p.charmDir, "JUJU_CHARM_DIR="+p.charmDir),
+		Clock:       p.clock,
+	}
+	if err := cmd.Run(); err != nil {
+		return errors.Trace(err)
+	}
+	result, err := cmd.WaitWithCancel(cancel)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if result.Code != 0 {
+		message := fmt.Sprintf("exit status %d", result.Code)
+		if stderr := strings.TrimSpace(string(result.Stderr)); stderr != "" {
+			message += ": " + stderr
+		}
+		return errors.New(message)
+	}
+	return nil
+}
+
+func (p *prober) probeHTTP(check Check, cancel <-chan struct{}) error {
+	req, err := http.NewRequest("GET", check.HTTP.URL, nil)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	req.Cancel = cancel
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 400 {
+		return errors.Errorf("GET %s: %s", check.HTTP.URL, resp.Status)
+	}
+	return nil
+}
+
+func (p *prober) probeTCP(check Check, cancel <-chan struct{}) error {
+	address := net.JoinHostPort(check.TCP.Host, strconv.Itoa(check.TCP.Port))
+	dialer := net.Dialer{Cancel: cancel}
+	conn, err := dialer.Dial("tcp", address)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	conn.Close()
+	return nil
+}
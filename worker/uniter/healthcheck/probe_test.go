@@ -0,0 +1,77 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package healthcheck_test
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"time"
+
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	"github.com/juju/utils/clock"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/worker/uniter/healthcheck"
+)
+
+type ProberSuite struct {
+	testing.IsolationSuite
+	prober healthcheck.Prober
+}
+
+var _ = gc.Suite(&ProberSuite{})
+
+func (s *ProberSuite) SetUpTest(c *gc.C) {
+	s.IsolationSuite.SetUpTest(c)
+	s.prober = healthcheck.NewProber(c.MkDir(), clock.WallClock)
+}
+
+func (s *ProberSuite) TestHTTPProbe(c *gc.C) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/health" {
+			http.Error(w, "not found", http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	err := s.prober.Probe(healthcheck.Check{
+		Name:    "website",
+		HTTP:    &healthcheck.HTTPProbe{URL: server.URL + "/health"},
+		Timeout: time.Second,
+	}, nil)
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = s.prober.Probe(healthcheck.Check{
+		Name:    "website",
+		HTTP:    &healthcheck.HTTPProbe{URL: server.URL + "/missing"},
+		Timeout: time.Second,
+	}, nil)
+	c.Assert(err, gc.ErrorMatches, `GET .*/missing: 404 Not Found`)
+}
+
+func (s *ProberSuite) TestTCPProbe(c *gc.C) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	c.Assert(err, jc.ErrorIsNil)
+	port := listener.Addr().(*net.TCPAddr).Port
+	check := healthcheck.Check{
+		Name:    "database",
+		TCP:     &healthcheck.TCPProbe{Host: "127.0.0.1", Port: port},
+		Timeout: time.Second,
+	}
+
+	err = s.prober.Probe(check, nil)
+	c.Assert(err, jc.ErrorIsNil)
+
+	listener.Close()
+	err = s.prober.Probe(check, nil)
+	c.Assert(err, gc.ErrorMatches, `dial tcp 127.0.0.1:`+strconv.Itoa(port)+`: .*`)
+}
+
+func (s *ProberSuite) TestNoProbe(c *gc.C) {
+	err := s.prober.Probe(healthcheck.Check{Name: "nothing"}, nil)
+	c.Assert(err, gc.ErrorMatches, `check "nothing" has no probe`)
+}
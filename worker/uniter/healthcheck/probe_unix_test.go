@@ -0,0 +1,53 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// +build !windows
+
+package healthcheck_test
+
+import (
+	"time"
+
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/worker/uniter/healthcheck"
+)
+
+func (s *ProberSuite) TestExecProbe(c *gc.C) {
+	err := s.prober.Probe(healthcheck.Check{
+		Name:    "queue",
+		Exec:    &healthcheck.ExecProbe{Command: `test "$CHARM_DIR" = "$(pwd)"`},
+		Timeout: time.Minute,
+	}, nil)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *ProberSuite) TestExecProbeFails(c *gc.C) {
+	err := s.prober.Probe(healthcheck.Check{
+		Name:    "queue",
+		Exec:    &healthcheck.ExecProbe{Command: "echo queue is stuck >&2; exit 3"},
+		Timeout: time.Minute,
+	}, nil)
+	c.Assert(err, gc.ErrorMatches, "exit status 3: queue is stuck")
+}
+
+func (s *ProberSuite) TestExecProbeTimesOut(c *gc.C) {
+	err := s.prober.Probe(healthcheck.Check{
+		Name:    "queue",
+		Exec:    &healthcheck.ExecProbe{Command: "sleep 60"},
+		Timeout: 10 * time.Millisecond,
+	}, nil)
+	c.Assert(err, gc.ErrorMatches, "timed out after 10ms")
+}
+
+func (s *ProberSuite) TestExecProbeAborted(c *gc.C) {
+	abort := make(chan struct{})
+	close(abort)
+	err := s.prober.Probe(healthcheck.Check{
+		Name:    "queue",
+		Exec:    &healthcheck.ExecProbe{Command: "sleep 60"},
+		Timeout: time.Minute,
+	}, abort)
+	c.Assert(err, gc.ErrorMatches, "cancelled")
+}
@@ -0,0 +1,233 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package healthcheck
+
+import (
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/loggo"
+	"github.com/juju/utils/clock"
+	"gopkg.in/juju/worker.v1"
+
+	"github.com/juju/juju/worker/catacomb"
+)
+
+var logger = loggo.GetLogger("juju.worker.uniter.healthcheck")
+
+// Result holds the state of a health check.
+type Result struct {
+	// Name is the name of the check.
+	Name string
+
+	// Healthy reports whether the check is passing.
+	Healthy bool
+
+	// Failures is the number of consecutive times the check's
+	// probe had failed when the check last changed state.
+	Failures int
+
+	// Message describes the most recent failure of the probe.
+	Message string
+
+	// Since records when the check became healthy or unhealthy.
+	Since time.Time
+}
+
+// Config holds the configuration and dependencies of a health
+// checker worker.
+type Config struct {
+	// Checks are the checks to run.
+	Checks []Check
+
+	// Initial holds the last reported state of the checks, if any.
+	// Checks start in that state, so that a restarted checker does
+	// not report checks whose state has not changed.
+	Initial []Result
+
+	// Prober runs the probes of the checks.
+	Prober Prober
+
+	// Clock is used to schedule the probes.
+	Clock clock.Clock
+
+	// Notify is called with the state of every check each time one
+	// of them becomes healthy or unhealthy. It is first called once
+	// the state of every check is known, unless that state is the
+	// same as the Initial one.
+	Notify func([]Result)
+}
+
+// Validate returns an error if the config cannot be used to start
+// a health checker.
+func (config Config) Validate() error {
+	if len(config.Checks) == 0 {
+		return errors.NotValidf("empty Checks")
+	}
+	if config.Prober == nil {
+		return errors.NotValidf("nil Prober")
+	}
+	if config.Clock == nil {
+		return errors.NotValidf("nil Clock")
+	}
+	if config.Notify == nil {
+		return errors.NotValidf("nil Notify")
+	}
+	return nil
+}
+
+// NewWorker returns a worker that runs the configured health checks
+// until it is stopped.
+func NewWorker(config Config) (worker.Worker, error) {
+	if err := config.Validate(); err != nil {
+		return nil, errors.Trace(err)
+	}
+	w := &healthChecker{
+		config: config,
+	}
+	err := catacomb.Invoke(catacomb.Plan{
+		Site: &w.catacomb,
+		Work: w.loop,
+	})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return w, nil
+}
+
+type healthChecker struct {
+	catacomb catacomb.Catacomb
+	config   Config
+}
+
+// checkState records the state of a check between probes.
+type checkState struct {
+	Result
+	known bool
+	next  time.Time
+}
+
+// Kill is part of the worker.Worker interface.
+func (w *healthChecker) Kill() {
+	w.catacomb.Kill(nil)
+}
+
+// Wait is part of the worker.Worker interface.
+func (w *healthChecker) Wait() error {
+	return w.catacomb.Wait()
+}
+
+func (w *healthChecker) loop() error {
+	clock := w.config.Clock
+	initial := make(map[string]Result)
+	for _, result := range w.config.Initial {
+		initial[result.Name] = result
+	}
+	// If the checks differ from those last reported, they are
+	// reported as soon as they are all known.
+	pending := len(initial) != len(w.config.Checks)
+	states := make([]checkState, len(w.config.Checks))
+	now := clock.Now()
+	for i, check := range w.config.Checks {
+		states[i].Name = check.Name
+		states[i].next = now
+		if result, ok := initial[check.Name]; ok {
+			states[i].Result = result
+			states[i].known = true
+		} else {
+			pending = true
+		}
+	}
+	for {
+		now := clock.Now()
+		for i, check := range w.config.Checks {
+			if states[i].next.After(now) {
+				continue
+			}
+			probeErr, err := w.probe(check)
+			if err != nil {
+				return err
+			}
+			if update(check, &states[i], probeErr, now) {
+				pending = true
+			}
+			states[i].next = now.Add(check.Interval)
+		}
+		if pending && allKnown(states) {
+			results := make([]Result, len(states))
+			for i, state := range states {
+				results[i] = state.Result
+			}
+			w.config.Notify(results)
+			pending = false
+		}
+
+		next := states[0].next
+		for _, state := range states[1:] {
+			if state.next.Before(next) {
+				next = state.next
+			}
+		}
+		select {
+		case <-w.catacomb.Dying():
+			return w.catacomb.ErrDying()
+		case <-clock.After(next.Sub(clock.Now())):
+		}
+	}
+}
+
+// probe runs the check's probe, and returns the error with which it
+// failed, if any. If the worker is stopped while the probe runs, the
+// probe is aborted and the catacomb's ErrDying is returned.
+func (w *healthChecker) probe(check Check) (probeErr error, err error) {
+	abort := make(chan struct{})
+	result := make(chan error, 1)
+	go func() {
+		result <- w.config.Prober.Probe(check, abort)
+	}()
+	select {
+	case <-w.catacomb.Dying():
+		close(abort)
+		return nil, w.catacomb.ErrDying()
+	case probeErr := <-result:
+		return probeErr, nil
+	}
+}
+
+// update records the result of a check's probe in its state, and
+// reports whether the check became healthy or unhealthy.
+func update(check Check, state *checkState, err error, now time.Time) bool {
+	if err == nil {
+		state.Failures = 0
+		state.Message = ""
+		if state.known && state.Healthy {
+			return false
+		}
+		logger.Debugf("health check %q is healthy", check.Name)
+		state.known = true
+		state.Healthy = true
+		state.Since = now
+		return true
+	}
+	state.Failures++
+	state.Message = err.Error()
+	logger.Debugf("health check %q failed (%d/%d): %v", check.Name, state.Failures, check.Threshold, err)
+	if state.Failures < check.Threshold || (state.known && !state.Healthy) {
+		return false
+	}
+	logger.Infof("health check %q is unhealthy: %v", check.Name, err)
+	state.known = true
+	state.Healthy = false
+	state.Since = now
+	return true
+}
+
+func allKnown(states []checkState) bool {
+	for _, state := range states {
+		if !state.known {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,286 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package healthcheck_test
+
+import (
+	"sync"
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+	"gopkg.in/juju/worker.v1"
+
+	coretesting "github.com/juju/juju/testing"
+	"github.com/juju/juju/worker/uniter/healthcheck"
+	"github.com/juju/juju/worker/workertest"
+)
+
+type WorkerSuite struct {
+	testing.IsolationSuite
+	clock    *testing.Clock
+	prober   *fakeProber
+	notified chan []healthcheck.Result
+	config   healthcheck.Config
+}
+
+var _ = gc.Suite(&WorkerSuite{})
+
+var start = time.Date(2018, 5, 1, 12, 0, 0, 0, time.UTC)
+
+func (s *WorkerSuite) SetUpTest(c *gc.C) {
+	s.IsolationSuite.SetUpTest(c)
+	s.clock = testing.NewClock(start)
+	s.prober = &fakeProber{
+		errors:  make(map[string]error),
+		probed:  make(chan string, 10),
+		aborted: make(chan struct{}),
+	}
+	s.notified = make(chan []healthcheck.Result, 10)
+	s.config = healthcheck.Config{
+		Checks: []healthcheck.Check{{
+			Name:      "database",
+			TCP:       &healthcheck.TCPProbe{Host: "localhost", Port: 5432},
+			Interval:  10 * time.Second,
+			Threshold: 2,
+		}, {
+			Name:      "website",
+			HTTP:      &healthcheck.HTTPProbe{URL: "http://localhost/"},
+			Interval:  20 * time.Second,
+			Threshold: 1,
+		}},
+		Prober: s.prober,
+		Clock:  s.clock,
+		Notify: func(results []healthcheck.Result) {
+			s.notified <- results
+		},
+	}
+}
+
+func (s *WorkerSuite) TestValidate(c *gc.C) {
+	s.testValidate(c, func(config *healthcheck.Config) {
+		config.Checks = nil
+	}, "empty Checks not valid")
+	s.testValidate(c, func(config *healthcheck.Config) {
+		config.Prober = nil
+	}, "nil Prober not valid")
+	s.testValidate(c, func(config *healthcheck.Config) {
+		config.Clock = nil
+	}, "nil Clock not valid")
+	s.testValidate(c, func(config *healthcheck.Config) {
+		config.Notify = nil
+	}, "nil Notify not valid")
+}
+
+func (s *WorkerSuite) testValidate(c *gc.C, mutate func(*healthcheck.Config), expect string) {
+	config := s.config
+	mutate(&config)
+	err := config.Validate()
+	c.Check(err, jc.Satisfies, errors.IsNotValid)
+	c.Check(err, gc.ErrorMatches, expect)
+	_, err = healthcheck.NewWorker(config)
+	c.Check(err, gc.ErrorMatches, expect)
+}
+
+func (s *WorkerSuite) startWorker(c *gc.C) worker.Worker {
+	w, err := healthcheck.NewWorker(s.config)
+	c.Assert(err, jc.ErrorIsNil)
+	s.AddCleanup(func(c *gc.C) { workertest.CleanKill(c, w) })
+	return w
+}
+
+func (s *WorkerSuite) TestNotifiesOnceAllChecksKnown(c *gc.C) {
+	s.prober.setError("database", errors.New("connection refused"))
+	s.startWorker(c)
+
+	// The website check is known to be healthy after the first
+	// probe, but the database check is not yet known to be unhealthy.
+	s.waitProbed(c, "database", "website")
+	s.assertNotNotified(c)
+
+	s.clock.WaitAdvance(10*time.Second, coretesting.LongWait, 1)
+	s.waitProbed(c, "database")
+	c.Assert(s.waitNotified(c), jc.DeepEquals, []healthcheck.Result{{
+		Name:     "database",
+		Failures: 2,
+		Message:  "connection refused",
+		Since:    start.Add(10 * time.Second),
+	}, {
+		Name:    "website",
+		Healthy: true,
+		Since:   start,
+	}})
+}
+
+func (s *WorkerSuite) TestNotifiesOnChange(c *gc.C) {
+	s.startWorker(c)
+	s.waitProbed(c, "database", "website")
+	c.Assert(s.waitNotified(c), gc.HasLen, 2)
+
+	// The website check fails once, which is enough to make it
+	// unhealthy.
+	s.prober.setError("website", errors.New("500 Internal Server Error"))
+	s.clock.WaitAdvance(10*time.Second, coretesting.LongWait, 1)
+	s.waitProbed(c, "database")
+	s.assertNotNotified(c)
+	s.clock.WaitAdvance(10*time.Second, coretesting.LongWait, 1)
+	s.waitProbed(c, "database", "website")
+	c.Assert(s.waitNotified(c), jc.DeepEquals, []healthcheck.Result{{
+		Name:    "database",
+		Healthy: true,
+		Since:   start,
+	}, {
+		Name:     "website",
+		Failures: 1,
+		Message:  "500 Internal Server Error",
+		Since:    start.Add(20 * time.Second),
+	}})
+
+	// Continued failures are not reported.
+	s.clock.WaitAdvance(20*time.Second, coretesting.LongWait, 1)
+	s.waitProbed(c, "database", "website")
+	s.assertNotNotified(c)
+
+	// The website check recovers as soon as it passes.
+	s.prober.setError("website", nil)
+	s.clock.WaitAdvance(20*time.Second, coretesting.LongWait, 1)
+	s.waitProbed(c, "database", "website")
+	results := s.waitNotified(c)
+	c.Assert(results, gc.HasLen, 2)
+	c.Assert(results[1], jc.DeepEquals, healthcheck.Result{
+		Name:    "website",
+		Healthy: true,
+		Since:   start.Add(60 * time.Second),
+	})
+}
+
+func (s *WorkerSuite) TestInitialStateNotNotified(c *gc.C) {
+	s.prober.setError("database", errors.New("connection refused"))
+	s.config.Initial = []healthcheck.Result{{
+		Name:     "database",
+		Failures: 2,
+		Message:  "connection refused",
+		Since:    start.Add(-time.Hour),
+	}, {
+		Name:    "website",
+		Healthy: true,
+		Since:   start.Add(-time.Hour),
+	}}
+	s.startWorker(c)
+
+	// Both checks are probed, but neither changes state.
+	s.waitProbed(c, "database", "website")
+	s.assertNotNotified(c)
+
+	s.prober.setError("database", nil)
+	s.clock.WaitAdvance(10*time.Second, coretesting.LongWait, 1)
+	s.waitProbed(c, "database")
+	c.Assert(s.waitNotified(c), jc.DeepEquals, []healthcheck.Result{{
+		Name:    "database",
+		Healthy: true,
+		Since:   start.Add(10 * time.Second),
+	}, {
+		Name:    "website",
+		Healthy: true,
+		Since:   start.Add(-time.Hour),
+	}})
+}
+
+func (s *WorkerSuite) TestInitialStateOtherChecksNotified(c *gc.C) {
+	s.config.Initial = []healthcheck.Result{{
+		Name:    "website",
+		Healthy: true,
+		Since:   start.Add(-time.Hour),
+	}}
+	s.startWorker(c)
+
+	// The database check was not reported before, so the
+	// checks are reported once it is known.
+	s.waitProbed(c, "database", "website")
+	c.Assert(s.waitNotified(c), jc.DeepEquals, []healthcheck.Result{{
+		Name:    "database",
+		Healthy: true,
+		Since:   start,
+	}, {
+		Name:    "website",
+		Healthy: true,
+		Since:   start.Add(-time.Hour),
+	}})
+}
+
+func (s *WorkerSuite) TestStopAbortsProbe(c *gc.C) {
+	s.prober.block = true
+	w := s.startWorker(c)
+	s.waitProbed(c, "database")
+
+	workertest.CleanKill(c, w)
+	select {
+	case <-s.prober.aborted:
+	case <-time.After(coretesting.LongWait):
+		c.Fatalf("timed out waiting for probe to be aborted")
+	}
+}
+
+func (s *WorkerSuite) waitProbed(c *gc.C, names ...string) {
+	var probed []string
+	for range names {
+		select {
+		case name := <-s.prober.probed:
+			probed = append(probed, name)
+		case <-time.After(coretesting.LongWait):
+			c.Fatalf("timed out waiting for probes; got %v", probed)
+		}
+	}
+	c.Assert(probed, jc.SameContents, names)
+}
+
+func (s *WorkerSuite) waitNotified(c *gc.C) []healthcheck.Result {
+	select {
+	case results := <-s.notified:
+		return results
+	case <-time.After(coretesting.LongWait):
+		c.Fatalf("timed out waiting for notification")
+	}
+	panic("unreachable")
+}
+
+func (s *WorkerSuite) assertNotNotified(c *gc.C) {
+	select {
+	case results := <-s.notified:
+		c.Fatalf("unexpected notification: %v", results)
+	case <-time.After(coretesting.ShortWait):
+	}
+}
+
+// fakeProber is a healthcheck.Prober whose probes fail with
+// the errors configured for each check by name. If block is
+// set, probes run until they are aborted.
+type fakeProber struct {
+	mu      sync.Mutex
+	errors  map[string]error
+	probed  chan string
+	block   bool
+	aborted chan struct{}
+}
+
+func (p *fakeProber) setError(name string, err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.errors[name] = err
+}
+
+// Probe is part of the healthcheck.Prober interface.
+func (p *fakeProber) Probe(check healthcheck.Check, abort <-chan struct{}) error {
+	p.mu.Lock()
+	err := p.errors[check.Name]
+	p.mu.Unlock()
+	p.probed <- check.Name
+	if p.block {
+		<-abort
+		close(p.aborted)
+		return errors.New("aborted")
+	}
+	return err
+}
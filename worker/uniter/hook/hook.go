@@ -16,8 +16,27 @@ const (
 	LeaderElected         hooks.Kind = "leader-elected"
 	LeaderDeposed         hooks.Kind = "leader-deposed"
 	LeaderSettingsChanged hooks.Kind = "leader-settings-changed"
+
+	// StorageResized is run when the storage attached to a unit
+	// has grown, so that the charm may grow the filesystem on it.
+	StorageResized hooks.Kind = "storage-resized"
+
+	// HealthChanged is run when one of the health checks declared
+	// by the charm becomes healthy or unhealthy.
+	HealthChanged hooks.Kind = "health-changed"
+
+	// Timer is run each time one of the timers registered by the
+	// charm with timer-set fires. The hook is named after the timer,
+	// so that a timer called "backup" runs the "timer-backup" hook.
+	Timer hooks.Kind = "timer"
 )
 
+// IsStorage returns whether the specified hook kind is a storage hook,
+// including those storage hooks not yet defined in charm/hooks.
+func IsStorage(kind hooks.Kind) bool {
+	return kind.IsStorage() || kind == StorageResized
+}
+
 // Info holds details required to execute a hook. Not all fields are
 // relevant to all Kind values.
 type Info struct {
@@ -31,18 +50,38 @@ type Info struct {
 	// set when Kind indicates a relation hook other than relation-broken.
 	RemoteUnit string `yaml:"remote-unit,omitempty"`
 
-	// ChangeVersion identifies the most recent unit settings change
-	// associated with RemoteUnit. It is only set when RemoteUnit is set.
+	// RemoteApplication is the name of the application whose settings
+	// change triggered the hook. It is only set for relation-changed
+	// hooks that have no RemoteUnit.
+	RemoteApplication string `yaml:"remote-application,omitempty"`
+
+	// ChangeVersion identifies the most recent settings change
+	// associated with RemoteUnit or RemoteApplication. It is only
+	// set when one of those is set.
 	ChangeVersion int64 `yaml:"change-version,omitempty"`
 
 	// StorageId is the ID of the storage instance relevant to the hook.
 	StorageId string `yaml:"storage-id,omitempty"`
+
+	// StorageSize is the size in MiB of the storage instance when the
+	// hook was queued. It is only set for storage-attached and
+	// storage-resized hooks.
+	StorageSize uint64 `yaml:"storage-size,omitempty"`
+
+	// TimerName is the name of the timer that fired. It is only set
+	// for timer hooks.
+	TimerName string `yaml:"timer-name,omitempty"`
 }
 
 // Validate returns an error if the info is not valid.
 func (hi Info) Validate() error {
 	switch hi.Kind {
-	case hooks.RelationJoined, hooks.RelationChanged, hooks.RelationDeparted:
+	case hooks.RelationChanged:
+		if hi.RemoteUnit == "" && hi.RemoteApplication == "" {
+			return fmt.Errorf("%q hook requires a remote unit or application", hi.Kind)
+		}
+		return nil
+	case hooks.RelationJoined, hooks.RelationDeparted:
 		if hi.RemoteUnit == "" {
 			return fmt.Errorf("%q hook requires a remote unit", hi.Kind)
 		}
@@ -52,13 +91,18 @@ func (hi Info) Validate() error {
 		return nil
 	case hooks.Action:
 		return fmt.Errorf("hooks.Kind Action is deprecated")
-	case hooks.StorageAttached, hooks.StorageDetaching:
+	case hooks.StorageAttached, hooks.StorageDetaching, StorageResized:
 		if !names.IsValidStorage(hi.StorageId) {
 			return fmt.Errorf("invalid storage ID %q", hi.StorageId)
 		}
 		return nil
+	case Timer:
+		if hi.TimerName == "" {
+			return fmt.Errorf("%q hook requires a timer name", hi.Kind)
+		}
+		return nil
 	// TODO(fwereade): define these in charm/hooks...
-	case LeaderElected, LeaderDeposed, LeaderSettingsChanged:
+	case LeaderElected, LeaderDeposed, LeaderSettingsChanged, HealthChanged:
 		return nil
 	}
 	return fmt.Errorf("unknown hook kind %q", hi.Kind)
@@ -27,7 +27,7 @@ var validateTests = []struct {
 		`"relation-joined" hook requires a remote unit`,
 	}, {
 		hook.Info{Kind: hooks.RelationChanged},
-		`"relation-changed" hook requires a remote unit`,
+		`"relation-changed" hook requires a remote unit or application`,
 	}, {
 		hook.Info{Kind: hooks.RelationDeparted},
 		`"relation-departed" hook requires a remote unit`,
@@ -45,11 +45,18 @@ var validateTests = []struct {
 	{hook.Info{Kind: hooks.Stop}, ""},
 	{hook.Info{Kind: hooks.RelationJoined, RemoteUnit: "x"}, ""},
 	{hook.Info{Kind: hooks.RelationChanged, RemoteUnit: "x"}, ""},
+	{hook.Info{Kind: hooks.RelationChanged, RemoteApplication: "x"}, ""},
+	{hook.Info{Kind: hooks.RelationJoined, RemoteApplication: "x"}, `"relation-joined" hook requires a remote unit`},
 	{hook.Info{Kind: hooks.RelationDeparted, RemoteUnit: "x"}, ""},
 	{hook.Info{Kind: hooks.RelationBroken}, ""},
 	{hook.Info{Kind: hooks.StorageAttached}, `invalid storage ID ""`},
 	{hook.Info{Kind: hooks.StorageAttached, StorageId: "data/0"}, ""},
 	{hook.Info{Kind: hooks.StorageDetaching, StorageId: "data/0"}, ""},
+	{hook.Info{Kind: hook.StorageResized}, `invalid storage ID ""`},
+	{hook.Info{Kind: hook.StorageResized, StorageId: "data/0"}, ""},
+	{hook.Info{Kind: hook.HealthChanged}, ""},
+	{hook.Info{Kind: hook.Timer}, `"timer" hook requires a timer name`},
+	{hook.Info{Kind: hook.Timer, TimerName: "backup"}, ""},
 }
 
 func (s *InfoSuite) TestValidate(c *gc.C) {
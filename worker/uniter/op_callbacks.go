@@ -5,6 +5,7 @@ package uniter
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/juju/errors"
 	corecharm "gopkg.in/juju/charm.v6"
@@ -34,7 +35,7 @@ func (opc *operationCallbacks) PrepareHook(hi hook.Info) (string, error) {
 		if err != nil {
 			return "", err
 		}
-	case hi.Kind.IsStorage():
+	case hook.IsStorage(hi.Kind):
 		if err := opc.u.storage.ValidateHook(hi); err != nil {
 			return "", err
 		}
@@ -45,6 +46,8 @@ func (opc *operationCallbacks) PrepareHook(hi hook.Info) (string, error) {
 		name = fmt.Sprintf("%s-%s", storageName, hi.Kind)
 		// TODO(axw) if the agent is not installed yet,
 		// set the status to "preparing storage".
+	case hi.Kind == hook.Timer:
+		name = fmt.Sprintf("%s-%s", hi.Kind, hi.TimerName)
 	case hi.Kind == hooks.ConfigChanged:
 		// TODO(axw)
 		//opc.u.f.DiscardConfigEvent()
@@ -60,8 +63,14 @@ func (opc *operationCallbacks) CommitHook(hi hook.Info) error {
 	switch {
 	case hi.Kind.IsRelation():
 		return opc.u.relations.CommitHook(hi)
-	case hi.Kind.IsStorage():
+	case hook.IsStorage(hi.Kind):
 		return opc.u.storage.CommitHook(hi)
+	case hi.Kind == hook.Timer:
+		return opc.u.timers.CommitTimer(hi.TimerName)
+	case hi.Kind == hooks.Install:
+		// The charm is deployed and installed, so its health
+		// checks can now be run.
+		return opc.u.restartHealthChecker()
 	}
 	return nil
 }
@@ -86,11 +95,18 @@ func (opc *operationCallbacks) NotifyHookCompleted(hook string, ctx runner.Conte
 
 // NotifyHookFailed is part of the operation.Callbacks interface.
 func (opc *operationCallbacks) NotifyHookFailed(hook string, ctx runner.Context) {
+	opc.u.timedOutHook = ""
 	if opc.u.observer != nil {
 		notifyHook(hook, ctx, opc.u.observer.HookFailed)
 	}
 }
 
+// NotifyHookTimedOut is part of the operation.Callbacks interface.
+func (opc *operationCallbacks) NotifyHookTimedOut(hook string, timeout time.Duration) {
+	opc.u.timedOutHook = hook
+	opc.u.hookTimeout = timeout
+}
+
 // FailAction is part of the operation.Callbacks interface.
 func (opc *operationCallbacks) FailAction(actionId, message string) error {
 	if !names.IsValidAction(actionId) {
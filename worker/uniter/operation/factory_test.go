@@ -193,6 +193,15 @@ func (s *FactorySuite) TestNewHookString_Run(c *gc.C) {
 	c.Check(op.String(), gc.Equals, "run install hook")
 }
 
+func (s *FactorySuite) TestNewHookString_Timer(c *gc.C) {
+	op, err := s.factory.NewRunHook(hook.Info{
+		Kind:      hook.Timer,
+		TimerName: "backup",
+	})
+	c.Check(err, jc.ErrorIsNil)
+	c.Check(op.String(), gc.Equals, "run timer (backup) hook")
+}
+
 func (s *FactorySuite) TestNewHookString_Skip(c *gc.C) {
 	op, err := s.factory.NewSkipHook(hook.Info{
 		Kind:       hooks.RelationJoined,
@@ -0,0 +1,86 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package operation
+
+import (
+	"time"
+
+	"github.com/juju/utils/clock"
+)
+
+// HistoryEntry describes an operation run by an Executor.
+type HistoryEntry struct {
+	// Operation is the operation's description, as returned
+	// by its String method.
+	Operation string
+
+	// Hook is the name of the hook run by the operation, if any.
+	Hook string
+
+	// RelationId is the id of the relation for a relation hook,
+	// and -1 otherwise.
+	RelationId int
+
+	// RemoteUnit is the name of the remote unit for a relation
+	// hook, if any.
+	RemoteUnit string
+
+	// Started and Completed record when the operation ran.
+	Started   time.Time
+	Completed time.Time
+
+	// Err holds the error returned by the run, if any.
+	Err error
+}
+
+// HistoryRecorder is called with each operation run by an Executor
+// returned from NewHistoryExecutor.
+type HistoryRecorder func(HistoryEntry)
+
+// NewHistoryExecutor returns an Executor that runs operations with the
+// supplied Executor, and passes each one that runs charm code, together
+// with its outcome, to record. Only operations that require the machine
+// lock run charm code.
+func NewHistoryExecutor(executor Executor, clock clock.Clock, record HistoryRecorder) Executor {
+	return &historyExecutor{
+		Executor: executor,
+		clock:    clock,
+		record:   record,
+	}
+}
+
+type historyExecutor struct {
+	Executor
+	clock  clock.Clock
+	record HistoryRecorder
+}
+
+// Run is part of the Executor interface.
+func (x *historyExecutor) Run(op Operation) error {
+	if !op.NeedsGlobalMachineLock() {
+		return x.Executor.Run(op)
+	}
+	started := x.clock.Now()
+	err := x.Executor.Run(op)
+	entry := HistoryEntry{
+		Operation:  op.String(),
+		RelationId: -1,
+		Started:    started,
+		Completed:  x.clock.Now(),
+		Err:        err,
+	}
+	if rh, ok := op.(*runHook); ok {
+		// The hook's name is only known once it has been prepared.
+		entry.Hook = rh.name
+		if entry.Hook == "" {
+			entry.Hook = string(rh.info.Kind)
+		}
+		if rh.info.Kind.IsRelation() {
+			entry.RelationId = rh.info.RelationId
+			entry.RemoteUnit = rh.info.RemoteUnit
+		}
+	}
+	x.record(entry)
+	return err
+}
@@ -0,0 +1,99 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package operation_test
+
+import (
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+	"gopkg.in/juju/charm.v6/hooks"
+
+	"github.com/juju/juju/worker/uniter/hook"
+	"github.com/juju/juju/worker/uniter/operation"
+)
+
+type HistoryExecutorSuite struct {
+	testing.IsolationSuite
+	clock    *testing.Clock
+	executor *runningExecutor
+	recorded []operation.HistoryEntry
+}
+
+var _ = gc.Suite(&HistoryExecutorSuite{})
+
+func (s *HistoryExecutorSuite) SetUpTest(c *gc.C) {
+	s.IsolationSuite.SetUpTest(c)
+	s.clock = testing.NewClock(time.Date(2018, 5, 1, 12, 0, 0, 0, time.UTC))
+	s.executor = &runningExecutor{clock: s.clock}
+	s.recorded = nil
+}
+
+func (s *HistoryExecutorSuite) newExecutor() operation.Executor {
+	return operation.NewHistoryExecutor(s.executor, s.clock, func(entry operation.HistoryEntry) {
+		s.recorded = append(s.recorded, entry)
+	})
+}
+
+func (s *HistoryExecutorSuite) TestRunRecordsOperation(c *gc.C) {
+	s.executor.err = errors.New("pow")
+	op := &mockOperation{needsLock: true}
+
+	err := s.newExecutor().Run(op)
+	c.Assert(err, gc.Equals, s.executor.err)
+	c.Assert(s.executor.ran, jc.DeepEquals, []operation.Operation{op})
+	c.Assert(s.recorded, jc.DeepEquals, []operation.HistoryEntry{{
+		Operation:  "mock operation",
+		RelationId: -1,
+		Started:    time.Date(2018, 5, 1, 12, 0, 0, 0, time.UTC),
+		Completed:  time.Date(2018, 5, 1, 12, 0, 1, 0, time.UTC),
+		Err:        s.executor.err,
+	}})
+}
+
+func (s *HistoryExecutorSuite) TestRunSkipsOperationsWithoutCharmCode(c *gc.C) {
+	op := &mockOperation{needsLock: false}
+
+	err := s.newExecutor().Run(op)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(s.executor.ran, jc.DeepEquals, []operation.Operation{op})
+	c.Assert(s.recorded, gc.HasLen, 0)
+}
+
+func (s *HistoryExecutorSuite) TestRunRecordsHook(c *gc.C) {
+	factory := operation.NewFactory(operation.FactoryParams{})
+	op, err := factory.NewRunHook(hook.Info{
+		Kind:       hooks.RelationChanged,
+		RelationId: 1,
+		RemoteUnit: "mysql/0",
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = s.newExecutor().Run(op)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(s.recorded, gc.HasLen, 1)
+	entry := s.recorded[0]
+	c.Check(entry.Operation, gc.Equals, op.String())
+	c.Check(entry.Hook, gc.Equals, "relation-changed")
+	c.Check(entry.RelationId, gc.Equals, 1)
+	c.Check(entry.RemoteUnit, gc.Equals, "mysql/0")
+	c.Check(entry.Err, jc.ErrorIsNil)
+}
+
+// runningExecutor is an operation.Executor whose Run records the
+// operations it is given, and takes a second of clock time.
+type runningExecutor struct {
+	operation.Executor
+	clock *testing.Clock
+	ran   []operation.Operation
+	err   error
+}
+
+func (x *runningExecutor) Run(op operation.Operation) error {
+	x.ran = append(x.ran, op)
+	x.clock.Advance(time.Second)
+	return x.err
+}
@@ -4,6 +4,8 @@
 package operation
 
 import (
+	"time"
+
 	"github.com/juju/loggo"
 	utilexec "github.com/juju/utils/exec"
 	corecharm "gopkg.in/juju/charm.v6"
@@ -149,6 +151,11 @@ type Callbacks interface {
 	NotifyHookCompleted(string, runner.Context)
 	NotifyHookFailed(string, runner.Context)
 
+	// NotifyHookTimedOut is called after NotifyHookFailed when the hook
+	// failed because it was killed after running for longer than the
+	// given timeout. It's only used by RunHook operations.
+	NotifyHookTimedOut(hookName string, timeout time.Duration)
+
 	// The following methods exist primarily to allow us to test operation code
 	// without using a live api connection.
 
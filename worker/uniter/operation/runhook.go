@@ -40,8 +40,10 @@ func (rh *runHook) String() string {
 		} else {
 			suffix = fmt.Sprintf(" (%d; %s)", rh.info.RelationId, rh.info.RemoteUnit)
 		}
-	case rh.info.Kind.IsStorage():
+	case hook.IsStorage(rh.info.Kind):
 		suffix = fmt.Sprintf(" (%s)", rh.info.StorageId)
+	case rh.info.Kind == hook.Timer:
+		suffix = fmt.Sprintf(" (%s)", rh.info.TimerName)
 	}
 	return fmt.Sprintf("run %s%s hook", rh.info.Kind, suffix)
 }
@@ -113,6 +115,9 @@ func (rh *runHook) Execute(state State) (*State, error) {
 	default:
 		logger.Errorf("hook %q failed: %v", rh.name, err)
 		rh.callbacks.NotifyHookFailed(rh.name, rh.runner.Context())
+		if charmrunner.IsHookTimeoutError(cause) {
+			rh.callbacks.NotifyHookTimedOut(rh.name, rh.runner.Context().HookTimeout())
+		}
 		return nil, ErrHookFailed
 	}
 
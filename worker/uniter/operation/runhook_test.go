@@ -4,6 +4,8 @@
 package operation_test
 
 import (
+	"time"
+
 	"github.com/juju/errors"
 	"github.com/juju/testing"
 	jc "github.com/juju/testing/checkers"
@@ -272,6 +274,23 @@ func (s *RunHookSuite) TestExecuteOtherError(c *gc.C) {
 	c.Assert(*callbacks.MockNotifyHookFailed.gotName, gc.Equals, "some-hook-name")
 	c.Assert(*callbacks.MockNotifyHookFailed.gotContext, gc.Equals, runnerFactory.MockNewHookRunner.runner.context)
 	c.Assert(callbacks.MockNotifyHookCompleted.gotName, gc.IsNil)
+	c.Assert(callbacks.gotHookTimeout, gc.IsNil)
+}
+
+func (s *RunHookSuite) TestExecuteTimeoutError(c *gc.C) {
+	runErr := charmrunner.NewHookTimeoutError("some-hook-name", 5*time.Minute)
+	op, callbacks, runnerFactory := s.getExecuteRunnerTest(c, (operation.Factory).NewRunHook, hooks.ConfigChanged, runErr)
+	runnerFactory.MockNewHookRunner.runner.context.(*MockContext).hookTimeout = 5 * time.Minute
+	_, err := op.Prepare(operation.State{})
+	c.Assert(err, jc.ErrorIsNil)
+
+	newState, err := op.Execute(operation.State{})
+	c.Assert(err, gc.Equals, operation.ErrHookFailed)
+	c.Assert(newState, gc.IsNil)
+	c.Assert(*callbacks.MockNotifyHookFailed.gotName, gc.Equals, "some-hook-name")
+	c.Assert(callbacks.MockNotifyHookCompleted.gotName, gc.IsNil)
+	c.Assert(callbacks.gotHookTimeout, gc.NotNil)
+	c.Assert(*callbacks.gotHookTimeout, gc.Equals, 5*time.Minute)
 }
 
 func (s *RunHookSuite) TestInstallHookPreservesStatus(c *gc.C) {
@@ -4,6 +4,8 @@
 package operation_test
 
 import (
+	"time"
+
 	"github.com/juju/errors"
 	"github.com/juju/testing"
 	utilexec "github.com/juju/utils/exec"
@@ -178,6 +180,7 @@ type ExecuteHookCallbacks struct {
 	*PrepareHookCallbacks
 	MockNotifyHookCompleted *MockNotify
 	MockNotifyHookFailed    *MockNotify
+	gotHookTimeout          *time.Duration
 }
 
 func (cb *ExecuteHookCallbacks) NotifyHookCompleted(hookName string, ctx runner.Context) {
@@ -188,6 +191,10 @@ func (cb *ExecuteHookCallbacks) NotifyHookFailed(hookName string, ctx runner.Con
 	cb.MockNotifyHookFailed.Call(hookName, ctx)
 }
 
+func (cb *ExecuteHookCallbacks) NotifyHookTimedOut(hookName string, timeout time.Duration) {
+	cb.gotHookTimeout = &timeout
+}
+
 type MockCommitHook struct {
 	gotHook *hook.Info
 	err     error
@@ -266,6 +273,7 @@ type MockContext struct {
 	status          jujuc.StatusInfo
 	isLeader        bool
 	relation        *MockRelation
+	hookTimeout     time.Duration
 }
 
 func (mock *MockContext) ActionData() (*context.ActionData, error) {
@@ -275,6 +283,10 @@ func (mock *MockContext) ActionData() (*context.ActionData, error) {
 	return mock.actionData, nil
 }
 
+func (mock *MockContext) HookTimeout() time.Duration {
+	return mock.hookTimeout
+}
+
 func (mock *MockContext) HasExecutionSetUnitStatus() bool {
 	return mock.setStatusCalled
 }
@@ -360,6 +360,25 @@ func nextRelationHook(
 		}
 	}
 
+	// Then scan for remote applications whose latest settings version
+	// is not reflected in local state.
+	appNames := set.NewStrings()
+	for appName := range remote.ApplicationMembers {
+		appNames.Add(appName)
+	}
+	for _, appName := range appNames.SortedValues() {
+		remoteChangeVersion := remote.ApplicationMembers[appName]
+		if localChangeVersion, found := local.ApplicationMembers[appName]; found && localChangeVersion == remoteChangeVersion {
+			continue
+		}
+		return hook.Info{
+			Kind:              hooks.RelationChanged,
+			RelationId:        relationId,
+			RemoteApplication: appName,
+			ChangeVersion:     remoteChangeVersion,
+		}, nil
+	}
+
 	// Nothing left to do for this relation.
 	return hook.Info{}, resolver.ErrNoOperation
 }
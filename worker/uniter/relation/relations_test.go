@@ -430,6 +430,48 @@ func (s *relationsSuite) TestHookRelationChanged(c *gc.C) {
 	}, &numCalls)
 }
 
+func (s *relationsSuite) TestHookRelationChangedApplication(c *gc.C) {
+	var numCalls int32
+	apiCalls := relationJoinedAPICalls()
+	r := s.assertHookRelationJoined(c, &numCalls, apiCalls...)
+	s.assertHookRelationChanged(c, r, remotestate.RelationSnapshot{
+		Life: params.Alive,
+	}, &numCalls)
+
+	// A change to the remote application's settings triggers a
+	// relation-changed hook with no remote unit.
+	snapshot := remotestate.RelationSnapshot{
+		Life:               params.Alive,
+		Members:            map[string]int64{"wordpress": 1},
+		ApplicationMembers: map[string]int64{"wordpress": 3},
+	}
+	s.assertHookRelationChanged(c, r, snapshot, &numCalls)
+
+	// Once committed, there is nothing more to do.
+	localState := resolver.LocalState{
+		State: operation.State{
+			Kind: operation.Continue,
+		},
+	}
+	remoteState := remotestate.Snapshot{
+		Relations: map[int]remotestate.RelationSnapshot{1: snapshot},
+	}
+	relationsResolver := relation.NewRelationsResolver(r)
+	_, err := relationsResolver.NextOp(localState, remoteState, &mockOperations{})
+	c.Assert(errors.Cause(err), gc.Equals, resolver.ErrNoOperation)
+
+	snapshot.ApplicationMembers = map[string]int64{"wordpress": 4}
+	remoteState.Relations[1] = snapshot
+	op, err := relationsResolver.NextOp(localState, remoteState, &mockOperations{})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(op.(*mockOperation).hookInfo, gc.DeepEquals, hook.Info{
+		Kind:              hooks.RelationChanged,
+		RelationId:        1,
+		RemoteApplication: "wordpress",
+		ChangeVersion:     4,
+	})
+}
+
 func (s *relationsSuite) TestHookRelationChangedSuspended(c *gc.C) {
 	var numCalls int32
 	apiCalls := relationJoinedAPICalls()
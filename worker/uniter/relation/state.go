@@ -32,6 +32,11 @@ type State struct {
 	// ChangedPending indicates that a "relation-changed" hook for the given
 	// unit name must be the first hook.Info to be sent to the output channel.
 	ChangedPending string
+
+	// ApplicationMembers is a map from application name to the last
+	// change version of its settings for which a hook.Info was delivered
+	// on the output channel.
+	ApplicationMembers map[string]int64
 }
 
 // copy returns an independent copy of the state.
@@ -46,6 +51,12 @@ func (s *State) copy() *State {
 			copy.Members[m] = v
 		}
 	}
+	if s.ApplicationMembers != nil {
+		copy.ApplicationMembers = map[string]int64{}
+		for a, v := range s.ApplicationMembers {
+			copy.ApplicationMembers[a] = v
+		}
+	}
 	return copy
 }
 
@@ -54,7 +65,11 @@ func (s *State) copy() *State {
 // against the current state before they are run, to ensure that the system
 // meets its guarantees about hook execution order.
 func (s *State) Validate(hi hook.Info) (err error) {
-	defer errors.DeferredAnnotatef(&err, "inappropriate %q for %q", hi.Kind, hi.RemoteUnit)
+	remote := hi.RemoteUnit
+	if remote == "" {
+		remote = hi.RemoteApplication
+	}
+	defer errors.DeferredAnnotatef(&err, "inappropriate %q for %q", hi.Kind, remote)
 	if hi.RelationId != s.RelationId {
 		return fmt.Errorf("expected relation %d, got relation %d", s.RelationId, hi.RelationId)
 	}
@@ -72,6 +87,9 @@ func (s *State) Validate(hi hook.Info) (err error) {
 		if unit != s.ChangedPending || kind != hooks.RelationChanged {
 			return fmt.Errorf(`expected "relation-changed" for %q`, s.ChangedPending)
 		}
+	} else if unit == "" && kind == hooks.RelationChanged {
+		// The settings of the remote application changed.
+		return nil
 	} else if _, joined := s.Members[unit]; joined && kind == hooks.RelationJoined {
 		return fmt.Errorf("unit already joined")
 	} else if !joined && kind != hooks.RelationJoined {
@@ -104,7 +122,7 @@ func (d *StateDir) State() *State {
 func ReadStateDir(dirPath string, relationId int) (d *StateDir, err error) {
 	d = &StateDir{
 		filepath.Join(dirPath, strconv.Itoa(relationId)),
-		State{relationId, map[string]int64{}, ""},
+		State{RelationId: relationId, Members: map[string]int64{}},
 	}
 	defer errors.DeferredAnnotatef(&err, "cannot load relation state from %q", d.path)
 	if _, err := os.Stat(d.path); os.IsNotExist(err) {
@@ -118,8 +136,15 @@ func ReadStateDir(dirPath string, relationId int) (d *StateDir, err error) {
 	}
 	for _, fi := range fis {
 		// Entries with names ending in "-" followed by an integer must be
-		// files containing valid unit data; all other names are ignored.
+		// files containing valid unit data; all other names are ignored,
+		// apart from the file holding application data.
 		name := fi.Name()
+		if name == applicationsFile {
+			if err = utils.ReadYaml(filepath.Join(d.path, name), &d.state.ApplicationMembers); err != nil {
+				return nil, fmt.Errorf("invalid applications file: %v", err)
+			}
+			continue
+		}
 		i := strings.LastIndex(name, "-")
 		if i == -1 {
 			continue
@@ -195,6 +220,10 @@ func (d *StateDir) Exists() bool {
 // Write doesn't validate hi but guarantees that successive writes of
 // the same hi are idempotent.
 func (d *StateDir) Write(hi hook.Info) (err error) {
+	if hi.RemoteUnit == "" && hi.RemoteApplication != "" {
+		defer errors.DeferredAnnotatef(&err, "failed to write %q hook info for %q on state directory", hi.Kind, hi.RemoteApplication)
+		return d.writeApplication(hi)
+	}
 	defer errors.DeferredAnnotatef(&err, "failed to write %q hook info for %q on state directory", hi.Kind, hi.RemoteUnit)
 	if hi.Kind == hooks.RelationBroken {
 		return d.Remove()
@@ -223,16 +252,42 @@ func (d *StateDir) Write(hi hook.Info) (err error) {
 	return nil
 }
 
-// Remove removes the directory if it exists and is empty.
+// writeApplication writes to disk the change to the settings of the
+// remote application in hi.
+func (d *StateDir) writeApplication(hi hook.Info) error {
+	applicationMembers := map[string]int64{hi.RemoteApplication: hi.ChangeVersion}
+	for appName, version := range d.state.ApplicationMembers {
+		if appName != hi.RemoteApplication {
+			applicationMembers[appName] = version
+		}
+	}
+	if err := utils.WriteYaml(filepath.Join(d.path, applicationsFile), applicationMembers); err != nil {
+		return err
+	}
+	// If write was successful, update own state.
+	d.state.ApplicationMembers = applicationMembers
+	return nil
+}
+
+// Remove removes the directory if it exists and holds no unit data.
 func (d *StateDir) Remove() error {
+	if err := os.Remove(filepath.Join(d.path, applicationsFile)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
 	if err := os.Remove(d.path); err != nil && !os.IsNotExist(err) {
 		return err
 	}
 	// If atomic delete succeeded, update own state.
 	d.state.Members = nil
+	d.state.ApplicationMembers = nil
 	return nil
 }
 
+// applicationsFile is the name of the file, within a relation's state
+// directory, that records the change versions of remote applications'
+// settings. It cannot be mistaken for a unit file.
+const applicationsFile = "applications"
+
 // diskInfo defines the relation unit data serialization.
 type diskInfo struct {
 	ChangeVersion  *int64 `yaml:"change-version"`
@@ -357,3 +357,54 @@ func assertState(c *gc.C, dir *relation.StateDir, relsdir string, relationId int
 		c.Assert(fresh.State(), gc.DeepEquals, expect)
 	}
 }
+
+func (s *StateDirSuite) TestWriteApplication(c *gc.C) {
+	basedir := c.MkDir()
+	setUpDir(c, basedir, "123", map[string]string{
+		"foo-1": "change-version: 0\n",
+	})
+	dir, err := relation.ReadStateDir(basedir, 123)
+	c.Assert(err, jc.ErrorIsNil)
+
+	for _, hi := range []hook.Info{
+		{Kind: hooks.RelationChanged, RelationId: 123, RemoteApplication: "foo", ChangeVersion: 3},
+		{Kind: hooks.RelationChanged, RelationId: 123, RemoteApplication: "bar", ChangeVersion: 1},
+		{Kind: hooks.RelationChanged, RelationId: 123, RemoteApplication: "foo", ChangeVersion: 4},
+	} {
+		err = dir.State().Validate(hi)
+		c.Assert(err, jc.ErrorIsNil)
+		err = dir.Write(hi)
+		c.Assert(err, jc.ErrorIsNil)
+	}
+	expect := &relation.State{
+		RelationId:         123,
+		Members:            map[string]int64{"foo/1": 0},
+		ApplicationMembers: map[string]int64{"foo": 4, "bar": 1},
+	}
+	c.Assert(dir.State(), gc.DeepEquals, expect)
+	fresh, err := relation.ReadStateDir(basedir, 123)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(fresh.State(), gc.DeepEquals, expect)
+
+	// Application changes must wait for a pending unit change.
+	err = dir.Write(hook.Info{Kind: hooks.RelationJoined, RelationId: 123, RemoteUnit: "foo/2"})
+	c.Assert(err, jc.ErrorIsNil)
+	err = dir.State().Validate(hook.Info{
+		Kind: hooks.RelationChanged, RelationId: 123, RemoteApplication: "foo", ChangeVersion: 5,
+	})
+	c.Assert(err, gc.ErrorMatches, `inappropriate "relation-changed" for "foo": expected "relation-changed" for "foo/2"`)
+}
+
+func (s *StateDirSuite) TestRemoveWithApplications(c *gc.C) {
+	basedir := c.MkDir()
+	setUpDir(c, basedir, "123", map[string]string{
+		"applications": "foo: 3\n",
+	})
+	dir, err := relation.ReadStateDir(basedir, 123)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(dir.State().ApplicationMembers, gc.DeepEquals, map[string]int64{"foo": 3})
+
+	err = dir.Write(hook.Info{Kind: hooks.RelationBroken, RelationId: 123})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(dir.Exists(), jc.IsFalse)
+}
@@ -61,6 +61,14 @@ type Snapshot struct {
 	// update-status hook is supposed to run.
 	UpdateStatusVersion int
 
+	// HealthVersion increments each time one of the
+	// charm's health checks becomes healthy or unhealthy.
+	HealthVersion int
+
+	// TimerVersions holds, for each timer registered by the charm,
+	// a version that increments each time the timer fires.
+	TimerVersions map[string]int
+
 	// Actions is the list of pending actions to
 	// be performed by this unit.
 	Actions []string
@@ -77,6 +85,11 @@ type RelationSnapshot struct {
 	Life      params.Life
 	Suspended bool
 	Members   map[string]int64
+
+	// ApplicationMembers holds the settings version of each
+	// counterpart application that has published settings in
+	// the relation.
+	ApplicationMembers map[string]int64
 }
 
 // StorageSnapshot has information relating to a storage
@@ -86,4 +99,5 @@ type StorageSnapshot struct {
 	Life     params.Life
 	Attached bool
 	Location string
+	Size     uint64
 }
@@ -38,6 +38,8 @@ type RemoteStateWatcher struct {
 	updateStatusChannel       UpdateStatusTimerFunc
 	commandChannel            <-chan string
 	retryHookChannel          watcher.NotifyChannel
+	healthChangedChannel      watcher.NotifyChannel
+	timerChannel              <-chan string
 	applicationChannel        watcher.NotifyChannel
 
 	catacomb catacomb.Catacomb
@@ -50,14 +52,16 @@ type RemoteStateWatcher struct {
 // WatcherConfig holds configuration parameters for the
 // remote state watcher.
 type WatcherConfig struct {
-	State               State
-	LeadershipTracker   leadership.Tracker
-	UpdateStatusChannel UpdateStatusTimerFunc
-	CommandChannel      <-chan string
-	RetryHookChannel    watcher.NotifyChannel
-	ApplicationChannel  watcher.NotifyChannel
-	UnitTag             names.UnitTag
-	ModelType           model.ModelType
+	State                State
+	LeadershipTracker    leadership.Tracker
+	UpdateStatusChannel  UpdateStatusTimerFunc
+	CommandChannel       <-chan string
+	RetryHookChannel     watcher.NotifyChannel
+	HealthChangedChannel watcher.NotifyChannel
+	TimerChannel         <-chan string
+	ApplicationChannel   watcher.NotifyChannel
+	UnitTag              names.UnitTag
+	ModelType            model.ModelType
 }
 
 func (w WatcherConfig) validate() error {
@@ -83,6 +87,8 @@ func NewWatcher(config WatcherConfig) (*RemoteStateWatcher, error) {
 		updateStatusChannel:       config.UpdateStatusChannel,
 		commandChannel:            config.CommandChannel,
 		retryHookChannel:          config.RetryHookChannel,
+		healthChangedChannel:      config.HealthChangedChannel,
+		timerChannel:              config.TimerChannel,
 		applicationChannel:        config.ApplicationChannel,
 		modelType:                 config.ModelType,
 		// Note: it is important that the out channel be buffered!
@@ -91,8 +97,9 @@ func NewWatcher(config WatcherConfig) (*RemoteStateWatcher, error) {
 		// so that we coalesce events while the observer is busy.
 		out: make(chan struct{}, 1),
 		current: Snapshot{
-			Relations: make(map[int]RelationSnapshot),
-			Storage:   make(map[names.StorageTag]StorageSnapshot),
+			Relations:     make(map[int]RelationSnapshot),
+			Storage:       make(map[names.StorageTag]StorageSnapshot),
+			TimerVersions: make(map[string]int),
 		},
 	}
 	err := catacomb.Invoke(catacomb.Plan{
@@ -128,19 +135,27 @@ func (w *RemoteStateWatcher) Snapshot() Snapshot {
 	snapshot.Relations = make(map[int]RelationSnapshot)
 	for id, relationSnapshot := range w.current.Relations {
 		relationSnapshotCopy := RelationSnapshot{
-			Life:      relationSnapshot.Life,
-			Suspended: relationSnapshot.Suspended,
-			Members:   make(map[string]int64),
+			Life:               relationSnapshot.Life,
+			Suspended:          relationSnapshot.Suspended,
+			Members:            make(map[string]int64),
+			ApplicationMembers: make(map[string]int64),
 		}
 		for name, version := range relationSnapshot.Members {
 			relationSnapshotCopy.Members[name] = version
 		}
+		for name, version := range relationSnapshot.ApplicationMembers {
+			relationSnapshotCopy.ApplicationMembers[name] = version
+		}
 		snapshot.Relations[id] = relationSnapshotCopy
 	}
 	snapshot.Storage = make(map[names.StorageTag]StorageSnapshot)
 	for tag, storageSnapshot := range w.current.Storage {
 		snapshot.Storage[tag] = storageSnapshot
 	}
+	snapshot.TimerVersions = make(map[string]int)
+	for name, version := range w.current.TimerVersions {
+		snapshot.TimerVersions[name] = version
+	}
 	snapshot.Actions = make([]string, len(w.current.Actions))
 	copy(snapshot.Actions, w.current.Actions)
 	snapshot.Commands = make([]string, len(w.current.Commands))
@@ -530,6 +545,24 @@ func (w *RemoteStateWatcher) loop(unitTag names.UnitTag) (err error) {
 			if err := w.retryHookTimerTriggered(); err != nil {
 				return err
 			}
+
+		case _, ok := <-w.healthChangedChannel:
+			if !ok {
+				return errors.New("healthChangedChannel closed")
+			}
+			logger.Debugf("health changed")
+			if err := w.healthChanged(); err != nil {
+				return err
+			}
+
+		case name, ok := <-w.timerChannel:
+			if !ok {
+				return errors.New("timerChannel closed")
+			}
+			logger.Debugf("timer %q fired", name)
+			if err := w.timerFired(name); err != nil {
+				return err
+			}
 		}
 
 		// Something changed.
@@ -686,9 +719,10 @@ func (w *RemoteStateWatcher) watchRelationUnits(
 	rel Relation, relationTag names.RelationTag, ruw watcher.RelationUnitsWatcher,
 ) error {
 	relationSnapshot := RelationSnapshot{
-		Life:      rel.Life(),
-		Suspended: rel.Suspended(),
-		Members:   make(map[string]int64),
+		Life:               rel.Life(),
+		Suspended:          rel.Suspended(),
+		Members:            make(map[string]int64),
+		ApplicationMembers: make(map[string]int64),
 	}
 	select {
 	case <-w.catacomb.Dying():
@@ -700,6 +734,9 @@ func (w *RemoteStateWatcher) watchRelationUnits(
 		for unit, settings := range change.Changed {
 			relationSnapshot.Members[unit] = settings.Version
 		}
+		for app, version := range change.AppChanged {
+			relationSnapshot.ApplicationMembers[app] = version
+		}
 	}
 	innerRUW, err := newRelationUnitsWatcher(rel.Id(), ruw, w.relationUnitsChanges)
 	if err != nil {
@@ -727,6 +764,9 @@ func (w *RemoteStateWatcher) relationUnitsChanged(change relationUnitsChange) er
 	for _, unit := range change.Departed {
 		delete(snapshot.Members, unit)
 	}
+	for app, version := range change.AppChanged {
+		snapshot.ApplicationMembers[app] = version
+	}
 	return nil
 }
 
@@ -845,3 +885,21 @@ func (w *RemoteStateWatcher) watchStorageAttachment(
 	w.storageAttachmentWatchers[tag] = innerSAW
 	return nil
 }
+
+// healthChanged is called when one of the charm's health checks
+// becomes healthy or unhealthy.
+func (w *RemoteStateWatcher) healthChanged() error {
+	w.mu.Lock()
+	w.current.HealthVersion++
+	w.mu.Unlock()
+	return nil
+}
+
+// timerFired is called when one of the timers registered by the
+// charm fires.
+func (w *RemoteStateWatcher) timerFired(name string) error {
+	w.mu.Lock()
+	w.current.TimerVersions[name]++
+	w.mu.Unlock()
+	return nil
+}
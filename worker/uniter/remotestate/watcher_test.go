@@ -29,6 +29,8 @@ type WatcherSuite struct {
 	clock      *testing.Clock
 
 	applicationWatcher *mockNotifyWatcher
+	healthChanged      chan struct{}
+	timerFired         chan string
 }
 
 type WatcherSuiteIAAS struct {
@@ -89,12 +91,16 @@ func (s *WatcherSuiteIAAS) SetUpTest(c *gc.C) {
 
 	s.st.unit.application.applicationWatcher = newMockNotifyWatcher()
 	s.applicationWatcher = s.st.unit.application.applicationWatcher
+	s.healthChanged = make(chan struct{}, 1)
+	s.timerFired = make(chan string, 1)
 	w, err := remotestate.NewWatcher(remotestate.WatcherConfig{
-		State:               s.st,
-		ModelType:           s.modelType,
-		LeadershipTracker:   s.leadership,
-		UnitTag:             s.st.unit.tag,
-		UpdateStatusChannel: statusTicker,
+		State:                s.st,
+		ModelType:            s.modelType,
+		LeadershipTracker:    s.leadership,
+		UnitTag:              s.st.unit.tag,
+		UpdateStatusChannel:  statusTicker,
+		HealthChangedChannel: s.healthChanged,
+		TimerChannel:         s.timerFired,
 	})
 	c.Assert(err, jc.ErrorIsNil)
 	s.watcher = w
@@ -617,6 +623,37 @@ func (s *WatcherSuite) TestRelationUnitsChanged(c *gc.C) {
 	)
 }
 
+func (s *WatcherSuite) TestRelationUnitsApplicationChanged(c *gc.C) {
+	s.signalAll()
+	assertNotifyEvent(c, s.watcher.RemoteStateChanged(), "waiting for remote state change")
+
+	relationTag := names.NewRelationTag("mysql:db wordpress:db")
+	s.st.relations[relationTag] = &mockRelation{
+		id: 123, life: params.Alive,
+	}
+	s.st.relationUnitsWatchers[relationTag] = newMockRelationUnitsWatcher()
+
+	s.st.unit.relationsWatcher.changes <- []string{relationTag.Id()}
+	s.st.relationUnitsWatchers[relationTag].changes <- watcher.RelationUnitsChange{
+		Changed:    map[string]watcher.UnitSettings{"mysql/1": {1}},
+		AppChanged: map[string]int64{"mysql": 1},
+	}
+	assertNotifyEvent(c, s.watcher.RemoteStateChanged(), "waiting for remote state change")
+	c.Assert(
+		s.watcher.Snapshot().Relations[123].ApplicationMembers,
+		jc.DeepEquals,
+		map[string]int64{"mysql": 1},
+	)
+
+	s.st.relationUnitsWatchers[relationTag].changes <- watcher.RelationUnitsChange{
+		AppChanged: map[string]int64{"mysql": 2},
+	}
+	assertNotifyEvent(c, s.watcher.RemoteStateChanged(), "waiting for remote state change")
+	snapshot := s.watcher.Snapshot().Relations[123]
+	c.Assert(snapshot.ApplicationMembers, jc.DeepEquals, map[string]int64{"mysql": 2})
+	c.Assert(snapshot.Members, jc.DeepEquals, map[string]int64{"mysql/1": 1})
+}
+
 func (s *WatcherSuite) TestRelationUnitsDontLeakReferences(c *gc.C) {
 	s.signalAll()
 	assertNotifyEvent(c, s.watcher.RemoteStateChanged(), "waiting for remote state change")
@@ -665,6 +702,34 @@ func (s *WatcherSuite) TestUpdateStatusTicker(c *gc.C) {
 	c.Assert(s.watcher.Snapshot().UpdateStatusVersion, gc.Equals, initial.UpdateStatusVersion+2)
 }
 
+func (s *WatcherSuiteIAAS) TestHealthChanged(c *gc.C) {
+	s.signalAll()
+	initial := s.watcher.Snapshot()
+	assertNotifyEvent(c, s.watcher.RemoteStateChanged(), "waiting for remote state change")
+
+	s.healthChanged <- struct{}{}
+	assertNotifyEvent(c, s.watcher.RemoteStateChanged(), "waiting for remote state change")
+	c.Assert(s.watcher.Snapshot().HealthVersion, gc.Equals, initial.HealthVersion+1)
+}
+
+func (s *WatcherSuiteIAAS) TestTimerFired(c *gc.C) {
+	s.signalAll()
+	initial := s.watcher.Snapshot()
+	assertNotifyEvent(c, s.watcher.RemoteStateChanged(), "waiting for remote state change")
+
+	s.timerFired <- "backup"
+	assertNotifyEvent(c, s.watcher.RemoteStateChanged(), "waiting for remote state change")
+	s.timerFired <- "backup"
+	assertNotifyEvent(c, s.watcher.RemoteStateChanged(), "waiting for remote state change")
+	s.timerFired <- "rotate"
+	assertNotifyEvent(c, s.watcher.RemoteStateChanged(), "waiting for remote state change")
+	c.Assert(initial.TimerVersions, gc.HasLen, 0)
+	c.Assert(s.watcher.Snapshot().TimerVersions, jc.DeepEquals, map[string]int{
+		"backup": 2,
+		"rotate": 1,
+	})
+}
+
 func (s *WatcherSuite) TestUpdateStatusIntervalChanges(c *gc.C) {
 	s.signalAll()
 	initial := s.watcher.Snapshot()
@@ -4,6 +4,8 @@
 package uniter
 
 import (
+	"sort"
+
 	"github.com/juju/errors"
 	"gopkg.in/juju/charm.v6/hooks"
 
@@ -222,6 +224,22 @@ func charmModified(local resolver.LocalState, remote remotestate.Snapshot) bool
 	return false
 }
 
+// nextTimer returns the name of the first timer, in name order, that
+// has fired since its hook was last committed.
+func nextTimer(local, remote map[string]int) (string, bool) {
+	var timerNames []string
+	for name, version := range remote {
+		if local[name] != version {
+			timerNames = append(timerNames, name)
+		}
+	}
+	if len(timerNames) == 0 {
+		return "", false
+	}
+	sort.Strings(timerNames)
+	return timerNames[0], true
+}
+
 func (s *uniterResolver) nextOp(
 	localState resolver.LocalState,
 	remoteState remotestate.Snapshot,
@@ -283,6 +301,14 @@ func (s *uniterResolver) nextOp(
 		return op, err
 	}
 
+	if localState.HealthVersion != remoteState.HealthVersion {
+		return opFactory.NewRunHook(hook.Info{Kind: hook.HealthChanged})
+	}
+
+	if name, ok := nextTimer(localState.TimerVersions, remoteState.TimerVersions); ok {
+		return opFactory.NewRunHook(hook.Info{Kind: hook.Timer, TimerName: name})
+	}
+
 	// UpdateStatus hook runs if nothing else needs to.
 	if localState.UpdateStatusVersion != remoteState.UpdateStatusVersion {
 		return opFactory.NewRunHook(hook.Info{Kind: hooks.UpdateStatus})
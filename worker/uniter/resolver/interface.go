@@ -77,6 +77,15 @@ type LocalState struct {
 	// for which an update-status hook has been committed.
 	UpdateStatusVersion int
 
+	// HealthVersion is the version of health from remotestate.Snapshot
+	// for which a health-changed hook has been committed.
+	HealthVersion int
+
+	// TimerVersions holds, for each timer, the version from
+	// remotestate.Snapshot for which a timer hook has been
+	// committed.
+	TimerVersions map[string]int
+
 	// RetryHookVersion is the version of hook-retries from
 	// remotestate.Snapshot for which a hook has been retried.
 	RetryHookVersion int
@@ -130,6 +130,20 @@ func (s *resolverOpFactory) wrapHookOp(op operation.Operation, info hook.Info) o
 		op = onCommitWrapper{op, func() {
 			s.LocalState.LeaderSettingsVersion = v
 		}}
+	case hook.HealthChanged:
+		v := s.RemoteState.HealthVersion
+		op = onCommitWrapper{op, func() {
+			s.LocalState.HealthVersion = v
+		}}
+	case hook.Timer:
+		name := info.TimerName
+		v := s.RemoteState.TimerVersions[name]
+		op = onCommitWrapper{op, func() {
+			if s.LocalState.TimerVersions == nil {
+				s.LocalState.TimerVersions = make(map[string]int)
+			}
+			s.LocalState.TimerVersions[name] = v
+		}}
 	}
 
 	charmModifiedVersion := s.RemoteState.CharmModifiedVersion
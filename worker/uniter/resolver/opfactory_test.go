@@ -129,6 +129,54 @@ func (s *ResolverOpFactorySuite) testLeaderSettingsChanged(
 	c.Assert(f.LocalState.UpdateStatusVersion, gc.Equals, 3)
 }
 
+func (s *ResolverOpFactorySuite) TestHealthChanged(c *gc.C) {
+	s.testHealthChanged(c, resolver.ResolverOpFactory.NewRunHook)
+	s.testHealthChanged(c, resolver.ResolverOpFactory.NewSkipHook)
+}
+
+func (s *ResolverOpFactorySuite) testHealthChanged(
+	c *gc.C, meth func(resolver.ResolverOpFactory, hook.Info) (operation.Operation, error),
+) {
+	f := resolver.NewResolverOpFactory(s.opFactory)
+	f.RemoteState.HealthVersion = 1
+
+	op, err := meth(f, hook.Info{Kind: hook.HealthChanged})
+	c.Assert(err, jc.ErrorIsNil)
+	f.RemoteState.HealthVersion = 2
+
+	_, err = op.Commit(operation.State{})
+	c.Assert(err, jc.ErrorIsNil)
+
+	// Local state's HealthVersion should be set to what
+	// RemoteState's HealthVersion was when the operation
+	// was constructed.
+	c.Assert(f.LocalState.HealthVersion, gc.Equals, 1)
+}
+
+func (s *ResolverOpFactorySuite) TestTimer(c *gc.C) {
+	s.testTimer(c, resolver.ResolverOpFactory.NewRunHook)
+	s.testTimer(c, resolver.ResolverOpFactory.NewSkipHook)
+}
+
+func (s *ResolverOpFactorySuite) testTimer(
+	c *gc.C, meth func(resolver.ResolverOpFactory, hook.Info) (operation.Operation, error),
+) {
+	f := resolver.NewResolverOpFactory(s.opFactory)
+	f.RemoteState.TimerVersions = map[string]int{"backup": 1, "rotate": 3}
+
+	op, err := meth(f, hook.Info{Kind: hook.Timer, TimerName: "backup"})
+	c.Assert(err, jc.ErrorIsNil)
+	f.RemoteState.TimerVersions["backup"] = 2
+
+	_, err = op.Commit(operation.State{})
+	c.Assert(err, jc.ErrorIsNil)
+
+	// Local state's version of the timer should be set to what
+	// RemoteState's version was when the operation was constructed,
+	// and the versions of other timers left alone.
+	c.Assert(f.LocalState.TimerVersions, jc.DeepEquals, map[string]int{"backup": 1})
+}
+
 func (s *ResolverOpFactorySuite) TestUpgrade(c *gc.C) {
 	s.testUpgrade(c, resolver.ResolverOpFactory.NewUpgrade)
 	s.testUpgrade(c, resolver.ResolverOpFactory.NewRevertUpgrade)
@@ -4,6 +4,8 @@
 package context
 
 import (
+	"time"
+
 	"gopkg.in/juju/names.v2"
 )
 
@@ -15,6 +17,10 @@ type ActionData struct {
 	Failed         bool
 	ResultsMessage string
 	ResultsMap     map[string]interface{}
+
+	// Timeout is how long the action may run before it is
+	// killed, or zero if there is no limit.
+	Timeout time.Duration
 }
 
 // NewActionData builds a suitable ActionData struct with no nil members.
@@ -7,6 +7,7 @@ package context
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -164,6 +165,12 @@ type HookContext struct {
 	// or if it is running a relation-broken hook.
 	remoteUnitName string
 
+	// remoteApplicationName identifies the application whose unit or
+	// settings changed for the executing relation hook. It will be empty
+	// if the context is not running a relation hook, or if it is running
+	// a relation-broken hook.
+	remoteApplicationName string
+
 	// relations contains the context for every relation the unit is a member
 	// of, keyed on relation id.
 	relations map[int]*ContextRelation
@@ -220,6 +227,15 @@ type HookContext struct {
 	// hook run, so the actual add will happen in a flush.
 	storageAddConstraints map[string][]params.StorageConstraints
 
+	// timers provides access to the timers registered by the charm.
+	timers TimerAccessor
+
+	// pendingTimers holds the timers set or unset by the hook, keyed
+	// on name; a zero interval removes the timer. The timers will be
+	// registered on successful hook run, so the change will happen
+	// in a flush.
+	pendingTimers map[string]time.Duration
+
 	// clock is used for any time operations.
 	clock Clock
 
@@ -231,6 +247,15 @@ type HookContext struct {
 
 	// The cloud specification
 	cloudSpec *params.CloudSpec
+
+	// hookRecording is true if hook executions in this context
+	// should be recorded, as set by the model's hook-recording
+	// config.
+	hookRecording bool
+
+	// hookTimeout is how long hooks run in this context may take
+	// before they are killed, or zero if there is no limit.
+	hookTimeout time.Duration
 }
 
 // Component implements hooks.Context.
@@ -400,6 +425,29 @@ func (ctx *HookContext) SetApplicationStatus(applicationStatus jujuc.StatusInfo)
 	)
 }
 
+// SetSubWorkload will set the given version and status for the named
+// sub-workload of this unit.
+func (ctx *HookContext) SetSubWorkload(name, version string, subStatus jujuc.StatusInfo) error {
+	logger.Tracef("[SUB-WORKLOAD-STATUS] %s: %s: %s", name, subStatus.Status, subStatus.Info)
+	return ctx.unit.SetSubWorkload(
+		name,
+		version,
+		status.Status(subStatus.Status),
+		subStatus.Info,
+	)
+}
+
+// UnsetSubWorkload will remove the named sub-workload of this unit,
+// if it has been reported.
+func (ctx *HookContext) UnsetSubWorkload(name string) error {
+	logger.Tracef("[SUB-WORKLOAD-STATUS] %s: unset", name)
+	err := ctx.unit.RemoveSubWorkload(name)
+	if params.IsCodeNotFound(err) {
+		return nil
+	}
+	return err
+}
+
 func (ctx *HookContext) HasExecutionSetUnitStatus() bool {
 	return ctx.hasRunStatusSet
 }
@@ -408,6 +456,18 @@ func (ctx *HookContext) ResetExecutionSetUnitStatus() {
 	ctx.hasRunStatusSet = false
 }
 
+// HookRecording returns whether hook executions in this context
+// should be recorded.
+func (ctx *HookContext) HookRecording() bool {
+	return ctx.hookRecording
+}
+
+// HookTimeout returns how long hooks run in this context may take
+// before they are killed, or zero if there is no limit.
+func (ctx *HookContext) HookTimeout() time.Duration {
+	return ctx.hookTimeout
+}
+
 func (ctx *HookContext) PublicAddress() (string, error) {
 	if ctx.publicAddress == "" {
 		return "", errors.NotFoundf("public address")
@@ -457,6 +517,25 @@ func (ctx *HookContext) AddUnitStorage(cons map[string]params.StorageConstraints
 	return nil
 }
 
+// SetTimer registers the named timer to fire every interval once
+// the hook completes successfully.
+func (ctx *HookContext) SetTimer(name string, interval time.Duration) error {
+	if ctx.timers == nil {
+		return errors.NotSupportedf("timers")
+	}
+	if ctx.pendingTimers == nil {
+		ctx.pendingTimers = make(map[string]time.Duration)
+	}
+	ctx.pendingTimers[name] = interval
+	return nil
+}
+
+// UnsetTimer removes the named timer once the hook completes
+// successfully.
+func (ctx *HookContext) UnsetTimer(name string) error {
+	return ctx.SetTimer(name, 0)
+}
+
 func (ctx *HookContext) OpenPorts(protocol string, fromPort, toPort int) error {
 	return tryOpenPorts(
 		protocol, fromPort, toPort,
@@ -591,6 +670,13 @@ func (ctx *HookContext) RemoteUnitName() (string, error) {
 	return ctx.remoteUnitName, nil
 }
 
+func (ctx *HookContext) RemoteApplicationName() (string, error) {
+	if ctx.remoteApplicationName == "" {
+		return "", errors.NotFoundf("remote application")
+	}
+	return ctx.remoteApplicationName, nil
+}
+
 func (ctx *HookContext) Relation(id int) (jujuc.ContextRelation, error) {
 	r, found := ctx.relations[id]
 	if !found {
@@ -667,6 +753,7 @@ func (context *HookContext) HookVars(paths Paths) ([]string, error) {
 			"JUJU_RELATION="+r.Name(),
 			"JUJU_RELATION_ID="+r.FakeId(),
 			"JUJU_REMOTE_UNIT="+context.remoteUnitName,
+			"JUJU_REMOTE_APP="+context.remoteApplicationName,
 		)
 	} else if !errors.IsNotFound(err) {
 		return nil, errors.Trace(err)
@@ -793,6 +880,16 @@ func (ctx *HookContext) Flush(process string, ctxErr error) (err error) {
 		}
 	}
 
+	// register and remove the timers set by the hook
+	if len(ctx.pendingTimers) > 0 && writeChanges {
+		if err := ctx.flushTimers(); err != nil {
+			logger.Errorf("%v", err)
+			if ctxErr == nil {
+				ctxErr = err
+			}
+		}
+	}
+
 	// TODO (tasdomas) 2014 09 03: context finalization needs to modified to apply all
 	//                             changes in one api call to minimize the risk
 	//                             of partial failures.
@@ -804,6 +901,28 @@ func (ctx *HookContext) Flush(process string, ctxErr error) (err error) {
 	return ctxErr
 }
 
+// flushTimers registers and removes the timers set and unset by
+// the hook, in name order.
+func (ctx *HookContext) flushTimers() error {
+	timerNames := make([]string, 0, len(ctx.pendingTimers))
+	for name := range ctx.pendingTimers {
+		timerNames = append(timerNames, name)
+	}
+	sort.Strings(timerNames)
+	for _, name := range timerNames {
+		var err error
+		if interval := ctx.pendingTimers[name]; interval == 0 {
+			err = ctx.timers.RemoveTimer(name)
+		} else {
+			err = ctx.timers.SetTimer(name, interval)
+		}
+		if err != nil {
+			return errors.Annotatef(err, "cannot set timer %q", name)
+		}
+	}
+	return nil
+}
+
 // finalizeAction passes back the final status of an Action hook to state.
 // It wraps any errors which occurred in normal behavior of the Action run;
 // only errors passed in unhandledErr will be returned.
@@ -56,6 +56,17 @@ type StorageContextAccessor interface {
 	Storage(names.StorageTag) (jujuc.ContextStorageAttachment, error)
 }
 
+// TimerAccessor is an interface providing access to the timers
+// registered by the charm.
+type TimerAccessor interface {
+	// SetTimer registers the named timer to fire every interval,
+	// replacing any timer already registered with that name.
+	SetTimer(name string, interval time.Duration) error
+
+	// RemoveTimer removes the named timer, if it is registered.
+	RemoveTimer(name string) error
+}
+
 // RelationsFunc is used to get snapshots of relation membership at context
 // creation time.
 type RelationsFunc func() map[int]*RelationInfo
@@ -73,6 +84,7 @@ type contextFactory struct {
 	modelType  model.ModelType
 	machineTag names.MachineTag
 	storage    StorageContextAccessor
+	timers     TimerAccessor
 	clock      Clock
 	zone       string
 	principal  string
@@ -93,6 +105,7 @@ type FactoryConfig struct {
 	Tracker          leadership.Tracker
 	GetRelationInfos RelationsFunc
 	Storage          StorageContextAccessor
+	Timers           TimerAccessor
 	Paths            Paths
 	Clock            Clock
 }
@@ -141,6 +154,7 @@ func NewContextFactory(config FactoryConfig) (ContextFactory, error) {
 		getRelationInfos: config.GetRelationInfos,
 		relationCaches:   map[int]*RelationCache{},
 		storage:          config.Storage,
+		timers:           config.Timers,
 		rand:             rand.New(rand.NewSource(time.Now().Unix())),
 		clock:            config.Clock,
 		zone:             zone,
@@ -175,6 +189,7 @@ func (f *contextFactory) coreContext() (*HookContext, error) {
 		relationId:         -1,
 		pendingPorts:       make(map[PortRange]PortRangeInfo),
 		storage:            f.storage,
+		timers:             f.timers,
 		clock:              f.clock,
 		componentDir:       f.paths.ComponentDir,
 		componentFuncs:     registeredComponentFuncs,
@@ -211,6 +226,14 @@ func (f *contextFactory) HookContext(hookInfo hook.Info) (*HookContext, error) {
 	if hookInfo.Kind.IsRelation() {
 		ctx.relationId = hookInfo.RelationId
 		ctx.remoteUnitName = hookInfo.RemoteUnit
+		ctx.remoteApplicationName = hookInfo.RemoteApplication
+		if hookInfo.RemoteUnit != "" {
+			appName, err := names.UnitApplication(hookInfo.RemoteUnit)
+			if err != nil {
+				return nil, errors.Trace(err)
+			}
+			ctx.remoteApplicationName = appName
+		}
 		relation, found := ctx.relations[hookInfo.RelationId]
 		if !found {
 			return nil, errors.Errorf("unknown relation id: %v", hookInfo.RelationId)
@@ -223,7 +246,7 @@ func (f *contextFactory) HookContext(hookInfo hook.Info) (*HookContext, error) {
 		}
 		hookName = fmt.Sprintf("%s-%s", relation.Name(), hookInfo.Kind)
 	}
-	if hookInfo.Kind.IsStorage() {
+	if hook.IsStorage(hookInfo.Kind) {
 		ctx.storageTag = names.NewStorageTag(hookInfo.StorageId)
 		if _, err := ctx.storage.Storage(ctx.storageTag); err != nil {
 			return nil, errors.Annotatef(err, "could not retrieve storage for id: %v", hookInfo.StorageId)
@@ -234,6 +257,9 @@ func (f *contextFactory) HookContext(hookInfo hook.Info) (*HookContext, error) {
 		}
 		hookName = fmt.Sprintf("%s-%s", storageName, hookName)
 	}
+	if hookInfo.Kind == hook.Timer {
+		hookName = fmt.Sprintf("%s-%s", hookName, hookInfo.TimerName)
+	}
 	ctx.id = f.newId(hookName)
 	return ctx, nil
 }
@@ -250,6 +276,13 @@ func (f *contextFactory) CommandContext(commandInfo CommandInfo) (*HookContext,
 	}
 	ctx.relationId = relationId
 	ctx.remoteUnitName = remoteUnitName
+	if remoteUnitName != "" {
+		appName, err := names.UnitApplication(remoteUnitName)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		ctx.remoteApplicationName = appName
+	}
 	ctx.id = f.newId("run-commands")
 	return ctx, nil
 }
@@ -305,6 +338,16 @@ func (f *contextFactory) updateContext(ctx *HookContext) (err error) {
 	}
 	ctx.legacyProxySettings = modelConfig.LegacyProxySettings()
 	ctx.jujuProxySettings = modelConfig.JujuProxySettings()
+	ctx.hookRecording = modelConfig.HookRecording()
+
+	ctx.hookTimeout, err = f.unit.HookTimeout()
+	if errors.IsNotImplemented(err) {
+		// Older controllers don't support application hook
+		// timeouts, so fall back to the model's.
+		ctx.hookTimeout = modelConfig.HookTimeout()
+	} else if err != nil {
+		return errors.Annotate(err, "could not retrieve the hook timeout")
+	}
 
 	statusCode, statusInfo, err := f.unit.MeterStatus()
 	if err != nil {
@@ -133,6 +133,15 @@ func (s *ContextFactorySuite) TestNewHookContextRetrievesSLALevel(c *gc.C) {
 	c.Assert(ctx.SLALevel(), gc.Equals, "essential")
 }
 
+func (s *ContextFactorySuite) TestNewHookContextTimer(c *gc.C) {
+	ctx, err := s.factory.HookContext(hook.Info{
+		Kind:      hook.Timer,
+		TimerName: "backup",
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(ctx.Id(), gc.Matches, `u/0-timer-backup-[0-9]+`)
+}
+
 func (s *ContextFactorySuite) TestNewHookContextLeadershipContext(c *gc.C) {
 	s.testLeadershipContextWiring(c, func() *context.HookContext {
 		ctx, err := s.factory.HookContext(hook.Info{Kind: hooks.ConfigChanged})
@@ -129,6 +129,7 @@ func (s *EnvSuite) setRelation(ctx *context.HookContext) (expectVars []string) {
 		"JUJU_RELATION=an-endpoint",
 		"JUJU_RELATION_ID=an-endpoint:22",
 		"JUJU_REMOTE_UNIT=that-unit/456",
+		"JUJU_REMOTE_APP=that-unit",
 	}
 }
 
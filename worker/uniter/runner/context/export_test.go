@@ -96,6 +96,7 @@ func SetEnvironmentHookContextRelation(
 ) {
 	context.relationId = relationId
 	context.remoteUnitName = remoteUnitName
+	context.remoteApplicationName, _ = names.UnitApplication(remoteUnitName)
 	context.relations = map[int]*ContextRelation{
 		relationId: {
 			endpointName: endpointName,
@@ -192,3 +193,7 @@ func CachedSettings(cf0 ContextFactory, relId int, unitName string) (params.Sett
 func (ctx *HookContext) SLALevel() string {
 	return ctx.slaLevel
 }
+
+func SetTimerAccessor(ctx *HookContext, timers TimerAccessor) {
+	ctx.timers = timers
+}
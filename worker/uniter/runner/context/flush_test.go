@@ -4,6 +4,8 @@
 package context_test
 
 import (
+	"time"
+
 	"github.com/juju/errors"
 	"github.com/juju/testing"
 	jc "github.com/juju/testing/checkers"
@@ -208,6 +210,71 @@ func (s *FlushContextSuite) TestRunHookAddUnitStorageOnSuccess(c *gc.C) {
 	c.Assert(all, gc.HasLen, 0)
 }
 
+func (s *FlushContextSuite) TestRunHookSetTimersOnFailure(c *gc.C) {
+	ctx := s.context(c)
+	context.SetTimerAccessor(ctx, &fakeTimers{&s.stub})
+	err := ctx.SetTimer("backup", time.Hour)
+	c.Assert(err, jc.ErrorIsNil)
+
+	// Flush the context with an error.
+	err = ctx.Flush("some badge", errors.New("blam pow"))
+	c.Assert(err, gc.ErrorMatches, "blam pow")
+	s.stub.CheckNoCalls(c)
+}
+
+func (s *FlushContextSuite) TestRunHookSetTimersOnSuccess(c *gc.C) {
+	ctx := s.context(c)
+	context.SetTimerAccessor(ctx, &fakeTimers{&s.stub})
+	err := ctx.SetTimer("rotate", time.Minute)
+	c.Assert(err, jc.ErrorIsNil)
+	err = ctx.SetTimer("backup", time.Hour)
+	c.Assert(err, jc.ErrorIsNil)
+	err = ctx.UnsetTimer("rotate")
+	c.Assert(err, jc.ErrorIsNil)
+	err = ctx.UnsetTimer("cleanup")
+	c.Assert(err, jc.ErrorIsNil)
+
+	// Flush the context with a success.
+	err = ctx.Flush("success", nil)
+	c.Assert(err, jc.ErrorIsNil)
+	s.stub.CheckCalls(c, []testing.StubCall{
+		{"SetTimer", []interface{}{"backup", time.Hour}},
+		{"RemoveTimer", []interface{}{"cleanup"}},
+		{"RemoveTimer", []interface{}{"rotate"}},
+	})
+}
+
+func (s *FlushContextSuite) TestRunHookSetTimersError(c *gc.C) {
+	ctx := s.context(c)
+	context.SetTimerAccessor(ctx, &fakeTimers{&s.stub})
+	s.stub.SetErrors(errors.New("disk full"))
+	err := ctx.SetTimer("backup", time.Hour)
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = ctx.Flush("success", nil)
+	c.Assert(err, gc.ErrorMatches, `cannot set timer "backup": disk full`)
+}
+
+func (s *FlushContextSuite) TestSetTimerNotSupported(c *gc.C) {
+	ctx := s.context(c)
+	err := ctx.SetTimer("backup", time.Hour)
+	c.Assert(err, jc.Satisfies, errors.IsNotSupported)
+}
+
+type fakeTimers struct {
+	stub *testing.Stub
+}
+
+func (t *fakeTimers) SetTimer(name string, interval time.Duration) error {
+	t.stub.AddCall("SetTimer", name, interval)
+	return t.stub.NextErr()
+}
+
+func (t *fakeTimers) RemoveTimer(name string) error {
+	t.stub.AddCall("RemoveTimer", name)
+	return t.stub.NextErr()
+}
+
 func (s *HookContextSuite) context(c *gc.C) *context.HookContext {
 	uuid, err := utils.NewUUID()
 	c.Assert(err, jc.ErrorIsNil)
@@ -26,6 +26,10 @@ type ContextRelation struct {
 	// settings allows read and write access to the relation unit settings.
 	settings *uniter.Settings
 
+	// applicationSettings allows read and write access to the settings
+	// of the unit's application in the relation.
+	applicationSettings *uniter.Settings
+
 	// cache holds remote unit membership and settings.
 	cache *RelationCache
 }
@@ -72,11 +76,30 @@ func (ctx *ContextRelation) Settings() (jujuc.Settings, error) {
 	return ctx.settings, nil
 }
 
-// WriteSettings persists all changes made to the unit's relation settings.
+func (ctx *ContextRelation) ApplicationSettings() (jujuc.Settings, error) {
+	if ctx.applicationSettings == nil {
+		node, err := ctx.ru.ApplicationSettings()
+		if err != nil {
+			return nil, err
+		}
+		ctx.applicationSettings = node
+	}
+	return ctx.applicationSettings, nil
+}
+
+func (ctx *ContextRelation) ReadApplicationSettings(app string) (params.Settings, error) {
+	return ctx.ru.ReadApplicationSettings(app)
+}
+
+// WriteSettings persists all changes made to the unit's and, if the
+// unit is the leader, its application's relation settings.
 func (ctx *ContextRelation) WriteSettings() (err error) {
 	if ctx.settings != nil {
 		err = ctx.settings.Write()
 	}
+	if err == nil && ctx.applicationSettings != nil {
+		err = ctx.applicationSettings.Write()
+	}
 	return
 }
 
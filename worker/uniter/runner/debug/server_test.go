@@ -101,6 +101,49 @@ func (s *DebugHooksServerSuite) TestFindSession(c *gc.C) {
 	c.Assert(session.MatchHook("bar"), jc.IsTrue)
 	c.Assert(session.MatchHook("baz"), jc.IsTrue)
 	c.Assert(session.MatchHook("foo bar baz"), jc.IsFalse)
+	c.Assert(session.DebugAt(), gc.Equals, "")
+
+	// Hooks file is present, with a debug-at value.
+	err = ioutil.WriteFile(s.ctx.ClientFileLock(), []byte(`{hooks: [foo], debug-at: all}`), 0777)
+	c.Assert(err, jc.ErrorIsNil)
+	session, err = s.ctx.FindSession()
+	c.Assert(session, gc.NotNil)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(session.MatchHook("foo"), jc.IsTrue)
+	c.Assert(session.DebugAt(), gc.Equals, "all")
+}
+
+func (s *DebugHooksServerSuite) TestWriteDebugFiles(c *gc.C) {
+	session := &ServerSession{HooksContext: s.ctx}
+	debugDir := c.MkDir()
+	err := session.writeDebugFiles(debugDir, "./hooks/install")
+	c.Assert(err, jc.ErrorIsNil)
+
+	data, err := ioutil.ReadFile(filepath.Join(debugDir, "hook.sh"))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(string(data), gc.Equals, fmt.Sprintf(`#!/bin/bash
+. %s/env.sh
+echo $$ > $JUJU_DEBUG/hook.pid
+exec /bin/bash --noprofile --init-file $JUJU_DEBUG/init.sh
+`, debugDir))
+}
+
+func (s *DebugHooksServerSuite) TestWriteDebugFilesDebugAt(c *gc.C) {
+	session := &ServerSession{HooksContext: s.ctx, debugAt: "all"}
+	debugDir := c.MkDir()
+	err := session.writeDebugFiles(debugDir, "./hooks/install")
+	c.Assert(err, jc.ErrorIsNil)
+
+	data, err := ioutil.ReadFile(filepath.Join(debugDir, "hook.sh"))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(string(data), gc.Equals, fmt.Sprintf(`#!/bin/bash
+. %s/env.sh
+echo $$ > $JUJU_DEBUG/hook.pid
+trap 'echo $? > $JUJU_DEBUG/hook_exit_status' EXIT
+cd "$JUJU_CHARM_DIR"
+echo "Running $JUJU_HOOK_NAME with JUJU_DEBUG_AT=$JUJU_DEBUG_AT"
+./hooks/install
+`, debugDir))
 }
 
 func (s *DebugHooksServerSuite) TestRunHookExceptional(c *gc.C) {
@@ -125,7 +168,7 @@ func (s *DebugHooksServerSuite) TestRunHookExceptional(c *gc.C) {
 	s.PatchValue(&waitClientExit, func(*ServerSession) {
 		flockAcquired <- struct{}{}
 	})
-	err = session.RunHook("myhook", s.tmpdir, os.Environ())
+	err = session.RunHook("myhook", s.tmpdir, os.Environ(), "")
 	c.Assert(err, gc.ErrorMatches, "signal: [kK]illed")
 	waitForFlock()
 
@@ -141,7 +184,7 @@ func (s *DebugHooksServerSuite) TestRunHookExceptional(c *gc.C) {
 		flockAcquired <- struct{}{}
 	})
 	go func() { ch <- true }() // asynchronously release the flock
-	err = session.RunHook("myhook", s.tmpdir, os.Environ())
+	err = session.RunHook("myhook", s.tmpdir, os.Environ(), "")
 	waitForFlock()
 	c.Assert(clientExited, jc.IsTrue)
 	c.Assert(err, gc.ErrorMatches, "signal: [kK]illed")
@@ -164,7 +207,7 @@ func (s *DebugHooksServerSuite) TestRunHook(c *gc.C) {
 	const hookName = "myhook"
 	runHookCh := make(chan error)
 	go func() {
-		runHookCh <- session.RunHook(hookName, s.tmpdir, os.Environ())
+		runHookCh <- session.RunHook(hookName, s.tmpdir, os.Environ(), "")
 	}()
 
 	flockCh := make(chan struct{})
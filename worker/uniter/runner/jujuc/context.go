@@ -41,6 +41,7 @@ type HookContext interface {
 	ContextComponents
 	ContextRelations
 	ContextVersion
+	ContextTimers
 }
 
 // UnitHookContext is the context for a unit hook.
@@ -63,6 +64,11 @@ type relationHookContext interface {
 	// is associated with if it was found, and an error if it was not found or is not
 	// available.
 	RemoteUnitName() (string, error)
+
+	// RemoteApplicationName returns the name of the remote application
+	// the hook execution is associated with if it was found, and an error
+	// if it was not found or is not available.
+	RemoteApplicationName() (string, error)
 }
 
 // ActionHookContext is the context for an action hook.
@@ -119,6 +125,13 @@ type ContextStatus interface {
 
 	// SetApplicationStatus updates the status for the unit's application.
 	SetApplicationStatus(StatusInfo) error
+
+	// SetSubWorkload updates the version and status of the named
+	// sub-workload of the unit.
+	SetSubWorkload(name, version string, info StatusInfo) error
+
+	// UnsetSubWorkload removes the named sub-workload of the unit.
+	UnsetSubWorkload(name string) error
 }
 
 // RebootPriority is the type used for reboot requests.
@@ -267,6 +280,15 @@ type ContextRelation interface {
 	// ReadSettings returns the settings of any remote unit in the relation.
 	ReadSettings(unit string) (params.Settings, error)
 
+	// ApplicationSettings allows read/write access to the local
+	// application's settings in this relation. Only the leader may
+	// access them.
+	ApplicationSettings() (Settings, error)
+
+	// ReadApplicationSettings returns the settings of any remote
+	// application in the relation.
+	ReadApplicationSettings(app string) (params.Settings, error)
+
 	// Suspended returns true if the relation is suspended.
 	Suspended() bool
 
@@ -302,6 +324,16 @@ type ContextVersion interface {
 	SetUnitWorkloadVersion(string) error
 }
 
+// ContextTimers expresses the parts of a hook context related to
+// the timers registered by the charm.
+type ContextTimers interface {
+	// SetTimer registers the named timer to fire every interval.
+	SetTimer(name string, interval time.Duration) error
+
+	// UnsetTimer removes the named timer.
+	UnsetTimer(name string) error
+}
+
 // Settings is implemented by types that manipulate unit settings.
 type Settings interface {
 	Map() params.Settings
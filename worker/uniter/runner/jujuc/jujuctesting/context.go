@@ -7,6 +7,7 @@ import (
 	"fmt"
 
 	"github.com/juju/testing"
+	"gopkg.in/juju/names.v2"
 )
 
 // ContextInfo holds the values for the hook context.
@@ -23,6 +24,7 @@ type ContextInfo struct {
 	RelationHook
 	ActionHook
 	Version
+	Timers
 }
 
 // Context returns a Context that wraps the info.
@@ -38,6 +40,10 @@ func (info *ContextInfo) SetAsRelationHook(id int, remote string) {
 	}
 	info.HookRelation = relation
 	info.RemoteUnitName = remote
+	info.RemoteApplicationName = ""
+	if names.IsValidUnit(remote) {
+		info.RemoteApplicationName, _ = names.UnitApplication(remote)
+	}
 }
 
 // SetAsActionHook updates the context to work as an action hook context.
@@ -63,6 +69,7 @@ type Context struct {
 	ContextRelationHook
 	ContextActionHook
 	ContextVersion
+	ContextTimers
 }
 
 // NewContext builds a jujuc.Context test double.
@@ -92,5 +99,7 @@ func NewContext(stub *testing.Stub, info *ContextInfo) *Context {
 	ctx.ContextActionHook.info = &info.ActionHook
 	ctx.ContextVersion.stub = stub
 	ctx.ContextVersion.info = &info.Version
+	ctx.ContextTimers.stub = stub
+	ctx.ContextTimers.info = &info.Timers
 	return &ctx
 }
@@ -13,6 +13,13 @@ import (
 type Status struct {
 	UnitStatus        jujuc.StatusInfo
 	ApplicationStatus jujuc.ApplicationStatusInfo
+	SubWorkloads      map[string]SubWorkload
+}
+
+// SubWorkload holds the version and status of a sub-workload.
+type SubWorkload struct {
+	Version string
+	Status  jujuc.StatusInfo
 }
 
 // SetApplicationStatus builds a application status and sets it on the Status.
@@ -70,3 +77,31 @@ func (c *ContextStatus) SetApplicationStatus(status jujuc.StatusInfo) error {
 	c.info.SetApplicationStatus(status, nil)
 	return nil
 }
+
+// SetSubWorkload implements jujuc.ContextStatus.
+func (c *ContextStatus) SetSubWorkload(name, version string, status jujuc.StatusInfo) error {
+	c.stub.AddCall("SetSubWorkload", name, version, status)
+	if err := c.stub.NextErr(); err != nil {
+		return errors.Trace(err)
+	}
+
+	if c.info.SubWorkloads == nil {
+		c.info.SubWorkloads = make(map[string]SubWorkload)
+	}
+	c.info.SubWorkloads[name] = SubWorkload{
+		Version: version,
+		Status:  status,
+	}
+	return nil
+}
+
+// UnsetSubWorkload implements jujuc.ContextStatus.
+func (c *ContextStatus) UnsetSubWorkload(name string) error {
+	c.stub.AddCall("UnsetSubWorkload", name)
+	if err := c.stub.NextErr(); err != nil {
+		return errors.Trace(err)
+	}
+
+	delete(c.info.SubWorkloads, name)
+	return nil
+}
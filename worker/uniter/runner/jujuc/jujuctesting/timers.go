@@ -0,0 +1,46 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package jujuctesting
+
+import (
+	"time"
+
+	"github.com/juju/errors"
+)
+
+// Timers holds the values for the hook context.
+type Timers struct {
+	Timers map[string]time.Duration
+}
+
+// ContextTimers is a test double for jujuc.ContextTimers.
+type ContextTimers struct {
+	contextBase
+	info *Timers
+}
+
+// SetTimer implements jujuc.ContextTimers.
+func (c *ContextTimers) SetTimer(name string, interval time.Duration) error {
+	c.stub.AddCall("SetTimer", name, interval)
+	if err := c.stub.NextErr(); err != nil {
+		return errors.Trace(err)
+	}
+
+	if c.info.Timers == nil {
+		c.info.Timers = make(map[string]time.Duration)
+	}
+	c.info.Timers[name] = interval
+	return nil
+}
+
+// UnsetTimer implements jujuc.ContextTimers.
+func (c *ContextTimers) UnsetTimer(name string) error {
+	c.stub.AddCall("UnsetTimer", name)
+	if err := c.stub.NextErr(); err != nil {
+		return errors.Trace(err)
+	}
+
+	delete(c.info.Timers, name)
+	return nil
+}
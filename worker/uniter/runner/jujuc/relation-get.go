@@ -9,6 +9,7 @@ import (
 	"github.com/juju/cmd"
 	"github.com/juju/errors"
 	"github.com/juju/gnuflag"
+	"gopkg.in/juju/names.v2"
 
 	"github.com/juju/juju/apiserver/params"
 )
@@ -24,6 +25,11 @@ type RelationGetCommand struct {
 	Key      string
 	UnitName string
 	out      cmd.Output
+
+	// Application is true if the settings of an application,
+	// rather than a unit, should be read.
+	Application     bool
+	ApplicationName string
 }
 
 func NewRelationGetCommand(ctx Context) (cmd.Command, error) {
@@ -43,6 +49,10 @@ func (c *RelationGetCommand) Info() *cmd.Info {
 	doc := `
 relation-get prints the value of a unit's relation setting, specified by key.
 If no key is given, or if the key is "-", all keys and values will be printed.
+
+With --app, the settings published by an application are printed instead,
+and the unit id may be replaced by the application's name. Only the leader
+may read the settings of its own application, except in a peer relation.
 `
 	// There's nothing we can really do about the error here.
 	if name, err := c.ctx.RemoteUnitName(); err == nil {
@@ -64,6 +74,7 @@ func (c *RelationGetCommand) SetFlags(f *gnuflag.FlagSet) {
 	c.out.AddFlags(f, "smart", cmd.DefaultFormatters)
 	f.Var(c.relationIdProxy, "r", "specify a relation by id")
 	f.Var(c.relationIdProxy, "relation", "")
+	f.BoolVar(&c.Application, "app", false, "get the settings of an application rather than a unit")
 }
 
 // Init is part of the cmd.Command interface.
@@ -78,6 +89,9 @@ func (c *RelationGetCommand) Init(args []string) error {
 		}
 		args = args[1:]
 	}
+	if c.Application {
+		return c.initApplication(args)
+	}
 	name, err := c.ctx.RemoteUnitName()
 	if err == nil {
 		c.UnitName = name
@@ -94,13 +108,38 @@ func (c *RelationGetCommand) Init(args []string) error {
 	return cmd.CheckEmpty(args)
 }
 
+func (c *RelationGetCommand) initApplication(args []string) error {
+	name, err := c.ctx.RemoteApplicationName()
+	if err == nil {
+		c.ApplicationName = name
+	} else if cause := errors.Cause(err); !errors.IsNotFound(cause) {
+		return errors.Trace(err)
+	}
+	if len(args) > 0 {
+		c.ApplicationName = args[0]
+		if names.IsValidUnit(c.ApplicationName) {
+			c.ApplicationName, _ = names.UnitApplication(c.ApplicationName)
+		}
+		args = args[1:]
+	}
+	if c.ApplicationName == "" {
+		return fmt.Errorf("no application specified")
+	}
+	return cmd.CheckEmpty(args)
+}
+
 func (c *RelationGetCommand) Run(ctx *cmd.Context) error {
 	r, err := c.ctx.Relation(c.RelationId)
 	if err != nil {
 		return errors.Trace(err)
 	}
 	var settings params.Settings
-	if c.UnitName == c.ctx.UnitName() {
+	if c.Application {
+		settings, err = c.readApplicationSettings(r)
+		if err != nil {
+			return err
+		}
+	} else if c.UnitName == c.ctx.UnitName() {
 		node, err := r.Settings()
 		if err != nil {
 			return err
@@ -121,3 +160,18 @@ func (c *RelationGetCommand) Run(ctx *cmd.Context) error {
 	}
 	return c.out.Write(ctx, nil)
 }
+
+func (c *RelationGetCommand) readApplicationSettings(r ContextRelation) (params.Settings, error) {
+	localApp, err := names.UnitApplication(c.ctx.UnitName())
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if c.ApplicationName != localApp {
+		return r.ReadApplicationSettings(c.ApplicationName)
+	}
+	node, err := r.ApplicationSettings()
+	if err != nil {
+		return nil, err
+	}
+	return node.Map(), nil
+}
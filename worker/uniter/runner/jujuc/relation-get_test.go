@@ -29,6 +29,8 @@ func (s *RelationGetSuite) newHookContext(relid int, remote string) (jujuc.Conte
 	info.rels[0].Units["u/0"]["private-address"] = "foo: bar\n"
 	info.rels[1].SetRelated("m/0", jujuctesting.Settings{"pew": "pew\npew\n"})
 	info.rels[1].SetRelated("u/1", jujuctesting.Settings{"value": "12345"})
+	info.rels[1].SetRelatedApplication("m", jujuctesting.Settings{"endpoint": "m.invalid"})
+	info.rels[1].SetRelatedApplication("u", jujuctesting.Settings{"leader": "u/0"})
 	return hctx, info
 }
 
@@ -137,6 +139,39 @@ var relationGetTests = []struct {
 		relid:   1,
 		args:    []string{"missing", "u/1", "--format", "smart"},
 		out:     "",
+	}, {
+		summary: "application settings with implicit application",
+		relid:   1,
+		unit:    "m/0",
+		args:    []string{"--app"},
+		out:     "endpoint: m.invalid",
+	}, {
+		summary: "application settings with explicit application",
+		relid:   1,
+		args:    []string{"--app", "endpoint", "m"},
+		out:     "m.invalid",
+	}, {
+		summary: "application settings with explicit unit",
+		relid:   1,
+		args:    []string{"--app", "-", "m/0"},
+		out:     "endpoint: m.invalid",
+	}, {
+		summary: "application settings with explicit local application",
+		relid:   1,
+		args:    []string{"--app", "leader", "u"},
+		out:     "u/0",
+	}, {
+		summary: "application settings with no application chosen",
+		relid:   1,
+		args:    []string{"--app"},
+		code:    2,
+		out:     `no application specified`,
+	}, {
+		summary: "application settings with unknown application",
+		relid:   1,
+		args:    []string{"--app", "-", "bad"},
+		code:    1,
+		out:     `unknown application bad`,
 	},
 }
 
@@ -222,6 +257,8 @@ Summary:
 get relation settings
 
 Options:
+--app  (= false)
+    get the settings of an application rather than a unit
 --format  (= smart)
     Specify output format (json|smart|yaml)
 -o, --output (= "")
@@ -232,6 +269,10 @@ Options:
 Details:
 relation-get prints the value of a unit's relation setting, specified by key.
 If no key is given, or if the key is "-", all keys and values will be printed.
+
+With --app, the settings published by an application are printed instead,
+and the unit id may be replaced by the application's name. Only the leader
+may read the settings of its own application, except in a peer relation.
 %s`[1:]
 
 var relationGetHelpTests = []struct {
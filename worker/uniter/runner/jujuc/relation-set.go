@@ -27,6 +27,10 @@ too long to fit within the command length limit of the shell or
 operating system. The file will contain a YAML map containing the
 settings.  Settings in the file will be overridden by any duplicate
 key-value arguments. A value of "-" for the filename means <stdin>.
+
+The --app option writes the settings of the local unit's application
+instead, which other applications in the relation may read with
+"relation-get --app". Only the leader may write them.
 `
 
 // RelationSetCommand implements the relation-set command.
@@ -38,6 +42,7 @@ type RelationSetCommand struct {
 	Settings        map[string]string
 	settingsFile    cmd.FileVar
 	formatFlag      string // deprecated
+	Application     bool
 }
 
 func NewRelationSetCommand(ctx Context) (cmd.Command, error) {
@@ -69,6 +74,7 @@ func (c *RelationSetCommand) SetFlags(f *gnuflag.FlagSet) {
 	f.Var(&c.settingsFile, "file", "file containing key-value pairs")
 
 	f.StringVar(&c.formatFlag, "format", "", "deprecated format flag")
+	f.BoolVar(&c.Application, "app", false, "set the settings of the local application rather than the unit")
 }
 
 func (c *RelationSetCommand) Init(args []string) error {
@@ -135,7 +141,12 @@ func (c *RelationSetCommand) Run(ctx *cmd.Context) (err error) {
 	if err != nil {
 		return errors.Trace(err)
 	}
-	settings, err := r.Settings()
+	var settings Settings
+	if c.Application {
+		settings, err = r.ApplicationSettings()
+	} else {
+		settings, err = r.Settings()
+	}
 	if err != nil {
 		return errors.Annotate(err, "cannot read relation settings")
 	}
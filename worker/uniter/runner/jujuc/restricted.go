@@ -58,6 +58,16 @@ func (*RestrictedContext) SetApplicationStatus(StatusInfo) error {
 	return ErrRestrictedContext
 }
 
+// SetSubWorkload implements hooks.Context.
+func (*RestrictedContext) SetSubWorkload(string, string, StatusInfo) error {
+	return ErrRestrictedContext
+}
+
+// UnsetSubWorkload implements hooks.Context.
+func (*RestrictedContext) UnsetSubWorkload(string) error {
+	return ErrRestrictedContext
+}
+
 // AvailabilityZone implements hooks.Context.
 func (*RestrictedContext) AvailabilityZone() (string, error) { return "", ErrRestrictedContext }
 
@@ -143,6 +153,9 @@ func (*RestrictedContext) HookRelation() (ContextRelation, error) {
 // RemoteUnitName implements hooks.Context.
 func (*RestrictedContext) RemoteUnitName() (string, error) { return "", ErrRestrictedContext }
 
+// RemoteApplicationName implements hooks.Context.
+func (*RestrictedContext) RemoteApplicationName() (string, error) { return "", ErrRestrictedContext }
+
 // ActionParams implements hooks.Context.
 func (*RestrictedContext) ActionParams() (map[string]interface{}, error) {
 	return nil, ErrRestrictedContext
@@ -173,3 +186,13 @@ func (*RestrictedContext) UnitWorkloadVersion() (string, error) {
 func (*RestrictedContext) SetUnitWorkloadVersion(string) error {
 	return ErrRestrictedContext
 }
+
+// SetTimer implements hooks.Context.
+func (*RestrictedContext) SetTimer(string, time.Duration) error {
+	return ErrRestrictedContext
+}
+
+// UnsetTimer implements hooks.Context.
+func (*RestrictedContext) UnsetTimer(string) error {
+	return ErrRestrictedContext
+}
@@ -61,6 +61,10 @@ var baseCommands = map[string]creator{
 	"juju-reboot" + cmdSuffix:             NewJujuRebootCommand,
 	"status-get" + cmdSuffix:              NewStatusGetCommand,
 	"status-set" + cmdSuffix:              NewStatusSetCommand,
+	"sub-workload-set" + cmdSuffix:        NewSubWorkloadSetCommand,
+	"sub-workload-unset" + cmdSuffix:      NewSubWorkloadUnsetCommand,
+	"timer-set" + cmdSuffix:               NewTimerSetCommand,
+	"timer-unset" + cmdSuffix:             NewTimerUnsetCommand,
 	"network-get" + cmdSuffix:             NewNetworkGetCommand,
 	"application-version-set" + cmdSuffix: NewApplicationVersionSetCommand,
 	"pod-spec-set" + cmdSuffix:            NewPodSpecSetCommand,
@@ -134,10 +138,15 @@ type Request struct {
 // CmdGetter looks up a Command implementation connected to a particular Context.
 type CmdGetter func(contextId, cmdName string) (cmd.Command, error)
 
+// CallRecorder is called with each hook tool call handled by a Server,
+// and the response to it.
+type CallRecorder func(req Request, resp exec.ExecResponse)
+
 // Jujuc implements the jujuc command in the form required by net/rpc.
 type Jujuc struct {
 	mu     sync.Mutex
 	getCmd CmdGetter
+	record CallRecorder
 }
 
 // badReqErrorf returns an error indicating a bad Request.
@@ -187,6 +196,9 @@ func (j *Jujuc) Main(req Request, resp *exec.ExecResponse) error {
 	}
 	resp.Stdout = stdout.Bytes()
 	resp.Stderr = stderr.Bytes()
+	if j.record != nil {
+		j.record(req, *resp)
+	}
 	return nil
 }
 
@@ -205,8 +217,14 @@ type Server struct {
 // remote command invocations against an appropriate Context. It will not
 // actually do so until Run is called.
 func NewServer(getCmd CmdGetter, socketPath string) (*Server, error) {
+	return NewRecordingServer(getCmd, socketPath, nil)
+}
+
+// NewRecordingServer is like NewServer, but the server also passes
+// each call it handles, and the response to it, to record.
+func NewRecordingServer(getCmd CmdGetter, socketPath string, record CallRecorder) (*Server, error) {
 	server := rpc.NewServer()
-	if err := server.Register(&Jujuc{getCmd: getCmd}); err != nil {
+	if err := server.Register(&Jujuc{getCmd: getCmd, record: record}); err != nil {
 		return nil, err
 	}
 	listener, err := sockets.Listen(socketPath)
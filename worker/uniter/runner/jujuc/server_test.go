@@ -145,6 +145,43 @@ func (s *ServerSuite) TestHappyPath(c *gc.C) {
 	c.Assert(string(content), gc.Equals, "something")
 }
 
+func (s *ServerSuite) TestRecordingServer(c *gc.C) {
+	var calls []jujuc.Request
+	var resps []exec.ExecResponse
+	record := func(req jujuc.Request, resp exec.ExecResponse) {
+		calls = append(calls, req)
+		resps = append(resps, resp)
+	}
+	sockPath := s.osDependentSockPath(c)
+	srv, err := jujuc.NewRecordingServer(factory, sockPath, record)
+	c.Assert(err, jc.ErrorIsNil)
+	errc := make(chan error)
+	go func() { errc <- srv.Run() }()
+	defer func() {
+		srv.Close()
+		c.Assert(<-errc, gc.IsNil)
+	}()
+
+	client, err := sockets.Dial(sockPath)
+	c.Assert(err, jc.ErrorIsNil)
+	defer client.Close()
+	req := jujuc.Request{
+		ContextId:   "validCtx",
+		Dir:         c.MkDir(),
+		CommandName: "remote",
+		Args:        []string{"--value", "something"},
+	}
+	var resp exec.ExecResponse
+	err = client.Call("Jujuc.Main", req, &resp)
+	c.Assert(err, jc.ErrorIsNil)
+
+	c.Assert(calls, jc.DeepEquals, []jujuc.Request{req})
+	c.Assert(resps, gc.HasLen, 1)
+	c.Assert(resps[0].Code, gc.Equals, 0)
+	c.Assert(string(resps[0].Stdout), gc.Equals, "eye of newt\n")
+	c.Assert(string(resps[0].Stderr), gc.Equals, "toe of frog\n")
+}
+
 func (s *ServerSuite) TestNoStdin(c *gc.C) {
 	dir := c.MkDir()
 	_, err := s.Call(c, jujuc.Request{
@@ -265,6 +302,10 @@ var newCommandTests = []struct {
 	{"storage-get", ""},
 	{"status-get", ""},
 	{"status-set", ""},
+	{"sub-workload-set", ""},
+	{"sub-workload-unset", ""},
+	{"timer-set", ""},
+	{"timer-unset", ""},
 	// The error message contains .exe on Windows
 	{"random", "unknown command: random(.exe)?"},
 }
@@ -0,0 +1,84 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package jujuc
+
+import (
+	"github.com/juju/cmd"
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+)
+
+// SubWorkloadSetCommand implements the sub-workload-set command.
+type SubWorkloadSetCommand struct {
+	cmd.CommandBase
+	ctx     Context
+	name    string
+	version string
+	status  string
+	message string
+}
+
+// NewSubWorkloadSetCommand makes a jujuc sub-workload-set command.
+func NewSubWorkloadSetCommand(ctx Context) (cmd.Command, error) {
+	return &SubWorkloadSetCommand{ctx: ctx}, nil
+}
+
+// Info is part of the cmd.Command interface.
+func (c *SubWorkloadSetCommand) Info() *cmd.Info {
+	doc := `
+Sets the status of a named sub-workload of the unit, such as a
+monitoring agent or log shipper managed by the charm alongside its
+main workload. Each sub-workload is reported separately in "juju status"
+and its status history, so that it does not have to share the unit's
+workload status. Message is optional.
+
+The sub-workload is added the first time its status is set, and keeps
+the version last given with --version until it is removed with
+sub-workload-unset. Names must start with a letter and contain only
+lower case letters, digits and single hyphens.
+`
+	return &cmd.Info{
+		Name:    "sub-workload-set",
+		Args:    "<name> <maintenance | blocked | waiting | active> [message]",
+		Purpose: "set the status of a sub-workload",
+		Doc:     doc,
+	}
+}
+
+// SetFlags is part of the cmd.Command interface.
+func (c *SubWorkloadSetCommand) SetFlags(f *gnuflag.FlagSet) {
+	f.StringVar(&c.version, "version", "", "the version of the sub-workload's software")
+}
+
+// Init is part of the cmd.Command interface.
+func (c *SubWorkloadSetCommand) Init(args []string) error {
+	if len(args) < 2 {
+		return errors.Errorf("invalid args, require <name> <status> [message]")
+	}
+	c.name = args[0]
+	valid := false
+	for _, s := range validStatus {
+		if string(s) == args[1] {
+			valid = true
+			break
+		}
+	}
+	if !valid {
+		return errors.Errorf("invalid status %q, expected one of %v", args[1], validStatus)
+	}
+	c.status = args[1]
+	if len(args) > 2 {
+		c.message = args[2]
+		return cmd.CheckEmpty(args[3:])
+	}
+	return nil
+}
+
+// Run is part of the cmd.Command interface.
+func (c *SubWorkloadSetCommand) Run(ctx *cmd.Context) error {
+	return c.ctx.SetSubWorkload(c.name, c.version, StatusInfo{
+		Status: c.status,
+		Info:   c.message,
+	})
+}
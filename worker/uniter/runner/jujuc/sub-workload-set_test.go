@@ -0,0 +1,96 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package jujuc_test
+
+import (
+	"github.com/juju/cmd"
+	"github.com/juju/cmd/cmdtesting"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/worker/uniter/runner/jujuc"
+	"github.com/juju/juju/worker/uniter/runner/jujuc/jujuctesting"
+)
+
+type subWorkloadSetSuite struct {
+	ContextSuite
+}
+
+var _ = gc.Suite(&subWorkloadSetSuite{})
+
+var subWorkloadSetInitTests = []struct {
+	args []string
+	err  string
+}{
+	{[]string{"telegraf", "active"}, ""},
+	{[]string{"telegraf", "active", "shipping metrics"}, ""},
+	{[]string{"--version", "1.7.2", "telegraf", "active"}, ""},
+	{[]string{}, `invalid args, require <name> <status> \[message\]`},
+	{[]string{"telegraf"}, `invalid args, require <name> <status> \[message\]`},
+	{[]string{"telegraf", "active", "hello", "extra"}, `unrecognized args: \["extra"\]`},
+	{[]string{"telegraf", "foo"}, `invalid status "foo", expected one of \[maintenance blocked waiting active\]`},
+}
+
+func (s *subWorkloadSetSuite) TestInit(c *gc.C) {
+	for i, t := range subWorkloadSetInitTests {
+		c.Logf("test %d: %#v", i, t.args)
+		hctx := s.GetStatusHookContext(c)
+		com, err := jujuc.NewCommand(hctx, cmdString("sub-workload-set"))
+		c.Assert(err, jc.ErrorIsNil)
+		cmdtesting.TestInit(c, com, t.args, t.err)
+	}
+}
+
+func (s *subWorkloadSetSuite) TestHelp(c *gc.C) {
+	hctx := s.GetStatusHookContext(c)
+	com, err := jujuc.NewCommand(hctx, cmdString("sub-workload-set"))
+	c.Assert(err, jc.ErrorIsNil)
+	ctx := cmdtesting.Context(c)
+	code := cmd.Main(com, ctx, []string{"--help"})
+	c.Assert(code, gc.Equals, 0)
+	expectedHelp := "" +
+		"Usage: sub-workload-set [options] <name> <maintenance | blocked | waiting | active> [message]\n" +
+		"\n" +
+		"Summary:\n" +
+		"set the status of a sub-workload\n" +
+		"\n" +
+		"Options:\n" +
+		"--version (= \"\")\n" +
+		"    the version of the sub-workload's software\n" +
+		"\n" +
+		"Details:\n" +
+		"Sets the status of a named sub-workload of the unit, such as a\n" +
+		"monitoring agent or log shipper managed by the charm alongside its\n" +
+		"main workload. Each sub-workload is reported separately in \"juju status\"\n" +
+		"and its status history, so that it does not have to share the unit's\n" +
+		"workload status. Message is optional.\n" +
+		"\n" +
+		"The sub-workload is added the first time its status is set, and keeps\n" +
+		"the version last given with --version until it is removed with\n" +
+		"sub-workload-unset. Names must start with a letter and contain only\n" +
+		"lower case letters, digits and single hyphens.\n"
+
+	c.Assert(bufferString(ctx.Stdout), gc.Equals, expectedHelp)
+	c.Assert(bufferString(ctx.Stderr), gc.Equals, "")
+}
+
+func (s *subWorkloadSetSuite) TestRun(c *gc.C) {
+	hctx := s.GetStatusHookContext(c)
+	com, err := jujuc.NewCommand(hctx, cmdString("sub-workload-set"))
+	c.Assert(err, jc.ErrorIsNil)
+	ctx := cmdtesting.Context(c)
+	code := cmd.Main(com, ctx, []string{"--version", "1.7.2", "telegraf", "active", "shipping metrics"})
+	c.Assert(code, gc.Equals, 0)
+	c.Assert(bufferString(ctx.Stderr), gc.Equals, "")
+	c.Assert(bufferString(ctx.Stdout), gc.Equals, "")
+	c.Assert(hctx.info.SubWorkloads, jc.DeepEquals, map[string]jujuctesting.SubWorkload{
+		"telegraf": {
+			Version: "1.7.2",
+			Status: jujuc.StatusInfo{
+				Status: "active",
+				Info:   "shipping metrics",
+			},
+		},
+	})
+}
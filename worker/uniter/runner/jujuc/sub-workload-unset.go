@@ -0,0 +1,50 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package jujuc
+
+import (
+	"github.com/juju/cmd"
+	"github.com/juju/errors"
+)
+
+// SubWorkloadUnsetCommand implements the sub-workload-unset command.
+type SubWorkloadUnsetCommand struct {
+	cmd.CommandBase
+	ctx  Context
+	name string
+}
+
+// NewSubWorkloadUnsetCommand makes a jujuc sub-workload-unset command.
+func NewSubWorkloadUnsetCommand(ctx Context) (cmd.Command, error) {
+	return &SubWorkloadUnsetCommand{ctx: ctx}, nil
+}
+
+// Info is part of the cmd.Command interface.
+func (c *SubWorkloadUnsetCommand) Info() *cmd.Info {
+	doc := `
+Removes a sub-workload reported with sub-workload-set, along with its
+status history, so that it is no longer shown in "juju status". It is
+not an error to remove a sub-workload that is not reported.
+`
+	return &cmd.Info{
+		Name:    "sub-workload-unset",
+		Args:    "<name>",
+		Purpose: "stop reporting a sub-workload",
+		Doc:     doc,
+	}
+}
+
+// Init is part of the cmd.Command interface.
+func (c *SubWorkloadUnsetCommand) Init(args []string) error {
+	if len(args) < 1 {
+		return errors.New("no sub-workload name specified")
+	}
+	c.name = args[0]
+	return cmd.CheckEmpty(args[1:])
+}
+
+// Run is part of the cmd.Command interface.
+func (c *SubWorkloadUnsetCommand) Run(ctx *cmd.Context) error {
+	return c.ctx.UnsetSubWorkload(c.name)
+}
@@ -0,0 +1,57 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package jujuc_test
+
+import (
+	"github.com/juju/cmd"
+	"github.com/juju/cmd/cmdtesting"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/worker/uniter/runner/jujuc"
+	"github.com/juju/juju/worker/uniter/runner/jujuc/jujuctesting"
+)
+
+type subWorkloadUnsetSuite struct {
+	ContextSuite
+}
+
+var _ = gc.Suite(&subWorkloadUnsetSuite{})
+
+var subWorkloadUnsetInitTests = []struct {
+	args []string
+	err  string
+}{
+	{[]string{"telegraf"}, ""},
+	{[]string{}, `no sub-workload name specified`},
+	{[]string{"telegraf", "extra"}, `unrecognized args: \["extra"\]`},
+}
+
+func (s *subWorkloadUnsetSuite) TestInit(c *gc.C) {
+	for i, t := range subWorkloadUnsetInitTests {
+		c.Logf("test %d: %#v", i, t.args)
+		hctx := s.GetStatusHookContext(c)
+		com, err := jujuc.NewCommand(hctx, cmdString("sub-workload-unset"))
+		c.Assert(err, jc.ErrorIsNil)
+		cmdtesting.TestInit(c, com, t.args, t.err)
+	}
+}
+
+func (s *subWorkloadUnsetSuite) TestRun(c *gc.C) {
+	hctx := s.GetStatusHookContext(c)
+	hctx.info.SubWorkloads = map[string]jujuctesting.SubWorkload{
+		"telegraf": {Status: jujuc.StatusInfo{Status: "active"}},
+		"filebeat": {Status: jujuc.StatusInfo{Status: "active"}},
+	}
+	com, err := jujuc.NewCommand(hctx, cmdString("sub-workload-unset"))
+	c.Assert(err, jc.ErrorIsNil)
+	ctx := cmdtesting.Context(c)
+	code := cmd.Main(com, ctx, []string{"telegraf"})
+	c.Assert(code, gc.Equals, 0)
+	c.Assert(bufferString(ctx.Stderr), gc.Equals, "")
+	c.Assert(bufferString(ctx.Stdout), gc.Equals, "")
+	c.Assert(hctx.info.SubWorkloads, jc.DeepEquals, map[string]jujuctesting.SubWorkload{
+		"filebeat": {Status: jujuc.StatusInfo{Status: "active"}},
+	})
+}
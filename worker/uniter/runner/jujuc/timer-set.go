@@ -0,0 +1,87 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package jujuc
+
+import (
+	"regexp"
+	"time"
+
+	"github.com/juju/cmd"
+	"github.com/juju/errors"
+)
+
+// minTimerInterval is the shortest interval at which a timer may fire.
+const minTimerInterval = time.Minute
+
+var validTimerName = regexp.MustCompile("^[a-z][a-z0-9]*(-[a-z0-9]+)*$")
+
+// validateTimerName returns an error if the name cannot be used
+// as the name of a timer.
+func validateTimerName(name string) error {
+	if !validTimerName.MatchString(name) {
+		return errors.Errorf("invalid timer name %q", name)
+	}
+	return nil
+}
+
+// TimerSetCommand implements the timer-set command.
+type TimerSetCommand struct {
+	cmd.CommandBase
+	ctx      Context
+	name     string
+	interval time.Duration
+}
+
+// NewTimerSetCommand makes a jujuc timer-set command.
+func NewTimerSetCommand(ctx Context) (cmd.Command, error) {
+	return &TimerSetCommand{ctx: ctx}, nil
+}
+
+// Info is part of the cmd.Command interface.
+func (c *TimerSetCommand) Info() *cmd.Info {
+	doc := `
+Registers a timer that runs the "timer-<name>" hook every interval,
+until the timer is removed with timer-unset. Timer hooks are queued
+and run one at a time along with the unit's other hooks; a timer that
+fires again before its hook has run only runs the hook once.
+
+The timer is registered when the current hook completes successfully.
+Setting a timer that is already registered changes its interval, but
+setting it again with the same interval does not postpone it. Names
+must start with a letter and contain only lower case letters, digits
+and single hyphens. Intervals are durations such as 30m or 6h, and
+must be at least one minute.
+`
+	return &cmd.Info{
+		Name:    "timer-set",
+		Args:    "<name> <interval>",
+		Purpose: "run a timer hook at regular intervals",
+		Doc:     doc,
+	}
+}
+
+// Init is part of the cmd.Command interface.
+func (c *TimerSetCommand) Init(args []string) error {
+	if len(args) < 2 {
+		return errors.Errorf("invalid args, require <name> <interval>")
+	}
+	if err := validateTimerName(args[0]); err != nil {
+		return errors.Trace(err)
+	}
+	c.name = args[0]
+	interval, err := time.ParseDuration(args[1])
+	if err != nil {
+		return errors.Errorf("invalid interval %q", args[1])
+	}
+	if interval < minTimerInterval {
+		return errors.Errorf("interval %v is shorter than the minimum of %v", interval, minTimerInterval)
+	}
+	c.interval = interval
+	return cmd.CheckEmpty(args[2:])
+}
+
+// Run is part of the cmd.Command interface.
+func (c *TimerSetCommand) Run(ctx *cmd.Context) error {
+	return c.ctx.SetTimer(c.name, c.interval)
+}
@@ -0,0 +1,74 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package jujuc_test
+
+import (
+	"time"
+
+	"github.com/juju/cmd"
+	"github.com/juju/cmd/cmdtesting"
+	"github.com/juju/errors"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/worker/uniter/runner/jujuc"
+)
+
+type timerSetSuite struct {
+	ContextSuite
+}
+
+var _ = gc.Suite(&timerSetSuite{})
+
+var timerSetInitTests = []struct {
+	args []string
+	err  string
+}{
+	{[]string{"backup", "6h"}, ""},
+	{[]string{"log-rotate", "1m"}, ""},
+	{[]string{}, `invalid args, require <name> <interval>`},
+	{[]string{"backup"}, `invalid args, require <name> <interval>`},
+	{[]string{"backup", "6h", "extra"}, `unrecognized args: \["extra"\]`},
+	{[]string{"Backup", "6h"}, `invalid timer name "Backup"`},
+	{[]string{"log--rotate", "6h"}, `invalid timer name "log--rotate"`},
+	{[]string{"1backup", "6h"}, `invalid timer name "1backup"`},
+	{[]string{"backup", "daily"}, `invalid interval "daily"`},
+	{[]string{"backup", "30s"}, `interval 30s is shorter than the minimum of 1m0s`},
+}
+
+func (s *timerSetSuite) TestInit(c *gc.C) {
+	for i, t := range timerSetInitTests {
+		c.Logf("test %d: %#v", i, t.args)
+		hctx := s.GetHookContext(c, -1, "")
+		com, err := jujuc.NewCommand(hctx, cmdString("timer-set"))
+		c.Assert(err, jc.ErrorIsNil)
+		cmdtesting.TestInit(c, com, t.args, t.err)
+	}
+}
+
+func (s *timerSetSuite) TestRun(c *gc.C) {
+	hctx := s.GetHookContext(c, -1, "")
+	com, err := jujuc.NewCommand(hctx, cmdString("timer-set"))
+	c.Assert(err, jc.ErrorIsNil)
+	ctx := cmdtesting.Context(c)
+	code := cmd.Main(com, ctx, []string{"backup", "6h"})
+	c.Assert(code, gc.Equals, 0)
+	c.Assert(bufferString(ctx.Stderr), gc.Equals, "")
+	c.Assert(bufferString(ctx.Stdout), gc.Equals, "")
+	c.Assert(hctx.info.Timers.Timers, jc.DeepEquals, map[string]time.Duration{
+		"backup": 6 * time.Hour,
+	})
+}
+
+func (s *timerSetSuite) TestRunError(c *gc.C) {
+	hctx := s.GetHookContext(c, -1, "")
+	s.Stub.SetErrors(errors.New("timers not supported"))
+	com, err := jujuc.NewCommand(hctx, cmdString("timer-set"))
+	c.Assert(err, jc.ErrorIsNil)
+	ctx := cmdtesting.Context(c)
+	code := cmd.Main(com, ctx, []string{"backup", "6h"})
+	c.Assert(code, gc.Equals, 1)
+	c.Assert(bufferString(ctx.Stderr), gc.Equals, "ERROR timers not supported\n")
+	c.Assert(hctx.info.Timers.Timers, gc.HasLen, 0)
+}
@@ -0,0 +1,54 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package jujuc
+
+import (
+	"github.com/juju/cmd"
+	"github.com/juju/errors"
+)
+
+// TimerUnsetCommand implements the timer-unset command.
+type TimerUnsetCommand struct {
+	cmd.CommandBase
+	ctx  Context
+	name string
+}
+
+// NewTimerUnsetCommand makes a jujuc timer-unset command.
+func NewTimerUnsetCommand(ctx Context) (cmd.Command, error) {
+	return &TimerUnsetCommand{ctx: ctx}, nil
+}
+
+// Info is part of the cmd.Command interface.
+func (c *TimerUnsetCommand) Info() *cmd.Info {
+	doc := `
+Removes a timer registered with timer-set, so that its hook is no
+longer run. The timer is removed when the current hook completes
+successfully. It is not an error to remove a timer that is not
+registered.
+`
+	return &cmd.Info{
+		Name:    "timer-unset",
+		Args:    "<name>",
+		Purpose: "stop running a timer hook",
+		Doc:     doc,
+	}
+}
+
+// Init is part of the cmd.Command interface.
+func (c *TimerUnsetCommand) Init(args []string) error {
+	if len(args) < 1 {
+		return errors.New("no timer name specified")
+	}
+	if err := validateTimerName(args[0]); err != nil {
+		return errors.Trace(err)
+	}
+	c.name = args[0]
+	return cmd.CheckEmpty(args[1:])
+}
+
+// Run is part of the cmd.Command interface.
+func (c *TimerUnsetCommand) Run(ctx *cmd.Context) error {
+	return c.ctx.UnsetTimer(c.name)
+}
@@ -0,0 +1,58 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package jujuc_test
+
+import (
+	"time"
+
+	"github.com/juju/cmd"
+	"github.com/juju/cmd/cmdtesting"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/worker/uniter/runner/jujuc"
+)
+
+type timerUnsetSuite struct {
+	ContextSuite
+}
+
+var _ = gc.Suite(&timerUnsetSuite{})
+
+var timerUnsetInitTests = []struct {
+	args []string
+	err  string
+}{
+	{[]string{"backup"}, ""},
+	{[]string{}, `no timer name specified`},
+	{[]string{"backup", "extra"}, `unrecognized args: \["extra"\]`},
+	{[]string{"back_up"}, `invalid timer name "back_up"`},
+}
+
+func (s *timerUnsetSuite) TestInit(c *gc.C) {
+	for i, t := range timerUnsetInitTests {
+		c.Logf("test %d: %#v", i, t.args)
+		hctx := s.GetHookContext(c, -1, "")
+		com, err := jujuc.NewCommand(hctx, cmdString("timer-unset"))
+		c.Assert(err, jc.ErrorIsNil)
+		cmdtesting.TestInit(c, com, t.args, t.err)
+	}
+}
+
+func (s *timerUnsetSuite) TestRun(c *gc.C) {
+	hctx := s.GetHookContext(c, -1, "")
+	hctx.info.Timers.Timers = map[string]time.Duration{
+		"backup": 6 * time.Hour,
+		"rotate": time.Hour,
+	}
+	com, err := jujuc.NewCommand(hctx, cmdString("timer-unset"))
+	c.Assert(err, jc.ErrorIsNil)
+	ctx := cmdtesting.Context(c)
+	code := cmd.Main(com, ctx, []string{"backup"})
+	c.Assert(code, gc.Equals, 0)
+	c.Assert(bufferString(ctx.Stderr), gc.Equals, "")
+	c.Assert(hctx.info.Timers.Timers, jc.DeepEquals, map[string]time.Duration{
+		"rotate": time.Hour,
+	})
+}
@@ -0,0 +1,20 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// +build windows
+
+package runner
+
+import (
+	"os/exec"
+)
+
+// setProcessGroup does nothing on windows, where there are no process
+// groups to kill.
+func setProcessGroup(cmd *exec.Cmd) {}
+
+// killProcessGroup kills the started command. Processes it spawned
+// are left running.
+func killProcessGroup(cmd *exec.Cmd) error {
+	return cmd.Process.Kill()
+}
@@ -0,0 +1,222 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package record supports the recording of hook executions by the
+// uniter, and their later replay against the recorded hook tool
+// responses.
+//
+// A recording holds the environment in which a hook was run, a
+// snapshot of the data available to it, and every hook tool call
+// it made along with the response it was given.
+package record
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/juju/errors"
+	"gopkg.in/yaml.v2"
+)
+
+// DirName is the name of the component directory, inside a unit
+// agent's data directory, in which recordings are kept.
+const DirName = "hook-recordings"
+
+// MaxRecordings is the number of recordings kept for each unit.
+// Older recordings are removed when a new one is written.
+const MaxRecordings = 20
+
+const (
+	fileSuffix = ".yaml"
+	timeFormat = "20060102-150405.000000000"
+)
+
+// Recording describes a single hook execution.
+type Recording struct {
+	// Unit is the name of the unit that ran the hook.
+	Unit string `yaml:"unit"`
+
+	// Hook is the name of the hook or action that was run.
+	Hook string `yaml:"hook"`
+
+	// Location is the directory, relative to the charm
+	// directory, that contains the hook: "hooks" or "actions".
+	Location string `yaml:"location"`
+
+	// Started is the time at which the hook was started.
+	Started time.Time `yaml:"started"`
+
+	// Duration is how long the hook ran for.
+	Duration time.Duration `yaml:"duration"`
+
+	// Error holds the error with which the hook failed, if any.
+	Error string `yaml:"error,omitempty"`
+
+	// Env holds the environment variables set for the hook.
+	Env map[string]string `yaml:"env"`
+
+	// Snapshot holds the data available to the hook when
+	// it was started.
+	Snapshot Snapshot `yaml:"snapshot"`
+
+	// Calls holds the hook tool calls made by the hook,
+	// in the order in which they were made.
+	Calls []Call `yaml:"calls,omitempty"`
+}
+
+// Snapshot holds the charm, leader and relation data available
+// to a hook when it was started.
+type Snapshot struct {
+	Config         map[string]interface{} `yaml:"config,omitempty"`
+	LeaderSettings map[string]string      `yaml:"leader-settings,omitempty"`
+	Relation       *RelationSnapshot      `yaml:"relation,omitempty"`
+}
+
+// RelationSnapshot holds the relation data available to a
+// relation hook when it was started.
+type RelationSnapshot struct {
+	Id         int               `yaml:"id"`
+	Key        string            `yaml:"key"`
+	RemoteUnit string            `yaml:"remote-unit,omitempty"`
+	Settings   map[string]string `yaml:"settings,omitempty"`
+}
+
+// Call describes a hook tool call, and the response to it.
+type Call struct {
+	Command string   `yaml:"command"`
+	Args    []string `yaml:"args,omitempty"`
+	Stdin   string   `yaml:"stdin,omitempty"`
+	Stdout  string   `yaml:"stdout,omitempty"`
+	Stderr  string   `yaml:"stderr,omitempty"`
+	Code    int      `yaml:"code"`
+}
+
+// Recorder accumulates the hook tool calls made during a hook
+// execution. It is safe for concurrent use.
+type Recorder struct {
+	mu        sync.Mutex
+	recording Recording
+}
+
+// NewRecorder returns a Recorder that adds calls to the
+// given recording.
+func NewRecorder(recording Recording) *Recorder {
+	return &Recorder{recording: recording}
+}
+
+// AddCall records a hook tool call.
+func (r *Recorder) AddCall(call Call) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.recording.Calls = append(r.recording.Calls, call)
+}
+
+// Finish records the outcome of the hook, and returns the
+// completed recording.
+func (r *Recorder) Finish(finished time.Time, hookErr error) Recording {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.recording.Duration = finished.Sub(r.recording.Started)
+	if hookErr != nil {
+		r.recording.Error = hookErr.Error()
+	}
+	return r.recording
+}
+
+// EnvMap converts a list of "key=value" environment variables
+// into a map.
+func EnvMap(env []string) map[string]string {
+	result := make(map[string]string)
+	for _, kv := range env {
+		parts := strings.SplitN(kv, "=", 2)
+		if len(parts) != 2 {
+			continue
+		}
+		result[parts[0]] = parts[1]
+	}
+	return result
+}
+
+// Write saves the recording to a new file in dir, creating dir if
+// necessary, and removes all but the most recent maxRecordings files.
+// The path of the new file is returned. Recordings may contain
+// secrets, so they are only readable by their owner.
+func Write(dir string, recording Recording, maxRecordings int) (string, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return "", errors.Trace(err)
+	}
+	data, err := yaml.Marshal(recording)
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	filename := fmt.Sprintf(
+		"%s-%s%s",
+		recording.Started.UTC().Format(timeFormat),
+		recording.Hook,
+		fileSuffix,
+	)
+	path := filepath.Join(dir, filename)
+	if err := ioutil.WriteFile(path, data, 0600); err != nil {
+		return "", errors.Trace(err)
+	}
+	if err := prune(dir, maxRecordings); err != nil {
+		return "", errors.Annotate(err, "removing old recordings")
+	}
+	return path, nil
+}
+
+// prune removes all but the newest maxRecordings recordings in dir.
+// Recording filenames start with their start time, so they sort in
+// the order in which they were made.
+func prune(dir string, maxRecordings int) error {
+	names, err := List(dir)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if len(names) <= maxRecordings {
+		return nil
+	}
+	for _, name := range names[:len(names)-maxRecordings] {
+		if err := os.Remove(filepath.Join(dir, name)); err != nil && !os.IsNotExist(err) {
+			return errors.Trace(err)
+		}
+	}
+	return nil
+}
+
+// List returns the filenames of the recordings in dir, oldest first.
+func List(dir string) ([]string, error) {
+	infos, err := ioutil.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, errors.Trace(err)
+	}
+	var names []string
+	for _, info := range infos {
+		if info.Mode().IsRegular() && strings.HasSuffix(info.Name(), fileSuffix) {
+			names = append(names, info.Name())
+		}
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// Read reads the recording in the file with the given path.
+func Read(path string) (Recording, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return Recording{}, errors.Trace(err)
+	}
+	var recording Recording
+	if err := yaml.Unmarshal(data, &recording); err != nil {
+		return Recording{}, errors.Annotatef(err, "reading recording %q", path)
+	}
+	return recording, nil
+}
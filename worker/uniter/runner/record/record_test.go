@@ -0,0 +1,107 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package record_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/worker/uniter/runner/record"
+)
+
+type recordSuite struct {
+	testing.IsolationSuite
+}
+
+var _ = gc.Suite(&recordSuite{})
+
+var started = time.Date(2018, 5, 1, 12, 30, 15, 0, time.UTC)
+
+func newRecording(hook string, started time.Time) record.Recording {
+	return record.Recording{
+		Unit:     "mysql/0",
+		Hook:     hook,
+		Location: "hooks",
+		Started:  started,
+		Env:      map[string]string{"JUJU_UNIT_NAME": "mysql/0"},
+		Snapshot: record.Snapshot{
+			Config:         map[string]interface{}{"port": 3306},
+			LeaderSettings: map[string]string{"password": "secret"},
+			Relation: &record.RelationSnapshot{
+				Id:         1,
+				Key:        "wordpress:db mysql:server",
+				RemoteUnit: "wordpress/0",
+				Settings:   map[string]string{"host": "10.0.0.1"},
+			},
+		},
+	}
+}
+
+func (s *recordSuite) TestRecorder(c *gc.C) {
+	recorder := record.NewRecorder(newRecording("install", started))
+	recorder.AddCall(record.Call{Command: "config-get", Args: []string{"port"}, Stdout: "3306\n"})
+	recorder.AddCall(record.Call{Command: "status-set", Args: []string{"active"}})
+	recording := recorder.Finish(started.Add(3*time.Second), errors.New("exit status 1"))
+
+	expected := newRecording("install", started)
+	expected.Duration = 3 * time.Second
+	expected.Error = "exit status 1"
+	expected.Calls = []record.Call{
+		{Command: "config-get", Args: []string{"port"}, Stdout: "3306\n"},
+		{Command: "status-set", Args: []string{"active"}},
+	}
+	c.Assert(recording, jc.DeepEquals, expected)
+}
+
+func (s *recordSuite) TestEnvMap(c *gc.C) {
+	env := record.EnvMap([]string{"A=1", "B=x=y", "C=", "invalid"})
+	c.Assert(env, jc.DeepEquals, map[string]string{"A": "1", "B": "x=y", "C": ""})
+}
+
+func (s *recordSuite) TestWriteRead(c *gc.C) {
+	dir := filepath.Join(c.MkDir(), "recordings")
+	recording := newRecording("install", started)
+	recording.Calls = []record.Call{{Command: "is-leader", Stdout: "True\n"}}
+
+	path, err := record.Write(dir, recording, 5)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(path, gc.Equals, filepath.Join(dir, "20180501-123015.000000000-install.yaml"))
+
+	info, err := os.Stat(path)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(info.Mode().Perm(), gc.Equals, os.FileMode(0600))
+
+	read, err := record.Read(path)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(read, jc.DeepEquals, recording)
+}
+
+func (s *recordSuite) TestWritePrunes(c *gc.C) {
+	dir := c.MkDir()
+	for i := 0; i < 5; i++ {
+		recording := newRecording(fmt.Sprintf("hook-%d", i), started.Add(time.Duration(i)*time.Second))
+		_, err := record.Write(dir, recording, 3)
+		c.Assert(err, jc.ErrorIsNil)
+	}
+	names, err := record.List(dir)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(names, jc.DeepEquals, []string{
+		"20180501-123017.000000000-hook-2.yaml",
+		"20180501-123018.000000000-hook-3.yaml",
+		"20180501-123019.000000000-hook-4.yaml",
+	})
+}
+
+func (s *recordSuite) TestListMissingDir(c *gc.C) {
+	names, err := record.List(filepath.Join(c.MkDir(), "missing"))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(names, gc.HasLen, 0)
+}
@@ -0,0 +1,147 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package record
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/juju/errors"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	// RecordingEnvKey is the environment variable through which the
+	// path of the recording being replayed is passed to hook tools.
+	RecordingEnvKey = "JUJU_REPLAY_RECORDING"
+
+	// StateEnvKey is the environment variable through which the path
+	// of the replay state file is passed to hook tools.
+	StateEnvKey = "JUJU_REPLAY_STATE"
+)
+
+// replayState records which of a recording's calls have been
+// replayed. It is kept in a file, as each hook tool call is made
+// in a separate process.
+type replayState struct {
+	Replayed []int `yaml:"replayed"`
+}
+
+// ReplayCall returns the recorded response to a call of the named hook
+// tool with the given args, and notes in the state file at statePath
+// that it has been replayed. The earliest matching call that has not
+// yet been replayed is chosen; if all matching calls have already been
+// replayed, the last of them is chosen again.
+func ReplayCall(recording Recording, statePath, command string, args []string) (Call, error) {
+	replayed, err := readReplayed(statePath)
+	if err != nil {
+		return Call{}, errors.Trace(err)
+	}
+	index := -1
+	for i, call := range recording.Calls {
+		if !callMatches(call, command, args) {
+			continue
+		}
+		index = i
+		if !replayed[i] {
+			break
+		}
+	}
+	if index == -1 {
+		return Call{}, errors.NotFoundf("recorded call %q", strings.Join(append([]string{command}, args...), " "))
+	}
+	replayed[index] = true
+	if err := writeReplayed(statePath, replayed); err != nil {
+		return Call{}, errors.Trace(err)
+	}
+	return recording.Calls[index], nil
+}
+
+// Unreplayed returns the calls in the recording that have not been
+// replayed, according to the state file at statePath.
+func Unreplayed(recording Recording, statePath string) ([]Call, error) {
+	replayed, err := readReplayed(statePath)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	var calls []Call
+	for i, call := range recording.Calls {
+		if !replayed[i] {
+			calls = append(calls, call)
+		}
+	}
+	return calls, nil
+}
+
+// ReplayEnv returns the environment in which to replay the recorded
+// hook from the charm in charmDir. The hook tools in toolsDir, which
+// replay the recorded calls, are put first on the path.
+func ReplayEnv(recording Recording, charmDir, toolsDir, recordingPath, statePath, path string) []string {
+	env := make(map[string]string)
+	for key, value := range recording.Env {
+		env[key] = value
+	}
+	env["CHARM_DIR"] = charmDir
+	env["JUJU_CHARM_DIR"] = charmDir
+	env["PATH"] = toolsDir + string(os.PathListSeparator) + path
+	env[RecordingEnvKey] = recordingPath
+	env[StateEnvKey] = statePath
+
+	keys := make([]string, 0, len(env))
+	for key := range env {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	result := make([]string, len(keys))
+	for i, key := range keys {
+		result[i] = fmt.Sprintf("%s=%s", key, env[key])
+	}
+	return result
+}
+
+func callMatches(call Call, command string, args []string) bool {
+	if call.Command != command || len(call.Args) != len(args) {
+		return false
+	}
+	for i, arg := range args {
+		if call.Args[i] != arg {
+			return false
+		}
+	}
+	return true
+}
+
+func readReplayed(statePath string) (map[int]bool, error) {
+	replayed := make(map[int]bool)
+	data, err := ioutil.ReadFile(statePath)
+	if os.IsNotExist(err) {
+		return replayed, nil
+	} else if err != nil {
+		return nil, errors.Trace(err)
+	}
+	var state replayState
+	if err := yaml.Unmarshal(data, &state); err != nil {
+		return nil, errors.Annotate(err, "reading replay state")
+	}
+	for _, i := range state.Replayed {
+		replayed[i] = true
+	}
+	return replayed, nil
+}
+
+func writeReplayed(statePath string, replayed map[int]bool) error {
+	var state replayState
+	for i := range replayed {
+		state.Replayed = append(state.Replayed, i)
+	}
+	sort.Ints(state.Replayed)
+	data, err := yaml.Marshal(state)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	return ioutil.WriteFile(statePath, data, 0600)
+}
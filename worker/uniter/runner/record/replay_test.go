@@ -0,0 +1,85 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package record_test
+
+import (
+	"path/filepath"
+
+	"github.com/juju/errors"
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/worker/uniter/runner/record"
+)
+
+type replaySuite struct {
+	testing.IsolationSuite
+
+	recording record.Recording
+	statePath string
+}
+
+var _ = gc.Suite(&replaySuite{})
+
+func (s *replaySuite) SetUpTest(c *gc.C) {
+	s.IsolationSuite.SetUpTest(c)
+	s.recording = newRecording("config-changed", started)
+	s.recording.Calls = []record.Call{
+		{Command: "config-get", Args: []string{"port"}, Stdout: "3306\n"},
+		{Command: "is-leader", Stdout: "False\n"},
+		{Command: "config-get", Args: []string{"port"}, Stdout: "3307\n"},
+		{Command: "relation-get", Args: []string{"host"}, Stderr: "ERROR no relation\n", Code: 1},
+	}
+	s.statePath = filepath.Join(c.MkDir(), "state")
+}
+
+func (s *replaySuite) replay(c *gc.C, command string, args ...string) record.Call {
+	call, err := record.ReplayCall(s.recording, s.statePath, command, args)
+	c.Assert(err, jc.ErrorIsNil)
+	return call
+}
+
+func (s *replaySuite) TestReplayCallInOrder(c *gc.C) {
+	c.Check(s.replay(c, "config-get", "port").Stdout, gc.Equals, "3306\n")
+	c.Check(s.replay(c, "config-get", "port").Stdout, gc.Equals, "3307\n")
+	// Once all matching calls have been replayed, the last is repeated.
+	c.Check(s.replay(c, "config-get", "port").Stdout, gc.Equals, "3307\n")
+	c.Check(s.replay(c, "relation-get", "host"), jc.DeepEquals, s.recording.Calls[3])
+}
+
+func (s *replaySuite) TestReplayCallNotFound(c *gc.C) {
+	_, err := record.ReplayCall(s.recording, s.statePath, "config-get", []string{"other"})
+	c.Assert(err, jc.Satisfies, errors.IsNotFound)
+	c.Assert(err, gc.ErrorMatches, `recorded call "config-get other" not found`)
+}
+
+func (s *replaySuite) TestUnreplayed(c *gc.C) {
+	calls, err := record.Unreplayed(s.recording, s.statePath)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(calls, jc.DeepEquals, s.recording.Calls)
+
+	s.replay(c, "config-get", "port")
+	s.replay(c, "is-leader")
+	calls, err = record.Unreplayed(s.recording, s.statePath)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(calls, jc.DeepEquals, s.recording.Calls[2:])
+}
+
+func (s *replaySuite) TestReplayEnv(c *gc.C) {
+	s.recording.Env = map[string]string{
+		"JUJU_UNIT_NAME": "mysql/0",
+		"JUJU_CHARM_DIR": "/var/lib/juju/agents/unit-mysql-0/charm",
+		"PATH":           "/var/lib/juju/tools/unit-mysql-0:/usr/bin",
+	}
+	env := record.ReplayEnv(s.recording, "/src/mysql", "/tmp/tools", "/tmp/rec.yaml", "/tmp/state", "/usr/local/bin:/usr/bin")
+	c.Assert(env, jc.DeepEquals, []string{
+		"CHARM_DIR=/src/mysql",
+		"JUJU_CHARM_DIR=/src/mysql",
+		"JUJU_REPLAY_RECORDING=/tmp/rec.yaml",
+		"JUJU_REPLAY_STATE=/tmp/state",
+		"JUJU_UNIT_NAME=mysql/0",
+		"PATH=/tmp/tools:/usr/local/bin:/usr/bin",
+	})
+}
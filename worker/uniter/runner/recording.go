@@ -0,0 +1,95 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package runner
+
+import (
+	"github.com/juju/errors"
+	"github.com/juju/utils/clock"
+	utilexec "github.com/juju/utils/exec"
+
+	"github.com/juju/juju/worker/uniter/runner/jujuc"
+	"github.com/juju/juju/worker/uniter/runner/record"
+)
+
+// newRecorder returns a Recorder for a run of the named hook,
+// holding a snapshot of the data available to it. Failure to
+// read any of that data is logged, but does not stop the hook
+// from being recorded.
+func (runner *runner) newRecorder(hookName, charmLocation string, env []string) *record.Recorder {
+	return record.NewRecorder(record.Recording{
+		Unit:     runner.context.UnitName(),
+		Hook:     hookName,
+		Location: charmLocation,
+		Started:  clock.WallClock.Now(),
+		Env:      record.EnvMap(env),
+		Snapshot: runner.snapshot(),
+	})
+}
+
+func (runner *runner) snapshot() record.Snapshot {
+	var snapshot record.Snapshot
+	if config, err := runner.context.ConfigSettings(); err != nil {
+		logger.Warningf("cannot record charm config: %v", err)
+	} else {
+		snapshot.Config = config
+	}
+	if settings, err := runner.context.LeaderSettings(); err != nil {
+		logger.Warningf("cannot record leader settings: %v", err)
+	} else {
+		snapshot.LeaderSettings = settings
+	}
+	relation, err := runner.context.HookRelation()
+	if errors.IsNotFound(err) {
+		return snapshot
+	} else if err != nil {
+		logger.Warningf("cannot record relation: %v", err)
+		return snapshot
+	}
+	snapshot.Relation = &record.RelationSnapshot{
+		Id:  relation.Id(),
+		Key: relation.FakeId(),
+	}
+	remoteUnit, err := runner.context.RemoteUnitName()
+	if errors.IsNotFound(err) {
+		return snapshot
+	} else if err != nil {
+		logger.Warningf("cannot record remote unit: %v", err)
+		return snapshot
+	}
+	snapshot.Relation.RemoteUnit = remoteUnit
+	if settings, err := relation.ReadSettings(remoteUnit); err != nil {
+		logger.Warningf("cannot record relation settings: %v", err)
+	} else {
+		snapshot.Relation.Settings = settings
+	}
+	return snapshot
+}
+
+// writeRecording completes the recording with the outcome of the
+// hook and saves it. The hook's outcome does not depend on the
+// recording, so failure to save it is only logged.
+func (runner *runner) writeRecording(recorder *record.Recorder, hookErr error) {
+	recording := recorder.Finish(clock.WallClock.Now(), hookErr)
+	dir := runner.paths.ComponentDir(record.DirName)
+	path, err := record.Write(dir, recording, record.MaxRecordings)
+	if err != nil {
+		logger.Warningf("cannot save recording of %s: %v", recording.Hook, err)
+		return
+	}
+	logger.Debugf("recorded %s in %s", recording.Hook, path)
+}
+
+func newCall(req jujuc.Request, resp utilexec.ExecResponse) record.Call {
+	call := record.Call{
+		Command: req.CommandName,
+		Args:    req.Args,
+		Stdout:  string(resp.Stdout),
+		Stderr:  string(resp.Stderr),
+		Code:    resp.Code,
+	}
+	if req.StdinSet {
+		call.Stdin = string(req.Stdin)
+	}
+	return call
+}
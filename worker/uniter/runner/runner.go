@@ -24,6 +24,7 @@ import (
 	"github.com/juju/juju/worker/uniter/runner/context"
 	"github.com/juju/juju/worker/uniter/runner/debug"
 	"github.com/juju/juju/worker/uniter/runner/jujuc"
+	"github.com/juju/juju/worker/uniter/runner/record"
 )
 
 var logger = loggo.GetLogger("juju.worker.uniter.runner")
@@ -53,6 +54,8 @@ type Context interface {
 	SetProcess(process context.HookProcess)
 	HasExecutionSetUnitStatus() bool
 	ResetExecutionSetUnitStatus()
+	HookRecording() bool
+	HookTimeout() time.Duration
 
 	Prepare() error
 	Flush(badge string, failure error) error
@@ -82,7 +85,7 @@ func (runner *runner) RunCommands(commands string) (*utilexec.ExecResponse, erro
 // runCommandsWithTimeout is a helper to abstract common code between run commands and
 // juju-run as an action
 func (runner *runner) runCommandsWithTimeout(commands string, timeout time.Duration, clock clock.Clock) (*utilexec.ExecResponse, error) {
-	srv, err := runner.startJujucServer()
+	srv, err := runner.startJujucServer(nil)
 	if err != nil {
 		return nil, err
 	}
@@ -190,27 +193,24 @@ func (runner *runner) updateActionResults(results *utilexec.ExecResponse) error
 
 // RunAction exists to satisfy the Runner interface.
 func (runner *runner) RunAction(actionName string) error {
-	if _, err := runner.context.ActionData(); err != nil {
+	data, err := runner.context.ActionData()
+	if err != nil {
 		return errors.Trace(err)
 	}
 	if actionName == actions.JujuRunActionName {
 		return runner.runJujuRunAction()
 	}
-	return runner.runCharmHookWithLocation(actionName, "actions")
+	return runner.runCharmHookWithLocation(actionName, "actions", data.Timeout)
 }
 
 // RunHook exists to satisfy the Runner interface.
 func (runner *runner) RunHook(hookName string) error {
-	return runner.runCharmHookWithLocation(hookName, "hooks")
+	return runner.runCharmHookWithLocation(hookName, "hooks", runner.context.HookTimeout())
 }
 
-func (runner *runner) runCharmHookWithLocation(hookName, charmLocation string) error {
-	srv, err := runner.startJujucServer()
-	if err != nil {
-		return err
-	}
-	defer srv.Close()
-
+// runCharmHookWithLocation runs the named hook or action, killing it if
+// it is still running after timeout. A zero timeout means no limit.
+func (runner *runner) runCharmHookWithLocation(hookName, charmLocation string, timeout time.Duration) error {
 	env, err := runner.context.HookVars(runner.paths)
 	if err != nil {
 		return errors.Trace(err)
@@ -222,17 +222,51 @@ func (runner *runner) runCharmHookWithLocation(hookName, charmLocation string) e
 		env = mergeWindowsEnvironment(env, os.Environ())
 	}
 
+	var recorder *record.Recorder
+	var recordCall jujuc.CallRecorder
+	if runner.context.HookRecording() {
+		recorder = runner.newRecorder(hookName, charmLocation, env)
+		recordCall = func(req jujuc.Request, resp utilexec.ExecResponse) {
+			recorder.AddCall(newCall(req, resp))
+		}
+	}
+	srv, err := runner.startJujucServer(recordCall)
+	if err != nil {
+		return err
+	}
+	defer srv.Close()
+
 	debugctx := debug.NewHooksContext(runner.context.UnitName())
 	if session, _ := debugctx.FindSession(); session != nil && session.MatchHook(hookName) {
-		logger.Infof("executing %s via debug-hooks", hookName)
-		err = session.RunHook(hookName, runner.paths.GetCharmDir(), env)
+		err = runner.runDebugHook(session, hookName, env, charmLocation)
 	} else {
-		err = runner.runCharmHook(hookName, env, charmLocation)
+		err = runner.runCharmHook(hookName, env, charmLocation, timeout, clock.WallClock)
+	}
+	if recorder != nil {
+		runner.writeRecording(recorder, err)
 	}
 	return runner.context.Flush(hookName, err)
 }
 
-func (runner *runner) runCharmHook(hookName string, env []string, charmLocation string) error {
+func (runner *runner) runDebugHook(session *debug.ServerSession, hookName string, env []string, charmLocation string) error {
+	charmDir := runner.paths.GetCharmDir()
+	if session.DebugAt() == "" {
+		logger.Infof("executing %s via debug-hooks", hookName)
+		return session.RunHook(hookName, charmDir, env, "")
+	}
+	// In a debug-code session the hook is run as usual, so
+	// it must exist.
+	if _, err := searchHook(charmDir, filepath.Join(charmLocation, hookName)); err != nil {
+		return err
+	}
+	hookRunner := "./" + filepath.Join(charmLocation, hookName)
+	logger.Infof("executing %s via debug-code, at %q", hookName, session.DebugAt())
+	return session.RunHook(hookName, charmDir, env, hookRunner)
+}
+
+func (runner *runner) runCharmHook(
+	hookName string, env []string, charmLocation string, timeout time.Duration, clock clock.Clock,
+) error {
 	charmDir := runner.paths.GetCharmDir()
 	hook, err := searchHook(charmDir, filepath.Join(charmLocation, hookName))
 	if err != nil {
@@ -248,6 +282,11 @@ func (runner *runner) runCharmHook(hookName string, env []string, charmLocation
 	}
 	ps.Stdout = outWriter
 	ps.Stderr = outWriter
+	if timeout > 0 {
+		// Run the hook in its own process group so that anything
+		// it starts is killed along with it on timeout.
+		setProcessGroup(ps)
+	}
 	hookLogger := charmrunner.NewHookLogger(runner.getLogger(hookName), outReader)
 	go hookLogger.Run()
 	err = ps.Start()
@@ -256,13 +295,37 @@ func (runner *runner) runCharmHook(hookName string, env []string, charmLocation
 		// Record the *os.Process of the hook
 		runner.context.SetProcess(hookProcess{ps.Process})
 		// Block until execution finishes
-		err = ps.Wait()
+		err = waitWithTimeout(ps, hookName, timeout, clock)
 	}
 	hookLogger.Stop()
 	return errors.Trace(err)
 }
 
-func (runner *runner) startJujucServer() (*jujuc.Server, error) {
+// waitWithTimeout waits for the started hook to finish. If it is still
+// running after timeout, the hook and its process group are killed and
+// a hook timeout error is returned. A zero timeout means no limit.
+func waitWithTimeout(ps *exec.Cmd, hookName string, timeout time.Duration, clock clock.Clock) error {
+	if timeout <= 0 {
+		return ps.Wait()
+	}
+	done := make(chan error, 1)
+	go func() {
+		done <- ps.Wait()
+	}()
+	select {
+	case err := <-done:
+		return err
+	case <-clock.After(timeout):
+	}
+	logger.Warningf("%s is still running after %v, killing it", hookName, timeout)
+	if err := killProcessGroup(ps); err != nil {
+		logger.Errorf("cannot kill %s: %v", hookName, err)
+	}
+	<-done
+	return charmrunner.NewHookTimeoutError(hookName, timeout)
+}
+
+func (runner *runner) startJujucServer(recordCall jujuc.CallRecorder) (*jujuc.Server, error) {
 	// Prepare server.
 	getCmd := func(ctxId, cmdName string) (cmd.Command, error) {
 		if ctxId != runner.context.Id() {
@@ -270,7 +333,7 @@ func (runner *runner) startJujucServer() (*jujuc.Server, error) {
 		}
 		return jujuc.NewCommand(runner.context, cmdName)
 	}
-	srv, err := jujuc.NewServer(getCmd, runner.paths.GetJujucSocket())
+	srv, err := jujuc.NewRecordingServer(getCmd, runner.paths.GetJujucSocket(), recordCall)
 	if err != nil {
 		return nil, errors.Annotate(err, "starting jujuc server")
 	}
@@ -17,12 +17,15 @@ import (
 	jc "github.com/juju/testing/checkers"
 	"github.com/juju/utils/exec"
 	gc "gopkg.in/check.v1"
+	"gopkg.in/juju/charm.v6"
 	"gopkg.in/juju/charm.v6/hooks"
 
 	"github.com/juju/juju/worker/common/charmrunner"
 	"github.com/juju/juju/worker/uniter/hook"
 	"github.com/juju/juju/worker/uniter/runner"
 	"github.com/juju/juju/worker/uniter/runner/context"
+	"github.com/juju/juju/worker/uniter/runner/jujuc"
+	"github.com/juju/juju/worker/uniter/runner/record"
 	runnertesting "github.com/juju/juju/worker/uniter/runner/testing"
 )
 
@@ -156,6 +159,8 @@ type MockContext struct {
 	flushBadge      string
 	flushFailure    error
 	flushResult     error
+	hookRecording   bool
+	hookTimeout     time.Duration
 }
 
 func (ctx *MockContext) UnitName() string {
@@ -187,6 +192,26 @@ func (ctx *MockContext) Flush(badge string, failure error) error {
 	return ctx.flushResult
 }
 
+func (ctx *MockContext) HookRecording() bool {
+	return ctx.hookRecording
+}
+
+func (ctx *MockContext) HookTimeout() time.Duration {
+	return ctx.hookTimeout
+}
+
+func (ctx *MockContext) ConfigSettings() (charm.Settings, error) {
+	return charm.Settings{"blog-title": "My Title"}, nil
+}
+
+func (ctx *MockContext) LeaderSettings() (map[string]string, error) {
+	return map[string]string{"leader": "data"}, nil
+}
+
+func (ctx *MockContext) HookRelation() (jujuc.ContextRelation, error) {
+	return nil, errors.NotFoundf("relation")
+}
+
 func (ctx *MockContext) ActionParams() (map[string]interface{}, error) {
 	return ctx.actionParams, ctx.actionParamsErr
 }
@@ -253,6 +278,85 @@ func (s *RunMockContextSuite) TestRunHookFlushFailure(c *gc.C) {
 	s.assertRecordedPid(c, ctx.expectPid)
 }
 
+func (s *RunMockContextSuite) TestRunHookNotRecorded(c *gc.C) {
+	ctx := &MockContext{}
+	makeCharm(c, hookSpec{
+		dir:  "hooks",
+		name: hookName,
+		perm: 0700,
+	}, s.paths.GetCharmDir())
+	err := runner.NewRunner(ctx, s.paths).RunHook("something-happened")
+	c.Assert(err, jc.ErrorIsNil)
+	names, err := record.List(s.paths.ComponentDir(record.DirName))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(names, gc.HasLen, 0)
+}
+
+func (s *RunMockContextSuite) TestRunHookRecorded(c *gc.C) {
+	ctx := &MockContext{hookRecording: true}
+	makeCharm(c, hookSpec{
+		dir:  "hooks",
+		name: hookName,
+		perm: 0700,
+		code: 123,
+	}, s.paths.GetCharmDir())
+	err := runner.NewRunner(ctx, s.paths).RunHook("something-happened")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(ctx.flushFailure, gc.ErrorMatches, "exit status 123")
+
+	dir := s.paths.ComponentDir(record.DirName)
+	names, err := record.List(dir)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(names, gc.HasLen, 1)
+	recording, err := record.Read(filepath.Join(dir, names[0]))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(recording.Unit, gc.Equals, "some-unit/999")
+	c.Assert(recording.Hook, gc.Equals, "something-happened")
+	c.Assert(recording.Location, gc.Equals, "hooks")
+	c.Assert(recording.Error, gc.Equals, "exit status 123")
+	c.Assert(recording.Env["VAR"], gc.Equals, "value")
+	c.Assert(recording.Snapshot, jc.DeepEquals, record.Snapshot{
+		Config:         map[string]interface{}{"blog-title": "My Title"},
+		LeaderSettings: map[string]string{"leader": "data"},
+	})
+	c.Assert(recording.Calls, gc.HasLen, 0)
+}
+
+func (s *RunMockContextSuite) TestRunHookTimeout(c *gc.C) {
+	ctx := &MockContext{hookTimeout: 100 * time.Millisecond}
+	makeCharm(c, hookSpec{
+		dir:   "hooks",
+		name:  hookName,
+		perm:  0700,
+		sleep: 10,
+	}, s.paths.GetCharmDir())
+	start := time.Now()
+	err := runner.NewRunner(ctx, s.paths).RunHook("something-happened")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(time.Since(start) < 5*time.Second, jc.IsTrue)
+	c.Assert(ctx.flushBadge, gc.Equals, "something-happened")
+	c.Assert(ctx.flushFailure, gc.ErrorMatches, "something-happened timed out after 100ms")
+	c.Assert(charmrunner.IsHookTimeoutError(ctx.flushFailure), jc.IsTrue)
+}
+
+func (s *RunMockContextSuite) TestRunActionTimeout(c *gc.C) {
+	// Actions are limited by their own timeout, not the hook timeout.
+	ctx := &MockContext{
+		actionData:  &context.ActionData{Timeout: 100 * time.Millisecond},
+		hookTimeout: time.Hour,
+	}
+	makeCharm(c, hookSpec{
+		dir:   "actions",
+		name:  hookName,
+		perm:  0700,
+		sleep: 10,
+	}, s.paths.GetCharmDir())
+	err := runner.NewRunner(ctx, s.paths).RunAction("something-happened")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(ctx.flushFailure, gc.ErrorMatches, "something-happened timed out after 100ms")
+	c.Assert(charmrunner.IsHookTimeoutError(ctx.flushFailure), jc.IsTrue)
+}
+
 func (s *RunMockContextSuite) TestRunActionFlushSuccess(c *gc.C) {
 	expectErr := errors.New("pew pew pew")
 	ctx := &MockContext{
@@ -11,4 +11,6 @@ var (
 	hookName = "something-happened.ps1"
 	// Platform specific script used in runner_test.go
 	echoPidScript = "Set-Content pid $pid"
+	// Platform specific sleep command used in runner_test.go
+	sleepScript = "Start-Sleep -s %d"
 )
@@ -130,6 +130,14 @@ func (a *Attachments) init() error {
 				storageTag.Id(),
 			)
 		}
+		if stateFile.size == 0 && attachment.Size > 0 {
+			// The storage was attached before its size was
+			// recorded; record the current size so that we
+			// only run storage-resized for later changes.
+			if err := stateFile.write(attachment.Size); err != nil {
+				return errors.Trace(err)
+			}
+		}
 		a.storageAttachments[storageTag] = storageAttachment{
 			stateFile,
 			&contextStorage{
@@ -234,7 +242,7 @@ func (a *Attachments) removeStorageAttachment(tag names.StorageTag) error {
 }
 
 func (a *Attachments) storageStateForHook(hi hook.Info) (*stateFile, error) {
-	if !hi.Kind.IsStorage() {
+	if !hook.IsStorage(hi.Kind) {
 		return nil, errors.Errorf("not a storage hook: %#v", hi)
 	}
 	storageAttachment, ok := a.storageAttachments[names.NewStorageTag(hi.StorageId)]
@@ -19,7 +19,7 @@ func StateAttached(s State) bool {
 }
 
 func ValidateHook(tag names.StorageTag, attached bool, hi hook.Info) error {
-	st := &state{tag, attached}
+	st := &state{tag: tag, attached: attached}
 	return st.ValidateHook(hi)
 }
 
@@ -0,0 +1,283 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package timers runs the timers registered by a charm with the
+// timer-set hook tool, so that the uniter can run a timer hook each
+// time one of them fires.
+package timers
+
+import (
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/loggo"
+	"github.com/juju/utils"
+	"github.com/juju/utils/clock"
+
+	"github.com/juju/juju/worker/catacomb"
+)
+
+var logger = loggo.GetLogger("juju.worker.uniter.timers")
+
+// Timer holds the state of a timer registered by the charm.
+type Timer struct {
+	// Interval is how often the timer fires.
+	Interval time.Duration `yaml:"interval"`
+
+	// Last records when the timer last fired for a hook that
+	// was committed or, if there is no such hook, when the timer
+	// was registered.
+	Last time.Time `yaml:"last"`
+}
+
+// Config holds the configuration and dependencies of a timers worker.
+type Config struct {
+	// Path is the file in which the registered timers are stored,
+	// so that they survive restarts of the agent.
+	Path string
+
+	// Clock is used to schedule the timers.
+	Clock clock.Clock
+
+	// Fired receives the name of each timer as it fires. The timer
+	// does not fire again until CommitTimer is called for it.
+	Fired chan<- string
+}
+
+// Validate returns an error if the config cannot be used to start
+// a timers worker.
+func (config Config) Validate() error {
+	if config.Path == "" {
+		return errors.NotValidf("empty Path")
+	}
+	if config.Clock == nil {
+		return errors.NotValidf("nil Clock")
+	}
+	if config.Fired == nil {
+		return errors.NotValidf("nil Fired")
+	}
+	return nil
+}
+
+// Worker fires the timers registered by a charm. A timer that fell due
+// while the agent was not running, or whose hook was not committed
+// before the agent stopped, fires as soon as the worker starts.
+type Worker struct {
+	catacomb catacomb.Catacomb
+	config   Config
+
+	mu     sync.Mutex
+	timers map[string]Timer
+	// fired records when each timer whose hook has not yet been
+	// committed fired.
+	fired map[string]time.Time
+	// redeliver records that the timers in fired should be sent
+	// again, because whoever received them has been replaced.
+	redeliver bool
+	changed   chan struct{}
+}
+
+// NewWorker returns a worker that fires the timers stored in the
+// configured file, and any registered with SetTimer, until it is
+// stopped.
+func NewWorker(config Config) (*Worker, error) {
+	if err := config.Validate(); err != nil {
+		return nil, errors.Trace(err)
+	}
+	timers := make(map[string]Timer)
+	if err := utils.ReadYaml(config.Path, &timers); err != nil && !os.IsNotExist(err) {
+		return nil, errors.Annotatef(err, "cannot read timers")
+	}
+	w := &Worker{
+		config:  config,
+		timers:  timers,
+		fired:   make(map[string]time.Time),
+		changed: make(chan struct{}, 1),
+	}
+	err := catacomb.Invoke(catacomb.Plan{
+		Site: &w.catacomb,
+		Work: w.loop,
+	})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return w, nil
+}
+
+// Kill is part of the worker.Worker interface.
+func (w *Worker) Kill() {
+	w.catacomb.Kill(nil)
+}
+
+// Wait is part of the worker.Worker interface.
+func (w *Worker) Wait() error {
+	return w.catacomb.Wait()
+}
+
+// SetTimer registers the named timer to fire every interval. A timer
+// that is already registered with the same interval is left alone, so
+// that registering it again does not postpone its next firing.
+func (w *Worker) SetTimer(name string, interval time.Duration) error {
+	if interval <= 0 {
+		return errors.NotValidf("timer interval %v", interval)
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if timer, ok := w.timers[name]; ok && timer.Interval == interval {
+		return nil
+	}
+	timers := w.copyTimers()
+	timers[name] = Timer{
+		Interval: interval,
+		Last:     w.config.Clock.Now(),
+	}
+	if err := w.update(timers); err != nil {
+		return errors.Trace(err)
+	}
+	delete(w.fired, name)
+	w.reschedule()
+	return nil
+}
+
+// RemoveTimer removes the named timer. It is not an error to remove
+// a timer that is not registered.
+func (w *Worker) RemoveTimer(name string) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if _, ok := w.timers[name]; !ok {
+		return nil
+	}
+	timers := w.copyTimers()
+	delete(timers, name)
+	if err := w.update(timers); err != nil {
+		return errors.Trace(err)
+	}
+	delete(w.fired, name)
+	w.reschedule()
+	return nil
+}
+
+// CommitTimer records that the hook run for the named timer when it
+// last fired has been committed, so that the timer next falls due one
+// interval after it fired. Until then the timer does not fire again,
+// and fires once more when the worker is restarted. It is not an error
+// to commit a timer that has not fired, or has since been registered
+// again or removed.
+func (w *Worker) CommitTimer(name string) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	firedAt, ok := w.fired[name]
+	if !ok {
+		return nil
+	}
+	timers := w.copyTimers()
+	timer := timers[name]
+	timer.Last = firedAt
+	timers[name] = timer
+	if err := w.update(timers); err != nil {
+		return errors.Trace(err)
+	}
+	delete(w.fired, name)
+	w.reschedule()
+	return nil
+}
+
+// Redeliver sends the name of each timer whose hook has not yet been
+// committed again, so that a new receiver of the fired timers, such as
+// a restarted remote state watcher, does not miss them.
+func (w *Worker) Redeliver() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if len(w.fired) == 0 {
+		return
+	}
+	w.redeliver = true
+	w.reschedule()
+}
+
+// copyTimers returns a copy of the registered timers. It must
+// be called with w.mu held.
+func (w *Worker) copyTimers() map[string]Timer {
+	timers := make(map[string]Timer)
+	for name, timer := range w.timers {
+		timers[name] = timer
+	}
+	return timers
+}
+
+// update stores the supplied timers. It must be called with w.mu held.
+func (w *Worker) update(timers map[string]Timer) error {
+	if err := utils.WriteYaml(w.config.Path, timers); err != nil {
+		return errors.Annotatef(err, "cannot write timers")
+	}
+	w.timers = timers
+	return nil
+}
+
+// reschedule wakes the loop so that it can schedule the timers again.
+func (w *Worker) reschedule() {
+	select {
+	case w.changed <- struct{}{}:
+	default:
+	}
+}
+
+func (w *Worker) loop() error {
+	for {
+		fired, next := w.fire()
+		for _, name := range fired {
+			logger.Debugf("timer %q fired", name)
+			select {
+			case <-w.catacomb.Dying():
+				return w.catacomb.ErrDying()
+			case w.config.Fired <- name:
+			}
+		}
+
+		var timeout <-chan time.Time
+		if !next.IsZero() {
+			timeout = w.config.Clock.After(next.Sub(w.config.Clock.Now()))
+		}
+		select {
+		case <-w.catacomb.Dying():
+			return w.catacomb.ErrDying()
+		case <-w.changed:
+		case <-timeout:
+		}
+	}
+}
+
+// fire records that every timer that has fallen due, and is not
+// waiting for its hook to be committed, has fired, and returns their
+// names in order, along with the time the next timer falls due; that
+// time is zero if no timers are waiting to fall due. If Redeliver has
+// been called, the names of the timers still waiting for their hooks
+// to be committed are returned too.
+func (w *Worker) fire() (fired []string, next time.Time) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	now := w.config.Clock.Now()
+	for name, timer := range w.timers {
+		if _, ok := w.fired[name]; ok {
+			if w.redeliver {
+				fired = append(fired, name)
+			}
+			continue
+		}
+		due := timer.Last.Add(timer.Interval)
+		if due.After(now) {
+			if next.IsZero() || due.Before(next) {
+				next = due
+			}
+			continue
+		}
+		fired = append(fired, name)
+		w.fired[name] = now
+	}
+	w.redeliver = false
+	sort.Strings(fired)
+	return fired, next
+}
@@ -0,0 +1,269 @@
+// Copyright 2018 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package timers_test
+
+import (
+	"path/filepath"
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	"github.com/juju/utils"
+	gc "gopkg.in/check.v1"
+
+	coretesting "github.com/juju/juju/testing"
+	"github.com/juju/juju/worker/uniter/timers"
+	"github.com/juju/juju/worker/workertest"
+)
+
+type WorkerSuite struct {
+	testing.IsolationSuite
+	clock  *testing.Clock
+	fired  chan string
+	config timers.Config
+}
+
+var _ = gc.Suite(&WorkerSuite{})
+
+var start = time.Date(2018, 5, 1, 12, 0, 0, 0, time.UTC)
+
+func (s *WorkerSuite) SetUpTest(c *gc.C) {
+	s.IsolationSuite.SetUpTest(c)
+	s.clock = testing.NewClock(start)
+	s.fired = make(chan string, 10)
+	s.config = timers.Config{
+		Path:  filepath.Join(c.MkDir(), "timers"),
+		Clock: s.clock,
+		Fired: s.fired,
+	}
+}
+
+func (s *WorkerSuite) TestValidate(c *gc.C) {
+	s.testValidate(c, func(config *timers.Config) {
+		config.Path = ""
+	}, "empty Path not valid")
+	s.testValidate(c, func(config *timers.Config) {
+		config.Clock = nil
+	}, "nil Clock not valid")
+	s.testValidate(c, func(config *timers.Config) {
+		config.Fired = nil
+	}, "nil Fired not valid")
+}
+
+func (s *WorkerSuite) testValidate(c *gc.C, mutate func(*timers.Config), expect string) {
+	config := s.config
+	mutate(&config)
+	err := config.Validate()
+	c.Check(err, jc.Satisfies, errors.IsNotValid)
+	c.Check(err, gc.ErrorMatches, expect)
+	_, err = timers.NewWorker(config)
+	c.Check(err, gc.ErrorMatches, expect)
+}
+
+func (s *WorkerSuite) startWorker(c *gc.C) *timers.Worker {
+	w, err := timers.NewWorker(s.config)
+	c.Assert(err, jc.ErrorIsNil)
+	s.AddCleanup(func(c *gc.C) { workertest.CleanKill(c, w) })
+	return w
+}
+
+func (s *WorkerSuite) TestSetTimerFires(c *gc.C) {
+	w := s.startWorker(c)
+	err := w.SetTimer("backup", time.Minute)
+	c.Assert(err, jc.ErrorIsNil)
+	s.assertNotFired(c)
+
+	s.clock.WaitAdvance(time.Minute, coretesting.LongWait, 1)
+	c.Assert(s.waitFired(c), gc.Equals, "backup")
+	s.assertNotFired(c)
+	err = w.CommitTimer("backup")
+	c.Assert(err, jc.ErrorIsNil)
+
+	s.clock.WaitAdvance(time.Minute, coretesting.LongWait, 1)
+	c.Assert(s.waitFired(c), gc.Equals, "backup")
+}
+
+func (s *WorkerSuite) TestTimerWaitsForCommit(c *gc.C) {
+	w := s.startWorker(c)
+	err := w.SetTimer("backup", time.Minute)
+	c.Assert(err, jc.ErrorIsNil)
+	s.clock.WaitAdvance(time.Minute, coretesting.LongWait, 1)
+	c.Assert(s.waitFired(c), gc.Equals, "backup")
+
+	// The timer is not recorded as fired until its hook is committed,
+	// and does not fire again in the meantime.
+	c.Assert(s.readTimers(c), jc.DeepEquals, map[string]timers.Timer{
+		"backup": {Interval: time.Minute, Last: start},
+	})
+	s.clock.Advance(5 * time.Minute)
+	s.assertNotFired(c)
+
+	err = w.CommitTimer("backup")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(s.readTimers(c), jc.DeepEquals, map[string]timers.Timer{
+		"backup": {Interval: time.Minute, Last: start.Add(time.Minute)},
+	})
+
+	// The timer was due again four minutes ago, so fires straight away.
+	c.Assert(s.waitFired(c), gc.Equals, "backup")
+
+	// Committing a timer that has not fired does nothing.
+	err = w.CommitTimer("rotate")
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *WorkerSuite) TestUncommittedTimerFiresAfterRestart(c *gc.C) {
+	w := s.startWorker(c)
+	err := w.SetTimer("backup", time.Hour)
+	c.Assert(err, jc.ErrorIsNil)
+	s.clock.WaitAdvance(time.Hour, coretesting.LongWait, 1)
+	c.Assert(s.waitFired(c), gc.Equals, "backup")
+	workertest.CleanKill(c, w)
+
+	// The hook was never committed, so the timer fires again
+	// when the worker restarts.
+	s.startWorker(c)
+	c.Assert(s.waitFired(c), gc.Equals, "backup")
+}
+
+func (s *WorkerSuite) TestRedeliver(c *gc.C) {
+	w := s.startWorker(c)
+	err := w.SetTimer("backup", time.Minute)
+	c.Assert(err, jc.ErrorIsNil)
+	err = w.SetTimer("rotate", time.Hour)
+	c.Assert(err, jc.ErrorIsNil)
+	s.clock.WaitAdvance(time.Minute, coretesting.LongWait, 1)
+	c.Assert(s.waitFired(c), gc.Equals, "backup")
+
+	// A timer whose hook has not been committed is sent again;
+	// one that has not fired is not.
+	w.Redeliver()
+	c.Assert(s.waitFired(c), gc.Equals, "backup")
+	s.assertNotFired(c)
+
+	// Once the hook is committed, there is nothing to redeliver.
+	err = w.CommitTimer("backup")
+	c.Assert(err, jc.ErrorIsNil)
+	w.Redeliver()
+	s.assertNotFired(c)
+}
+
+func (s *WorkerSuite) TestSetTimerWhileFired(c *gc.C) {
+	w := s.startWorker(c)
+	err := w.SetTimer("backup", time.Minute)
+	c.Assert(err, jc.ErrorIsNil)
+	s.clock.WaitAdvance(time.Minute, coretesting.LongWait, 1)
+	c.Assert(s.waitFired(c), gc.Equals, "backup")
+
+	// Registering the timer with a new interval restarts it, and
+	// the earlier firing is no longer recorded when committed.
+	err = w.SetTimer("backup", time.Hour)
+	c.Assert(err, jc.ErrorIsNil)
+	err = w.CommitTimer("backup")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(s.readTimers(c), jc.DeepEquals, map[string]timers.Timer{
+		"backup": {Interval: time.Hour, Last: start.Add(time.Minute)},
+	})
+
+	s.clock.WaitAdvance(time.Hour, coretesting.LongWait, 1)
+	c.Assert(s.waitFired(c), gc.Equals, "backup")
+}
+
+func (s *WorkerSuite) TestSetTimerStored(c *gc.C) {
+	w := s.startWorker(c)
+	err := w.SetTimer("backup", time.Hour)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(s.readTimers(c), jc.DeepEquals, map[string]timers.Timer{
+		"backup": {Interval: time.Hour, Last: start},
+	})
+}
+
+func (s *WorkerSuite) TestSetTimerSameInterval(c *gc.C) {
+	w := s.startWorker(c)
+	err := w.SetTimer("backup", time.Hour)
+	c.Assert(err, jc.ErrorIsNil)
+	s.clock.Advance(time.Minute)
+
+	// Registering the timer again does not postpone it.
+	err = w.SetTimer("backup", time.Hour)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(s.readTimers(c), jc.DeepEquals, map[string]timers.Timer{
+		"backup": {Interval: time.Hour, Last: start},
+	})
+}
+
+func (s *WorkerSuite) TestSetTimerInvalidInterval(c *gc.C) {
+	w := s.startWorker(c)
+	err := w.SetTimer("backup", 0)
+	c.Assert(err, gc.ErrorMatches, "timer interval 0s not valid")
+}
+
+func (s *WorkerSuite) TestRemoveTimer(c *gc.C) {
+	w := s.startWorker(c)
+	err := w.SetTimer("backup", time.Hour)
+	c.Assert(err, jc.ErrorIsNil)
+	err = w.SetTimer("rotate", time.Minute)
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = w.RemoveTimer("backup")
+	c.Assert(err, jc.ErrorIsNil)
+	err = w.RemoveTimer("unknown")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(s.readTimers(c), jc.DeepEquals, map[string]timers.Timer{
+		"rotate": {Interval: time.Minute, Last: start},
+	})
+}
+
+func (s *WorkerSuite) TestStoredTimersFire(c *gc.C) {
+	err := utils.WriteYaml(s.config.Path, map[string]timers.Timer{
+		"backup": {Interval: time.Hour, Last: start.Add(-2 * time.Hour)},
+		"rotate": {Interval: time.Hour, Last: start.Add(-time.Minute)},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	// The backup timer fell due while the worker was not running,
+	// so it fires straight away, but only once.
+	w := s.startWorker(c)
+	c.Assert(s.waitFired(c), gc.Equals, "backup")
+	s.assertNotFired(c)
+	err = w.CommitTimer("backup")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(s.readTimers(c), jc.DeepEquals, map[string]timers.Timer{
+		"backup": {Interval: time.Hour, Last: start},
+		"rotate": {Interval: time.Hour, Last: start.Add(-time.Minute)},
+	})
+
+	s.clock.WaitAdvance(59*time.Minute, coretesting.LongWait, 1)
+	c.Assert(s.waitFired(c), gc.Equals, "rotate")
+}
+
+func (s *WorkerSuite) readTimers(c *gc.C) map[string]timers.Timer {
+	var stored map[string]timers.Timer
+	err := utils.ReadYaml(s.config.Path, &stored)
+	c.Assert(err, jc.ErrorIsNil)
+	for name, timer := range stored {
+		timer.Last = timer.Last.UTC()
+		stored[name] = timer
+	}
+	return stored
+}
+
+func (s *WorkerSuite) waitFired(c *gc.C) string {
+	select {
+	case name := <-s.fired:
+		return name
+	case <-time.After(coretesting.LongWait):
+		c.Fatalf("timed out waiting for timer to fire")
+	}
+	panic("unreachable")
+}
+
+func (s *WorkerSuite) assertNotFired(c *gc.C) {
+	select {
+	case name := <-s.fired:
+		c.Fatalf("unexpected timer %q fired", name)
+	case <-time.After(coretesting.ShortWait):
+	}
+}
@@ -11,7 +11,6 @@ import (
 
 	"github.com/juju/errors"
 	"github.com/juju/loggo"
-	"github.com/juju/mutex"
 	"github.com/juju/utils"
 	"github.com/juju/utils/clock"
 	"github.com/juju/utils/exec"
@@ -24,6 +23,7 @@ import (
 	"github.com/juju/juju/api/uniter"
 	"github.com/juju/juju/apiserver/params"
 	"github.com/juju/juju/core/leadership"
+	"github.com/juju/juju/core/machinelock"
 	"github.com/juju/juju/core/model"
 	"github.com/juju/juju/status"
 	"github.com/juju/juju/watcher"
@@ -32,6 +32,7 @@ import (
 	"github.com/juju/juju/worker/fortress"
 	"github.com/juju/juju/worker/uniter/actions"
 	"github.com/juju/juju/worker/uniter/charm"
+	"github.com/juju/juju/worker/uniter/healthcheck"
 	"github.com/juju/juju/worker/uniter/hook"
 	uniterleadership "github.com/juju/juju/worker/uniter/leadership"
 	"github.com/juju/juju/worker/uniter/operation"
@@ -43,6 +44,7 @@ import (
 	"github.com/juju/juju/worker/uniter/runner/context"
 	"github.com/juju/juju/worker/uniter/runner/jujuc"
 	"github.com/juju/juju/worker/uniter/storage"
+	"github.com/juju/juju/worker/uniter/timers"
 )
 
 var logger = loggo.GetLogger("juju.worker.uniter")
@@ -83,7 +85,7 @@ type Uniter struct {
 	leadershipTracker leadership.Tracker
 	charmDirGuard     fortress.Guard
 
-	hookLockName string
+	hookLock machinelock.Lock
 
 	// TODO(axw) move the runListener and run-command code outside of the
 	// uniter, and introduce a separate worker. Each worker would feed
@@ -92,6 +94,17 @@ type Uniter struct {
 	commands       runcommands.Commands
 	commandChannel chan string
 
+	// timers fires the timers registered by the charm, sending
+	// their names on timerChannel so that their hooks are run.
+	timers       *timers.Worker
+	timerChannel chan string
+
+	// healthChecker runs the health checks declared by the deployed
+	// charm, signalling healthChangedChannel when the unit's health
+	// changes.
+	healthChecker        worker.Worker
+	healthChangedChannel chan struct{}
+
 	// The execution observer is only used in tests at this stage. Should this
 	// need to be extended, perhaps a list of observers would be needed.
 	observer UniterExecutionObserver
@@ -107,6 +120,12 @@ type Uniter struct {
 	// hookRetryStrategy represents configuration for hook retries
 	hookRetryStrategy params.RetryStrategy
 
+	// timedOutHook and hookTimeout record the hook that most recently
+	// failed by running for too long, so that the failure can be
+	// reported as a timeout.
+	timedOutHook string
+	hookTimeout  time.Duration
+
 	// downloader is the downloader that should be used to get the charm
 	// archive.
 	downloader charm.Downloader
@@ -119,7 +138,7 @@ type UniterParams struct {
 	LeadershipTracker    leadership.Tracker
 	DataDir              string
 	Downloader           charm.Downloader
-	MachineLockName      string
+	MachineLock          machinelock.Lock
 	CharmDirGuard        fortress.Guard
 	UpdateStatusSignal   remotestate.UpdateStatusTimerFunc
 	HookRetryStrategy    params.RetryStrategy
@@ -133,7 +152,7 @@ type UniterParams struct {
 	Observer UniterExecutionObserver
 }
 
-type NewExecutorFunc func(string, operation.State, func() (mutex.Releaser, error)) (operation.Executor, error)
+type NewExecutorFunc func(string, operation.State, func(string) (func(), error)) (operation.Executor, error)
 
 // NewUniter creates a new Uniter which will install, run, and upgrade
 // a charm on behalf of the unit with the given unitTag, by executing
@@ -162,7 +181,7 @@ func newUniter(uniterParams *UniterParams) func() (worker.Worker, error) {
 	u := &Uniter{
 		st:                   uniterParams.UniterFacade,
 		paths:                NewPaths(uniterParams.DataDir, uniterParams.UnitTag),
-		hookLockName:         uniterParams.MachineLockName,
+		hookLock:             uniterParams.MachineLock,
 		leadershipTracker:    uniterParams.LeadershipTracker,
 		charmDirGuard:        uniterParams.CharmDirGuard,
 		updateStatusAt:       uniterParams.UpdateStatusSignal,
@@ -236,6 +255,7 @@ func (u *Uniter) loop(unitTag names.UnitTag) (err error) {
 
 	logger.Infof("hooks are retried %v", u.hookRetryStrategy.ShouldRetry)
 	retryHookChan := make(chan struct{}, 1)
+	u.healthChangedChannel = make(chan struct{}, 1)
 	// TODO(katco): 2016-08-09: This type is deprecated: lp:1611427
 	retryHookTimer := utils.NewBackoffTimer(utils.BackoffTimerConfig{
 		Min:    u.hookRetryStrategy.MinRetryTime,
@@ -270,14 +290,16 @@ func (u *Uniter) loop(unitTag names.UnitTag) (err error) {
 		var err error
 		watcher, err = remotestate.NewWatcher(
 			remotestate.WatcherConfig{
-				State:               remotestate.NewAPIState(u.st),
-				LeadershipTracker:   u.leadershipTracker,
-				UnitTag:             unitTag,
-				UpdateStatusChannel: u.updateStatusAt,
-				CommandChannel:      u.commandChannel,
-				RetryHookChannel:    retryHookChan,
-				ApplicationChannel:  u.applicationChannel,
-				ModelType:           u.modelType,
+				State:                remotestate.NewAPIState(u.st),
+				LeadershipTracker:    u.leadershipTracker,
+				UnitTag:              unitTag,
+				UpdateStatusChannel:  u.updateStatusAt,
+				CommandChannel:       u.commandChannel,
+				RetryHookChannel:     retryHookChan,
+				HealthChangedChannel: u.healthChangedChannel,
+				TimerChannel:         u.timerChannel,
+				ApplicationChannel:   u.applicationChannel,
+				ModelType:            u.modelType,
 			})
 		if err != nil {
 			return errors.Trace(err)
@@ -285,6 +307,9 @@ func (u *Uniter) loop(unitTag names.UnitTag) (err error) {
 		if err := u.catacomb.Add(watcher); err != nil {
 			return errors.Trace(err)
 		}
+		// Timers that fired for the old watcher, and whose hooks
+		// have not been committed, would otherwise be lost.
+		u.timers.Redeliver()
 		return nil
 	}
 
@@ -313,6 +338,15 @@ func (u *Uniter) loop(unitTag names.UnitTag) (err error) {
 			err = errors.Annotate(err, "(re)starting watcher")
 			break
 		}
+		// The health checks are started once the install hook has
+		// been committed; until then, the charm's workload is not
+		// expected to be healthy.
+		if u.operationExecutor.State().Installed {
+			if err = u.restartHealthChecker(); err != nil {
+				err = errors.Annotate(err, "(re)starting health checker")
+				break
+			}
+		}
 
 		cfg := ResolverConfig{
 			ModelType:           u.modelType,
@@ -494,6 +528,19 @@ func (u *Uniter) init(unitTag names.UnitTag) (err error) {
 	u.commands = runcommands.NewCommands()
 	u.commandChannel = make(chan string)
 
+	u.timerChannel = make(chan string)
+	u.timers, err = timers.NewWorker(timers.Config{
+		Path:  u.paths.State.TimersFile,
+		Clock: u.clock,
+		Fired: u.timerChannel,
+	})
+	if err != nil {
+		return errors.Annotatef(err, "cannot create timers")
+	}
+	if err := u.catacomb.Add(u.timers); err != nil {
+		return errors.Trace(err)
+	}
+
 	m, err := u.st.Model()
 	if err != nil {
 		return errors.Trace(err)
@@ -530,6 +577,7 @@ func (u *Uniter) init(unitTag names.UnitTag) (err error) {
 		Tracker:          u.leadershipTracker,
 		GetRelationInfos: u.relations.GetInfo,
 		Storage:          u.storage,
+		Timers:           u.timers,
 		Paths:            u.paths,
 		Clock:            u.clock,
 	})
@@ -576,7 +624,7 @@ func (u *Uniter) init(unitTag names.UnitTag) (err error) {
 	if err != nil {
 		return errors.Trace(err)
 	}
-	u.operationExecutor = operationExecutor
+	u.operationExecutor = operation.NewHistoryExecutor(operationExecutor, u.clock, u.recordHookHistory)
 
 	logger.Debugf("starting juju-run listener on unix:%s", u.paths.Runtime.JujuRunSocket)
 	commandRunner, err := NewChannelCommandRunner(ChannelCommandRunnerConfig{
@@ -629,24 +677,144 @@ func (u *Uniter) RunCommands(args RunCommandsArgs) (results *exec.ExecResponse,
 // acquireExecutionLock acquires the machine-level execution lock, and
 // returns a func that must be called to unlock it. It's used by operation.Executor
 // when running operations that execute external code.
-func (u *Uniter) acquireExecutionLock() (mutex.Releaser, error) {
+func (u *Uniter) acquireExecutionLock(action string) (func(), error) {
 	// We want to make sure we don't block forever when locking, but take the
 	// Uniter's catacomb into account.
-	spec := mutex.Spec{
-		Name:   u.hookLockName,
-		Clock:  u.clock,
-		Delay:  250 * time.Millisecond,
-		Cancel: u.catacomb.Dying(),
-	}
-	logger.Debugf("acquire lock %q for uniter hook execution", u.hookLockName)
-	releaser, err := mutex.Acquire(spec)
+	spec := machinelock.Spec{
+		Cancel:  u.catacomb.Dying(),
+		Worker:  "uniter",
+		Comment: action,
+	}
+	releaser, err := u.hookLock.Acquire(spec)
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
-	logger.Debugf("lock %q acquired", u.hookLockName)
 	return releaser, nil
 }
 
+// recordHookHistory records an operation run by the operation executor
+// in the unit's hook history. Failure to do so is logged, but otherwise
+// ignored: the history is purely diagnostic.
+func (u *Uniter) recordHookHistory(entry operation.HistoryEntry) {
+	result := params.HookHistoryEntry{
+		Operation:  entry.Operation,
+		Hook:       entry.Hook,
+		RemoteUnit: entry.RemoteUnit,
+		Started:    entry.Started,
+		Completed:  entry.Completed,
+		Result:     "completed",
+	}
+	if entry.Err != nil {
+		result.Result = "failed"
+		result.Error = entry.Err.Error()
+	}
+	err := u.unit.RecordHookHistory(result, entry.RelationId)
+	if errors.IsNotImplemented(err) {
+		// The controller is too old to record hook history.
+		return
+	} else if err != nil {
+		logger.Warningf("cannot record hook history for %q: %v", entry.Operation, err)
+	}
+}
+
+// restartHealthChecker stops any running health checker, and starts one
+// that runs the health checks declared by the deployed charm. The checks
+// are run for as long as that charm is deployed; each time the charm
+// changes, they are read again and the checker restarted.
+func (u *Uniter) restartHealthChecker() error {
+	if u.healthChecker != nil {
+		// health checker added to catacomb, will kill uniter if there's an error.
+		worker.Stop(u.healthChecker)
+		u.healthChecker = nil
+	}
+	if u.modelType != model.IAAS {
+		return nil
+	}
+	checks, err := healthcheck.ReadChecks(u.paths.State.CharmDir)
+	if err != nil {
+		// A charm with broken health checks is reported as
+		// having none, rather than stopping the unit.
+		logger.Errorf("cannot run health checks: %v", err)
+	}
+	// The checks start from the state last reported, so that
+	// only real changes are reported and fire health-changed.
+	reported := u.reportedHealth()
+	if len(checks) == 0 {
+		if len(reported) > 0 {
+			if err := u.unit.SetHealth(nil); err != nil {
+				logger.Warningf("cannot clear health: %v", err)
+			}
+		}
+		return nil
+	}
+	healthChecker, err := healthcheck.NewWorker(healthcheck.Config{
+		Checks:  checks,
+		Initial: reported,
+		Prober:  healthcheck.NewProber(u.paths.State.CharmDir, u.clock),
+		Clock:   u.clock,
+		Notify: func(results []healthcheck.Result) {
+			u.reportHealth(results)
+			select {
+			case u.healthChangedChannel <- struct{}{}:
+			default:
+			}
+		},
+	})
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if err := u.catacomb.Add(healthChecker); err != nil {
+		return errors.Trace(err)
+	}
+	u.healthChecker = healthChecker
+	return nil
+}
+
+// reportHealth records the results of the charm's health checks.
+func (u *Uniter) reportHealth(results []healthcheck.Result) {
+	checks := make([]params.HealthCheckResult, len(results))
+	for i, result := range results {
+		checks[i] = params.HealthCheckResult{
+			Name:     result.Name,
+			Healthy:  result.Healthy,
+			Failures: result.Failures,
+			Message:  result.Message,
+			Since:    result.Since,
+		}
+	}
+	err := u.unit.SetHealth(checks)
+	if errors.IsNotImplemented(err) {
+		// The controller is too old to record unit health.
+		return
+	} else if err != nil {
+		logger.Warningf("cannot report health: %v", err)
+	}
+}
+
+// reportedHealth returns the health check results last recorded
+// for the unit.
+func (u *Uniter) reportedHealth() []healthcheck.Result {
+	health, err := u.unit.Health()
+	if errors.IsNotImplemented(err) {
+		// The controller is too old to record unit health.
+		return nil
+	} else if err != nil {
+		logger.Warningf("cannot get health: %v", err)
+		return nil
+	}
+	results := make([]healthcheck.Result, len(health.Checks))
+	for i, check := range health.Checks {
+		results[i] = healthcheck.Result{
+			Name:     check.Name,
+			Healthy:  check.Healthy,
+			Failures: check.Failures,
+			Message:  check.Message,
+			Since:    check.Since,
+		}
+	}
+	return results
+}
+
 func (u *Uniter) reportHookError(hookInfo hook.Info) error {
 	// Set the agent status to "error". We must do this here in case the
 	// hook is interrupted (e.g. unit agent crashes), rather than immediately
@@ -666,5 +834,9 @@ func (u *Uniter) reportHookError(hookInfo hook.Info) error {
 	}
 	statusData["hook"] = hookName
 	statusMessage := fmt.Sprintf("hook failed: %q", hookName)
+	if hookName == u.timedOutHook {
+		statusMessage = fmt.Sprintf("hook failed: %q timed out after %v", hookName, u.hookTimeout)
+		statusData["timeout"] = u.hookTimeout.String()
+	}
 	return setAgentStatus(u, status.Error, statusMessage, statusData)
 }
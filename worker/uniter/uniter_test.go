@@ -15,7 +15,6 @@ import (
 	"time"
 
 	"github.com/juju/errors"
-	"github.com/juju/mutex"
 	jujutesting "github.com/juju/testing"
 	jc "github.com/juju/testing/checkers"
 	ft "github.com/juju/testing/filetesting"
@@ -206,7 +205,7 @@ func (m *noopExecutor) Run(op operation.Operation) error {
 }
 
 func (s *UniterSuite) TestUniterStartupStatus(c *gc.C) {
-	executorFunc := func(stateFilePath string, initialState operation.State, acquireLock func() (mutex.Releaser, error)) (operation.Executor, error) {
+	executorFunc := func(stateFilePath string, initialState operation.State, acquireLock func(string) (func(), error)) (operation.Executor, error) {
 		e, err := operation.NewExecutor(stateFilePath, initialState, acquireLock)
 		c.Assert(err, jc.ErrorIsNil)
 		return &mockExecutor{e}, nil
@@ -293,6 +292,46 @@ func (s *UniterSuite) TestUniterUpdateStatusHook(c *gc.C) {
 	})
 }
 
+func (s *UniterSuite) TestUniterHealthChecksFreshDeploy(c *gc.C) {
+	s.runUniterTests(c, []uniterTest{
+		ut(
+			"health checks run once a fresh unit is installed",
+			createCharm{
+				customize: func(c *gc.C, ctx *context, path string) {
+					metadataPath := filepath.Join(path, "metadata.yaml")
+					f, err := os.OpenFile(metadataPath, os.O_WRONLY|os.O_APPEND, 0644)
+					c.Assert(err, jc.ErrorIsNil)
+					defer f.Close()
+					_, err = f.Write([]byte("\nhealth-checks:\n  ready:\n    exec:\n      command: exit 0\n    interval: 1s\n"))
+					c.Assert(err, jc.ErrorIsNil)
+				},
+			},
+			serveCharm{},
+			createUniter{},
+			waitHooks(startupHooks(false)),
+			waitUnitAgent{status: status.Idle},
+			custom{func(c *gc.C, ctx *context) {
+				timeout := time.After(worstCase)
+				for {
+					select {
+					case <-timeout:
+						c.Fatalf("timed out waiting for unit health")
+					case <-time.After(coretesting.ShortWait):
+						health, err := ctx.unit.Health()
+						c.Assert(err, jc.ErrorIsNil)
+						if len(health.Checks) != 1 {
+							continue
+						}
+						c.Assert(health.Checks[0].Name, gc.Equals, "ready")
+						c.Assert(health.Checks[0].Healthy, jc.IsTrue)
+						return
+					}
+				}
+			}},
+		),
+	})
+}
+
 func (s *UniterSuite) TestNoUniterUpdateStatusHookInError(c *gc.C) {
 	s.runUniterTests(c, []uniterTest{
 		ut(
@@ -1944,7 +1983,7 @@ func (m *mockExecutor) Run(op operation.Operation) error {
 }
 
 func (s *UniterSuite) TestOperationErrorReported(c *gc.C) {
-	executorFunc := func(stateFilePath string, initialState operation.State, acquireLock func() (mutex.Releaser, error)) (operation.Executor, error) {
+	executorFunc := func(stateFilePath string, initialState operation.State, acquireLock func(string) (func(), error)) (operation.Executor, error) {
 		e, err := operation.NewExecutor(stateFilePath, initialState, acquireLock)
 		c.Assert(err, jc.ErrorIsNil)
 		return &mockExecutor{e}, nil
@@ -1965,7 +2004,7 @@ func (s *UniterSuite) TestOperationErrorReported(c *gc.C) {
 }
 
 func (s *UniterSuite) TestTranslateResolverError(c *gc.C) {
-	executorFunc := func(stateFilePath string, initialState operation.State, acquireLock func() (mutex.Releaser, error)) (operation.Executor, error) {
+	executorFunc := func(stateFilePath string, initialState operation.State, acquireLock func(string) (func(), error)) (operation.Executor, error) {
 		e, err := operation.NewExecutor(stateFilePath, initialState, acquireLock)
 		c.Assert(err, jc.ErrorIsNil)
 		return &mockExecutor{e}, nil
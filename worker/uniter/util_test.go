@@ -35,6 +35,7 @@ import (
 	apiuniter "github.com/juju/juju/api/uniter"
 	"github.com/juju/juju/core/leadership"
 	coreleadership "github.com/juju/juju/core/leadership"
+	"github.com/juju/juju/core/machinelock"
 	"github.com/juju/juju/juju/sockets"
 	"github.com/juju/juju/juju/testing"
 	"github.com/juju/juju/network"
@@ -481,6 +482,12 @@ func (s startUniter) step(c *gc.C, ctx *context) {
 	if s.newExecutorFunc != nil {
 		operationExecutor = s.newExecutorFunc
 	}
+	machineLock, err := machinelock.New(machinelock.Config{
+		AgentName: s.unitTag,
+		LockName:  hookExecutionLockName(),
+		Clock:     clock.WallClock,
+	})
+	c.Assert(err, jc.ErrorIsNil)
 
 	uniterParams := uniter.UniterParams{
 		UniterFacade:         ctx.api,
@@ -489,7 +496,7 @@ func (s startUniter) step(c *gc.C, ctx *context) {
 		CharmDirGuard:        ctx.charmDirGuard,
 		DataDir:              ctx.dataDir,
 		Downloader:           downloader,
-		MachineLockName:      hookExecutionLockName(),
+		MachineLock:          machineLock,
 		UpdateStatusSignal:   ctx.updateStatusHookTicker.ReturnTimer(),
 		NewOperationExecutor: operationExecutor,
 		TranslateResolverErr: s.translateResolverErr,